@@ -0,0 +1,231 @@
+package algofft
+
+import (
+	"math"
+
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// Accuracy selects how a plan generates its twiddle factors and Bluestein
+// chirp, trading plan-creation time for accuracy. The transforms themselves
+// run at the same speed at every level.
+type Accuracy uint8
+
+const (
+	// AccuracyDefault evaluates sin/cos of the full angle in float64.
+	AccuracyDefault Accuracy = iota
+
+	// AccuracyFast generates twiddles by block-corrected phasor recursion,
+	// which is cheaper to set up for large sizes. For complex64 the result
+	// is as accurate as the default; for complex128 the recursion adds up
+	// to about 2e-13.
+	AccuracyFast
+
+	// AccuracyAccurate reduces every angle exactly to the first octant in
+	// integer arithmetic before evaluating sin/cos in float64, so twiddles
+	// and chirps are within about one float64 ulp of the exact roots at any
+	// size. It helps complex128 plans, most of all large Bluestein sizes,
+	// whose direct chirp angles πk²/N lose digits as N grows. It does not
+	// improve complex64 plans at any size: almost all of their twiddles
+	// round to the same float32 values at every level, and their error
+	// comes from float32 arithmetic in the butterflies.
+	AccuracyAccurate
+)
+
+// String returns the name of the accuracy level.
+func (a Accuracy) String() string {
+	switch a {
+	case AccuracyDefault:
+		return "default"
+	case AccuracyFast:
+		return "fast"
+	case AccuracyAccurate:
+		return "accurate"
+	default:
+		return "unknown"
+	}
+}
+
+// twiddleMode returns the twiddle generator of the accuracy level.
+func (a Accuracy) twiddleMode() m.TwiddleMode {
+	switch a {
+	case AccuracyFast:
+		return m.TwiddlePhasor
+	case AccuracyAccurate:
+		return m.TwiddleOctant
+	default:
+		return m.TwiddleDirect
+	}
+}
+
+// chirpSequence returns the Bluestein chirp of size n for the accuracy level.
+func chirpSequence[T Complex](n int, acc Accuracy) []T {
+	if acc == AccuracyAccurate {
+		return m.ComputeChirpSequenceOctant[T](n)
+	}
+
+	return fft.ComputeChirpSequence[T](n)
+}
+
+// realWeight returns U[k] = 0.5*(1 + i*W_n^k), the recombination weight of
+// a real plan of size n, in float64.
+func realWeight(k, n int, acc Accuracy) (float64, float64) {
+	if acc == AccuracyAccurate {
+		re, im := m.RootOfUnity(k, n)
+
+		return 0.5 * (1 - im), 0.5 * re
+	}
+
+	theta := 2 * math.Pi * float64(k) / float64(n)
+
+	return 0.5 * (1 + math.Sin(theta)), 0.5 * math.Cos(theta)
+}
+
+// float64Roundoff is the unit roundoff of float64.
+const float64Roundoff = 0x1p-53
+
+// unitRoundoff returns the unit roundoff of the precision of T.
+func unitRoundoff[T Complex]() float64 {
+	var zero T
+	if _, ok := any(zero).(complex64); ok {
+		return 0x1p-24
+	}
+
+	return float64Roundoff
+}
+
+// twiddleError returns the error of one twiddle factor of type T generated
+// at the accuracy level: the float64 evaluation error plus the rounding to T.
+func twiddleError[T Complex](acc Accuracy) float64 {
+	var eval float64
+
+	switch acc {
+	case AccuracyFast:
+		// Each phasor step of a block adds about two roundings.
+		block := m.PhasorBlockSize128
+
+		var zero T
+		if _, ok := any(zero).(complex64); ok {
+			block = m.PhasorBlockSize64
+		}
+
+		eval = 2 * float64(block) * float64Roundoff
+	case AccuracyAccurate:
+		eval = 4 * float64Roundoff
+	default:
+		// The angle 2πk/N, up to 2π, is formed with three roundings.
+		eval = 20 * float64Roundoff
+	}
+
+	if unitRoundoff[T]() > float64Roundoff {
+		eval += unitRoundoff[T]()
+	}
+
+	return eval
+}
+
+// chirpError is twiddleError for the Bluestein chirp of size n, whose
+// direct angles πk²/n grow up to πn.
+func chirpError[T Complex](n int, acc Accuracy) float64 {
+	if acc != AccuracyAccurate {
+		eval := 3 * math.Pi * float64(n) * float64Roundoff
+		if unitRoundoff[T]() > float64Roundoff {
+			eval += unitRoundoff[T]()
+		}
+
+		return eval
+	}
+
+	return twiddleError[T](acc)
+}
+
+// transformErrorBound returns the EstimatedErrorBound of a complex plan of
+// size n. Each of the ⌈log2 n⌉ butterfly stages contributes one rounding of
+// the arithmetic plus the twiddle error; Bluestein runs three transforms of
+// size bluesteinM and two chirp multiplications, Rader three transforms of
+// size n-1 (one of them for its filter) and the filter multiplication.
+func transformErrorBound[T Complex](n int, strategy KernelStrategy, bluesteinM int, acc Accuracy) float64 {
+	if n <= 1 {
+		return 0
+	}
+
+	u := unitRoundoff[T]()
+
+	if strategy == KernelBluestein {
+		inner := transformErrorBound[T](bluesteinM, KernelAuto, 0, acc)
+
+		return 3*inner + 2*chirpError[T](n, acc) + u
+	}
+
+	if strategy == KernelRader {
+		inner := transformErrorBound[T](n-1, KernelAuto, 0, acc)
+
+		return 3*inner + twiddleError[T](acc) + u
+	}
+
+	stages := math.Ceil(math.Log2(float64(n)))
+
+	return stages * (u + twiddleError[T](acc))
+}
+
+// realErrorBound returns the EstimatedErrorBound of a real plan whose
+// half-size complex plan has the given bound: the recombination adds two
+// roundings and the weight error.
+func realErrorBound[C Complex](complexBound float64, acc Accuracy) float64 {
+	return complexBound + 2*unitRoundoff[C]() + twiddleError[C](acc)
+}
+
+// EstimatedErrorBound returns an estimate of the relative RMS error
+// ‖X̃ − X‖/‖X‖ of Forward or Inverse for inputs without extreme dynamic
+// range. It charges every butterfly stage one rounding plus the twiddle
+// error of PlanOptions.Accuracy, so it grows with ⌈log2 N⌉ and sits a few
+// times above measured errors; a Forward/Inverse round trip stays within
+// twice the bound. Compare it with a target tolerance to choose between
+// complex64 and complex128 plans. Prime-factor plans add the bounds of
+// their two factor plans, like 2D plans.
+func (p *Plan[T]) EstimatedErrorBound() float64 {
+	if p.kernelStrategy == KernelPFA {
+		return p.pfaPlan1.EstimatedErrorBound() + p.pfaPlan2.EstimatedErrorBound()
+	}
+
+	return transformErrorBound[T](p.n, p.kernelStrategy, p.bluesteinM, p.meta.Accuracy)
+}
+
+// EstimatedErrorBound returns an estimate of the relative RMS error of
+// Forward or Inverse, like Plan.EstimatedErrorBound.
+func (p *PlanRealT[F, C]) EstimatedErrorBound() float64 {
+	return realErrorBound[C](p.plan.EstimatedErrorBound(), p.options.Accuracy)
+}
+
+// EstimatedErrorBound returns an estimate of the relative RMS error of
+// Forward or Inverse, like Plan.EstimatedErrorBound.
+func (p *PlanReal) EstimatedErrorBound() float64 {
+	return realErrorBound[complex64](p.plan.EstimatedErrorBound(), p.options.Accuracy)
+}
+
+// EstimatedErrorBound returns an estimate of the relative RMS error of
+// Forward or Inverse: the sum of the bounds of the row and column plans.
+func (p *Plan2D[T]) EstimatedErrorBound() float64 {
+	return p.rowPlan.EstimatedErrorBound() + p.colPlan.EstimatedErrorBound()
+}
+
+// EstimatedErrorBound returns an estimate of the relative RMS error of
+// Forward or Inverse: the sum of the bounds of the three axis plans.
+func (p *Plan3D[T]) EstimatedErrorBound() float64 {
+	return p.widthPlan.EstimatedErrorBound() + p.heightPlan.EstimatedErrorBound() +
+		p.depthPlan.EstimatedErrorBound()
+}
+
+// EstimatedErrorBound returns an estimate of the relative RMS error of
+// Forward or Inverse: the sum of the bounds of the row and column plans.
+func (p *PlanReal2D) EstimatedErrorBound() float64 {
+	return p.rowPlan.EstimatedErrorBound() + p.colPlans[0].EstimatedErrorBound()
+}
+
+// EstimatedErrorBound returns an estimate of the relative RMS error of
+// Forward or Inverse: the sum of the bounds of the three axis plans.
+func (p *PlanReal3D) EstimatedErrorBound() float64 {
+	return p.widthPlan.EstimatedErrorBound() + p.heightPlans[0].EstimatedErrorBound() +
+		p.depthPlans[0].EstimatedErrorBound()
+}
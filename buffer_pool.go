@@ -0,0 +1,108 @@
+package algofft
+
+import (
+	"sync"
+
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	mem "github.com/MeKo-Christian/algo-fft/internal/memory"
+)
+
+// BufferPool recycles the aligned twiddle, index and scratch buffers of
+// pooled plans (NewPlanFromPool, NewPlan2DFromPool, NewPlanRealFromPool, ...).
+// Closing a pooled plan returns its buffers so the next plan of the same
+// sizes can be built without allocating.
+//
+// Buffers are grouped into size classes by element type and length. A pool
+// keeps at most MaxBytes of idle buffers, evicting the least recently used
+// size classes first; Trim releases idle buffers on demand and Stats reports
+// per-class hit rates. A BufferPool is safe for concurrent use.
+type BufferPool = fft.BufferPool
+
+// BufferPoolStats is a snapshot of a BufferPool returned by Stats.
+type BufferPoolStats = fft.PoolStats
+
+// BufferPoolClassStats describes one size class within BufferPoolStats.
+type BufferPoolClassStats = fft.SizeClassStats
+
+// BufferKind identifies the element type of a buffer pool size class.
+type BufferKind = fft.BufferKind
+
+const (
+	BufferComplex64  = fft.BufferComplex64
+	BufferComplex128 = fft.BufferComplex128
+	BufferInt        = fft.BufferInt
+)
+
+// DefaultBufferPoolMaxBytes is the idle-memory cap of DefaultBufferPool.
+const DefaultBufferPoolMaxBytes = fft.DefaultMaxBytes
+
+// NewBufferPool returns an empty buffer pool that keeps at most maxBytes of
+// idle buffers. maxBytes <= 0 means no cap.
+func NewBufferPool(maxBytes int64) *BufferPool {
+	return fft.NewBufferPool(maxBytes)
+}
+
+// DefaultBufferPool returns the process-wide pool used by NewPlanPooled.
+func DefaultBufferPool() *BufferPool {
+	return fft.DefaultPool
+}
+
+// getPooledBuffer takes an aligned n-element buffer from pool, or allocates
+// one if pool is nil.
+func getPooledBuffer[T Complex](pool *BufferPool, n int) ([]T, []byte) {
+	var (
+		buf     any
+		backing []byte
+	)
+
+	switch any(*new(T)).(type) {
+	case complex64:
+		if pool == nil {
+			buf, backing = mem.AllocAlignedComplex64(n)
+		} else {
+			buf, backing = pool.GetComplex64(n)
+		}
+	case complex128:
+		if pool == nil {
+			buf, backing = mem.AllocAlignedComplex128(n)
+		} else {
+			buf, backing = pool.GetComplex128(n)
+		}
+	}
+
+	return buf.([]T), backing
+}
+
+// putPooledBuffer returns a buffer obtained from getPooledBuffer to pool.
+func putPooledBuffer[T Complex](pool *BufferPool, buf []T, backing []byte) {
+	if pool == nil || backing == nil {
+		return
+	}
+
+	switch b := any(buf).(type) {
+	case []complex64:
+		pool.PutComplex64(len(b), b, backing)
+	case []complex128:
+		pool.PutComplex128(len(b), b, backing)
+	}
+}
+
+// drainPool empties sp, passing every cached item to release. sp.New is
+// cleared first so Get reports exhaustion; sp must not be used afterwards.
+// Items the runtime already dropped are simply garbage collected.
+func drainPool(sp *sync.Pool, release func(any)) {
+	if sp == nil {
+		return
+	}
+
+	sp.New = nil
+
+	for {
+		item := sp.Get()
+		if item == nil {
+			return
+		}
+
+		release(item)
+	}
+}
@@ -106,6 +106,7 @@ func benchmarkSize(rnd *rand.Rand, n, iters, warmup int, mode string) []benchRes
 		algofft.KernelStockham,
 		algofft.KernelSixStep,
 		algofft.KernelEightStep,
+		algofft.KernelSplitRadix,
 	}
 
 	results := make([]benchResult, 0, len(strategies))
@@ -242,6 +243,8 @@ func strategyName(strategy algofft.KernelStrategy) string {
 		return "SixStep"
 	case algofft.KernelEightStep:
 		return "EightStep"
+	case algofft.KernelSplitRadix:
+		return "SplitRadix"
 	default:
 		return "Auto"
 	}
@@ -257,6 +260,8 @@ func strategyConst(strategy algofft.KernelStrategy) string {
 		return "KernelSixStep"
 	case algofft.KernelEightStep:
 		return "KernelEightStep"
+	case algofft.KernelSplitRadix:
+		return "KernelSplitRadix"
 	default:
 		return "KernelAuto"
 	}
@@ -306,6 +311,8 @@ func strategyToAlgorithmName(strategy algofft.KernelStrategy) string {
 		return "sixstep"
 	case algofft.KernelEightStep:
 		return "eightstep"
+	case algofft.KernelSplitRadix:
+		return "splitradix"
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,368 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// spec is one codelet to generate: a size and its radix plan, outermost
+// stage first.
+type spec struct {
+	size    int
+	radices []int
+}
+
+// parseSpec parses "size=r1xr2x...", e.g. "60=4x5x3".
+func parseSpec(arg string) (spec, error) {
+	sizeText, planText, ok := strings.Cut(arg, "=")
+	if !ok {
+		return spec{}, fmt.Errorf("codelet %q: want size=radices, e.g. 12=4x3", arg)
+	}
+
+	size, err := strconv.Atoi(sizeText)
+	if err != nil || size < 2 {
+		return spec{}, fmt.Errorf("codelet %q: invalid size %q", arg, sizeText)
+	}
+
+	product := 1
+
+	var radices []int
+
+	for _, field := range strings.Split(planText, "x") {
+		r, err := strconv.Atoi(field)
+		if err != nil || !supportedRadix(r) {
+			return spec{}, fmt.Errorf("codelet %q: radix %q is not one of 2, 3, 4, 5", arg, field)
+		}
+
+		radices = append(radices, r)
+		product *= r
+	}
+
+	if product != size {
+		return spec{}, fmt.Errorf("codelet %q: radices multiply to %d, not %d", arg, product, size)
+	}
+
+	return spec{size: size, radices: radices}, nil
+}
+
+func supportedRadix(r int) bool {
+	return r == 2 || r == 3 || r == 4 || r == 5
+}
+
+// planName joins the radices, e.g. "4x5x3".
+func (s spec) planName() string {
+	fields := make([]string, len(s.radices))
+	for i, r := range s.radices {
+		fields[i] = strconv.Itoa(r)
+	}
+
+	return strings.Join(fields, "x")
+}
+
+// funcName returns the name of the generated kernel, e.g.
+// forwardDIT60Radix4x5x3Complex64.
+func (s spec) funcName(direction, typ string) string {
+	return fmt.Sprintf("%sDIT%dRadix%sComplex%s", direction, s.size, s.planName(), strings.TrimPrefix(typ, "complex"))
+}
+
+// signature returns the registry signature, e.g. "dit60_radix4x5x3_generic".
+func (s spec) signature() string {
+	return fmt.Sprintf("dit%d_radix%s_generic", s.size, s.planName())
+}
+
+// fileStem returns the file name without extension, e.g. "dit_size60_radix4x5x3".
+func (s spec) fileStem() string {
+	return fmt.Sprintf("dit_size%d_radix%s", s.size, s.planName())
+}
+
+// codelet emits the straight-line body of one transform. Values are SSA
+// temporaries: every input is loaded once, every operation gets a new
+// name, and the outputs are stored at the end, so dst may alias src.
+type codelet struct {
+	n       int
+	inverse bool
+
+	body     strings.Builder
+	temps    int
+	twiddles []int // table indices loaded into w<index>, in first-use order
+	loaded   map[int]bool
+}
+
+func newCodelet(n int, inverse bool) *codelet {
+	return &codelet{n: n, inverse: inverse, loaded: make(map[int]bool)}
+}
+
+// let emits "tN := expr" and returns tN.
+func (c *codelet) let(format string, args ...any) string {
+	name := "t" + strconv.Itoa(c.temps)
+	c.temps++
+	fmt.Fprintf(&c.body, "\t%s := %s\n", name, fmt.Sprintf(format, args...))
+
+	return name
+}
+
+// transform emits the DFT of the size len(in) values in with radix plan
+// radices. stride maps exponents of this size onto the size n twiddle
+// table. The outputs are returned in natural order.
+//
+// Decimation in time: with size = r*m, the r sub-transforms of size m over
+// in[q], in[q+r], ... are combined by
+//
+//	X[k+m*s] = sum_q W_r^(q*s) * (W_size^(q*k) * Y_q[k])
+func (c *codelet) transform(in []string, radices []int, stride int) []string {
+	size := len(in)
+	if size == 1 {
+		return in
+	}
+
+	r := radices[0]
+	m := size / r
+
+	sub := make([][]string, r)
+	for q := range r {
+		part := make([]string, m)
+		for j := range m {
+			part[j] = in[j*r+q]
+		}
+
+		sub[q] = c.transform(part, radices[1:], stride*r)
+	}
+
+	out := make([]string, size)
+	legs := make([]string, r)
+
+	for k := range m {
+		for q := range r {
+			legs[q] = c.twiddle(sub[q][k], q*k*stride)
+		}
+
+		for s, y := range c.butterfly(legs) {
+			out[k+m*s] = y
+		}
+	}
+
+	return out
+}
+
+// twiddle multiplies v by W_n^e, taking the powers of -i for free and
+// loading the others from the twiddle table.
+func (c *codelet) twiddle(v string, e int) string {
+	e %= c.n
+
+	switch {
+	case e == 0:
+		return v
+	case 2*e == c.n:
+		return c.let("-%s", v)
+	case 4*e == c.n:
+		return c.mulNegI(v, !c.inverse)
+	case 4*e == 3*c.n:
+		return c.mulNegI(v, c.inverse)
+	}
+
+	if !c.loaded[e] {
+		c.loaded[e] = true
+		c.twiddles = append(c.twiddles, e)
+	}
+
+	return c.let("w%d * %s", e, v)
+}
+
+// mulNegI returns -i*v, or i*v when negI is false.
+func (c *codelet) mulNegI(v string, negI bool) string {
+	if negI {
+		return c.let("complex(imag(%s), -real(%s))", v, v)
+	}
+
+	return c.let("complex(-imag(%s), real(%s))", v, v)
+}
+
+// butterfly emits the DFT of len(in) values without twiddles.
+func (c *codelet) butterfly(in []string) []string {
+	switch len(in) {
+	case 2:
+		return []string{c.let("%s + %s", in[0], in[1]), c.let("%s - %s", in[0], in[1])}
+	case 4:
+		return c.butterfly4(in)
+	default:
+		return c.butterflyOdd(in)
+	}
+}
+
+func (c *codelet) butterfly4(in []string) []string {
+	a := c.let("%s + %s", in[0], in[2])
+	b := c.let("%s - %s", in[0], in[2])
+	s := c.let("%s + %s", in[1], in[3])
+	d := c.let("%s - %s", in[1], in[3])
+	rot := c.mulNegI(d, !c.inverse)
+
+	return []string{
+		c.let("%s + %s", a, s),
+		c.let("%s + %s", b, rot),
+		c.let("%s - %s", a, s),
+		c.let("%s - %s", b, rot),
+	}
+}
+
+// butterflyOdd emits an odd-radix DFT from the sums and differences of the
+// mirrored inputs, so each output pair costs real multiplies only:
+//
+//	X[k]   = a_k - i*b_k,  X[r-k] = a_k + i*b_k  (forward)
+//	a_k    = x_0 + sum_j cos(2*pi*j*k/r) * (x_j + x_(r-j))
+//	b_k    = sum_j sin(2*pi*j*k/r) * (x_j - x_(r-j))
+func (c *codelet) butterflyOdd(in []string) []string {
+	r := len(in)
+	half := (r - 1) / 2
+
+	sums := make([]string, half+1)
+	diffs := make([]string, half+1)
+
+	for j := 1; j <= half; j++ {
+		sums[j] = c.let("%s + %s", in[j], in[r-j])
+		diffs[j] = c.let("%s - %s", in[j], in[r-j])
+	}
+
+	out := make([]string, r)
+
+	total := in[0]
+	for j := 1; j <= half; j++ {
+		total += " + " + sums[j]
+	}
+
+	out[0] = c.let("%s", total)
+
+	for k := 1; k <= half; k++ {
+		re, im := "real("+in[0]+")", "imag("+in[0]+")"
+		bre, bim := "", ""
+
+		for j := 1; j <= half; j++ {
+			cos, sin := unitRoot(j*k, r)
+			re += termOf(cos, "real("+sums[j]+")", false)
+			im += termOf(cos, "imag("+sums[j]+")", false)
+			bre += termOf(sin, "real("+diffs[j]+")", bre == "")
+			bim += termOf(sin, "imag("+diffs[j]+")", bim == "")
+		}
+
+		a := c.let("complex(%s, %s)", re, im)
+		b := c.let("complex(%s, %s)", bre, bim)
+		rot := c.mulNegI(b, !c.inverse)
+
+		out[k] = c.let("%s + %s", a, rot)
+		out[r-k] = c.let("%s - %s", a, rot)
+	}
+
+	return out
+}
+
+// unitRoot returns cos and sin of 2*pi*num/den, with values that are exact
+// in binary (0, ±1/2, ±1) snapped to them.
+func unitRoot(num, den int) (float64, float64) {
+	angle := 2 * math.Pi * float64(num%den) / float64(den)
+
+	return snap(math.Cos(angle)), snap(math.Sin(angle))
+}
+
+func snap(v float64) float64 {
+	for _, exact := range [...]float64{0, 0.5, -0.5, 1, -1} {
+		if math.Abs(v-exact) < 1e-15 {
+			return exact
+		}
+	}
+
+	return v
+}
+
+// termOf formats coef*operand as a term of a sum, or as its first term.
+func termOf(coef float64, operand string, first bool) string {
+	sign := " + "
+	if coef < 0 {
+		sign = " - "
+		coef = -coef
+	}
+
+	if first {
+		sign = strings.TrimSpace(strings.TrimPrefix(sign, " + "))
+	}
+
+	if coef == 1 {
+		return sign + operand
+	}
+
+	return sign + strconv.FormatFloat(coef, 'g', -1, 64) + "*" + operand
+}
+
+// kernel returns the Go source of one generated kernel function.
+func kernel(s spec, typ string, inverse bool) string {
+	c := newCodelet(s.size, inverse)
+
+	in := make([]string, s.size)
+	for i := range in {
+		in[i] = "x" + strconv.Itoa(i)
+	}
+
+	out := c.transform(in, s.radices, 1)
+
+	direction := "forward"
+	if inverse {
+		direction = "inverse"
+	}
+
+	var b strings.Builder
+
+	name := s.funcName(direction, typ)
+	stages := make([]string, len(s.radices))
+	for i, r := range s.radices {
+		stages[i] = "radix-" + strconv.Itoa(r)
+	}
+
+	fmt.Fprintf(&b, "// %s computes a %d-point %s FFT of %s data\n", name, s.size, direction, typ)
+	fmt.Fprintf(&b, "// with %s stages, fully unrolled.", strings.Join(stages, ", "))
+
+	if inverse {
+		b.WriteString(" The twiddles are\n// conjugated and the result is scaled by 1/N.")
+	}
+
+	b.WriteString("\n// Returns false if any slice is too small.\n//\n//nolint:funlen,maintidx\n")
+	fmt.Fprintf(&b, "func %s(dst, src, twiddle, scratch []%s) bool {\n", name, typ)
+	fmt.Fprintf(&b, "\tconst n = %d\n\n", s.size)
+	b.WriteString("\tif len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {\n\t\treturn false\n\t}\n\n")
+	b.WriteString("\tx := src[:n]\n\ttw := twiddle[:n]\n\n")
+
+	for _, e := range c.twiddles {
+		if inverse {
+			fmt.Fprintf(&b, "\tw%d := complex(real(tw[%d]), -imag(tw[%d]))\n", e, e, e)
+		} else {
+			fmt.Fprintf(&b, "\tw%d := tw[%d]\n", e, e)
+		}
+	}
+
+	if len(c.twiddles) > 0 {
+		b.WriteString("\n")
+	}
+
+	for i := range s.size {
+		fmt.Fprintf(&b, "\tx%d := x[%d]\n", i, i)
+	}
+
+	b.WriteString("\n")
+	b.WriteString(c.body.String())
+	b.WriteString("\n\ty := dst[:n]\n")
+
+	if inverse {
+		fmt.Fprintf(&b, "\n\tconst scale = 1.0 / n\n\n")
+
+		for k, v := range out {
+			fmt.Fprintf(&b, "\ty[%d] = complex(real(%s)*scale, imag(%s)*scale)\n", k, v, v)
+		}
+	} else {
+		for k, v := range out {
+			fmt.Fprintf(&b, "\ty[%d] = %s\n", k, v)
+		}
+	}
+
+	b.WriteString("\n\treturn true\n}\n")
+
+	return b.String()
+}
@@ -0,0 +1,206 @@
+// Command gencodelets generates straight-line FFT codelets in the spirit of
+// FFTW's genfft. For each size and radix plan it writes a Go file with the
+// forward and inverse kernels for complex64 and complex128 and a test file
+// that checks them against the reference DFT, plus one file registering all
+// of them in the codelet registries.
+//
+// The kernels are fully unrolled decimation-in-time transforms: inputs are
+// loaded once into locals, twiddles with constant indices are loaded up
+// front (multiples of -i are applied as swaps), odd radices use the
+// symmetric real-coefficient butterfly, and the outputs are stored at the
+// end, so the kernels need no scratch and work in place.
+//
+// Usage, from internal/kernels via go generate:
+//
+//	go run ../../cmd/gencodelets -out . 12=4x3 20=4x5 60=4x5x3
+//
+// Each argument is a size and its radices (2, 3, 4 or 5), outermost stage
+// first.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const header = "// Code generated by gencodelets. DO NOT EDIT.\n\n"
+
+// registrationFile holds the generated Register calls.
+const registrationFile = "codelet_init_gen.go"
+
+func main() {
+	out := flag.String("out", ".", "output directory")
+	pkg := flag.String("pkg", "kernels", "package name of the generated files")
+	flag.Parse()
+
+	err := run(*out, *pkg, flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gencodelets:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, pkg string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no codelets given, e.g. 12=4x3")
+	}
+
+	specs := make([]spec, 0, len(args))
+	seen := make(map[string]bool)
+
+	for _, arg := range args {
+		s, err := parseSpec(arg)
+		if err != nil {
+			return err
+		}
+
+		if seen[s.signature()] {
+			return fmt.Errorf("codelet %q given twice", arg)
+		}
+
+		seen[s.signature()] = true
+		specs = append(specs, s)
+	}
+
+	files := generate(pkg, specs)
+	for name, src := range files {
+		err := os.WriteFile(filepath.Join(dir, name), src, 0o644) //nolint:gosec // generated source
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generate returns the formatted generated files by name.
+func generate(pkg string, specs []spec) map[string][]byte {
+	files := make(map[string][]byte, 2*len(specs)+1)
+
+	for _, s := range specs {
+		files[s.fileStem()+".go"] = mustFormat(kernelFile(pkg, s))
+		files[s.fileStem()+"_test.go"] = mustFormat(testFile(pkg, s))
+	}
+
+	files[registrationFile] = mustFormat(registrations(pkg, specs))
+
+	return files
+}
+
+func kernelFile(pkg string, s spec) string {
+	var b strings.Builder
+
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n", pkg)
+
+	for _, typ := range []string{"complex64", "complex128"} {
+		b.WriteString("\n" + kernel(s, typ, false))
+		b.WriteString("\n" + kernel(s, typ, true))
+	}
+
+	return b.String()
+}
+
+func testFile(pkg string, s spec) string {
+	var b strings.Builder
+
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	b.WriteString("import (\n\t\"testing\"\n\n\t\"github.com/MeKo-Christian/algo-fft/internal/reference\"\n)\n")
+
+	for _, tc := range []struct {
+		typ, bits, dft, fwdTol, invTol string
+		seed                           uint64
+	}{
+		{"complex64", "64", "reference.NaiveDFT", "1e-4", "1e-5", 0x9E3779B9},
+		{"complex128", "128", "reference.NaiveDFT128", "1e-10", "1e-12", 0x7F4A7C15},
+	} {
+		name := fmt.Sprintf("DIT%dRadix%sComplex%s", s.size, s.planName(), tc.bits)
+		forward, inverse := s.funcName("forward", tc.typ), s.funcName("inverse", tc.typ)
+
+		fmt.Fprintf(&b, `
+// Test%[1]s checks the generated size-%[2]d kernels against the reference
+// DFT and round-trips in place.
+func Test%[1]s(t *testing.T) {
+	t.Parallel()
+
+	const n = %[2]d
+
+	src := randomComplex%[3]s(n, %#[4]x)
+	twiddle := ComputeTwiddleFactors[%[5]s](n)
+	scratch := make([]%[5]s, n)
+
+	fwd := make([]%[5]s, n)
+	if !%[6]s(fwd, src, twiddle, scratch) {
+		t.Fatal("%[6]s failed")
+	}
+
+	assertComplex%[3]sClose(t, fwd, %[8]s(src), %[9]s)
+
+	back := append([]%[5]s(nil), fwd...)
+	if !%[7]s(back, back, twiddle, scratch) {
+		t.Fatal("%[7]s failed")
+	}
+
+	assertComplex%[3]sClose(t, back, src, %[10]s)
+
+	if %[6]s(fwd, src[:n-1], twiddle, scratch) {
+		t.Error("%[6]s accepted a short src")
+	}
+}
+`, name, s.size, tc.bits, tc.seed+uint64(s.size), tc.typ, forward, inverse, tc.dft, tc.fwdTol, tc.invTol)
+	}
+
+	return b.String()
+}
+
+func registrations(pkg string, specs []spec) string {
+	var b strings.Builder
+
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n", pkg)
+
+	for _, tc := range []struct{ typ, bits, registry string }{
+		{"complex64", "64", "Registry64"},
+		{"complex128", "128", "Registry128"},
+	} {
+		fmt.Fprintf(&b, "\n// registerGeneratedCodelets%s registers the generated %s codelets.\n", tc.bits, tc.typ)
+		fmt.Fprintf(&b, "func registerGeneratedCodelets%s() {\n", tc.bits)
+
+		for i, s := range specs {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+
+			fmt.Fprintf(&b, `	%s.Register(CodeletEntry[%s]{
+		Size:       %d,
+		Forward:    wrapCodelet%s(%s),
+		Inverse:    wrapCodelet%s(%s),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  %q,
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+`, tc.registry, tc.typ, s.size, tc.bits, s.funcName("forward", tc.typ), tc.bits, s.funcName("inverse", tc.typ), s.signature())
+		}
+
+		b.WriteString("}\n")
+	}
+
+	return b.String()
+}
+
+func mustFormat(src string) []byte {
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		// A bug in the generator; show the source to debug it
+		panic(fmt.Sprintf("format generated source: %v\n%s", err, src))
+	}
+
+	return formatted
+}
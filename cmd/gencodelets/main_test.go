@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const kernelsDir = "../../internal/kernels"
+
+// generateArgs returns the codelet arguments of the go:generate directive
+// in internal/kernels.
+func generateArgs(t *testing.T) []string {
+	t.Helper()
+
+	src, err := os.ReadFile(filepath.Join(kernelsDir, "codelet_init.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const prefix = "//go:generate go run ../../cmd/gencodelets -out . "
+
+	for line := range strings.SplitSeq(string(src), "\n") {
+		if args, ok := strings.CutPrefix(line, prefix); ok {
+			return strings.Fields(args)
+		}
+	}
+
+	t.Fatal("no gencodelets directive in codelet_init.go")
+
+	return nil
+}
+
+func TestGeneratedCodeletsUpToDate(t *testing.T) {
+	t.Parallel()
+
+	var specs []spec
+
+	for _, arg := range generateArgs(t) {
+		s, err := parseSpec(arg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		specs = append(specs, s)
+	}
+
+	for name, want := range generate("kernels", specs) {
+		got, err := os.ReadFile(filepath.Join(kernelsDir, name))
+		if err != nil {
+			t.Errorf("%s: %v (run go generate ./internal/kernels)", name, err)
+			continue
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is stale, run go generate ./internal/kernels", name)
+		}
+	}
+}
+
+func TestParseSpec(t *testing.T) {
+	t.Parallel()
+
+	s, err := parseSpec("60=4x5x3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.size != 60 || s.planName() != "4x5x3" || s.signature() != "dit60_radix4x5x3_generic" ||
+		s.funcName("inverse", "complex128") != "inverseDIT60Radix4x5x3Complex128" {
+		t.Errorf("parseSpec(60=4x5x3) = %+v", s)
+	}
+
+	for _, arg := range []string{"12", "12=4x4", "12=6x2", "x=4x3", "1=1", "14=7x2", "12=4x3x"} {
+		if _, err := parseSpec(arg); err == nil {
+			t.Errorf("parseSpec(%q) succeeded", arg)
+		}
+	}
+}
+
+func TestRunRejectsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	if err := run(t.TempDir(), "kernels", []string{"12=4x3", "12=4x3"}); err == nil {
+		t.Error("run accepted a duplicate codelet")
+	}
+
+	if err := run(t.TempDir(), "kernels", nil); err == nil {
+		t.Error("run accepted no codelets")
+	}
+}
@@ -0,0 +1,277 @@
+package algofft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// CodeletFunc is a transform of one fixed size. It reads len(dst) elements
+// of src, may use scratch of the same length, and receives the plan's
+// twiddle table with twiddle[k] = exp(-2πik/n). It must work in place
+// (dst and src the same slice). Forward codelets are unscaled; inverse
+// codelets use the conjugate twiddles and scale their result by 1/n.
+type CodeletFunc[T Complex] = fft.CodeletFunc[T]
+
+// SIMDLevel is the CPU feature a codelet requires.
+type SIMDLevel = fft.SIMDLevel
+
+// SIMD levels, from pure Go to the vector extensions.
+const (
+	SIMDNone   = fft.SIMDNone
+	SIMDSSE2   = fft.SIMDSSE2
+	SIMDAVX2   = fft.SIMDAVX2
+	SIMDAVX512 = fft.SIMDAVX512
+	SIMDNEON   = fft.SIMDNEON
+)
+
+// Codelet describes a fixed-size transform for the codelet registry. Plans
+// of its size bind the best codelet the CPU supports ahead of the generic
+// kernels, and the measuring planners time it against the alternatives.
+// The type parameter selects the precision.
+type Codelet[T Complex] struct {
+	// Size is the transform length the codelet handles.
+	Size int
+
+	// Forward and Inverse are the two directions; both are required.
+	Forward CodeletFunc[T]
+	Inverse CodeletFunc[T]
+
+	// Signature names the codelet, e.g. "dit64_mine_avx2". Plan.Algorithm
+	// and wisdom report it. It is unique per size and precision.
+	Signature string
+
+	// SIMD is the CPU feature the codelet needs. The registry prefers higher
+	// levels the CPU supports, then higher Priority.
+	SIMD SIMDLevel
+
+	// Priority orders codelets of the same size and SIMD level; higher wins.
+	// Codelets with a negative priority are never selected.
+	Priority int
+
+	// Strategy is reported by Plan.KernelStrategy and matched against a
+	// forced PlanOptions.Strategy. KernelAuto selects KernelDIT; KernelDIT,
+	// KernelStockham, KernelSixStep, KernelEightStep and KernelSplitRadix are
+	// accepted.
+	Strategy KernelStrategy
+}
+
+// RegisterCodelet adds c to the codelet registry of its precision, so that
+// plans created afterwards can bind it. A codelet with the signature of one
+// already registered for the size, built-in or not, replaces it.
+//
+// Before registering, the codelet is checked against a direct DFT of
+// pseudo-random input, out of place and in place, in both directions. The
+// check costs O(n²) for size n. A codelet that needs SIMD features the CPU
+// lacks cannot be checked and is rejected.
+//
+// It returns an error wrapping ErrInvalidCodelet if c is incomplete, needs
+// features the CPU lacks or fails the check; the registry is then unchanged.
+func RegisterCodelet[T Complex](c Codelet[T]) error {
+	err := validateCodelet(c)
+	if err != nil {
+		return err
+	}
+
+	if c.Strategy == KernelAuto {
+		c.Strategy = KernelDIT
+	}
+
+	if !fft.SupportsSIMD(cpu.DetectFeatures(), c.SIMD) {
+		return fmt.Errorf("codelet %q needs %v, which this CPU lacks: %w", c.Signature, c.SIMD, ErrInvalidCodelet)
+	}
+
+	err = checkCodelet(c)
+	if err != nil {
+		return err
+	}
+
+	fft.GetRegistry[T]().Replace(fft.CodeletEntry[T]{
+		Size:      c.Size,
+		Forward:   c.Forward,
+		Inverse:   c.Inverse,
+		Algorithm: c.Strategy,
+		SIMDLevel: c.SIMD,
+		Signature: c.Signature,
+		Priority:  c.Priority,
+	})
+
+	return nil
+}
+
+// UnregisterCodelet removes the codelet with the given size and signature
+// from the registry of precision T, built-in or not, and reports whether
+// there was one. Plans created before keep the codelet they bound.
+func UnregisterCodelet[T Complex](size int, signature string) bool {
+	return fft.GetRegistry[T]().Unregister(size, signature)
+}
+
+// RegisteredCodelets returns the codelets registered for size in precision
+// T, in the order the registry prefers them, whether or not the CPU
+// supports them. Use it to find the signatures of built-in codelets.
+func RegisteredCodelets[T Complex](size int) []Codelet[T] {
+	entries := fft.GetRegistry[T]().GetAllForSize(size)
+	codelets := make([]Codelet[T], len(entries))
+
+	for i, e := range entries {
+		codelets[i] = Codelet[T]{
+			Size:      e.Size,
+			Forward:   e.Forward,
+			Inverse:   e.Inverse,
+			Signature: e.Signature,
+			SIMD:      e.SIMDLevel,
+			Priority:  e.Priority,
+			Strategy:  e.Algorithm,
+		}
+	}
+
+	return codelets
+}
+
+func validateCodelet[T Complex](c Codelet[T]) error {
+	switch {
+	case c.Size < 1:
+		return fmt.Errorf("codelet size %d: %w", c.Size, ErrInvalidCodelet)
+	case c.Forward == nil || c.Inverse == nil:
+		return fmt.Errorf("codelet %q needs forward and inverse functions: %w", c.Signature, ErrInvalidCodelet)
+	case c.Signature == "":
+		return fmt.Errorf("codelet of size %d has no signature: %w", c.Size, ErrInvalidCodelet)
+	case c.SIMD > SIMDNEON:
+		return fmt.Errorf("codelet %q has unknown SIMD level %d: %w", c.Signature, c.SIMD, ErrInvalidCodelet)
+	}
+
+	switch c.Strategy {
+	case KernelAuto, KernelDIT, KernelStockham, KernelSixStep, KernelEightStep, KernelSplitRadix:
+		return nil
+	default:
+		return fmt.Errorf("codelet %q cannot report strategy %v: %w", c.Signature, c.Strategy, ErrInvalidCodelet)
+	}
+}
+
+// checkCodelet runs both directions of c on pseudo-random input and
+// compares them with a direct DFT. Errors are relative to the largest
+// expected magnitude.
+func checkCodelet[T Complex](c Codelet[T]) error {
+	n := c.Size
+	tol := 1e-4
+
+	var zero T
+	if _, ok := any(zero).(complex128); ok {
+		tol = 1e-10
+	}
+
+	rng := rand.New(rand.NewPCG(uint64(n), 0x5DEECE66D)) //nolint:gosec // test signal, not crypto
+	signal := make([]complex128, n)
+	src := make([]T, n)
+
+	for i := range signal {
+		src[i] = m.ComplexFromFloat64[T](2*rng.Float64()-1, 2*rng.Float64()-1)
+		signal[i] = complexToC128(src[i])
+	}
+
+	spectrum := directDFT(signal)
+	twiddle := m.TwiddleFactors[T](n, AccuracyDefault.twiddleMode())
+
+	freq := make([]T, n)
+	for i, v := range spectrum {
+		freq[i] = m.ComplexFromFloat64[T](real(v), imag(v))
+	}
+
+	for _, tc := range []struct {
+		name     string
+		fn       CodeletFunc[T]
+		in       []T
+		want     []complex128
+		wantNorm float64
+	}{
+		{"forward", c.Forward, src, spectrum, maxAbs(spectrum)},
+		{"inverse", c.Inverse, freq, signal, maxAbs(signal)},
+	} {
+		for _, inPlace := range []bool{false, true} {
+			got, err := runCodelet(tc.fn, tc.in, twiddle, inPlace)
+			if err != nil {
+				return fmt.Errorf("codelet %q %s transform panicked: %v: %w", c.Signature, tc.name, err, ErrInvalidCodelet)
+			}
+
+			diff := 0.0
+			for i, v := range got {
+				d := cmplx.Abs(complexToC128(v) - tc.want[i])
+				if math.IsNaN(d) {
+					d = math.Inf(1)
+				}
+
+				diff = max(diff, d)
+			}
+
+			if rel := diff / max(tc.wantNorm, 1e-300); rel > tol {
+				return fmt.Errorf("codelet %q %s transform (in place: %v) is off by %.3g relative to the DFT: %w",
+					c.Signature, tc.name, inPlace, rel, ErrInvalidCodelet)
+			}
+		}
+	}
+
+	return nil
+}
+
+// runCodelet applies fn to a copy of in and returns the result, turning a
+// panic into an error.
+func runCodelet[T Complex](fn CodeletFunc[T], in, twiddle []T, inPlace bool) (out []T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	n := len(in)
+	src := append([]T(nil), in...)
+	scratch := make([]T, n)
+
+	out = src
+	if !inPlace {
+		out = make([]T, n)
+	}
+
+	fn(out, src, twiddle, scratch)
+
+	return out, nil
+}
+
+// directDFT computes the forward DFT of x by definition, reducing every
+// exponent j*k modulo n so that large products lose no accuracy.
+func directDFT(x []complex128) []complex128 {
+	n := len(x)
+	w := m.TwiddleFactors[complex128](n, m.TwiddleOctant)
+	out := make([]complex128, n)
+
+	for k := range n {
+		var sum complex128
+
+		idx := 0
+		for j := range n {
+			sum += x[j] * w[idx]
+
+			idx += k
+			if idx >= n {
+				idx -= n
+			}
+		}
+
+		out[k] = sum
+	}
+
+	return out
+}
+
+func maxAbs(x []complex128) float64 {
+	peak := 0.0
+	for _, v := range x {
+		peak = max(peak, cmplx.Abs(v))
+	}
+
+	return peak
+}
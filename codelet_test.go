@@ -0,0 +1,344 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+// dftCodelet returns a codelet that computes the DFT by definition, counting
+// its calls in calls if it is not nil.
+func dftCodelet(n int, signature string, calls *atomic.Int64) Codelet[complex128] {
+	transform := func(inverse bool) CodeletFunc[complex128] {
+		return func(dst, src, twiddle, scratch []complex128) {
+			if calls != nil {
+				calls.Add(1)
+			}
+
+			for k := range n {
+				var sum complex128
+
+				for j := range n {
+					w := twiddle[j*k%n]
+					if inverse {
+						w = complex(real(w), -imag(w))
+					}
+
+					sum += src[j] * w
+				}
+
+				scratch[k] = sum
+			}
+
+			scale := 1.0
+			if inverse {
+				scale = 1 / float64(n)
+			}
+
+			for k := range n {
+				dst[k] = scratch[k] * complex(scale, 0)
+			}
+		}
+	}
+
+	return Codelet[complex128]{
+		Size:      n,
+		Forward:   transform(false),
+		Inverse:   transform(true),
+		Signature: signature,
+	}
+}
+
+func TestRegisterCodelet(t *testing.T) {
+	t.Parallel()
+
+	// 86 = 2*43 would run on the prime-factor algorithm
+	const n = 86
+
+	var calls atomic.Int64
+
+	codelet := dftCodelet(n, "test_dft86", &calls)
+	if err := RegisterCodelet(codelet); err != nil {
+		t.Fatalf("RegisterCodelet failed: %v", err)
+	}
+
+	t.Cleanup(func() { UnregisterCodelet[complex128](n, codelet.Signature) })
+
+	registered := RegisteredCodelets[complex128](n)
+	if len(registered) != 1 || registered[0].Signature != codelet.Signature || registered[0].Strategy != KernelDIT {
+		t.Fatalf("RegisteredCodelets(%d) = %+v, want the DIT codelet %q", n, registered, codelet.Signature)
+	}
+
+	plan, err := NewPlanWithOptions[complex128](n, PlanOptions{})
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+
+	if plan.Algorithm() != codelet.Signature || plan.KernelStrategy() != KernelDIT {
+		t.Fatalf("plan = %v/%q, want KernelDIT/%q", plan.KernelStrategy(), plan.Algorithm(), codelet.Signature)
+	}
+
+	src := randomComplex128(n, 86)
+	before := calls.Load()
+
+	dst := make([]complex128, n)
+	if err := plan.Forward(dst, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "forward", dst, reference.NaiveDFT128(src), 1e-9)
+
+	if err := plan.InverseInPlace(dst); err != nil {
+		t.Fatalf("InverseInPlace failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "inverse", dst, src, 1e-12)
+
+	if calls.Load()-before != 2 {
+		t.Errorf("codelet ran %d times, want 2", calls.Load()-before)
+	}
+
+	if !UnregisterCodelet[complex128](n, codelet.Signature) {
+		t.Fatal("UnregisterCodelet found no codelet")
+	}
+
+	if UnregisterCodelet[complex128](n, codelet.Signature) {
+		t.Error("UnregisterCodelet removed the codelet twice")
+	}
+
+	plan, err = NewPlanWithOptions[complex128](n, PlanOptions{})
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+
+	if plan.KernelStrategy() != KernelPFA {
+		t.Errorf("after UnregisterCodelet, KernelStrategy() = %v, want KernelPFA", plan.KernelStrategy())
+	}
+}
+
+func TestRegisterCodelet_Measure(t *testing.T) {
+	t.Parallel()
+
+	// A prime without a prime-factor split: the codelet replaces Rader and
+	// Bluestein
+	const n = 43
+
+	codelet := dftCodelet(n, "test_dft43", nil)
+	if err := RegisterCodelet(codelet); err != nil {
+		t.Fatalf("RegisterCodelet failed: %v", err)
+	}
+
+	t.Cleanup(func() { UnregisterCodelet[complex128](n, codelet.Signature) })
+
+	wisdom := &mapWisdom{entries: make(map[WisdomKey]WisdomEntry)}
+
+	plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Planner: PlannerMeasure, Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+
+	if plan.Algorithm() != codelet.Signature {
+		t.Fatalf("Algorithm() = %q, want %q", plan.Algorithm(), codelet.Signature)
+	}
+
+	for key, entry := range wisdom.entries {
+		if key.Size == n && entry.Algorithm != codelet.Signature {
+			t.Errorf("wisdom recorded %q, want %q", entry.Algorithm, codelet.Signature)
+		}
+	}
+
+	replay, err := NewPlanWithOptions[complex128](n, PlanOptions{Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlan with wisdom failed: %v", err)
+	}
+
+	if replay.Algorithm() != codelet.Signature {
+		t.Errorf("replayed Algorithm() = %q, want %q", replay.Algorithm(), codelet.Signature)
+	}
+
+	// A forced convolution still runs it
+	plan, err = NewPlanWithOptions[complex128](n, PlanOptions{Strategy: KernelBluestein})
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+
+	if plan.KernelStrategy() != KernelBluestein {
+		t.Errorf("forced KernelStrategy() = %v, want KernelBluestein", plan.KernelStrategy())
+	}
+}
+
+func TestRegisterCodelet_Rejects(t *testing.T) {
+	t.Parallel()
+
+	const n = 53
+
+	good := dftCodelet(n, "test_dft53", nil)
+
+	unscaled := good
+	unscaled.Inverse = func(dst, src, twiddle, scratch []complex128) {
+		good.Inverse(dst, src, twiddle, scratch)
+
+		for i := range dst {
+			dst[i] *= n
+		}
+	}
+
+	identity := good
+	identity.Forward = func(dst, src, _, _ []complex128) { copy(dst, src) }
+
+	panics := good
+	panics.Forward = func(dst, src, _, _ []complex128) { dst[n] = src[0] }
+
+	nan := good
+	nan.Forward = func(dst, _, _, _ []complex128) {
+		for i := range dst {
+			dst[i] = complex(math.NaN(), 0)
+		}
+	}
+
+	// Writes dst while it still reads src, so it fails in place only
+	outOfPlace := good
+	outOfPlace.Forward = func(dst, src, twiddle, _ []complex128) {
+		for k := range n {
+			var sum complex128
+			for j := range n {
+				sum += src[j] * twiddle[j*k%n]
+			}
+
+			dst[k] = sum
+		}
+	}
+
+	noInverse := good
+	noInverse.Inverse = nil
+
+	noSignature := good
+	noSignature.Signature = ""
+
+	badSize := good
+	badSize.Size = 0
+
+	badStrategy := good
+	badStrategy.Strategy = KernelBluestein
+
+	badSIMD := good
+	badSIMD.SIMD = SIMDNEON + 1
+
+	// A level this CPU lacks, so the codelet cannot be checked
+	unsupported := good
+	unsupported.SIMD = SIMDNEON
+
+	if runtime.GOARCH == "arm64" {
+		unsupported.SIMD = SIMDAVX2
+	}
+
+	for _, tc := range []struct {
+		name    string
+		codelet Codelet[complex128]
+	}{
+		{"unscaled inverse", unscaled},
+		{"identity forward", identity},
+		{"panicking forward", panics},
+		{"NaN forward", nan},
+		{"not in place", outOfPlace},
+		{"no inverse", noInverse},
+		{"no signature", noSignature},
+		{"zero size", badSize},
+		{"convolution strategy", badStrategy},
+		{"unknown SIMD level", badSIMD},
+		{"unsupported SIMD level", unsupported},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := RegisterCodelet(tc.codelet)
+			if !errors.Is(err, ErrInvalidCodelet) {
+				t.Fatalf("RegisterCodelet = %v, want ErrInvalidCodelet", err)
+			}
+		})
+	}
+
+	t.Cleanup(func() {
+		if codelets := RegisteredCodelets[complex128](n); len(codelets) != 0 {
+			t.Errorf("rejected codelets were registered: %+v", codelets)
+		}
+	})
+}
+
+// TestRegisterCodelet_OverrideBuiltin replaces and removes a built-in
+// codelet. It is not parallel: other tests plan size 12.
+//
+//nolint:paralleltest
+func TestRegisterCodelet_OverrideBuiltin(t *testing.T) {
+	const n = 12
+
+	builtins := RegisteredCodelets[complex128](n)
+	if len(builtins) == 0 {
+		t.Skip("no built-in codelet for size 12")
+	}
+
+	builtin := builtins[0]
+
+	t.Cleanup(func() {
+		UnregisterCodelet[complex128](n, builtin.Signature)
+
+		if err := RegisterCodelet(builtin); err != nil {
+			t.Errorf("restoring %q failed: %v", builtin.Signature, err)
+		}
+	})
+
+	// Same signature: replaces the built-in
+	var calls atomic.Int64
+
+	override := dftCodelet(n, builtin.Signature, &calls)
+	override.SIMD = builtin.SIMD
+
+	if err := RegisterCodelet(override); err != nil {
+		t.Fatalf("RegisterCodelet failed: %v", err)
+	}
+
+	if got := RegisteredCodelets[complex128](n); len(got) != len(builtins) {
+		t.Fatalf("%d codelets after override, want %d", len(got), len(builtins))
+	}
+
+	plan, err := NewPlanWithOptions[complex128](n, PlanOptions{})
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+
+	before := calls.Load()
+	src := randomComplex128(n, 12)
+
+	dst := make([]complex128, n)
+	if err := plan.Forward(dst, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if plan.Algorithm() != builtin.Signature || calls.Load() == before {
+		t.Errorf("plan %q did not run the override", plan.Algorithm())
+	}
+
+	// Unregistered: plans fall back to the other codelets or kernels
+	if !UnregisterCodelet[complex128](n, builtin.Signature) {
+		t.Fatal("UnregisterCodelet found no codelet")
+	}
+
+	plan, err = NewPlanWithOptions[complex128](n, PlanOptions{})
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+
+	if plan.Algorithm() == builtin.Signature {
+		t.Errorf("plan still uses %q after UnregisterCodelet", builtin.Signature)
+	}
+
+	if err := plan.Forward(dst, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "forward", dst, reference.NaiveDFT128(src), 1e-9)
+}
@@ -396,3 +396,204 @@ func TestConcurrentStress(t *testing.T) {
 	t.Logf("Stress test completed: %d goroutines × %d iterations = %d total operations",
 		numGoroutines, itersPerGoroutine, totalOps)
 }
+
+// TestConcurrentSharedMultiDimPlans shares one multi-dimensional plan
+// across goroutines and checks every result against a serial reference.
+func TestConcurrentSharedMultiDimPlans(t *testing.T) {
+	t.Parallel()
+
+	plan2D, err := NewPlan2D[complex64](12, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	square2D, err := NewPlan2D[complex64](16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan3D, err := NewPlan3D[complex64](4, 6, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	planND, err := NewPlanND[complex64]([]int{3, 4, 2, 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	complexPlans := map[string]ComplexTransformer[complex64]{
+		"Plan2D":        plan2D,
+		"Plan2D_square": square2D,
+		"Plan3D":        plan3D,
+		"PlanND":        planND,
+	}
+
+	for name, plan := range complexPlans {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			testSharedComplexTransformer(t, plan, 8, 50)
+		})
+	}
+
+	real1D, err := NewPlanReal(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	realT, err := NewPlanRealT[float32, complex64](48)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	real2D, err := NewPlanReal2D(8, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	real3D, err := NewPlanReal3D(4, 6, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	realAxes, err := NewPlanRealNDAxes[float32, complex64]([]int{4, 6, 8}, []int{0, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	realPlans := map[string]RealTransformer[float32, complex64]{
+		"PlanReal":       real1D,
+		"PlanRealT":      realT,
+		"PlanReal2D":     real2D,
+		"PlanReal3D":     real3D,
+		"PlanRealNDAxes": realAxes,
+	}
+
+	for name, plan := range realPlans {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			testSharedRealTransformer(t, plan, 8, 50)
+		})
+	}
+}
+
+func testSharedComplexTransformer(t *testing.T, plan ComplexTransformer[complex64], numGoroutines, iters int) {
+	t.Helper()
+
+	n := plan.Len()
+	inputs := make([][]complex64, numGoroutines)
+	wants := make([][]complex64, numGoroutines)
+
+	for g := range numGoroutines {
+		inputs[g] = make([]complex64, n)
+		for i := range inputs[g] {
+			inputs[g][i] = complex(float32(g)+rand.Float32(), rand.Float32())
+		}
+
+		wants[g] = make([]complex64, n)
+		if err := plan.Forward(wants[g], inputs[g]); err != nil {
+			t.Fatalf("reference Forward: %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	errors := make(chan error, numGoroutines)
+
+	for g := range numGoroutines {
+		wg.Add(1)
+
+		go func(goroutineID int) {
+			defer wg.Done()
+
+			dst := make([]complex64, n)
+			back := make([]complex64, n)
+
+			for iter := range iters {
+				if err := plan.Forward(dst, inputs[goroutineID]); err != nil {
+					errors <- fmt.Errorf("goroutine %d iteration %d Forward: %w", goroutineID, iter, err)
+					return
+				}
+
+				for i, want := range wants[goroutineID] {
+					if dst[i] != want {
+						errors <- fmt.Errorf("goroutine %d iteration %d: bin %d = %v, want %v", goroutineID, iter, i, dst[i], want)
+						return
+					}
+				}
+
+				if err := plan.Inverse(back, dst); err != nil {
+					errors <- fmt.Errorf("goroutine %d iteration %d Inverse: %w", goroutineID, iter, err)
+					return
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+	close(errors)
+
+	for err := range errors {
+		t.Error(err)
+	}
+}
+
+func testSharedRealTransformer(t *testing.T, plan RealTransformer[float32, complex64], numGoroutines, iters int) {
+	t.Helper()
+
+	inputs := make([][]float32, numGoroutines)
+	wants := make([][]complex64, numGoroutines)
+
+	for g := range numGoroutines {
+		inputs[g] = make([]float32, plan.Len())
+		for i := range inputs[g] {
+			inputs[g][i] = float32(g) + rand.Float32()
+		}
+
+		wants[g] = make([]complex64, plan.SpectrumLen())
+		if err := plan.Forward(wants[g], inputs[g]); err != nil {
+			t.Fatalf("reference Forward: %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	errors := make(chan error, numGoroutines)
+
+	for g := range numGoroutines {
+		wg.Add(1)
+
+		go func(goroutineID int) {
+			defer wg.Done()
+
+			dst := make([]complex64, plan.SpectrumLen())
+			back := make([]float32, plan.Len())
+
+			for iter := range iters {
+				if err := plan.Forward(dst, inputs[goroutineID]); err != nil {
+					errors <- fmt.Errorf("goroutine %d iteration %d Forward: %w", goroutineID, iter, err)
+					return
+				}
+
+				for i, want := range wants[goroutineID] {
+					if dst[i] != want {
+						errors <- fmt.Errorf("goroutine %d iteration %d: bin %d = %v, want %v", goroutineID, iter, i, dst[i], want)
+						return
+					}
+				}
+
+				if err := plan.Inverse(back, dst); err != nil {
+					errors <- fmt.Errorf("goroutine %d iteration %d Inverse: %w", goroutineID, iter, err)
+					return
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+	close(errors)
+
+	for err := range errors {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,411 @@
+package algofft
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+)
+
+// deterministicSizes covers power-of-two, mixed-radix and Bluestein sizes.
+var deterministicSizes = []int{1, 2, 8, 12, 60, 1024, 2310, 4096, 17, 1009}
+
+// hashComplex returns an FNV-1a hash of the bit patterns of s.
+func hashComplex[T Complex](s []T) uint64 {
+	h := fnv.New64a()
+
+	var buf [8]byte
+
+	write := func(bits uint64, size int) {
+		for i := range size {
+			buf[i] = byte(bits >> (8 * i))
+		}
+
+		_, _ = h.Write(buf[:size])
+	}
+
+	switch v := any(s).(type) {
+	case []complex64:
+		for _, c := range v {
+			write(uint64(math.Float32bits(real(c))), 4)
+			write(uint64(math.Float32bits(imag(c))), 4)
+		}
+	case []complex128:
+		for _, c := range v {
+			write(math.Float64bits(real(c)), 8)
+			write(math.Float64bits(imag(c)), 8)
+		}
+	}
+
+	return h.Sum64()
+}
+
+// hashFloat returns an FNV-1a hash of the bit patterns of s.
+func hashFloat[F Float](s []F) uint64 {
+	out := make([]complex128, len(s))
+	for i, v := range s {
+		out[i] = complex(float64(v), 0)
+	}
+
+	return hashComplex(out)
+}
+
+// deterministicDigest runs forward and inverse transforms of every
+// deterministic plan kind and returns one hash per transform. Plans are
+// created under the currently forced CPU features.
+func deterministicDigest(t *testing.T, opts PlanOptions) map[string]uint64 {
+	t.Helper()
+
+	opts.Deterministic = true
+	digest := make(map[string]uint64)
+
+	for _, n := range deterministicSizes {
+		src := randomComplex128(n, uint64(n))
+
+		plan64, err := NewPlanWithOptions[complex128](n, opts)
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions[complex128](%d): %v", n, err)
+		}
+
+		digestComplex(t, digest, fmt.Sprintf("complex128/%d", n), plan64, src)
+
+		plan32, err := NewPlanWithOptions[complex64](n, opts)
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions[complex64](%d): %v", n, err)
+		}
+
+		digestComplex(t, digest, fmt.Sprintf("complex64/%d", n), plan32, toComplex64(src))
+
+		split64, err := NewPlanSplitWithOptions[float64, complex128](n, opts)
+		if err != nil {
+			t.Fatalf("NewPlanSplitWithOptions[float64](%d): %v", n, err)
+		}
+
+		digestSplit(t, digest, fmt.Sprintf("split64/%d", n), split64, src)
+
+		split32, err := NewPlanSplitWithOptions[float32, complex64](n, opts)
+		if err != nil {
+			t.Fatalf("NewPlanSplitWithOptions[float32](%d): %v", n, err)
+		}
+
+		digestSplit(t, digest, fmt.Sprintf("split32/%d", n), split32, toComplex64(src))
+
+		if n%2 != 0 {
+			continue
+		}
+
+		real64, err := NewPlanReal64WithOptions(n, opts)
+		if err != nil {
+			t.Fatalf("NewPlanReal64WithOptions(%d): %v", n, err)
+		}
+
+		spec := make([]complex128, real64.SpectrumLen())
+		back := make([]float64, n)
+
+		err = real64.Forward(spec, randomFloat64(n, uint64(n)))
+		if err == nil {
+			err = real64.Inverse(back, spec)
+		}
+
+		if err != nil {
+			t.Fatalf("PlanReal64(%d): %v", n, err)
+		}
+
+		digest[fmt.Sprintf("real64/%d/forward", n)] = hashComplex(spec)
+		digest[fmt.Sprintf("real64/%d/inverse", n)] = hashFloat(back)
+
+		real32, err := NewPlanRealWithOptions(n, opts)
+		if err != nil {
+			t.Fatalf("NewPlanRealWithOptions(%d): %v", n, err)
+		}
+
+		spec32 := make([]complex64, real32.SpectrumLen())
+		back32 := make([]float32, n)
+
+		err = real32.Forward(spec32, toFloat32(randomFloat64(n, uint64(n))))
+		if err == nil {
+			err = real32.Inverse(back32, spec32)
+		}
+
+		if err != nil {
+			t.Fatalf("PlanReal(%d): %v", n, err)
+		}
+
+		digest[fmt.Sprintf("real32/%d/forward", n)] = hashComplex(spec32)
+		digest[fmt.Sprintf("real32/%d/inverse", n)] = hashFloat(back32)
+	}
+
+	const rows, cols = 12, 34
+
+	plan2D, err := NewPlan2DWithOptions[complex128](rows, cols, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digestComplex(t, digest, "plan2d", plan2D, randomComplex128(rows*cols, 7))
+
+	return digest
+}
+
+// digestComplex records the hashes of the forward and inverse transforms of src.
+func digestComplex[T Complex](t *testing.T, digest map[string]uint64, label string, plan interface {
+	Forward(dst, src []T) error
+	Inverse(dst, src []T) error
+}, src []T,
+) {
+	t.Helper()
+
+	dst := make([]T, len(src))
+
+	err := plan.Forward(dst, src)
+	if err != nil {
+		t.Fatalf("%s: Forward: %v", label, err)
+	}
+
+	digest[label+"/forward"] = hashComplex(dst)
+
+	err = plan.Inverse(dst, src)
+	if err != nil {
+		t.Fatalf("%s: Inverse: %v", label, err)
+	}
+
+	digest[label+"/inverse"] = hashComplex(dst)
+}
+
+// digestSplit records the hashes of the forward and inverse transforms of
+// src with a split-complex plan.
+func digestSplit[F Float, C Complex](t *testing.T, digest map[string]uint64, label string, plan *PlanSplit[F, C], src []C) {
+	t.Helper()
+
+	srcRe, srcIm := splitFromComplex[F](src)
+	re, im := make([]F, len(src)), make([]F, len(src))
+
+	err := plan.ForwardSplit(re, im, srcRe, srcIm)
+	if err != nil {
+		t.Fatalf("%s: ForwardSplit: %v", label, err)
+	}
+
+	digest[label+"/forward"] = hashFloat(append(re, im...))
+
+	err = plan.InverseSplit(re, im, srcRe, srcIm)
+	if err != nil {
+		t.Fatalf("%s: InverseSplit: %v", label, err)
+	}
+
+	digest[label+"/inverse"] = hashFloat(append(re, im...))
+}
+
+// TestDeterministicAcrossFeatures checks that deterministic plans give the
+// same bits whatever kernels the CPU features would select. It toggles the
+// global feature override, so it does not run in parallel.
+func TestDeterministicAcrossFeatures(t *testing.T) {
+	defer cpu.ResetDetection()
+
+	type featureSet struct {
+		name     string
+		features *cpu.Features
+	}
+
+	featureSets := []featureSet{
+		{"detected", nil},
+		{"generic", &cpu.Features{ForceGeneric: true, Architecture: runtime.GOARCH}},
+	}
+
+	switch runtime.GOARCH {
+	case "amd64":
+		featureSets = append(featureSets,
+			featureSet{"sse2", &cpu.Features{HasSSE: true, HasSSE2: true, Architecture: "amd64"}},
+			featureSet{"avx2", &cpu.Features{
+				HasSSE: true, HasSSE2: true, HasSSE3: true, HasSSSE3: true, HasSSE41: true,
+				HasAVX: true, HasAVX2: true, Architecture: "amd64",
+			}},
+		)
+	case "arm64":
+		featureSets = append(featureSets, featureSet{"neon", &cpu.Features{HasNEON: true, Architecture: "arm64"}})
+	}
+
+	var (
+		want     map[string]uint64
+		wantName string
+	)
+
+	for _, fs := range featureSets {
+		cpu.ResetDetection()
+
+		if fs.features != nil {
+			cpu.SetForcedFeatures(*fs.features)
+		}
+
+		got := deterministicDigest(t, PlanOptions{})
+		if want == nil {
+			want, wantName = got, fs.name
+			continue
+		}
+
+		for key, h := range want {
+			if got[key] != h {
+				t.Errorf("%s: %s differs from %s", key, fs.name, wantName)
+			}
+		}
+	}
+}
+
+// TestDeterministicIgnoresPlanner checks that planner settings that pick
+// other kernels for regular plans do not change deterministic results.
+func TestDeterministicIgnoresPlanner(t *testing.T) {
+	t.Parallel()
+
+	want := deterministicDigest(t, PlanOptions{})
+
+	for _, opts := range []PlanOptions{
+		{Planner: PlannerMeasure},
+		{Strategy: KernelStockham},
+		{Accuracy: AccuracyFast},
+	} {
+		got := deterministicDigest(t, opts)
+
+		for key, h := range want {
+			if got[key] != h {
+				t.Errorf("%s: differs with options %+v", key, opts)
+			}
+		}
+	}
+}
+
+// TestDeterministicGolden pins the results of a few deterministic plans, so
+// that a build on another architecture or compiler that changes a single bit
+// fails here.
+func TestDeterministicGolden(t *testing.T) {
+	t.Parallel()
+
+	digest := deterministicDigest(t, PlanOptions{})
+
+	golden := map[string]uint64{
+		"complex128/1024/forward": 0x3515422216faa7a8,
+		"complex128/1009/forward": 0x51ebb183521473c5,
+		"complex128/2310/inverse": 0x3d41a69f4fc592d6,
+		"complex64/4096/forward":  0xd3ba162c40b8387c,
+		"complex64/17/inverse":    0x163feacda90c07b5,
+		"real64/60/forward":       0x4eb888c8d8acfba3,
+		"real32/1024/inverse":     0x209afffa03db8a24,
+		"plan2d/forward":          0xe6ff55be3a87e420,
+		"split64/1024/forward":    0x1d45340dc5fcdcd3,
+		"split32/4096/inverse":    0x72228655024c5906,
+	}
+
+	for key, want := range golden {
+		if got := digest[key]; got != want {
+			t.Errorf("%s: hash %#x, want %#x", key, got, want)
+		}
+	}
+}
+
+func TestDeterministicAccuracy(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range deterministicSizes {
+		src := randomComplex128(n, uint64(n)+1)
+
+		plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Deterministic: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ref, err := NewPlan64(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := make([]complex128, n)
+		want := make([]complex128, n)
+
+		err = plan.Forward(got, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = ref.Forward(want, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertComplexSlicesClose(t, fmt.Sprintf("n=%d Forward", n), got, want, 1e-9)
+
+		// In place, inverse and round trip.
+		err = plan.Inverse(got, got)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertComplexSlicesClose(t, fmt.Sprintf("n=%d round trip", n), got, src, 1e-12)
+
+		plan32, err := NewPlanWithOptions[complex64](n, PlanOptions{Deterministic: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got32 := make([]complex64, n)
+
+		err = plan32.Forward(got32, toComplex64(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertComplexSlicesClose(t, fmt.Sprintf("n=%d complex64 Forward", n), got32, toComplex64(want), 1e-3)
+	}
+}
+
+func TestDeterministicMeta(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n         int
+		opts      PlanOptions
+		algorithm string
+		radices   []int
+	}{
+		{64, PlanOptions{}, "deterministic_mixedradix_4x4x4", []int{4, 4, 4}},
+		{120, PlanOptions{}, "deterministic_mixedradix_4x2x3x5", []int{4, 2, 3, 5}},
+		{120, PlanOptions{Radices: []int{8, 5, 3}}, "deterministic_mixedradix_8x5x3", []int{8, 5, 3}},
+		{34, PlanOptions{}, "deterministic_bluestein", nil},
+	}
+
+	for _, tt := range tests {
+		tt.opts.Deterministic = true
+
+		plan, err := NewPlanWithOptions[complex64](tt.n, tt.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		meta := plan.Meta()
+		if !meta.Deterministic || meta.Accuracy != AccuracyAccurate {
+			t.Errorf("n=%d: Meta = %+v, want Deterministic with AccuracyAccurate", tt.n, meta)
+		}
+
+		if plan.Algorithm() != tt.algorithm {
+			t.Errorf("n=%d: Algorithm = %q, want %q", tt.n, plan.Algorithm(), tt.algorithm)
+		}
+
+		if fmt.Sprint(meta.Radices) != fmt.Sprint(tt.radices) {
+			t.Errorf("n=%d: Radices = %v, want %v", tt.n, meta.Radices, tt.radices)
+		}
+
+		if !strings.HasPrefix(plan.Clone().Algorithm(), "deterministic_") {
+			t.Errorf("n=%d: clone lost the deterministic kernel", tt.n)
+		}
+	}
+
+	pooled, err := NewPlanFromPoolWithOptions[complex128](256, nil, PlanOptions{Deterministic: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !pooled.Meta().Deterministic {
+		t.Error("NewPlanFromPoolWithOptions ignored Deterministic")
+	}
+}
@@ -103,6 +103,33 @@
 // conjugate symmetry of real signals: X[k] = conj(X[N-k]) for k = 1..N/2-1.
 // Index 0 is DC, index N/2 is Nyquist (purely real for even N).
 //
+// ForwardFormat and InverseFormat of the 1D, 2D and 3D real plans read and
+// write other layouts of the same spectrum in a real-valued slice: the
+// IPP/MKL formats SpectrumCCS, SpectrumPack and SpectrumPerm (the last two
+// fit in N reals), and SpectrumFull with all N bins. FormatLen sizes the
+// buffer:
+//
+//	packed := make([]float32, planReal.FormatLen(algofft.SpectrumPack))
+//	err = planReal.ForwardFormat(packed, input, algofft.SpectrumPack)
+//
+// ForwardInPlace and InverseInPlace transform FFTW-style padded buffers, in
+// which every row of N samples is padded to 2*(N/2+1) reals and the
+// spectrum overwrites the samples. ComplexView and RealView reinterpret the
+// one buffer without copying:
+//
+//	data := make([]float32, plan3D.PaddedLen())
+//	// ... fill row (d, h) at data[(d*H+h)*2*(W/2+1):][:W]
+//	err = plan3D.ForwardInPlace(data)
+//	spectrum := algofft.ComplexView[complex64](data) // D×H×(W/2+1) bins
+//
+// Inverses expect a Hermitian spectrum. PlanOptions.Symmetry selects what
+// happens to bins that deviate from the conjugates of their partners:
+// SymmetryStrict returns a *SymmetryError naming the first offending bin,
+// SymmetryProject averages the bins onto the nearest Hermitian spectrum like
+// numpy's irfft, and SymmetryIgnore reads only the bins the inverse needs.
+// The default, SymmetryAuto, checks only the DC and Nyquist bins of 1D
+// half-spectra, as inverses did before the policies existed.
+//
 // Precision note: real FFT round-trips use float32 arithmetic. Expect small
 // absolute errors (around 1e-3 in typical tests) depending on size and input.
 //
@@ -177,6 +204,12 @@
 //		log.Fatal(err)
 //	}
 //
+// To transform only some axes (numpy's fftn/rfftn with axes=...), use
+// NewPlanNDAxes or NewPlanRealNDAxes; the other axes are batched:
+//
+//	// Only the last two axes of a 4D batch
+//	planAxes, err := algofft.NewPlanNDAxes[complex64](dims, []int{2, 3})
+//
 // # Batch Processing
 //
 // Process multiple signals of the same length efficiently:
@@ -197,19 +230,96 @@
 //
 // # Strided Data
 //
-// Transform non-contiguous data (e.g., matrix columns):
-//
-//	plan, _ := algofft.NewPlan(128)
+// Transform non-contiguous data (e.g., matrix columns) with a guru plan.
+// Input and output strides are independent, and howmany dimensions repeat
+// the transform across the layout:
 //
 //	// 128x256 matrix in row-major order
 //	matrix := make([]complex64, 128*256)
 //
-//	// Transform column 0 (stride = 256, the number of columns)
-//	colOutput := make([]complex64, 128)
-//	if err := plan.ForwardStrided(colOutput, matrix, 256); err != nil {
+//	// FFT of all 256 columns in place: length 128 with stride 256,
+//	// repeated 256 times at distance 1.
+//	plan, _ := algofft.NewPlanGuru[complex64](
+//		[]algofft.IODim{{N: 128, InStride: 256, OutStride: 256}},
+//		[]algofft.IODim{{N: 256, InStride: 1, OutStride: 1}},
+//	)
+//	if err := plan.Forward(matrix, matrix); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// NewPlanMany is the shorthand for FFTW-style plan_many layouts (element
+// stride plus distance between transforms).
+//
+// # Split-Complex Data
+//
+// Planar data (separate real and imaginary arrays) is transformed without
+// interleaving. Power-of-two lengths use a kernel that works on the planar
+// layout directly; PlanSplitND covers 2D and higher:
+//
+//	plan, _ := algofft.NewPlanSplit32(4096)
+//	err := plan.ForwardSplit(dstRe, dstIm, srcRe, srcIm)
+//
+//	img, _ := algofft.NewPlanSplitND[float32, complex64]([]int{512, 512})
+//	err = img.InverseSplit(re, im, re, im) // in place
+//
+// # Normalization
+//
+// By default Forward is unscaled and Inverse scales by 1/N (numpy "backward").
+// PlanOptions.Normalization selects NormOrtho (1/√N both ways), NormForward
+// (1/N on Forward) or NormNone (no scaling at all). Every plan type honours
+// it, and the factor is folded into an existing pass rather than a separate
+// loop over the output:
+//
+//	plan, _ := algofft.NewPlanWithOptions[complex64](1024, algofft.PlanOptions{
+//		Normalization: algofft.NormOrtho,
+//	})
+//
+// # Pruned FFT
+//
+// When most inputs are zero or only a few bins are needed, a pruned plan
+// skips the butterflies that cannot contribute to the kept outputs:
+//
+//	// 256 non-zero samples zero-padded to 65536, bins 0..99 only
+//	plan, _ := algofft.NewPlanPruned[complex64](65536, 256, algofft.BinRange{Start: 0, Count: 100})
+//
+//	src := make([]complex64, 256)
+//	bins := make([]complex64, 100)
+//	if err := plan.Forward(bins, src); err != nil {
 //		log.Fatal(err)
 //	}
 //
+// # Sparse FFT
+//
+// For spectra dominated by a few tones, a sparse plan recovers the K largest
+// coefficients in sublinear time by hashing frequencies into buckets:
+//
+//	plan, _ := algofft.NewPlanSparse64(1<<24, algofft.SparseFFTParams{K: 50})
+//	fmt.Println(plan.SuccessProbability(), plan.Params().Buckets)
+//
+//	top := make([]algofft.SparseCoefficient[complex128], 50)
+//	found, err := plan.Forward(top, signal) // top[:found] sorted by magnitude
+//
+// # Resampling
+//
+// Fourier resampling trims or zero-pads the spectrum (scipy.signal.resample
+// semantics, including the Nyquist bin) for any input and output lengths:
+//
+//	out := make([]float64, 441)
+//	err := algofft.Resample64(out, in) // len(in) == 480
+//
+//	// Reusable plans: Resampler (complex), ResamplerReal, ResamplerND, ResamplerRealND
+//	r, _ := algofft.NewResamplerRealND[float32, complex64]([]int{480, 640}, []int{240, 320}, algofft.ResampleOptions{})
+//	err = r.Resample(small, image)
+//
+// # Frequency Axes and Spectra
+//
+// FFTFreq, RFFTFreq, FFTShift/IFFTShift and FFTShiftND/IFFTShiftND follow
+// numpy.fft. Spectrum wraps transform output with its sample rate:
+//
+//	spec, _ := algofft.NewRealSpectrum(bins, 4096, 48000) // PlanRealT output
+//	k := spec.Bin(1000)                                   // nearest bin to 1 kHz
+//	fmt.Println(spec.Frequency(k), spec.Magnitude()[k], spec.PowerDB()[k])
+//
 // # Convolution via FFT
 //
 // Efficient O(N log N) convolution for filtering and correlation:
@@ -295,21 +405,84 @@
 // The library supports several transform types:
 //   - Complex FFT: forward and inverse transforms of complex-valued signals
 //   - Real FFT: optimized transforms for real-valued input signals (PlanReal)
-//   - Multi-dimensional: 2D, 3D, and arbitrary N-dimensional FFTs
+//   - Multi-dimensional: 2D, 3D, and arbitrary N-dimensional FFTs, optionally over selected axes
 //   - Batch: efficient processing of multiple transforms with same Plan
-//   - Strided: transform non-contiguous data without copying
+//   - Strided: guru layouts with independent input/output strides (PlanGuru)
+//   - Split-complex: planar real/imaginary arrays (PlanSplit, PlanSplitND)
+//   - Pruned: sparse-input and subset-of-outputs transforms (PlanPruned)
+//   - Sparse: top-K coefficients of sparse spectra in sublinear time (PlanSparse)
+//   - Resampling: Fourier interpolation to arbitrary lengths (Resample, Resampler)
 //
 // # Size Support
 //
 // Plans support:
-//   - Power-of-2 sizes: optimized Radix-2 and Radix-4 algorithms
-//   - Composite sizes: mixed-radix Radix-2/3/4/5 algorithms
+//   - Power-of-2 sizes: optimized Radix-2 and Radix-4 algorithms, or split-radix (KernelSplitRadix)
+//   - Composite sizes: mixed-radix Radix-2/3/4/5/7/11/13 algorithms
+//   - Prime sizes p with a composite p-1: Rader's algorithm (KernelRader)
+//   - Sizes with coprime factors: Good-Thomas prime-factor algorithm (KernelPFA)
 //   - Arbitrary sizes: Bluestein's algorithm (Chirp-Z transform)
 //
+// Bluestein's algorithm runs a convolution of some length M >= 2n-1 on a
+// sub-plan. Besides the next power of two, M can be a 5-smooth length on
+// the mixed-radix kernel, e.g. 2304 instead of 4096 for n = 1025. The
+// estimate planner picks M with a cost model; the measuring planners
+// benchmark the cheapest candidates and record the winner as wisdom, e.g.
+// "bluestein_2304".
+//
+// Rader's algorithm runs a cyclic convolution of length p-1 on a mixed-radix
+// or codelet sub-plan instead of Bluestein's three FFTs of length >= 2p-1.
+// The measuring planners benchmark both for every prime and record the
+// winner as wisdom; PlanOptions.Strategy can force either one.
+//
+// The prime-factor algorithm splits n = n1·n2 with coprime n1 and n2 (the
+// largest prime power and the rest) into n2 transforms of size n1 and n1 of
+// size n2, reordering the data with CRT index maps instead of multiplying
+// twiddle factors. Factors of 2, 3, 4, 5, 7, 11 and 13 run their radix
+// butterfly directly on each line; the others run on a sub-plan: a codelet,
+// the mixed-radix kernel, Rader or a nested PFA. The estimate planner uses
+// it for sizes such as 51, 969 or 17·64 that have no mixed-radix schedule,
+// and for mixed-radix sizes such as 15, 7·64 or 1001 = 13·7·11 whose
+// factors are butterflies and power-of-two codelets; the measuring planners
+// also try it for other mixed-radix sizes like 63 or 360.
+//
+// PlanOptions.Radices pins the mixed-radix factorization, e.g. for
+// reproducibility or hardware-specific tuning:
+//
+//	plan, err := algofft.NewPlanWithOptions[complex64](120,
+//		algofft.PlanOptions{Radices: []int{8, 5, 3}})
+//	// plan.Algorithm() == "mixedradix_8x5x3"
+//
+// The measuring planners benchmark alternative schedules for composite
+// sizes, and PlanMeta.Radices reports the schedule a plan runs.
+//
+// # Custom codelets
+//
+// RegisterCodelet adds a hand-written transform of one size to the codelet
+// registry of its precision:
+//
+//	err := algofft.RegisterCodelet(algofft.Codelet[complex64]{
+//		Size:      96,
+//		Forward:   myForward96,
+//		Inverse:   myInverse96,
+//		Signature: "dit96_mine",
+//		Priority:  10,
+//	})
+//
+// Plans created afterwards bind the best codelet the CPU supports, ahead of
+// the generic kernels and of Rader's and Bluestein's algorithms; the
+// measuring planners benchmark it against the strategies for power-of-two
+// sizes and against PFA otherwise. Registration first compares the codelet
+// with a direct DFT and returns ErrInvalidCodelet if it is wrong or needs
+// SIMD features the CPU lacks. A codelet
+// registered with the signature of a built-in one replaces it, and
+// UnregisterCodelet removes codelets by size and signature.
+//
 // # Performance
 //
 // The library achieves high performance through:
 //   - Zero-dispatch codelets for common sizes (8, 16, 32, 64, 128)
+//   - Straight-line codelets for 12, 20, 24, 48, 60 and 80, generated by
+//     cmd/gencodelets (go generate ./internal/kernels)
 //   - Zero-allocation transforms with pre-allocated Plans
 //   - SIMD optimization (AVX2 on amd64, NEON on ARM64)
 //   - CPU feature detection and runtime dispatch
@@ -327,6 +500,47 @@
 //
 // Plans are safe for concurrent use (read-only during transforms).
 //
+// # Buffer Pools
+//
+// Programs that build and discard many short-lived plans can recycle plan
+// buffers through a BufferPool. Every plan type has FromPool constructors
+// (NewPlanFromPool, NewPlan2DFromPool, NewPlanRealFromPool, ...); Close hands
+// the buffers back for the next plan of the same sizes:
+//
+//	pool := algofft.NewBufferPool(256 << 20) // keep at most 256 MiB idle
+//
+//	plan, err := algofft.NewPlan2DFromPool[complex64](512, 512, pool)
+//	if err != nil {
+//		return err
+//	}
+//	defer plan.Close()
+//
+// pool.Stats() reports per-size-class hits and misses, and pool.Trim
+// releases idle buffers, e.g. after a burst of requests.
+//
+// # Caller-Owned Scratch
+//
+// With PlanOptions.Workspace set to WorkspaceExternal a plan keeps no
+// per-call scratch of its own. The caller sizes a buffer with ScratchLen and
+// passes it to ForwardWithScratch / InverseWithScratch; those calls never
+// allocate and never touch a sync.Pool. One scratch buffer per goroutine
+// makes a single plan safe to share:
+//
+//	plan, err := algofft.NewPlanWithOptions[complex64](4096,
+//		algofft.PlanOptions{Workspace: algofft.WorkspaceExternal})
+//	if err != nil {
+//		return err
+//	}
+//
+//	scratch := make([]complex64, plan.ScratchLen())
+//	err = plan.ForwardWithScratch(dst, src, scratch)
+//
+// Forward and Inverse on such a plan return ErrScratchRequired. Real plans
+// also offer ForwardFormatWithScratch and ForwardInPlaceWithScratch (and
+// their inverses). Split plans have ForwardWithScratch and
+// InverseWithScratch, pruned plans ForwardWithScratch, and resamplers
+// ResampleWithScratch, each sized by the type's own ScratchLen.
+//
 // # Precision
 //
 // Two precision levels are available:
@@ -338,11 +552,43 @@
 // Numerical accuracy is maintained through careful algorithm implementation.
 // See the documentation for precision characteristics of different transform types.
 //
+// PlanOptions.Accuracy selects how twiddle factors are generated:
+// AccuracyFast (phasor recursion), AccuracyDefault (direct sin/cos) or
+// AccuracyAccurate (exact octant reduction, which also keeps large
+// Bluestein chirps accurate). EstimatedErrorBound reports the expected
+// relative error of a plan, so a target tolerance can pick the precision:
+//
+//	plan32, _ := algofft.NewPlan32(n)
+//	if plan32.EstimatedErrorBound() > tolerance {
+//		plan64, _ := algofft.NewPlanWithOptions[complex128](n,
+//			algofft.PlanOptions{Accuracy: algofft.AccuracyAccurate})
+//		// ...
+//	}
+//
+// PlanOptions.Deterministic makes a plan return bit-identical results for
+// a given size, precision and options on every amd64, arm64 and generic
+// build. Such plans run a fixed pure Go schedule without SIMD or FMA
+// contraction and are about half as fast:
+//
+//	plan, _ := algofft.NewPlanWithOptions[complex128](n,
+//		algofft.PlanOptions{Deterministic: true})
+//
 // # Thread Safety
 //
 // Plan objects are safe for concurrent use during transform operations.
 // Multiple goroutines can safely call transform methods with different input/output buffers.
 //
+// Plan types share the ComplexTransformer and RealTransformer interfaces,
+// so pipeline code can accept any plan shape. NewExecutor (or
+// CloneTransformer) gives each goroutine its own workspace:
+//
+//	func worker(tr algofft.ComplexTransformer[complex64], jobs <-chan []complex64) {
+//		tr = tr.CloneTransformer()
+//		for buf := range jobs {
+//			_ = tr.Forward(buf, buf)
+//		}
+//	}
+//
 // # Error Handling
 //
 // All transform methods return an error if inputs are invalid:
@@ -351,6 +597,10 @@
 //   - ErrLengthMismatch: slice sizes don't match Plan dimensions
 //   - ErrInvalidStride: stride parameter is invalid for the data layout
 //   - ErrInvalidSpectrum: real FFT spectrum violates expected symmetry constraints
+//     (returned as a *SymmetryError carrying the offending bin)
+//   - ErrScratchRequired: a WorkspaceExternal plan was called without scratch
+//   - ErrInvalidRadices: PlanOptions.Radices is not a schedule for the size
+//   - ErrInvalidFormat: a SpectrumFormat value is not a defined format
 //
 // # Examples
 //
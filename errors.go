@@ -5,8 +5,10 @@ import "errors"
 // Sentinel errors returned by FFT operations.
 var (
 	// ErrInvalidLength is returned when the FFT size is not valid.
-	// Supported sizes include powers of two and lengths factored by 2, 3, or 5.
-	// Mixed-radix and Bluestein algorithms extend supported sizes further.
+	// Supported sizes include powers of two and lengths factored by 2, 3, 5,
+	// 7, 11 or 13, which run on the mixed-radix kernel. The prime-factor,
+	// Rader and Bluestein algorithms extend supported sizes further; pooled
+	// plans only accept the mixed-radix sizes.
 	ErrInvalidLength = errors.New("algo-fft: invalid FFT length")
 
 	// ErrNilSlice is returned when a nil slice is passed to a transform method.
@@ -24,6 +26,22 @@ var (
 	// expected symmetry constraints (e.g., non-real DC or Nyquist bins).
 	ErrInvalidSpectrum = errors.New("algo-fft: invalid spectrum")
 
+	// ErrScratchRequired is returned by Forward and Inverse of plans created
+	// with WorkspaceExternal, which only run with caller-supplied scratch.
+	ErrScratchRequired = errors.New("algo-fft: plan requires caller-supplied scratch")
+
+	// ErrInvalidRadices is returned when PlanOptions.Radices is not a valid
+	// mixed-radix schedule for the transform size.
+	ErrInvalidRadices = errors.New("algo-fft: invalid radix schedule")
+
+	// ErrInvalidFormat is returned when a SpectrumFormat value is not one of
+	// the defined formats.
+	ErrInvalidFormat = errors.New("algo-fft: invalid spectrum format")
+
+	// ErrInvalidCodelet is returned by RegisterCodelet when a codelet is
+	// incomplete or does not match the reference DFT.
+	ErrInvalidCodelet = errors.New("algo-fft: invalid codelet")
+
 	// ErrNotImplemented is returned for features that are not yet implemented.
 	// This is a temporary error used during development.
 	ErrNotImplemented = errors.New("algo-fft: not implemented")
@@ -3,8 +3,11 @@ package algofft
 // Executor runs transforms with its own workspace.
 // Executors are safe for concurrent use as long as each goroutine
 // uses a distinct Executor.
+//
+// Executors are created from any complex plan type (Plan, Plan2D, Plan3D,
+// PlanND, PlanNDAxes) and implement ComplexTransformer themselves.
 type Executor[T Complex] struct {
-	plan *Plan[T]
+	plan ComplexTransformer[T]
 }
 
 // NewExecutor creates an executor with its own workspace.
@@ -12,6 +15,31 @@ func (p *Plan[T]) NewExecutor() *Executor[T] {
 	return &Executor[T]{plan: p.Clone()}
 }
 
+// NewExecutor creates an executor with its own workspace.
+func (p *Plan2D[T]) NewExecutor() *Executor[T] {
+	return &Executor[T]{plan: p.Clone()}
+}
+
+// NewExecutor creates an executor with its own workspace.
+func (p *Plan3D[T]) NewExecutor() *Executor[T] {
+	return &Executor[T]{plan: p.Clone()}
+}
+
+// NewExecutor creates an executor with its own workspace.
+func (p *PlanND[T]) NewExecutor() *Executor[T] {
+	return &Executor[T]{plan: p.Clone()}
+}
+
+// NewExecutor creates an executor with its own workspace.
+func (p *PlanNDAxes[T]) NewExecutor() *Executor[T] {
+	return &Executor[T]{plan: p.Clone()}
+}
+
+// Len returns the transform length of the underlying plan.
+func (e *Executor[T]) Len() int {
+	return e.plan.Len()
+}
+
 // Forward computes the forward transform using the executor's workspace.
 func (e *Executor[T]) Forward(dst, src []T) error {
 	return e.plan.Forward(dst, src)
@@ -32,12 +60,93 @@ func (e *Executor[T]) InverseInPlace(data []T) error {
 	return e.plan.Inverse(data, data)
 }
 
+// CloneTransformer implements ComplexTransformer by creating another
+// executor with its own workspace.
+func (e *Executor[T]) CloneTransformer() ComplexTransformer[T] {
+	return &Executor[T]{plan: e.plan.CloneTransformer()}
+}
+
 // Close releases any pooled resources (no-op for executors).
 func (e *Executor[T]) Close() {
 	if e == nil || e.plan == nil {
 		return
 	}
 
-	e.plan.Close()
+	if closer, ok := e.plan.(interface{ Close() }); ok {
+		closer.Close()
+	}
+
+	e.plan = nil
+}
+
+// RealExecutor runs real transforms with its own workspace.
+// RealExecutors are safe for concurrent use as long as each goroutine
+// uses a distinct RealExecutor.
+//
+// RealExecutors are created from any real plan type (PlanReal, PlanRealT,
+// PlanReal2D, PlanReal3D, PlanRealNDAxes) and implement RealTransformer
+// themselves.
+type RealExecutor[F Float, C Complex] struct {
+	plan RealTransformer[F, C]
+}
+
+// NewExecutor creates an executor with its own workspace.
+func (p *PlanReal) NewExecutor() *RealExecutor[float32, complex64] {
+	return &RealExecutor[float32, complex64]{plan: p.Clone()}
+}
+
+// NewExecutor creates an executor with its own workspace.
+func (p *PlanRealT[F, C]) NewExecutor() *RealExecutor[F, C] {
+	return &RealExecutor[F, C]{plan: p.Clone()}
+}
+
+// NewExecutor creates an executor with its own workspace.
+func (p *PlanReal2D) NewExecutor() *RealExecutor[float32, complex64] {
+	return &RealExecutor[float32, complex64]{plan: p.Clone()}
+}
+
+// NewExecutor creates an executor with its own workspace.
+func (p *PlanReal3D) NewExecutor() *RealExecutor[float32, complex64] {
+	return &RealExecutor[float32, complex64]{plan: p.Clone()}
+}
+
+// NewExecutor creates an executor with its own workspace.
+func (p *PlanRealNDAxes[F, C]) NewExecutor() *RealExecutor[F, C] {
+	return &RealExecutor[F, C]{plan: p.Clone()}
+}
+
+// Len returns the number of real samples of the underlying plan.
+func (e *RealExecutor[F, C]) Len() int {
+	return e.plan.Len()
+}
+
+// SpectrumLen returns the number of complex bins of the underlying plan.
+func (e *RealExecutor[F, C]) SpectrumLen() int {
+	return e.plan.SpectrumLen()
+}
+
+// Forward computes the real-to-complex transform using the executor's workspace.
+func (e *RealExecutor[F, C]) Forward(dst []C, src []F) error {
+	return e.plan.Forward(dst, src)
+}
+
+// Inverse computes the complex-to-real transform using the executor's workspace.
+func (e *RealExecutor[F, C]) Inverse(dst []F, src []C) error {
+	return e.plan.Inverse(dst, src)
+}
+
+// CloneTransformer implements RealTransformer by creating another
+// executor with its own workspace.
+func (e *RealExecutor[F, C]) CloneTransformer() RealTransformer[F, C] {
+	return &RealExecutor[F, C]{plan: e.plan.CloneTransformer()}
+}
+
+// Close releases the executor's workspace. The executor must not be used
+// afterwards.
+func (e *RealExecutor[F, C]) Close() {
+	if e == nil {
+		return
+	}
+
 	e.plan = nil
 }
@@ -0,0 +1,119 @@
+package algofft
+
+import "slices"
+
+// FFTFreq returns the sample frequencies of a length-n FFT with sample
+// spacing d (1/sampleRate), in FFT order (numpy.fft.fftfreq):
+//
+//	[0, 1, ..., ceil(n/2)-1, -floor(n/2), ..., -1] / (d*n)
+func FFTFreq(n int, d float64) []float64 {
+	freqs := make([]float64, max(n, 0))
+	scale := 1 / (d * float64(n))
+
+	for k := range freqs {
+		bin := k
+		if k >= (n+1)/2 {
+			bin = k - n
+		}
+
+		freqs[k] = float64(bin) * scale
+	}
+
+	return freqs
+}
+
+// RFFTFreq returns the n/2+1 non-negative sample frequencies of a length-n
+// real FFT with sample spacing d (numpy.fft.rfftfreq).
+func RFFTFreq(n int, d float64) []float64 {
+	if n < 1 {
+		return []float64{}
+	}
+
+	freqs := make([]float64, n/2+1)
+	scale := 1 / (d * float64(n))
+
+	for k := range freqs {
+		freqs[k] = float64(k) * scale
+	}
+
+	return freqs
+}
+
+// FFTShift moves the zero-frequency bin to the center of data, in place
+// (numpy.fft.fftshift). For odd lengths the element at index 0 moves to
+// index n/2, so IFFTShift is required to undo it.
+func FFTShift[T any](data []T) {
+	rotateRight(data, len(data)/2)
+}
+
+// IFFTShift is the inverse of FFTShift, in place (numpy.fft.ifftshift).
+func IFFTShift[T any](data []T) {
+	rotateRight(data, len(data)-len(data)/2)
+}
+
+// FFTShiftND applies FFTShift along every axis of a row-major array with
+// shape dims, in place.
+//
+// Returns ErrLengthMismatch if len(data) does not match dims.
+func FFTShiftND[T any](data []T, dims []int) error {
+	return shiftND(data, dims, false)
+}
+
+// IFFTShiftND is the inverse of FFTShiftND, in place.
+//
+// Returns ErrLengthMismatch if len(data) does not match dims.
+func IFFTShiftND[T any](data []T, dims []int) error {
+	return shiftND(data, dims, true)
+}
+
+// shiftND rolls each axis by half its length. Rolling axis a of a row-major
+// array by k is a rotation of each contiguous (dims[a] * inner) block by
+// k*inner elements, so every axis is handled with in-place rotations.
+func shiftND[T any](data []T, dims []int, inverse bool) error {
+	if data == nil {
+		return ErrNilSlice
+	}
+
+	if len(dims) == 0 || len(data) != shapeSize(dims) {
+		return ErrLengthMismatch
+	}
+
+	inner := len(data)
+
+	for _, dim := range dims {
+		if dim < 1 {
+			return ErrInvalidLength
+		}
+
+		inner /= dim
+
+		shift := dim / 2
+		if inverse {
+			shift = dim - shift
+		}
+
+		block := dim * inner
+		for start := 0; start < len(data); start += block {
+			rotateRight(data[start:start+block], shift*inner)
+		}
+	}
+
+	return nil
+}
+
+// rotateRight rotates data right by k positions using three reversals.
+func rotateRight[T any](data []T, k int) {
+	n := len(data)
+	if n == 0 {
+		return
+	}
+
+	k %= n
+	if k == 0 {
+		return
+	}
+
+	slices.Reverse(data)
+	slices.Reverse(data[:k])
+	slices.Reverse(data[k:])
+}
@@ -0,0 +1,135 @@
+package algofft
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestFFTFreq(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		n    int
+		d    float64
+		want []float64
+	}{
+		{8, 0.1, []float64{0, 1.25, 2.5, 3.75, -5, -3.75, -2.5, -1.25}},
+		{5, 1, []float64{0, 0.2, 0.4, -0.4, -0.2}},
+		{1, 1, []float64{0}},
+	}
+
+	for _, tc := range cases {
+		if got := FFTFreq(tc.n, tc.d); !floatsNear(got, tc.want, 1e-12) {
+			t.Errorf("FFTFreq(%d, %v) = %v, want %v", tc.n, tc.d, got, tc.want)
+		}
+	}
+
+	if got := RFFTFreq(8, 0.1); !floatsNear(got, []float64{0, 1.25, 2.5, 3.75, 5}, 1e-12) {
+		t.Errorf("RFFTFreq(8) = %v", got)
+	}
+
+	if got := RFFTFreq(5, 1); !floatsNear(got, []float64{0, 0.2, 0.4}, 1e-12) {
+		t.Errorf("RFFTFreq(5) = %v", got)
+	}
+}
+
+func TestFFTShift(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		in, want []int
+	}{
+		{[]int{0, 1, 2, 3, 4, 5}, []int{3, 4, 5, 0, 1, 2}},
+		{[]int{0, 1, 2, 3, 4}, []int{3, 4, 0, 1, 2}},
+		{[]int{7}, []int{7}},
+		{[]int{}, []int{}},
+	}
+
+	for _, tc := range cases {
+		data := slices.Clone(tc.in)
+
+		FFTShift(data)
+
+		if !slices.Equal(data, tc.want) {
+			t.Errorf("FFTShift(%v) = %v, want %v", tc.in, data, tc.want)
+		}
+
+		IFFTShift(data)
+
+		if !slices.Equal(data, tc.in) {
+			t.Errorf("IFFTShift(FFTShift(%v)) = %v", tc.in, data)
+		}
+	}
+}
+
+func TestFFTShiftND(t *testing.T) {
+	t.Parallel()
+
+	// 3x4 array: rows roll by 1, columns by 2 (numpy.fft.fftshift).
+	data := []int{
+		0, 1, 2, 3,
+		4, 5, 6, 7,
+		8, 9, 10, 11,
+	}
+	want := []int{
+		10, 11, 8, 9,
+		2, 3, 0, 1,
+		6, 7, 4, 5,
+	}
+
+	orig := slices.Clone(data)
+
+	if err := FFTShiftND(data, []int{3, 4}); err != nil {
+		t.Fatalf("FFTShiftND failed: %v", err)
+	}
+
+	if !slices.Equal(data, want) {
+		t.Fatalf("FFTShiftND = %v, want %v", data, want)
+	}
+
+	if err := IFFTShiftND(data, []int{3, 4}); err != nil {
+		t.Fatalf("IFFTShiftND failed: %v", err)
+	}
+
+	if !slices.Equal(data, orig) {
+		t.Fatalf("IFFTShiftND = %v, want %v", data, orig)
+	}
+
+	// Odd 3D shape round trip.
+	cube := make([]complex64, 3*5*7)
+	for i := range cube {
+		cube[i] = complex(float32(i), 0)
+	}
+
+	shifted := slices.Clone(cube)
+	_ = FFTShiftND(shifted, []int{3, 5, 7})
+
+	if shifted[0] != cube[(2*5+3)*7+4] {
+		t.Fatalf("3D FFTShiftND: origin got %v want %v", shifted[0], cube[(2*5+3)*7+4])
+	}
+
+	_ = IFFTShiftND(shifted, []int{3, 5, 7})
+
+	if !slices.Equal(shifted, cube) {
+		t.Fatal("3D IFFTShiftND did not restore input")
+	}
+
+	if err := FFTShiftND(data, []int{4, 4}); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("bad dims: expected ErrLengthMismatch, got %v", err)
+	}
+}
+
+func floatsNear(a, b []float64, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if d := a[i] - b[i]; d > tol || d < -tol {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,164 @@
+//go:build amd64 && asm && !purego
+
+// ===========================================================================
+// AVX2 Radix-7/11/13 Stage Loads and Stores (complex64) for AMD64
+// ===========================================================================
+// A mixed-radix stage of radix r transforms, for each column k < span,
+// w_j · input[j*span+k] over j with w_j = twiddle[j*k*step]. Four adjacent
+// columns k..k+3 are contiguous in every row j, so one YMM load fetches
+// input j of four butterflies; their twiddles sit step*j elements apart and
+// are fetched with one 64-bit gather.
+//
+// PrimeRadixLoadAVX2Complex64 writes the twiddled inputs in the packed
+// layout of the Butterfly{7,11,13}*AVX2Complex64 kernels (a[4*j+b] is input
+// j of butterfly b), and PrimeRadixStoreAVX2Complex64 scatters their
+// outputs back to the rows of dst.
+// ===========================================================================
+
+#include "textflag.h"
+
+// Sign bit of the imaginary lanes, to conjugate the twiddles.
+DATA primestage_negim<>+0x00(SB)/4, $0x00000000
+DATA primestage_negim<>+0x04(SB)/4, $0x80000000
+DATA primestage_negim<>+0x08(SB)/4, $0x00000000
+DATA primestage_negim<>+0x0C(SB)/4, $0x80000000
+DATA primestage_negim<>+0x10(SB)/4, $0x00000000
+DATA primestage_negim<>+0x14(SB)/4, $0x80000000
+DATA primestage_negim<>+0x18(SB)/4, $0x00000000
+DATA primestage_negim<>+0x1C(SB)/4, $0x80000000
+GLOBL primestage_negim<>(SB), RODATA|NOPTR, $32
+
+// ===========================================================================
+// Function: PrimeRadixLoadAVX2Complex64
+// ===========================================================================
+// a[4*j+b] = w · input[j*span+k+b] for j < radix and b < 4, where
+// w = twiddle[j*(k+b)*step], conjugated when inverse is set.
+//
+// func PrimeRadixLoadAVX2Complex64(a, input, twiddle []complex64, radix, span, k, step int, inverse bool)
+TEXT ·PrimeRadixLoadAVX2Complex64(SB), NOSPLIT, $0-105
+	MOVQ a_base+0(FP), DI
+	MOVQ input_base+24(FP), SI
+	MOVQ twiddle_base+48(FP), DX
+	MOVQ radix+72(FP), CX
+	MOVQ span+80(FP), R8
+	MOVQ k+88(FP), R9
+	MOVQ step+96(FP), R10
+
+	TESTQ CX, CX
+	JLE   primeload_return
+
+	// len(a) >= 4*radix
+	LEAQ (CX)(CX*1), AX
+	SHLQ $1, AX
+	CMPQ a_len+8(FP), AX
+	JL   primeload_return
+
+	// len(input) >= (radix-1)*span + k + 4
+	LEAQ -1(CX), R11
+	MOVQ R11, AX
+	IMULQ R8, AX
+	ADDQ R9, AX
+	ADDQ $4, AX
+	CMPQ input_len+32(FP), AX
+	JL   primeload_return
+
+	// len(twiddle) > (radix-1)*(k+3)*step
+	LEAQ 3(R9), AX
+	IMULQ R10, AX
+	IMULQ R11, AX
+	CMPQ twiddle_len+56(FP), AX
+	JLE  primeload_return
+
+	// Y14 = per-lane index increments (k+b)*step; Y15 = j*(k+b)*step, j = 0
+	MOVQ R9, AX
+	IMULQ R10, AX
+	LEAQ (AX)(R10*1), BX
+	LEAQ (BX)(R10*1), R12
+	LEAQ (R12)(R10*1), R13
+	VMOVQ AX, X0
+	VPINSRQ $1, BX, X0, X0
+	VMOVQ R12, X1
+	VPINSRQ $1, R13, X1, X1
+	VINSERTI128 $1, X1, Y0, Y14
+	VPXOR Y15, Y15, Y15
+
+	// Y12 flips the sign of the twiddles' imaginary parts for the inverse
+	VPXOR Y12, Y12, Y12
+	MOVBLZX inverse+104(FP), AX
+	TESTQ AX, AX
+	JZ    primeload_setup
+	VMOVUPS primestage_negim<>(SB), Y12
+
+primeload_setup:
+	LEAQ (SI)(R9*8), SI // &input[k]
+	SHLQ $3, R8         // row stride in bytes
+
+primeload_loop:
+	// Gather the four twiddles of row j
+	VPCMPEQQ Y11, Y11, Y11
+	VPGATHERQQ Y11, (DX)(Y15*8), Y10
+	VXORPS Y12, Y10, Y10
+
+	// a_j = w · x, with x = input[j*span+k : j*span+k+4]
+	VMOVUPS (SI), Y0
+	VMOVSLDUP Y10, Y1        // [w.r, w.r, ...]
+	VMOVSHDUP Y10, Y2        // [w.i, w.i, ...]
+	VSHUFPS $0xB1, Y0, Y0, Y3 // [x.i, x.r, ...]
+	VMULPS Y2, Y3, Y3
+	VFMADDSUB231PS Y1, Y0, Y3 // w.r*x -/+ w.i*x_swap
+	VMOVUPS Y3, (DI)
+
+	VPADDQ Y14, Y15, Y15
+	ADDQ R8, SI
+	ADDQ $32, DI
+	DECQ CX
+	JNZ  primeload_loop
+
+primeload_return:
+	VZEROUPPER
+	RET
+
+// ===========================================================================
+// Function: PrimeRadixStoreAVX2Complex64
+// ===========================================================================
+// dst[q*span+k+b] = y[4*q+b] for q < radix and b < 4.
+//
+// func PrimeRadixStoreAVX2Complex64(dst, y []complex64, radix, span, k int)
+TEXT ·PrimeRadixStoreAVX2Complex64(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ y_base+24(FP), SI
+	MOVQ radix+48(FP), CX
+	MOVQ span+56(FP), R8
+	MOVQ k+64(FP), R9
+
+	TESTQ CX, CX
+	JLE   primestore_return
+
+	// len(y) >= 4*radix
+	LEAQ (CX)(CX*1), AX
+	SHLQ $1, AX
+	CMPQ y_len+32(FP), AX
+	JL   primestore_return
+
+	// len(dst) >= (radix-1)*span + k + 4
+	LEAQ -1(CX), AX
+	IMULQ R8, AX
+	ADDQ R9, AX
+	ADDQ $4, AX
+	CMPQ dst_len+8(FP), AX
+	JL   primestore_return
+
+	LEAQ (DI)(R9*8), DI // &dst[k]
+	SHLQ $3, R8
+
+primestore_loop:
+	VMOVUPS (SI), Y0
+	VMOVUPS Y0, (DI)
+	ADDQ $32, SI
+	ADDQ R8, DI
+	DECQ CX
+	JNZ  primestore_loop
+
+primestore_return:
+	VZEROUPPER
+	RET
@@ -0,0 +1,427 @@
+//go:build amd64 && asm && !purego
+
+// ===========================================================================
+// AVX2 Radix-11 Butterfly (complex64) for AMD64
+// ===========================================================================
+// Processes 4 radix-11 butterflies in parallel using YMM registers.
+// Input j of the 4 butterflies is packed in a[4*j : 4*j+4], so each YMM
+// holds one input: [re0, im0, re1, im1, re2, im2, re3, im3].
+//
+// With s_j = a[j] + a[11-j] and d_j = a[j] - a[11-j] (j = 1..5), outputs k and
+// 11-k come from t_k = a[0] + Σ cos(2πjk/11)·s_j and u_k = Σ sin(2πjk/11)·d_j:
+// forward y_k = t_k - i·u_k, y_(11-k) = t_k + i·u_k; the inverse swaps them.
+// ===========================================================================
+
+#include "textflag.h"
+
+// cos(2πj/11) and sin(2πj/11) for j = 1..5, broadcast to all 8 lanes.
+DATA radix11_c1<>+0x00(SB)/4, $0x3F575C64  //  0.84125353
+DATA radix11_c1<>+0x04(SB)/4, $0x3F575C64
+DATA radix11_c1<>+0x08(SB)/4, $0x3F575C64
+DATA radix11_c1<>+0x0C(SB)/4, $0x3F575C64
+DATA radix11_c1<>+0x10(SB)/4, $0x3F575C64
+DATA radix11_c1<>+0x14(SB)/4, $0x3F575C64
+DATA radix11_c1<>+0x18(SB)/4, $0x3F575C64
+DATA radix11_c1<>+0x1C(SB)/4, $0x3F575C64
+GLOBL radix11_c1<>(SB), RODATA|NOPTR, $32
+
+DATA radix11_c2<>+0x00(SB)/4, $0x3ED4B147  //  0.41541501
+DATA radix11_c2<>+0x04(SB)/4, $0x3ED4B147
+DATA radix11_c2<>+0x08(SB)/4, $0x3ED4B147
+DATA radix11_c2<>+0x0C(SB)/4, $0x3ED4B147
+DATA radix11_c2<>+0x10(SB)/4, $0x3ED4B147
+DATA radix11_c2<>+0x14(SB)/4, $0x3ED4B147
+DATA radix11_c2<>+0x18(SB)/4, $0x3ED4B147
+DATA radix11_c2<>+0x1C(SB)/4, $0x3ED4B147
+GLOBL radix11_c2<>(SB), RODATA|NOPTR, $32
+
+DATA radix11_c3<>+0x00(SB)/4, $0xBE11BAFB  // -0.14231484
+DATA radix11_c3<>+0x04(SB)/4, $0xBE11BAFB
+DATA radix11_c3<>+0x08(SB)/4, $0xBE11BAFB
+DATA radix11_c3<>+0x0C(SB)/4, $0xBE11BAFB
+DATA radix11_c3<>+0x10(SB)/4, $0xBE11BAFB
+DATA radix11_c3<>+0x14(SB)/4, $0xBE11BAFB
+DATA radix11_c3<>+0x18(SB)/4, $0xBE11BAFB
+DATA radix11_c3<>+0x1C(SB)/4, $0xBE11BAFB
+GLOBL radix11_c3<>(SB), RODATA|NOPTR, $32
+
+DATA radix11_c4<>+0x00(SB)/4, $0xBF27A4F4  // -0.65486073
+DATA radix11_c4<>+0x04(SB)/4, $0xBF27A4F4
+DATA radix11_c4<>+0x08(SB)/4, $0xBF27A4F4
+DATA radix11_c4<>+0x0C(SB)/4, $0xBF27A4F4
+DATA radix11_c4<>+0x10(SB)/4, $0xBF27A4F4
+DATA radix11_c4<>+0x14(SB)/4, $0xBF27A4F4
+DATA radix11_c4<>+0x18(SB)/4, $0xBF27A4F4
+DATA radix11_c4<>+0x1C(SB)/4, $0xBF27A4F4
+GLOBL radix11_c4<>(SB), RODATA|NOPTR, $32
+
+DATA radix11_c5<>+0x00(SB)/4, $0xBF75A155  // -0.95949297
+DATA radix11_c5<>+0x04(SB)/4, $0xBF75A155
+DATA radix11_c5<>+0x08(SB)/4, $0xBF75A155
+DATA radix11_c5<>+0x0C(SB)/4, $0xBF75A155
+DATA radix11_c5<>+0x10(SB)/4, $0xBF75A155
+DATA radix11_c5<>+0x14(SB)/4, $0xBF75A155
+DATA radix11_c5<>+0x18(SB)/4, $0xBF75A155
+DATA radix11_c5<>+0x1C(SB)/4, $0xBF75A155
+GLOBL radix11_c5<>(SB), RODATA|NOPTR, $32
+
+DATA radix11_s1<>+0x00(SB)/4, $0x3F0A6770  //  0.54064082
+DATA radix11_s1<>+0x04(SB)/4, $0x3F0A6770
+DATA radix11_s1<>+0x08(SB)/4, $0x3F0A6770
+DATA radix11_s1<>+0x0C(SB)/4, $0x3F0A6770
+DATA radix11_s1<>+0x10(SB)/4, $0x3F0A6770
+DATA radix11_s1<>+0x14(SB)/4, $0x3F0A6770
+DATA radix11_s1<>+0x18(SB)/4, $0x3F0A6770
+DATA radix11_s1<>+0x1C(SB)/4, $0x3F0A6770
+GLOBL radix11_s1<>(SB), RODATA|NOPTR, $32
+
+DATA radix11_s2<>+0x00(SB)/4, $0x3F68DDA4  //  0.90963200
+DATA radix11_s2<>+0x04(SB)/4, $0x3F68DDA4
+DATA radix11_s2<>+0x08(SB)/4, $0x3F68DDA4
+DATA radix11_s2<>+0x0C(SB)/4, $0x3F68DDA4
+DATA radix11_s2<>+0x10(SB)/4, $0x3F68DDA4
+DATA radix11_s2<>+0x14(SB)/4, $0x3F68DDA4
+DATA radix11_s2<>+0x18(SB)/4, $0x3F68DDA4
+DATA radix11_s2<>+0x1C(SB)/4, $0x3F68DDA4
+GLOBL radix11_s2<>(SB), RODATA|NOPTR, $32
+
+DATA radix11_s3<>+0x00(SB)/4, $0x3F7D64F0  //  0.98982144
+DATA radix11_s3<>+0x04(SB)/4, $0x3F7D64F0
+DATA radix11_s3<>+0x08(SB)/4, $0x3F7D64F0
+DATA radix11_s3<>+0x0C(SB)/4, $0x3F7D64F0
+DATA radix11_s3<>+0x10(SB)/4, $0x3F7D64F0
+DATA radix11_s3<>+0x14(SB)/4, $0x3F7D64F0
+DATA radix11_s3<>+0x18(SB)/4, $0x3F7D64F0
+DATA radix11_s3<>+0x1C(SB)/4, $0x3F7D64F0
+GLOBL radix11_s3<>(SB), RODATA|NOPTR, $32
+
+DATA radix11_s4<>+0x00(SB)/4, $0x3F4178CE  //  0.75574957
+DATA radix11_s4<>+0x04(SB)/4, $0x3F4178CE
+DATA radix11_s4<>+0x08(SB)/4, $0x3F4178CE
+DATA radix11_s4<>+0x0C(SB)/4, $0x3F4178CE
+DATA radix11_s4<>+0x10(SB)/4, $0x3F4178CE
+DATA radix11_s4<>+0x14(SB)/4, $0x3F4178CE
+DATA radix11_s4<>+0x18(SB)/4, $0x3F4178CE
+DATA radix11_s4<>+0x1C(SB)/4, $0x3F4178CE
+GLOBL radix11_s4<>(SB), RODATA|NOPTR, $32
+
+DATA radix11_s5<>+0x00(SB)/4, $0x3E903F40  //  0.28173256
+DATA radix11_s5<>+0x04(SB)/4, $0x3E903F40
+DATA radix11_s5<>+0x08(SB)/4, $0x3E903F40
+DATA radix11_s5<>+0x0C(SB)/4, $0x3E903F40
+DATA radix11_s5<>+0x10(SB)/4, $0x3E903F40
+DATA radix11_s5<>+0x14(SB)/4, $0x3E903F40
+DATA radix11_s5<>+0x18(SB)/4, $0x3E903F40
+DATA radix11_s5<>+0x1C(SB)/4, $0x3E903F40
+GLOBL radix11_s5<>(SB), RODATA|NOPTR, $32
+
+// Sign bit of the imaginary lanes.
+DATA radix11_negim<>+0x00(SB)/4, $0x00000000
+DATA radix11_negim<>+0x04(SB)/4, $0x80000000
+DATA radix11_negim<>+0x08(SB)/4, $0x00000000
+DATA radix11_negim<>+0x0C(SB)/4, $0x80000000
+DATA radix11_negim<>+0x10(SB)/4, $0x00000000
+DATA radix11_negim<>+0x14(SB)/4, $0x80000000
+DATA radix11_negim<>+0x18(SB)/4, $0x00000000
+DATA radix11_negim<>+0x1C(SB)/4, $0x80000000
+GLOBL radix11_negim<>(SB), RODATA|NOPTR, $32
+
+// ===========================================================================
+// Function: Butterfly11ForwardAVX2Complex64
+// ===========================================================================
+// Processes 4 radix-11 forward butterflies in parallel.
+//
+// func Butterfly11ForwardAVX2Complex64(y, a []complex64)
+TEXT ·Butterfly11ForwardAVX2Complex64(SB), NOSPLIT, $0-48
+	MOVQ y_base+0(FP), DI
+	MOVQ a_base+24(FP), SI
+
+	// Verify both slices hold 11×4 values
+	MOVQ y_len+8(FP), AX
+	CMPQ AX, $44
+	JL   butterfly11_fwd_return
+
+	MOVQ a_len+32(FP), AX
+	CMPQ AX, $44
+	JL   butterfly11_fwd_return
+
+	// a0 and the symmetric sums and differences
+	VMOVUPS (SI), Y0
+	VMOVUPS 32(SI), Y1
+	VMOVUPS 320(SI), Y13
+	VSUBPS Y13, Y1, Y2
+	VADDPS Y13, Y1, Y1
+	VMOVUPS 64(SI), Y3
+	VMOVUPS 288(SI), Y13
+	VSUBPS Y13, Y3, Y4
+	VADDPS Y13, Y3, Y3
+	VMOVUPS 96(SI), Y5
+	VMOVUPS 256(SI), Y13
+	VSUBPS Y13, Y5, Y6
+	VADDPS Y13, Y5, Y5
+	VMOVUPS 128(SI), Y7
+	VMOVUPS 224(SI), Y13
+	VSUBPS Y13, Y7, Y8
+	VADDPS Y13, Y7, Y7
+	VMOVUPS 160(SI), Y9
+	VMOVUPS 192(SI), Y13
+	VSUBPS Y13, Y9, Y10
+	VADDPS Y13, Y9, Y9
+
+	// y0 = a0 + Σ s_j
+	VADDPS Y1, Y0, Y11
+	VADDPS Y3, Y11, Y11
+	VADDPS Y5, Y11, Y11
+	VADDPS Y7, Y11, Y11
+	VADDPS Y9, Y11, Y11
+	VMOVUPS Y11, (DI)
+
+	// y1 and y10
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c1<>(SB), Y1, Y11
+	VFMADD231PS radix11_c2<>(SB), Y3, Y11
+	VFMADD231PS radix11_c3<>(SB), Y5, Y11
+	VFMADD231PS radix11_c4<>(SB), Y7, Y11
+	VFMADD231PS radix11_c5<>(SB), Y9, Y11
+	VMULPS radix11_s1<>(SB), Y2, Y12
+	VFMADD231PS radix11_s2<>(SB), Y4, Y12
+	VFMADD231PS radix11_s3<>(SB), Y6, Y12
+	VFMADD231PS radix11_s4<>(SB), Y8, Y12
+	VFMADD231PS radix11_s5<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 320(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 32(DI)
+
+	// y2 and y9
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c2<>(SB), Y1, Y11
+	VFMADD231PS radix11_c4<>(SB), Y3, Y11
+	VFMADD231PS radix11_c5<>(SB), Y5, Y11
+	VFMADD231PS radix11_c3<>(SB), Y7, Y11
+	VFMADD231PS radix11_c1<>(SB), Y9, Y11
+	VMULPS radix11_s2<>(SB), Y2, Y12
+	VFMADD231PS radix11_s4<>(SB), Y4, Y12
+	VFNMADD231PS radix11_s5<>(SB), Y6, Y12
+	VFNMADD231PS radix11_s3<>(SB), Y8, Y12
+	VFNMADD231PS radix11_s1<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 288(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 64(DI)
+
+	// y3 and y8
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c3<>(SB), Y1, Y11
+	VFMADD231PS radix11_c5<>(SB), Y3, Y11
+	VFMADD231PS radix11_c2<>(SB), Y5, Y11
+	VFMADD231PS radix11_c1<>(SB), Y7, Y11
+	VFMADD231PS radix11_c4<>(SB), Y9, Y11
+	VMULPS radix11_s3<>(SB), Y2, Y12
+	VFNMADD231PS radix11_s5<>(SB), Y4, Y12
+	VFNMADD231PS radix11_s2<>(SB), Y6, Y12
+	VFMADD231PS radix11_s1<>(SB), Y8, Y12
+	VFMADD231PS radix11_s4<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 256(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 96(DI)
+
+	// y4 and y7
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c4<>(SB), Y1, Y11
+	VFMADD231PS radix11_c3<>(SB), Y3, Y11
+	VFMADD231PS radix11_c1<>(SB), Y5, Y11
+	VFMADD231PS radix11_c5<>(SB), Y7, Y11
+	VFMADD231PS radix11_c2<>(SB), Y9, Y11
+	VMULPS radix11_s4<>(SB), Y2, Y12
+	VFNMADD231PS radix11_s3<>(SB), Y4, Y12
+	VFMADD231PS radix11_s1<>(SB), Y6, Y12
+	VFMADD231PS radix11_s5<>(SB), Y8, Y12
+	VFNMADD231PS radix11_s2<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 224(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 128(DI)
+
+	// y5 and y6
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c5<>(SB), Y1, Y11
+	VFMADD231PS radix11_c1<>(SB), Y3, Y11
+	VFMADD231PS radix11_c4<>(SB), Y5, Y11
+	VFMADD231PS radix11_c2<>(SB), Y7, Y11
+	VFMADD231PS radix11_c3<>(SB), Y9, Y11
+	VMULPS radix11_s5<>(SB), Y2, Y12
+	VFNMADD231PS radix11_s1<>(SB), Y4, Y12
+	VFMADD231PS radix11_s4<>(SB), Y6, Y12
+	VFNMADD231PS radix11_s2<>(SB), Y8, Y12
+	VFMADD231PS radix11_s3<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 192(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 160(DI)
+
+	VZEROUPPER
+
+butterfly11_fwd_return:
+	RET
+
+// ===========================================================================
+// Function: Butterfly11InverseAVX2Complex64
+// ===========================================================================
+// Processes 4 radix-11 inverse butterflies in parallel.
+//
+// func Butterfly11InverseAVX2Complex64(y, a []complex64)
+TEXT ·Butterfly11InverseAVX2Complex64(SB), NOSPLIT, $0-48
+	MOVQ y_base+0(FP), DI
+	MOVQ a_base+24(FP), SI
+
+	// Verify both slices hold 11×4 values
+	MOVQ y_len+8(FP), AX
+	CMPQ AX, $44
+	JL   butterfly11_inv_return
+
+	MOVQ a_len+32(FP), AX
+	CMPQ AX, $44
+	JL   butterfly11_inv_return
+
+	// a0 and the symmetric sums and differences
+	VMOVUPS (SI), Y0
+	VMOVUPS 32(SI), Y1
+	VMOVUPS 320(SI), Y13
+	VSUBPS Y13, Y1, Y2
+	VADDPS Y13, Y1, Y1
+	VMOVUPS 64(SI), Y3
+	VMOVUPS 288(SI), Y13
+	VSUBPS Y13, Y3, Y4
+	VADDPS Y13, Y3, Y3
+	VMOVUPS 96(SI), Y5
+	VMOVUPS 256(SI), Y13
+	VSUBPS Y13, Y5, Y6
+	VADDPS Y13, Y5, Y5
+	VMOVUPS 128(SI), Y7
+	VMOVUPS 224(SI), Y13
+	VSUBPS Y13, Y7, Y8
+	VADDPS Y13, Y7, Y7
+	VMOVUPS 160(SI), Y9
+	VMOVUPS 192(SI), Y13
+	VSUBPS Y13, Y9, Y10
+	VADDPS Y13, Y9, Y9
+
+	// y0 = a0 + Σ s_j
+	VADDPS Y1, Y0, Y11
+	VADDPS Y3, Y11, Y11
+	VADDPS Y5, Y11, Y11
+	VADDPS Y7, Y11, Y11
+	VADDPS Y9, Y11, Y11
+	VMOVUPS Y11, (DI)
+
+	// y1 and y10
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c1<>(SB), Y1, Y11
+	VFMADD231PS radix11_c2<>(SB), Y3, Y11
+	VFMADD231PS radix11_c3<>(SB), Y5, Y11
+	VFMADD231PS radix11_c4<>(SB), Y7, Y11
+	VFMADD231PS radix11_c5<>(SB), Y9, Y11
+	VMULPS radix11_s1<>(SB), Y2, Y12
+	VFMADD231PS radix11_s2<>(SB), Y4, Y12
+	VFMADD231PS radix11_s3<>(SB), Y6, Y12
+	VFMADD231PS radix11_s4<>(SB), Y8, Y12
+	VFMADD231PS radix11_s5<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 32(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 320(DI)
+
+	// y2 and y9
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c2<>(SB), Y1, Y11
+	VFMADD231PS radix11_c4<>(SB), Y3, Y11
+	VFMADD231PS radix11_c5<>(SB), Y5, Y11
+	VFMADD231PS radix11_c3<>(SB), Y7, Y11
+	VFMADD231PS radix11_c1<>(SB), Y9, Y11
+	VMULPS radix11_s2<>(SB), Y2, Y12
+	VFMADD231PS radix11_s4<>(SB), Y4, Y12
+	VFNMADD231PS radix11_s5<>(SB), Y6, Y12
+	VFNMADD231PS radix11_s3<>(SB), Y8, Y12
+	VFNMADD231PS radix11_s1<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 64(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 288(DI)
+
+	// y3 and y8
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c3<>(SB), Y1, Y11
+	VFMADD231PS radix11_c5<>(SB), Y3, Y11
+	VFMADD231PS radix11_c2<>(SB), Y5, Y11
+	VFMADD231PS radix11_c1<>(SB), Y7, Y11
+	VFMADD231PS radix11_c4<>(SB), Y9, Y11
+	VMULPS radix11_s3<>(SB), Y2, Y12
+	VFNMADD231PS radix11_s5<>(SB), Y4, Y12
+	VFNMADD231PS radix11_s2<>(SB), Y6, Y12
+	VFMADD231PS radix11_s1<>(SB), Y8, Y12
+	VFMADD231PS radix11_s4<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 96(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 256(DI)
+
+	// y4 and y7
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c4<>(SB), Y1, Y11
+	VFMADD231PS radix11_c3<>(SB), Y3, Y11
+	VFMADD231PS radix11_c1<>(SB), Y5, Y11
+	VFMADD231PS radix11_c5<>(SB), Y7, Y11
+	VFMADD231PS radix11_c2<>(SB), Y9, Y11
+	VMULPS radix11_s4<>(SB), Y2, Y12
+	VFNMADD231PS radix11_s3<>(SB), Y4, Y12
+	VFMADD231PS radix11_s1<>(SB), Y6, Y12
+	VFMADD231PS radix11_s5<>(SB), Y8, Y12
+	VFNMADD231PS radix11_s2<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 128(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 224(DI)
+
+	// y5 and y6
+	VMOVAPS Y0, Y11
+	VFMADD231PS radix11_c5<>(SB), Y1, Y11
+	VFMADD231PS radix11_c1<>(SB), Y3, Y11
+	VFMADD231PS radix11_c4<>(SB), Y5, Y11
+	VFMADD231PS radix11_c2<>(SB), Y7, Y11
+	VFMADD231PS radix11_c3<>(SB), Y9, Y11
+	VMULPS radix11_s5<>(SB), Y2, Y12
+	VFNMADD231PS radix11_s1<>(SB), Y4, Y12
+	VFMADD231PS radix11_s4<>(SB), Y6, Y12
+	VFNMADD231PS radix11_s2<>(SB), Y8, Y12
+	VFMADD231PS radix11_s3<>(SB), Y10, Y12
+	VPERMILPS $0xB1, Y12, Y12
+	VADDSUBPS Y12, Y11, Y13
+	VMOVUPS Y13, 160(DI)
+	VXORPS radix11_negim<>(SB), Y12, Y12
+	VADDPS Y12, Y11, Y13
+	VMOVUPS Y13, 192(DI)
+
+	VZEROUPPER
+
+butterfly11_inv_return:
+	RET
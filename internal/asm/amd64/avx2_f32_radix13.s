@@ -0,0 +1,519 @@
+//go:build amd64 && asm && !purego
+
+// ===========================================================================
+// AVX2 Radix-13 Butterfly (complex64) for AMD64
+// ===========================================================================
+// Processes 4 radix-13 butterflies in parallel using YMM registers.
+// Input j of the 4 butterflies is packed in a[4*j : 4*j+4], so each YMM
+// holds one input: [re0, im0, re1, im1, re2, im2, re3, im3].
+//
+// With s_j = a[j] + a[13-j] and d_j = a[j] - a[13-j] (j = 1..6), outputs k and
+// 13-k come from t_k = a[0] + Σ cos(2πjk/13)·s_j and u_k = Σ sin(2πjk/13)·d_j:
+// forward y_k = t_k - i·u_k, y_(13-k) = t_k + i·u_k; the inverse swaps them.
+// ===========================================================================
+
+#include "textflag.h"
+
+// cos(2πj/13) and sin(2πj/13) for j = 1..6, broadcast to all 8 lanes.
+DATA radix13_c1<>+0x00(SB)/4, $0x3F62AD3F  //  0.88545603
+DATA radix13_c1<>+0x04(SB)/4, $0x3F62AD3F
+DATA radix13_c1<>+0x08(SB)/4, $0x3F62AD3F
+DATA radix13_c1<>+0x0C(SB)/4, $0x3F62AD3F
+DATA radix13_c1<>+0x10(SB)/4, $0x3F62AD3F
+DATA radix13_c1<>+0x14(SB)/4, $0x3F62AD3F
+DATA radix13_c1<>+0x18(SB)/4, $0x3F62AD3F
+DATA radix13_c1<>+0x1C(SB)/4, $0x3F62AD3F
+GLOBL radix13_c1<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_c2<>+0x00(SB)/4, $0x3F116CB1  //  0.56806475
+DATA radix13_c2<>+0x04(SB)/4, $0x3F116CB1
+DATA radix13_c2<>+0x08(SB)/4, $0x3F116CB1
+DATA radix13_c2<>+0x0C(SB)/4, $0x3F116CB1
+DATA radix13_c2<>+0x10(SB)/4, $0x3F116CB1
+DATA radix13_c2<>+0x14(SB)/4, $0x3F116CB1
+DATA radix13_c2<>+0x18(SB)/4, $0x3F116CB1
+DATA radix13_c2<>+0x1C(SB)/4, $0x3F116CB1
+GLOBL radix13_c2<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_c3<>+0x00(SB)/4, $0x3DF6DBEF  //  0.12053668
+DATA radix13_c3<>+0x04(SB)/4, $0x3DF6DBEF
+DATA radix13_c3<>+0x08(SB)/4, $0x3DF6DBEF
+DATA radix13_c3<>+0x0C(SB)/4, $0x3DF6DBEF
+DATA radix13_c3<>+0x10(SB)/4, $0x3DF6DBEF
+DATA radix13_c3<>+0x14(SB)/4, $0x3DF6DBEF
+DATA radix13_c3<>+0x18(SB)/4, $0x3DF6DBEF
+DATA radix13_c3<>+0x1C(SB)/4, $0x3DF6DBEF
+GLOBL radix13_c3<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_c4<>+0x00(SB)/4, $0xBEB58EC6  // -0.35460489
+DATA radix13_c4<>+0x04(SB)/4, $0xBEB58EC6
+DATA radix13_c4<>+0x08(SB)/4, $0xBEB58EC6
+DATA radix13_c4<>+0x0C(SB)/4, $0xBEB58EC6
+DATA radix13_c4<>+0x10(SB)/4, $0xBEB58EC6
+DATA radix13_c4<>+0x14(SB)/4, $0xBEB58EC6
+DATA radix13_c4<>+0x18(SB)/4, $0xBEB58EC6
+DATA radix13_c4<>+0x1C(SB)/4, $0xBEB58EC6
+GLOBL radix13_c4<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_c5<>+0x00(SB)/4, $0xBF3F9E67  // -0.74851075
+DATA radix13_c5<>+0x04(SB)/4, $0xBF3F9E67
+DATA radix13_c5<>+0x08(SB)/4, $0xBF3F9E67
+DATA radix13_c5<>+0x0C(SB)/4, $0xBF3F9E67
+DATA radix13_c5<>+0x10(SB)/4, $0xBF3F9E67
+DATA radix13_c5<>+0x14(SB)/4, $0xBF3F9E67
+DATA radix13_c5<>+0x18(SB)/4, $0xBF3F9E67
+DATA radix13_c5<>+0x1C(SB)/4, $0xBF3F9E67
+GLOBL radix13_c5<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_c6<>+0x00(SB)/4, $0xBF788FA5  // -0.97094182
+DATA radix13_c6<>+0x04(SB)/4, $0xBF788FA5
+DATA radix13_c6<>+0x08(SB)/4, $0xBF788FA5
+DATA radix13_c6<>+0x0C(SB)/4, $0xBF788FA5
+DATA radix13_c6<>+0x10(SB)/4, $0xBF788FA5
+DATA radix13_c6<>+0x14(SB)/4, $0xBF788FA5
+DATA radix13_c6<>+0x18(SB)/4, $0xBF788FA5
+DATA radix13_c6<>+0x1C(SB)/4, $0xBF788FA5
+GLOBL radix13_c6<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_s1<>+0x00(SB)/4, $0x3EEDF032  //  0.46472317
+DATA radix13_s1<>+0x04(SB)/4, $0x3EEDF032
+DATA radix13_s1<>+0x08(SB)/4, $0x3EEDF032
+DATA radix13_s1<>+0x0C(SB)/4, $0x3EEDF032
+DATA radix13_s1<>+0x10(SB)/4, $0x3EEDF032
+DATA radix13_s1<>+0x14(SB)/4, $0x3EEDF032
+DATA radix13_s1<>+0x18(SB)/4, $0x3EEDF032
+DATA radix13_s1<>+0x1C(SB)/4, $0x3EEDF032
+GLOBL radix13_s1<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_s2<>+0x00(SB)/4, $0x3F52AF12  //  0.82298387
+DATA radix13_s2<>+0x04(SB)/4, $0x3F52AF12
+DATA radix13_s2<>+0x08(SB)/4, $0x3F52AF12
+DATA radix13_s2<>+0x0C(SB)/4, $0x3F52AF12
+DATA radix13_s2<>+0x10(SB)/4, $0x3F52AF12
+DATA radix13_s2<>+0x14(SB)/4, $0x3F52AF12
+DATA radix13_s2<>+0x18(SB)/4, $0x3F52AF12
+DATA radix13_s2<>+0x1C(SB)/4, $0x3F52AF12
+GLOBL radix13_s2<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_s3<>+0x00(SB)/4, $0x3F7E222B  //  0.99270887
+DATA radix13_s3<>+0x04(SB)/4, $0x3F7E222B
+DATA radix13_s3<>+0x08(SB)/4, $0x3F7E222B
+DATA radix13_s3<>+0x0C(SB)/4, $0x3F7E222B
+DATA radix13_s3<>+0x10(SB)/4, $0x3F7E222B
+DATA radix13_s3<>+0x14(SB)/4, $0x3F7E222B
+DATA radix13_s3<>+0x18(SB)/4, $0x3F7E222B
+DATA radix13_s3<>+0x1C(SB)/4, $0x3F7E222B
+GLOBL radix13_s3<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_s4<>+0x00(SB)/4, $0x3F6F5D39  //  0.93501624
+DATA radix13_s4<>+0x04(SB)/4, $0x3F6F5D39
+DATA radix13_s4<>+0x08(SB)/4, $0x3F6F5D39
+DATA radix13_s4<>+0x0C(SB)/4, $0x3F6F5D39
+DATA radix13_s4<>+0x10(SB)/4, $0x3F6F5D39
+DATA radix13_s4<>+0x14(SB)/4, $0x3F6F5D39
+DATA radix13_s4<>+0x18(SB)/4, $0x3F6F5D39
+DATA radix13_s4<>+0x1C(SB)/4, $0x3F6F5D39
+GLOBL radix13_s4<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_s5<>+0x00(SB)/4, $0x3F29C268  //  0.66312266
+DATA radix13_s5<>+0x04(SB)/4, $0x3F29C268
+DATA radix13_s5<>+0x08(SB)/4, $0x3F29C268
+DATA radix13_s5<>+0x0C(SB)/4, $0x3F29C268
+DATA radix13_s5<>+0x10(SB)/4, $0x3F29C268
+DATA radix13_s5<>+0x14(SB)/4, $0x3F29C268
+DATA radix13_s5<>+0x18(SB)/4, $0x3F29C268
+DATA radix13_s5<>+0x1C(SB)/4, $0x3F29C268
+GLOBL radix13_s5<>(SB), RODATA|NOPTR, $32
+
+DATA radix13_s6<>+0x00(SB)/4, $0x3E750F2A  //  0.23931566
+DATA radix13_s6<>+0x04(SB)/4, $0x3E750F2A
+DATA radix13_s6<>+0x08(SB)/4, $0x3E750F2A
+DATA radix13_s6<>+0x0C(SB)/4, $0x3E750F2A
+DATA radix13_s6<>+0x10(SB)/4, $0x3E750F2A
+DATA radix13_s6<>+0x14(SB)/4, $0x3E750F2A
+DATA radix13_s6<>+0x18(SB)/4, $0x3E750F2A
+DATA radix13_s6<>+0x1C(SB)/4, $0x3E750F2A
+GLOBL radix13_s6<>(SB), RODATA|NOPTR, $32
+
+// Sign bit of the imaginary lanes.
+DATA radix13_negim<>+0x00(SB)/4, $0x00000000
+DATA radix13_negim<>+0x04(SB)/4, $0x80000000
+DATA radix13_negim<>+0x08(SB)/4, $0x00000000
+DATA radix13_negim<>+0x0C(SB)/4, $0x80000000
+DATA radix13_negim<>+0x10(SB)/4, $0x00000000
+DATA radix13_negim<>+0x14(SB)/4, $0x80000000
+DATA radix13_negim<>+0x18(SB)/4, $0x00000000
+DATA radix13_negim<>+0x1C(SB)/4, $0x80000000
+GLOBL radix13_negim<>(SB), RODATA|NOPTR, $32
+
+// ===========================================================================
+// Function: Butterfly13ForwardAVX2Complex64
+// ===========================================================================
+// Processes 4 radix-13 forward butterflies in parallel.
+//
+// func Butterfly13ForwardAVX2Complex64(y, a []complex64)
+TEXT ·Butterfly13ForwardAVX2Complex64(SB), NOSPLIT, $0-48
+	MOVQ y_base+0(FP), DI
+	MOVQ a_base+24(FP), SI
+
+	// Verify both slices hold 13×4 values
+	MOVQ y_len+8(FP), AX
+	CMPQ AX, $52
+	JL   butterfly13_fwd_return
+
+	MOVQ a_len+32(FP), AX
+	CMPQ AX, $52
+	JL   butterfly13_fwd_return
+
+	// a0 and the symmetric sums and differences
+	VMOVUPS (SI), Y0
+	VMOVUPS 32(SI), Y1
+	VMOVUPS 384(SI), Y15
+	VSUBPS Y15, Y1, Y2
+	VADDPS Y15, Y1, Y1
+	VMOVUPS 64(SI), Y3
+	VMOVUPS 352(SI), Y15
+	VSUBPS Y15, Y3, Y4
+	VADDPS Y15, Y3, Y3
+	VMOVUPS 96(SI), Y5
+	VMOVUPS 320(SI), Y15
+	VSUBPS Y15, Y5, Y6
+	VADDPS Y15, Y5, Y5
+	VMOVUPS 128(SI), Y7
+	VMOVUPS 288(SI), Y15
+	VSUBPS Y15, Y7, Y8
+	VADDPS Y15, Y7, Y7
+	VMOVUPS 160(SI), Y9
+	VMOVUPS 256(SI), Y15
+	VSUBPS Y15, Y9, Y10
+	VADDPS Y15, Y9, Y9
+	VMOVUPS 192(SI), Y11
+	VMOVUPS 224(SI), Y15
+	VSUBPS Y15, Y11, Y12
+	VADDPS Y15, Y11, Y11
+
+	// y0 = a0 + Σ s_j
+	VADDPS Y1, Y0, Y13
+	VADDPS Y3, Y13, Y13
+	VADDPS Y5, Y13, Y13
+	VADDPS Y7, Y13, Y13
+	VADDPS Y9, Y13, Y13
+	VADDPS Y11, Y13, Y13
+	VMOVUPS Y13, (DI)
+
+	// y1 and y12
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c1<>(SB), Y1, Y13
+	VFMADD231PS radix13_c2<>(SB), Y3, Y13
+	VFMADD231PS radix13_c3<>(SB), Y5, Y13
+	VFMADD231PS radix13_c4<>(SB), Y7, Y13
+	VFMADD231PS radix13_c5<>(SB), Y9, Y13
+	VFMADD231PS radix13_c6<>(SB), Y11, Y13
+	VMULPS radix13_s1<>(SB), Y2, Y14
+	VFMADD231PS radix13_s2<>(SB), Y4, Y14
+	VFMADD231PS radix13_s3<>(SB), Y6, Y14
+	VFMADD231PS radix13_s4<>(SB), Y8, Y14
+	VFMADD231PS radix13_s5<>(SB), Y10, Y14
+	VFMADD231PS radix13_s6<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 384(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 32(DI)
+
+	// y2 and y11
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c2<>(SB), Y1, Y13
+	VFMADD231PS radix13_c4<>(SB), Y3, Y13
+	VFMADD231PS radix13_c6<>(SB), Y5, Y13
+	VFMADD231PS radix13_c5<>(SB), Y7, Y13
+	VFMADD231PS radix13_c3<>(SB), Y9, Y13
+	VFMADD231PS radix13_c1<>(SB), Y11, Y13
+	VMULPS radix13_s2<>(SB), Y2, Y14
+	VFMADD231PS radix13_s4<>(SB), Y4, Y14
+	VFMADD231PS radix13_s6<>(SB), Y6, Y14
+	VFNMADD231PS radix13_s5<>(SB), Y8, Y14
+	VFNMADD231PS radix13_s3<>(SB), Y10, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 352(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 64(DI)
+
+	// y3 and y10
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c3<>(SB), Y1, Y13
+	VFMADD231PS radix13_c6<>(SB), Y3, Y13
+	VFMADD231PS radix13_c4<>(SB), Y5, Y13
+	VFMADD231PS radix13_c1<>(SB), Y7, Y13
+	VFMADD231PS radix13_c2<>(SB), Y9, Y13
+	VFMADD231PS radix13_c5<>(SB), Y11, Y13
+	VMULPS radix13_s3<>(SB), Y2, Y14
+	VFMADD231PS radix13_s6<>(SB), Y4, Y14
+	VFNMADD231PS radix13_s4<>(SB), Y6, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y8, Y14
+	VFMADD231PS radix13_s2<>(SB), Y10, Y14
+	VFMADD231PS radix13_s5<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 320(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 96(DI)
+
+	// y4 and y9
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c4<>(SB), Y1, Y13
+	VFMADD231PS radix13_c5<>(SB), Y3, Y13
+	VFMADD231PS radix13_c1<>(SB), Y5, Y13
+	VFMADD231PS radix13_c3<>(SB), Y7, Y13
+	VFMADD231PS radix13_c6<>(SB), Y9, Y13
+	VFMADD231PS radix13_c2<>(SB), Y11, Y13
+	VMULPS radix13_s4<>(SB), Y2, Y14
+	VFNMADD231PS radix13_s5<>(SB), Y4, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y6, Y14
+	VFMADD231PS radix13_s3<>(SB), Y8, Y14
+	VFNMADD231PS radix13_s6<>(SB), Y10, Y14
+	VFNMADD231PS radix13_s2<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 288(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 128(DI)
+
+	// y5 and y8
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c5<>(SB), Y1, Y13
+	VFMADD231PS radix13_c3<>(SB), Y3, Y13
+	VFMADD231PS radix13_c2<>(SB), Y5, Y13
+	VFMADD231PS radix13_c6<>(SB), Y7, Y13
+	VFMADD231PS radix13_c1<>(SB), Y9, Y13
+	VFMADD231PS radix13_c4<>(SB), Y11, Y13
+	VMULPS radix13_s5<>(SB), Y2, Y14
+	VFNMADD231PS radix13_s3<>(SB), Y4, Y14
+	VFMADD231PS radix13_s2<>(SB), Y6, Y14
+	VFNMADD231PS radix13_s6<>(SB), Y8, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y10, Y14
+	VFMADD231PS radix13_s4<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 256(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 160(DI)
+
+	// y6 and y7
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c6<>(SB), Y1, Y13
+	VFMADD231PS radix13_c1<>(SB), Y3, Y13
+	VFMADD231PS radix13_c5<>(SB), Y5, Y13
+	VFMADD231PS radix13_c2<>(SB), Y7, Y13
+	VFMADD231PS radix13_c4<>(SB), Y9, Y13
+	VFMADD231PS radix13_c3<>(SB), Y11, Y13
+	VMULPS radix13_s6<>(SB), Y2, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y4, Y14
+	VFMADD231PS radix13_s5<>(SB), Y6, Y14
+	VFNMADD231PS radix13_s2<>(SB), Y8, Y14
+	VFMADD231PS radix13_s4<>(SB), Y10, Y14
+	VFNMADD231PS radix13_s3<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 224(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 192(DI)
+
+	VZEROUPPER
+
+butterfly13_fwd_return:
+	RET
+
+// ===========================================================================
+// Function: Butterfly13InverseAVX2Complex64
+// ===========================================================================
+// Processes 4 radix-13 inverse butterflies in parallel.
+//
+// func Butterfly13InverseAVX2Complex64(y, a []complex64)
+TEXT ·Butterfly13InverseAVX2Complex64(SB), NOSPLIT, $0-48
+	MOVQ y_base+0(FP), DI
+	MOVQ a_base+24(FP), SI
+
+	// Verify both slices hold 13×4 values
+	MOVQ y_len+8(FP), AX
+	CMPQ AX, $52
+	JL   butterfly13_inv_return
+
+	MOVQ a_len+32(FP), AX
+	CMPQ AX, $52
+	JL   butterfly13_inv_return
+
+	// a0 and the symmetric sums and differences
+	VMOVUPS (SI), Y0
+	VMOVUPS 32(SI), Y1
+	VMOVUPS 384(SI), Y15
+	VSUBPS Y15, Y1, Y2
+	VADDPS Y15, Y1, Y1
+	VMOVUPS 64(SI), Y3
+	VMOVUPS 352(SI), Y15
+	VSUBPS Y15, Y3, Y4
+	VADDPS Y15, Y3, Y3
+	VMOVUPS 96(SI), Y5
+	VMOVUPS 320(SI), Y15
+	VSUBPS Y15, Y5, Y6
+	VADDPS Y15, Y5, Y5
+	VMOVUPS 128(SI), Y7
+	VMOVUPS 288(SI), Y15
+	VSUBPS Y15, Y7, Y8
+	VADDPS Y15, Y7, Y7
+	VMOVUPS 160(SI), Y9
+	VMOVUPS 256(SI), Y15
+	VSUBPS Y15, Y9, Y10
+	VADDPS Y15, Y9, Y9
+	VMOVUPS 192(SI), Y11
+	VMOVUPS 224(SI), Y15
+	VSUBPS Y15, Y11, Y12
+	VADDPS Y15, Y11, Y11
+
+	// y0 = a0 + Σ s_j
+	VADDPS Y1, Y0, Y13
+	VADDPS Y3, Y13, Y13
+	VADDPS Y5, Y13, Y13
+	VADDPS Y7, Y13, Y13
+	VADDPS Y9, Y13, Y13
+	VADDPS Y11, Y13, Y13
+	VMOVUPS Y13, (DI)
+
+	// y1 and y12
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c1<>(SB), Y1, Y13
+	VFMADD231PS radix13_c2<>(SB), Y3, Y13
+	VFMADD231PS radix13_c3<>(SB), Y5, Y13
+	VFMADD231PS radix13_c4<>(SB), Y7, Y13
+	VFMADD231PS radix13_c5<>(SB), Y9, Y13
+	VFMADD231PS radix13_c6<>(SB), Y11, Y13
+	VMULPS radix13_s1<>(SB), Y2, Y14
+	VFMADD231PS radix13_s2<>(SB), Y4, Y14
+	VFMADD231PS radix13_s3<>(SB), Y6, Y14
+	VFMADD231PS radix13_s4<>(SB), Y8, Y14
+	VFMADD231PS radix13_s5<>(SB), Y10, Y14
+	VFMADD231PS radix13_s6<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 32(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 384(DI)
+
+	// y2 and y11
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c2<>(SB), Y1, Y13
+	VFMADD231PS radix13_c4<>(SB), Y3, Y13
+	VFMADD231PS radix13_c6<>(SB), Y5, Y13
+	VFMADD231PS radix13_c5<>(SB), Y7, Y13
+	VFMADD231PS radix13_c3<>(SB), Y9, Y13
+	VFMADD231PS radix13_c1<>(SB), Y11, Y13
+	VMULPS radix13_s2<>(SB), Y2, Y14
+	VFMADD231PS radix13_s4<>(SB), Y4, Y14
+	VFMADD231PS radix13_s6<>(SB), Y6, Y14
+	VFNMADD231PS radix13_s5<>(SB), Y8, Y14
+	VFNMADD231PS radix13_s3<>(SB), Y10, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 64(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 352(DI)
+
+	// y3 and y10
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c3<>(SB), Y1, Y13
+	VFMADD231PS radix13_c6<>(SB), Y3, Y13
+	VFMADD231PS radix13_c4<>(SB), Y5, Y13
+	VFMADD231PS radix13_c1<>(SB), Y7, Y13
+	VFMADD231PS radix13_c2<>(SB), Y9, Y13
+	VFMADD231PS radix13_c5<>(SB), Y11, Y13
+	VMULPS radix13_s3<>(SB), Y2, Y14
+	VFMADD231PS radix13_s6<>(SB), Y4, Y14
+	VFNMADD231PS radix13_s4<>(SB), Y6, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y8, Y14
+	VFMADD231PS radix13_s2<>(SB), Y10, Y14
+	VFMADD231PS radix13_s5<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 96(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 320(DI)
+
+	// y4 and y9
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c4<>(SB), Y1, Y13
+	VFMADD231PS radix13_c5<>(SB), Y3, Y13
+	VFMADD231PS radix13_c1<>(SB), Y5, Y13
+	VFMADD231PS radix13_c3<>(SB), Y7, Y13
+	VFMADD231PS radix13_c6<>(SB), Y9, Y13
+	VFMADD231PS radix13_c2<>(SB), Y11, Y13
+	VMULPS radix13_s4<>(SB), Y2, Y14
+	VFNMADD231PS radix13_s5<>(SB), Y4, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y6, Y14
+	VFMADD231PS radix13_s3<>(SB), Y8, Y14
+	VFNMADD231PS radix13_s6<>(SB), Y10, Y14
+	VFNMADD231PS radix13_s2<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 128(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 288(DI)
+
+	// y5 and y8
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c5<>(SB), Y1, Y13
+	VFMADD231PS radix13_c3<>(SB), Y3, Y13
+	VFMADD231PS radix13_c2<>(SB), Y5, Y13
+	VFMADD231PS radix13_c6<>(SB), Y7, Y13
+	VFMADD231PS radix13_c1<>(SB), Y9, Y13
+	VFMADD231PS radix13_c4<>(SB), Y11, Y13
+	VMULPS radix13_s5<>(SB), Y2, Y14
+	VFNMADD231PS radix13_s3<>(SB), Y4, Y14
+	VFMADD231PS radix13_s2<>(SB), Y6, Y14
+	VFNMADD231PS radix13_s6<>(SB), Y8, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y10, Y14
+	VFMADD231PS radix13_s4<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 160(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 256(DI)
+
+	// y6 and y7
+	VMOVAPS Y0, Y13
+	VFMADD231PS radix13_c6<>(SB), Y1, Y13
+	VFMADD231PS radix13_c1<>(SB), Y3, Y13
+	VFMADD231PS radix13_c5<>(SB), Y5, Y13
+	VFMADD231PS radix13_c2<>(SB), Y7, Y13
+	VFMADD231PS radix13_c4<>(SB), Y9, Y13
+	VFMADD231PS radix13_c3<>(SB), Y11, Y13
+	VMULPS radix13_s6<>(SB), Y2, Y14
+	VFNMADD231PS radix13_s1<>(SB), Y4, Y14
+	VFMADD231PS radix13_s5<>(SB), Y6, Y14
+	VFNMADD231PS radix13_s2<>(SB), Y8, Y14
+	VFMADD231PS radix13_s4<>(SB), Y10, Y14
+	VFNMADD231PS radix13_s3<>(SB), Y12, Y14
+	VPERMILPS $0xB1, Y14, Y14
+	VADDSUBPS Y14, Y13, Y15
+	VMOVUPS Y15, 192(DI)
+	VXORPS radix13_negim<>(SB), Y14, Y14
+	VADDPS Y14, Y13, Y15
+	VMOVUPS Y15, 224(DI)
+
+	VZEROUPPER
+
+butterfly13_inv_return:
+	RET
@@ -0,0 +1,267 @@
+//go:build amd64 && asm && !purego
+
+// ===========================================================================
+// AVX2 Radix-7 Butterfly (complex64) for AMD64
+// ===========================================================================
+// Processes 4 radix-7 butterflies in parallel using YMM registers.
+// Input j of the 4 butterflies is packed in a[4*j : 4*j+4], so each YMM
+// holds one input: [re0, im0, re1, im1, re2, im2, re3, im3].
+//
+// With s_j = a[j] + a[7-j] and d_j = a[j] - a[7-j] (j = 1..3), outputs k and
+// 7-k come from t_k = a[0] + Σ cos(2πjk/7)·s_j and u_k = Σ sin(2πjk/7)·d_j:
+// forward y_k = t_k - i·u_k, y_(7-k) = t_k + i·u_k; the inverse swaps them.
+// ===========================================================================
+
+#include "textflag.h"
+
+// cos(2πj/7) and sin(2πj/7) for j = 1..3, broadcast to all 8 lanes.
+DATA radix7_c1<>+0x00(SB)/4, $0x3F1F9D07  //  0.62348980
+DATA radix7_c1<>+0x04(SB)/4, $0x3F1F9D07
+DATA radix7_c1<>+0x08(SB)/4, $0x3F1F9D07
+DATA radix7_c1<>+0x0C(SB)/4, $0x3F1F9D07
+DATA radix7_c1<>+0x10(SB)/4, $0x3F1F9D07
+DATA radix7_c1<>+0x14(SB)/4, $0x3F1F9D07
+DATA radix7_c1<>+0x18(SB)/4, $0x3F1F9D07
+DATA radix7_c1<>+0x1C(SB)/4, $0x3F1F9D07
+GLOBL radix7_c1<>(SB), RODATA|NOPTR, $32
+
+DATA radix7_c2<>+0x00(SB)/4, $0xBE63DC87  // -0.22252093
+DATA radix7_c2<>+0x04(SB)/4, $0xBE63DC87
+DATA radix7_c2<>+0x08(SB)/4, $0xBE63DC87
+DATA radix7_c2<>+0x0C(SB)/4, $0xBE63DC87
+DATA radix7_c2<>+0x10(SB)/4, $0xBE63DC87
+DATA radix7_c2<>+0x14(SB)/4, $0xBE63DC87
+DATA radix7_c2<>+0x18(SB)/4, $0xBE63DC87
+DATA radix7_c2<>+0x1C(SB)/4, $0xBE63DC87
+GLOBL radix7_c2<>(SB), RODATA|NOPTR, $32
+
+DATA radix7_c3<>+0x00(SB)/4, $0xBF66A5E5  // -0.90096887
+DATA radix7_c3<>+0x04(SB)/4, $0xBF66A5E5
+DATA radix7_c3<>+0x08(SB)/4, $0xBF66A5E5
+DATA radix7_c3<>+0x0C(SB)/4, $0xBF66A5E5
+DATA radix7_c3<>+0x10(SB)/4, $0xBF66A5E5
+DATA radix7_c3<>+0x14(SB)/4, $0xBF66A5E5
+DATA radix7_c3<>+0x18(SB)/4, $0xBF66A5E5
+DATA radix7_c3<>+0x1C(SB)/4, $0xBF66A5E5
+GLOBL radix7_c3<>(SB), RODATA|NOPTR, $32
+
+DATA radix7_s1<>+0x00(SB)/4, $0x3F48261C  //  0.78183148
+DATA radix7_s1<>+0x04(SB)/4, $0x3F48261C
+DATA radix7_s1<>+0x08(SB)/4, $0x3F48261C
+DATA radix7_s1<>+0x0C(SB)/4, $0x3F48261C
+DATA radix7_s1<>+0x10(SB)/4, $0x3F48261C
+DATA radix7_s1<>+0x14(SB)/4, $0x3F48261C
+DATA radix7_s1<>+0x18(SB)/4, $0x3F48261C
+DATA radix7_s1<>+0x1C(SB)/4, $0x3F48261C
+GLOBL radix7_s1<>(SB), RODATA|NOPTR, $32
+
+DATA radix7_s2<>+0x00(SB)/4, $0x3F7994E0  //  0.97492791
+DATA radix7_s2<>+0x04(SB)/4, $0x3F7994E0
+DATA radix7_s2<>+0x08(SB)/4, $0x3F7994E0
+DATA radix7_s2<>+0x0C(SB)/4, $0x3F7994E0
+DATA radix7_s2<>+0x10(SB)/4, $0x3F7994E0
+DATA radix7_s2<>+0x14(SB)/4, $0x3F7994E0
+DATA radix7_s2<>+0x18(SB)/4, $0x3F7994E0
+DATA radix7_s2<>+0x1C(SB)/4, $0x3F7994E0
+GLOBL radix7_s2<>(SB), RODATA|NOPTR, $32
+
+DATA radix7_s3<>+0x00(SB)/4, $0x3EDE2602  //  0.43388374
+DATA radix7_s3<>+0x04(SB)/4, $0x3EDE2602
+DATA radix7_s3<>+0x08(SB)/4, $0x3EDE2602
+DATA radix7_s3<>+0x0C(SB)/4, $0x3EDE2602
+DATA radix7_s3<>+0x10(SB)/4, $0x3EDE2602
+DATA radix7_s3<>+0x14(SB)/4, $0x3EDE2602
+DATA radix7_s3<>+0x18(SB)/4, $0x3EDE2602
+DATA radix7_s3<>+0x1C(SB)/4, $0x3EDE2602
+GLOBL radix7_s3<>(SB), RODATA|NOPTR, $32
+
+// Sign bit of the imaginary lanes.
+DATA radix7_negim<>+0x00(SB)/4, $0x00000000
+DATA radix7_negim<>+0x04(SB)/4, $0x80000000
+DATA radix7_negim<>+0x08(SB)/4, $0x00000000
+DATA radix7_negim<>+0x0C(SB)/4, $0x80000000
+DATA radix7_negim<>+0x10(SB)/4, $0x00000000
+DATA radix7_negim<>+0x14(SB)/4, $0x80000000
+DATA radix7_negim<>+0x18(SB)/4, $0x00000000
+DATA radix7_negim<>+0x1C(SB)/4, $0x80000000
+GLOBL radix7_negim<>(SB), RODATA|NOPTR, $32
+
+// ===========================================================================
+// Function: Butterfly7ForwardAVX2Complex64
+// ===========================================================================
+// Processes 4 radix-7 forward butterflies in parallel.
+//
+// func Butterfly7ForwardAVX2Complex64(y, a []complex64)
+TEXT ·Butterfly7ForwardAVX2Complex64(SB), NOSPLIT, $0-48
+	MOVQ y_base+0(FP), DI
+	MOVQ a_base+24(FP), SI
+
+	// Verify both slices hold 7×4 values
+	MOVQ y_len+8(FP), AX
+	CMPQ AX, $28
+	JL   butterfly7_fwd_return
+
+	MOVQ a_len+32(FP), AX
+	CMPQ AX, $28
+	JL   butterfly7_fwd_return
+
+	// a0 and the symmetric sums and differences
+	VMOVUPS (SI), Y0
+	VMOVUPS 32(SI), Y1
+	VMOVUPS 192(SI), Y9
+	VSUBPS Y9, Y1, Y2
+	VADDPS Y9, Y1, Y1
+	VMOVUPS 64(SI), Y3
+	VMOVUPS 160(SI), Y9
+	VSUBPS Y9, Y3, Y4
+	VADDPS Y9, Y3, Y3
+	VMOVUPS 96(SI), Y5
+	VMOVUPS 128(SI), Y9
+	VSUBPS Y9, Y5, Y6
+	VADDPS Y9, Y5, Y5
+
+	// y0 = a0 + Σ s_j
+	VADDPS Y1, Y0, Y7
+	VADDPS Y3, Y7, Y7
+	VADDPS Y5, Y7, Y7
+	VMOVUPS Y7, (DI)
+
+	// y1 and y6
+	VMOVAPS Y0, Y7
+	VFMADD231PS radix7_c1<>(SB), Y1, Y7
+	VFMADD231PS radix7_c2<>(SB), Y3, Y7
+	VFMADD231PS radix7_c3<>(SB), Y5, Y7
+	VMULPS radix7_s1<>(SB), Y2, Y8
+	VFMADD231PS radix7_s2<>(SB), Y4, Y8
+	VFMADD231PS radix7_s3<>(SB), Y6, Y8
+	VPERMILPS $0xB1, Y8, Y8
+	VADDSUBPS Y8, Y7, Y9
+	VMOVUPS Y9, 192(DI)
+	VXORPS radix7_negim<>(SB), Y8, Y8
+	VADDPS Y8, Y7, Y9
+	VMOVUPS Y9, 32(DI)
+
+	// y2 and y5
+	VMOVAPS Y0, Y7
+	VFMADD231PS radix7_c2<>(SB), Y1, Y7
+	VFMADD231PS radix7_c3<>(SB), Y3, Y7
+	VFMADD231PS radix7_c1<>(SB), Y5, Y7
+	VMULPS radix7_s2<>(SB), Y2, Y8
+	VFNMADD231PS radix7_s3<>(SB), Y4, Y8
+	VFNMADD231PS radix7_s1<>(SB), Y6, Y8
+	VPERMILPS $0xB1, Y8, Y8
+	VADDSUBPS Y8, Y7, Y9
+	VMOVUPS Y9, 160(DI)
+	VXORPS radix7_negim<>(SB), Y8, Y8
+	VADDPS Y8, Y7, Y9
+	VMOVUPS Y9, 64(DI)
+
+	// y3 and y4
+	VMOVAPS Y0, Y7
+	VFMADD231PS radix7_c3<>(SB), Y1, Y7
+	VFMADD231PS radix7_c1<>(SB), Y3, Y7
+	VFMADD231PS radix7_c2<>(SB), Y5, Y7
+	VMULPS radix7_s3<>(SB), Y2, Y8
+	VFNMADD231PS radix7_s1<>(SB), Y4, Y8
+	VFMADD231PS radix7_s2<>(SB), Y6, Y8
+	VPERMILPS $0xB1, Y8, Y8
+	VADDSUBPS Y8, Y7, Y9
+	VMOVUPS Y9, 128(DI)
+	VXORPS radix7_negim<>(SB), Y8, Y8
+	VADDPS Y8, Y7, Y9
+	VMOVUPS Y9, 96(DI)
+
+	VZEROUPPER
+
+butterfly7_fwd_return:
+	RET
+
+// ===========================================================================
+// Function: Butterfly7InverseAVX2Complex64
+// ===========================================================================
+// Processes 4 radix-7 inverse butterflies in parallel.
+//
+// func Butterfly7InverseAVX2Complex64(y, a []complex64)
+TEXT ·Butterfly7InverseAVX2Complex64(SB), NOSPLIT, $0-48
+	MOVQ y_base+0(FP), DI
+	MOVQ a_base+24(FP), SI
+
+	// Verify both slices hold 7×4 values
+	MOVQ y_len+8(FP), AX
+	CMPQ AX, $28
+	JL   butterfly7_inv_return
+
+	MOVQ a_len+32(FP), AX
+	CMPQ AX, $28
+	JL   butterfly7_inv_return
+
+	// a0 and the symmetric sums and differences
+	VMOVUPS (SI), Y0
+	VMOVUPS 32(SI), Y1
+	VMOVUPS 192(SI), Y9
+	VSUBPS Y9, Y1, Y2
+	VADDPS Y9, Y1, Y1
+	VMOVUPS 64(SI), Y3
+	VMOVUPS 160(SI), Y9
+	VSUBPS Y9, Y3, Y4
+	VADDPS Y9, Y3, Y3
+	VMOVUPS 96(SI), Y5
+	VMOVUPS 128(SI), Y9
+	VSUBPS Y9, Y5, Y6
+	VADDPS Y9, Y5, Y5
+
+	// y0 = a0 + Σ s_j
+	VADDPS Y1, Y0, Y7
+	VADDPS Y3, Y7, Y7
+	VADDPS Y5, Y7, Y7
+	VMOVUPS Y7, (DI)
+
+	// y1 and y6
+	VMOVAPS Y0, Y7
+	VFMADD231PS radix7_c1<>(SB), Y1, Y7
+	VFMADD231PS radix7_c2<>(SB), Y3, Y7
+	VFMADD231PS radix7_c3<>(SB), Y5, Y7
+	VMULPS radix7_s1<>(SB), Y2, Y8
+	VFMADD231PS radix7_s2<>(SB), Y4, Y8
+	VFMADD231PS radix7_s3<>(SB), Y6, Y8
+	VPERMILPS $0xB1, Y8, Y8
+	VADDSUBPS Y8, Y7, Y9
+	VMOVUPS Y9, 32(DI)
+	VXORPS radix7_negim<>(SB), Y8, Y8
+	VADDPS Y8, Y7, Y9
+	VMOVUPS Y9, 192(DI)
+
+	// y2 and y5
+	VMOVAPS Y0, Y7
+	VFMADD231PS radix7_c2<>(SB), Y1, Y7
+	VFMADD231PS radix7_c3<>(SB), Y3, Y7
+	VFMADD231PS radix7_c1<>(SB), Y5, Y7
+	VMULPS radix7_s2<>(SB), Y2, Y8
+	VFNMADD231PS radix7_s3<>(SB), Y4, Y8
+	VFNMADD231PS radix7_s1<>(SB), Y6, Y8
+	VPERMILPS $0xB1, Y8, Y8
+	VADDSUBPS Y8, Y7, Y9
+	VMOVUPS Y9, 64(DI)
+	VXORPS radix7_negim<>(SB), Y8, Y8
+	VADDPS Y8, Y7, Y9
+	VMOVUPS Y9, 160(DI)
+
+	// y3 and y4
+	VMOVAPS Y0, Y7
+	VFMADD231PS radix7_c3<>(SB), Y1, Y7
+	VFMADD231PS radix7_c1<>(SB), Y3, Y7
+	VFMADD231PS radix7_c2<>(SB), Y5, Y7
+	VMULPS radix7_s3<>(SB), Y2, Y8
+	VFNMADD231PS radix7_s1<>(SB), Y4, Y8
+	VFMADD231PS radix7_s2<>(SB), Y6, Y8
+	VPERMILPS $0xB1, Y8, Y8
+	VADDSUBPS Y8, Y7, Y9
+	VMOVUPS Y9, 96(DI)
+	VXORPS radix7_negim<>(SB), Y8, Y8
+	VADDPS Y8, Y7, Y9
+	VMOVUPS Y9, 128(DI)
+
+	VZEROUPPER
+
+butterfly7_inv_return:
+	RET
@@ -0,0 +1,191 @@
+//go:build amd64 && asm && !purego
+
+// ===========================================================================
+// AVX2 Split-Complex Radix-2 Butterflies for AMD64
+// ===========================================================================
+//
+// Split-complex (SoA) data keeps real and imaginary parts in separate
+// arrays, so a radix-2 Stockham butterfly over a contiguous span is a purely
+// vertical operation - no shuffles are needed:
+//
+//   lo[j] = a[j] + b[j]
+//   hi[j] = (a[j] - b[j]) * w[j]
+//
+//   hi.re = d.re*w.re - d.im*w.im   (VFMSUB231)
+//   hi.im = d.re*w.im + d.im*w.re   (VFMADD231)
+//
+// Each YMM register holds 8 float32 or 4 float64 components of one array.
+// Remaining elements are processed with scalar VEX instructions.
+//
+// ===========================================================================
+
+#include "textflag.h"
+
+// func SplitButterflies{Float32,Float64}AVX2Asm(loRe, loIm, hiRe, hiIm, aRe, aIm, bRe, bIm, twRe, twIm []T)
+// Stack frame layout (offsets from FP), 24 bytes per slice:
+//   loRe: FP+0   loIm: FP+24  hiRe: FP+48  hiIm: FP+72
+//   aRe:  FP+96  aIm:  FP+120 bRe:  FP+144 bIm:  FP+168
+//   twRe: FP+192 twIm: FP+216
+// The element count is len(aRe); all other slices must be at least as long.
+
+// ===========================================================================
+// SplitButterfliesFloat32AVX2Asm - 8 butterflies per iteration
+// ===========================================================================
+TEXT ·SplitButterfliesFloat32AVX2Asm(SB), NOSPLIT, $0-240
+	MOVQ loRe+0(FP), BX
+	MOVQ loIm+24(FP), DX
+	MOVQ hiRe+48(FP), SI
+	MOVQ hiIm+72(FP), DI
+	MOVQ aRe+96(FP), R8
+	MOVQ aIm+120(FP), R9
+	MOVQ bRe+144(FP), R10
+	MOVQ bIm+168(FP), R11
+	MOVQ twRe+192(FP), R12
+	MOVQ twIm+216(FP), R13
+	MOVQ aRe_len+104(FP), CX  // CX = remaining elements
+	XORQ AX, AX               // AX = byte offset
+
+split32_vec_loop:
+	CMPQ CX, $8
+	JL   split32_tail
+
+	VMOVUPS (R8)(AX*1), Y0      // aRe
+	VMOVUPS (R9)(AX*1), Y1      // aIm
+	VMOVUPS (R10)(AX*1), Y2     // bRe
+	VMOVUPS (R11)(AX*1), Y3     // bIm
+	VMOVUPS (R12)(AX*1), Y8     // wr
+	VMOVUPS (R13)(AX*1), Y9     // wi
+
+	VADDPS Y2, Y0, Y4        // loRe = aRe + bRe
+	VADDPS Y3, Y1, Y5        // loIm = aIm + bIm
+	VSUBPS Y2, Y0, Y6        // dr = aRe - bRe
+	VSUBPS Y3, Y1, Y7        // di = aIm - bIm
+
+	VMULPS Y9, Y7, Y10       // di*wi
+	VFMSUB231PS Y8, Y6, Y10  // hiRe = dr*wr - di*wi
+	VMULPS Y8, Y7, Y11       // di*wr
+	VFMADD231PS Y9, Y6, Y11  // hiIm = dr*wi + di*wr
+
+	VMOVUPS Y4, (BX)(AX*1)
+	VMOVUPS Y5, (DX)(AX*1)
+	VMOVUPS Y10, (SI)(AX*1)
+	VMOVUPS Y11, (DI)(AX*1)
+
+	ADDQ $32, AX
+	SUBQ $8, CX
+	JMP  split32_vec_loop
+
+split32_tail:
+	TESTQ CX, CX
+	JZ    split32_done
+
+	VMOVSS (R8)(AX*1), X0
+	VMOVSS (R9)(AX*1), X1
+	VMOVSS (R10)(AX*1), X2
+	VMOVSS (R11)(AX*1), X3
+	VMOVSS (R12)(AX*1), X8
+	VMOVSS (R13)(AX*1), X9
+
+	VADDSS X2, X0, X4
+	VADDSS X3, X1, X5
+	VSUBSS X2, X0, X6
+	VSUBSS X3, X1, X7
+
+	VMULSS X9, X7, X10
+	VFMSUB231SS X8, X6, X10
+	VMULSS X8, X7, X11
+	VFMADD231SS X9, X6, X11
+
+	VMOVSS X4, (BX)(AX*1)
+	VMOVSS X5, (DX)(AX*1)
+	VMOVSS X10, (SI)(AX*1)
+	VMOVSS X11, (DI)(AX*1)
+
+	ADDQ $4, AX
+	DECQ CX
+	JMP  split32_tail
+
+split32_done:
+	VZEROUPPER
+	RET
+
+// ===========================================================================
+// SplitButterfliesFloat64AVX2Asm - 4 butterflies per iteration
+// ===========================================================================
+TEXT ·SplitButterfliesFloat64AVX2Asm(SB), NOSPLIT, $0-240
+	MOVQ loRe+0(FP), BX
+	MOVQ loIm+24(FP), DX
+	MOVQ hiRe+48(FP), SI
+	MOVQ hiIm+72(FP), DI
+	MOVQ aRe+96(FP), R8
+	MOVQ aIm+120(FP), R9
+	MOVQ bRe+144(FP), R10
+	MOVQ bIm+168(FP), R11
+	MOVQ twRe+192(FP), R12
+	MOVQ twIm+216(FP), R13
+	MOVQ aRe_len+104(FP), CX  // CX = remaining elements
+	XORQ AX, AX               // AX = byte offset
+
+split64_vec_loop:
+	CMPQ CX, $4
+	JL   split64_tail
+
+	VMOVUPD (R8)(AX*1), Y0      // aRe
+	VMOVUPD (R9)(AX*1), Y1      // aIm
+	VMOVUPD (R10)(AX*1), Y2     // bRe
+	VMOVUPD (R11)(AX*1), Y3     // bIm
+	VMOVUPD (R12)(AX*1), Y8     // wr
+	VMOVUPD (R13)(AX*1), Y9     // wi
+
+	VADDPD Y2, Y0, Y4        // loRe = aRe + bRe
+	VADDPD Y3, Y1, Y5        // loIm = aIm + bIm
+	VSUBPD Y2, Y0, Y6        // dr = aRe - bRe
+	VSUBPD Y3, Y1, Y7        // di = aIm - bIm
+
+	VMULPD Y9, Y7, Y10       // di*wi
+	VFMSUB231PD Y8, Y6, Y10  // hiRe = dr*wr - di*wi
+	VMULPD Y8, Y7, Y11       // di*wr
+	VFMADD231PD Y9, Y6, Y11  // hiIm = dr*wi + di*wr
+
+	VMOVUPD Y4, (BX)(AX*1)
+	VMOVUPD Y5, (DX)(AX*1)
+	VMOVUPD Y10, (SI)(AX*1)
+	VMOVUPD Y11, (DI)(AX*1)
+
+	ADDQ $32, AX
+	SUBQ $4, CX
+	JMP  split64_vec_loop
+
+split64_tail:
+	TESTQ CX, CX
+	JZ    split64_done
+
+	VMOVSD (R8)(AX*1), X0
+	VMOVSD (R9)(AX*1), X1
+	VMOVSD (R10)(AX*1), X2
+	VMOVSD (R11)(AX*1), X3
+	VMOVSD (R12)(AX*1), X8
+	VMOVSD (R13)(AX*1), X9
+
+	VADDSD X2, X0, X4
+	VADDSD X3, X1, X5
+	VSUBSD X2, X0, X6
+	VSUBSD X3, X1, X7
+
+	VMULSD X9, X7, X10
+	VFMSUB231SD X8, X6, X10
+	VMULSD X8, X7, X11
+	VFMADD231SD X9, X6, X11
+
+	VMOVSD X4, (BX)(AX*1)
+	VMOVSD X5, (DX)(AX*1)
+	VMOVSD X10, (SI)(AX*1)
+	VMOVSD X11, (DI)(AX*1)
+
+	ADDQ $8, AX
+	DECQ CX
+	JMP  split64_tail
+
+split64_done:
+	VZEROUPPER
+	RET
@@ -542,6 +542,76 @@ func Butterfly5ForwardAVX2Complex64(y0, y1, y2, y3, y4, a0, a1, a2, a3, a4 []com
 //go:noescape
 func Butterfly5InverseAVX2Complex64(y0, y1, y2, y3, y4, a0, a1, a2, a3, a4 []complex64)
 
+// ============================================================================
+// Radix-7 FFT Butterfly Operations
+// ============================================================================
+
+// Butterfly7ForwardAVX2Complex64 processes 4 radix-7 forward butterflies in parallel.
+// Input j of butterfly b is a[4*j+b]; outputs use the same layout in y.
+// Both slices must have length >= 28.
+//
+//go:noescape
+func Butterfly7ForwardAVX2Complex64(y, a []complex64)
+
+// Butterfly7InverseAVX2Complex64 processes 4 radix-7 inverse butterflies in parallel,
+// without the 1/7 scaling. Both slices must have length >= 28.
+//
+//go:noescape
+func Butterfly7InverseAVX2Complex64(y, a []complex64)
+
+// ============================================================================
+// Radix-11 FFT Butterfly Operations
+// ============================================================================
+
+// Butterfly11ForwardAVX2Complex64 processes 4 radix-11 forward butterflies in parallel.
+// Input j of butterfly b is a[4*j+b]; outputs use the same layout in y.
+// Both slices must have length >= 44.
+//
+//go:noescape
+func Butterfly11ForwardAVX2Complex64(y, a []complex64)
+
+// Butterfly11InverseAVX2Complex64 processes 4 radix-11 inverse butterflies in parallel,
+// without the 1/11 scaling. Both slices must have length >= 44.
+//
+//go:noescape
+func Butterfly11InverseAVX2Complex64(y, a []complex64)
+
+// ============================================================================
+// Radix-13 FFT Butterfly Operations
+// ============================================================================
+
+// Butterfly13ForwardAVX2Complex64 processes 4 radix-13 forward butterflies in parallel.
+// Input j of butterfly b is a[4*j+b]; outputs use the same layout in y.
+// Both slices must have length >= 52.
+//
+//go:noescape
+func Butterfly13ForwardAVX2Complex64(y, a []complex64)
+
+// Butterfly13InverseAVX2Complex64 processes 4 radix-13 inverse butterflies in parallel,
+// without the 1/13 scaling. Both slices must have length >= 52.
+//
+//go:noescape
+func Butterfly13InverseAVX2Complex64(y, a []complex64)
+
+// ============================================================================
+// Radix-7/11/13 Stage Loads and Stores
+// ============================================================================
+
+// PrimeRadixLoadAVX2Complex64 packs the twiddled inputs of four radix-r
+// butterflies of a mixed-radix stage, columns k..k+3:
+// a[4*j+b] = twiddle[j*(k+b)*step] * input[j*span+k+b], with conjugated
+// twiddles if inverse. It does nothing if a slice is too short.
+//
+//go:noescape
+func PrimeRadixLoadAVX2Complex64(a, input, twiddle []complex64, radix, span, k, step int, inverse bool)
+
+// PrimeRadixStoreAVX2Complex64 writes the packed outputs of four radix-r
+// butterflies back to columns k..k+3: dst[q*span+k+b] = y[4*q+b]. It does
+// nothing if a slice is too short.
+//
+//go:noescape
+func PrimeRadixStoreAVX2Complex64(dst, y []complex64, radix, span, k int)
+
 // ============================================================================
 // Size-384 Mixed-Radix (128×3) FFT Operations
 // ============================================================================
@@ -603,3 +673,19 @@ func Radix3Butterflies384ForwardComplex128Asm(data []complex128)
 //
 //go:noescape
 func Radix3Butterflies384InverseComplex128Asm(data []complex128)
+
+// ============================================================================
+// Split-Complex Operations
+// ============================================================================
+
+// SplitButterfliesFloat32AVX2Asm computes radix-2 Stockham butterflies on
+// split-complex float32 data: lo = a + b, hi = (a - b) * tw, for len(aRe)
+// elements.
+//
+//go:noescape
+func SplitButterfliesFloat32AVX2Asm(loRe, loIm, hiRe, hiIm, aRe, aIm, bRe, bIm, twRe, twIm []float32)
+
+// SplitButterfliesFloat64AVX2Asm is the float64 variant of SplitButterfliesFloat32AVX2Asm.
+//
+//go:noescape
+func SplitButterfliesFloat64AVX2Asm(loRe, loIm, hiRe, hiIm, aRe, aIm, bRe, bIm, twRe, twIm []float64)
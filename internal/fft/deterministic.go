@@ -0,0 +1,317 @@
+package fft
+
+// The deterministic kernel gives bit-identical results on every platform.
+//
+// The SIMD kernels, codelets and pure Go kernels order their operations
+// differently, and the Go compiler contracts x*y + z into a fused
+// multiply-add on arm64 (and on amd64 with GOAMD64=v3), so the same plan can
+// differ in the last bits from one machine to the next. The deterministic
+// kernel runs one fixed recursion per schedule in plain Go and rounds every
+// complex128 product explicitly; an explicit conversion keeps the compiler
+// from fusing it. complex64 products need no such care: Go forms them in
+// float64, where the products of float32 values are exact.
+
+// deterministicRadixOrder is the order in which DeterministicRadices takes
+// factors out of n.
+var deterministicRadixOrder = [...]int{4, 2, 3, 5, 7, 11, 13}
+
+// DeterministicRadices returns the schedule the deterministic kernel runs for
+// n, outermost stage first: fours, at most one two, then odd primes in
+// ascending order. It returns nil if n < 1 or n has a prime factor above
+// MixedRadixMaxRadix; such sizes need Bluestein's algorithm.
+func DeterministicRadices(n int) []int {
+	if n < 1 {
+		return nil
+	}
+
+	radices := []int{}
+
+	for _, r := range deterministicRadixOrder {
+		for n%r == 0 {
+			radices = append(radices, r)
+			n /= r
+		}
+	}
+
+	if n != 1 {
+		return nil
+	}
+
+	return radices
+}
+
+// DeterministicForward computes a forward FFT with the deterministic kernel
+// and the given radix schedule, whose product must equal len(src). Every
+// radix must be in [2, MixedRadixMaxRadix]. dst may alias src, in which case
+// src is first copied to scratch.
+func DeterministicForward[T Complex](dst, src, twiddle, scratch []T, radices []int) bool {
+	return deterministicTransform(dst, src, twiddle, scratch, radices, false)
+}
+
+// DeterministicInverse is the inverse of DeterministicForward, including the
+// 1/n scaling.
+func DeterministicInverse[T Complex](dst, src, twiddle, scratch []T, radices []int) bool {
+	return deterministicTransform(dst, src, twiddle, scratch, radices, true)
+}
+
+func deterministicTransform[T Complex](dst, src, twiddle, scratch []T, radices []int, inverse bool) bool {
+	n := len(src)
+	if n == 0 {
+		return true
+	}
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n {
+		return false
+	}
+
+	for _, r := range radices {
+		if r < 2 || r > MixedRadixMaxRadix {
+			return false
+		}
+	}
+
+	if sameSlice(dst, src) {
+		copy(scratch[:n], src)
+		src = scratch[:n]
+	}
+
+	switch d := any(dst).(type) {
+	case []complex64:
+		deterministicComplex64(d[:n], any(src).([]complex64), any(twiddle).([]complex64), radices, inverse) //nolint:forcetypeassert
+	case []complex128:
+		deterministicComplex128(d[:n], any(src).([]complex128), any(twiddle).([]complex128), radices, inverse) //nolint:forcetypeassert
+	default:
+		return false
+	}
+
+	return true
+}
+
+func deterministicComplex64(dst, src, twiddle []complex64, radices []int, inverse bool) {
+	n := len(src)
+	deterministicStepComplex64(dst, src, n, 1, 1, radices, twiddle, inverse)
+
+	if inverse {
+		scale := complex(float32(1/float64(n)), 0)
+		for i := range dst {
+			dst[i] *= scale
+		}
+	}
+}
+
+func deterministicComplex128(dst, src, twiddle []complex128, radices []int, inverse bool) {
+	n := len(src)
+	deterministicStepComplex128(dst, src, n, 1, 1, radices, twiddle, inverse)
+
+	if inverse {
+		scale := 1 / float64(n)
+		for i, v := range dst {
+			dst[i] = complex(real(v)*scale, imag(v)*scale)
+		}
+	}
+}
+
+// deterministicStepComplex64 computes the size-n DFT of src[0], src[stride],
+// ... into dst: it transforms the radices[0] decimated subsequences into
+// consecutive blocks of dst, then combines them in place with radix-r
+// butterflies. twiddle[x*step] is W_n^x.
+func deterministicStepComplex64(dst, src []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse bool) {
+	if n == 1 {
+		dst[0] = src[0]
+		return
+	}
+
+	radix := radices[0]
+	span := n / radix
+
+	for j := range radix {
+		if span == 1 {
+			dst[j] = src[j*stride]
+		} else {
+			deterministicStepComplex64(dst[j*span:], src[j*stride:], span, stride*radix, step*radix, radices[1:], twiddle, inverse)
+		}
+	}
+
+	var vals [MixedRadixMaxRadix]complex64
+
+	// twiddle[span*step] is the primitive radix-th root of unity.
+	rootStep := span * step
+
+	for k := range span {
+		vals[0] = dst[k]
+
+		for j := 1; j < radix; j++ {
+			w := twiddle[j*k*step]
+			if inverse {
+				w = complex(real(w), -imag(w))
+			}
+
+			vals[j] = w * dst[j*span+k]
+		}
+
+		switch radix {
+		case 2:
+			dst[k] = vals[0] + vals[1]
+			dst[span+k] = vals[0] - vals[1]
+		case 4:
+			t0 := vals[0] + vals[2]
+			t1 := vals[0] - vals[2]
+			t2 := vals[1] + vals[3]
+			t3 := vals[1] - vals[3]
+
+			// Multiply t3 by -i (forward) or i (inverse) exactly.
+			if inverse {
+				t3 = complex(-imag(t3), real(t3))
+			} else {
+				t3 = complex(imag(t3), -real(t3))
+			}
+
+			dst[k] = t0 + t2
+			dst[span+k] = t1 + t3
+			dst[2*span+k] = t0 - t2
+			dst[3*span+k] = t1 - t3
+		default:
+			for q := range radix {
+				sum := vals[0]
+
+				for j := 1; j < radix; j++ {
+					w := twiddle[((j*q)%radix)*rootStep]
+					if inverse {
+						w = complex(real(w), -imag(w))
+					}
+
+					sum += w * vals[j]
+				}
+
+				dst[q*span+k] = sum
+			}
+		}
+	}
+}
+
+// deterministicStepComplex128 is deterministicStepComplex64 for complex128,
+// with every product rounded by MulRoundedComplex128.
+func deterministicStepComplex128(dst, src []complex128, n, stride, step int, radices []int, twiddle []complex128, inverse bool) {
+	if n == 1 {
+		dst[0] = src[0]
+		return
+	}
+
+	radix := radices[0]
+	span := n / radix
+
+	for j := range radix {
+		if span == 1 {
+			dst[j] = src[j*stride]
+		} else {
+			deterministicStepComplex128(dst[j*span:], src[j*stride:], span, stride*radix, step*radix, radices[1:], twiddle, inverse)
+		}
+	}
+
+	var vals [MixedRadixMaxRadix]complex128
+
+	// twiddle[span*step] is the primitive radix-th root of unity.
+	rootStep := span * step
+
+	for k := range span {
+		vals[0] = dst[k]
+
+		for j := 1; j < radix; j++ {
+			w := twiddle[j*k*step]
+			if inverse {
+				w = complex(real(w), -imag(w))
+			}
+
+			vals[j] = MulRoundedComplex128(w, dst[j*span+k])
+		}
+
+		switch radix {
+		case 2:
+			dst[k] = vals[0] + vals[1]
+			dst[span+k] = vals[0] - vals[1]
+		case 4:
+			t0 := vals[0] + vals[2]
+			t1 := vals[0] - vals[2]
+			t2 := vals[1] + vals[3]
+			t3 := vals[1] - vals[3]
+
+			// Multiply t3 by -i (forward) or i (inverse) exactly.
+			if inverse {
+				t3 = complex(-imag(t3), real(t3))
+			} else {
+				t3 = complex(imag(t3), -real(t3))
+			}
+
+			dst[k] = t0 + t2
+			dst[span+k] = t1 + t3
+			dst[2*span+k] = t0 - t2
+			dst[3*span+k] = t1 - t3
+		default:
+			for q := range radix {
+				sum := vals[0]
+
+				for j := 1; j < radix; j++ {
+					w := twiddle[((j*q)%radix)*rootStep]
+					if inverse {
+						w = complex(real(w), -imag(w))
+					}
+
+					sum += MulRoundedComplex128(w, vals[j])
+				}
+
+				dst[q*span+k] = sum
+			}
+		}
+	}
+}
+
+// MulRoundedComplex128 returns a*b with both products of each part rounded
+// before they are combined, so the compiler cannot contract them into a
+// fused multiply-add. On platforms without contraction it returns the same
+// bits as a*b.
+func MulRoundedComplex128(a, b complex128) complex128 {
+	ar, ai := real(a), imag(a)
+	br, bi := real(b), imag(b)
+
+	return complex(float64(ar*br)-float64(ai*bi), float64(ar*bi)+float64(ai*br))
+}
+
+// DeterministicMul computes dst[i] = a[i] * b[i] for i < len(dst) with the
+// rounding of the deterministic kernel.
+func DeterministicMul[T Complex](dst, a, b []T) {
+	switch d := any(dst).(type) {
+	case []complex64:
+		a64 := any(a).([]complex64) //nolint:forcetypeassert
+		b64 := any(b).([]complex64) //nolint:forcetypeassert
+
+		for i := range d {
+			d[i] = a64[i] * b64[i]
+		}
+	case []complex128:
+		a128 := any(a).([]complex128) //nolint:forcetypeassert
+		b128 := any(b).([]complex128) //nolint:forcetypeassert
+
+		for i := range d {
+			d[i] = MulRoundedComplex128(a128[i], b128[i])
+		}
+	}
+}
+
+// DeterministicBluesteinFilter is ComputeBluesteinFilter computed with the
+// deterministic kernel: the forward FFT of size m of the conjugated chirp,
+// wrapped around. radices is the deterministic schedule of m.
+func DeterministicBluesteinFilter[T Complex](n, m int, chirp, twiddle, scratch []T, radices []int) []T {
+	b := ComputeBluesteinSequence(n, m, chirp)
+	DeterministicForward(b, b, twiddle, scratch, radices)
+
+	return b
+}
+
+// DeterministicConvolve is BluesteinConvolution with the deterministic
+// kernel, in place on x: x is replaced by the inverse FFT of FFT(x)*filter.
+// x, filter, twiddle and scratch have the size m whose schedule is radices.
+func DeterministicConvolve[T Complex](x, filter, twiddle, scratch []T, radices []int) {
+	DeterministicForward(x, x, twiddle, scratch, radices)
+	DeterministicMul(x, x, filter)
+	DeterministicInverse(x, x, twiddle, scratch, radices)
+}
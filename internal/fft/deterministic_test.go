@@ -0,0 +1,149 @@
+package fft
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	mathpkg "github.com/MeKo-Christian/algo-fft/internal/math"
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+func TestDeterministicRadices(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n    int
+		want []int
+	}{
+		{0, nil},
+		{1, []int{}},
+		{8, []int{4, 2}},
+		{64, []int{4, 4, 4}},
+		{360, []int{4, 2, 3, 3, 5}},
+		{1001, []int{7, 11, 13}},
+		{17, nil},
+		{2 * 19, nil},
+	}
+
+	for _, tt := range tests {
+		got := DeterministicRadices(tt.n)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) || (got == nil) != (tt.want == nil) {
+			t.Errorf("DeterministicRadices(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDeterministicTransform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n       int
+		radices []int
+	}{
+		{1, []int{}},
+		{2, []int{2}},
+		{16, []int{4, 4}},
+		{24, []int{4, 2, 3}},
+		{60, []int{3, 4, 5}},
+		{77, []int{7, 11}},
+		{128, []int{16, 8}},
+		{1001, []int{7, 11, 13}},
+	}
+
+	for _, tt := range tests {
+		src := make([]complex128, tt.n)
+		for i := range src {
+			src[i] = complex(float64(i%7)-3, float64(i%5)*0.5)
+		}
+
+		twiddle := mathpkg.ComputeTwiddleFactorsOctant[complex128](tt.n)
+		scratch := make([]complex128, tt.n)
+		dst := make([]complex128, tt.n)
+
+		if !DeterministicForward(dst, src, twiddle, scratch, tt.radices) {
+			t.Fatalf("n=%d: DeterministicForward failed", tt.n)
+		}
+
+		ref := reference.NaiveDFT128(src)
+		for i := range dst {
+			if cmplx.Abs(dst[i]-ref[i]) > 1e-9 {
+				t.Fatalf("n=%d radices=%v: bin %d = %v, want %v", tt.n, tt.radices, i, dst[i], ref[i])
+			}
+		}
+
+		// In place inverse.
+		if !DeterministicInverse(dst, dst, twiddle, scratch, tt.radices) {
+			t.Fatalf("n=%d: DeterministicInverse failed", tt.n)
+		}
+
+		for i := range dst {
+			if cmplx.Abs(dst[i]-src[i]) > 1e-12 {
+				t.Fatalf("n=%d: round trip [%d] = %v, want %v", tt.n, i, dst[i], src[i])
+			}
+		}
+
+		src64 := make([]complex64, tt.n)
+		for i, v := range src {
+			src64[i] = complex64(v)
+		}
+
+		dst64 := make([]complex64, tt.n)
+		if !DeterministicForward(dst64, src64, mathpkg.ComputeTwiddleFactorsOctant[complex64](tt.n),
+			make([]complex64, tt.n), tt.radices) {
+			t.Fatalf("n=%d: DeterministicForward[complex64] failed", tt.n)
+		}
+
+		for i := range dst64 {
+			if cmplx.Abs(complex128(dst64[i])-ref[i]) > 1e-3 {
+				t.Fatalf("n=%d: complex64 bin %d = %v, want %v", tt.n, i, dst64[i], ref[i])
+			}
+		}
+	}
+
+	if DeterministicForward(make([]complex64, 4), make([]complex64, 4), make([]complex64, 4),
+		make([]complex64, 4), []int{17}) {
+		t.Error("DeterministicForward accepted radix 17")
+	}
+}
+
+func TestDeterministicConvolve(t *testing.T) {
+	t.Parallel()
+
+	// Bluestein of a prime size through the deterministic pieces.
+	const n, m = 13, 32
+
+	chirp := mathpkg.ComputeChirpSequenceOctant[complex128](n)
+	twiddle := mathpkg.ComputeTwiddleFactorsOctant[complex128](m)
+	radices := DeterministicRadices(m)
+	scratch := make([]complex128, m)
+	filter := DeterministicBluesteinFilter(n, m, chirp, twiddle, scratch, radices)
+
+	src := make([]complex128, n)
+	for i := range src {
+		src[i] = complex(float64(i), -float64(i*i%5))
+	}
+
+	work := make([]complex128, m)
+	DeterministicMul(work[:n], src, chirp)
+	DeterministicConvolve(work, filter, twiddle, scratch, radices)
+
+	dst := make([]complex128, n)
+	DeterministicMul(dst, work[:n], chirp)
+
+	ref := reference.NaiveDFT128(src)
+	for i := range dst {
+		if cmplx.Abs(dst[i]-ref[i]) > 1e-9 {
+			t.Fatalf("bin %d = %v, want %v", i, dst[i], ref[i])
+		}
+	}
+}
+
+func TestMulRoundedComplex128(t *testing.T) {
+	t.Parallel()
+
+	a, b := complex(1.5, -2.25), complex(-0.75, 3.125)
+	if got, want := MulRoundedComplex128(a, b), a*b; got != want {
+		t.Errorf("MulRoundedComplex128(%v, %v) = %v, want %v", a, b, got, want)
+	}
+}
@@ -27,13 +27,16 @@ const (
 
 // Re-export kernel strategy constants from planner.
 const (
-	KernelAuto      = planner.KernelAuto
-	KernelDIT       = planner.KernelDIT
-	KernelStockham  = planner.KernelStockham
-	KernelSixStep   = planner.KernelSixStep
-	KernelEightStep = planner.KernelEightStep
-	KernelBluestein = planner.KernelBluestein
-	KernelRecursive = planner.KernelRecursive
+	KernelAuto       = planner.KernelAuto
+	KernelDIT        = planner.KernelDIT
+	KernelStockham   = planner.KernelStockham
+	KernelSixStep    = planner.KernelSixStep
+	KernelEightStep  = planner.KernelEightStep
+	KernelBluestein  = planner.KernelBluestein
+	KernelRecursive  = planner.KernelRecursive
+	KernelRader      = planner.KernelRader
+	KernelPFA        = planner.KernelPFA
+	KernelSplitRadix = planner.KernelSplitRadix
 )
 
 // Re-export functions and variables from planner.
@@ -45,6 +48,10 @@ var (
 	DefaultWisdom           = planner.DefaultWisdom
 	NewWisdom               = planner.NewWisdom
 	CPUFeatureMask          = planner.CPUFeatureMask
+	RadixScheduleName       = planner.RadixScheduleName
+	PFAFactors              = planner.PFAFactors
+	BluesteinSize           = planner.BluesteinSize
+	SupportsSIMD            = planner.SupportsSIMD
 )
 
 // Wrapper functions for generic planner functions.
@@ -67,6 +74,10 @@ func TwiddleFactorsRecursive[T Complex](strategy *DecomposeStrategy) []T {
 	return transform.TwiddleFactorsRecursive[T](strategy)
 }
 
+func TwiddleFactorsRecursiveMode[T Complex](strategy *DecomposeStrategy, mode math.TwiddleMode) []T {
+	return transform.TwiddleFactorsRecursiveMode[T](strategy, mode)
+}
+
 var ScratchSizeRecursive = transform.ScratchSizeRecursive
 
 func RecursiveForward[T Complex](
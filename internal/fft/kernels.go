@@ -127,6 +127,10 @@ func ComputeChirpSequence[T Complex](n int) []T {
 	return kernels.ComputeChirpSequence[T](n)
 }
 
+func ComputeBluesteinSequence[T Complex](n, m int, chirp []T) []T {
+	return kernels.ComputeBluesteinSequence[T](n, m, chirp)
+}
+
 func ComputeBluesteinFilter[T Complex](n, m int, chirp []T, twiddles []T, scratch []T) []T {
 	return kernels.ComputeBluesteinFilter[T](n, m, chirp, twiddles, scratch)
 }
@@ -135,6 +139,50 @@ func BluesteinConvolution[T Complex](dst, x, filter, twiddles, scratch []T) {
 	kernels.BluesteinConvolution[T](dst, x, filter, twiddles, scratch)
 }
 
+func BluesteinConvolutionBitrev[T Complex](dst, x, filter, twiddles, scratch []T, bitrev []int) {
+	kernels.BluesteinConvolutionBitrev[T](dst, x, filter, twiddles, scratch, bitrev)
+}
+
+func RaderIndices(p int) ([]int, []int) {
+	return kernels.RaderIndices(p)
+}
+
+func ComputeRaderSequence[T Complex](twiddles []T, scatter []int, inverse bool) []T {
+	return kernels.ComputeRaderSequence[T](twiddles, scatter, inverse)
+}
+
+func RaderGather[T Complex](work, src []T, gather []int) T {
+	return kernels.RaderGather[T](work, src, gather)
+}
+
+func RaderScatter[T Complex](dst, work []T, scatter []int, x0, sum T) {
+	kernels.RaderScatter[T](dst, work, scatter, x0, sum)
+}
+
+func RaderScatterScaled[T Complex](dst, work []T, scatter []int, x0, sum, scale T) {
+	kernels.RaderScatterScaled[T](dst, work, scatter, x0, sum, scale)
+}
+
+func PFAIndices(n1, n2 int) ([]int, []int) {
+	return kernels.PFAIndices(n1, n2)
+}
+
+func PFAGather[T Complex](work, src []T, input []int) {
+	kernels.PFAGather[T](work, src, input)
+}
+
+func PFAStoreColumn[T Complex](dst, line []T, col, cols int) {
+	kernels.PFAStoreColumn[T](dst, line, col, cols)
+}
+
+func PFAModule[T Complex](size int) func(line []T) {
+	return kernels.PFAModule[T](size)
+}
+
+func PFAScatterScaled[T Complex](dst, work []T, output []int, scale T, reverse bool) {
+	kernels.PFAScatterScaled[T](dst, work, output, scale, reverse)
+}
+
 func GetRegistry[T Complex]() *CodeletRegistry[T] {
 	return planner.GetRegistry[T]()
 }
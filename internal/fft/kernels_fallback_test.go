@@ -92,6 +92,7 @@ func TestAutoKernelComplex64_PowerOf2(t *testing.T) {
 		{"Auto_Large", 2048, planner.KernelAuto},
 		{"SixStep_1024", 1024, planner.KernelSixStep},
 		{"EightStep_1024", 1024, planner.KernelEightStep},
+		{"SplitRadix_1024", 1024, planner.KernelSplitRadix},
 	}
 
 	for _, tt := range tests {
@@ -166,7 +167,7 @@ func TestAutoKernelComplex64_NonComposite(t *testing.T) {
 	t.Parallel()
 
 	// Prime numbers and non-highly-composite non-power-of-2 sizes
-	sizes := []int{7, 11, 13, 17}
+	sizes := []int{17, 19, 23, 34}
 
 	for _, size := range sizes {
 		t.Run("NonComposite_"+string(rune(size)), func(t *testing.T) {
@@ -211,6 +212,7 @@ func TestAutoKernelComplex128(t *testing.T) {
 		{"MixedRadix_12", 12, planner.KernelAuto},
 		{"SixStep_1024", 1024, planner.KernelSixStep},
 		{"EightStep_1024", 1024, planner.KernelEightStep},
+		{"SplitRadix_1024", 1024, planner.KernelSplitRadix},
 	}
 
 	for _, tt := range tests {
@@ -247,7 +249,7 @@ func TestAutoKernelComplex128(t *testing.T) {
 func TestAutoKernelComplex128_NonComposite(t *testing.T) {
 	t.Parallel()
 
-	size := 7 // Prime number
+	size := 17 // Prime number
 
 	input := make([]complex128, size)
 	for i := range input {
@@ -263,12 +265,12 @@ func TestAutoKernelComplex128_NonComposite(t *testing.T) {
 	// Should fail for prime size
 	ok := kernels.Forward(output, input, twiddle, scratch)
 	if ok {
-		t.Error("autoKernelComplex128 should fail for prime size 7, but succeeded")
+		t.Error("autoKernelComplex128 should fail for prime size 17, but succeeded")
 	}
 
 	ok = kernels.Inverse(output, input, twiddle, scratch)
 	if ok {
-		t.Error("autoKernelComplex128 inverse should fail for prime size 7, but succeeded")
+		t.Error("autoKernelComplex128 inverse should fail for prime size 17, but succeeded")
 	}
 }
 
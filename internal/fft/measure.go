@@ -32,7 +32,10 @@ type WisdomRecorder interface {
 type MeasureResult struct {
 	Strategy  KernelStrategy
 	Algorithm string
-	NsPerOp   float64
+	Radices   []int // Mixed-radix schedule, nil for strategy results
+	// BluesteinM is the convolution length of a Bluestein result, else 0
+	BluesteinM int
+	NsPerOp    float64
 }
 
 // measureConfig holds configuration for benchmarking based on planner mode.
@@ -61,9 +64,22 @@ func getMeasureConfig(mode PlannerMode) measureConfig {
 
 // selectStrategiesToTest returns the strategies to benchmark based on planner mode.
 func selectStrategiesToTest(mode PlannerMode, n int) []KernelStrategy {
-	// For non-power-of-two sizes, only Bluestein is available
+	// Other sizes need a convolution: Bluestein always works, prime sizes can
+	// also run Rader and sizes with coprime factors PFA
 	if !m.IsPowerOf2(n) && !m.IsHighlyComposite(n) {
-		return []KernelStrategy{KernelBluestein}
+		var strategies []KernelStrategy
+
+		if mode != PlannerEstimate {
+			if planner.CanUseRader(n) {
+				strategies = append(strategies, KernelRader)
+			}
+
+			if planner.CanUsePFA(n) {
+				strategies = append(strategies, KernelPFA)
+			}
+		}
+
+		return append(strategies, KernelBluestein)
 	}
 
 	switch mode {
@@ -78,14 +94,16 @@ func selectStrategiesToTest(mode PlannerMode, n int) []KernelStrategy {
 		return []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep}
 	case PlannerExhaustive:
 		// Thorough: test all power-of-two strategies
-		return []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep, KernelEightStep}
+		return []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep, KernelEightStep, KernelSplitRadix}
 	}
 
 	return []KernelStrategy{KernelDIT, KernelStockham}
 }
 
 // MeasureAndSelect benchmarks multiple strategies and returns the best one.
-// It optionally records the result to the provided wisdom recorder.
+// For mixed-radix sizes it compares radix schedules instead (see
+// searchesRadixSchedules). It optionally records the result to the provided
+// wisdom recorder.
 func MeasureAndSelect[T Complex](
 	n int,
 	features cpu.Features,
@@ -99,19 +117,52 @@ func MeasureAndSelect[T Complex](
 	}
 
 	strategies := selectStrategiesToTest(mode, n)
-	if len(strategies) == 0 {
-		return estimateWithStrategy[T](n, features, KernelAuto)
-	}
+	searchSchedules := searchesRadixSchedules[T](n, features, mode)
+
+	if !searchSchedules {
+		if len(strategies) == 0 {
+			return estimateWithStrategy[T](n, features, KernelAuto)
+		}
 
-	// Single strategy? Just use it directly
-	if len(strategies) == 1 {
-		return estimateWithStrategy[T](n, features, strategies[0])
+		// Single strategy? Just use it directly. Bluestein's algorithm still
+		// compares convolution lengths.
+		if len(strategies) == 1 && strategies[0] != KernelBluestein {
+			return estimateWithStrategy[T](n, features, strategies[0])
+		}
 	}
 
 	config := getMeasureConfig(mode)
 	results := make([]MeasureResult, 0, len(strategies))
 
+	// Mixed-radix sizes run the same kernel under every strategy, so only
+	// their radix schedules are worth comparing, and the prime-factor
+	// algorithm, which splits them into smaller kernels. A codelet is bound
+	// ahead of any schedule and of Rader's and Bluestein's algorithms, so
+	// when there is one it takes their place; power-of-two codelets compete
+	// with the strategies.
+	hasCodelet := !searchSchedules && HasCodelet[T](n, features)
+
+	switch {
+	case searchSchedules:
+		results = append(results, measureRadixSchedules[T](n, mode, config)...)
+	case hasCodelet:
+		results = append(results, measureCodelet[T](n, features, config)...)
+	}
+
+	if (searchSchedules || hasCodelet) && !m.IsPowerOf2(n) {
+		strategies = nil
+
+		if planner.CanUsePFA(n) {
+			strategies = []KernelStrategy{KernelPFA}
+		}
+	}
+
 	for _, strategy := range strategies {
+		if strategy == KernelBluestein {
+			results = append(results, measureBluesteinSizes[T](n, features, mode, config)...)
+			continue
+		}
+
 		elapsed := benchmarkStrategy[T](n, features, strategy, config)
 		if elapsed > 0 {
 			results = append(results, MeasureResult{
@@ -137,6 +188,22 @@ func MeasureAndSelect[T Complex](
 	// Record to wisdom if recorder is provided
 	recordToWisdom[T](n, features, wisdom, best.Algorithm)
 
+	if best.Radices != nil {
+		return PlanEstimate[T]{
+			Strategy:  KernelDIT,
+			Algorithm: best.Algorithm,
+			Radices:   best.Radices,
+		}
+	}
+
+	if best.BluesteinM != 0 {
+		return PlanEstimate[T]{
+			Strategy:   KernelBluestein,
+			Algorithm:  best.Algorithm,
+			BluesteinM: best.BluesteinM,
+		}
+	}
+
 	return estimateWithStrategy[T](n, features, best.Strategy)
 }
 
@@ -184,6 +251,15 @@ func benchmarkStrategy[T Complex](
 	strategy KernelStrategy,
 	config measureConfig,
 ) time.Duration {
+	switch strategy {
+	case KernelRader:
+		return benchmarkRader[T](n, features, config)
+	case KernelBluestein:
+		return benchmarkBluestein[T](n, planner.BluesteinSize(n), features, config)
+	case KernelPFA:
+		return benchmarkPFA[T](n, features, config)
+	}
+
 	// Prepare data buffers
 	src := make([]T, n)
 	dst := make([]T, n)
@@ -225,6 +301,22 @@ func estimateWithStrategy[T Complex](
 	features cpu.Features,
 	strategy KernelStrategy,
 ) PlanEstimate[T] {
+	if strategy == KernelRader {
+		return planner.ConvolutionEstimate[T](n, strategy)
+	}
+
+	// A forced PFA on a size without coprime factors is ignored
+	if strategy == KernelPFA {
+		if planner.CanUsePFA(n) {
+			return PlanEstimate[T]{
+				Strategy:  strategy,
+				Algorithm: planner.StrategyToAlgorithmName(strategy),
+			}
+		}
+
+		strategy = KernelAuto
+	}
+
 	// Check for codelets first
 	registry := GetRegistry[T]()
 	if registry != nil {
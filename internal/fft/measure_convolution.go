@@ -0,0 +1,200 @@
+package fft
+
+import (
+	"math"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+	"github.com/MeKo-Christian/algo-fft/internal/planner"
+)
+
+// benchmarkRader is benchmarkStrategy for Rader's algorithm on the prime n.
+func benchmarkRader[T Complex](n int, features cpu.Features, config measureConfig) time.Duration {
+	transform := raderMeasure[T](n, features)
+	if transform == nil {
+		return 0
+	}
+
+	src, dst := benchmarkBuffers[T](n)
+
+	return timeTransform(config, func() bool {
+		return transform(dst, src)
+	})
+}
+
+// raderMeasure returns Rader's algorithm on the prime n as a benchmark
+// transform. The convolution of length n-1 runs on the kernels the plan
+// would use for that size; it returns nil if there are none.
+func raderMeasure[T Complex](n int, features cpu.Features) func(dst, src []T) bool {
+	gather, scatter := RaderIndices(n)
+	if gather == nil {
+		return nil
+	}
+
+	size := n - 1
+	kernels := SelectKernelsWithStrategy[T](features, KernelAuto)
+	twiddle := ComputeTwiddleFactors[T](size)
+	scratch := make([]T, size)
+	work := make([]T, size)
+
+	filter := ComputeRaderSequence(ComputeTwiddleFactors[T](n), scatter, false)
+	if !kernels.Forward(filter, filter, twiddle, scratch) {
+		return nil
+	}
+
+	return func(dst, src []T) bool {
+		x0 := RaderGather(work, src, gather)
+		if !kernels.Forward(work, work, twiddle, scratch) {
+			return false
+		}
+
+		sum := work[0]
+		for i := range work {
+			work[i] *= filter[i]
+		}
+
+		if !kernels.Inverse(work, work, twiddle, scratch) {
+			return false
+		}
+
+		RaderScatter(dst, work, scatter, x0, sum)
+
+		return true
+	}
+}
+
+// benchmarkBluestein is benchmarkStrategy for Bluestein's algorithm with
+// the convolution length size.
+func benchmarkBluestein[T Complex](n, size int, features cpu.Features, config measureConfig) time.Duration {
+	transform := bluesteinMeasure[T](n, size, features)
+	if transform == nil {
+		return 0
+	}
+
+	src, dst := benchmarkBuffers[T](n)
+
+	return timeTransform(config, func() bool {
+		return transform(dst, src)
+	})
+}
+
+// bluesteinMeasure returns Bluestein's algorithm for n with the convolution
+// length size as a benchmark transform. Like raderMeasure, the convolution
+// runs on the kernels the plan would use for that size; it returns nil if
+// there are none.
+func bluesteinMeasure[T Complex](n, size int, features cpu.Features) func(dst, src []T) bool {
+	kernels := SelectKernelsWithStrategy[T](features, KernelAuto)
+	chirp := ComputeChirpSequence[T](n)
+	twiddle := ComputeTwiddleFactors[T](size)
+	scratch := make([]T, size)
+	work := make([]T, size)
+
+	filter := ComputeBluesteinSequence(n, size, chirp)
+	if !kernels.Forward(filter, filter, twiddle, scratch) {
+		return nil
+	}
+
+	return func(dst, src []T) bool {
+		for i := range n {
+			work[i] = src[i] * chirp[i]
+		}
+
+		clear(work[n:])
+
+		if !kernels.Forward(work, work, twiddle, scratch) {
+			return false
+		}
+
+		for i := range work {
+			work[i] *= filter[i]
+		}
+
+		if !kernels.Inverse(work, work, twiddle, scratch) {
+			return false
+		}
+
+		for i := range n {
+			dst[i] = work[i] * chirp[i]
+		}
+
+		return true
+	}
+}
+
+// measureBluesteinSizes benchmarks Bluestein's algorithm for n with the
+// cheapest convolution lengths of BluesteinSizes, as many as mode allows,
+// and the power of two.
+func measureBluesteinSizes[T Complex](n int, features cpu.Features, mode PlannerMode, config measureConfig) []MeasureResult {
+	sizes := planner.BluesteinSizes(n)
+	if limit := bluesteinSizesToTest(mode); limit < len(sizes) {
+		pow2 := m.NextPowerOfTwo(2*n - 1)
+
+		sizes = sizes[:limit]
+		if !slices.Contains(sizes, pow2) {
+			sizes = append(sizes, pow2)
+		}
+	}
+
+	results := make([]MeasureResult, 0, len(sizes))
+
+	for _, size := range sizes {
+		elapsed := benchmarkBluestein[T](n, size, features, config)
+		if elapsed > 0 {
+			results = append(results, MeasureResult{
+				Strategy:   KernelBluestein,
+				Algorithm:  planner.BluesteinAlgorithmName(size),
+				BluesteinM: size,
+				NsPerOp:    float64(elapsed.Nanoseconds()) / float64(config.iters),
+			})
+		}
+	}
+
+	return results
+}
+
+// bluesteinSizesToTest returns how many convolution lengths
+// measureBluesteinSizes tries in mode, besides the power of two.
+func bluesteinSizesToTest(mode PlannerMode) int {
+	switch mode {
+	case PlannerPatient:
+		return 4
+	case PlannerExhaustive:
+		return math.MaxInt
+	default:
+		return 2
+	}
+}
+
+// benchmarkBuffers returns a source filled with the benchmark pattern and a
+// destination of size n.
+func benchmarkBuffers[T Complex](n int) ([]T, []T) {
+	src := make([]T, n)
+	for i := range src {
+		src[i] = complexFromFloat64[T](float64(i%16)/16.0, float64((i+1)%16)/16.0)
+	}
+
+	return src, make([]T, n)
+}
+
+// timeTransform runs transform config.warmup times, then returns the time of
+// config.iters runs, or 0 if a warmup run fails.
+func timeTransform(config measureConfig, transform func() bool) time.Duration {
+	for range config.warmup {
+		if !transform() {
+			return 0
+		}
+	}
+
+	runtime.GC()
+
+	start := time.Now()
+
+	for range config.iters {
+		transform()
+	}
+
+	return time.Since(start)
+}
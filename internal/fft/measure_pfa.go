@@ -0,0 +1,115 @@
+package fft
+
+import (
+	"time"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/planner"
+)
+
+// benchmarkPFA is benchmarkStrategy for the prime-factor algorithm. It
+// returns 0 if a factor would need Bluestein's algorithm.
+func benchmarkPFA[T Complex](n int, features cpu.Features, config measureConfig) time.Duration {
+	transform := pfaMeasure[T](n, features)
+	if transform == nil {
+		return 0
+	}
+
+	src, dst := benchmarkBuffers[T](n)
+
+	return timeTransform(config, func() bool {
+		return transform(dst, src)
+	})
+}
+
+// pfaMeasure returns the prime-factor algorithm on n as a benchmark
+// transform, with each factor running the transform its plan would pick by
+// default. It returns nil if n has no coprime factors or a factor has no
+// such transform.
+func pfaMeasure[T Complex](n int, features cpu.Features) func(dst, src []T) bool {
+	n1, n2 := PFAFactors(n)
+	if n1 == 0 {
+		return nil
+	}
+
+	rows1 := pfaMeasureLine[T](n1, features)
+	rows2 := pfaMeasureLine[T](n2, features)
+
+	if rows1 == nil || rows2 == nil {
+		return nil
+	}
+
+	input, output := PFAIndices(n1, n2)
+	work := make([]T, n)
+	line := make([]T, n1)
+
+	return func(dst, src []T) bool {
+		for j2 := range n2 {
+			PFAGather(line, src, input[j2*n1:(j2+1)*n1])
+
+			if !rows1(line) {
+				return false
+			}
+
+			PFAStoreColumn(work, line, j2, n2)
+		}
+
+		for k1 := range n1 {
+			row := work[k1*n2 : (k1+1)*n2]
+			if !rows2(row) {
+				return false
+			}
+
+			PFAScatterScaled(dst, row, output[k1*n2:(k1+1)*n2], 1, false)
+		}
+
+		return true
+	}
+}
+
+// pfaMeasureLine returns the in-place line transform the prime-factor
+// algorithm runs for a factor of size n: its radix butterfly if there is
+// one and the factor's default transform otherwise.
+func pfaMeasureLine[T Complex](n int, features cpu.Features) func(line []T) bool {
+	if module := PFAModule[T](n); module != nil {
+		return func(line []T) bool {
+			module(line)
+			return true
+		}
+	}
+
+	transform := measureTransform[T](n, features)
+	if transform == nil {
+		return nil
+	}
+
+	return func(line []T) bool {
+		return transform(line, line)
+	}
+}
+
+// measureTransform returns the forward transform an estimated plan of size
+// n runs, for benchmarking the factors of composite algorithms: PFA, Rader
+// or the kernels. It returns nil for sizes that would need Bluestein's
+// algorithm.
+func measureTransform[T Complex](n int, features cpu.Features) func(dst, src []T) bool {
+	switch {
+	case planner.PrefersPFA(n):
+		return pfaMeasure[T](n, features)
+	case planner.PrefersRader(n):
+		return raderMeasure[T](n, features)
+	}
+
+	kernels := SelectKernelsWithStrategy[T](features, KernelAuto)
+	twiddle := ComputeTwiddleFactors[T](n)
+	scratch := make([]T, n)
+
+	probe := make([]T, n)
+	if !kernels.Forward(probe, probe, twiddle, scratch) {
+		return nil
+	}
+
+	return func(dst, src []T) bool {
+		return kernels.Forward(dst, src, twiddle, scratch)
+	}
+}
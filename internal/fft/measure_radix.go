@@ -0,0 +1,187 @@
+package fft
+
+import (
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// radixPreferences are the radix orders used to build alternative schedules.
+// Each one is applied greedily: the first radix that divides the remaining
+// size becomes the next stage.
+var radixPreferences = [][]int{
+	{4, 2, 3, 5, 7, 11, 13},
+	{8, 4, 2, 3, 5, 7, 11, 13},
+	{2, 3, 5, 7, 11, 13},
+	{13, 11, 7, 5, 3, 8, 4, 2},
+	{16, 8, 4, 2, 3, 5, 7, 11, 13},
+}
+
+// radixScheduleLimit returns how many schedules a planner mode benchmarks.
+func radixScheduleLimit(mode PlannerMode) int {
+	switch mode {
+	case PlannerMeasure:
+		return 4
+	case PlannerPatient:
+		return 8
+	case PlannerExhaustive:
+		return 16
+	}
+
+	return 1
+}
+
+// searchesRadixSchedules reports whether the measuring planner benchmarks
+// radix schedules for n: mixed-radix sizes always, power-of-two sizes
+// without a codelet in exhaustive mode.
+func searchesRadixSchedules[T Complex](n int, features cpu.Features, mode PlannerMode) bool {
+	if n < 4 || HasCodelet[T](n, features) {
+		return false
+	}
+
+	if m.IsPowerOf2(n) {
+		return mode == PlannerExhaustive
+	}
+
+	return m.IsHighlyComposite(n)
+}
+
+// radixScheduleCandidates returns up to limit distinct schedules for n,
+// starting with the default one.
+func radixScheduleCandidates[T Complex](n, limit int) [][]int {
+	var candidates [][]int
+
+	add := func(radices []int) {
+		if len(candidates) >= limit || len(radices) == 0 || len(radices) > mixedRadixMaxStages {
+			return
+		}
+
+		for _, c := range candidates {
+			if slices.Equal(c, radices) {
+				return
+			}
+		}
+
+		candidates = append(candidates, radices)
+	}
+
+	add(MixedRadixSchedule[T](n))
+
+	for _, prefs := range radixPreferences {
+		radices := greedyRadixSchedule(n, prefs)
+		add(radices)
+
+		reversed := slices.Clone(radices)
+		slices.Reverse(reversed)
+		add(reversed)
+	}
+
+	return candidates
+}
+
+// greedyRadixSchedule factors n by repeatedly taking the first radix in prefs
+// that divides what is left. It returns nil if n does not fully factor.
+func greedyRadixSchedule(n int, prefs []int) []int {
+	var radices []int
+
+	for n > 1 {
+		idx := slices.IndexFunc(prefs, func(r int) bool { return n%r == 0 })
+		if idx < 0 {
+			return nil
+		}
+
+		radices = append(radices, prefs[idx])
+		n /= prefs[idx]
+	}
+
+	return radices
+}
+
+// measureRadixSchedules benchmarks each candidate schedule and returns the
+// results of those that ran.
+func measureRadixSchedules[T Complex](n int, mode PlannerMode, config measureConfig) []MeasureResult {
+	candidates := radixScheduleCandidates[T](n, radixScheduleLimit(mode))
+	results := make([]MeasureResult, 0, len(candidates))
+
+	for _, radices := range candidates {
+		elapsed := benchmarkRadixSchedule[T](n, radices, config)
+		if elapsed > 0 {
+			results = append(results, MeasureResult{
+				Strategy:  KernelDIT,
+				Algorithm: RadixScheduleName(radices),
+				Radices:   radices,
+				NsPerOp:   float64(elapsed.Nanoseconds()) / float64(config.iters),
+			})
+		}
+	}
+
+	return results
+}
+
+// benchmarkRadixSchedule is benchmarkStrategy for an explicit schedule.
+func benchmarkRadixSchedule[T Complex](n int, radices []int, config measureConfig) time.Duration {
+	src := make([]T, n)
+	dst := make([]T, n)
+	twiddle := ComputeTwiddleFactors[T](n)
+	scratch := make([]T, n)
+
+	for i := range src {
+		src[i] = complexFromFloat64[T](float64(i%16)/16.0, float64((i+1)%16)/16.0)
+	}
+
+	for range config.warmup {
+		if !MixedRadixForwardSchedule(dst, src, twiddle, scratch, radices) {
+			return 0
+		}
+	}
+
+	runtime.GC()
+
+	start := time.Now()
+
+	for range config.iters {
+		MixedRadixForwardSchedule(dst, src, twiddle, scratch, radices)
+	}
+
+	return time.Since(start)
+}
+
+// measureCodelet benchmarks the codelet EstimatePlan binds for n.
+func measureCodelet[T Complex](n int, features cpu.Features, config measureConfig) []MeasureResult {
+	entry := GetRegistry[T]().Lookup(n, features)
+	if entry == nil || entry.Forward == nil {
+		return nil
+	}
+
+	src := make([]T, n)
+	dst := make([]T, n)
+	twiddle := ComputeTwiddleFactors[T](n)
+	scratch := make([]T, n)
+
+	for i := range src {
+		src[i] = complexFromFloat64[T](float64(i%16)/16.0, float64((i+1)%16)/16.0)
+	}
+
+	for range config.warmup {
+		entry.Forward(dst, src, twiddle, scratch)
+	}
+
+	runtime.GC()
+
+	start := time.Now()
+
+	for range config.iters {
+		entry.Forward(dst, src, twiddle, scratch)
+	}
+
+	elapsed := time.Since(start)
+
+	return []MeasureResult{{
+		Strategy:  entry.Algorithm,
+		Algorithm: entry.Signature,
+		NsPerOp:   float64(elapsed.Nanoseconds()) / float64(config.iters),
+	}}
+}
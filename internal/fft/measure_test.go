@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/planner"
 )
 
 // mockWisdomRecorder records wisdom entries for testing.
@@ -51,12 +52,24 @@ func TestSelectStrategiesToTest(t *testing.T) {
 			name:     "Exhaustive mode power-of-two",
 			mode:     PlannerExhaustive,
 			n:        1024,
-			expected: []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep, KernelEightStep},
+			expected: []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep, KernelEightStep, KernelSplitRadix},
 		},
 		{
-			name:     "Prime size uses Bluestein only",
-			mode:     PlannerExhaustive,
+			name:     "Prime size tests Rader and Bluestein",
+			mode:     PlannerMeasure,
 			n:        17,
+			expected: []KernelStrategy{KernelRader, KernelBluestein},
+		},
+		{
+			name:     "Coprime non-smooth size tests PFA and Bluestein",
+			mode:     PlannerExhaustive,
+			n:        34,
+			expected: []KernelStrategy{KernelPFA, KernelBluestein},
+		},
+		{
+			name:     "Prime power non-smooth size uses Bluestein only",
+			mode:     PlannerExhaustive,
+			n:        17 * 17,
 			expected: []KernelStrategy{KernelBluestein},
 		},
 	}
@@ -120,6 +133,10 @@ func TestBenchmarkStrategy(t *testing.T) {
 		{"Stockham 64", 64, KernelStockham},
 		{"DIT 256", 256, KernelDIT},
 		{"Stockham 256", 256, KernelStockham},
+		{"Rader 97", 97, KernelRader},
+		{"Bluestein 97", 97, KernelBluestein},
+		{"PFA 1001", 1001, KernelPFA},
+		{"PFA 60", 60, KernelPFA},
 	}
 
 	for _, tt := range tests {
@@ -328,3 +345,122 @@ func TestWisdomEntry_Timestamp(t *testing.T) {
 		t.Errorf("timestamp %v not between %v and %v", ts, before, after)
 	}
 }
+
+func TestMeasureAndSelect_Prime(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.DetectFeatures()
+	recorder := &mockWisdomRecorder{}
+
+	estimate := MeasureAndSelect[complex64](257, features, PlannerMeasure, recorder, KernelAuto)
+
+	if estimate.Strategy != KernelRader && estimate.Strategy != KernelBluestein {
+		t.Fatalf("MeasureAndSelect(257) strategy = %v, want Rader or Bluestein", estimate.Strategy)
+	}
+
+	if len(recorder.entries) != 1 || recorder.entries[0].Algorithm != estimate.Algorithm {
+		t.Fatalf("wisdom entries = %+v, want one for %q", recorder.entries, estimate.Algorithm)
+	}
+
+	// The recorded choice is what the estimate planner replays.
+	replay := EstimatePlan[complex64](257, features, recorder, KernelAuto)
+	if replay.Strategy != estimate.Strategy {
+		t.Errorf("EstimatePlan with wisdom = %v, want %v", replay.Strategy, estimate.Strategy)
+	}
+}
+
+func TestMeasureAndSelect_PFA(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.DetectFeatures()
+
+	for _, n := range []int{77, 60} {
+		recorder := &mockWisdomRecorder{}
+
+		estimate := MeasureAndSelect[complex64](n, features, PlannerMeasure, recorder, KernelAuto)
+		if len(recorder.entries) != 1 || recorder.entries[0].Algorithm != estimate.Algorithm {
+			t.Fatalf("MeasureAndSelect(%d): wisdom entries = %+v, want one for %q", n, recorder.entries, estimate.Algorithm)
+		}
+
+		replay := EstimatePlan[complex64](n, features, recorder, KernelAuto)
+		if replay.Strategy != estimate.Strategy || replay.Algorithm != estimate.Algorithm {
+			t.Errorf("EstimatePlan(%d) with wisdom = %v (%q), want %v (%q)", n,
+				replay.Strategy, replay.Algorithm, estimate.Strategy, estimate.Algorithm)
+		}
+	}
+
+	if estimate := MeasureAndSelect[complex64](64, features, PlannerMeasure, nil, KernelPFA); estimate.Strategy == KernelPFA {
+		t.Errorf("MeasureAndSelect(64) forced PFA = %v, want the power-of-two plan", estimate.Strategy)
+	}
+}
+
+func TestMeasureAndSelect_MixedRadixCodelet(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.DetectFeatures()
+
+	const n = 60
+
+	entry := GetRegistry[complex64]().Lookup(n, features)
+	if entry == nil {
+		t.Skip("no codelet for size 60")
+	}
+
+	estimate := MeasureAndSelect[complex64](n, features, PlannerPatient, nil, KernelAuto)
+
+	switch estimate.Algorithm {
+	case entry.Signature:
+		if estimate.ForwardCodelet == nil {
+			t.Errorf("MeasureAndSelect(%d) chose %q without binding it", n, entry.Signature)
+		}
+	case planner.StrategyToAlgorithmName(KernelPFA):
+	default:
+		t.Errorf("MeasureAndSelect(%d) = %q, want %q or pfa", n, estimate.Algorithm, entry.Signature)
+	}
+}
+
+func TestMeasureAndSelect_BluesteinSize(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.DetectFeatures()
+	config := measureConfig{warmup: 1, iters: 3}
+
+	const n = 17 * 17 // only Bluestein's algorithm applies
+
+	results := measureBluesteinSizes[complex64](n, features, PlannerPatient, config)
+	if len(results) != 4 {
+		t.Fatalf("measureBluesteinSizes(%d) = %d results, want the four cheapest sizes", n, len(results))
+	}
+
+	hasPow2 := false
+
+	for _, result := range results {
+		if result.Strategy != KernelBluestein || result.BluesteinM < 2*n-1 || result.NsPerOp <= 0 ||
+			result.Algorithm != planner.BluesteinAlgorithmName(result.BluesteinM) {
+			t.Errorf("result %+v, want a Bluestein size", result)
+		}
+
+		hasPow2 = hasPow2 || result.BluesteinM == 1024
+	}
+
+	if !hasPow2 {
+		t.Errorf("results %+v do not include the power of two", results)
+	}
+
+	recorder := &mockWisdomRecorder{}
+
+	estimate := MeasureAndSelect[complex64](n, features, PlannerMeasure, recorder, KernelAuto)
+	if estimate.Strategy != KernelBluestein || estimate.Algorithm != planner.BluesteinAlgorithmName(estimate.BluesteinM) {
+		t.Fatalf("MeasureAndSelect(%d) = %v (%q) with M = %d, want a Bluestein size", n,
+			estimate.Strategy, estimate.Algorithm, estimate.BluesteinM)
+	}
+
+	if len(recorder.entries) != 1 || recorder.entries[0].Algorithm != estimate.Algorithm {
+		t.Fatalf("wisdom entries = %+v, want one for %q", recorder.entries, estimate.Algorithm)
+	}
+
+	replay := EstimatePlan[complex64](n, features, recorder, KernelAuto)
+	if replay.BluesteinM != estimate.BluesteinM {
+		t.Errorf("EstimatePlan with wisdom: M = %d, want %d", replay.BluesteinM, estimate.BluesteinM)
+	}
+}
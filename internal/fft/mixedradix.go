@@ -1,9 +1,19 @@
 package fft
 
-import "github.com/MeKo-Christian/algo-fft/internal/kernels"
+import (
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/kernels"
+)
 
 const mixedRadixMaxStages = 64
 
+// MixedRadixMaxRadix is the largest radix the mixed-radix kernel accepts in
+// a schedule. Radices without a dedicated butterfly run a direct DFT.
+const MixedRadixMaxRadix = 16
+
+// MixedRadixMaxStages is the longest schedule the mixed-radix kernel accepts.
+const MixedRadixMaxStages = mixedRadixMaxStages
+
 func forwardMixedRadixComplex64(dst, src, twiddle, scratch []complex64) bool {
 	return mixedRadixForward[complex64](dst, src, twiddle, scratch)
 }
@@ -28,21 +38,74 @@ func mixedRadixInverse[T Complex](dst, src, twiddle, scratch []T) bool {
 	return mixedRadixTransform(dst, src, twiddle, scratch, true)
 }
 
-// Recursion hooks for SIMD acceleration.
-// By default, these point to the pure Go implementations.
-// SIMD-optimized files (like mixedradix_avx2.go) can override these in init().
-var (
-	recursiveStep64  func(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse bool)
-	recursiveStep128 func(dst, src, work []complex128, n, stride, step int, radices []int, twiddle []complex128, inverse bool)
-)
+func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, inverse bool) bool {
+	n := len(src)
+	if n == 0 {
+		return true
+	}
+
+	var radices [mixedRadixMaxStages]int
 
-//nolint:gochecknoinits
-func init() {
-	recursiveStep64 = mixedRadixRecursivePingPongComplex64
-	recursiveStep128 = mixedRadixRecursivePingPongComplex128
+	stageCount := mixedRadixSchedule(n, &radices, hasMixedRadixLeaf[T])
+	if stageCount == 0 && n > 1 {
+		return false
+	}
+
+	primeAVX2 := kernels.PrimeRadixAVX2Available(cpu.DetectFeatures())
+
+	return mixedRadixTransformSchedule(dst, src, twiddle, scratch, radices[:stageCount], inverse, primeAVX2)
 }
 
-func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, inverse bool) bool {
+// MixedRadixSchedule returns the radix schedule the mixed-radix kernel picks
+// for n, outermost stage first, or nil if n has no such schedule.
+func MixedRadixSchedule[T Complex](n int) []int {
+	var radices [mixedRadixMaxStages]int
+
+	count := mixedRadixSchedule(n, &radices, hasMixedRadixLeaf[T])
+	if count == 0 {
+		return nil
+	}
+
+	return append([]int(nil), radices[:count]...)
+}
+
+// MixedRadixForwardSchedule computes a forward FFT using the given radix
+// schedule, outermost stage first. The product of radices must equal len(src)
+// and every radix must be in [2, MixedRadixMaxRadix].
+func MixedRadixForwardSchedule[T Complex](dst, src, twiddle, scratch []T, radices []int) bool {
+	primeAVX2 := kernels.PrimeRadixAVX2Available(cpu.DetectFeatures())
+
+	return mixedRadixTransformSchedule(dst, src, twiddle, scratch, radices, false, primeAVX2)
+}
+
+// MixedRadixInverseSchedule is the inverse of MixedRadixForwardSchedule,
+// including the 1/n scaling.
+func MixedRadixInverseSchedule[T Complex](dst, src, twiddle, scratch []T, radices []int) bool {
+	primeAVX2 := kernels.PrimeRadixAVX2Available(cpu.DetectFeatures())
+
+	return mixedRadixTransformSchedule(dst, src, twiddle, scratch, radices, true, primeAVX2)
+}
+
+// MixedRadixScheduleCodelets returns forward and inverse codelets running
+// the given schedule like MixedRadixForwardSchedule and
+// MixedRadixInverseSchedule. The radix-7, 11 and 13 stages use AVX2 when
+// features allow it; the choice is made here rather than per call.
+func MixedRadixScheduleCodelets[T Complex](radices []int, features cpu.Features) (forward, inverse CodeletFunc[T]) {
+	primeAVX2 := kernels.PrimeRadixAVX2Available(features)
+
+	forward = func(dst, src, twiddle, scratch []T) {
+		mixedRadixTransformSchedule(dst, src, twiddle, scratch, radices, false, primeAVX2)
+	}
+	inverse = func(dst, src, twiddle, scratch []T) {
+		mixedRadixTransformSchedule(dst, src, twiddle, scratch, radices, true, primeAVX2)
+	}
+
+	return forward, inverse
+}
+
+// mixedRadixTransformSchedule runs a schedule. primeAVX2 selects
+// PrimeRadixStageAVX2Complex64 for the complex64 radix-7, 11 and 13 stages.
+func mixedRadixTransformSchedule[T Complex](dst, src, twiddle, scratch []T, radices []int, inverse, primeAVX2 bool) bool {
 	n := len(src)
 	if n == 0 {
 		return true
@@ -57,28 +120,12 @@ func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, inverse bool
 		return true
 	}
 
-	var (
-		radices    [mixedRadixMaxStages]int
-		hasCodelet func(int) bool
-		zero       T
-	)
-
-	// Determine which registry to check based on type T
-
-	switch any(zero).(type) {
-	case complex64:
-		hasCodelet = kernels.Registry64.Has
-	case complex128:
-		hasCodelet = kernels.Registry128.Has
-	default:
-		hasCodelet = func(int) bool { return false }
-	}
-
-	stageCount := mixedRadixSchedule(n, &radices, hasCodelet)
-	if stageCount == 0 {
+	if len(radices) == 0 || len(radices) > mixedRadixMaxStages {
 		return false
 	}
 
+	var zero T
+
 	work := dst
 	workIsDst := true
 
@@ -94,16 +141,16 @@ func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, inverse bool
 			any(work).([]complex64),    //nolint:forcetypeassert
 			any(src).([]complex64),     //nolint:forcetypeassert
 			any(scratch).([]complex64), //nolint:forcetypeassert
-			n, 1, 1, radices[:stageCount],
+			n, 1, 1, radices,
 			any(twiddle).([]complex64), //nolint:forcetypeassert
-			inverse,
+			inverse, primeAVX2,
 		)
 	case complex128:
 		recursiveStep128(
 			any(work).([]complex128),    //nolint:forcetypeassert
 			any(src).([]complex128),     //nolint:forcetypeassert
 			any(scratch).([]complex128), //nolint:forcetypeassert
-			n, 1, 1, radices[:stageCount],
+			n, 1, 1, radices,
 			any(twiddle).([]complex128), //nolint:forcetypeassert
 			inverse,
 		)
@@ -159,6 +206,15 @@ func mixedRadixSchedule(n int, radices *[mixedRadixMaxStages]int, hasCodelet fun
 		}
 
 		switch {
+		case n%13 == 0:
+			radices[count] = 13
+			n /= 13
+		case n%11 == 0:
+			radices[count] = 11
+			n /= 11
+		case n%7 == 0:
+			radices[count] = 7
+			n /= 7
 		case n%5 == 0:
 			radices[count] = 5
 			n /= 5
@@ -221,9 +277,43 @@ func mixedRadixPermutation(x, n int, radices []int, count int) int {
 	return result
 }
 
+// mixedRadixButterflyGeneric applies a radix-r butterfly at offset k as a
+// direct r-point DFT of the twiddled inputs. It covers radices without a
+// dedicated butterfly; input and dst may alias.
+func mixedRadixButterflyGeneric[T Complex](dst, input, twiddle []T, k, span, step, radix int, inverse bool) {
+	var vals [MixedRadixMaxRadix]T
+
+	for j := range radix {
+		w := twiddle[j*k*step]
+		if inverse {
+			w = conj(w)
+		}
+
+		vals[j] = w * input[j*span+k]
+	}
+
+	// twiddle[span*step] is the primitive radix-th root of unity.
+	rootStep := span * step
+
+	for q := range radix {
+		sum := vals[0]
+
+		for j := 1; j < radix; j++ {
+			w := twiddle[((j*q)%radix)*rootStep]
+			if inverse {
+				w = conj(w)
+			}
+
+			sum += w * vals[j]
+		}
+
+		dst[q*span+k] = sum
+	}
+}
+
 // mixedRadixRecursivePingPongComplex64 is a specialized complex64 version that calls
 // type-specific butterfly functions to avoid generic overhead.
-func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse bool) {
+func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse, primeAVX2 bool) {
 	if n == 1 {
 		dst[0] = src[0]
 		return
@@ -238,7 +328,7 @@ func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride,
 		if len(nextRadices) == 0 {
 			dst[j*span] = src[j*stride]
 		} else {
-			recursiveStep64(work[j*span:], src[j*stride:], dst[j*span:], span, stride*radix, step*radix, nextRadices, twiddle, inverse)
+			recursiveStep64(work[j*span:], src[j*stride:], dst[j*span:], span, stride*radix, step*radix, nextRadices, twiddle, inverse, primeAVX2)
 		}
 	}
 
@@ -250,6 +340,15 @@ func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride,
 		input = work
 	}
 
+	// Radices 7, 11 and 13 run a whole stage of dedicated butterflies.
+	if primeAVX2 && kernels.PrimeRadixStageAVX2Complex64(dst, input, twiddle, radix, span, step, inverse) {
+		return
+	}
+
+	if kernels.PrimeRadixStageComplex64(dst, input, twiddle, radix, span, step, inverse) {
+		return
+	}
+
 	// Apply radix-r butterfly with type-specific functions
 	for k := range span {
 		switch radix {
@@ -346,7 +445,11 @@ func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride,
 			dst[3*span+k] = y3
 			dst[4*span+k] = y4
 		default:
-			return
+			if radix > MixedRadixMaxRadix {
+				return
+			}
+
+			mixedRadixButterflyGeneric(dst, input, twiddle, k, span, step, radix, inverse)
 		}
 	}
 }
@@ -380,6 +483,11 @@ func mixedRadixRecursivePingPongComplex128(dst, src, work []complex128, n, strid
 		input = work
 	}
 
+	// Radices 7, 11 and 13 run a whole stage of dedicated butterflies.
+	if kernels.PrimeRadixStageComplex128(dst, input, twiddle, radix, span, step, inverse) {
+		return
+	}
+
 	// Apply radix-r butterfly with type-specific functions
 	for k := range span {
 		switch radix {
@@ -476,7 +584,11 @@ func mixedRadixRecursivePingPongComplex128(dst, src, work []complex128, n, strid
 			dst[3*span+k] = y3
 			dst[4*span+k] = y4
 		default:
-			return
+			if radix > MixedRadixMaxRadix {
+				return
+			}
+
+			mixedRadixButterflyGeneric(dst, input, twiddle, k, span, step, radix, inverse)
 		}
 	}
 }
@@ -525,6 +637,11 @@ func mixedRadixRecursivePingPong[T Complex](dst, src, work []T, n, stride, step
 		input = work
 	}
 
+	// Radices 7, 11 and 13 run a whole stage of dedicated butterflies.
+	if kernels.PrimeRadixStage(dst, input, twiddle, radix, span, step, inverse) {
+		return
+	}
+
 	// Apply radix-r butterfly, reading from input and writing to dst
 	for k := range span {
 		switch radix {
@@ -621,7 +738,11 @@ func mixedRadixRecursivePingPong[T Complex](dst, src, work []T, n, stride, step
 			dst[3*span+k] = y3
 			dst[4*span+k] = y4
 		default:
-			return
+			if radix > MixedRadixMaxRadix {
+				return
+			}
+
+			mixedRadixButterflyGeneric(dst, input, twiddle, k, span, step, radix, inverse)
 		}
 	}
 }
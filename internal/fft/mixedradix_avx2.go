@@ -7,17 +7,41 @@ import (
 	"github.com/MeKo-Christian/algo-fft/internal/kernels"
 )
 
-func init() {
-	// Override the recursion hooks with AVX2-aware versions.
-	recursiveStep64 = mixedRadixRecursivePingPongComplex64AVX2
-	recursiveStep128 = mixedRadixRecursivePingPongComplex128AVX2
+// recursiveStep64 routes the mixed-radix recursion through the AVX2-aware step.
+func recursiveStep64(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse, primeAVX2 bool) {
+	mixedRadixRecursivePingPongComplex64AVX2(dst, src, work, n, stride, step, radices, twiddle, inverse, primeAVX2)
+}
+
+// recursiveStep128 routes the mixed-radix recursion through the AVX2-aware step.
+func recursiveStep128(dst, src, work []complex128, n, stride, step int, radices []int, twiddle []complex128, inverse bool) {
+	mixedRadixRecursivePingPongComplex128AVX2(dst, src, work, n, stride, step, radices, twiddle, inverse)
+}
+
+// hasMixedRadixLeaf reports whether the AVX2 recursion step can run a size-n
+// leaf with a codelet.
+func hasMixedRadixLeaf[T Complex](n int) bool {
+	var zero T
+
+	features := cpu.DetectFeatures()
+
+	switch any(zero).(type) {
+	case complex64:
+		entry := kernels.Registry64.Lookup(n, features)
+		return entry != nil && entry.SIMDLevel >= kernels.SIMDAVX2
+	case complex128:
+		entry := kernels.Registry128.Lookup(n, features)
+		return entry != nil && entry.SIMDLevel >= kernels.SIMDAVX2
+	default:
+		return false
+	}
 }
 
 // mixedRadixRecursivePingPongComplex64AVX2 checks for AVX2 codelets before recursing.
-func mixedRadixRecursivePingPongComplex64AVX2(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse bool) {
-	// Optimization: check if we have an AVX2 codelet for this sub-transform size.
+func mixedRadixRecursivePingPongComplex64AVX2(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse, primeAVX2 bool) {
+	// Optimization: run a schedule leaf (a single remaining radix) with an AVX2
+	// codelet when one exists. Interior stages always follow the schedule.
 	// We only do this for n > 1 (base cases are handled by the pure Go recursion anyway).
-	if n > 1 {
+	if n > 1 && len(radices) == 1 {
 		features := cpu.DetectFeatures()
 		if entry := kernels.Registry64.Lookup(n, features); entry != nil && entry.SIMDLevel >= kernels.SIMDAVX2 {
 			// Found an AVX2 kernel for this sub-transform!
@@ -69,12 +93,12 @@ func mixedRadixRecursivePingPongComplex64AVX2(dst, src, work []complex64, n, str
 	}
 
 	// Fallback to pure Go implementation.
-	mixedRadixRecursivePingPongComplex64(dst, src, work, n, stride, step, radices, twiddle, inverse)
+	mixedRadixRecursivePingPongComplex64(dst, src, work, n, stride, step, radices, twiddle, inverse, primeAVX2)
 }
 
 // mixedRadixRecursivePingPongComplex128AVX2 is the complex128 version.
 func mixedRadixRecursivePingPongComplex128AVX2(dst, src, work []complex128, n, stride, step int, radices []int, twiddle []complex128, inverse bool) {
-	if n > 1 {
+	if n > 1 && len(radices) == 1 {
 		features := cpu.DetectFeatures()
 		if entry := kernels.Registry128.Lookup(n, features); entry != nil && entry.SIMDLevel >= kernels.SIMDAVX2 {
 			var inputBuf []complex128
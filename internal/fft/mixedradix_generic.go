@@ -0,0 +1,23 @@
+//go:build !amd64 || purego || !asm
+
+package fft
+
+// The recursion steps are plain functions selected by build tags rather than
+// function variables: an indirect call would force the radix schedule in
+// mixedRadixTransform onto the heap on every transform.
+
+// recursiveStep64 is the pure Go mixed-radix recursion step.
+func recursiveStep64(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse, primeAVX2 bool) {
+	mixedRadixRecursivePingPongComplex64(dst, src, work, n, stride, step, radices, twiddle, inverse, primeAVX2)
+}
+
+// recursiveStep128 is the pure Go mixed-radix recursion step.
+func recursiveStep128(dst, src, work []complex128, n, stride, step int, radices []int, twiddle []complex128, inverse bool) {
+	mixedRadixRecursivePingPongComplex128(dst, src, work, n, stride, step, radices, twiddle, inverse)
+}
+
+// hasMixedRadixLeaf reports whether the recursion step can run a size-n leaf
+// with a codelet. The pure Go step has no codelet leaves.
+func hasMixedRadixLeaf[T Complex](int) bool {
+	return false
+}
@@ -85,4 +85,169 @@ func TestMixedRadixComplex128(t *testing.T) {
 			t.Errorf("inverseMixedRadixComplex128 mismatch at %d", i)
 		}
 	}
-}
\ No newline at end of file
+}
+func TestMixedRadixScheduleExplicit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n       int
+		radices []int
+	}{
+		{128, []int{4, 4, 4, 2}},
+		{120, []int{8, 5, 3}},
+		{49, []int{7, 7}},
+		{66, []int{11, 6}},
+		{64, []int{16, 4}},
+	}
+
+	for _, tc := range tests {
+		src := make([]complex128, tc.n)
+		for i := range src {
+			src[i] = complex(float64(i%7)-3, float64(i%5))
+		}
+
+		twiddle := mathpkg.ComputeTwiddleFactors[complex128](tc.n)
+		scratch := make([]complex128, tc.n)
+		dst := make([]complex128, tc.n)
+
+		if !MixedRadixForwardSchedule(dst, src, twiddle, scratch, tc.radices) {
+			t.Fatalf("%v: forward failed", tc.radices)
+		}
+
+		ref := reference.NaiveDFT128(src)
+		for i := range dst {
+			if cmplx.Abs(dst[i]-ref[i]) > 1e-9 {
+				t.Fatalf("%v: forward[%d] = %v, want %v", tc.radices, i, dst[i], ref[i])
+			}
+		}
+
+		if !MixedRadixInverseSchedule(dst, dst, twiddle, scratch, tc.radices) {
+			t.Fatalf("%v: inverse failed", tc.radices)
+		}
+
+		for i := range dst {
+			if cmplx.Abs(dst[i]-src[i]) > 1e-9 {
+				t.Fatalf("%v: roundtrip[%d] = %v, want %v", tc.radices, i, dst[i], src[i])
+			}
+		}
+	}
+}
+
+// TestMixedRadixPrimeRadices covers 7-, 11- and 13-smooth sizes, which run
+// the dedicated prime-radix butterflies.
+func TestMixedRadixPrimeRadices(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{7, 11, 13, 49, 308, 448, 704, 832, 1001} {
+		radices := MixedRadixSchedule[complex128](n)
+
+		product := 1
+		for _, r := range radices {
+			product *= r
+		}
+
+		if product != n {
+			t.Fatalf("n=%d: schedule %v does not multiply to n", n, radices)
+		}
+
+		src := make([]complex128, n)
+		for i := range src {
+			src[i] = complex(float64(i%7)-3, float64(i%5))
+		}
+
+		twiddle := mathpkg.ComputeTwiddleFactors[complex128](n)
+		scratch := make([]complex128, n)
+		dst := make([]complex128, n)
+
+		if !forwardMixedRadixComplex128(dst, src, twiddle, scratch) {
+			t.Fatalf("n=%d: forward failed", n)
+		}
+
+		ref := reference.NaiveDFT128(src)
+		for i := range dst {
+			if cmplx.Abs(dst[i]-ref[i]) > 1e-8 {
+				t.Fatalf("n=%d: forward[%d] = %v, want %v", n, i, dst[i], ref[i])
+			}
+		}
+
+		src64 := make([]complex64, n)
+		for i, v := range src {
+			src64[i] = complex64(v)
+		}
+
+		dst64 := make([]complex64, n)
+		if !forwardMixedRadixComplex64(dst64, src64, mathpkg.ComputeTwiddleFactors[complex64](n), make([]complex64, n)) {
+			t.Fatalf("n=%d: complex64 forward failed", n)
+		}
+
+		for i := range dst64 {
+			if cmplx.Abs(complex128(dst64[i])-ref[i]) > 1e-3*float64(n) {
+				t.Fatalf("n=%d: complex64 forward[%d] = %v, want %v", n, i, dst64[i], ref[i])
+			}
+		}
+
+		if !inverseMixedRadixComplex128(dst, dst, twiddle, scratch) {
+			t.Fatalf("n=%d: inverse failed", n)
+		}
+
+		for i := range dst {
+			if cmplx.Abs(dst[i]-src[i]) > 1e-10 {
+				t.Fatalf("n=%d: roundtrip[%d] = %v, want %v", n, i, dst[i], src[i])
+			}
+		}
+	}
+}
+
+// TestMixedRadixCodeletLeafSizes covers sizes whose default schedule may end
+// in a codelet-sized leaf, e.g. 2560 = 5 * 512.
+func TestMixedRadixCodeletLeafSizes(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{768, 2560} {
+		src := make([]complex64, n)
+		for i := range src {
+			src[i] = complex(float32(i%11)-5, float32(i%3))
+		}
+
+		twiddle := mathpkg.ComputeTwiddleFactors[complex64](n)
+		scratch := make([]complex64, n)
+		dst := make([]complex64, n)
+
+		if !forwardMixedRadixComplex64(dst, src, twiddle, scratch) {
+			t.Fatalf("n=%d: forward failed", n)
+		}
+
+		ref := reference.NaiveDFT(src)
+		for i := range dst {
+			if cmplx.Abs(complex128(dst[i]-ref[i])) > 1e-2 {
+				t.Fatalf("n=%d: forward[%d] = %v, want %v", n, i, dst[i], ref[i])
+			}
+		}
+	}
+}
+
+func TestRadixScheduleCandidates(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{360, 1000, 4096} {
+		candidates := radixScheduleCandidates[complex64](n, 16)
+		if len(candidates) < 2 {
+			t.Fatalf("n=%d: %d candidates, want several", n, len(candidates))
+		}
+
+		for _, radices := range candidates {
+			product := 1
+			for _, r := range radices {
+				product *= r
+			}
+
+			if product != n {
+				t.Fatalf("n=%d: candidate %v multiplies to %d", n, radices, product)
+			}
+		}
+
+		if got := radixScheduleCandidates[complex64](n, 2); len(got) != 2 {
+			t.Fatalf("n=%d: limit 2 returned %d candidates", n, len(got))
+		}
+	}
+}
@@ -1,53 +1,204 @@
 package fft
 
 import (
+	"cmp"
+	"math/bits"
+	"slices"
 	"sync"
 
 	mem "github.com/MeKo-Christian/algo-fft/internal/memory"
 )
 
+// DefaultMaxBytes is the idle-memory cap of DefaultPool.
+const DefaultMaxBytes = 64 << 20
+
 // BufferPool provides pooled allocations for FFT buffers to reduce GC pressure
 // when creating and destroying many Plans with the same size.
 //
-// Buffers are organized by size class (power of 2) for efficient reuse.
-// The pool automatically handles both complex64 and complex128 buffers.
+// Buffers are organized into size classes keyed by element type and length.
+// Idle buffers are kept until they are taken again, evicted to stay under
+// the pool's memory cap, or released with Trim. When the cap is exceeded,
+// buffers of the least recently used size classes are evicted first.
+//
+// The zero value is an empty pool without a memory cap. A BufferPool is safe
+// for concurrent use.
 type BufferPool struct {
-	// pools64 maps FFT size → pool of complex64 aligned buffers
-	pools64 sync.Map // map[int]*sync.Pool
+	mu sync.Mutex
 
-	// pools128 maps FFT size → pool of complex128 aligned buffers
-	pools128 sync.Map // map[int]*sync.Pool
+	maxBytes  int64 // idle-memory cap; 0 means unlimited
+	idleBytes int64
+	clock     uint64 // advances on every Get/Put; drives LRU eviction
 
-	// poolsInt maps FFT size → pool of int slices (for bit-reversal indices)
-	poolsInt sync.Map // map[int]*sync.Pool
+	classes map[sizeClassKey]*sizeClass
 }
 
-// alignedBuffer64 holds an aligned complex64 buffer and its backing storage.
-type alignedBuffer64 struct {
-	data    []complex64
-	backing []byte
+// BufferKind identifies the element type of a size class.
+type BufferKind uint8
+
+const (
+	BufferComplex64 BufferKind = iota
+	BufferComplex128
+	BufferInt
+)
+
+// String returns the Go name of the element type.
+func (k BufferKind) String() string {
+	switch k {
+	case BufferComplex64:
+		return "complex64"
+	case BufferComplex128:
+		return "complex128"
+	case BufferInt:
+		return "int"
+	default:
+		return "unknown"
+	}
 }
 
-// alignedBuffer128 holds an aligned complex128 buffer and its backing storage.
-type alignedBuffer128 struct {
-	data    []complex128
+// elemSize returns the size in bytes of one element of kind k.
+func (k BufferKind) elemSize() int64 {
+	switch k {
+	case BufferComplex64:
+		return 8
+	case BufferComplex128:
+		return 16
+	default:
+		return bits.UintSize / 8
+	}
+}
+
+type sizeClassKey struct {
+	kind BufferKind
+	n    int
+}
+
+// pooledBuffer is an idle buffer; exactly one of the data fields is set.
+type pooledBuffer struct {
+	c64     []complex64
+	c128    []complex128
+	ints    []int
 	backing []byte
 }
 
-// intBuffer holds an int slice for pooling.
-type intBuffer struct {
-	data []int
+type sizeClass struct {
+	idle     []pooledBuffer
+	lastUsed uint64
+
+	gets, hits, puts, evictions uint64
+}
+
+// SizeClassStats describes the activity of one size class of a BufferPool.
+type SizeClassStats struct {
+	Kind BufferKind // element type
+	N    int        // buffer length in elements
+
+	Gets      uint64 // buffers requested
+	Hits      uint64 // requests served from idle buffers
+	Misses    uint64 // requests that allocated a new buffer
+	Puts      uint64 // buffers returned
+	Evictions uint64 // idle buffers dropped by the cap or Trim
+
+	Idle      int   // buffers currently idle
+	IdleBytes int64 // bytes held by idle buffers
+}
+
+// PoolStats is a snapshot of a BufferPool's state.
+type PoolStats struct {
+	MaxBytes  int64            // idle-memory cap (0 = unlimited)
+	IdleBytes int64            // bytes held by idle buffers
+	Classes   []SizeClassStats // sorted by kind, then length
 }
 
 // DefaultPool is the global buffer pool used by NewPlanPooled.
-var DefaultPool = &BufferPool{}
+var DefaultPool = NewBufferPool(DefaultMaxBytes)
+
+// NewBufferPool returns an empty pool that keeps at most maxBytes of idle
+// buffers. maxBytes <= 0 means no cap.
+func NewBufferPool(maxBytes int64) *BufferPool {
+	return &BufferPool{maxBytes: max(maxBytes, 0)}
+}
+
+// MaxBytes returns the idle-memory cap (0 = unlimited).
+func (p *BufferPool) MaxBytes() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.maxBytes
+}
+
+// SetMaxBytes changes the idle-memory cap, evicting idle buffers if the pool
+// currently holds more. maxBytes <= 0 removes the cap.
+func (p *BufferPool) SetMaxBytes(maxBytes int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.maxBytes = max(maxBytes, 0)
+	if p.maxBytes > 0 {
+		p.evictLocked(p.maxBytes)
+	}
+}
+
+// Trim evicts idle buffers, least recently used size classes first, until
+// at most targetBytes remain idle. It returns the number of bytes released.
+// Trim(0) empties the pool.
+func (p *BufferPool) Trim(targetBytes int64) int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.evictLocked(max(targetBytes, 0))
+}
+
+// IdleBytes returns the number of bytes held by idle buffers.
+func (p *BufferPool) IdleBytes() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.idleBytes
+}
+
+// Stats returns a snapshot of the pool's size classes.
+func (p *BufferPool) Stats() PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := PoolStats{
+		MaxBytes:  p.maxBytes,
+		IdleBytes: p.idleBytes,
+		Classes:   make([]SizeClassStats, 0, len(p.classes)),
+	}
+
+	for key, class := range p.classes {
+		stats.Classes = append(stats.Classes, SizeClassStats{
+			Kind:      key.kind,
+			N:         key.n,
+			Gets:      class.gets,
+			Hits:      class.hits,
+			Misses:    class.gets - class.hits,
+			Puts:      class.puts,
+			Evictions: class.evictions,
+			Idle:      len(class.idle),
+			IdleBytes: int64(len(class.idle)) * int64(key.n) * key.kind.elemSize(),
+		})
+	}
+
+	slices.SortFunc(stats.Classes, func(a, b SizeClassStats) int {
+		if c := cmp.Compare(a.Kind, b.Kind); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.N, b.N)
+	})
+
+	return stats
+}
 
 // GetComplex64 retrieves or allocates an aligned complex64 buffer of size n.
 func (p *BufferPool) GetComplex64(n int) ([]complex64, []byte) {
-	pool := p.getOrCreatePool64(n)
-	buf := pool.Get().(*alignedBuffer64)
+	if buf, ok := p.get(BufferComplex64, n); ok {
+		return buf.c64, buf.backing
+	}
 
-	return buf.data, buf.backing
+	return mem.AllocAlignedComplex64(n)
 }
 
 // PutComplex64 returns a complex64 buffer to the pool for reuse.
@@ -57,16 +208,16 @@ func (p *BufferPool) PutComplex64(n int, data []complex64, backing []byte) {
 		return // Wrong size, don't pool
 	}
 
-	pool := p.getOrCreatePool64(n)
-	pool.Put(&alignedBuffer64{data: data, backing: backing})
+	p.put(BufferComplex64, n, pooledBuffer{c64: data, backing: backing})
 }
 
 // GetComplex128 retrieves or allocates an aligned complex128 buffer of size n.
 func (p *BufferPool) GetComplex128(n int) ([]complex128, []byte) {
-	pool := p.getOrCreatePool128(n)
-	buf := pool.Get().(*alignedBuffer128)
+	if buf, ok := p.get(BufferComplex128, n); ok {
+		return buf.c128, buf.backing
+	}
 
-	return buf.data, buf.backing
+	return mem.AllocAlignedComplex128(n)
 }
 
 // PutComplex128 returns a complex128 buffer to the pool for reuse.
@@ -76,16 +227,16 @@ func (p *BufferPool) PutComplex128(n int, data []complex128, backing []byte) {
 		return // Wrong size, don't pool
 	}
 
-	pool := p.getOrCreatePool128(n)
-	pool.Put(&alignedBuffer128{data: data, backing: backing})
+	p.put(BufferComplex128, n, pooledBuffer{c128: data, backing: backing})
 }
 
 // GetIntSlice retrieves or allocates an int slice of size n.
 func (p *BufferPool) GetIntSlice(n int) []int {
-	pool := p.getOrCreatePoolInt(n)
-	buf := pool.Get().(*intBuffer)
+	if buf, ok := p.get(BufferInt, n); ok {
+		return buf.ints
+	}
 
-	return buf.data
+	return make([]int, n)
 }
 
 // PutIntSlice returns an int slice to the pool for reuse.
@@ -94,56 +245,104 @@ func (p *BufferPool) PutIntSlice(n int, data []int) {
 		return // Wrong size, don't pool
 	}
 
-	pool := p.getOrCreatePoolInt(n)
-	pool.Put(&intBuffer{data: data})
+	p.put(BufferInt, n, pooledBuffer{ints: data})
 }
 
-func (p *BufferPool) getOrCreatePool64(n int) *sync.Pool {
-	if existing, ok := p.pools64.Load(n); ok {
-		return existing.(*sync.Pool)
-	}
+// get pops an idle buffer of the given class, if any.
+func (p *BufferPool) get(kind BufferKind, n int) (pooledBuffer, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := sizeClassKey{kind: kind, n: n}
+	class := p.classLocked(key)
+	class.gets++
 
-	pool := &sync.Pool{
-		New: func() any {
-			data, backing := mem.AllocAlignedComplex64(n)
-			return &alignedBuffer64{data: data, backing: backing}
-		},
+	last := len(class.idle) - 1
+	if last < 0 {
+		return pooledBuffer{}, false
 	}
 
-	actual, _ := p.pools64.LoadOrStore(n, pool)
+	buf := class.idle[last]
+	class.idle[last] = pooledBuffer{}
+	class.idle = class.idle[:last]
+	class.hits++
+	p.idleBytes -= int64(n) * kind.elemSize()
 
-	return actual.(*sync.Pool)
+	return buf, true
 }
 
-func (p *BufferPool) getOrCreatePool128(n int) *sync.Pool {
-	if existing, ok := p.pools128.Load(n); ok {
-		return existing.(*sync.Pool)
-	}
+// put stores buf as idle and evicts older buffers if the cap is exceeded.
+func (p *BufferPool) put(kind BufferKind, n int, buf pooledBuffer) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	pool := &sync.Pool{
-		New: func() any {
-			data, backing := mem.AllocAlignedComplex128(n)
-			return &alignedBuffer128{data: data, backing: backing}
-		},
+	key := sizeClassKey{kind: kind, n: n}
+	class := p.classLocked(key)
+	class.puts++
+
+	size := int64(n) * kind.elemSize()
+	if p.maxBytes > 0 && size > p.maxBytes {
+		class.evictions++
+		return // Larger than the whole pool
 	}
 
-	actual, _ := p.pools128.LoadOrStore(n, pool)
+	class.idle = append(class.idle, buf)
+	p.idleBytes += size
 
-	return actual.(*sync.Pool)
+	if p.maxBytes > 0 && p.idleBytes > p.maxBytes {
+		p.evictLocked(p.maxBytes)
+	}
 }
 
-func (p *BufferPool) getOrCreatePoolInt(n int) *sync.Pool {
-	if existing, ok := p.poolsInt.Load(n); ok {
-		return existing.(*sync.Pool)
+// classLocked returns the size class for key, creating it on first use,
+// and marks it as most recently used.
+func (p *BufferPool) classLocked(key sizeClassKey) *sizeClass {
+	if p.classes == nil {
+		p.classes = make(map[sizeClassKey]*sizeClass)
 	}
 
-	pool := &sync.Pool{
-		New: func() any {
-			return &intBuffer{data: make([]int, n)}
-		},
+	class, ok := p.classes[key]
+	if !ok {
+		class = &sizeClass{}
+		p.classes[key] = class
 	}
 
-	actual, _ := p.poolsInt.LoadOrStore(n, pool)
+	p.clock++
+	class.lastUsed = p.clock
+
+	return class
+}
+
+// evictLocked drops idle buffers from the least recently used size classes
+// until at most target bytes remain idle, and returns the bytes dropped.
+func (p *BufferPool) evictLocked(target int64) int64 {
+	var released int64
+
+	for p.idleBytes > target {
+		var (
+			oldestKey sizeClassKey
+			oldest    *sizeClass
+		)
+
+		for key, class := range p.classes {
+			if len(class.idle) > 0 && (oldest == nil || class.lastUsed < oldest.lastUsed) {
+				oldestKey, oldest = key, class
+			}
+		}
+
+		if oldest == nil {
+			break
+		}
+
+		last := len(oldest.idle) - 1
+		oldest.idle[last] = pooledBuffer{}
+		oldest.idle = oldest.idle[:last]
+		oldest.evictions++
+
+		size := int64(oldestKey.n) * oldestKey.kind.elemSize()
+		p.idleBytes -= size
+		released += size
+	}
 
-	return actual.(*sync.Pool)
+	return released
 }
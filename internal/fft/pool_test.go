@@ -223,6 +223,113 @@ func TestDefaultPool(t *testing.T) {
 	DefaultPool.PutComplex64(128, buf, backing)
 }
 
+// TestBufferPool_Stats tests per-size-class counters.
+func TestBufferPool_Stats(t *testing.T) {
+	t.Parallel()
+
+	pool := NewBufferPool(0)
+
+	buf, backing := pool.GetComplex64(64)
+	pool.PutComplex64(64, buf, backing)
+	buf, backing = pool.GetComplex64(64)
+	pool.PutComplex64(64, buf, backing)
+	pool.PutIntSlice(16, pool.GetIntSlice(16))
+
+	stats := pool.Stats()
+	if len(stats.Classes) != 2 {
+		t.Fatalf("got %d size classes, want 2", len(stats.Classes))
+	}
+
+	c64 := stats.Classes[0]
+	if c64.Kind != BufferComplex64 || c64.N != 64 {
+		t.Fatalf("first class = %v/%d, want complex64/64", c64.Kind, c64.N)
+	}
+
+	if c64.Gets != 2 || c64.Hits != 1 || c64.Misses != 1 || c64.Puts != 2 || c64.Idle != 1 {
+		t.Errorf("complex64 stats = %+v", c64)
+	}
+
+	if c64.IdleBytes != 64*8 {
+		t.Errorf("complex64 idle bytes = %d, want %d", c64.IdleBytes, 64*8)
+	}
+
+	if stats.Classes[1].Kind != BufferInt || stats.Classes[1].N != 16 {
+		t.Errorf("second class = %v/%d, want int/16", stats.Classes[1].Kind, stats.Classes[1].N)
+	}
+
+	if want := c64.IdleBytes + stats.Classes[1].IdleBytes; stats.IdleBytes != want || pool.IdleBytes() != want {
+		t.Errorf("pool idle bytes = %d, want %d", stats.IdleBytes, want)
+	}
+}
+
+// TestBufferPool_MaxBytes tests that the cap evicts least recently used classes.
+func TestBufferPool_MaxBytes(t *testing.T) {
+	t.Parallel()
+
+	// Room for exactly two complex128 buffers of 64 elements.
+	pool := NewBufferPool(2 * 64 * 16)
+
+	a, aBacking := pool.GetComplex128(64)
+	b, bBacking := pool.GetComplex128(32)
+	c, cBacking := pool.GetComplex128(64)
+
+	pool.PutComplex128(32, b, bBacking)
+	pool.PutComplex128(64, a, aBacking)
+	pool.PutComplex128(64, c, cBacking) // over the cap: the size-32 class is older
+
+	if got := pool.IdleBytes(); got != 2*64*16 {
+		t.Fatalf("idle bytes = %d, want %d", got, 2*64*16)
+	}
+
+	stats := pool.Stats()
+	if stats.Classes[0].N != 32 || stats.Classes[0].Idle != 0 || stats.Classes[0].Evictions != 1 {
+		t.Errorf("size-32 class = %+v, want evicted", stats.Classes[0])
+	}
+
+	// A buffer larger than the cap is never kept.
+	big, bigBacking := pool.GetComplex128(1024)
+	pool.PutComplex128(1024, big, bigBacking)
+
+	if got := pool.IdleBytes(); got != 2*64*16 {
+		t.Errorf("idle bytes after oversized put = %d, want %d", got, 2*64*16)
+	}
+
+	pool.SetMaxBytes(64 * 16)
+
+	if got := pool.IdleBytes(); got != 64*16 {
+		t.Errorf("idle bytes after SetMaxBytes = %d, want %d", got, 64*16)
+	}
+}
+
+// TestBufferPool_Trim tests explicit eviction.
+func TestBufferPool_Trim(t *testing.T) {
+	t.Parallel()
+
+	pool := &BufferPool{}
+
+	for _, size := range []int{16, 32, 64} {
+		buf, backing := pool.GetComplex64(size)
+		pool.PutComplex64(size, buf, backing)
+	}
+
+	// The size-16 class is the least recently used.
+	if released := pool.Trim(96 * 8); released != 16*8 {
+		t.Errorf("Trim released %d bytes, want %d", released, 16*8)
+	}
+
+	if released := pool.Trim(0); released != 96*8 {
+		t.Errorf("Trim(0) released %d bytes, want %d", released, 96*8)
+	}
+
+	if got := pool.IdleBytes(); got != 0 {
+		t.Errorf("idle bytes after Trim(0) = %d, want 0", got)
+	}
+
+	if _, backing := pool.GetComplex64(64); backing == nil {
+		t.Error("GetComplex64 after Trim returned nil backing")
+	}
+}
+
 // BenchmarkBufferPool_GetPut benchmarks pool get/put operations.
 func BenchmarkBufferPool_GetPut_Complex64(b *testing.B) {
 	pool := &BufferPool{}
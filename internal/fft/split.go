@@ -0,0 +1,305 @@
+package fft
+
+import (
+	"math"
+
+	"github.com/MeKo-Christian/algo-fft/internal/fftypes"
+	mathpkg "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// Float is a type alias for the real number constraint.
+type Float = fftypes.Float
+
+// splitSIMDMinHalf is the butterfly span below which stages are fused into
+// a per-block pass: shorter spans cannot fill a vector register.
+const splitSIMDMinHalf = 8
+
+// SplitTwiddles holds the tables for the split-complex FFT kernel, with
+// twiddle factors in separate real and imaginary arrays.
+//
+// The stage with butterfly span m uses W_m^j for j = 0..m/2-1, stored
+// contiguously so that vector butterflies can load them directly. Stages
+// are stored for m = n, n/2, ..., 2 (n-1 values in total).
+type SplitTwiddles[F Float] struct {
+	Re []F
+	Im []F
+
+	// Bitrev maps each output index to its position after the DIF stages.
+	Bitrev []int
+
+	// butterflies is the wide-stage kernel, resolved once for this CPU.
+	butterflies splitButterflyFunc[F]
+}
+
+// splitButterflyFunc computes, for every j < len(aRe):
+//
+//	lo[j] = a[j] + b[j]
+//	hi[j] = (a[j] - b[j]) * tw[j]
+type splitButterflyFunc[F Float] func(loRe, loIm, hiRe, hiIm, aRe, aIm, bRe, bIm, twRe, twIm []F)
+
+// NewSplitTwiddles computes split-complex tables for a power-of-two length n.
+func NewSplitTwiddles[F Float](n int) *SplitTwiddles[F] {
+	return newSplitTwiddles(n, splitButterflyKernel[F](), func(j, m int) (float64, float64) {
+		sin, cos := math.Sincos(-2 * math.Pi * float64(j) / float64(m))
+		return cos, sin
+	})
+}
+
+// NewSplitTwiddlesDeterministic is NewSplitTwiddles for deterministic plans:
+// the twiddles come from RootOfUnity and the kernel always runs the pure Go
+// butterflies, so results are the same on every platform.
+func NewSplitTwiddlesDeterministic[F Float](n int) *SplitTwiddles[F] {
+	return newSplitTwiddles(n, splitButterfliesGeneric[F], mathpkg.RootOfUnity)
+}
+
+// newSplitTwiddles builds the tables with root(j, m) = W_m^j as (re, im).
+func newSplitTwiddles[F Float](n int, butterflies splitButterflyFunc[F], root func(j, m int) (float64, float64)) *SplitTwiddles[F] {
+	tw := &SplitTwiddles[F]{
+		Re:          make([]F, max(n-1, 0)),
+		Im:          make([]F, max(n-1, 0)),
+		Bitrev:      mathpkg.ComputeBitReversalIndices(n),
+		butterflies: butterflies,
+	}
+
+	offset := 0
+	for m := n; m >= 2; m >>= 1 {
+		for j := range m / 2 {
+			re, im := root(j, m)
+			tw.Re[offset+j] = F(re)
+			tw.Im[offset+j] = F(im)
+		}
+
+		offset += m / 2
+	}
+
+	return tw
+}
+
+// ForwardSplit computes an unscaled forward FFT on split-complex data.
+//
+// The kernel is a radix-2 decimation-in-frequency FFT. Every wide stage
+// combines two contiguous half-blocks of real and imaginary parts, so its
+// butterflies are purely vertical vector operations (AVX2 assembly where
+// available). The last stages, whose blocks are too short to vectorize,
+// are fused into a single pass over small blocks, and a final bit-reversal
+// permutation writes dst in natural order.
+//
+// The inverse transform (without 1/n) is obtained by swapping the real and
+// imaginary arrays on both input and output.
+//
+// dst may alias src. The scratch slices must have at least n elements.
+// Returns false if n is not a power of two or any slice is too short.
+func ForwardSplit[F Float](dstRe, dstIm, srcRe, srcIm, scratchRe, scratchIm []F, tw *SplitTwiddles[F]) bool {
+	return ForwardSplitScaled(dstRe, dstIm, srcRe, srcIm, scratchRe, scratchIm, tw, 1)
+}
+
+// ForwardSplitScaled is ForwardSplit with the output multiplied by scale
+// during the bit-reversal permutation.
+func ForwardSplitScaled[F Float](dstRe, dstIm, srcRe, srcIm, scratchRe, scratchIm []F, tw *SplitTwiddles[F], scale F) bool {
+	n := len(srcRe)
+	if n == 0 {
+		return true
+	}
+
+	if len(srcIm) < n || len(dstRe) < n || len(dstIm) < n ||
+		len(scratchRe) < n || len(scratchIm) < n || !mathpkg.IsPowerOf2(n) {
+		return false
+	}
+
+	if n == 1 {
+		dstRe[0], dstIm[0] = srcRe[0]*scale, srcIm[0]*scale
+		return true
+	}
+
+	if tw == nil || len(tw.Re) < n-1 || len(tw.Im) < n-1 || len(tw.Bitrev) < n || tw.butterflies == nil {
+		return false
+	}
+
+	workRe, workIm := scratchRe[:n], scratchIm[:n]
+	inRe, inIm := srcRe[:n], srcIm[:n]
+	offset := 0
+	m := n
+
+	// Wide stages: the first reads src, the rest run in place in scratch.
+	for ; m/2 >= splitSIMDMinHalf; m >>= 1 {
+		half := m >> 1
+		twRe := tw.Re[offset : offset+half]
+		twIm := tw.Im[offset : offset+half]
+
+		for base := 0; base < n; base += m {
+			tw.butterflies(
+				workRe[base:base+half], workIm[base:base+half],
+				workRe[base+half:base+m], workIm[base+half:base+m],
+				inRe[base:base+half], inIm[base:base+half],
+				inRe[base+half:base+m], inIm[base+half:base+m],
+				twRe, twIm,
+			)
+		}
+
+		inRe, inIm = workRe, workIm
+		offset += half
+	}
+
+	if m == n {
+		copy(workRe, srcRe[:n])
+		copy(workIm, srcIm[:n])
+	}
+
+	if m == 8 {
+		splitFinishBlocks8(workRe, workIm)
+	} else {
+		splitFinishBlocks(workRe, workIm, tw.Re[offset:], tw.Im[offset:], m)
+	}
+
+	rev := tw.Bitrev[:n]
+	dstRe, dstIm = dstRe[:n], dstIm[:n]
+
+	if scale == 1 {
+		for i, r := range rev {
+			dstRe[i] = workRe[r]
+			dstIm[i] = workIm[r]
+		}
+
+		return true
+	}
+
+	for i, r := range rev {
+		dstRe[i] = workRe[r] * scale
+		dstIm[i] = workIm[r] * scale
+	}
+
+	return true
+}
+
+// splitFinishBlocks runs the remaining DIF stages (spans m, m/2, ..., 2) on
+// every contiguous block of m elements while the block is in registers or
+// L1. tw holds the tables for those stages, starting with span m.
+//
+// Like splitButterfliesGeneric it rounds every product explicitly, so the
+// compiler cannot fuse it into a multiply-add (see deterministic.go).
+func splitFinishBlocks[F Float](re, im, twRe, twIm []F, m int) {
+	n := len(re)
+
+	for base := 0; base < n; base += m {
+		blockRe := re[base : base+m]
+		blockIm := im[base : base+m]
+		offset := 0
+
+		for span := m; span >= 2; span >>= 1 {
+			half := span >> 1
+
+			for sub := 0; sub < m; sub += span {
+				for j := range half {
+					i0, i1 := sub+j, sub+j+half
+					ar, ai := blockRe[i0], blockIm[i0]
+					br, bi := blockRe[i1], blockIm[i1]
+					dr, di := ar-br, ai-bi
+					wr, wi := twRe[offset+j], twIm[offset+j]
+
+					blockRe[i0] = ar + br
+					blockIm[i0] = ai + bi
+					blockRe[i1] = F(dr*wr) - F(di*wi)
+					blockIm[i1] = F(dr*wi) + F(di*wr)
+				}
+			}
+
+			offset += half
+		}
+	}
+}
+
+// splitFinishBlocks8 is splitFinishBlocks for m = 8 with the twiddles
+// W_8^j folded into the arithmetic; its products are rounded explicitly too.
+func splitFinishBlocks8[F Float](re, im []F) {
+	const sqrtHalf = 0.70710678118654752440084436210484903928483593768847
+
+	h := F(sqrtHalf)
+
+	for base := 0; base+8 <= len(re); base += 8 {
+		r := re[base : base+8 : base+8]
+		i := im[base : base+8 : base+8]
+
+		// Span 8: x[j] ± x[j+4], difference times W_8^j.
+		a0r, a0i := r[0]+r[4], i[0]+i[4]
+		a1r, a1i := r[1]+r[5], i[1]+i[5]
+		a2r, a2i := r[2]+r[6], i[2]+i[6]
+		a3r, a3i := r[3]+r[7], i[3]+i[7]
+
+		d0r, d0i := r[0]-r[4], i[0]-i[4]
+		d1r, d1i := r[1]-r[5], i[1]-i[5]
+		d2r, d2i := r[2]-r[6], i[2]-i[6]
+		d3r, d3i := r[3]-r[7], i[3]-i[7]
+
+		b4r, b4i := d0r, d0i
+		b5r, b5i := F(h*(d1r+d1i)), F(h*(d1i-d1r))  // W_8^1
+		b6r, b6i := d2i, -d2r                       // W_8^2 = -i
+		b7r, b7i := F(h*(d3i-d3r)), -F(h*(d3r+d3i)) // W_8^3
+
+		// Span 4 on both halves: x[j] ± x[j+2], difference times W_4^j.
+		c0r, c0i := a0r+a2r, a0i+a2i
+		c1r, c1i := a1r+a3r, a1i+a3i
+		c2r, c2i := a0r-a2r, a0i-a2i
+		c3r, c3i := a1i-a3i, a3r-a1r // (a1-a3) * -i
+
+		c4r, c4i := b4r+b6r, b4i+b6i
+		c5r, c5i := b5r+b7r, b5i+b7i
+		c6r, c6i := b4r-b6r, b4i-b6i
+		c7r, c7i := b5i-b7i, b7r-b5r // (b5-b7) * -i
+
+		// Span 2.
+		r[0], i[0] = c0r+c1r, c0i+c1i
+		r[1], i[1] = c0r-c1r, c0i-c1i
+		r[2], i[2] = c2r+c3r, c2i+c3i
+		r[3], i[3] = c2r-c3r, c2i-c3i
+		r[4], i[4] = c4r+c5r, c4i+c5i
+		r[5], i[5] = c4r-c5r, c4i-c5i
+		r[6], i[6] = c6r+c7r, c6i+c7i
+		r[7], i[7] = c6r-c7r, c6i-c7i
+	}
+}
+
+// splitButterflyKernel returns the fastest butterfly kernel for F on this CPU.
+func splitButterflyKernel[F Float]() splitButterflyFunc[F] {
+	var zero F
+
+	var kernel any
+
+	switch any(zero).(type) {
+	case float32:
+		if k := splitButterfliesFloat32SIMD(); k != nil {
+			kernel = splitButterflyFunc[float32](k)
+		}
+	case float64:
+		if k := splitButterfliesFloat64SIMD(); k != nil {
+			kernel = splitButterflyFunc[float64](k)
+		}
+	}
+
+	if k, ok := kernel.(splitButterflyFunc[F]); ok {
+		return k
+	}
+
+	return splitButterfliesGeneric[F]
+}
+
+// splitButterfliesGeneric is the pure Go butterfly kernel. Its products are
+// rounded explicitly, so deterministic plans get the same bits everywhere.
+func splitButterfliesGeneric[F Float](loRe, loIm, hiRe, hiIm, aRe, aIm, bRe, bIm, twRe, twIm []F) {
+	n := len(aRe)
+	loRe, loIm = loRe[:n], loIm[:n]
+	hiRe, hiIm = hiRe[:n], hiIm[:n]
+	aIm, bRe, bIm = aIm[:n], bRe[:n], bIm[:n]
+	twRe, twIm = twRe[:n], twIm[:n]
+
+	for j := range n {
+		ar, ai := aRe[j], aIm[j]
+		br, bi := bRe[j], bIm[j]
+		dr, di := ar-br, ai-bi
+		wr, wi := twRe[j], twIm[j]
+
+		loRe[j] = ar + br
+		loIm[j] = ai + bi
+		hiRe[j] = F(dr*wr) - F(di*wi)
+		hiIm[j] = F(dr*wi) + F(di*wr)
+	}
+}
@@ -0,0 +1,27 @@
+//go:build amd64 && asm && !purego
+
+package fft
+
+import (
+	amd64 "github.com/MeKo-Christian/algo-fft/internal/asm/amd64"
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+)
+
+// AMD64 SIMD kernels for split-complex radix-2 butterflies.
+// The assembly handles any length, finishing the tail with scalar code.
+
+func splitButterfliesFloat32SIMD() func(loRe, loIm, hiRe, hiIm, aRe, aIm, bRe, bIm, twRe, twIm []float32) {
+	if !cpu.DetectFeatures().HasAVX2 {
+		return nil
+	}
+
+	return amd64.SplitButterfliesFloat32AVX2Asm
+}
+
+func splitButterfliesFloat64SIMD() func(loRe, loIm, hiRe, hiIm, aRe, aIm, bRe, bIm, twRe, twIm []float64) {
+	if !cpu.DetectFeatures().HasAVX2 {
+		return nil
+	}
+
+	return amd64.SplitButterfliesFloat64AVX2Asm
+}
@@ -0,0 +1,15 @@
+//go:build !amd64 || purego || !asm
+
+package fft
+
+// Split-complex SIMD stubs for platforms without optimized implementations
+// or when assembly is disabled. They return nil, selecting the generic
+// butterflies.
+
+func splitButterfliesFloat32SIMD() func(loRe, loIm, hiRe, hiIm, aRe, aIm, bRe, bIm, twRe, twIm []float32) {
+	return nil
+}
+
+func splitButterfliesFloat64SIMD() func(loRe, loIm, hiRe, hiIm, aRe, aIm, bRe, bIm, twRe, twIm []float64) {
+	return nil
+}
@@ -0,0 +1,131 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+func TestForwardSplit_MatchesDFT(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 4, 8, 16, 32, 64, 128, 1024} {
+		t.Run(fmt.Sprintf("float64/%d", n), func(t *testing.T) {
+			t.Parallel()
+			checkForwardSplit[float64](t, n, 1e-9)
+		})
+
+		t.Run(fmt.Sprintf("float32/%d", n), func(t *testing.T) {
+			t.Parallel()
+			checkForwardSplit[float32](t, n, 1e-3)
+		})
+	}
+}
+
+func checkForwardSplit[F Float](t *testing.T, n int, tol float64) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(uint64(n), 31)) //nolint:gosec
+
+	src := make([]complex128, n)
+	srcRe := make([]F, n)
+	srcIm := make([]F, n)
+
+	for i := range src {
+		srcRe[i] = F(rng.Float64()*2 - 1)
+		srcIm[i] = F(rng.Float64()*2 - 1)
+		src[i] = complex(float64(srcRe[i]), float64(srcIm[i]))
+	}
+
+	want := reference.NaiveDFT128(src)
+	tw := NewSplitTwiddles[F](n)
+
+	dstRe := make([]F, n)
+	dstIm := make([]F, n)
+	scratchRe := make([]F, n)
+	scratchIm := make([]F, n)
+
+	if !ForwardSplit(dstRe, dstIm, srcRe, srcIm, scratchRe, scratchIm, tw) {
+		t.Fatalf("ForwardSplit(%d) returned false", n)
+	}
+
+	assertSplitNear(t, dstRe, dstIm, want, tol*float64(n))
+
+	// In place.
+	if !ForwardSplit(srcRe, srcIm, srcRe, srcIm, scratchRe, scratchIm, tw) {
+		t.Fatalf("in-place ForwardSplit(%d) returned false", n)
+	}
+
+	assertSplitNear(t, srcRe, srcIm, want, tol*float64(n))
+
+	// Swapping real and imaginary parts computes the unscaled inverse;
+	// scaling by 1/n recovers the input.
+	if !ForwardSplitScaled(dstIm, dstRe, srcIm, srcRe, scratchRe, scratchIm, tw, 1/F(n)) {
+		t.Fatalf("inverse ForwardSplitScaled(%d) returned false", n)
+	}
+
+	for i := range src {
+		if math.Abs(float64(dstRe[i])-real(src[i])) > tol ||
+			math.Abs(float64(dstIm[i])-imag(src[i])) > tol {
+			t.Fatalf("inverse[%d] = %v+%vi, want %v", i, dstRe[i], dstIm[i], src[i])
+		}
+	}
+}
+
+func assertSplitNear[F Float](t *testing.T, re, im []F, want []complex128, tol float64) {
+	t.Helper()
+
+	for k := range want {
+		if math.Abs(float64(re[k])-real(want[k])) > tol || math.Abs(float64(im[k])-imag(want[k])) > tol {
+			t.Fatalf("bin %d = %v+%vi, want %v", k, re[k], im[k], want[k])
+		}
+	}
+}
+
+func TestForwardSplit_Rejects(t *testing.T) {
+	t.Parallel()
+
+	buf := make([]float32, 12)
+	if ForwardSplit(buf, buf, buf, buf, buf, buf, NewSplitTwiddles[float32](12)) {
+		t.Fatal("expected non-power-of-two length to be rejected")
+	}
+
+	short := make([]float32, 4)
+	src := make([]float32, 8)
+
+	if ForwardSplit(short, short, src, src, src, src, NewSplitTwiddles[float32](8)) {
+		t.Fatal("expected short destination to be rejected")
+	}
+}
+
+func TestSplitButterflies_Tail(t *testing.T) {
+	t.Parallel()
+
+	// Lengths around the vector width exercise both the SIMD body and the scalar tail.
+	for _, n := range []int{8, 9, 13, 16, 21} {
+		a := make([][]float32, 6) // aRe, aIm, bRe, bIm, twRe, twIm
+		for i := range a {
+			a[i] = make([]float32, n)
+			for j := range a[i] {
+				a[i][j] = float32(i*n+j) * 0.125
+			}
+		}
+
+		got := [4][]float32{make([]float32, n), make([]float32, n), make([]float32, n), make([]float32, n)}
+		want := [4][]float32{make([]float32, n), make([]float32, n), make([]float32, n), make([]float32, n)}
+
+		NewSplitTwiddles[float32](2).butterflies(got[0], got[1], got[2], got[3], a[0], a[1], a[2], a[3], a[4], a[5])
+		splitButterfliesGeneric(want[0], want[1], want[2], want[3], a[0], a[1], a[2], a[3], a[4], a[5])
+
+		for o := range got {
+			for j := range n {
+				if math.Abs(float64(got[o][j]-want[o][j])) > 1e-3 {
+					t.Fatalf("n=%d output %d[%d] = %v, want %v", n, o, j, got[o][j], want[o][j])
+				}
+			}
+		}
+	}
+}
@@ -10,7 +10,10 @@ const (
 	KernelSixStep
 	KernelEightStep
 	KernelBluestein
-	KernelRecursive // Recursive decomposition with codelet leaves
+	KernelRecursive  // Recursive decomposition with codelet leaves
+	KernelRader      // Rader's algorithm for prime sizes
+	KernelPFA        // Good-Thomas prime-factor algorithm for coprime factors
+	KernelSplitRadix // Split-radix algorithm for power-of-two sizes
 )
 
 // SIMDLevel describes the minimum required CPU features for a codelet.
@@ -196,3 +196,38 @@ func BenchmarkAVX2Complex64(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkSplitRadixAVX2 compares the pure Go split-radix kernel with the
+// AVX2 DIT and Stockham kernels it does not replace.
+func BenchmarkSplitRadixAVX2(b *testing.B) {
+	for _, n := range []int{256, 1024, 4096, 16384, 65536} {
+		src := randomComplex64(n, uint64(n))
+		dst := make([]complex64, n)
+		scratch := make([]complex64, n)
+		twiddle := ComputeTwiddleFactors[complex64](n)
+		bitrev := mathpkg.ComputeBitReversalIndices(n)
+
+		kernels := []struct {
+			name    string
+			forward func() bool
+		}{
+			{"splitradix", func() bool { return ForwardSplitRadixComplex64(dst, src, twiddle, scratch) }},
+			{"dit_avx2", func() bool { return amd64.ForwardAVX2Complex64Asm(dst, src, twiddle, scratch, bitrev) }},
+			{"stockham_avx2", func() bool { return amd64.ForwardAVX2StockhamComplex64Asm(dst, src, twiddle, scratch, bitrev) }},
+		}
+
+		for _, k := range kernels {
+			b.Run(benchName(k.name, n), func(b *testing.B) {
+				if !k.forward() {
+					b.Fatalf("%s rejected size %d", k.name, n)
+				}
+
+				b.SetBytes(int64(n * 8))
+
+				for range b.N {
+					k.forward()
+				}
+			})
+		}
+	}
+}
@@ -31,17 +31,12 @@ func ComputeChirpSequence[T Complex](n int) []T {
 	return chirp
 }
 
-// ComputeBluesteinFilter computes the frequency-domain filter for Bluestein's algorithm.
-// n is the original size, m is the padded size (power of 2 >= 2n-1).
-// chirp is the sequence of length n computed by ComputeChirpSequence.
-// twiddles are for size m.
-// scratch is a pre-allocated buffer of size m for intermediate computations.
-func ComputeBluesteinFilter[T Complex](n, m int, chirp []T, twiddles []T, scratch []T) []T {
+// ComputeBluesteinSequence returns the time-domain filter of Bluestein's
+// algorithm for the padded size m >= 2n-1: the conjugated chirp, wrapped
+// around so that b[k] = b[m-k] = conj(chirp[k]) for 0 <= k < n. Its forward
+// FFT of size m is the filter.
+func ComputeBluesteinSequence[T Complex](n, m int, chirp []T) []T {
 	b := make([]T, m)
-	// Construct b sequence
-	// b_k = w_k^{-1} = conj(w_k)
-	// b[k] = conj(chirp[k]) for 0 <= k < n
-	// b[m-k] = conj(chirp[k]) for 1 <= k < n
 
 	b[0] = conj(chirp[0])
 	for k := 1; k < n; k++ {
@@ -50,6 +45,17 @@ func ComputeBluesteinFilter[T Complex](n, m int, chirp []T, twiddles []T, scratc
 		b[m-k] = val
 	}
 
+	return b
+}
+
+// ComputeBluesteinFilter computes the frequency-domain filter for Bluestein's algorithm.
+// n is the original size, m is the padded size (power of 2 >= 2n-1).
+// chirp is the sequence of length n computed by ComputeChirpSequence.
+// twiddles are for size m.
+// scratch is a pre-allocated buffer of size m for intermediate computations.
+func ComputeBluesteinFilter[T Complex](n, m int, chirp []T, twiddles []T, scratch []T) []T {
+	b := ComputeBluesteinSequence(n, m, chirp)
+
 	// Perform FFT using provided scratch buffer
 	ditForward(b, b, twiddles, scratch)
 
@@ -74,4 +80,16 @@ func BluesteinConvolution[T Complex](dst, x, filter, twiddles, scratch []T) {
 
 	// 3. IFFT
 	ditInverse(dst, dst, twiddles, scratch)
-}
\ No newline at end of file
+}
+
+// BluesteinConvolutionBitrev is BluesteinConvolution with precomputed
+// bit-reversal indices for size m, so the convolution does not allocate.
+func BluesteinConvolutionBitrev[T Complex](dst, x, filter, twiddles, scratch []T, bitrev []int) {
+	ditForwardBitrev(dst, x, twiddles, scratch, bitrev)
+
+	for i := range dst {
+		dst[i] *= filter[i]
+	}
+
+	ditInverseBitrev(dst, dst, twiddles, scratch, bitrev)
+}
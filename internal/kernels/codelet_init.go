@@ -38,6 +38,10 @@ func GetRegistry[T Complex]() *planner.CodeletRegistry[T] {
 
 // This file registers all built-in codelets with the global registries.
 // Registration happens at init time so codelets are available when plans are created.
+// The straight-line codelets for sizes without a hand-written one are
+// generated by cmd/gencodelets and registered in codelet_init_gen.go.
+
+//go:generate go run ../../cmd/gencodelets -out . 12=4x3 20=4x5 24=4x2x3 48=4x4x3 60=4x5x3 80=4x4x5
 
 //nolint:gochecknoinits
 func init() {
@@ -47,6 +51,10 @@ func init() {
 	// Register complex128 DIT codelets
 	registerDITCodelets128()
 
+	// Register generated codelets
+	registerGeneratedCodelets64()
+	registerGeneratedCodelets128()
+
 	// Register NEON codelets (conditional on build tags)
 	registerNEONDITCodelets64()
 	registerNEONDITCodelets128()
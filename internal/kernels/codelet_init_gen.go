@@ -0,0 +1,141 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// registerGeneratedCodelets64 registers the generated complex64 codelets.
+func registerGeneratedCodelets64() {
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       12,
+		Forward:    wrapCodelet64(forwardDIT12Radix4x3Complex64),
+		Inverse:    wrapCodelet64(inverseDIT12Radix4x3Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit12_radix4x3_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       20,
+		Forward:    wrapCodelet64(forwardDIT20Radix4x5Complex64),
+		Inverse:    wrapCodelet64(inverseDIT20Radix4x5Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit20_radix4x5_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       24,
+		Forward:    wrapCodelet64(forwardDIT24Radix4x2x3Complex64),
+		Inverse:    wrapCodelet64(inverseDIT24Radix4x2x3Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit24_radix4x2x3_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       48,
+		Forward:    wrapCodelet64(forwardDIT48Radix4x4x3Complex64),
+		Inverse:    wrapCodelet64(inverseDIT48Radix4x4x3Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit48_radix4x4x3_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       60,
+		Forward:    wrapCodelet64(forwardDIT60Radix4x5x3Complex64),
+		Inverse:    wrapCodelet64(inverseDIT60Radix4x5x3Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit60_radix4x5x3_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       80,
+		Forward:    wrapCodelet64(forwardDIT80Radix4x4x5Complex64),
+		Inverse:    wrapCodelet64(inverseDIT80Radix4x4x5Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit80_radix4x4x5_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+}
+
+// registerGeneratedCodelets128 registers the generated complex128 codelets.
+func registerGeneratedCodelets128() {
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       12,
+		Forward:    wrapCodelet128(forwardDIT12Radix4x3Complex128),
+		Inverse:    wrapCodelet128(inverseDIT12Radix4x3Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit12_radix4x3_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       20,
+		Forward:    wrapCodelet128(forwardDIT20Radix4x5Complex128),
+		Inverse:    wrapCodelet128(inverseDIT20Radix4x5Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit20_radix4x5_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       24,
+		Forward:    wrapCodelet128(forwardDIT24Radix4x2x3Complex128),
+		Inverse:    wrapCodelet128(inverseDIT24Radix4x2x3Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit24_radix4x2x3_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       48,
+		Forward:    wrapCodelet128(forwardDIT48Radix4x4x3Complex128),
+		Inverse:    wrapCodelet128(inverseDIT48Radix4x4x3Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit48_radix4x4x3_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       60,
+		Forward:    wrapCodelet128(forwardDIT60Radix4x5x3Complex128),
+		Inverse:    wrapCodelet128(inverseDIT60Radix4x5x3Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit60_radix4x5x3_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       80,
+		Forward:    wrapCodelet128(forwardDIT80Radix4x4x5Complex128),
+		Inverse:    wrapCodelet128(inverseDIT80Radix4x4x5Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit80_radix4x4x5_generic",
+		Priority:   0,
+		KernelType: KernelTypeCore,
+	})
+}
@@ -91,11 +91,17 @@ func TestCodeletRegistrySizes(t *testing.T) {
 	}
 
 	expected := map[int]bool{4: true, 8: true, 16: true, 32: true, 64: true, 128: true, 256: true, 512: true, 1024: true, 2048: true, 4096: true, 8192: true, 16384: true}
-	expectedCount := 13
+
+	// Generated by cmd/gencodelets
+	for _, size := range []int{12, 20, 24, 48, 60, 80} {
+		expected[size] = true
+	}
+
+	expectedCount := 19
 
 	if has384 {
 		expected[384] = true
-		expectedCount = 14
+		expectedCount = 20
 	}
 
 	if len(sizes) != expectedCount {
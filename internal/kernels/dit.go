@@ -215,8 +215,15 @@ func inverseDITComplex128(dst, src, twiddle, scratch []complex128) bool {
 	return ditInverseComplex128(dst, src, twiddle, scratch)
 }
 
-//nolint:cyclop
 func ditForward[T Complex](dst, src, twiddle, scratch []T) bool {
+	return ditForwardBitrev(dst, src, twiddle, scratch, nil)
+}
+
+// ditForwardBitrev is ditForward with precomputed bit-reversal indices. A nil bitrev
+// computes them locally, which allocates.
+//
+//nolint:cyclop
+func ditForwardBitrev[T Complex](dst, src, twiddle, scratch []T, bitrev []int) bool {
 	n := len(src)
 	if n == 0 {
 		return true
@@ -246,9 +253,10 @@ func ditForward[T Complex](dst, src, twiddle, scratch []T) bool {
 	work = work[:n]
 	src = src[:n]
 	twiddle = twiddle[:n]
-	
-	// Compute bit-reversal indices locally for fallback
-	bitrev := mathpkg.ComputeBitReversalIndices(n)
+
+	if len(bitrev) < n {
+		bitrev = mathpkg.ComputeBitReversalIndices(n)
+	}
 
 	for i := range n {
 		work[i] = src[bitrev[i]]
@@ -277,8 +285,15 @@ func ditForward[T Complex](dst, src, twiddle, scratch []T) bool {
 	return true
 }
 
-//nolint:cyclop
 func ditInverse[T Complex](dst, src, twiddle, scratch []T) bool {
+	return ditInverseBitrev(dst, src, twiddle, scratch, nil)
+}
+
+// ditInverseBitrev is ditInverse with precomputed bit-reversal indices. A nil bitrev
+// computes them locally, which allocates.
+//
+//nolint:cyclop
+func ditInverseBitrev[T Complex](dst, src, twiddle, scratch []T, bitrev []int) bool {
 	n := len(src)
 	if n == 0 {
 		return true
@@ -308,8 +323,10 @@ func ditInverse[T Complex](dst, src, twiddle, scratch []T) bool {
 	work = work[:n]
 	src = src[:n]
 	twiddle = twiddle[:n]
-	
-	bitrev := mathpkg.ComputeBitReversalIndices(n)
+
+	if len(bitrev) < n {
+		bitrev = mathpkg.ComputeBitReversalIndices(n)
+	}
 
 	for i := range n {
 		work[i] = src[bitrev[i]]
@@ -0,0 +1,471 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT12Radix4x3Complex64 computes a 12-point forward FFT of complex64 data
+// with radix-4, radix-3 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT12Radix4x3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 12
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w1 := tw[1]
+	w2 := tw[2]
+	w4 := tw[4]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(imag(t4), -real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x5 + x9
+	t9 := x5 - x9
+	t10 := x1 + t8
+	t11 := complex(real(x1)-0.5*real(t8), imag(x1)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(imag(t12), -real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x6 + x10
+	t17 := x6 - x10
+	t18 := x2 + t16
+	t19 := complex(real(x2)-0.5*real(t16), imag(x2)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(imag(t20), -real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x7 + x11
+	t25 := x7 - x11
+	t26 := x3 + t24
+	t27 := complex(real(x3)-0.5*real(t24), imag(x3)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(imag(t28), -real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := t2 + t18
+	t33 := t2 - t18
+	t34 := t10 + t26
+	t35 := t10 - t26
+	t36 := complex(imag(t35), -real(t35))
+	t37 := t32 + t34
+	t38 := t33 + t36
+	t39 := t32 - t34
+	t40 := t33 - t36
+	t41 := w1 * t14
+	t42 := w2 * t22
+	t43 := complex(imag(t30), -real(t30))
+	t44 := t6 + t42
+	t45 := t6 - t42
+	t46 := t41 + t43
+	t47 := t41 - t43
+	t48 := complex(imag(t47), -real(t47))
+	t49 := t44 + t46
+	t50 := t45 + t48
+	t51 := t44 - t46
+	t52 := t45 - t48
+	t53 := w2 * t15
+	t54 := w4 * t23
+	t55 := -t31
+	t56 := t7 + t54
+	t57 := t7 - t54
+	t58 := t53 + t55
+	t59 := t53 - t55
+	t60 := complex(imag(t59), -real(t59))
+	t61 := t56 + t58
+	t62 := t57 + t60
+	t63 := t56 - t58
+	t64 := t57 - t60
+
+	y := dst[:n]
+	y[0] = t37
+	y[1] = t49
+	y[2] = t61
+	y[3] = t38
+	y[4] = t50
+	y[5] = t62
+	y[6] = t39
+	y[7] = t51
+	y[8] = t63
+	y[9] = t40
+	y[10] = t52
+	y[11] = t64
+
+	return true
+}
+
+// inverseDIT12Radix4x3Complex64 computes a 12-point inverse FFT of complex64 data
+// with radix-4, radix-3 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT12Radix4x3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 12
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(-imag(t4), real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x5 + x9
+	t9 := x5 - x9
+	t10 := x1 + t8
+	t11 := complex(real(x1)-0.5*real(t8), imag(x1)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(-imag(t12), real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x6 + x10
+	t17 := x6 - x10
+	t18 := x2 + t16
+	t19 := complex(real(x2)-0.5*real(t16), imag(x2)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(-imag(t20), real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x7 + x11
+	t25 := x7 - x11
+	t26 := x3 + t24
+	t27 := complex(real(x3)-0.5*real(t24), imag(x3)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(-imag(t28), real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := t2 + t18
+	t33 := t2 - t18
+	t34 := t10 + t26
+	t35 := t10 - t26
+	t36 := complex(-imag(t35), real(t35))
+	t37 := t32 + t34
+	t38 := t33 + t36
+	t39 := t32 - t34
+	t40 := t33 - t36
+	t41 := w1 * t14
+	t42 := w2 * t22
+	t43 := complex(-imag(t30), real(t30))
+	t44 := t6 + t42
+	t45 := t6 - t42
+	t46 := t41 + t43
+	t47 := t41 - t43
+	t48 := complex(-imag(t47), real(t47))
+	t49 := t44 + t46
+	t50 := t45 + t48
+	t51 := t44 - t46
+	t52 := t45 - t48
+	t53 := w2 * t15
+	t54 := w4 * t23
+	t55 := -t31
+	t56 := t7 + t54
+	t57 := t7 - t54
+	t58 := t53 + t55
+	t59 := t53 - t55
+	t60 := complex(-imag(t59), real(t59))
+	t61 := t56 + t58
+	t62 := t57 + t60
+	t63 := t56 - t58
+	t64 := t57 - t60
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t37)*scale, imag(t37)*scale)
+	y[1] = complex(real(t49)*scale, imag(t49)*scale)
+	y[2] = complex(real(t61)*scale, imag(t61)*scale)
+	y[3] = complex(real(t38)*scale, imag(t38)*scale)
+	y[4] = complex(real(t50)*scale, imag(t50)*scale)
+	y[5] = complex(real(t62)*scale, imag(t62)*scale)
+	y[6] = complex(real(t39)*scale, imag(t39)*scale)
+	y[7] = complex(real(t51)*scale, imag(t51)*scale)
+	y[8] = complex(real(t63)*scale, imag(t63)*scale)
+	y[9] = complex(real(t40)*scale, imag(t40)*scale)
+	y[10] = complex(real(t52)*scale, imag(t52)*scale)
+	y[11] = complex(real(t64)*scale, imag(t64)*scale)
+
+	return true
+}
+
+// forwardDIT12Radix4x3Complex128 computes a 12-point forward FFT of complex128 data
+// with radix-4, radix-3 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT12Radix4x3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 12
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w1 := tw[1]
+	w2 := tw[2]
+	w4 := tw[4]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(imag(t4), -real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x5 + x9
+	t9 := x5 - x9
+	t10 := x1 + t8
+	t11 := complex(real(x1)-0.5*real(t8), imag(x1)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(imag(t12), -real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x6 + x10
+	t17 := x6 - x10
+	t18 := x2 + t16
+	t19 := complex(real(x2)-0.5*real(t16), imag(x2)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(imag(t20), -real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x7 + x11
+	t25 := x7 - x11
+	t26 := x3 + t24
+	t27 := complex(real(x3)-0.5*real(t24), imag(x3)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(imag(t28), -real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := t2 + t18
+	t33 := t2 - t18
+	t34 := t10 + t26
+	t35 := t10 - t26
+	t36 := complex(imag(t35), -real(t35))
+	t37 := t32 + t34
+	t38 := t33 + t36
+	t39 := t32 - t34
+	t40 := t33 - t36
+	t41 := w1 * t14
+	t42 := w2 * t22
+	t43 := complex(imag(t30), -real(t30))
+	t44 := t6 + t42
+	t45 := t6 - t42
+	t46 := t41 + t43
+	t47 := t41 - t43
+	t48 := complex(imag(t47), -real(t47))
+	t49 := t44 + t46
+	t50 := t45 + t48
+	t51 := t44 - t46
+	t52 := t45 - t48
+	t53 := w2 * t15
+	t54 := w4 * t23
+	t55 := -t31
+	t56 := t7 + t54
+	t57 := t7 - t54
+	t58 := t53 + t55
+	t59 := t53 - t55
+	t60 := complex(imag(t59), -real(t59))
+	t61 := t56 + t58
+	t62 := t57 + t60
+	t63 := t56 - t58
+	t64 := t57 - t60
+
+	y := dst[:n]
+	y[0] = t37
+	y[1] = t49
+	y[2] = t61
+	y[3] = t38
+	y[4] = t50
+	y[5] = t62
+	y[6] = t39
+	y[7] = t51
+	y[8] = t63
+	y[9] = t40
+	y[10] = t52
+	y[11] = t64
+
+	return true
+}
+
+// inverseDIT12Radix4x3Complex128 computes a 12-point inverse FFT of complex128 data
+// with radix-4, radix-3 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT12Radix4x3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 12
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(-imag(t4), real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x5 + x9
+	t9 := x5 - x9
+	t10 := x1 + t8
+	t11 := complex(real(x1)-0.5*real(t8), imag(x1)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(-imag(t12), real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x6 + x10
+	t17 := x6 - x10
+	t18 := x2 + t16
+	t19 := complex(real(x2)-0.5*real(t16), imag(x2)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(-imag(t20), real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x7 + x11
+	t25 := x7 - x11
+	t26 := x3 + t24
+	t27 := complex(real(x3)-0.5*real(t24), imag(x3)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(-imag(t28), real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := t2 + t18
+	t33 := t2 - t18
+	t34 := t10 + t26
+	t35 := t10 - t26
+	t36 := complex(-imag(t35), real(t35))
+	t37 := t32 + t34
+	t38 := t33 + t36
+	t39 := t32 - t34
+	t40 := t33 - t36
+	t41 := w1 * t14
+	t42 := w2 * t22
+	t43 := complex(-imag(t30), real(t30))
+	t44 := t6 + t42
+	t45 := t6 - t42
+	t46 := t41 + t43
+	t47 := t41 - t43
+	t48 := complex(-imag(t47), real(t47))
+	t49 := t44 + t46
+	t50 := t45 + t48
+	t51 := t44 - t46
+	t52 := t45 - t48
+	t53 := w2 * t15
+	t54 := w4 * t23
+	t55 := -t31
+	t56 := t7 + t54
+	t57 := t7 - t54
+	t58 := t53 + t55
+	t59 := t53 - t55
+	t60 := complex(-imag(t59), real(t59))
+	t61 := t56 + t58
+	t62 := t57 + t60
+	t63 := t56 - t58
+	t64 := t57 - t60
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t37)*scale, imag(t37)*scale)
+	y[1] = complex(real(t49)*scale, imag(t49)*scale)
+	y[2] = complex(real(t61)*scale, imag(t61)*scale)
+	y[3] = complex(real(t38)*scale, imag(t38)*scale)
+	y[4] = complex(real(t50)*scale, imag(t50)*scale)
+	y[5] = complex(real(t62)*scale, imag(t62)*scale)
+	y[6] = complex(real(t39)*scale, imag(t39)*scale)
+	y[7] = complex(real(t51)*scale, imag(t51)*scale)
+	y[8] = complex(real(t63)*scale, imag(t63)*scale)
+	y[9] = complex(real(t40)*scale, imag(t40)*scale)
+	y[10] = complex(real(t52)*scale, imag(t52)*scale)
+	y[11] = complex(real(t64)*scale, imag(t64)*scale)
+
+	return true
+}
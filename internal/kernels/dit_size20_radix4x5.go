@@ -0,0 +1,763 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT20Radix4x5Complex64 computes a 20-point forward FFT of complex64 data
+// with radix-4, radix-5 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT20Radix4x5Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 20
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w4 := tw[4]
+	w6 := tw[6]
+	w9 := tw[9]
+	w8 := tw[8]
+	w12 := tw[12]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.8090169943749475*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.8090169943749475*imag(t2))
+	t6 := complex(0.9510565162951535*real(t1)+0.5877852522924732*real(t3), 0.9510565162951535*imag(t1)+0.5877852522924732*imag(t3))
+	t7 := complex(imag(t6), -real(t6))
+	t8 := t5 + t7
+	t9 := t5 - t7
+	t10 := complex(real(x0)-0.8090169943749475*real(t0)+0.30901699437494723*real(t2), imag(x0)-0.8090169943749475*imag(t0)+0.30901699437494723*imag(t2))
+	t11 := complex(0.5877852522924732*real(t1)-0.9510565162951536*real(t3), 0.5877852522924732*imag(t1)-0.9510565162951536*imag(t3))
+	t12 := complex(imag(t11), -real(t11))
+	t13 := t10 + t12
+	t14 := t10 - t12
+	t15 := x5 + x17
+	t16 := x5 - x17
+	t17 := x9 + x13
+	t18 := x9 - x13
+	t19 := x1 + t15 + t17
+	t20 := complex(real(x1)+0.30901699437494745*real(t15)-0.8090169943749475*real(t17), imag(x1)+0.30901699437494745*imag(t15)-0.8090169943749475*imag(t17))
+	t21 := complex(0.9510565162951535*real(t16)+0.5877852522924732*real(t18), 0.9510565162951535*imag(t16)+0.5877852522924732*imag(t18))
+	t22 := complex(imag(t21), -real(t21))
+	t23 := t20 + t22
+	t24 := t20 - t22
+	t25 := complex(real(x1)-0.8090169943749475*real(t15)+0.30901699437494723*real(t17), imag(x1)-0.8090169943749475*imag(t15)+0.30901699437494723*imag(t17))
+	t26 := complex(0.5877852522924732*real(t16)-0.9510565162951536*real(t18), 0.5877852522924732*imag(t16)-0.9510565162951536*imag(t18))
+	t27 := complex(imag(t26), -real(t26))
+	t28 := t25 + t27
+	t29 := t25 - t27
+	t30 := x6 + x18
+	t31 := x6 - x18
+	t32 := x10 + x14
+	t33 := x10 - x14
+	t34 := x2 + t30 + t32
+	t35 := complex(real(x2)+0.30901699437494745*real(t30)-0.8090169943749475*real(t32), imag(x2)+0.30901699437494745*imag(t30)-0.8090169943749475*imag(t32))
+	t36 := complex(0.9510565162951535*real(t31)+0.5877852522924732*real(t33), 0.9510565162951535*imag(t31)+0.5877852522924732*imag(t33))
+	t37 := complex(imag(t36), -real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := complex(real(x2)-0.8090169943749475*real(t30)+0.30901699437494723*real(t32), imag(x2)-0.8090169943749475*imag(t30)+0.30901699437494723*imag(t32))
+	t41 := complex(0.5877852522924732*real(t31)-0.9510565162951536*real(t33), 0.5877852522924732*imag(t31)-0.9510565162951536*imag(t33))
+	t42 := complex(imag(t41), -real(t41))
+	t43 := t40 + t42
+	t44 := t40 - t42
+	t45 := x7 + x19
+	t46 := x7 - x19
+	t47 := x11 + x15
+	t48 := x11 - x15
+	t49 := x3 + t45 + t47
+	t50 := complex(real(x3)+0.30901699437494745*real(t45)-0.8090169943749475*real(t47), imag(x3)+0.30901699437494745*imag(t45)-0.8090169943749475*imag(t47))
+	t51 := complex(0.9510565162951535*real(t46)+0.5877852522924732*real(t48), 0.9510565162951535*imag(t46)+0.5877852522924732*imag(t48))
+	t52 := complex(imag(t51), -real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := complex(real(x3)-0.8090169943749475*real(t45)+0.30901699437494723*real(t47), imag(x3)-0.8090169943749475*imag(t45)+0.30901699437494723*imag(t47))
+	t56 := complex(0.5877852522924732*real(t46)-0.9510565162951536*real(t48), 0.5877852522924732*imag(t46)-0.9510565162951536*imag(t48))
+	t57 := complex(imag(t56), -real(t56))
+	t58 := t55 + t57
+	t59 := t55 - t57
+	t60 := t4 + t34
+	t61 := t4 - t34
+	t62 := t19 + t49
+	t63 := t19 - t49
+	t64 := complex(imag(t63), -real(t63))
+	t65 := t60 + t62
+	t66 := t61 + t64
+	t67 := t60 - t62
+	t68 := t61 - t64
+	t69 := w1 * t23
+	t70 := w2 * t38
+	t71 := w3 * t53
+	t72 := t8 + t70
+	t73 := t8 - t70
+	t74 := t69 + t71
+	t75 := t69 - t71
+	t76 := complex(imag(t75), -real(t75))
+	t77 := t72 + t74
+	t78 := t73 + t76
+	t79 := t72 - t74
+	t80 := t73 - t76
+	t81 := w2 * t28
+	t82 := w4 * t43
+	t83 := w6 * t58
+	t84 := t13 + t82
+	t85 := t13 - t82
+	t86 := t81 + t83
+	t87 := t81 - t83
+	t88 := complex(imag(t87), -real(t87))
+	t89 := t84 + t86
+	t90 := t85 + t88
+	t91 := t84 - t86
+	t92 := t85 - t88
+	t93 := w3 * t29
+	t94 := w6 * t44
+	t95 := w9 * t59
+	t96 := t14 + t94
+	t97 := t14 - t94
+	t98 := t93 + t95
+	t99 := t93 - t95
+	t100 := complex(imag(t99), -real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w4 * t24
+	t106 := w8 * t39
+	t107 := w12 * t54
+	t108 := t9 + t106
+	t109 := t9 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(imag(t111), -real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+
+	y := dst[:n]
+	y[0] = t65
+	y[1] = t77
+	y[2] = t89
+	y[3] = t101
+	y[4] = t113
+	y[5] = t66
+	y[6] = t78
+	y[7] = t90
+	y[8] = t102
+	y[9] = t114
+	y[10] = t67
+	y[11] = t79
+	y[12] = t91
+	y[13] = t103
+	y[14] = t115
+	y[15] = t68
+	y[16] = t80
+	y[17] = t92
+	y[18] = t104
+	y[19] = t116
+
+	return true
+}
+
+// inverseDIT20Radix4x5Complex64 computes a 20-point inverse FFT of complex64 data
+// with radix-4, radix-5 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT20Radix4x5Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 20
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w6 := complex(real(tw[6]), -imag(tw[6]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w12 := complex(real(tw[12]), -imag(tw[12]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.8090169943749475*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.8090169943749475*imag(t2))
+	t6 := complex(0.9510565162951535*real(t1)+0.5877852522924732*real(t3), 0.9510565162951535*imag(t1)+0.5877852522924732*imag(t3))
+	t7 := complex(-imag(t6), real(t6))
+	t8 := t5 + t7
+	t9 := t5 - t7
+	t10 := complex(real(x0)-0.8090169943749475*real(t0)+0.30901699437494723*real(t2), imag(x0)-0.8090169943749475*imag(t0)+0.30901699437494723*imag(t2))
+	t11 := complex(0.5877852522924732*real(t1)-0.9510565162951536*real(t3), 0.5877852522924732*imag(t1)-0.9510565162951536*imag(t3))
+	t12 := complex(-imag(t11), real(t11))
+	t13 := t10 + t12
+	t14 := t10 - t12
+	t15 := x5 + x17
+	t16 := x5 - x17
+	t17 := x9 + x13
+	t18 := x9 - x13
+	t19 := x1 + t15 + t17
+	t20 := complex(real(x1)+0.30901699437494745*real(t15)-0.8090169943749475*real(t17), imag(x1)+0.30901699437494745*imag(t15)-0.8090169943749475*imag(t17))
+	t21 := complex(0.9510565162951535*real(t16)+0.5877852522924732*real(t18), 0.9510565162951535*imag(t16)+0.5877852522924732*imag(t18))
+	t22 := complex(-imag(t21), real(t21))
+	t23 := t20 + t22
+	t24 := t20 - t22
+	t25 := complex(real(x1)-0.8090169943749475*real(t15)+0.30901699437494723*real(t17), imag(x1)-0.8090169943749475*imag(t15)+0.30901699437494723*imag(t17))
+	t26 := complex(0.5877852522924732*real(t16)-0.9510565162951536*real(t18), 0.5877852522924732*imag(t16)-0.9510565162951536*imag(t18))
+	t27 := complex(-imag(t26), real(t26))
+	t28 := t25 + t27
+	t29 := t25 - t27
+	t30 := x6 + x18
+	t31 := x6 - x18
+	t32 := x10 + x14
+	t33 := x10 - x14
+	t34 := x2 + t30 + t32
+	t35 := complex(real(x2)+0.30901699437494745*real(t30)-0.8090169943749475*real(t32), imag(x2)+0.30901699437494745*imag(t30)-0.8090169943749475*imag(t32))
+	t36 := complex(0.9510565162951535*real(t31)+0.5877852522924732*real(t33), 0.9510565162951535*imag(t31)+0.5877852522924732*imag(t33))
+	t37 := complex(-imag(t36), real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := complex(real(x2)-0.8090169943749475*real(t30)+0.30901699437494723*real(t32), imag(x2)-0.8090169943749475*imag(t30)+0.30901699437494723*imag(t32))
+	t41 := complex(0.5877852522924732*real(t31)-0.9510565162951536*real(t33), 0.5877852522924732*imag(t31)-0.9510565162951536*imag(t33))
+	t42 := complex(-imag(t41), real(t41))
+	t43 := t40 + t42
+	t44 := t40 - t42
+	t45 := x7 + x19
+	t46 := x7 - x19
+	t47 := x11 + x15
+	t48 := x11 - x15
+	t49 := x3 + t45 + t47
+	t50 := complex(real(x3)+0.30901699437494745*real(t45)-0.8090169943749475*real(t47), imag(x3)+0.30901699437494745*imag(t45)-0.8090169943749475*imag(t47))
+	t51 := complex(0.9510565162951535*real(t46)+0.5877852522924732*real(t48), 0.9510565162951535*imag(t46)+0.5877852522924732*imag(t48))
+	t52 := complex(-imag(t51), real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := complex(real(x3)-0.8090169943749475*real(t45)+0.30901699437494723*real(t47), imag(x3)-0.8090169943749475*imag(t45)+0.30901699437494723*imag(t47))
+	t56 := complex(0.5877852522924732*real(t46)-0.9510565162951536*real(t48), 0.5877852522924732*imag(t46)-0.9510565162951536*imag(t48))
+	t57 := complex(-imag(t56), real(t56))
+	t58 := t55 + t57
+	t59 := t55 - t57
+	t60 := t4 + t34
+	t61 := t4 - t34
+	t62 := t19 + t49
+	t63 := t19 - t49
+	t64 := complex(-imag(t63), real(t63))
+	t65 := t60 + t62
+	t66 := t61 + t64
+	t67 := t60 - t62
+	t68 := t61 - t64
+	t69 := w1 * t23
+	t70 := w2 * t38
+	t71 := w3 * t53
+	t72 := t8 + t70
+	t73 := t8 - t70
+	t74 := t69 + t71
+	t75 := t69 - t71
+	t76 := complex(-imag(t75), real(t75))
+	t77 := t72 + t74
+	t78 := t73 + t76
+	t79 := t72 - t74
+	t80 := t73 - t76
+	t81 := w2 * t28
+	t82 := w4 * t43
+	t83 := w6 * t58
+	t84 := t13 + t82
+	t85 := t13 - t82
+	t86 := t81 + t83
+	t87 := t81 - t83
+	t88 := complex(-imag(t87), real(t87))
+	t89 := t84 + t86
+	t90 := t85 + t88
+	t91 := t84 - t86
+	t92 := t85 - t88
+	t93 := w3 * t29
+	t94 := w6 * t44
+	t95 := w9 * t59
+	t96 := t14 + t94
+	t97 := t14 - t94
+	t98 := t93 + t95
+	t99 := t93 - t95
+	t100 := complex(-imag(t99), real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w4 * t24
+	t106 := w8 * t39
+	t107 := w12 * t54
+	t108 := t9 + t106
+	t109 := t9 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(-imag(t111), real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t65)*scale, imag(t65)*scale)
+	y[1] = complex(real(t77)*scale, imag(t77)*scale)
+	y[2] = complex(real(t89)*scale, imag(t89)*scale)
+	y[3] = complex(real(t101)*scale, imag(t101)*scale)
+	y[4] = complex(real(t113)*scale, imag(t113)*scale)
+	y[5] = complex(real(t66)*scale, imag(t66)*scale)
+	y[6] = complex(real(t78)*scale, imag(t78)*scale)
+	y[7] = complex(real(t90)*scale, imag(t90)*scale)
+	y[8] = complex(real(t102)*scale, imag(t102)*scale)
+	y[9] = complex(real(t114)*scale, imag(t114)*scale)
+	y[10] = complex(real(t67)*scale, imag(t67)*scale)
+	y[11] = complex(real(t79)*scale, imag(t79)*scale)
+	y[12] = complex(real(t91)*scale, imag(t91)*scale)
+	y[13] = complex(real(t103)*scale, imag(t103)*scale)
+	y[14] = complex(real(t115)*scale, imag(t115)*scale)
+	y[15] = complex(real(t68)*scale, imag(t68)*scale)
+	y[16] = complex(real(t80)*scale, imag(t80)*scale)
+	y[17] = complex(real(t92)*scale, imag(t92)*scale)
+	y[18] = complex(real(t104)*scale, imag(t104)*scale)
+	y[19] = complex(real(t116)*scale, imag(t116)*scale)
+
+	return true
+}
+
+// forwardDIT20Radix4x5Complex128 computes a 20-point forward FFT of complex128 data
+// with radix-4, radix-5 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT20Radix4x5Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 20
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w4 := tw[4]
+	w6 := tw[6]
+	w9 := tw[9]
+	w8 := tw[8]
+	w12 := tw[12]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.8090169943749475*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.8090169943749475*imag(t2))
+	t6 := complex(0.9510565162951535*real(t1)+0.5877852522924732*real(t3), 0.9510565162951535*imag(t1)+0.5877852522924732*imag(t3))
+	t7 := complex(imag(t6), -real(t6))
+	t8 := t5 + t7
+	t9 := t5 - t7
+	t10 := complex(real(x0)-0.8090169943749475*real(t0)+0.30901699437494723*real(t2), imag(x0)-0.8090169943749475*imag(t0)+0.30901699437494723*imag(t2))
+	t11 := complex(0.5877852522924732*real(t1)-0.9510565162951536*real(t3), 0.5877852522924732*imag(t1)-0.9510565162951536*imag(t3))
+	t12 := complex(imag(t11), -real(t11))
+	t13 := t10 + t12
+	t14 := t10 - t12
+	t15 := x5 + x17
+	t16 := x5 - x17
+	t17 := x9 + x13
+	t18 := x9 - x13
+	t19 := x1 + t15 + t17
+	t20 := complex(real(x1)+0.30901699437494745*real(t15)-0.8090169943749475*real(t17), imag(x1)+0.30901699437494745*imag(t15)-0.8090169943749475*imag(t17))
+	t21 := complex(0.9510565162951535*real(t16)+0.5877852522924732*real(t18), 0.9510565162951535*imag(t16)+0.5877852522924732*imag(t18))
+	t22 := complex(imag(t21), -real(t21))
+	t23 := t20 + t22
+	t24 := t20 - t22
+	t25 := complex(real(x1)-0.8090169943749475*real(t15)+0.30901699437494723*real(t17), imag(x1)-0.8090169943749475*imag(t15)+0.30901699437494723*imag(t17))
+	t26 := complex(0.5877852522924732*real(t16)-0.9510565162951536*real(t18), 0.5877852522924732*imag(t16)-0.9510565162951536*imag(t18))
+	t27 := complex(imag(t26), -real(t26))
+	t28 := t25 + t27
+	t29 := t25 - t27
+	t30 := x6 + x18
+	t31 := x6 - x18
+	t32 := x10 + x14
+	t33 := x10 - x14
+	t34 := x2 + t30 + t32
+	t35 := complex(real(x2)+0.30901699437494745*real(t30)-0.8090169943749475*real(t32), imag(x2)+0.30901699437494745*imag(t30)-0.8090169943749475*imag(t32))
+	t36 := complex(0.9510565162951535*real(t31)+0.5877852522924732*real(t33), 0.9510565162951535*imag(t31)+0.5877852522924732*imag(t33))
+	t37 := complex(imag(t36), -real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := complex(real(x2)-0.8090169943749475*real(t30)+0.30901699437494723*real(t32), imag(x2)-0.8090169943749475*imag(t30)+0.30901699437494723*imag(t32))
+	t41 := complex(0.5877852522924732*real(t31)-0.9510565162951536*real(t33), 0.5877852522924732*imag(t31)-0.9510565162951536*imag(t33))
+	t42 := complex(imag(t41), -real(t41))
+	t43 := t40 + t42
+	t44 := t40 - t42
+	t45 := x7 + x19
+	t46 := x7 - x19
+	t47 := x11 + x15
+	t48 := x11 - x15
+	t49 := x3 + t45 + t47
+	t50 := complex(real(x3)+0.30901699437494745*real(t45)-0.8090169943749475*real(t47), imag(x3)+0.30901699437494745*imag(t45)-0.8090169943749475*imag(t47))
+	t51 := complex(0.9510565162951535*real(t46)+0.5877852522924732*real(t48), 0.9510565162951535*imag(t46)+0.5877852522924732*imag(t48))
+	t52 := complex(imag(t51), -real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := complex(real(x3)-0.8090169943749475*real(t45)+0.30901699437494723*real(t47), imag(x3)-0.8090169943749475*imag(t45)+0.30901699437494723*imag(t47))
+	t56 := complex(0.5877852522924732*real(t46)-0.9510565162951536*real(t48), 0.5877852522924732*imag(t46)-0.9510565162951536*imag(t48))
+	t57 := complex(imag(t56), -real(t56))
+	t58 := t55 + t57
+	t59 := t55 - t57
+	t60 := t4 + t34
+	t61 := t4 - t34
+	t62 := t19 + t49
+	t63 := t19 - t49
+	t64 := complex(imag(t63), -real(t63))
+	t65 := t60 + t62
+	t66 := t61 + t64
+	t67 := t60 - t62
+	t68 := t61 - t64
+	t69 := w1 * t23
+	t70 := w2 * t38
+	t71 := w3 * t53
+	t72 := t8 + t70
+	t73 := t8 - t70
+	t74 := t69 + t71
+	t75 := t69 - t71
+	t76 := complex(imag(t75), -real(t75))
+	t77 := t72 + t74
+	t78 := t73 + t76
+	t79 := t72 - t74
+	t80 := t73 - t76
+	t81 := w2 * t28
+	t82 := w4 * t43
+	t83 := w6 * t58
+	t84 := t13 + t82
+	t85 := t13 - t82
+	t86 := t81 + t83
+	t87 := t81 - t83
+	t88 := complex(imag(t87), -real(t87))
+	t89 := t84 + t86
+	t90 := t85 + t88
+	t91 := t84 - t86
+	t92 := t85 - t88
+	t93 := w3 * t29
+	t94 := w6 * t44
+	t95 := w9 * t59
+	t96 := t14 + t94
+	t97 := t14 - t94
+	t98 := t93 + t95
+	t99 := t93 - t95
+	t100 := complex(imag(t99), -real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w4 * t24
+	t106 := w8 * t39
+	t107 := w12 * t54
+	t108 := t9 + t106
+	t109 := t9 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(imag(t111), -real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+
+	y := dst[:n]
+	y[0] = t65
+	y[1] = t77
+	y[2] = t89
+	y[3] = t101
+	y[4] = t113
+	y[5] = t66
+	y[6] = t78
+	y[7] = t90
+	y[8] = t102
+	y[9] = t114
+	y[10] = t67
+	y[11] = t79
+	y[12] = t91
+	y[13] = t103
+	y[14] = t115
+	y[15] = t68
+	y[16] = t80
+	y[17] = t92
+	y[18] = t104
+	y[19] = t116
+
+	return true
+}
+
+// inverseDIT20Radix4x5Complex128 computes a 20-point inverse FFT of complex128 data
+// with radix-4, radix-5 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT20Radix4x5Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 20
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w6 := complex(real(tw[6]), -imag(tw[6]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w12 := complex(real(tw[12]), -imag(tw[12]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.8090169943749475*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.8090169943749475*imag(t2))
+	t6 := complex(0.9510565162951535*real(t1)+0.5877852522924732*real(t3), 0.9510565162951535*imag(t1)+0.5877852522924732*imag(t3))
+	t7 := complex(-imag(t6), real(t6))
+	t8 := t5 + t7
+	t9 := t5 - t7
+	t10 := complex(real(x0)-0.8090169943749475*real(t0)+0.30901699437494723*real(t2), imag(x0)-0.8090169943749475*imag(t0)+0.30901699437494723*imag(t2))
+	t11 := complex(0.5877852522924732*real(t1)-0.9510565162951536*real(t3), 0.5877852522924732*imag(t1)-0.9510565162951536*imag(t3))
+	t12 := complex(-imag(t11), real(t11))
+	t13 := t10 + t12
+	t14 := t10 - t12
+	t15 := x5 + x17
+	t16 := x5 - x17
+	t17 := x9 + x13
+	t18 := x9 - x13
+	t19 := x1 + t15 + t17
+	t20 := complex(real(x1)+0.30901699437494745*real(t15)-0.8090169943749475*real(t17), imag(x1)+0.30901699437494745*imag(t15)-0.8090169943749475*imag(t17))
+	t21 := complex(0.9510565162951535*real(t16)+0.5877852522924732*real(t18), 0.9510565162951535*imag(t16)+0.5877852522924732*imag(t18))
+	t22 := complex(-imag(t21), real(t21))
+	t23 := t20 + t22
+	t24 := t20 - t22
+	t25 := complex(real(x1)-0.8090169943749475*real(t15)+0.30901699437494723*real(t17), imag(x1)-0.8090169943749475*imag(t15)+0.30901699437494723*imag(t17))
+	t26 := complex(0.5877852522924732*real(t16)-0.9510565162951536*real(t18), 0.5877852522924732*imag(t16)-0.9510565162951536*imag(t18))
+	t27 := complex(-imag(t26), real(t26))
+	t28 := t25 + t27
+	t29 := t25 - t27
+	t30 := x6 + x18
+	t31 := x6 - x18
+	t32 := x10 + x14
+	t33 := x10 - x14
+	t34 := x2 + t30 + t32
+	t35 := complex(real(x2)+0.30901699437494745*real(t30)-0.8090169943749475*real(t32), imag(x2)+0.30901699437494745*imag(t30)-0.8090169943749475*imag(t32))
+	t36 := complex(0.9510565162951535*real(t31)+0.5877852522924732*real(t33), 0.9510565162951535*imag(t31)+0.5877852522924732*imag(t33))
+	t37 := complex(-imag(t36), real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := complex(real(x2)-0.8090169943749475*real(t30)+0.30901699437494723*real(t32), imag(x2)-0.8090169943749475*imag(t30)+0.30901699437494723*imag(t32))
+	t41 := complex(0.5877852522924732*real(t31)-0.9510565162951536*real(t33), 0.5877852522924732*imag(t31)-0.9510565162951536*imag(t33))
+	t42 := complex(-imag(t41), real(t41))
+	t43 := t40 + t42
+	t44 := t40 - t42
+	t45 := x7 + x19
+	t46 := x7 - x19
+	t47 := x11 + x15
+	t48 := x11 - x15
+	t49 := x3 + t45 + t47
+	t50 := complex(real(x3)+0.30901699437494745*real(t45)-0.8090169943749475*real(t47), imag(x3)+0.30901699437494745*imag(t45)-0.8090169943749475*imag(t47))
+	t51 := complex(0.9510565162951535*real(t46)+0.5877852522924732*real(t48), 0.9510565162951535*imag(t46)+0.5877852522924732*imag(t48))
+	t52 := complex(-imag(t51), real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := complex(real(x3)-0.8090169943749475*real(t45)+0.30901699437494723*real(t47), imag(x3)-0.8090169943749475*imag(t45)+0.30901699437494723*imag(t47))
+	t56 := complex(0.5877852522924732*real(t46)-0.9510565162951536*real(t48), 0.5877852522924732*imag(t46)-0.9510565162951536*imag(t48))
+	t57 := complex(-imag(t56), real(t56))
+	t58 := t55 + t57
+	t59 := t55 - t57
+	t60 := t4 + t34
+	t61 := t4 - t34
+	t62 := t19 + t49
+	t63 := t19 - t49
+	t64 := complex(-imag(t63), real(t63))
+	t65 := t60 + t62
+	t66 := t61 + t64
+	t67 := t60 - t62
+	t68 := t61 - t64
+	t69 := w1 * t23
+	t70 := w2 * t38
+	t71 := w3 * t53
+	t72 := t8 + t70
+	t73 := t8 - t70
+	t74 := t69 + t71
+	t75 := t69 - t71
+	t76 := complex(-imag(t75), real(t75))
+	t77 := t72 + t74
+	t78 := t73 + t76
+	t79 := t72 - t74
+	t80 := t73 - t76
+	t81 := w2 * t28
+	t82 := w4 * t43
+	t83 := w6 * t58
+	t84 := t13 + t82
+	t85 := t13 - t82
+	t86 := t81 + t83
+	t87 := t81 - t83
+	t88 := complex(-imag(t87), real(t87))
+	t89 := t84 + t86
+	t90 := t85 + t88
+	t91 := t84 - t86
+	t92 := t85 - t88
+	t93 := w3 * t29
+	t94 := w6 * t44
+	t95 := w9 * t59
+	t96 := t14 + t94
+	t97 := t14 - t94
+	t98 := t93 + t95
+	t99 := t93 - t95
+	t100 := complex(-imag(t99), real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w4 * t24
+	t106 := w8 * t39
+	t107 := w12 * t54
+	t108 := t9 + t106
+	t109 := t9 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(-imag(t111), real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t65)*scale, imag(t65)*scale)
+	y[1] = complex(real(t77)*scale, imag(t77)*scale)
+	y[2] = complex(real(t89)*scale, imag(t89)*scale)
+	y[3] = complex(real(t101)*scale, imag(t101)*scale)
+	y[4] = complex(real(t113)*scale, imag(t113)*scale)
+	y[5] = complex(real(t66)*scale, imag(t66)*scale)
+	y[6] = complex(real(t78)*scale, imag(t78)*scale)
+	y[7] = complex(real(t90)*scale, imag(t90)*scale)
+	y[8] = complex(real(t102)*scale, imag(t102)*scale)
+	y[9] = complex(real(t114)*scale, imag(t114)*scale)
+	y[10] = complex(real(t67)*scale, imag(t67)*scale)
+	y[11] = complex(real(t79)*scale, imag(t79)*scale)
+	y[12] = complex(real(t91)*scale, imag(t91)*scale)
+	y[13] = complex(real(t103)*scale, imag(t103)*scale)
+	y[14] = complex(real(t115)*scale, imag(t115)*scale)
+	y[15] = complex(real(t68)*scale, imag(t68)*scale)
+	y[16] = complex(real(t80)*scale, imag(t80)*scale)
+	y[17] = complex(real(t92)*scale, imag(t92)*scale)
+	y[18] = complex(real(t104)*scale, imag(t104)*scale)
+	y[19] = complex(real(t116)*scale, imag(t116)*scale)
+
+	return true
+}
@@ -0,0 +1,991 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT24Radix4x2x3Complex64 computes a 24-point forward FFT of complex64 data
+// with radix-4, radix-2, radix-3 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT24Radix4x2x3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 24
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := tw[4]
+	w8 := tw[8]
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w9 := tw[9]
+	w5 := tw[5]
+	w10 := tw[10]
+	w15 := tw[15]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(imag(t4), -real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x12 + x20
+	t9 := x12 - x20
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(imag(t12), -real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := t2 + t10
+	t17 := t2 - t10
+	t18 := w4 * t14
+	t19 := t6 + t18
+	t20 := t6 - t18
+	t21 := w8 * t15
+	t22 := t7 + t21
+	t23 := t7 - t21
+	t24 := x9 + x17
+	t25 := x9 - x17
+	t26 := x1 + t24
+	t27 := complex(real(x1)-0.5*real(t24), imag(x1)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(imag(t28), -real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := x13 + x21
+	t33 := x13 - x21
+	t34 := x5 + t32
+	t35 := complex(real(x5)-0.5*real(t32), imag(x5)-0.5*imag(t32))
+	t36 := complex(0.8660254037844388*real(t33), 0.8660254037844388*imag(t33))
+	t37 := complex(imag(t36), -real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := t26 + t34
+	t41 := t26 - t34
+	t42 := w4 * t38
+	t43 := t30 + t42
+	t44 := t30 - t42
+	t45 := w8 * t39
+	t46 := t31 + t45
+	t47 := t31 - t45
+	t48 := x10 + x18
+	t49 := x10 - x18
+	t50 := x2 + t48
+	t51 := complex(real(x2)-0.5*real(t48), imag(x2)-0.5*imag(t48))
+	t52 := complex(0.8660254037844388*real(t49), 0.8660254037844388*imag(t49))
+	t53 := complex(imag(t52), -real(t52))
+	t54 := t51 + t53
+	t55 := t51 - t53
+	t56 := x14 + x22
+	t57 := x14 - x22
+	t58 := x6 + t56
+	t59 := complex(real(x6)-0.5*real(t56), imag(x6)-0.5*imag(t56))
+	t60 := complex(0.8660254037844388*real(t57), 0.8660254037844388*imag(t57))
+	t61 := complex(imag(t60), -real(t60))
+	t62 := t59 + t61
+	t63 := t59 - t61
+	t64 := t50 + t58
+	t65 := t50 - t58
+	t66 := w4 * t62
+	t67 := t54 + t66
+	t68 := t54 - t66
+	t69 := w8 * t63
+	t70 := t55 + t69
+	t71 := t55 - t69
+	t72 := x11 + x19
+	t73 := x11 - x19
+	t74 := x3 + t72
+	t75 := complex(real(x3)-0.5*real(t72), imag(x3)-0.5*imag(t72))
+	t76 := complex(0.8660254037844388*real(t73), 0.8660254037844388*imag(t73))
+	t77 := complex(imag(t76), -real(t76))
+	t78 := t75 + t77
+	t79 := t75 - t77
+	t80 := x15 + x23
+	t81 := x15 - x23
+	t82 := x7 + t80
+	t83 := complex(real(x7)-0.5*real(t80), imag(x7)-0.5*imag(t80))
+	t84 := complex(0.8660254037844388*real(t81), 0.8660254037844388*imag(t81))
+	t85 := complex(imag(t84), -real(t84))
+	t86 := t83 + t85
+	t87 := t83 - t85
+	t88 := t74 + t82
+	t89 := t74 - t82
+	t90 := w4 * t86
+	t91 := t78 + t90
+	t92 := t78 - t90
+	t93 := w8 * t87
+	t94 := t79 + t93
+	t95 := t79 - t93
+	t96 := t16 + t64
+	t97 := t16 - t64
+	t98 := t40 + t88
+	t99 := t40 - t88
+	t100 := complex(imag(t99), -real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w1 * t43
+	t106 := w2 * t67
+	t107 := w3 * t91
+	t108 := t19 + t106
+	t109 := t19 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(imag(t111), -real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+	t117 := w2 * t46
+	t118 := w4 * t70
+	t119 := complex(imag(t94), -real(t94))
+	t120 := t22 + t118
+	t121 := t22 - t118
+	t122 := t117 + t119
+	t123 := t117 - t119
+	t124 := complex(imag(t123), -real(t123))
+	t125 := t120 + t122
+	t126 := t121 + t124
+	t127 := t120 - t122
+	t128 := t121 - t124
+	t129 := w3 * t41
+	t130 := complex(imag(t65), -real(t65))
+	t131 := w9 * t89
+	t132 := t17 + t130
+	t133 := t17 - t130
+	t134 := t129 + t131
+	t135 := t129 - t131
+	t136 := complex(imag(t135), -real(t135))
+	t137 := t132 + t134
+	t138 := t133 + t136
+	t139 := t132 - t134
+	t140 := t133 - t136
+	t141 := w4 * t44
+	t142 := w8 * t68
+	t143 := -t92
+	t144 := t20 + t142
+	t145 := t20 - t142
+	t146 := t141 + t143
+	t147 := t141 - t143
+	t148 := complex(imag(t147), -real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := w5 * t47
+	t154 := w10 * t71
+	t155 := w15 * t95
+	t156 := t23 + t154
+	t157 := t23 - t154
+	t158 := t153 + t155
+	t159 := t153 - t155
+	t160 := complex(imag(t159), -real(t159))
+	t161 := t156 + t158
+	t162 := t157 + t160
+	t163 := t156 - t158
+	t164 := t157 - t160
+
+	y := dst[:n]
+	y[0] = t101
+	y[1] = t113
+	y[2] = t125
+	y[3] = t137
+	y[4] = t149
+	y[5] = t161
+	y[6] = t102
+	y[7] = t114
+	y[8] = t126
+	y[9] = t138
+	y[10] = t150
+	y[11] = t162
+	y[12] = t103
+	y[13] = t115
+	y[14] = t127
+	y[15] = t139
+	y[16] = t151
+	y[17] = t163
+	y[18] = t104
+	y[19] = t116
+	y[20] = t128
+	y[21] = t140
+	y[22] = t152
+	y[23] = t164
+
+	return true
+}
+
+// inverseDIT24Radix4x2x3Complex64 computes a 24-point inverse FFT of complex64 data
+// with radix-4, radix-2, radix-3 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT24Radix4x2x3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 24
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w5 := complex(real(tw[5]), -imag(tw[5]))
+	w10 := complex(real(tw[10]), -imag(tw[10]))
+	w15 := complex(real(tw[15]), -imag(tw[15]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(-imag(t4), real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x12 + x20
+	t9 := x12 - x20
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(-imag(t12), real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := t2 + t10
+	t17 := t2 - t10
+	t18 := w4 * t14
+	t19 := t6 + t18
+	t20 := t6 - t18
+	t21 := w8 * t15
+	t22 := t7 + t21
+	t23 := t7 - t21
+	t24 := x9 + x17
+	t25 := x9 - x17
+	t26 := x1 + t24
+	t27 := complex(real(x1)-0.5*real(t24), imag(x1)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(-imag(t28), real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := x13 + x21
+	t33 := x13 - x21
+	t34 := x5 + t32
+	t35 := complex(real(x5)-0.5*real(t32), imag(x5)-0.5*imag(t32))
+	t36 := complex(0.8660254037844388*real(t33), 0.8660254037844388*imag(t33))
+	t37 := complex(-imag(t36), real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := t26 + t34
+	t41 := t26 - t34
+	t42 := w4 * t38
+	t43 := t30 + t42
+	t44 := t30 - t42
+	t45 := w8 * t39
+	t46 := t31 + t45
+	t47 := t31 - t45
+	t48 := x10 + x18
+	t49 := x10 - x18
+	t50 := x2 + t48
+	t51 := complex(real(x2)-0.5*real(t48), imag(x2)-0.5*imag(t48))
+	t52 := complex(0.8660254037844388*real(t49), 0.8660254037844388*imag(t49))
+	t53 := complex(-imag(t52), real(t52))
+	t54 := t51 + t53
+	t55 := t51 - t53
+	t56 := x14 + x22
+	t57 := x14 - x22
+	t58 := x6 + t56
+	t59 := complex(real(x6)-0.5*real(t56), imag(x6)-0.5*imag(t56))
+	t60 := complex(0.8660254037844388*real(t57), 0.8660254037844388*imag(t57))
+	t61 := complex(-imag(t60), real(t60))
+	t62 := t59 + t61
+	t63 := t59 - t61
+	t64 := t50 + t58
+	t65 := t50 - t58
+	t66 := w4 * t62
+	t67 := t54 + t66
+	t68 := t54 - t66
+	t69 := w8 * t63
+	t70 := t55 + t69
+	t71 := t55 - t69
+	t72 := x11 + x19
+	t73 := x11 - x19
+	t74 := x3 + t72
+	t75 := complex(real(x3)-0.5*real(t72), imag(x3)-0.5*imag(t72))
+	t76 := complex(0.8660254037844388*real(t73), 0.8660254037844388*imag(t73))
+	t77 := complex(-imag(t76), real(t76))
+	t78 := t75 + t77
+	t79 := t75 - t77
+	t80 := x15 + x23
+	t81 := x15 - x23
+	t82 := x7 + t80
+	t83 := complex(real(x7)-0.5*real(t80), imag(x7)-0.5*imag(t80))
+	t84 := complex(0.8660254037844388*real(t81), 0.8660254037844388*imag(t81))
+	t85 := complex(-imag(t84), real(t84))
+	t86 := t83 + t85
+	t87 := t83 - t85
+	t88 := t74 + t82
+	t89 := t74 - t82
+	t90 := w4 * t86
+	t91 := t78 + t90
+	t92 := t78 - t90
+	t93 := w8 * t87
+	t94 := t79 + t93
+	t95 := t79 - t93
+	t96 := t16 + t64
+	t97 := t16 - t64
+	t98 := t40 + t88
+	t99 := t40 - t88
+	t100 := complex(-imag(t99), real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w1 * t43
+	t106 := w2 * t67
+	t107 := w3 * t91
+	t108 := t19 + t106
+	t109 := t19 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(-imag(t111), real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+	t117 := w2 * t46
+	t118 := w4 * t70
+	t119 := complex(-imag(t94), real(t94))
+	t120 := t22 + t118
+	t121 := t22 - t118
+	t122 := t117 + t119
+	t123 := t117 - t119
+	t124 := complex(-imag(t123), real(t123))
+	t125 := t120 + t122
+	t126 := t121 + t124
+	t127 := t120 - t122
+	t128 := t121 - t124
+	t129 := w3 * t41
+	t130 := complex(-imag(t65), real(t65))
+	t131 := w9 * t89
+	t132 := t17 + t130
+	t133 := t17 - t130
+	t134 := t129 + t131
+	t135 := t129 - t131
+	t136 := complex(-imag(t135), real(t135))
+	t137 := t132 + t134
+	t138 := t133 + t136
+	t139 := t132 - t134
+	t140 := t133 - t136
+	t141 := w4 * t44
+	t142 := w8 * t68
+	t143 := -t92
+	t144 := t20 + t142
+	t145 := t20 - t142
+	t146 := t141 + t143
+	t147 := t141 - t143
+	t148 := complex(-imag(t147), real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := w5 * t47
+	t154 := w10 * t71
+	t155 := w15 * t95
+	t156 := t23 + t154
+	t157 := t23 - t154
+	t158 := t153 + t155
+	t159 := t153 - t155
+	t160 := complex(-imag(t159), real(t159))
+	t161 := t156 + t158
+	t162 := t157 + t160
+	t163 := t156 - t158
+	t164 := t157 - t160
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t101)*scale, imag(t101)*scale)
+	y[1] = complex(real(t113)*scale, imag(t113)*scale)
+	y[2] = complex(real(t125)*scale, imag(t125)*scale)
+	y[3] = complex(real(t137)*scale, imag(t137)*scale)
+	y[4] = complex(real(t149)*scale, imag(t149)*scale)
+	y[5] = complex(real(t161)*scale, imag(t161)*scale)
+	y[6] = complex(real(t102)*scale, imag(t102)*scale)
+	y[7] = complex(real(t114)*scale, imag(t114)*scale)
+	y[8] = complex(real(t126)*scale, imag(t126)*scale)
+	y[9] = complex(real(t138)*scale, imag(t138)*scale)
+	y[10] = complex(real(t150)*scale, imag(t150)*scale)
+	y[11] = complex(real(t162)*scale, imag(t162)*scale)
+	y[12] = complex(real(t103)*scale, imag(t103)*scale)
+	y[13] = complex(real(t115)*scale, imag(t115)*scale)
+	y[14] = complex(real(t127)*scale, imag(t127)*scale)
+	y[15] = complex(real(t139)*scale, imag(t139)*scale)
+	y[16] = complex(real(t151)*scale, imag(t151)*scale)
+	y[17] = complex(real(t163)*scale, imag(t163)*scale)
+	y[18] = complex(real(t104)*scale, imag(t104)*scale)
+	y[19] = complex(real(t116)*scale, imag(t116)*scale)
+	y[20] = complex(real(t128)*scale, imag(t128)*scale)
+	y[21] = complex(real(t140)*scale, imag(t140)*scale)
+	y[22] = complex(real(t152)*scale, imag(t152)*scale)
+	y[23] = complex(real(t164)*scale, imag(t164)*scale)
+
+	return true
+}
+
+// forwardDIT24Radix4x2x3Complex128 computes a 24-point forward FFT of complex128 data
+// with radix-4, radix-2, radix-3 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT24Radix4x2x3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 24
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := tw[4]
+	w8 := tw[8]
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w9 := tw[9]
+	w5 := tw[5]
+	w10 := tw[10]
+	w15 := tw[15]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(imag(t4), -real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x12 + x20
+	t9 := x12 - x20
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(imag(t12), -real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := t2 + t10
+	t17 := t2 - t10
+	t18 := w4 * t14
+	t19 := t6 + t18
+	t20 := t6 - t18
+	t21 := w8 * t15
+	t22 := t7 + t21
+	t23 := t7 - t21
+	t24 := x9 + x17
+	t25 := x9 - x17
+	t26 := x1 + t24
+	t27 := complex(real(x1)-0.5*real(t24), imag(x1)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(imag(t28), -real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := x13 + x21
+	t33 := x13 - x21
+	t34 := x5 + t32
+	t35 := complex(real(x5)-0.5*real(t32), imag(x5)-0.5*imag(t32))
+	t36 := complex(0.8660254037844388*real(t33), 0.8660254037844388*imag(t33))
+	t37 := complex(imag(t36), -real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := t26 + t34
+	t41 := t26 - t34
+	t42 := w4 * t38
+	t43 := t30 + t42
+	t44 := t30 - t42
+	t45 := w8 * t39
+	t46 := t31 + t45
+	t47 := t31 - t45
+	t48 := x10 + x18
+	t49 := x10 - x18
+	t50 := x2 + t48
+	t51 := complex(real(x2)-0.5*real(t48), imag(x2)-0.5*imag(t48))
+	t52 := complex(0.8660254037844388*real(t49), 0.8660254037844388*imag(t49))
+	t53 := complex(imag(t52), -real(t52))
+	t54 := t51 + t53
+	t55 := t51 - t53
+	t56 := x14 + x22
+	t57 := x14 - x22
+	t58 := x6 + t56
+	t59 := complex(real(x6)-0.5*real(t56), imag(x6)-0.5*imag(t56))
+	t60 := complex(0.8660254037844388*real(t57), 0.8660254037844388*imag(t57))
+	t61 := complex(imag(t60), -real(t60))
+	t62 := t59 + t61
+	t63 := t59 - t61
+	t64 := t50 + t58
+	t65 := t50 - t58
+	t66 := w4 * t62
+	t67 := t54 + t66
+	t68 := t54 - t66
+	t69 := w8 * t63
+	t70 := t55 + t69
+	t71 := t55 - t69
+	t72 := x11 + x19
+	t73 := x11 - x19
+	t74 := x3 + t72
+	t75 := complex(real(x3)-0.5*real(t72), imag(x3)-0.5*imag(t72))
+	t76 := complex(0.8660254037844388*real(t73), 0.8660254037844388*imag(t73))
+	t77 := complex(imag(t76), -real(t76))
+	t78 := t75 + t77
+	t79 := t75 - t77
+	t80 := x15 + x23
+	t81 := x15 - x23
+	t82 := x7 + t80
+	t83 := complex(real(x7)-0.5*real(t80), imag(x7)-0.5*imag(t80))
+	t84 := complex(0.8660254037844388*real(t81), 0.8660254037844388*imag(t81))
+	t85 := complex(imag(t84), -real(t84))
+	t86 := t83 + t85
+	t87 := t83 - t85
+	t88 := t74 + t82
+	t89 := t74 - t82
+	t90 := w4 * t86
+	t91 := t78 + t90
+	t92 := t78 - t90
+	t93 := w8 * t87
+	t94 := t79 + t93
+	t95 := t79 - t93
+	t96 := t16 + t64
+	t97 := t16 - t64
+	t98 := t40 + t88
+	t99 := t40 - t88
+	t100 := complex(imag(t99), -real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w1 * t43
+	t106 := w2 * t67
+	t107 := w3 * t91
+	t108 := t19 + t106
+	t109 := t19 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(imag(t111), -real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+	t117 := w2 * t46
+	t118 := w4 * t70
+	t119 := complex(imag(t94), -real(t94))
+	t120 := t22 + t118
+	t121 := t22 - t118
+	t122 := t117 + t119
+	t123 := t117 - t119
+	t124 := complex(imag(t123), -real(t123))
+	t125 := t120 + t122
+	t126 := t121 + t124
+	t127 := t120 - t122
+	t128 := t121 - t124
+	t129 := w3 * t41
+	t130 := complex(imag(t65), -real(t65))
+	t131 := w9 * t89
+	t132 := t17 + t130
+	t133 := t17 - t130
+	t134 := t129 + t131
+	t135 := t129 - t131
+	t136 := complex(imag(t135), -real(t135))
+	t137 := t132 + t134
+	t138 := t133 + t136
+	t139 := t132 - t134
+	t140 := t133 - t136
+	t141 := w4 * t44
+	t142 := w8 * t68
+	t143 := -t92
+	t144 := t20 + t142
+	t145 := t20 - t142
+	t146 := t141 + t143
+	t147 := t141 - t143
+	t148 := complex(imag(t147), -real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := w5 * t47
+	t154 := w10 * t71
+	t155 := w15 * t95
+	t156 := t23 + t154
+	t157 := t23 - t154
+	t158 := t153 + t155
+	t159 := t153 - t155
+	t160 := complex(imag(t159), -real(t159))
+	t161 := t156 + t158
+	t162 := t157 + t160
+	t163 := t156 - t158
+	t164 := t157 - t160
+
+	y := dst[:n]
+	y[0] = t101
+	y[1] = t113
+	y[2] = t125
+	y[3] = t137
+	y[4] = t149
+	y[5] = t161
+	y[6] = t102
+	y[7] = t114
+	y[8] = t126
+	y[9] = t138
+	y[10] = t150
+	y[11] = t162
+	y[12] = t103
+	y[13] = t115
+	y[14] = t127
+	y[15] = t139
+	y[16] = t151
+	y[17] = t163
+	y[18] = t104
+	y[19] = t116
+	y[20] = t128
+	y[21] = t140
+	y[22] = t152
+	y[23] = t164
+
+	return true
+}
+
+// inverseDIT24Radix4x2x3Complex128 computes a 24-point inverse FFT of complex128 data
+// with radix-4, radix-2, radix-3 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT24Radix4x2x3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 24
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w5 := complex(real(tw[5]), -imag(tw[5]))
+	w10 := complex(real(tw[10]), -imag(tw[10]))
+	w15 := complex(real(tw[15]), -imag(tw[15]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(-imag(t4), real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x12 + x20
+	t9 := x12 - x20
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(-imag(t12), real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := t2 + t10
+	t17 := t2 - t10
+	t18 := w4 * t14
+	t19 := t6 + t18
+	t20 := t6 - t18
+	t21 := w8 * t15
+	t22 := t7 + t21
+	t23 := t7 - t21
+	t24 := x9 + x17
+	t25 := x9 - x17
+	t26 := x1 + t24
+	t27 := complex(real(x1)-0.5*real(t24), imag(x1)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(-imag(t28), real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := x13 + x21
+	t33 := x13 - x21
+	t34 := x5 + t32
+	t35 := complex(real(x5)-0.5*real(t32), imag(x5)-0.5*imag(t32))
+	t36 := complex(0.8660254037844388*real(t33), 0.8660254037844388*imag(t33))
+	t37 := complex(-imag(t36), real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := t26 + t34
+	t41 := t26 - t34
+	t42 := w4 * t38
+	t43 := t30 + t42
+	t44 := t30 - t42
+	t45 := w8 * t39
+	t46 := t31 + t45
+	t47 := t31 - t45
+	t48 := x10 + x18
+	t49 := x10 - x18
+	t50 := x2 + t48
+	t51 := complex(real(x2)-0.5*real(t48), imag(x2)-0.5*imag(t48))
+	t52 := complex(0.8660254037844388*real(t49), 0.8660254037844388*imag(t49))
+	t53 := complex(-imag(t52), real(t52))
+	t54 := t51 + t53
+	t55 := t51 - t53
+	t56 := x14 + x22
+	t57 := x14 - x22
+	t58 := x6 + t56
+	t59 := complex(real(x6)-0.5*real(t56), imag(x6)-0.5*imag(t56))
+	t60 := complex(0.8660254037844388*real(t57), 0.8660254037844388*imag(t57))
+	t61 := complex(-imag(t60), real(t60))
+	t62 := t59 + t61
+	t63 := t59 - t61
+	t64 := t50 + t58
+	t65 := t50 - t58
+	t66 := w4 * t62
+	t67 := t54 + t66
+	t68 := t54 - t66
+	t69 := w8 * t63
+	t70 := t55 + t69
+	t71 := t55 - t69
+	t72 := x11 + x19
+	t73 := x11 - x19
+	t74 := x3 + t72
+	t75 := complex(real(x3)-0.5*real(t72), imag(x3)-0.5*imag(t72))
+	t76 := complex(0.8660254037844388*real(t73), 0.8660254037844388*imag(t73))
+	t77 := complex(-imag(t76), real(t76))
+	t78 := t75 + t77
+	t79 := t75 - t77
+	t80 := x15 + x23
+	t81 := x15 - x23
+	t82 := x7 + t80
+	t83 := complex(real(x7)-0.5*real(t80), imag(x7)-0.5*imag(t80))
+	t84 := complex(0.8660254037844388*real(t81), 0.8660254037844388*imag(t81))
+	t85 := complex(-imag(t84), real(t84))
+	t86 := t83 + t85
+	t87 := t83 - t85
+	t88 := t74 + t82
+	t89 := t74 - t82
+	t90 := w4 * t86
+	t91 := t78 + t90
+	t92 := t78 - t90
+	t93 := w8 * t87
+	t94 := t79 + t93
+	t95 := t79 - t93
+	t96 := t16 + t64
+	t97 := t16 - t64
+	t98 := t40 + t88
+	t99 := t40 - t88
+	t100 := complex(-imag(t99), real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w1 * t43
+	t106 := w2 * t67
+	t107 := w3 * t91
+	t108 := t19 + t106
+	t109 := t19 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(-imag(t111), real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+	t117 := w2 * t46
+	t118 := w4 * t70
+	t119 := complex(-imag(t94), real(t94))
+	t120 := t22 + t118
+	t121 := t22 - t118
+	t122 := t117 + t119
+	t123 := t117 - t119
+	t124 := complex(-imag(t123), real(t123))
+	t125 := t120 + t122
+	t126 := t121 + t124
+	t127 := t120 - t122
+	t128 := t121 - t124
+	t129 := w3 * t41
+	t130 := complex(-imag(t65), real(t65))
+	t131 := w9 * t89
+	t132 := t17 + t130
+	t133 := t17 - t130
+	t134 := t129 + t131
+	t135 := t129 - t131
+	t136 := complex(-imag(t135), real(t135))
+	t137 := t132 + t134
+	t138 := t133 + t136
+	t139 := t132 - t134
+	t140 := t133 - t136
+	t141 := w4 * t44
+	t142 := w8 * t68
+	t143 := -t92
+	t144 := t20 + t142
+	t145 := t20 - t142
+	t146 := t141 + t143
+	t147 := t141 - t143
+	t148 := complex(-imag(t147), real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := w5 * t47
+	t154 := w10 * t71
+	t155 := w15 * t95
+	t156 := t23 + t154
+	t157 := t23 - t154
+	t158 := t153 + t155
+	t159 := t153 - t155
+	t160 := complex(-imag(t159), real(t159))
+	t161 := t156 + t158
+	t162 := t157 + t160
+	t163 := t156 - t158
+	t164 := t157 - t160
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t101)*scale, imag(t101)*scale)
+	y[1] = complex(real(t113)*scale, imag(t113)*scale)
+	y[2] = complex(real(t125)*scale, imag(t125)*scale)
+	y[3] = complex(real(t137)*scale, imag(t137)*scale)
+	y[4] = complex(real(t149)*scale, imag(t149)*scale)
+	y[5] = complex(real(t161)*scale, imag(t161)*scale)
+	y[6] = complex(real(t102)*scale, imag(t102)*scale)
+	y[7] = complex(real(t114)*scale, imag(t114)*scale)
+	y[8] = complex(real(t126)*scale, imag(t126)*scale)
+	y[9] = complex(real(t138)*scale, imag(t138)*scale)
+	y[10] = complex(real(t150)*scale, imag(t150)*scale)
+	y[11] = complex(real(t162)*scale, imag(t162)*scale)
+	y[12] = complex(real(t103)*scale, imag(t103)*scale)
+	y[13] = complex(real(t115)*scale, imag(t115)*scale)
+	y[14] = complex(real(t127)*scale, imag(t127)*scale)
+	y[15] = complex(real(t139)*scale, imag(t139)*scale)
+	y[16] = complex(real(t151)*scale, imag(t151)*scale)
+	y[17] = complex(real(t163)*scale, imag(t163)*scale)
+	y[18] = complex(real(t104)*scale, imag(t104)*scale)
+	y[19] = complex(real(t116)*scale, imag(t116)*scale)
+	y[20] = complex(real(t128)*scale, imag(t128)*scale)
+	y[21] = complex(real(t140)*scale, imag(t140)*scale)
+	y[22] = complex(real(t152)*scale, imag(t152)*scale)
+	y[23] = complex(real(t164)*scale, imag(t164)*scale)
+
+	return true
+}
@@ -0,0 +1,2175 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT48Radix4x4x3Complex64 computes a 48-point forward FFT of complex64 data
+// with radix-4, radix-4, radix-3 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT48Radix4x4x3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 48
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := tw[4]
+	w8 := tw[8]
+	w16 := tw[16]
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w6 := tw[6]
+	w9 := tw[9]
+	w5 := tw[5]
+	w10 := tw[10]
+	w15 := tw[15]
+	w18 := tw[18]
+	w7 := tw[7]
+	w14 := tw[14]
+	w21 := tw[21]
+	w27 := tw[27]
+	w20 := tw[20]
+	w30 := tw[30]
+	w11 := tw[11]
+	w22 := tw[22]
+	w33 := tw[33]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(imag(t4), -real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x20 + x36
+	t9 := x20 - x36
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(imag(t12), -real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x24 + x40
+	t17 := x24 - x40
+	t18 := x8 + t16
+	t19 := complex(real(x8)-0.5*real(t16), imag(x8)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(imag(t20), -real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x28 + x44
+	t25 := x28 - x44
+	t26 := x12 + t24
+	t27 := complex(real(x12)-0.5*real(t24), imag(x12)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(imag(t28), -real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := t2 + t18
+	t33 := t2 - t18
+	t34 := t10 + t26
+	t35 := t10 - t26
+	t36 := complex(imag(t35), -real(t35))
+	t37 := t32 + t34
+	t38 := t33 + t36
+	t39 := t32 - t34
+	t40 := t33 - t36
+	t41 := w4 * t14
+	t42 := w8 * t22
+	t43 := complex(imag(t30), -real(t30))
+	t44 := t6 + t42
+	t45 := t6 - t42
+	t46 := t41 + t43
+	t47 := t41 - t43
+	t48 := complex(imag(t47), -real(t47))
+	t49 := t44 + t46
+	t50 := t45 + t48
+	t51 := t44 - t46
+	t52 := t45 - t48
+	t53 := w8 * t15
+	t54 := w16 * t23
+	t55 := -t31
+	t56 := t7 + t54
+	t57 := t7 - t54
+	t58 := t53 + t55
+	t59 := t53 - t55
+	t60 := complex(imag(t59), -real(t59))
+	t61 := t56 + t58
+	t62 := t57 + t60
+	t63 := t56 - t58
+	t64 := t57 - t60
+	t65 := x17 + x33
+	t66 := x17 - x33
+	t67 := x1 + t65
+	t68 := complex(real(x1)-0.5*real(t65), imag(x1)-0.5*imag(t65))
+	t69 := complex(0.8660254037844388*real(t66), 0.8660254037844388*imag(t66))
+	t70 := complex(imag(t69), -real(t69))
+	t71 := t68 + t70
+	t72 := t68 - t70
+	t73 := x21 + x37
+	t74 := x21 - x37
+	t75 := x5 + t73
+	t76 := complex(real(x5)-0.5*real(t73), imag(x5)-0.5*imag(t73))
+	t77 := complex(0.8660254037844388*real(t74), 0.8660254037844388*imag(t74))
+	t78 := complex(imag(t77), -real(t77))
+	t79 := t76 + t78
+	t80 := t76 - t78
+	t81 := x25 + x41
+	t82 := x25 - x41
+	t83 := x9 + t81
+	t84 := complex(real(x9)-0.5*real(t81), imag(x9)-0.5*imag(t81))
+	t85 := complex(0.8660254037844388*real(t82), 0.8660254037844388*imag(t82))
+	t86 := complex(imag(t85), -real(t85))
+	t87 := t84 + t86
+	t88 := t84 - t86
+	t89 := x29 + x45
+	t90 := x29 - x45
+	t91 := x13 + t89
+	t92 := complex(real(x13)-0.5*real(t89), imag(x13)-0.5*imag(t89))
+	t93 := complex(0.8660254037844388*real(t90), 0.8660254037844388*imag(t90))
+	t94 := complex(imag(t93), -real(t93))
+	t95 := t92 + t94
+	t96 := t92 - t94
+	t97 := t67 + t83
+	t98 := t67 - t83
+	t99 := t75 + t91
+	t100 := t75 - t91
+	t101 := complex(imag(t100), -real(t100))
+	t102 := t97 + t99
+	t103 := t98 + t101
+	t104 := t97 - t99
+	t105 := t98 - t101
+	t106 := w4 * t79
+	t107 := w8 * t87
+	t108 := complex(imag(t95), -real(t95))
+	t109 := t71 + t107
+	t110 := t71 - t107
+	t111 := t106 + t108
+	t112 := t106 - t108
+	t113 := complex(imag(t112), -real(t112))
+	t114 := t109 + t111
+	t115 := t110 + t113
+	t116 := t109 - t111
+	t117 := t110 - t113
+	t118 := w8 * t80
+	t119 := w16 * t88
+	t120 := -t96
+	t121 := t72 + t119
+	t122 := t72 - t119
+	t123 := t118 + t120
+	t124 := t118 - t120
+	t125 := complex(imag(t124), -real(t124))
+	t126 := t121 + t123
+	t127 := t122 + t125
+	t128 := t121 - t123
+	t129 := t122 - t125
+	t130 := x18 + x34
+	t131 := x18 - x34
+	t132 := x2 + t130
+	t133 := complex(real(x2)-0.5*real(t130), imag(x2)-0.5*imag(t130))
+	t134 := complex(0.8660254037844388*real(t131), 0.8660254037844388*imag(t131))
+	t135 := complex(imag(t134), -real(t134))
+	t136 := t133 + t135
+	t137 := t133 - t135
+	t138 := x22 + x38
+	t139 := x22 - x38
+	t140 := x6 + t138
+	t141 := complex(real(x6)-0.5*real(t138), imag(x6)-0.5*imag(t138))
+	t142 := complex(0.8660254037844388*real(t139), 0.8660254037844388*imag(t139))
+	t143 := complex(imag(t142), -real(t142))
+	t144 := t141 + t143
+	t145 := t141 - t143
+	t146 := x26 + x42
+	t147 := x26 - x42
+	t148 := x10 + t146
+	t149 := complex(real(x10)-0.5*real(t146), imag(x10)-0.5*imag(t146))
+	t150 := complex(0.8660254037844388*real(t147), 0.8660254037844388*imag(t147))
+	t151 := complex(imag(t150), -real(t150))
+	t152 := t149 + t151
+	t153 := t149 - t151
+	t154 := x30 + x46
+	t155 := x30 - x46
+	t156 := x14 + t154
+	t157 := complex(real(x14)-0.5*real(t154), imag(x14)-0.5*imag(t154))
+	t158 := complex(0.8660254037844388*real(t155), 0.8660254037844388*imag(t155))
+	t159 := complex(imag(t158), -real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := t132 + t148
+	t163 := t132 - t148
+	t164 := t140 + t156
+	t165 := t140 - t156
+	t166 := complex(imag(t165), -real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := w4 * t144
+	t172 := w8 * t152
+	t173 := complex(imag(t160), -real(t160))
+	t174 := t136 + t172
+	t175 := t136 - t172
+	t176 := t171 + t173
+	t177 := t171 - t173
+	t178 := complex(imag(t177), -real(t177))
+	t179 := t174 + t176
+	t180 := t175 + t178
+	t181 := t174 - t176
+	t182 := t175 - t178
+	t183 := w8 * t145
+	t184 := w16 * t153
+	t185 := -t161
+	t186 := t137 + t184
+	t187 := t137 - t184
+	t188 := t183 + t185
+	t189 := t183 - t185
+	t190 := complex(imag(t189), -real(t189))
+	t191 := t186 + t188
+	t192 := t187 + t190
+	t193 := t186 - t188
+	t194 := t187 - t190
+	t195 := x19 + x35
+	t196 := x19 - x35
+	t197 := x3 + t195
+	t198 := complex(real(x3)-0.5*real(t195), imag(x3)-0.5*imag(t195))
+	t199 := complex(0.8660254037844388*real(t196), 0.8660254037844388*imag(t196))
+	t200 := complex(imag(t199), -real(t199))
+	t201 := t198 + t200
+	t202 := t198 - t200
+	t203 := x23 + x39
+	t204 := x23 - x39
+	t205 := x7 + t203
+	t206 := complex(real(x7)-0.5*real(t203), imag(x7)-0.5*imag(t203))
+	t207 := complex(0.8660254037844388*real(t204), 0.8660254037844388*imag(t204))
+	t208 := complex(imag(t207), -real(t207))
+	t209 := t206 + t208
+	t210 := t206 - t208
+	t211 := x27 + x43
+	t212 := x27 - x43
+	t213 := x11 + t211
+	t214 := complex(real(x11)-0.5*real(t211), imag(x11)-0.5*imag(t211))
+	t215 := complex(0.8660254037844388*real(t212), 0.8660254037844388*imag(t212))
+	t216 := complex(imag(t215), -real(t215))
+	t217 := t214 + t216
+	t218 := t214 - t216
+	t219 := x31 + x47
+	t220 := x31 - x47
+	t221 := x15 + t219
+	t222 := complex(real(x15)-0.5*real(t219), imag(x15)-0.5*imag(t219))
+	t223 := complex(0.8660254037844388*real(t220), 0.8660254037844388*imag(t220))
+	t224 := complex(imag(t223), -real(t223))
+	t225 := t222 + t224
+	t226 := t222 - t224
+	t227 := t197 + t213
+	t228 := t197 - t213
+	t229 := t205 + t221
+	t230 := t205 - t221
+	t231 := complex(imag(t230), -real(t230))
+	t232 := t227 + t229
+	t233 := t228 + t231
+	t234 := t227 - t229
+	t235 := t228 - t231
+	t236 := w4 * t209
+	t237 := w8 * t217
+	t238 := complex(imag(t225), -real(t225))
+	t239 := t201 + t237
+	t240 := t201 - t237
+	t241 := t236 + t238
+	t242 := t236 - t238
+	t243 := complex(imag(t242), -real(t242))
+	t244 := t239 + t241
+	t245 := t240 + t243
+	t246 := t239 - t241
+	t247 := t240 - t243
+	t248 := w8 * t210
+	t249 := w16 * t218
+	t250 := -t226
+	t251 := t202 + t249
+	t252 := t202 - t249
+	t253 := t248 + t250
+	t254 := t248 - t250
+	t255 := complex(imag(t254), -real(t254))
+	t256 := t251 + t253
+	t257 := t252 + t255
+	t258 := t251 - t253
+	t259 := t252 - t255
+	t260 := t37 + t167
+	t261 := t37 - t167
+	t262 := t102 + t232
+	t263 := t102 - t232
+	t264 := complex(imag(t263), -real(t263))
+	t265 := t260 + t262
+	t266 := t261 + t264
+	t267 := t260 - t262
+	t268 := t261 - t264
+	t269 := w1 * t114
+	t270 := w2 * t179
+	t271 := w3 * t244
+	t272 := t49 + t270
+	t273 := t49 - t270
+	t274 := t269 + t271
+	t275 := t269 - t271
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+	t281 := w2 * t126
+	t282 := w4 * t191
+	t283 := w6 * t256
+	t284 := t61 + t282
+	t285 := t61 - t282
+	t286 := t281 + t283
+	t287 := t281 - t283
+	t288 := complex(imag(t287), -real(t287))
+	t289 := t284 + t286
+	t290 := t285 + t288
+	t291 := t284 - t286
+	t292 := t285 - t288
+	t293 := w3 * t103
+	t294 := w6 * t168
+	t295 := w9 * t233
+	t296 := t38 + t294
+	t297 := t38 - t294
+	t298 := t293 + t295
+	t299 := t293 - t295
+	t300 := complex(imag(t299), -real(t299))
+	t301 := t296 + t298
+	t302 := t297 + t300
+	t303 := t296 - t298
+	t304 := t297 - t300
+	t305 := w4 * t115
+	t306 := w8 * t180
+	t307 := complex(imag(t245), -real(t245))
+	t308 := t50 + t306
+	t309 := t50 - t306
+	t310 := t305 + t307
+	t311 := t305 - t307
+	t312 := complex(imag(t311), -real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := w5 * t127
+	t318 := w10 * t192
+	t319 := w15 * t257
+	t320 := t62 + t318
+	t321 := t62 - t318
+	t322 := t317 + t319
+	t323 := t317 - t319
+	t324 := complex(imag(t323), -real(t323))
+	t325 := t320 + t322
+	t326 := t321 + t324
+	t327 := t320 - t322
+	t328 := t321 - t324
+	t329 := w6 * t104
+	t330 := complex(imag(t169), -real(t169))
+	t331 := w18 * t234
+	t332 := t39 + t330
+	t333 := t39 - t330
+	t334 := t329 + t331
+	t335 := t329 - t331
+	t336 := complex(imag(t335), -real(t335))
+	t337 := t332 + t334
+	t338 := t333 + t336
+	t339 := t332 - t334
+	t340 := t333 - t336
+	t341 := w7 * t116
+	t342 := w14 * t181
+	t343 := w21 * t246
+	t344 := t51 + t342
+	t345 := t51 - t342
+	t346 := t341 + t343
+	t347 := t341 - t343
+	t348 := complex(imag(t347), -real(t347))
+	t349 := t344 + t346
+	t350 := t345 + t348
+	t351 := t344 - t346
+	t352 := t345 - t348
+	t353 := w8 * t128
+	t354 := w16 * t193
+	t355 := -t258
+	t356 := t63 + t354
+	t357 := t63 - t354
+	t358 := t353 + t355
+	t359 := t353 - t355
+	t360 := complex(imag(t359), -real(t359))
+	t361 := t356 + t358
+	t362 := t357 + t360
+	t363 := t356 - t358
+	t364 := t357 - t360
+	t365 := w9 * t105
+	t366 := w18 * t170
+	t367 := w27 * t235
+	t368 := t40 + t366
+	t369 := t40 - t366
+	t370 := t365 + t367
+	t371 := t365 - t367
+	t372 := complex(imag(t371), -real(t371))
+	t373 := t368 + t370
+	t374 := t369 + t372
+	t375 := t368 - t370
+	t376 := t369 - t372
+	t377 := w10 * t117
+	t378 := w20 * t182
+	t379 := w30 * t247
+	t380 := t52 + t378
+	t381 := t52 - t378
+	t382 := t377 + t379
+	t383 := t377 - t379
+	t384 := complex(imag(t383), -real(t383))
+	t385 := t380 + t382
+	t386 := t381 + t384
+	t387 := t380 - t382
+	t388 := t381 - t384
+	t389 := w11 * t129
+	t390 := w22 * t194
+	t391 := w33 * t259
+	t392 := t64 + t390
+	t393 := t64 - t390
+	t394 := t389 + t391
+	t395 := t389 - t391
+	t396 := complex(imag(t395), -real(t395))
+	t397 := t392 + t394
+	t398 := t393 + t396
+	t399 := t392 - t394
+	t400 := t393 - t396
+
+	y := dst[:n]
+	y[0] = t265
+	y[1] = t277
+	y[2] = t289
+	y[3] = t301
+	y[4] = t313
+	y[5] = t325
+	y[6] = t337
+	y[7] = t349
+	y[8] = t361
+	y[9] = t373
+	y[10] = t385
+	y[11] = t397
+	y[12] = t266
+	y[13] = t278
+	y[14] = t290
+	y[15] = t302
+	y[16] = t314
+	y[17] = t326
+	y[18] = t338
+	y[19] = t350
+	y[20] = t362
+	y[21] = t374
+	y[22] = t386
+	y[23] = t398
+	y[24] = t267
+	y[25] = t279
+	y[26] = t291
+	y[27] = t303
+	y[28] = t315
+	y[29] = t327
+	y[30] = t339
+	y[31] = t351
+	y[32] = t363
+	y[33] = t375
+	y[34] = t387
+	y[35] = t399
+	y[36] = t268
+	y[37] = t280
+	y[38] = t292
+	y[39] = t304
+	y[40] = t316
+	y[41] = t328
+	y[42] = t340
+	y[43] = t352
+	y[44] = t364
+	y[45] = t376
+	y[46] = t388
+	y[47] = t400
+
+	return true
+}
+
+// inverseDIT48Radix4x4x3Complex64 computes a 48-point inverse FFT of complex64 data
+// with radix-4, radix-4, radix-3 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT48Radix4x4x3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 48
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w16 := complex(real(tw[16]), -imag(tw[16]))
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w6 := complex(real(tw[6]), -imag(tw[6]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w5 := complex(real(tw[5]), -imag(tw[5]))
+	w10 := complex(real(tw[10]), -imag(tw[10]))
+	w15 := complex(real(tw[15]), -imag(tw[15]))
+	w18 := complex(real(tw[18]), -imag(tw[18]))
+	w7 := complex(real(tw[7]), -imag(tw[7]))
+	w14 := complex(real(tw[14]), -imag(tw[14]))
+	w21 := complex(real(tw[21]), -imag(tw[21]))
+	w27 := complex(real(tw[27]), -imag(tw[27]))
+	w20 := complex(real(tw[20]), -imag(tw[20]))
+	w30 := complex(real(tw[30]), -imag(tw[30]))
+	w11 := complex(real(tw[11]), -imag(tw[11]))
+	w22 := complex(real(tw[22]), -imag(tw[22]))
+	w33 := complex(real(tw[33]), -imag(tw[33]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(-imag(t4), real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x20 + x36
+	t9 := x20 - x36
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(-imag(t12), real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x24 + x40
+	t17 := x24 - x40
+	t18 := x8 + t16
+	t19 := complex(real(x8)-0.5*real(t16), imag(x8)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(-imag(t20), real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x28 + x44
+	t25 := x28 - x44
+	t26 := x12 + t24
+	t27 := complex(real(x12)-0.5*real(t24), imag(x12)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(-imag(t28), real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := t2 + t18
+	t33 := t2 - t18
+	t34 := t10 + t26
+	t35 := t10 - t26
+	t36 := complex(-imag(t35), real(t35))
+	t37 := t32 + t34
+	t38 := t33 + t36
+	t39 := t32 - t34
+	t40 := t33 - t36
+	t41 := w4 * t14
+	t42 := w8 * t22
+	t43 := complex(-imag(t30), real(t30))
+	t44 := t6 + t42
+	t45 := t6 - t42
+	t46 := t41 + t43
+	t47 := t41 - t43
+	t48 := complex(-imag(t47), real(t47))
+	t49 := t44 + t46
+	t50 := t45 + t48
+	t51 := t44 - t46
+	t52 := t45 - t48
+	t53 := w8 * t15
+	t54 := w16 * t23
+	t55 := -t31
+	t56 := t7 + t54
+	t57 := t7 - t54
+	t58 := t53 + t55
+	t59 := t53 - t55
+	t60 := complex(-imag(t59), real(t59))
+	t61 := t56 + t58
+	t62 := t57 + t60
+	t63 := t56 - t58
+	t64 := t57 - t60
+	t65 := x17 + x33
+	t66 := x17 - x33
+	t67 := x1 + t65
+	t68 := complex(real(x1)-0.5*real(t65), imag(x1)-0.5*imag(t65))
+	t69 := complex(0.8660254037844388*real(t66), 0.8660254037844388*imag(t66))
+	t70 := complex(-imag(t69), real(t69))
+	t71 := t68 + t70
+	t72 := t68 - t70
+	t73 := x21 + x37
+	t74 := x21 - x37
+	t75 := x5 + t73
+	t76 := complex(real(x5)-0.5*real(t73), imag(x5)-0.5*imag(t73))
+	t77 := complex(0.8660254037844388*real(t74), 0.8660254037844388*imag(t74))
+	t78 := complex(-imag(t77), real(t77))
+	t79 := t76 + t78
+	t80 := t76 - t78
+	t81 := x25 + x41
+	t82 := x25 - x41
+	t83 := x9 + t81
+	t84 := complex(real(x9)-0.5*real(t81), imag(x9)-0.5*imag(t81))
+	t85 := complex(0.8660254037844388*real(t82), 0.8660254037844388*imag(t82))
+	t86 := complex(-imag(t85), real(t85))
+	t87 := t84 + t86
+	t88 := t84 - t86
+	t89 := x29 + x45
+	t90 := x29 - x45
+	t91 := x13 + t89
+	t92 := complex(real(x13)-0.5*real(t89), imag(x13)-0.5*imag(t89))
+	t93 := complex(0.8660254037844388*real(t90), 0.8660254037844388*imag(t90))
+	t94 := complex(-imag(t93), real(t93))
+	t95 := t92 + t94
+	t96 := t92 - t94
+	t97 := t67 + t83
+	t98 := t67 - t83
+	t99 := t75 + t91
+	t100 := t75 - t91
+	t101 := complex(-imag(t100), real(t100))
+	t102 := t97 + t99
+	t103 := t98 + t101
+	t104 := t97 - t99
+	t105 := t98 - t101
+	t106 := w4 * t79
+	t107 := w8 * t87
+	t108 := complex(-imag(t95), real(t95))
+	t109 := t71 + t107
+	t110 := t71 - t107
+	t111 := t106 + t108
+	t112 := t106 - t108
+	t113 := complex(-imag(t112), real(t112))
+	t114 := t109 + t111
+	t115 := t110 + t113
+	t116 := t109 - t111
+	t117 := t110 - t113
+	t118 := w8 * t80
+	t119 := w16 * t88
+	t120 := -t96
+	t121 := t72 + t119
+	t122 := t72 - t119
+	t123 := t118 + t120
+	t124 := t118 - t120
+	t125 := complex(-imag(t124), real(t124))
+	t126 := t121 + t123
+	t127 := t122 + t125
+	t128 := t121 - t123
+	t129 := t122 - t125
+	t130 := x18 + x34
+	t131 := x18 - x34
+	t132 := x2 + t130
+	t133 := complex(real(x2)-0.5*real(t130), imag(x2)-0.5*imag(t130))
+	t134 := complex(0.8660254037844388*real(t131), 0.8660254037844388*imag(t131))
+	t135 := complex(-imag(t134), real(t134))
+	t136 := t133 + t135
+	t137 := t133 - t135
+	t138 := x22 + x38
+	t139 := x22 - x38
+	t140 := x6 + t138
+	t141 := complex(real(x6)-0.5*real(t138), imag(x6)-0.5*imag(t138))
+	t142 := complex(0.8660254037844388*real(t139), 0.8660254037844388*imag(t139))
+	t143 := complex(-imag(t142), real(t142))
+	t144 := t141 + t143
+	t145 := t141 - t143
+	t146 := x26 + x42
+	t147 := x26 - x42
+	t148 := x10 + t146
+	t149 := complex(real(x10)-0.5*real(t146), imag(x10)-0.5*imag(t146))
+	t150 := complex(0.8660254037844388*real(t147), 0.8660254037844388*imag(t147))
+	t151 := complex(-imag(t150), real(t150))
+	t152 := t149 + t151
+	t153 := t149 - t151
+	t154 := x30 + x46
+	t155 := x30 - x46
+	t156 := x14 + t154
+	t157 := complex(real(x14)-0.5*real(t154), imag(x14)-0.5*imag(t154))
+	t158 := complex(0.8660254037844388*real(t155), 0.8660254037844388*imag(t155))
+	t159 := complex(-imag(t158), real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := t132 + t148
+	t163 := t132 - t148
+	t164 := t140 + t156
+	t165 := t140 - t156
+	t166 := complex(-imag(t165), real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := w4 * t144
+	t172 := w8 * t152
+	t173 := complex(-imag(t160), real(t160))
+	t174 := t136 + t172
+	t175 := t136 - t172
+	t176 := t171 + t173
+	t177 := t171 - t173
+	t178 := complex(-imag(t177), real(t177))
+	t179 := t174 + t176
+	t180 := t175 + t178
+	t181 := t174 - t176
+	t182 := t175 - t178
+	t183 := w8 * t145
+	t184 := w16 * t153
+	t185 := -t161
+	t186 := t137 + t184
+	t187 := t137 - t184
+	t188 := t183 + t185
+	t189 := t183 - t185
+	t190 := complex(-imag(t189), real(t189))
+	t191 := t186 + t188
+	t192 := t187 + t190
+	t193 := t186 - t188
+	t194 := t187 - t190
+	t195 := x19 + x35
+	t196 := x19 - x35
+	t197 := x3 + t195
+	t198 := complex(real(x3)-0.5*real(t195), imag(x3)-0.5*imag(t195))
+	t199 := complex(0.8660254037844388*real(t196), 0.8660254037844388*imag(t196))
+	t200 := complex(-imag(t199), real(t199))
+	t201 := t198 + t200
+	t202 := t198 - t200
+	t203 := x23 + x39
+	t204 := x23 - x39
+	t205 := x7 + t203
+	t206 := complex(real(x7)-0.5*real(t203), imag(x7)-0.5*imag(t203))
+	t207 := complex(0.8660254037844388*real(t204), 0.8660254037844388*imag(t204))
+	t208 := complex(-imag(t207), real(t207))
+	t209 := t206 + t208
+	t210 := t206 - t208
+	t211 := x27 + x43
+	t212 := x27 - x43
+	t213 := x11 + t211
+	t214 := complex(real(x11)-0.5*real(t211), imag(x11)-0.5*imag(t211))
+	t215 := complex(0.8660254037844388*real(t212), 0.8660254037844388*imag(t212))
+	t216 := complex(-imag(t215), real(t215))
+	t217 := t214 + t216
+	t218 := t214 - t216
+	t219 := x31 + x47
+	t220 := x31 - x47
+	t221 := x15 + t219
+	t222 := complex(real(x15)-0.5*real(t219), imag(x15)-0.5*imag(t219))
+	t223 := complex(0.8660254037844388*real(t220), 0.8660254037844388*imag(t220))
+	t224 := complex(-imag(t223), real(t223))
+	t225 := t222 + t224
+	t226 := t222 - t224
+	t227 := t197 + t213
+	t228 := t197 - t213
+	t229 := t205 + t221
+	t230 := t205 - t221
+	t231 := complex(-imag(t230), real(t230))
+	t232 := t227 + t229
+	t233 := t228 + t231
+	t234 := t227 - t229
+	t235 := t228 - t231
+	t236 := w4 * t209
+	t237 := w8 * t217
+	t238 := complex(-imag(t225), real(t225))
+	t239 := t201 + t237
+	t240 := t201 - t237
+	t241 := t236 + t238
+	t242 := t236 - t238
+	t243 := complex(-imag(t242), real(t242))
+	t244 := t239 + t241
+	t245 := t240 + t243
+	t246 := t239 - t241
+	t247 := t240 - t243
+	t248 := w8 * t210
+	t249 := w16 * t218
+	t250 := -t226
+	t251 := t202 + t249
+	t252 := t202 - t249
+	t253 := t248 + t250
+	t254 := t248 - t250
+	t255 := complex(-imag(t254), real(t254))
+	t256 := t251 + t253
+	t257 := t252 + t255
+	t258 := t251 - t253
+	t259 := t252 - t255
+	t260 := t37 + t167
+	t261 := t37 - t167
+	t262 := t102 + t232
+	t263 := t102 - t232
+	t264 := complex(-imag(t263), real(t263))
+	t265 := t260 + t262
+	t266 := t261 + t264
+	t267 := t260 - t262
+	t268 := t261 - t264
+	t269 := w1 * t114
+	t270 := w2 * t179
+	t271 := w3 * t244
+	t272 := t49 + t270
+	t273 := t49 - t270
+	t274 := t269 + t271
+	t275 := t269 - t271
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+	t281 := w2 * t126
+	t282 := w4 * t191
+	t283 := w6 * t256
+	t284 := t61 + t282
+	t285 := t61 - t282
+	t286 := t281 + t283
+	t287 := t281 - t283
+	t288 := complex(-imag(t287), real(t287))
+	t289 := t284 + t286
+	t290 := t285 + t288
+	t291 := t284 - t286
+	t292 := t285 - t288
+	t293 := w3 * t103
+	t294 := w6 * t168
+	t295 := w9 * t233
+	t296 := t38 + t294
+	t297 := t38 - t294
+	t298 := t293 + t295
+	t299 := t293 - t295
+	t300 := complex(-imag(t299), real(t299))
+	t301 := t296 + t298
+	t302 := t297 + t300
+	t303 := t296 - t298
+	t304 := t297 - t300
+	t305 := w4 * t115
+	t306 := w8 * t180
+	t307 := complex(-imag(t245), real(t245))
+	t308 := t50 + t306
+	t309 := t50 - t306
+	t310 := t305 + t307
+	t311 := t305 - t307
+	t312 := complex(-imag(t311), real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := w5 * t127
+	t318 := w10 * t192
+	t319 := w15 * t257
+	t320 := t62 + t318
+	t321 := t62 - t318
+	t322 := t317 + t319
+	t323 := t317 - t319
+	t324 := complex(-imag(t323), real(t323))
+	t325 := t320 + t322
+	t326 := t321 + t324
+	t327 := t320 - t322
+	t328 := t321 - t324
+	t329 := w6 * t104
+	t330 := complex(-imag(t169), real(t169))
+	t331 := w18 * t234
+	t332 := t39 + t330
+	t333 := t39 - t330
+	t334 := t329 + t331
+	t335 := t329 - t331
+	t336 := complex(-imag(t335), real(t335))
+	t337 := t332 + t334
+	t338 := t333 + t336
+	t339 := t332 - t334
+	t340 := t333 - t336
+	t341 := w7 * t116
+	t342 := w14 * t181
+	t343 := w21 * t246
+	t344 := t51 + t342
+	t345 := t51 - t342
+	t346 := t341 + t343
+	t347 := t341 - t343
+	t348 := complex(-imag(t347), real(t347))
+	t349 := t344 + t346
+	t350 := t345 + t348
+	t351 := t344 - t346
+	t352 := t345 - t348
+	t353 := w8 * t128
+	t354 := w16 * t193
+	t355 := -t258
+	t356 := t63 + t354
+	t357 := t63 - t354
+	t358 := t353 + t355
+	t359 := t353 - t355
+	t360 := complex(-imag(t359), real(t359))
+	t361 := t356 + t358
+	t362 := t357 + t360
+	t363 := t356 - t358
+	t364 := t357 - t360
+	t365 := w9 * t105
+	t366 := w18 * t170
+	t367 := w27 * t235
+	t368 := t40 + t366
+	t369 := t40 - t366
+	t370 := t365 + t367
+	t371 := t365 - t367
+	t372 := complex(-imag(t371), real(t371))
+	t373 := t368 + t370
+	t374 := t369 + t372
+	t375 := t368 - t370
+	t376 := t369 - t372
+	t377 := w10 * t117
+	t378 := w20 * t182
+	t379 := w30 * t247
+	t380 := t52 + t378
+	t381 := t52 - t378
+	t382 := t377 + t379
+	t383 := t377 - t379
+	t384 := complex(-imag(t383), real(t383))
+	t385 := t380 + t382
+	t386 := t381 + t384
+	t387 := t380 - t382
+	t388 := t381 - t384
+	t389 := w11 * t129
+	t390 := w22 * t194
+	t391 := w33 * t259
+	t392 := t64 + t390
+	t393 := t64 - t390
+	t394 := t389 + t391
+	t395 := t389 - t391
+	t396 := complex(-imag(t395), real(t395))
+	t397 := t392 + t394
+	t398 := t393 + t396
+	t399 := t392 - t394
+	t400 := t393 - t396
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t265)*scale, imag(t265)*scale)
+	y[1] = complex(real(t277)*scale, imag(t277)*scale)
+	y[2] = complex(real(t289)*scale, imag(t289)*scale)
+	y[3] = complex(real(t301)*scale, imag(t301)*scale)
+	y[4] = complex(real(t313)*scale, imag(t313)*scale)
+	y[5] = complex(real(t325)*scale, imag(t325)*scale)
+	y[6] = complex(real(t337)*scale, imag(t337)*scale)
+	y[7] = complex(real(t349)*scale, imag(t349)*scale)
+	y[8] = complex(real(t361)*scale, imag(t361)*scale)
+	y[9] = complex(real(t373)*scale, imag(t373)*scale)
+	y[10] = complex(real(t385)*scale, imag(t385)*scale)
+	y[11] = complex(real(t397)*scale, imag(t397)*scale)
+	y[12] = complex(real(t266)*scale, imag(t266)*scale)
+	y[13] = complex(real(t278)*scale, imag(t278)*scale)
+	y[14] = complex(real(t290)*scale, imag(t290)*scale)
+	y[15] = complex(real(t302)*scale, imag(t302)*scale)
+	y[16] = complex(real(t314)*scale, imag(t314)*scale)
+	y[17] = complex(real(t326)*scale, imag(t326)*scale)
+	y[18] = complex(real(t338)*scale, imag(t338)*scale)
+	y[19] = complex(real(t350)*scale, imag(t350)*scale)
+	y[20] = complex(real(t362)*scale, imag(t362)*scale)
+	y[21] = complex(real(t374)*scale, imag(t374)*scale)
+	y[22] = complex(real(t386)*scale, imag(t386)*scale)
+	y[23] = complex(real(t398)*scale, imag(t398)*scale)
+	y[24] = complex(real(t267)*scale, imag(t267)*scale)
+	y[25] = complex(real(t279)*scale, imag(t279)*scale)
+	y[26] = complex(real(t291)*scale, imag(t291)*scale)
+	y[27] = complex(real(t303)*scale, imag(t303)*scale)
+	y[28] = complex(real(t315)*scale, imag(t315)*scale)
+	y[29] = complex(real(t327)*scale, imag(t327)*scale)
+	y[30] = complex(real(t339)*scale, imag(t339)*scale)
+	y[31] = complex(real(t351)*scale, imag(t351)*scale)
+	y[32] = complex(real(t363)*scale, imag(t363)*scale)
+	y[33] = complex(real(t375)*scale, imag(t375)*scale)
+	y[34] = complex(real(t387)*scale, imag(t387)*scale)
+	y[35] = complex(real(t399)*scale, imag(t399)*scale)
+	y[36] = complex(real(t268)*scale, imag(t268)*scale)
+	y[37] = complex(real(t280)*scale, imag(t280)*scale)
+	y[38] = complex(real(t292)*scale, imag(t292)*scale)
+	y[39] = complex(real(t304)*scale, imag(t304)*scale)
+	y[40] = complex(real(t316)*scale, imag(t316)*scale)
+	y[41] = complex(real(t328)*scale, imag(t328)*scale)
+	y[42] = complex(real(t340)*scale, imag(t340)*scale)
+	y[43] = complex(real(t352)*scale, imag(t352)*scale)
+	y[44] = complex(real(t364)*scale, imag(t364)*scale)
+	y[45] = complex(real(t376)*scale, imag(t376)*scale)
+	y[46] = complex(real(t388)*scale, imag(t388)*scale)
+	y[47] = complex(real(t400)*scale, imag(t400)*scale)
+
+	return true
+}
+
+// forwardDIT48Radix4x4x3Complex128 computes a 48-point forward FFT of complex128 data
+// with radix-4, radix-4, radix-3 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT48Radix4x4x3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 48
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := tw[4]
+	w8 := tw[8]
+	w16 := tw[16]
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w6 := tw[6]
+	w9 := tw[9]
+	w5 := tw[5]
+	w10 := tw[10]
+	w15 := tw[15]
+	w18 := tw[18]
+	w7 := tw[7]
+	w14 := tw[14]
+	w21 := tw[21]
+	w27 := tw[27]
+	w20 := tw[20]
+	w30 := tw[30]
+	w11 := tw[11]
+	w22 := tw[22]
+	w33 := tw[33]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(imag(t4), -real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x20 + x36
+	t9 := x20 - x36
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(imag(t12), -real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x24 + x40
+	t17 := x24 - x40
+	t18 := x8 + t16
+	t19 := complex(real(x8)-0.5*real(t16), imag(x8)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(imag(t20), -real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x28 + x44
+	t25 := x28 - x44
+	t26 := x12 + t24
+	t27 := complex(real(x12)-0.5*real(t24), imag(x12)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(imag(t28), -real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := t2 + t18
+	t33 := t2 - t18
+	t34 := t10 + t26
+	t35 := t10 - t26
+	t36 := complex(imag(t35), -real(t35))
+	t37 := t32 + t34
+	t38 := t33 + t36
+	t39 := t32 - t34
+	t40 := t33 - t36
+	t41 := w4 * t14
+	t42 := w8 * t22
+	t43 := complex(imag(t30), -real(t30))
+	t44 := t6 + t42
+	t45 := t6 - t42
+	t46 := t41 + t43
+	t47 := t41 - t43
+	t48 := complex(imag(t47), -real(t47))
+	t49 := t44 + t46
+	t50 := t45 + t48
+	t51 := t44 - t46
+	t52 := t45 - t48
+	t53 := w8 * t15
+	t54 := w16 * t23
+	t55 := -t31
+	t56 := t7 + t54
+	t57 := t7 - t54
+	t58 := t53 + t55
+	t59 := t53 - t55
+	t60 := complex(imag(t59), -real(t59))
+	t61 := t56 + t58
+	t62 := t57 + t60
+	t63 := t56 - t58
+	t64 := t57 - t60
+	t65 := x17 + x33
+	t66 := x17 - x33
+	t67 := x1 + t65
+	t68 := complex(real(x1)-0.5*real(t65), imag(x1)-0.5*imag(t65))
+	t69 := complex(0.8660254037844388*real(t66), 0.8660254037844388*imag(t66))
+	t70 := complex(imag(t69), -real(t69))
+	t71 := t68 + t70
+	t72 := t68 - t70
+	t73 := x21 + x37
+	t74 := x21 - x37
+	t75 := x5 + t73
+	t76 := complex(real(x5)-0.5*real(t73), imag(x5)-0.5*imag(t73))
+	t77 := complex(0.8660254037844388*real(t74), 0.8660254037844388*imag(t74))
+	t78 := complex(imag(t77), -real(t77))
+	t79 := t76 + t78
+	t80 := t76 - t78
+	t81 := x25 + x41
+	t82 := x25 - x41
+	t83 := x9 + t81
+	t84 := complex(real(x9)-0.5*real(t81), imag(x9)-0.5*imag(t81))
+	t85 := complex(0.8660254037844388*real(t82), 0.8660254037844388*imag(t82))
+	t86 := complex(imag(t85), -real(t85))
+	t87 := t84 + t86
+	t88 := t84 - t86
+	t89 := x29 + x45
+	t90 := x29 - x45
+	t91 := x13 + t89
+	t92 := complex(real(x13)-0.5*real(t89), imag(x13)-0.5*imag(t89))
+	t93 := complex(0.8660254037844388*real(t90), 0.8660254037844388*imag(t90))
+	t94 := complex(imag(t93), -real(t93))
+	t95 := t92 + t94
+	t96 := t92 - t94
+	t97 := t67 + t83
+	t98 := t67 - t83
+	t99 := t75 + t91
+	t100 := t75 - t91
+	t101 := complex(imag(t100), -real(t100))
+	t102 := t97 + t99
+	t103 := t98 + t101
+	t104 := t97 - t99
+	t105 := t98 - t101
+	t106 := w4 * t79
+	t107 := w8 * t87
+	t108 := complex(imag(t95), -real(t95))
+	t109 := t71 + t107
+	t110 := t71 - t107
+	t111 := t106 + t108
+	t112 := t106 - t108
+	t113 := complex(imag(t112), -real(t112))
+	t114 := t109 + t111
+	t115 := t110 + t113
+	t116 := t109 - t111
+	t117 := t110 - t113
+	t118 := w8 * t80
+	t119 := w16 * t88
+	t120 := -t96
+	t121 := t72 + t119
+	t122 := t72 - t119
+	t123 := t118 + t120
+	t124 := t118 - t120
+	t125 := complex(imag(t124), -real(t124))
+	t126 := t121 + t123
+	t127 := t122 + t125
+	t128 := t121 - t123
+	t129 := t122 - t125
+	t130 := x18 + x34
+	t131 := x18 - x34
+	t132 := x2 + t130
+	t133 := complex(real(x2)-0.5*real(t130), imag(x2)-0.5*imag(t130))
+	t134 := complex(0.8660254037844388*real(t131), 0.8660254037844388*imag(t131))
+	t135 := complex(imag(t134), -real(t134))
+	t136 := t133 + t135
+	t137 := t133 - t135
+	t138 := x22 + x38
+	t139 := x22 - x38
+	t140 := x6 + t138
+	t141 := complex(real(x6)-0.5*real(t138), imag(x6)-0.5*imag(t138))
+	t142 := complex(0.8660254037844388*real(t139), 0.8660254037844388*imag(t139))
+	t143 := complex(imag(t142), -real(t142))
+	t144 := t141 + t143
+	t145 := t141 - t143
+	t146 := x26 + x42
+	t147 := x26 - x42
+	t148 := x10 + t146
+	t149 := complex(real(x10)-0.5*real(t146), imag(x10)-0.5*imag(t146))
+	t150 := complex(0.8660254037844388*real(t147), 0.8660254037844388*imag(t147))
+	t151 := complex(imag(t150), -real(t150))
+	t152 := t149 + t151
+	t153 := t149 - t151
+	t154 := x30 + x46
+	t155 := x30 - x46
+	t156 := x14 + t154
+	t157 := complex(real(x14)-0.5*real(t154), imag(x14)-0.5*imag(t154))
+	t158 := complex(0.8660254037844388*real(t155), 0.8660254037844388*imag(t155))
+	t159 := complex(imag(t158), -real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := t132 + t148
+	t163 := t132 - t148
+	t164 := t140 + t156
+	t165 := t140 - t156
+	t166 := complex(imag(t165), -real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := w4 * t144
+	t172 := w8 * t152
+	t173 := complex(imag(t160), -real(t160))
+	t174 := t136 + t172
+	t175 := t136 - t172
+	t176 := t171 + t173
+	t177 := t171 - t173
+	t178 := complex(imag(t177), -real(t177))
+	t179 := t174 + t176
+	t180 := t175 + t178
+	t181 := t174 - t176
+	t182 := t175 - t178
+	t183 := w8 * t145
+	t184 := w16 * t153
+	t185 := -t161
+	t186 := t137 + t184
+	t187 := t137 - t184
+	t188 := t183 + t185
+	t189 := t183 - t185
+	t190 := complex(imag(t189), -real(t189))
+	t191 := t186 + t188
+	t192 := t187 + t190
+	t193 := t186 - t188
+	t194 := t187 - t190
+	t195 := x19 + x35
+	t196 := x19 - x35
+	t197 := x3 + t195
+	t198 := complex(real(x3)-0.5*real(t195), imag(x3)-0.5*imag(t195))
+	t199 := complex(0.8660254037844388*real(t196), 0.8660254037844388*imag(t196))
+	t200 := complex(imag(t199), -real(t199))
+	t201 := t198 + t200
+	t202 := t198 - t200
+	t203 := x23 + x39
+	t204 := x23 - x39
+	t205 := x7 + t203
+	t206 := complex(real(x7)-0.5*real(t203), imag(x7)-0.5*imag(t203))
+	t207 := complex(0.8660254037844388*real(t204), 0.8660254037844388*imag(t204))
+	t208 := complex(imag(t207), -real(t207))
+	t209 := t206 + t208
+	t210 := t206 - t208
+	t211 := x27 + x43
+	t212 := x27 - x43
+	t213 := x11 + t211
+	t214 := complex(real(x11)-0.5*real(t211), imag(x11)-0.5*imag(t211))
+	t215 := complex(0.8660254037844388*real(t212), 0.8660254037844388*imag(t212))
+	t216 := complex(imag(t215), -real(t215))
+	t217 := t214 + t216
+	t218 := t214 - t216
+	t219 := x31 + x47
+	t220 := x31 - x47
+	t221 := x15 + t219
+	t222 := complex(real(x15)-0.5*real(t219), imag(x15)-0.5*imag(t219))
+	t223 := complex(0.8660254037844388*real(t220), 0.8660254037844388*imag(t220))
+	t224 := complex(imag(t223), -real(t223))
+	t225 := t222 + t224
+	t226 := t222 - t224
+	t227 := t197 + t213
+	t228 := t197 - t213
+	t229 := t205 + t221
+	t230 := t205 - t221
+	t231 := complex(imag(t230), -real(t230))
+	t232 := t227 + t229
+	t233 := t228 + t231
+	t234 := t227 - t229
+	t235 := t228 - t231
+	t236 := w4 * t209
+	t237 := w8 * t217
+	t238 := complex(imag(t225), -real(t225))
+	t239 := t201 + t237
+	t240 := t201 - t237
+	t241 := t236 + t238
+	t242 := t236 - t238
+	t243 := complex(imag(t242), -real(t242))
+	t244 := t239 + t241
+	t245 := t240 + t243
+	t246 := t239 - t241
+	t247 := t240 - t243
+	t248 := w8 * t210
+	t249 := w16 * t218
+	t250 := -t226
+	t251 := t202 + t249
+	t252 := t202 - t249
+	t253 := t248 + t250
+	t254 := t248 - t250
+	t255 := complex(imag(t254), -real(t254))
+	t256 := t251 + t253
+	t257 := t252 + t255
+	t258 := t251 - t253
+	t259 := t252 - t255
+	t260 := t37 + t167
+	t261 := t37 - t167
+	t262 := t102 + t232
+	t263 := t102 - t232
+	t264 := complex(imag(t263), -real(t263))
+	t265 := t260 + t262
+	t266 := t261 + t264
+	t267 := t260 - t262
+	t268 := t261 - t264
+	t269 := w1 * t114
+	t270 := w2 * t179
+	t271 := w3 * t244
+	t272 := t49 + t270
+	t273 := t49 - t270
+	t274 := t269 + t271
+	t275 := t269 - t271
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+	t281 := w2 * t126
+	t282 := w4 * t191
+	t283 := w6 * t256
+	t284 := t61 + t282
+	t285 := t61 - t282
+	t286 := t281 + t283
+	t287 := t281 - t283
+	t288 := complex(imag(t287), -real(t287))
+	t289 := t284 + t286
+	t290 := t285 + t288
+	t291 := t284 - t286
+	t292 := t285 - t288
+	t293 := w3 * t103
+	t294 := w6 * t168
+	t295 := w9 * t233
+	t296 := t38 + t294
+	t297 := t38 - t294
+	t298 := t293 + t295
+	t299 := t293 - t295
+	t300 := complex(imag(t299), -real(t299))
+	t301 := t296 + t298
+	t302 := t297 + t300
+	t303 := t296 - t298
+	t304 := t297 - t300
+	t305 := w4 * t115
+	t306 := w8 * t180
+	t307 := complex(imag(t245), -real(t245))
+	t308 := t50 + t306
+	t309 := t50 - t306
+	t310 := t305 + t307
+	t311 := t305 - t307
+	t312 := complex(imag(t311), -real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := w5 * t127
+	t318 := w10 * t192
+	t319 := w15 * t257
+	t320 := t62 + t318
+	t321 := t62 - t318
+	t322 := t317 + t319
+	t323 := t317 - t319
+	t324 := complex(imag(t323), -real(t323))
+	t325 := t320 + t322
+	t326 := t321 + t324
+	t327 := t320 - t322
+	t328 := t321 - t324
+	t329 := w6 * t104
+	t330 := complex(imag(t169), -real(t169))
+	t331 := w18 * t234
+	t332 := t39 + t330
+	t333 := t39 - t330
+	t334 := t329 + t331
+	t335 := t329 - t331
+	t336 := complex(imag(t335), -real(t335))
+	t337 := t332 + t334
+	t338 := t333 + t336
+	t339 := t332 - t334
+	t340 := t333 - t336
+	t341 := w7 * t116
+	t342 := w14 * t181
+	t343 := w21 * t246
+	t344 := t51 + t342
+	t345 := t51 - t342
+	t346 := t341 + t343
+	t347 := t341 - t343
+	t348 := complex(imag(t347), -real(t347))
+	t349 := t344 + t346
+	t350 := t345 + t348
+	t351 := t344 - t346
+	t352 := t345 - t348
+	t353 := w8 * t128
+	t354 := w16 * t193
+	t355 := -t258
+	t356 := t63 + t354
+	t357 := t63 - t354
+	t358 := t353 + t355
+	t359 := t353 - t355
+	t360 := complex(imag(t359), -real(t359))
+	t361 := t356 + t358
+	t362 := t357 + t360
+	t363 := t356 - t358
+	t364 := t357 - t360
+	t365 := w9 * t105
+	t366 := w18 * t170
+	t367 := w27 * t235
+	t368 := t40 + t366
+	t369 := t40 - t366
+	t370 := t365 + t367
+	t371 := t365 - t367
+	t372 := complex(imag(t371), -real(t371))
+	t373 := t368 + t370
+	t374 := t369 + t372
+	t375 := t368 - t370
+	t376 := t369 - t372
+	t377 := w10 * t117
+	t378 := w20 * t182
+	t379 := w30 * t247
+	t380 := t52 + t378
+	t381 := t52 - t378
+	t382 := t377 + t379
+	t383 := t377 - t379
+	t384 := complex(imag(t383), -real(t383))
+	t385 := t380 + t382
+	t386 := t381 + t384
+	t387 := t380 - t382
+	t388 := t381 - t384
+	t389 := w11 * t129
+	t390 := w22 * t194
+	t391 := w33 * t259
+	t392 := t64 + t390
+	t393 := t64 - t390
+	t394 := t389 + t391
+	t395 := t389 - t391
+	t396 := complex(imag(t395), -real(t395))
+	t397 := t392 + t394
+	t398 := t393 + t396
+	t399 := t392 - t394
+	t400 := t393 - t396
+
+	y := dst[:n]
+	y[0] = t265
+	y[1] = t277
+	y[2] = t289
+	y[3] = t301
+	y[4] = t313
+	y[5] = t325
+	y[6] = t337
+	y[7] = t349
+	y[8] = t361
+	y[9] = t373
+	y[10] = t385
+	y[11] = t397
+	y[12] = t266
+	y[13] = t278
+	y[14] = t290
+	y[15] = t302
+	y[16] = t314
+	y[17] = t326
+	y[18] = t338
+	y[19] = t350
+	y[20] = t362
+	y[21] = t374
+	y[22] = t386
+	y[23] = t398
+	y[24] = t267
+	y[25] = t279
+	y[26] = t291
+	y[27] = t303
+	y[28] = t315
+	y[29] = t327
+	y[30] = t339
+	y[31] = t351
+	y[32] = t363
+	y[33] = t375
+	y[34] = t387
+	y[35] = t399
+	y[36] = t268
+	y[37] = t280
+	y[38] = t292
+	y[39] = t304
+	y[40] = t316
+	y[41] = t328
+	y[42] = t340
+	y[43] = t352
+	y[44] = t364
+	y[45] = t376
+	y[46] = t388
+	y[47] = t400
+
+	return true
+}
+
+// inverseDIT48Radix4x4x3Complex128 computes a 48-point inverse FFT of complex128 data
+// with radix-4, radix-4, radix-3 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT48Radix4x4x3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 48
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w16 := complex(real(tw[16]), -imag(tw[16]))
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w6 := complex(real(tw[6]), -imag(tw[6]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w5 := complex(real(tw[5]), -imag(tw[5]))
+	w10 := complex(real(tw[10]), -imag(tw[10]))
+	w15 := complex(real(tw[15]), -imag(tw[15]))
+	w18 := complex(real(tw[18]), -imag(tw[18]))
+	w7 := complex(real(tw[7]), -imag(tw[7]))
+	w14 := complex(real(tw[14]), -imag(tw[14]))
+	w21 := complex(real(tw[21]), -imag(tw[21]))
+	w27 := complex(real(tw[27]), -imag(tw[27]))
+	w20 := complex(real(tw[20]), -imag(tw[20]))
+	w30 := complex(real(tw[30]), -imag(tw[30]))
+	w11 := complex(real(tw[11]), -imag(tw[11]))
+	w22 := complex(real(tw[22]), -imag(tw[22]))
+	w33 := complex(real(tw[33]), -imag(tw[33]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(-imag(t4), real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x20 + x36
+	t9 := x20 - x36
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(-imag(t12), real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x24 + x40
+	t17 := x24 - x40
+	t18 := x8 + t16
+	t19 := complex(real(x8)-0.5*real(t16), imag(x8)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(-imag(t20), real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x28 + x44
+	t25 := x28 - x44
+	t26 := x12 + t24
+	t27 := complex(real(x12)-0.5*real(t24), imag(x12)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(-imag(t28), real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := t2 + t18
+	t33 := t2 - t18
+	t34 := t10 + t26
+	t35 := t10 - t26
+	t36 := complex(-imag(t35), real(t35))
+	t37 := t32 + t34
+	t38 := t33 + t36
+	t39 := t32 - t34
+	t40 := t33 - t36
+	t41 := w4 * t14
+	t42 := w8 * t22
+	t43 := complex(-imag(t30), real(t30))
+	t44 := t6 + t42
+	t45 := t6 - t42
+	t46 := t41 + t43
+	t47 := t41 - t43
+	t48 := complex(-imag(t47), real(t47))
+	t49 := t44 + t46
+	t50 := t45 + t48
+	t51 := t44 - t46
+	t52 := t45 - t48
+	t53 := w8 * t15
+	t54 := w16 * t23
+	t55 := -t31
+	t56 := t7 + t54
+	t57 := t7 - t54
+	t58 := t53 + t55
+	t59 := t53 - t55
+	t60 := complex(-imag(t59), real(t59))
+	t61 := t56 + t58
+	t62 := t57 + t60
+	t63 := t56 - t58
+	t64 := t57 - t60
+	t65 := x17 + x33
+	t66 := x17 - x33
+	t67 := x1 + t65
+	t68 := complex(real(x1)-0.5*real(t65), imag(x1)-0.5*imag(t65))
+	t69 := complex(0.8660254037844388*real(t66), 0.8660254037844388*imag(t66))
+	t70 := complex(-imag(t69), real(t69))
+	t71 := t68 + t70
+	t72 := t68 - t70
+	t73 := x21 + x37
+	t74 := x21 - x37
+	t75 := x5 + t73
+	t76 := complex(real(x5)-0.5*real(t73), imag(x5)-0.5*imag(t73))
+	t77 := complex(0.8660254037844388*real(t74), 0.8660254037844388*imag(t74))
+	t78 := complex(-imag(t77), real(t77))
+	t79 := t76 + t78
+	t80 := t76 - t78
+	t81 := x25 + x41
+	t82 := x25 - x41
+	t83 := x9 + t81
+	t84 := complex(real(x9)-0.5*real(t81), imag(x9)-0.5*imag(t81))
+	t85 := complex(0.8660254037844388*real(t82), 0.8660254037844388*imag(t82))
+	t86 := complex(-imag(t85), real(t85))
+	t87 := t84 + t86
+	t88 := t84 - t86
+	t89 := x29 + x45
+	t90 := x29 - x45
+	t91 := x13 + t89
+	t92 := complex(real(x13)-0.5*real(t89), imag(x13)-0.5*imag(t89))
+	t93 := complex(0.8660254037844388*real(t90), 0.8660254037844388*imag(t90))
+	t94 := complex(-imag(t93), real(t93))
+	t95 := t92 + t94
+	t96 := t92 - t94
+	t97 := t67 + t83
+	t98 := t67 - t83
+	t99 := t75 + t91
+	t100 := t75 - t91
+	t101 := complex(-imag(t100), real(t100))
+	t102 := t97 + t99
+	t103 := t98 + t101
+	t104 := t97 - t99
+	t105 := t98 - t101
+	t106 := w4 * t79
+	t107 := w8 * t87
+	t108 := complex(-imag(t95), real(t95))
+	t109 := t71 + t107
+	t110 := t71 - t107
+	t111 := t106 + t108
+	t112 := t106 - t108
+	t113 := complex(-imag(t112), real(t112))
+	t114 := t109 + t111
+	t115 := t110 + t113
+	t116 := t109 - t111
+	t117 := t110 - t113
+	t118 := w8 * t80
+	t119 := w16 * t88
+	t120 := -t96
+	t121 := t72 + t119
+	t122 := t72 - t119
+	t123 := t118 + t120
+	t124 := t118 - t120
+	t125 := complex(-imag(t124), real(t124))
+	t126 := t121 + t123
+	t127 := t122 + t125
+	t128 := t121 - t123
+	t129 := t122 - t125
+	t130 := x18 + x34
+	t131 := x18 - x34
+	t132 := x2 + t130
+	t133 := complex(real(x2)-0.5*real(t130), imag(x2)-0.5*imag(t130))
+	t134 := complex(0.8660254037844388*real(t131), 0.8660254037844388*imag(t131))
+	t135 := complex(-imag(t134), real(t134))
+	t136 := t133 + t135
+	t137 := t133 - t135
+	t138 := x22 + x38
+	t139 := x22 - x38
+	t140 := x6 + t138
+	t141 := complex(real(x6)-0.5*real(t138), imag(x6)-0.5*imag(t138))
+	t142 := complex(0.8660254037844388*real(t139), 0.8660254037844388*imag(t139))
+	t143 := complex(-imag(t142), real(t142))
+	t144 := t141 + t143
+	t145 := t141 - t143
+	t146 := x26 + x42
+	t147 := x26 - x42
+	t148 := x10 + t146
+	t149 := complex(real(x10)-0.5*real(t146), imag(x10)-0.5*imag(t146))
+	t150 := complex(0.8660254037844388*real(t147), 0.8660254037844388*imag(t147))
+	t151 := complex(-imag(t150), real(t150))
+	t152 := t149 + t151
+	t153 := t149 - t151
+	t154 := x30 + x46
+	t155 := x30 - x46
+	t156 := x14 + t154
+	t157 := complex(real(x14)-0.5*real(t154), imag(x14)-0.5*imag(t154))
+	t158 := complex(0.8660254037844388*real(t155), 0.8660254037844388*imag(t155))
+	t159 := complex(-imag(t158), real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := t132 + t148
+	t163 := t132 - t148
+	t164 := t140 + t156
+	t165 := t140 - t156
+	t166 := complex(-imag(t165), real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := w4 * t144
+	t172 := w8 * t152
+	t173 := complex(-imag(t160), real(t160))
+	t174 := t136 + t172
+	t175 := t136 - t172
+	t176 := t171 + t173
+	t177 := t171 - t173
+	t178 := complex(-imag(t177), real(t177))
+	t179 := t174 + t176
+	t180 := t175 + t178
+	t181 := t174 - t176
+	t182 := t175 - t178
+	t183 := w8 * t145
+	t184 := w16 * t153
+	t185 := -t161
+	t186 := t137 + t184
+	t187 := t137 - t184
+	t188 := t183 + t185
+	t189 := t183 - t185
+	t190 := complex(-imag(t189), real(t189))
+	t191 := t186 + t188
+	t192 := t187 + t190
+	t193 := t186 - t188
+	t194 := t187 - t190
+	t195 := x19 + x35
+	t196 := x19 - x35
+	t197 := x3 + t195
+	t198 := complex(real(x3)-0.5*real(t195), imag(x3)-0.5*imag(t195))
+	t199 := complex(0.8660254037844388*real(t196), 0.8660254037844388*imag(t196))
+	t200 := complex(-imag(t199), real(t199))
+	t201 := t198 + t200
+	t202 := t198 - t200
+	t203 := x23 + x39
+	t204 := x23 - x39
+	t205 := x7 + t203
+	t206 := complex(real(x7)-0.5*real(t203), imag(x7)-0.5*imag(t203))
+	t207 := complex(0.8660254037844388*real(t204), 0.8660254037844388*imag(t204))
+	t208 := complex(-imag(t207), real(t207))
+	t209 := t206 + t208
+	t210 := t206 - t208
+	t211 := x27 + x43
+	t212 := x27 - x43
+	t213 := x11 + t211
+	t214 := complex(real(x11)-0.5*real(t211), imag(x11)-0.5*imag(t211))
+	t215 := complex(0.8660254037844388*real(t212), 0.8660254037844388*imag(t212))
+	t216 := complex(-imag(t215), real(t215))
+	t217 := t214 + t216
+	t218 := t214 - t216
+	t219 := x31 + x47
+	t220 := x31 - x47
+	t221 := x15 + t219
+	t222 := complex(real(x15)-0.5*real(t219), imag(x15)-0.5*imag(t219))
+	t223 := complex(0.8660254037844388*real(t220), 0.8660254037844388*imag(t220))
+	t224 := complex(-imag(t223), real(t223))
+	t225 := t222 + t224
+	t226 := t222 - t224
+	t227 := t197 + t213
+	t228 := t197 - t213
+	t229 := t205 + t221
+	t230 := t205 - t221
+	t231 := complex(-imag(t230), real(t230))
+	t232 := t227 + t229
+	t233 := t228 + t231
+	t234 := t227 - t229
+	t235 := t228 - t231
+	t236 := w4 * t209
+	t237 := w8 * t217
+	t238 := complex(-imag(t225), real(t225))
+	t239 := t201 + t237
+	t240 := t201 - t237
+	t241 := t236 + t238
+	t242 := t236 - t238
+	t243 := complex(-imag(t242), real(t242))
+	t244 := t239 + t241
+	t245 := t240 + t243
+	t246 := t239 - t241
+	t247 := t240 - t243
+	t248 := w8 * t210
+	t249 := w16 * t218
+	t250 := -t226
+	t251 := t202 + t249
+	t252 := t202 - t249
+	t253 := t248 + t250
+	t254 := t248 - t250
+	t255 := complex(-imag(t254), real(t254))
+	t256 := t251 + t253
+	t257 := t252 + t255
+	t258 := t251 - t253
+	t259 := t252 - t255
+	t260 := t37 + t167
+	t261 := t37 - t167
+	t262 := t102 + t232
+	t263 := t102 - t232
+	t264 := complex(-imag(t263), real(t263))
+	t265 := t260 + t262
+	t266 := t261 + t264
+	t267 := t260 - t262
+	t268 := t261 - t264
+	t269 := w1 * t114
+	t270 := w2 * t179
+	t271 := w3 * t244
+	t272 := t49 + t270
+	t273 := t49 - t270
+	t274 := t269 + t271
+	t275 := t269 - t271
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+	t281 := w2 * t126
+	t282 := w4 * t191
+	t283 := w6 * t256
+	t284 := t61 + t282
+	t285 := t61 - t282
+	t286 := t281 + t283
+	t287 := t281 - t283
+	t288 := complex(-imag(t287), real(t287))
+	t289 := t284 + t286
+	t290 := t285 + t288
+	t291 := t284 - t286
+	t292 := t285 - t288
+	t293 := w3 * t103
+	t294 := w6 * t168
+	t295 := w9 * t233
+	t296 := t38 + t294
+	t297 := t38 - t294
+	t298 := t293 + t295
+	t299 := t293 - t295
+	t300 := complex(-imag(t299), real(t299))
+	t301 := t296 + t298
+	t302 := t297 + t300
+	t303 := t296 - t298
+	t304 := t297 - t300
+	t305 := w4 * t115
+	t306 := w8 * t180
+	t307 := complex(-imag(t245), real(t245))
+	t308 := t50 + t306
+	t309 := t50 - t306
+	t310 := t305 + t307
+	t311 := t305 - t307
+	t312 := complex(-imag(t311), real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := w5 * t127
+	t318 := w10 * t192
+	t319 := w15 * t257
+	t320 := t62 + t318
+	t321 := t62 - t318
+	t322 := t317 + t319
+	t323 := t317 - t319
+	t324 := complex(-imag(t323), real(t323))
+	t325 := t320 + t322
+	t326 := t321 + t324
+	t327 := t320 - t322
+	t328 := t321 - t324
+	t329 := w6 * t104
+	t330 := complex(-imag(t169), real(t169))
+	t331 := w18 * t234
+	t332 := t39 + t330
+	t333 := t39 - t330
+	t334 := t329 + t331
+	t335 := t329 - t331
+	t336 := complex(-imag(t335), real(t335))
+	t337 := t332 + t334
+	t338 := t333 + t336
+	t339 := t332 - t334
+	t340 := t333 - t336
+	t341 := w7 * t116
+	t342 := w14 * t181
+	t343 := w21 * t246
+	t344 := t51 + t342
+	t345 := t51 - t342
+	t346 := t341 + t343
+	t347 := t341 - t343
+	t348 := complex(-imag(t347), real(t347))
+	t349 := t344 + t346
+	t350 := t345 + t348
+	t351 := t344 - t346
+	t352 := t345 - t348
+	t353 := w8 * t128
+	t354 := w16 * t193
+	t355 := -t258
+	t356 := t63 + t354
+	t357 := t63 - t354
+	t358 := t353 + t355
+	t359 := t353 - t355
+	t360 := complex(-imag(t359), real(t359))
+	t361 := t356 + t358
+	t362 := t357 + t360
+	t363 := t356 - t358
+	t364 := t357 - t360
+	t365 := w9 * t105
+	t366 := w18 * t170
+	t367 := w27 * t235
+	t368 := t40 + t366
+	t369 := t40 - t366
+	t370 := t365 + t367
+	t371 := t365 - t367
+	t372 := complex(-imag(t371), real(t371))
+	t373 := t368 + t370
+	t374 := t369 + t372
+	t375 := t368 - t370
+	t376 := t369 - t372
+	t377 := w10 * t117
+	t378 := w20 * t182
+	t379 := w30 * t247
+	t380 := t52 + t378
+	t381 := t52 - t378
+	t382 := t377 + t379
+	t383 := t377 - t379
+	t384 := complex(-imag(t383), real(t383))
+	t385 := t380 + t382
+	t386 := t381 + t384
+	t387 := t380 - t382
+	t388 := t381 - t384
+	t389 := w11 * t129
+	t390 := w22 * t194
+	t391 := w33 * t259
+	t392 := t64 + t390
+	t393 := t64 - t390
+	t394 := t389 + t391
+	t395 := t389 - t391
+	t396 := complex(-imag(t395), real(t395))
+	t397 := t392 + t394
+	t398 := t393 + t396
+	t399 := t392 - t394
+	t400 := t393 - t396
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t265)*scale, imag(t265)*scale)
+	y[1] = complex(real(t277)*scale, imag(t277)*scale)
+	y[2] = complex(real(t289)*scale, imag(t289)*scale)
+	y[3] = complex(real(t301)*scale, imag(t301)*scale)
+	y[4] = complex(real(t313)*scale, imag(t313)*scale)
+	y[5] = complex(real(t325)*scale, imag(t325)*scale)
+	y[6] = complex(real(t337)*scale, imag(t337)*scale)
+	y[7] = complex(real(t349)*scale, imag(t349)*scale)
+	y[8] = complex(real(t361)*scale, imag(t361)*scale)
+	y[9] = complex(real(t373)*scale, imag(t373)*scale)
+	y[10] = complex(real(t385)*scale, imag(t385)*scale)
+	y[11] = complex(real(t397)*scale, imag(t397)*scale)
+	y[12] = complex(real(t266)*scale, imag(t266)*scale)
+	y[13] = complex(real(t278)*scale, imag(t278)*scale)
+	y[14] = complex(real(t290)*scale, imag(t290)*scale)
+	y[15] = complex(real(t302)*scale, imag(t302)*scale)
+	y[16] = complex(real(t314)*scale, imag(t314)*scale)
+	y[17] = complex(real(t326)*scale, imag(t326)*scale)
+	y[18] = complex(real(t338)*scale, imag(t338)*scale)
+	y[19] = complex(real(t350)*scale, imag(t350)*scale)
+	y[20] = complex(real(t362)*scale, imag(t362)*scale)
+	y[21] = complex(real(t374)*scale, imag(t374)*scale)
+	y[22] = complex(real(t386)*scale, imag(t386)*scale)
+	y[23] = complex(real(t398)*scale, imag(t398)*scale)
+	y[24] = complex(real(t267)*scale, imag(t267)*scale)
+	y[25] = complex(real(t279)*scale, imag(t279)*scale)
+	y[26] = complex(real(t291)*scale, imag(t291)*scale)
+	y[27] = complex(real(t303)*scale, imag(t303)*scale)
+	y[28] = complex(real(t315)*scale, imag(t315)*scale)
+	y[29] = complex(real(t327)*scale, imag(t327)*scale)
+	y[30] = complex(real(t339)*scale, imag(t339)*scale)
+	y[31] = complex(real(t351)*scale, imag(t351)*scale)
+	y[32] = complex(real(t363)*scale, imag(t363)*scale)
+	y[33] = complex(real(t375)*scale, imag(t375)*scale)
+	y[34] = complex(real(t387)*scale, imag(t387)*scale)
+	y[35] = complex(real(t399)*scale, imag(t399)*scale)
+	y[36] = complex(real(t268)*scale, imag(t268)*scale)
+	y[37] = complex(real(t280)*scale, imag(t280)*scale)
+	y[38] = complex(real(t292)*scale, imag(t292)*scale)
+	y[39] = complex(real(t304)*scale, imag(t304)*scale)
+	y[40] = complex(real(t316)*scale, imag(t316)*scale)
+	y[41] = complex(real(t328)*scale, imag(t328)*scale)
+	y[42] = complex(real(t340)*scale, imag(t340)*scale)
+	y[43] = complex(real(t352)*scale, imag(t352)*scale)
+	y[44] = complex(real(t364)*scale, imag(t364)*scale)
+	y[45] = complex(real(t376)*scale, imag(t376)*scale)
+	y[46] = complex(real(t388)*scale, imag(t388)*scale)
+	y[47] = complex(real(t400)*scale, imag(t400)*scale)
+
+	return true
+}
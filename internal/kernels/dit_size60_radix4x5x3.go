@@ -0,0 +1,2891 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT60Radix4x5x3Complex64 computes a 60-point forward FFT of complex64 data
+// with radix-4, radix-5, radix-3 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT60Radix4x5x3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 60
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := tw[4]
+	w8 := tw[8]
+	w12 := tw[12]
+	w16 := tw[16]
+	w24 := tw[24]
+	w32 := tw[32]
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w6 := tw[6]
+	w9 := tw[9]
+	w5 := tw[5]
+	w10 := tw[10]
+	w18 := tw[18]
+	w7 := tw[7]
+	w14 := tw[14]
+	w21 := tw[21]
+	w27 := tw[27]
+	w20 := tw[20]
+	w11 := tw[11]
+	w22 := tw[22]
+	w33 := tw[33]
+	w36 := tw[36]
+	w13 := tw[13]
+	w26 := tw[26]
+	w39 := tw[39]
+	w28 := tw[28]
+	w42 := tw[42]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+	x48 := x[48]
+	x49 := x[49]
+	x50 := x[50]
+	x51 := x[51]
+	x52 := x[52]
+	x53 := x[53]
+	x54 := x[54]
+	x55 := x[55]
+	x56 := x[56]
+	x57 := x[57]
+	x58 := x[58]
+	x59 := x[59]
+
+	t0 := x20 + x40
+	t1 := x20 - x40
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(imag(t4), -real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x24 + x44
+	t9 := x24 - x44
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(imag(t12), -real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x28 + x48
+	t17 := x28 - x48
+	t18 := x8 + t16
+	t19 := complex(real(x8)-0.5*real(t16), imag(x8)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(imag(t20), -real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x32 + x52
+	t25 := x32 - x52
+	t26 := x12 + t24
+	t27 := complex(real(x12)-0.5*real(t24), imag(x12)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(imag(t28), -real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := x36 + x56
+	t33 := x36 - x56
+	t34 := x16 + t32
+	t35 := complex(real(x16)-0.5*real(t32), imag(x16)-0.5*imag(t32))
+	t36 := complex(0.8660254037844388*real(t33), 0.8660254037844388*imag(t33))
+	t37 := complex(imag(t36), -real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := t10 + t34
+	t41 := t10 - t34
+	t42 := t18 + t26
+	t43 := t18 - t26
+	t44 := t2 + t40 + t42
+	t45 := complex(real(t2)+0.30901699437494745*real(t40)-0.8090169943749475*real(t42), imag(t2)+0.30901699437494745*imag(t40)-0.8090169943749475*imag(t42))
+	t46 := complex(0.9510565162951535*real(t41)+0.5877852522924732*real(t43), 0.9510565162951535*imag(t41)+0.5877852522924732*imag(t43))
+	t47 := complex(imag(t46), -real(t46))
+	t48 := t45 + t47
+	t49 := t45 - t47
+	t50 := complex(real(t2)-0.8090169943749475*real(t40)+0.30901699437494723*real(t42), imag(t2)-0.8090169943749475*imag(t40)+0.30901699437494723*imag(t42))
+	t51 := complex(0.5877852522924732*real(t41)-0.9510565162951536*real(t43), 0.5877852522924732*imag(t41)-0.9510565162951536*imag(t43))
+	t52 := complex(imag(t51), -real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := w4 * t14
+	t56 := w8 * t22
+	t57 := w12 * t30
+	t58 := w16 * t38
+	t59 := t55 + t58
+	t60 := t55 - t58
+	t61 := t56 + t57
+	t62 := t56 - t57
+	t63 := t6 + t59 + t61
+	t64 := complex(real(t6)+0.30901699437494745*real(t59)-0.8090169943749475*real(t61), imag(t6)+0.30901699437494745*imag(t59)-0.8090169943749475*imag(t61))
+	t65 := complex(0.9510565162951535*real(t60)+0.5877852522924732*real(t62), 0.9510565162951535*imag(t60)+0.5877852522924732*imag(t62))
+	t66 := complex(imag(t65), -real(t65))
+	t67 := t64 + t66
+	t68 := t64 - t66
+	t69 := complex(real(t6)-0.8090169943749475*real(t59)+0.30901699437494723*real(t61), imag(t6)-0.8090169943749475*imag(t59)+0.30901699437494723*imag(t61))
+	t70 := complex(0.5877852522924732*real(t60)-0.9510565162951536*real(t62), 0.5877852522924732*imag(t60)-0.9510565162951536*imag(t62))
+	t71 := complex(imag(t70), -real(t70))
+	t72 := t69 + t71
+	t73 := t69 - t71
+	t74 := w8 * t15
+	t75 := w16 * t23
+	t76 := w24 * t31
+	t77 := w32 * t39
+	t78 := t74 + t77
+	t79 := t74 - t77
+	t80 := t75 + t76
+	t81 := t75 - t76
+	t82 := t7 + t78 + t80
+	t83 := complex(real(t7)+0.30901699437494745*real(t78)-0.8090169943749475*real(t80), imag(t7)+0.30901699437494745*imag(t78)-0.8090169943749475*imag(t80))
+	t84 := complex(0.9510565162951535*real(t79)+0.5877852522924732*real(t81), 0.9510565162951535*imag(t79)+0.5877852522924732*imag(t81))
+	t85 := complex(imag(t84), -real(t84))
+	t86 := t83 + t85
+	t87 := t83 - t85
+	t88 := complex(real(t7)-0.8090169943749475*real(t78)+0.30901699437494723*real(t80), imag(t7)-0.8090169943749475*imag(t78)+0.30901699437494723*imag(t80))
+	t89 := complex(0.5877852522924732*real(t79)-0.9510565162951536*real(t81), 0.5877852522924732*imag(t79)-0.9510565162951536*imag(t81))
+	t90 := complex(imag(t89), -real(t89))
+	t91 := t88 + t90
+	t92 := t88 - t90
+	t93 := x21 + x41
+	t94 := x21 - x41
+	t95 := x1 + t93
+	t96 := complex(real(x1)-0.5*real(t93), imag(x1)-0.5*imag(t93))
+	t97 := complex(0.8660254037844388*real(t94), 0.8660254037844388*imag(t94))
+	t98 := complex(imag(t97), -real(t97))
+	t99 := t96 + t98
+	t100 := t96 - t98
+	t101 := x25 + x45
+	t102 := x25 - x45
+	t103 := x5 + t101
+	t104 := complex(real(x5)-0.5*real(t101), imag(x5)-0.5*imag(t101))
+	t105 := complex(0.8660254037844388*real(t102), 0.8660254037844388*imag(t102))
+	t106 := complex(imag(t105), -real(t105))
+	t107 := t104 + t106
+	t108 := t104 - t106
+	t109 := x29 + x49
+	t110 := x29 - x49
+	t111 := x9 + t109
+	t112 := complex(real(x9)-0.5*real(t109), imag(x9)-0.5*imag(t109))
+	t113 := complex(0.8660254037844388*real(t110), 0.8660254037844388*imag(t110))
+	t114 := complex(imag(t113), -real(t113))
+	t115 := t112 + t114
+	t116 := t112 - t114
+	t117 := x33 + x53
+	t118 := x33 - x53
+	t119 := x13 + t117
+	t120 := complex(real(x13)-0.5*real(t117), imag(x13)-0.5*imag(t117))
+	t121 := complex(0.8660254037844388*real(t118), 0.8660254037844388*imag(t118))
+	t122 := complex(imag(t121), -real(t121))
+	t123 := t120 + t122
+	t124 := t120 - t122
+	t125 := x37 + x57
+	t126 := x37 - x57
+	t127 := x17 + t125
+	t128 := complex(real(x17)-0.5*real(t125), imag(x17)-0.5*imag(t125))
+	t129 := complex(0.8660254037844388*real(t126), 0.8660254037844388*imag(t126))
+	t130 := complex(imag(t129), -real(t129))
+	t131 := t128 + t130
+	t132 := t128 - t130
+	t133 := t103 + t127
+	t134 := t103 - t127
+	t135 := t111 + t119
+	t136 := t111 - t119
+	t137 := t95 + t133 + t135
+	t138 := complex(real(t95)+0.30901699437494745*real(t133)-0.8090169943749475*real(t135), imag(t95)+0.30901699437494745*imag(t133)-0.8090169943749475*imag(t135))
+	t139 := complex(0.9510565162951535*real(t134)+0.5877852522924732*real(t136), 0.9510565162951535*imag(t134)+0.5877852522924732*imag(t136))
+	t140 := complex(imag(t139), -real(t139))
+	t141 := t138 + t140
+	t142 := t138 - t140
+	t143 := complex(real(t95)-0.8090169943749475*real(t133)+0.30901699437494723*real(t135), imag(t95)-0.8090169943749475*imag(t133)+0.30901699437494723*imag(t135))
+	t144 := complex(0.5877852522924732*real(t134)-0.9510565162951536*real(t136), 0.5877852522924732*imag(t134)-0.9510565162951536*imag(t136))
+	t145 := complex(imag(t144), -real(t144))
+	t146 := t143 + t145
+	t147 := t143 - t145
+	t148 := w4 * t107
+	t149 := w8 * t115
+	t150 := w12 * t123
+	t151 := w16 * t131
+	t152 := t148 + t151
+	t153 := t148 - t151
+	t154 := t149 + t150
+	t155 := t149 - t150
+	t156 := t99 + t152 + t154
+	t157 := complex(real(t99)+0.30901699437494745*real(t152)-0.8090169943749475*real(t154), imag(t99)+0.30901699437494745*imag(t152)-0.8090169943749475*imag(t154))
+	t158 := complex(0.9510565162951535*real(t153)+0.5877852522924732*real(t155), 0.9510565162951535*imag(t153)+0.5877852522924732*imag(t155))
+	t159 := complex(imag(t158), -real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := complex(real(t99)-0.8090169943749475*real(t152)+0.30901699437494723*real(t154), imag(t99)-0.8090169943749475*imag(t152)+0.30901699437494723*imag(t154))
+	t163 := complex(0.5877852522924732*real(t153)-0.9510565162951536*real(t155), 0.5877852522924732*imag(t153)-0.9510565162951536*imag(t155))
+	t164 := complex(imag(t163), -real(t163))
+	t165 := t162 + t164
+	t166 := t162 - t164
+	t167 := w8 * t108
+	t168 := w16 * t116
+	t169 := w24 * t124
+	t170 := w32 * t132
+	t171 := t167 + t170
+	t172 := t167 - t170
+	t173 := t168 + t169
+	t174 := t168 - t169
+	t175 := t100 + t171 + t173
+	t176 := complex(real(t100)+0.30901699437494745*real(t171)-0.8090169943749475*real(t173), imag(t100)+0.30901699437494745*imag(t171)-0.8090169943749475*imag(t173))
+	t177 := complex(0.9510565162951535*real(t172)+0.5877852522924732*real(t174), 0.9510565162951535*imag(t172)+0.5877852522924732*imag(t174))
+	t178 := complex(imag(t177), -real(t177))
+	t179 := t176 + t178
+	t180 := t176 - t178
+	t181 := complex(real(t100)-0.8090169943749475*real(t171)+0.30901699437494723*real(t173), imag(t100)-0.8090169943749475*imag(t171)+0.30901699437494723*imag(t173))
+	t182 := complex(0.5877852522924732*real(t172)-0.9510565162951536*real(t174), 0.5877852522924732*imag(t172)-0.9510565162951536*imag(t174))
+	t183 := complex(imag(t182), -real(t182))
+	t184 := t181 + t183
+	t185 := t181 - t183
+	t186 := x22 + x42
+	t187 := x22 - x42
+	t188 := x2 + t186
+	t189 := complex(real(x2)-0.5*real(t186), imag(x2)-0.5*imag(t186))
+	t190 := complex(0.8660254037844388*real(t187), 0.8660254037844388*imag(t187))
+	t191 := complex(imag(t190), -real(t190))
+	t192 := t189 + t191
+	t193 := t189 - t191
+	t194 := x26 + x46
+	t195 := x26 - x46
+	t196 := x6 + t194
+	t197 := complex(real(x6)-0.5*real(t194), imag(x6)-0.5*imag(t194))
+	t198 := complex(0.8660254037844388*real(t195), 0.8660254037844388*imag(t195))
+	t199 := complex(imag(t198), -real(t198))
+	t200 := t197 + t199
+	t201 := t197 - t199
+	t202 := x30 + x50
+	t203 := x30 - x50
+	t204 := x10 + t202
+	t205 := complex(real(x10)-0.5*real(t202), imag(x10)-0.5*imag(t202))
+	t206 := complex(0.8660254037844388*real(t203), 0.8660254037844388*imag(t203))
+	t207 := complex(imag(t206), -real(t206))
+	t208 := t205 + t207
+	t209 := t205 - t207
+	t210 := x34 + x54
+	t211 := x34 - x54
+	t212 := x14 + t210
+	t213 := complex(real(x14)-0.5*real(t210), imag(x14)-0.5*imag(t210))
+	t214 := complex(0.8660254037844388*real(t211), 0.8660254037844388*imag(t211))
+	t215 := complex(imag(t214), -real(t214))
+	t216 := t213 + t215
+	t217 := t213 - t215
+	t218 := x38 + x58
+	t219 := x38 - x58
+	t220 := x18 + t218
+	t221 := complex(real(x18)-0.5*real(t218), imag(x18)-0.5*imag(t218))
+	t222 := complex(0.8660254037844388*real(t219), 0.8660254037844388*imag(t219))
+	t223 := complex(imag(t222), -real(t222))
+	t224 := t221 + t223
+	t225 := t221 - t223
+	t226 := t196 + t220
+	t227 := t196 - t220
+	t228 := t204 + t212
+	t229 := t204 - t212
+	t230 := t188 + t226 + t228
+	t231 := complex(real(t188)+0.30901699437494745*real(t226)-0.8090169943749475*real(t228), imag(t188)+0.30901699437494745*imag(t226)-0.8090169943749475*imag(t228))
+	t232 := complex(0.9510565162951535*real(t227)+0.5877852522924732*real(t229), 0.9510565162951535*imag(t227)+0.5877852522924732*imag(t229))
+	t233 := complex(imag(t232), -real(t232))
+	t234 := t231 + t233
+	t235 := t231 - t233
+	t236 := complex(real(t188)-0.8090169943749475*real(t226)+0.30901699437494723*real(t228), imag(t188)-0.8090169943749475*imag(t226)+0.30901699437494723*imag(t228))
+	t237 := complex(0.5877852522924732*real(t227)-0.9510565162951536*real(t229), 0.5877852522924732*imag(t227)-0.9510565162951536*imag(t229))
+	t238 := complex(imag(t237), -real(t237))
+	t239 := t236 + t238
+	t240 := t236 - t238
+	t241 := w4 * t200
+	t242 := w8 * t208
+	t243 := w12 * t216
+	t244 := w16 * t224
+	t245 := t241 + t244
+	t246 := t241 - t244
+	t247 := t242 + t243
+	t248 := t242 - t243
+	t249 := t192 + t245 + t247
+	t250 := complex(real(t192)+0.30901699437494745*real(t245)-0.8090169943749475*real(t247), imag(t192)+0.30901699437494745*imag(t245)-0.8090169943749475*imag(t247))
+	t251 := complex(0.9510565162951535*real(t246)+0.5877852522924732*real(t248), 0.9510565162951535*imag(t246)+0.5877852522924732*imag(t248))
+	t252 := complex(imag(t251), -real(t251))
+	t253 := t250 + t252
+	t254 := t250 - t252
+	t255 := complex(real(t192)-0.8090169943749475*real(t245)+0.30901699437494723*real(t247), imag(t192)-0.8090169943749475*imag(t245)+0.30901699437494723*imag(t247))
+	t256 := complex(0.5877852522924732*real(t246)-0.9510565162951536*real(t248), 0.5877852522924732*imag(t246)-0.9510565162951536*imag(t248))
+	t257 := complex(imag(t256), -real(t256))
+	t258 := t255 + t257
+	t259 := t255 - t257
+	t260 := w8 * t201
+	t261 := w16 * t209
+	t262 := w24 * t217
+	t263 := w32 * t225
+	t264 := t260 + t263
+	t265 := t260 - t263
+	t266 := t261 + t262
+	t267 := t261 - t262
+	t268 := t193 + t264 + t266
+	t269 := complex(real(t193)+0.30901699437494745*real(t264)-0.8090169943749475*real(t266), imag(t193)+0.30901699437494745*imag(t264)-0.8090169943749475*imag(t266))
+	t270 := complex(0.9510565162951535*real(t265)+0.5877852522924732*real(t267), 0.9510565162951535*imag(t265)+0.5877852522924732*imag(t267))
+	t271 := complex(imag(t270), -real(t270))
+	t272 := t269 + t271
+	t273 := t269 - t271
+	t274 := complex(real(t193)-0.8090169943749475*real(t264)+0.30901699437494723*real(t266), imag(t193)-0.8090169943749475*imag(t264)+0.30901699437494723*imag(t266))
+	t275 := complex(0.5877852522924732*real(t265)-0.9510565162951536*real(t267), 0.5877852522924732*imag(t265)-0.9510565162951536*imag(t267))
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t274 + t276
+	t278 := t274 - t276
+	t279 := x23 + x43
+	t280 := x23 - x43
+	t281 := x3 + t279
+	t282 := complex(real(x3)-0.5*real(t279), imag(x3)-0.5*imag(t279))
+	t283 := complex(0.8660254037844388*real(t280), 0.8660254037844388*imag(t280))
+	t284 := complex(imag(t283), -real(t283))
+	t285 := t282 + t284
+	t286 := t282 - t284
+	t287 := x27 + x47
+	t288 := x27 - x47
+	t289 := x7 + t287
+	t290 := complex(real(x7)-0.5*real(t287), imag(x7)-0.5*imag(t287))
+	t291 := complex(0.8660254037844388*real(t288), 0.8660254037844388*imag(t288))
+	t292 := complex(imag(t291), -real(t291))
+	t293 := t290 + t292
+	t294 := t290 - t292
+	t295 := x31 + x51
+	t296 := x31 - x51
+	t297 := x11 + t295
+	t298 := complex(real(x11)-0.5*real(t295), imag(x11)-0.5*imag(t295))
+	t299 := complex(0.8660254037844388*real(t296), 0.8660254037844388*imag(t296))
+	t300 := complex(imag(t299), -real(t299))
+	t301 := t298 + t300
+	t302 := t298 - t300
+	t303 := x35 + x55
+	t304 := x35 - x55
+	t305 := x15 + t303
+	t306 := complex(real(x15)-0.5*real(t303), imag(x15)-0.5*imag(t303))
+	t307 := complex(0.8660254037844388*real(t304), 0.8660254037844388*imag(t304))
+	t308 := complex(imag(t307), -real(t307))
+	t309 := t306 + t308
+	t310 := t306 - t308
+	t311 := x39 + x59
+	t312 := x39 - x59
+	t313 := x19 + t311
+	t314 := complex(real(x19)-0.5*real(t311), imag(x19)-0.5*imag(t311))
+	t315 := complex(0.8660254037844388*real(t312), 0.8660254037844388*imag(t312))
+	t316 := complex(imag(t315), -real(t315))
+	t317 := t314 + t316
+	t318 := t314 - t316
+	t319 := t289 + t313
+	t320 := t289 - t313
+	t321 := t297 + t305
+	t322 := t297 - t305
+	t323 := t281 + t319 + t321
+	t324 := complex(real(t281)+0.30901699437494745*real(t319)-0.8090169943749475*real(t321), imag(t281)+0.30901699437494745*imag(t319)-0.8090169943749475*imag(t321))
+	t325 := complex(0.9510565162951535*real(t320)+0.5877852522924732*real(t322), 0.9510565162951535*imag(t320)+0.5877852522924732*imag(t322))
+	t326 := complex(imag(t325), -real(t325))
+	t327 := t324 + t326
+	t328 := t324 - t326
+	t329 := complex(real(t281)-0.8090169943749475*real(t319)+0.30901699437494723*real(t321), imag(t281)-0.8090169943749475*imag(t319)+0.30901699437494723*imag(t321))
+	t330 := complex(0.5877852522924732*real(t320)-0.9510565162951536*real(t322), 0.5877852522924732*imag(t320)-0.9510565162951536*imag(t322))
+	t331 := complex(imag(t330), -real(t330))
+	t332 := t329 + t331
+	t333 := t329 - t331
+	t334 := w4 * t293
+	t335 := w8 * t301
+	t336 := w12 * t309
+	t337 := w16 * t317
+	t338 := t334 + t337
+	t339 := t334 - t337
+	t340 := t335 + t336
+	t341 := t335 - t336
+	t342 := t285 + t338 + t340
+	t343 := complex(real(t285)+0.30901699437494745*real(t338)-0.8090169943749475*real(t340), imag(t285)+0.30901699437494745*imag(t338)-0.8090169943749475*imag(t340))
+	t344 := complex(0.9510565162951535*real(t339)+0.5877852522924732*real(t341), 0.9510565162951535*imag(t339)+0.5877852522924732*imag(t341))
+	t345 := complex(imag(t344), -real(t344))
+	t346 := t343 + t345
+	t347 := t343 - t345
+	t348 := complex(real(t285)-0.8090169943749475*real(t338)+0.30901699437494723*real(t340), imag(t285)-0.8090169943749475*imag(t338)+0.30901699437494723*imag(t340))
+	t349 := complex(0.5877852522924732*real(t339)-0.9510565162951536*real(t341), 0.5877852522924732*imag(t339)-0.9510565162951536*imag(t341))
+	t350 := complex(imag(t349), -real(t349))
+	t351 := t348 + t350
+	t352 := t348 - t350
+	t353 := w8 * t294
+	t354 := w16 * t302
+	t355 := w24 * t310
+	t356 := w32 * t318
+	t357 := t353 + t356
+	t358 := t353 - t356
+	t359 := t354 + t355
+	t360 := t354 - t355
+	t361 := t286 + t357 + t359
+	t362 := complex(real(t286)+0.30901699437494745*real(t357)-0.8090169943749475*real(t359), imag(t286)+0.30901699437494745*imag(t357)-0.8090169943749475*imag(t359))
+	t363 := complex(0.9510565162951535*real(t358)+0.5877852522924732*real(t360), 0.9510565162951535*imag(t358)+0.5877852522924732*imag(t360))
+	t364 := complex(imag(t363), -real(t363))
+	t365 := t362 + t364
+	t366 := t362 - t364
+	t367 := complex(real(t286)-0.8090169943749475*real(t357)+0.30901699437494723*real(t359), imag(t286)-0.8090169943749475*imag(t357)+0.30901699437494723*imag(t359))
+	t368 := complex(0.5877852522924732*real(t358)-0.9510565162951536*real(t360), 0.5877852522924732*imag(t358)-0.9510565162951536*imag(t360))
+	t369 := complex(imag(t368), -real(t368))
+	t370 := t367 + t369
+	t371 := t367 - t369
+	t372 := t44 + t230
+	t373 := t44 - t230
+	t374 := t137 + t323
+	t375 := t137 - t323
+	t376 := complex(imag(t375), -real(t375))
+	t377 := t372 + t374
+	t378 := t373 + t376
+	t379 := t372 - t374
+	t380 := t373 - t376
+	t381 := w1 * t156
+	t382 := w2 * t249
+	t383 := w3 * t342
+	t384 := t63 + t382
+	t385 := t63 - t382
+	t386 := t381 + t383
+	t387 := t381 - t383
+	t388 := complex(imag(t387), -real(t387))
+	t389 := t384 + t386
+	t390 := t385 + t388
+	t391 := t384 - t386
+	t392 := t385 - t388
+	t393 := w2 * t175
+	t394 := w4 * t268
+	t395 := w6 * t361
+	t396 := t82 + t394
+	t397 := t82 - t394
+	t398 := t393 + t395
+	t399 := t393 - t395
+	t400 := complex(imag(t399), -real(t399))
+	t401 := t396 + t398
+	t402 := t397 + t400
+	t403 := t396 - t398
+	t404 := t397 - t400
+	t405 := w3 * t141
+	t406 := w6 * t234
+	t407 := w9 * t327
+	t408 := t48 + t406
+	t409 := t48 - t406
+	t410 := t405 + t407
+	t411 := t405 - t407
+	t412 := complex(imag(t411), -real(t411))
+	t413 := t408 + t410
+	t414 := t409 + t412
+	t415 := t408 - t410
+	t416 := t409 - t412
+	t417 := w4 * t160
+	t418 := w8 * t253
+	t419 := w12 * t346
+	t420 := t67 + t418
+	t421 := t67 - t418
+	t422 := t417 + t419
+	t423 := t417 - t419
+	t424 := complex(imag(t423), -real(t423))
+	t425 := t420 + t422
+	t426 := t421 + t424
+	t427 := t420 - t422
+	t428 := t421 - t424
+	t429 := w5 * t179
+	t430 := w10 * t272
+	t431 := complex(imag(t365), -real(t365))
+	t432 := t86 + t430
+	t433 := t86 - t430
+	t434 := t429 + t431
+	t435 := t429 - t431
+	t436 := complex(imag(t435), -real(t435))
+	t437 := t432 + t434
+	t438 := t433 + t436
+	t439 := t432 - t434
+	t440 := t433 - t436
+	t441 := w6 * t146
+	t442 := w12 * t239
+	t443 := w18 * t332
+	t444 := t53 + t442
+	t445 := t53 - t442
+	t446 := t441 + t443
+	t447 := t441 - t443
+	t448 := complex(imag(t447), -real(t447))
+	t449 := t444 + t446
+	t450 := t445 + t448
+	t451 := t444 - t446
+	t452 := t445 - t448
+	t453 := w7 * t165
+	t454 := w14 * t258
+	t455 := w21 * t351
+	t456 := t72 + t454
+	t457 := t72 - t454
+	t458 := t453 + t455
+	t459 := t453 - t455
+	t460 := complex(imag(t459), -real(t459))
+	t461 := t456 + t458
+	t462 := t457 + t460
+	t463 := t456 - t458
+	t464 := t457 - t460
+	t465 := w8 * t184
+	t466 := w16 * t277
+	t467 := w24 * t370
+	t468 := t91 + t466
+	t469 := t91 - t466
+	t470 := t465 + t467
+	t471 := t465 - t467
+	t472 := complex(imag(t471), -real(t471))
+	t473 := t468 + t470
+	t474 := t469 + t472
+	t475 := t468 - t470
+	t476 := t469 - t472
+	t477 := w9 * t147
+	t478 := w18 * t240
+	t479 := w27 * t333
+	t480 := t54 + t478
+	t481 := t54 - t478
+	t482 := t477 + t479
+	t483 := t477 - t479
+	t484 := complex(imag(t483), -real(t483))
+	t485 := t480 + t482
+	t486 := t481 + t484
+	t487 := t480 - t482
+	t488 := t481 - t484
+	t489 := w10 * t166
+	t490 := w20 * t259
+	t491 := -t352
+	t492 := t73 + t490
+	t493 := t73 - t490
+	t494 := t489 + t491
+	t495 := t489 - t491
+	t496 := complex(imag(t495), -real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := w11 * t185
+	t502 := w22 * t278
+	t503 := w33 * t371
+	t504 := t92 + t502
+	t505 := t92 - t502
+	t506 := t501 + t503
+	t507 := t501 - t503
+	t508 := complex(imag(t507), -real(t507))
+	t509 := t504 + t506
+	t510 := t505 + t508
+	t511 := t504 - t506
+	t512 := t505 - t508
+	t513 := w12 * t142
+	t514 := w24 * t235
+	t515 := w36 * t328
+	t516 := t49 + t514
+	t517 := t49 - t514
+	t518 := t513 + t515
+	t519 := t513 - t515
+	t520 := complex(imag(t519), -real(t519))
+	t521 := t516 + t518
+	t522 := t517 + t520
+	t523 := t516 - t518
+	t524 := t517 - t520
+	t525 := w13 * t161
+	t526 := w26 * t254
+	t527 := w39 * t347
+	t528 := t68 + t526
+	t529 := t68 - t526
+	t530 := t525 + t527
+	t531 := t525 - t527
+	t532 := complex(imag(t531), -real(t531))
+	t533 := t528 + t530
+	t534 := t529 + t532
+	t535 := t528 - t530
+	t536 := t529 - t532
+	t537 := w14 * t180
+	t538 := w28 * t273
+	t539 := w42 * t366
+	t540 := t87 + t538
+	t541 := t87 - t538
+	t542 := t537 + t539
+	t543 := t537 - t539
+	t544 := complex(imag(t543), -real(t543))
+	t545 := t540 + t542
+	t546 := t541 + t544
+	t547 := t540 - t542
+	t548 := t541 - t544
+
+	y := dst[:n]
+	y[0] = t377
+	y[1] = t389
+	y[2] = t401
+	y[3] = t413
+	y[4] = t425
+	y[5] = t437
+	y[6] = t449
+	y[7] = t461
+	y[8] = t473
+	y[9] = t485
+	y[10] = t497
+	y[11] = t509
+	y[12] = t521
+	y[13] = t533
+	y[14] = t545
+	y[15] = t378
+	y[16] = t390
+	y[17] = t402
+	y[18] = t414
+	y[19] = t426
+	y[20] = t438
+	y[21] = t450
+	y[22] = t462
+	y[23] = t474
+	y[24] = t486
+	y[25] = t498
+	y[26] = t510
+	y[27] = t522
+	y[28] = t534
+	y[29] = t546
+	y[30] = t379
+	y[31] = t391
+	y[32] = t403
+	y[33] = t415
+	y[34] = t427
+	y[35] = t439
+	y[36] = t451
+	y[37] = t463
+	y[38] = t475
+	y[39] = t487
+	y[40] = t499
+	y[41] = t511
+	y[42] = t523
+	y[43] = t535
+	y[44] = t547
+	y[45] = t380
+	y[46] = t392
+	y[47] = t404
+	y[48] = t416
+	y[49] = t428
+	y[50] = t440
+	y[51] = t452
+	y[52] = t464
+	y[53] = t476
+	y[54] = t488
+	y[55] = t500
+	y[56] = t512
+	y[57] = t524
+	y[58] = t536
+	y[59] = t548
+
+	return true
+}
+
+// inverseDIT60Radix4x5x3Complex64 computes a 60-point inverse FFT of complex64 data
+// with radix-4, radix-5, radix-3 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT60Radix4x5x3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 60
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w12 := complex(real(tw[12]), -imag(tw[12]))
+	w16 := complex(real(tw[16]), -imag(tw[16]))
+	w24 := complex(real(tw[24]), -imag(tw[24]))
+	w32 := complex(real(tw[32]), -imag(tw[32]))
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w6 := complex(real(tw[6]), -imag(tw[6]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w5 := complex(real(tw[5]), -imag(tw[5]))
+	w10 := complex(real(tw[10]), -imag(tw[10]))
+	w18 := complex(real(tw[18]), -imag(tw[18]))
+	w7 := complex(real(tw[7]), -imag(tw[7]))
+	w14 := complex(real(tw[14]), -imag(tw[14]))
+	w21 := complex(real(tw[21]), -imag(tw[21]))
+	w27 := complex(real(tw[27]), -imag(tw[27]))
+	w20 := complex(real(tw[20]), -imag(tw[20]))
+	w11 := complex(real(tw[11]), -imag(tw[11]))
+	w22 := complex(real(tw[22]), -imag(tw[22]))
+	w33 := complex(real(tw[33]), -imag(tw[33]))
+	w36 := complex(real(tw[36]), -imag(tw[36]))
+	w13 := complex(real(tw[13]), -imag(tw[13]))
+	w26 := complex(real(tw[26]), -imag(tw[26]))
+	w39 := complex(real(tw[39]), -imag(tw[39]))
+	w28 := complex(real(tw[28]), -imag(tw[28]))
+	w42 := complex(real(tw[42]), -imag(tw[42]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+	x48 := x[48]
+	x49 := x[49]
+	x50 := x[50]
+	x51 := x[51]
+	x52 := x[52]
+	x53 := x[53]
+	x54 := x[54]
+	x55 := x[55]
+	x56 := x[56]
+	x57 := x[57]
+	x58 := x[58]
+	x59 := x[59]
+
+	t0 := x20 + x40
+	t1 := x20 - x40
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(-imag(t4), real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x24 + x44
+	t9 := x24 - x44
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(-imag(t12), real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x28 + x48
+	t17 := x28 - x48
+	t18 := x8 + t16
+	t19 := complex(real(x8)-0.5*real(t16), imag(x8)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(-imag(t20), real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x32 + x52
+	t25 := x32 - x52
+	t26 := x12 + t24
+	t27 := complex(real(x12)-0.5*real(t24), imag(x12)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(-imag(t28), real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := x36 + x56
+	t33 := x36 - x56
+	t34 := x16 + t32
+	t35 := complex(real(x16)-0.5*real(t32), imag(x16)-0.5*imag(t32))
+	t36 := complex(0.8660254037844388*real(t33), 0.8660254037844388*imag(t33))
+	t37 := complex(-imag(t36), real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := t10 + t34
+	t41 := t10 - t34
+	t42 := t18 + t26
+	t43 := t18 - t26
+	t44 := t2 + t40 + t42
+	t45 := complex(real(t2)+0.30901699437494745*real(t40)-0.8090169943749475*real(t42), imag(t2)+0.30901699437494745*imag(t40)-0.8090169943749475*imag(t42))
+	t46 := complex(0.9510565162951535*real(t41)+0.5877852522924732*real(t43), 0.9510565162951535*imag(t41)+0.5877852522924732*imag(t43))
+	t47 := complex(-imag(t46), real(t46))
+	t48 := t45 + t47
+	t49 := t45 - t47
+	t50 := complex(real(t2)-0.8090169943749475*real(t40)+0.30901699437494723*real(t42), imag(t2)-0.8090169943749475*imag(t40)+0.30901699437494723*imag(t42))
+	t51 := complex(0.5877852522924732*real(t41)-0.9510565162951536*real(t43), 0.5877852522924732*imag(t41)-0.9510565162951536*imag(t43))
+	t52 := complex(-imag(t51), real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := w4 * t14
+	t56 := w8 * t22
+	t57 := w12 * t30
+	t58 := w16 * t38
+	t59 := t55 + t58
+	t60 := t55 - t58
+	t61 := t56 + t57
+	t62 := t56 - t57
+	t63 := t6 + t59 + t61
+	t64 := complex(real(t6)+0.30901699437494745*real(t59)-0.8090169943749475*real(t61), imag(t6)+0.30901699437494745*imag(t59)-0.8090169943749475*imag(t61))
+	t65 := complex(0.9510565162951535*real(t60)+0.5877852522924732*real(t62), 0.9510565162951535*imag(t60)+0.5877852522924732*imag(t62))
+	t66 := complex(-imag(t65), real(t65))
+	t67 := t64 + t66
+	t68 := t64 - t66
+	t69 := complex(real(t6)-0.8090169943749475*real(t59)+0.30901699437494723*real(t61), imag(t6)-0.8090169943749475*imag(t59)+0.30901699437494723*imag(t61))
+	t70 := complex(0.5877852522924732*real(t60)-0.9510565162951536*real(t62), 0.5877852522924732*imag(t60)-0.9510565162951536*imag(t62))
+	t71 := complex(-imag(t70), real(t70))
+	t72 := t69 + t71
+	t73 := t69 - t71
+	t74 := w8 * t15
+	t75 := w16 * t23
+	t76 := w24 * t31
+	t77 := w32 * t39
+	t78 := t74 + t77
+	t79 := t74 - t77
+	t80 := t75 + t76
+	t81 := t75 - t76
+	t82 := t7 + t78 + t80
+	t83 := complex(real(t7)+0.30901699437494745*real(t78)-0.8090169943749475*real(t80), imag(t7)+0.30901699437494745*imag(t78)-0.8090169943749475*imag(t80))
+	t84 := complex(0.9510565162951535*real(t79)+0.5877852522924732*real(t81), 0.9510565162951535*imag(t79)+0.5877852522924732*imag(t81))
+	t85 := complex(-imag(t84), real(t84))
+	t86 := t83 + t85
+	t87 := t83 - t85
+	t88 := complex(real(t7)-0.8090169943749475*real(t78)+0.30901699437494723*real(t80), imag(t7)-0.8090169943749475*imag(t78)+0.30901699437494723*imag(t80))
+	t89 := complex(0.5877852522924732*real(t79)-0.9510565162951536*real(t81), 0.5877852522924732*imag(t79)-0.9510565162951536*imag(t81))
+	t90 := complex(-imag(t89), real(t89))
+	t91 := t88 + t90
+	t92 := t88 - t90
+	t93 := x21 + x41
+	t94 := x21 - x41
+	t95 := x1 + t93
+	t96 := complex(real(x1)-0.5*real(t93), imag(x1)-0.5*imag(t93))
+	t97 := complex(0.8660254037844388*real(t94), 0.8660254037844388*imag(t94))
+	t98 := complex(-imag(t97), real(t97))
+	t99 := t96 + t98
+	t100 := t96 - t98
+	t101 := x25 + x45
+	t102 := x25 - x45
+	t103 := x5 + t101
+	t104 := complex(real(x5)-0.5*real(t101), imag(x5)-0.5*imag(t101))
+	t105 := complex(0.8660254037844388*real(t102), 0.8660254037844388*imag(t102))
+	t106 := complex(-imag(t105), real(t105))
+	t107 := t104 + t106
+	t108 := t104 - t106
+	t109 := x29 + x49
+	t110 := x29 - x49
+	t111 := x9 + t109
+	t112 := complex(real(x9)-0.5*real(t109), imag(x9)-0.5*imag(t109))
+	t113 := complex(0.8660254037844388*real(t110), 0.8660254037844388*imag(t110))
+	t114 := complex(-imag(t113), real(t113))
+	t115 := t112 + t114
+	t116 := t112 - t114
+	t117 := x33 + x53
+	t118 := x33 - x53
+	t119 := x13 + t117
+	t120 := complex(real(x13)-0.5*real(t117), imag(x13)-0.5*imag(t117))
+	t121 := complex(0.8660254037844388*real(t118), 0.8660254037844388*imag(t118))
+	t122 := complex(-imag(t121), real(t121))
+	t123 := t120 + t122
+	t124 := t120 - t122
+	t125 := x37 + x57
+	t126 := x37 - x57
+	t127 := x17 + t125
+	t128 := complex(real(x17)-0.5*real(t125), imag(x17)-0.5*imag(t125))
+	t129 := complex(0.8660254037844388*real(t126), 0.8660254037844388*imag(t126))
+	t130 := complex(-imag(t129), real(t129))
+	t131 := t128 + t130
+	t132 := t128 - t130
+	t133 := t103 + t127
+	t134 := t103 - t127
+	t135 := t111 + t119
+	t136 := t111 - t119
+	t137 := t95 + t133 + t135
+	t138 := complex(real(t95)+0.30901699437494745*real(t133)-0.8090169943749475*real(t135), imag(t95)+0.30901699437494745*imag(t133)-0.8090169943749475*imag(t135))
+	t139 := complex(0.9510565162951535*real(t134)+0.5877852522924732*real(t136), 0.9510565162951535*imag(t134)+0.5877852522924732*imag(t136))
+	t140 := complex(-imag(t139), real(t139))
+	t141 := t138 + t140
+	t142 := t138 - t140
+	t143 := complex(real(t95)-0.8090169943749475*real(t133)+0.30901699437494723*real(t135), imag(t95)-0.8090169943749475*imag(t133)+0.30901699437494723*imag(t135))
+	t144 := complex(0.5877852522924732*real(t134)-0.9510565162951536*real(t136), 0.5877852522924732*imag(t134)-0.9510565162951536*imag(t136))
+	t145 := complex(-imag(t144), real(t144))
+	t146 := t143 + t145
+	t147 := t143 - t145
+	t148 := w4 * t107
+	t149 := w8 * t115
+	t150 := w12 * t123
+	t151 := w16 * t131
+	t152 := t148 + t151
+	t153 := t148 - t151
+	t154 := t149 + t150
+	t155 := t149 - t150
+	t156 := t99 + t152 + t154
+	t157 := complex(real(t99)+0.30901699437494745*real(t152)-0.8090169943749475*real(t154), imag(t99)+0.30901699437494745*imag(t152)-0.8090169943749475*imag(t154))
+	t158 := complex(0.9510565162951535*real(t153)+0.5877852522924732*real(t155), 0.9510565162951535*imag(t153)+0.5877852522924732*imag(t155))
+	t159 := complex(-imag(t158), real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := complex(real(t99)-0.8090169943749475*real(t152)+0.30901699437494723*real(t154), imag(t99)-0.8090169943749475*imag(t152)+0.30901699437494723*imag(t154))
+	t163 := complex(0.5877852522924732*real(t153)-0.9510565162951536*real(t155), 0.5877852522924732*imag(t153)-0.9510565162951536*imag(t155))
+	t164 := complex(-imag(t163), real(t163))
+	t165 := t162 + t164
+	t166 := t162 - t164
+	t167 := w8 * t108
+	t168 := w16 * t116
+	t169 := w24 * t124
+	t170 := w32 * t132
+	t171 := t167 + t170
+	t172 := t167 - t170
+	t173 := t168 + t169
+	t174 := t168 - t169
+	t175 := t100 + t171 + t173
+	t176 := complex(real(t100)+0.30901699437494745*real(t171)-0.8090169943749475*real(t173), imag(t100)+0.30901699437494745*imag(t171)-0.8090169943749475*imag(t173))
+	t177 := complex(0.9510565162951535*real(t172)+0.5877852522924732*real(t174), 0.9510565162951535*imag(t172)+0.5877852522924732*imag(t174))
+	t178 := complex(-imag(t177), real(t177))
+	t179 := t176 + t178
+	t180 := t176 - t178
+	t181 := complex(real(t100)-0.8090169943749475*real(t171)+0.30901699437494723*real(t173), imag(t100)-0.8090169943749475*imag(t171)+0.30901699437494723*imag(t173))
+	t182 := complex(0.5877852522924732*real(t172)-0.9510565162951536*real(t174), 0.5877852522924732*imag(t172)-0.9510565162951536*imag(t174))
+	t183 := complex(-imag(t182), real(t182))
+	t184 := t181 + t183
+	t185 := t181 - t183
+	t186 := x22 + x42
+	t187 := x22 - x42
+	t188 := x2 + t186
+	t189 := complex(real(x2)-0.5*real(t186), imag(x2)-0.5*imag(t186))
+	t190 := complex(0.8660254037844388*real(t187), 0.8660254037844388*imag(t187))
+	t191 := complex(-imag(t190), real(t190))
+	t192 := t189 + t191
+	t193 := t189 - t191
+	t194 := x26 + x46
+	t195 := x26 - x46
+	t196 := x6 + t194
+	t197 := complex(real(x6)-0.5*real(t194), imag(x6)-0.5*imag(t194))
+	t198 := complex(0.8660254037844388*real(t195), 0.8660254037844388*imag(t195))
+	t199 := complex(-imag(t198), real(t198))
+	t200 := t197 + t199
+	t201 := t197 - t199
+	t202 := x30 + x50
+	t203 := x30 - x50
+	t204 := x10 + t202
+	t205 := complex(real(x10)-0.5*real(t202), imag(x10)-0.5*imag(t202))
+	t206 := complex(0.8660254037844388*real(t203), 0.8660254037844388*imag(t203))
+	t207 := complex(-imag(t206), real(t206))
+	t208 := t205 + t207
+	t209 := t205 - t207
+	t210 := x34 + x54
+	t211 := x34 - x54
+	t212 := x14 + t210
+	t213 := complex(real(x14)-0.5*real(t210), imag(x14)-0.5*imag(t210))
+	t214 := complex(0.8660254037844388*real(t211), 0.8660254037844388*imag(t211))
+	t215 := complex(-imag(t214), real(t214))
+	t216 := t213 + t215
+	t217 := t213 - t215
+	t218 := x38 + x58
+	t219 := x38 - x58
+	t220 := x18 + t218
+	t221 := complex(real(x18)-0.5*real(t218), imag(x18)-0.5*imag(t218))
+	t222 := complex(0.8660254037844388*real(t219), 0.8660254037844388*imag(t219))
+	t223 := complex(-imag(t222), real(t222))
+	t224 := t221 + t223
+	t225 := t221 - t223
+	t226 := t196 + t220
+	t227 := t196 - t220
+	t228 := t204 + t212
+	t229 := t204 - t212
+	t230 := t188 + t226 + t228
+	t231 := complex(real(t188)+0.30901699437494745*real(t226)-0.8090169943749475*real(t228), imag(t188)+0.30901699437494745*imag(t226)-0.8090169943749475*imag(t228))
+	t232 := complex(0.9510565162951535*real(t227)+0.5877852522924732*real(t229), 0.9510565162951535*imag(t227)+0.5877852522924732*imag(t229))
+	t233 := complex(-imag(t232), real(t232))
+	t234 := t231 + t233
+	t235 := t231 - t233
+	t236 := complex(real(t188)-0.8090169943749475*real(t226)+0.30901699437494723*real(t228), imag(t188)-0.8090169943749475*imag(t226)+0.30901699437494723*imag(t228))
+	t237 := complex(0.5877852522924732*real(t227)-0.9510565162951536*real(t229), 0.5877852522924732*imag(t227)-0.9510565162951536*imag(t229))
+	t238 := complex(-imag(t237), real(t237))
+	t239 := t236 + t238
+	t240 := t236 - t238
+	t241 := w4 * t200
+	t242 := w8 * t208
+	t243 := w12 * t216
+	t244 := w16 * t224
+	t245 := t241 + t244
+	t246 := t241 - t244
+	t247 := t242 + t243
+	t248 := t242 - t243
+	t249 := t192 + t245 + t247
+	t250 := complex(real(t192)+0.30901699437494745*real(t245)-0.8090169943749475*real(t247), imag(t192)+0.30901699437494745*imag(t245)-0.8090169943749475*imag(t247))
+	t251 := complex(0.9510565162951535*real(t246)+0.5877852522924732*real(t248), 0.9510565162951535*imag(t246)+0.5877852522924732*imag(t248))
+	t252 := complex(-imag(t251), real(t251))
+	t253 := t250 + t252
+	t254 := t250 - t252
+	t255 := complex(real(t192)-0.8090169943749475*real(t245)+0.30901699437494723*real(t247), imag(t192)-0.8090169943749475*imag(t245)+0.30901699437494723*imag(t247))
+	t256 := complex(0.5877852522924732*real(t246)-0.9510565162951536*real(t248), 0.5877852522924732*imag(t246)-0.9510565162951536*imag(t248))
+	t257 := complex(-imag(t256), real(t256))
+	t258 := t255 + t257
+	t259 := t255 - t257
+	t260 := w8 * t201
+	t261 := w16 * t209
+	t262 := w24 * t217
+	t263 := w32 * t225
+	t264 := t260 + t263
+	t265 := t260 - t263
+	t266 := t261 + t262
+	t267 := t261 - t262
+	t268 := t193 + t264 + t266
+	t269 := complex(real(t193)+0.30901699437494745*real(t264)-0.8090169943749475*real(t266), imag(t193)+0.30901699437494745*imag(t264)-0.8090169943749475*imag(t266))
+	t270 := complex(0.9510565162951535*real(t265)+0.5877852522924732*real(t267), 0.9510565162951535*imag(t265)+0.5877852522924732*imag(t267))
+	t271 := complex(-imag(t270), real(t270))
+	t272 := t269 + t271
+	t273 := t269 - t271
+	t274 := complex(real(t193)-0.8090169943749475*real(t264)+0.30901699437494723*real(t266), imag(t193)-0.8090169943749475*imag(t264)+0.30901699437494723*imag(t266))
+	t275 := complex(0.5877852522924732*real(t265)-0.9510565162951536*real(t267), 0.5877852522924732*imag(t265)-0.9510565162951536*imag(t267))
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t274 + t276
+	t278 := t274 - t276
+	t279 := x23 + x43
+	t280 := x23 - x43
+	t281 := x3 + t279
+	t282 := complex(real(x3)-0.5*real(t279), imag(x3)-0.5*imag(t279))
+	t283 := complex(0.8660254037844388*real(t280), 0.8660254037844388*imag(t280))
+	t284 := complex(-imag(t283), real(t283))
+	t285 := t282 + t284
+	t286 := t282 - t284
+	t287 := x27 + x47
+	t288 := x27 - x47
+	t289 := x7 + t287
+	t290 := complex(real(x7)-0.5*real(t287), imag(x7)-0.5*imag(t287))
+	t291 := complex(0.8660254037844388*real(t288), 0.8660254037844388*imag(t288))
+	t292 := complex(-imag(t291), real(t291))
+	t293 := t290 + t292
+	t294 := t290 - t292
+	t295 := x31 + x51
+	t296 := x31 - x51
+	t297 := x11 + t295
+	t298 := complex(real(x11)-0.5*real(t295), imag(x11)-0.5*imag(t295))
+	t299 := complex(0.8660254037844388*real(t296), 0.8660254037844388*imag(t296))
+	t300 := complex(-imag(t299), real(t299))
+	t301 := t298 + t300
+	t302 := t298 - t300
+	t303 := x35 + x55
+	t304 := x35 - x55
+	t305 := x15 + t303
+	t306 := complex(real(x15)-0.5*real(t303), imag(x15)-0.5*imag(t303))
+	t307 := complex(0.8660254037844388*real(t304), 0.8660254037844388*imag(t304))
+	t308 := complex(-imag(t307), real(t307))
+	t309 := t306 + t308
+	t310 := t306 - t308
+	t311 := x39 + x59
+	t312 := x39 - x59
+	t313 := x19 + t311
+	t314 := complex(real(x19)-0.5*real(t311), imag(x19)-0.5*imag(t311))
+	t315 := complex(0.8660254037844388*real(t312), 0.8660254037844388*imag(t312))
+	t316 := complex(-imag(t315), real(t315))
+	t317 := t314 + t316
+	t318 := t314 - t316
+	t319 := t289 + t313
+	t320 := t289 - t313
+	t321 := t297 + t305
+	t322 := t297 - t305
+	t323 := t281 + t319 + t321
+	t324 := complex(real(t281)+0.30901699437494745*real(t319)-0.8090169943749475*real(t321), imag(t281)+0.30901699437494745*imag(t319)-0.8090169943749475*imag(t321))
+	t325 := complex(0.9510565162951535*real(t320)+0.5877852522924732*real(t322), 0.9510565162951535*imag(t320)+0.5877852522924732*imag(t322))
+	t326 := complex(-imag(t325), real(t325))
+	t327 := t324 + t326
+	t328 := t324 - t326
+	t329 := complex(real(t281)-0.8090169943749475*real(t319)+0.30901699437494723*real(t321), imag(t281)-0.8090169943749475*imag(t319)+0.30901699437494723*imag(t321))
+	t330 := complex(0.5877852522924732*real(t320)-0.9510565162951536*real(t322), 0.5877852522924732*imag(t320)-0.9510565162951536*imag(t322))
+	t331 := complex(-imag(t330), real(t330))
+	t332 := t329 + t331
+	t333 := t329 - t331
+	t334 := w4 * t293
+	t335 := w8 * t301
+	t336 := w12 * t309
+	t337 := w16 * t317
+	t338 := t334 + t337
+	t339 := t334 - t337
+	t340 := t335 + t336
+	t341 := t335 - t336
+	t342 := t285 + t338 + t340
+	t343 := complex(real(t285)+0.30901699437494745*real(t338)-0.8090169943749475*real(t340), imag(t285)+0.30901699437494745*imag(t338)-0.8090169943749475*imag(t340))
+	t344 := complex(0.9510565162951535*real(t339)+0.5877852522924732*real(t341), 0.9510565162951535*imag(t339)+0.5877852522924732*imag(t341))
+	t345 := complex(-imag(t344), real(t344))
+	t346 := t343 + t345
+	t347 := t343 - t345
+	t348 := complex(real(t285)-0.8090169943749475*real(t338)+0.30901699437494723*real(t340), imag(t285)-0.8090169943749475*imag(t338)+0.30901699437494723*imag(t340))
+	t349 := complex(0.5877852522924732*real(t339)-0.9510565162951536*real(t341), 0.5877852522924732*imag(t339)-0.9510565162951536*imag(t341))
+	t350 := complex(-imag(t349), real(t349))
+	t351 := t348 + t350
+	t352 := t348 - t350
+	t353 := w8 * t294
+	t354 := w16 * t302
+	t355 := w24 * t310
+	t356 := w32 * t318
+	t357 := t353 + t356
+	t358 := t353 - t356
+	t359 := t354 + t355
+	t360 := t354 - t355
+	t361 := t286 + t357 + t359
+	t362 := complex(real(t286)+0.30901699437494745*real(t357)-0.8090169943749475*real(t359), imag(t286)+0.30901699437494745*imag(t357)-0.8090169943749475*imag(t359))
+	t363 := complex(0.9510565162951535*real(t358)+0.5877852522924732*real(t360), 0.9510565162951535*imag(t358)+0.5877852522924732*imag(t360))
+	t364 := complex(-imag(t363), real(t363))
+	t365 := t362 + t364
+	t366 := t362 - t364
+	t367 := complex(real(t286)-0.8090169943749475*real(t357)+0.30901699437494723*real(t359), imag(t286)-0.8090169943749475*imag(t357)+0.30901699437494723*imag(t359))
+	t368 := complex(0.5877852522924732*real(t358)-0.9510565162951536*real(t360), 0.5877852522924732*imag(t358)-0.9510565162951536*imag(t360))
+	t369 := complex(-imag(t368), real(t368))
+	t370 := t367 + t369
+	t371 := t367 - t369
+	t372 := t44 + t230
+	t373 := t44 - t230
+	t374 := t137 + t323
+	t375 := t137 - t323
+	t376 := complex(-imag(t375), real(t375))
+	t377 := t372 + t374
+	t378 := t373 + t376
+	t379 := t372 - t374
+	t380 := t373 - t376
+	t381 := w1 * t156
+	t382 := w2 * t249
+	t383 := w3 * t342
+	t384 := t63 + t382
+	t385 := t63 - t382
+	t386 := t381 + t383
+	t387 := t381 - t383
+	t388 := complex(-imag(t387), real(t387))
+	t389 := t384 + t386
+	t390 := t385 + t388
+	t391 := t384 - t386
+	t392 := t385 - t388
+	t393 := w2 * t175
+	t394 := w4 * t268
+	t395 := w6 * t361
+	t396 := t82 + t394
+	t397 := t82 - t394
+	t398 := t393 + t395
+	t399 := t393 - t395
+	t400 := complex(-imag(t399), real(t399))
+	t401 := t396 + t398
+	t402 := t397 + t400
+	t403 := t396 - t398
+	t404 := t397 - t400
+	t405 := w3 * t141
+	t406 := w6 * t234
+	t407 := w9 * t327
+	t408 := t48 + t406
+	t409 := t48 - t406
+	t410 := t405 + t407
+	t411 := t405 - t407
+	t412 := complex(-imag(t411), real(t411))
+	t413 := t408 + t410
+	t414 := t409 + t412
+	t415 := t408 - t410
+	t416 := t409 - t412
+	t417 := w4 * t160
+	t418 := w8 * t253
+	t419 := w12 * t346
+	t420 := t67 + t418
+	t421 := t67 - t418
+	t422 := t417 + t419
+	t423 := t417 - t419
+	t424 := complex(-imag(t423), real(t423))
+	t425 := t420 + t422
+	t426 := t421 + t424
+	t427 := t420 - t422
+	t428 := t421 - t424
+	t429 := w5 * t179
+	t430 := w10 * t272
+	t431 := complex(-imag(t365), real(t365))
+	t432 := t86 + t430
+	t433 := t86 - t430
+	t434 := t429 + t431
+	t435 := t429 - t431
+	t436 := complex(-imag(t435), real(t435))
+	t437 := t432 + t434
+	t438 := t433 + t436
+	t439 := t432 - t434
+	t440 := t433 - t436
+	t441 := w6 * t146
+	t442 := w12 * t239
+	t443 := w18 * t332
+	t444 := t53 + t442
+	t445 := t53 - t442
+	t446 := t441 + t443
+	t447 := t441 - t443
+	t448 := complex(-imag(t447), real(t447))
+	t449 := t444 + t446
+	t450 := t445 + t448
+	t451 := t444 - t446
+	t452 := t445 - t448
+	t453 := w7 * t165
+	t454 := w14 * t258
+	t455 := w21 * t351
+	t456 := t72 + t454
+	t457 := t72 - t454
+	t458 := t453 + t455
+	t459 := t453 - t455
+	t460 := complex(-imag(t459), real(t459))
+	t461 := t456 + t458
+	t462 := t457 + t460
+	t463 := t456 - t458
+	t464 := t457 - t460
+	t465 := w8 * t184
+	t466 := w16 * t277
+	t467 := w24 * t370
+	t468 := t91 + t466
+	t469 := t91 - t466
+	t470 := t465 + t467
+	t471 := t465 - t467
+	t472 := complex(-imag(t471), real(t471))
+	t473 := t468 + t470
+	t474 := t469 + t472
+	t475 := t468 - t470
+	t476 := t469 - t472
+	t477 := w9 * t147
+	t478 := w18 * t240
+	t479 := w27 * t333
+	t480 := t54 + t478
+	t481 := t54 - t478
+	t482 := t477 + t479
+	t483 := t477 - t479
+	t484 := complex(-imag(t483), real(t483))
+	t485 := t480 + t482
+	t486 := t481 + t484
+	t487 := t480 - t482
+	t488 := t481 - t484
+	t489 := w10 * t166
+	t490 := w20 * t259
+	t491 := -t352
+	t492 := t73 + t490
+	t493 := t73 - t490
+	t494 := t489 + t491
+	t495 := t489 - t491
+	t496 := complex(-imag(t495), real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := w11 * t185
+	t502 := w22 * t278
+	t503 := w33 * t371
+	t504 := t92 + t502
+	t505 := t92 - t502
+	t506 := t501 + t503
+	t507 := t501 - t503
+	t508 := complex(-imag(t507), real(t507))
+	t509 := t504 + t506
+	t510 := t505 + t508
+	t511 := t504 - t506
+	t512 := t505 - t508
+	t513 := w12 * t142
+	t514 := w24 * t235
+	t515 := w36 * t328
+	t516 := t49 + t514
+	t517 := t49 - t514
+	t518 := t513 + t515
+	t519 := t513 - t515
+	t520 := complex(-imag(t519), real(t519))
+	t521 := t516 + t518
+	t522 := t517 + t520
+	t523 := t516 - t518
+	t524 := t517 - t520
+	t525 := w13 * t161
+	t526 := w26 * t254
+	t527 := w39 * t347
+	t528 := t68 + t526
+	t529 := t68 - t526
+	t530 := t525 + t527
+	t531 := t525 - t527
+	t532 := complex(-imag(t531), real(t531))
+	t533 := t528 + t530
+	t534 := t529 + t532
+	t535 := t528 - t530
+	t536 := t529 - t532
+	t537 := w14 * t180
+	t538 := w28 * t273
+	t539 := w42 * t366
+	t540 := t87 + t538
+	t541 := t87 - t538
+	t542 := t537 + t539
+	t543 := t537 - t539
+	t544 := complex(-imag(t543), real(t543))
+	t545 := t540 + t542
+	t546 := t541 + t544
+	t547 := t540 - t542
+	t548 := t541 - t544
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t377)*scale, imag(t377)*scale)
+	y[1] = complex(real(t389)*scale, imag(t389)*scale)
+	y[2] = complex(real(t401)*scale, imag(t401)*scale)
+	y[3] = complex(real(t413)*scale, imag(t413)*scale)
+	y[4] = complex(real(t425)*scale, imag(t425)*scale)
+	y[5] = complex(real(t437)*scale, imag(t437)*scale)
+	y[6] = complex(real(t449)*scale, imag(t449)*scale)
+	y[7] = complex(real(t461)*scale, imag(t461)*scale)
+	y[8] = complex(real(t473)*scale, imag(t473)*scale)
+	y[9] = complex(real(t485)*scale, imag(t485)*scale)
+	y[10] = complex(real(t497)*scale, imag(t497)*scale)
+	y[11] = complex(real(t509)*scale, imag(t509)*scale)
+	y[12] = complex(real(t521)*scale, imag(t521)*scale)
+	y[13] = complex(real(t533)*scale, imag(t533)*scale)
+	y[14] = complex(real(t545)*scale, imag(t545)*scale)
+	y[15] = complex(real(t378)*scale, imag(t378)*scale)
+	y[16] = complex(real(t390)*scale, imag(t390)*scale)
+	y[17] = complex(real(t402)*scale, imag(t402)*scale)
+	y[18] = complex(real(t414)*scale, imag(t414)*scale)
+	y[19] = complex(real(t426)*scale, imag(t426)*scale)
+	y[20] = complex(real(t438)*scale, imag(t438)*scale)
+	y[21] = complex(real(t450)*scale, imag(t450)*scale)
+	y[22] = complex(real(t462)*scale, imag(t462)*scale)
+	y[23] = complex(real(t474)*scale, imag(t474)*scale)
+	y[24] = complex(real(t486)*scale, imag(t486)*scale)
+	y[25] = complex(real(t498)*scale, imag(t498)*scale)
+	y[26] = complex(real(t510)*scale, imag(t510)*scale)
+	y[27] = complex(real(t522)*scale, imag(t522)*scale)
+	y[28] = complex(real(t534)*scale, imag(t534)*scale)
+	y[29] = complex(real(t546)*scale, imag(t546)*scale)
+	y[30] = complex(real(t379)*scale, imag(t379)*scale)
+	y[31] = complex(real(t391)*scale, imag(t391)*scale)
+	y[32] = complex(real(t403)*scale, imag(t403)*scale)
+	y[33] = complex(real(t415)*scale, imag(t415)*scale)
+	y[34] = complex(real(t427)*scale, imag(t427)*scale)
+	y[35] = complex(real(t439)*scale, imag(t439)*scale)
+	y[36] = complex(real(t451)*scale, imag(t451)*scale)
+	y[37] = complex(real(t463)*scale, imag(t463)*scale)
+	y[38] = complex(real(t475)*scale, imag(t475)*scale)
+	y[39] = complex(real(t487)*scale, imag(t487)*scale)
+	y[40] = complex(real(t499)*scale, imag(t499)*scale)
+	y[41] = complex(real(t511)*scale, imag(t511)*scale)
+	y[42] = complex(real(t523)*scale, imag(t523)*scale)
+	y[43] = complex(real(t535)*scale, imag(t535)*scale)
+	y[44] = complex(real(t547)*scale, imag(t547)*scale)
+	y[45] = complex(real(t380)*scale, imag(t380)*scale)
+	y[46] = complex(real(t392)*scale, imag(t392)*scale)
+	y[47] = complex(real(t404)*scale, imag(t404)*scale)
+	y[48] = complex(real(t416)*scale, imag(t416)*scale)
+	y[49] = complex(real(t428)*scale, imag(t428)*scale)
+	y[50] = complex(real(t440)*scale, imag(t440)*scale)
+	y[51] = complex(real(t452)*scale, imag(t452)*scale)
+	y[52] = complex(real(t464)*scale, imag(t464)*scale)
+	y[53] = complex(real(t476)*scale, imag(t476)*scale)
+	y[54] = complex(real(t488)*scale, imag(t488)*scale)
+	y[55] = complex(real(t500)*scale, imag(t500)*scale)
+	y[56] = complex(real(t512)*scale, imag(t512)*scale)
+	y[57] = complex(real(t524)*scale, imag(t524)*scale)
+	y[58] = complex(real(t536)*scale, imag(t536)*scale)
+	y[59] = complex(real(t548)*scale, imag(t548)*scale)
+
+	return true
+}
+
+// forwardDIT60Radix4x5x3Complex128 computes a 60-point forward FFT of complex128 data
+// with radix-4, radix-5, radix-3 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT60Radix4x5x3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 60
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := tw[4]
+	w8 := tw[8]
+	w12 := tw[12]
+	w16 := tw[16]
+	w24 := tw[24]
+	w32 := tw[32]
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w6 := tw[6]
+	w9 := tw[9]
+	w5 := tw[5]
+	w10 := tw[10]
+	w18 := tw[18]
+	w7 := tw[7]
+	w14 := tw[14]
+	w21 := tw[21]
+	w27 := tw[27]
+	w20 := tw[20]
+	w11 := tw[11]
+	w22 := tw[22]
+	w33 := tw[33]
+	w36 := tw[36]
+	w13 := tw[13]
+	w26 := tw[26]
+	w39 := tw[39]
+	w28 := tw[28]
+	w42 := tw[42]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+	x48 := x[48]
+	x49 := x[49]
+	x50 := x[50]
+	x51 := x[51]
+	x52 := x[52]
+	x53 := x[53]
+	x54 := x[54]
+	x55 := x[55]
+	x56 := x[56]
+	x57 := x[57]
+	x58 := x[58]
+	x59 := x[59]
+
+	t0 := x20 + x40
+	t1 := x20 - x40
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(imag(t4), -real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x24 + x44
+	t9 := x24 - x44
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(imag(t12), -real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x28 + x48
+	t17 := x28 - x48
+	t18 := x8 + t16
+	t19 := complex(real(x8)-0.5*real(t16), imag(x8)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(imag(t20), -real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x32 + x52
+	t25 := x32 - x52
+	t26 := x12 + t24
+	t27 := complex(real(x12)-0.5*real(t24), imag(x12)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(imag(t28), -real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := x36 + x56
+	t33 := x36 - x56
+	t34 := x16 + t32
+	t35 := complex(real(x16)-0.5*real(t32), imag(x16)-0.5*imag(t32))
+	t36 := complex(0.8660254037844388*real(t33), 0.8660254037844388*imag(t33))
+	t37 := complex(imag(t36), -real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := t10 + t34
+	t41 := t10 - t34
+	t42 := t18 + t26
+	t43 := t18 - t26
+	t44 := t2 + t40 + t42
+	t45 := complex(real(t2)+0.30901699437494745*real(t40)-0.8090169943749475*real(t42), imag(t2)+0.30901699437494745*imag(t40)-0.8090169943749475*imag(t42))
+	t46 := complex(0.9510565162951535*real(t41)+0.5877852522924732*real(t43), 0.9510565162951535*imag(t41)+0.5877852522924732*imag(t43))
+	t47 := complex(imag(t46), -real(t46))
+	t48 := t45 + t47
+	t49 := t45 - t47
+	t50 := complex(real(t2)-0.8090169943749475*real(t40)+0.30901699437494723*real(t42), imag(t2)-0.8090169943749475*imag(t40)+0.30901699437494723*imag(t42))
+	t51 := complex(0.5877852522924732*real(t41)-0.9510565162951536*real(t43), 0.5877852522924732*imag(t41)-0.9510565162951536*imag(t43))
+	t52 := complex(imag(t51), -real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := w4 * t14
+	t56 := w8 * t22
+	t57 := w12 * t30
+	t58 := w16 * t38
+	t59 := t55 + t58
+	t60 := t55 - t58
+	t61 := t56 + t57
+	t62 := t56 - t57
+	t63 := t6 + t59 + t61
+	t64 := complex(real(t6)+0.30901699437494745*real(t59)-0.8090169943749475*real(t61), imag(t6)+0.30901699437494745*imag(t59)-0.8090169943749475*imag(t61))
+	t65 := complex(0.9510565162951535*real(t60)+0.5877852522924732*real(t62), 0.9510565162951535*imag(t60)+0.5877852522924732*imag(t62))
+	t66 := complex(imag(t65), -real(t65))
+	t67 := t64 + t66
+	t68 := t64 - t66
+	t69 := complex(real(t6)-0.8090169943749475*real(t59)+0.30901699437494723*real(t61), imag(t6)-0.8090169943749475*imag(t59)+0.30901699437494723*imag(t61))
+	t70 := complex(0.5877852522924732*real(t60)-0.9510565162951536*real(t62), 0.5877852522924732*imag(t60)-0.9510565162951536*imag(t62))
+	t71 := complex(imag(t70), -real(t70))
+	t72 := t69 + t71
+	t73 := t69 - t71
+	t74 := w8 * t15
+	t75 := w16 * t23
+	t76 := w24 * t31
+	t77 := w32 * t39
+	t78 := t74 + t77
+	t79 := t74 - t77
+	t80 := t75 + t76
+	t81 := t75 - t76
+	t82 := t7 + t78 + t80
+	t83 := complex(real(t7)+0.30901699437494745*real(t78)-0.8090169943749475*real(t80), imag(t7)+0.30901699437494745*imag(t78)-0.8090169943749475*imag(t80))
+	t84 := complex(0.9510565162951535*real(t79)+0.5877852522924732*real(t81), 0.9510565162951535*imag(t79)+0.5877852522924732*imag(t81))
+	t85 := complex(imag(t84), -real(t84))
+	t86 := t83 + t85
+	t87 := t83 - t85
+	t88 := complex(real(t7)-0.8090169943749475*real(t78)+0.30901699437494723*real(t80), imag(t7)-0.8090169943749475*imag(t78)+0.30901699437494723*imag(t80))
+	t89 := complex(0.5877852522924732*real(t79)-0.9510565162951536*real(t81), 0.5877852522924732*imag(t79)-0.9510565162951536*imag(t81))
+	t90 := complex(imag(t89), -real(t89))
+	t91 := t88 + t90
+	t92 := t88 - t90
+	t93 := x21 + x41
+	t94 := x21 - x41
+	t95 := x1 + t93
+	t96 := complex(real(x1)-0.5*real(t93), imag(x1)-0.5*imag(t93))
+	t97 := complex(0.8660254037844388*real(t94), 0.8660254037844388*imag(t94))
+	t98 := complex(imag(t97), -real(t97))
+	t99 := t96 + t98
+	t100 := t96 - t98
+	t101 := x25 + x45
+	t102 := x25 - x45
+	t103 := x5 + t101
+	t104 := complex(real(x5)-0.5*real(t101), imag(x5)-0.5*imag(t101))
+	t105 := complex(0.8660254037844388*real(t102), 0.8660254037844388*imag(t102))
+	t106 := complex(imag(t105), -real(t105))
+	t107 := t104 + t106
+	t108 := t104 - t106
+	t109 := x29 + x49
+	t110 := x29 - x49
+	t111 := x9 + t109
+	t112 := complex(real(x9)-0.5*real(t109), imag(x9)-0.5*imag(t109))
+	t113 := complex(0.8660254037844388*real(t110), 0.8660254037844388*imag(t110))
+	t114 := complex(imag(t113), -real(t113))
+	t115 := t112 + t114
+	t116 := t112 - t114
+	t117 := x33 + x53
+	t118 := x33 - x53
+	t119 := x13 + t117
+	t120 := complex(real(x13)-0.5*real(t117), imag(x13)-0.5*imag(t117))
+	t121 := complex(0.8660254037844388*real(t118), 0.8660254037844388*imag(t118))
+	t122 := complex(imag(t121), -real(t121))
+	t123 := t120 + t122
+	t124 := t120 - t122
+	t125 := x37 + x57
+	t126 := x37 - x57
+	t127 := x17 + t125
+	t128 := complex(real(x17)-0.5*real(t125), imag(x17)-0.5*imag(t125))
+	t129 := complex(0.8660254037844388*real(t126), 0.8660254037844388*imag(t126))
+	t130 := complex(imag(t129), -real(t129))
+	t131 := t128 + t130
+	t132 := t128 - t130
+	t133 := t103 + t127
+	t134 := t103 - t127
+	t135 := t111 + t119
+	t136 := t111 - t119
+	t137 := t95 + t133 + t135
+	t138 := complex(real(t95)+0.30901699437494745*real(t133)-0.8090169943749475*real(t135), imag(t95)+0.30901699437494745*imag(t133)-0.8090169943749475*imag(t135))
+	t139 := complex(0.9510565162951535*real(t134)+0.5877852522924732*real(t136), 0.9510565162951535*imag(t134)+0.5877852522924732*imag(t136))
+	t140 := complex(imag(t139), -real(t139))
+	t141 := t138 + t140
+	t142 := t138 - t140
+	t143 := complex(real(t95)-0.8090169943749475*real(t133)+0.30901699437494723*real(t135), imag(t95)-0.8090169943749475*imag(t133)+0.30901699437494723*imag(t135))
+	t144 := complex(0.5877852522924732*real(t134)-0.9510565162951536*real(t136), 0.5877852522924732*imag(t134)-0.9510565162951536*imag(t136))
+	t145 := complex(imag(t144), -real(t144))
+	t146 := t143 + t145
+	t147 := t143 - t145
+	t148 := w4 * t107
+	t149 := w8 * t115
+	t150 := w12 * t123
+	t151 := w16 * t131
+	t152 := t148 + t151
+	t153 := t148 - t151
+	t154 := t149 + t150
+	t155 := t149 - t150
+	t156 := t99 + t152 + t154
+	t157 := complex(real(t99)+0.30901699437494745*real(t152)-0.8090169943749475*real(t154), imag(t99)+0.30901699437494745*imag(t152)-0.8090169943749475*imag(t154))
+	t158 := complex(0.9510565162951535*real(t153)+0.5877852522924732*real(t155), 0.9510565162951535*imag(t153)+0.5877852522924732*imag(t155))
+	t159 := complex(imag(t158), -real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := complex(real(t99)-0.8090169943749475*real(t152)+0.30901699437494723*real(t154), imag(t99)-0.8090169943749475*imag(t152)+0.30901699437494723*imag(t154))
+	t163 := complex(0.5877852522924732*real(t153)-0.9510565162951536*real(t155), 0.5877852522924732*imag(t153)-0.9510565162951536*imag(t155))
+	t164 := complex(imag(t163), -real(t163))
+	t165 := t162 + t164
+	t166 := t162 - t164
+	t167 := w8 * t108
+	t168 := w16 * t116
+	t169 := w24 * t124
+	t170 := w32 * t132
+	t171 := t167 + t170
+	t172 := t167 - t170
+	t173 := t168 + t169
+	t174 := t168 - t169
+	t175 := t100 + t171 + t173
+	t176 := complex(real(t100)+0.30901699437494745*real(t171)-0.8090169943749475*real(t173), imag(t100)+0.30901699437494745*imag(t171)-0.8090169943749475*imag(t173))
+	t177 := complex(0.9510565162951535*real(t172)+0.5877852522924732*real(t174), 0.9510565162951535*imag(t172)+0.5877852522924732*imag(t174))
+	t178 := complex(imag(t177), -real(t177))
+	t179 := t176 + t178
+	t180 := t176 - t178
+	t181 := complex(real(t100)-0.8090169943749475*real(t171)+0.30901699437494723*real(t173), imag(t100)-0.8090169943749475*imag(t171)+0.30901699437494723*imag(t173))
+	t182 := complex(0.5877852522924732*real(t172)-0.9510565162951536*real(t174), 0.5877852522924732*imag(t172)-0.9510565162951536*imag(t174))
+	t183 := complex(imag(t182), -real(t182))
+	t184 := t181 + t183
+	t185 := t181 - t183
+	t186 := x22 + x42
+	t187 := x22 - x42
+	t188 := x2 + t186
+	t189 := complex(real(x2)-0.5*real(t186), imag(x2)-0.5*imag(t186))
+	t190 := complex(0.8660254037844388*real(t187), 0.8660254037844388*imag(t187))
+	t191 := complex(imag(t190), -real(t190))
+	t192 := t189 + t191
+	t193 := t189 - t191
+	t194 := x26 + x46
+	t195 := x26 - x46
+	t196 := x6 + t194
+	t197 := complex(real(x6)-0.5*real(t194), imag(x6)-0.5*imag(t194))
+	t198 := complex(0.8660254037844388*real(t195), 0.8660254037844388*imag(t195))
+	t199 := complex(imag(t198), -real(t198))
+	t200 := t197 + t199
+	t201 := t197 - t199
+	t202 := x30 + x50
+	t203 := x30 - x50
+	t204 := x10 + t202
+	t205 := complex(real(x10)-0.5*real(t202), imag(x10)-0.5*imag(t202))
+	t206 := complex(0.8660254037844388*real(t203), 0.8660254037844388*imag(t203))
+	t207 := complex(imag(t206), -real(t206))
+	t208 := t205 + t207
+	t209 := t205 - t207
+	t210 := x34 + x54
+	t211 := x34 - x54
+	t212 := x14 + t210
+	t213 := complex(real(x14)-0.5*real(t210), imag(x14)-0.5*imag(t210))
+	t214 := complex(0.8660254037844388*real(t211), 0.8660254037844388*imag(t211))
+	t215 := complex(imag(t214), -real(t214))
+	t216 := t213 + t215
+	t217 := t213 - t215
+	t218 := x38 + x58
+	t219 := x38 - x58
+	t220 := x18 + t218
+	t221 := complex(real(x18)-0.5*real(t218), imag(x18)-0.5*imag(t218))
+	t222 := complex(0.8660254037844388*real(t219), 0.8660254037844388*imag(t219))
+	t223 := complex(imag(t222), -real(t222))
+	t224 := t221 + t223
+	t225 := t221 - t223
+	t226 := t196 + t220
+	t227 := t196 - t220
+	t228 := t204 + t212
+	t229 := t204 - t212
+	t230 := t188 + t226 + t228
+	t231 := complex(real(t188)+0.30901699437494745*real(t226)-0.8090169943749475*real(t228), imag(t188)+0.30901699437494745*imag(t226)-0.8090169943749475*imag(t228))
+	t232 := complex(0.9510565162951535*real(t227)+0.5877852522924732*real(t229), 0.9510565162951535*imag(t227)+0.5877852522924732*imag(t229))
+	t233 := complex(imag(t232), -real(t232))
+	t234 := t231 + t233
+	t235 := t231 - t233
+	t236 := complex(real(t188)-0.8090169943749475*real(t226)+0.30901699437494723*real(t228), imag(t188)-0.8090169943749475*imag(t226)+0.30901699437494723*imag(t228))
+	t237 := complex(0.5877852522924732*real(t227)-0.9510565162951536*real(t229), 0.5877852522924732*imag(t227)-0.9510565162951536*imag(t229))
+	t238 := complex(imag(t237), -real(t237))
+	t239 := t236 + t238
+	t240 := t236 - t238
+	t241 := w4 * t200
+	t242 := w8 * t208
+	t243 := w12 * t216
+	t244 := w16 * t224
+	t245 := t241 + t244
+	t246 := t241 - t244
+	t247 := t242 + t243
+	t248 := t242 - t243
+	t249 := t192 + t245 + t247
+	t250 := complex(real(t192)+0.30901699437494745*real(t245)-0.8090169943749475*real(t247), imag(t192)+0.30901699437494745*imag(t245)-0.8090169943749475*imag(t247))
+	t251 := complex(0.9510565162951535*real(t246)+0.5877852522924732*real(t248), 0.9510565162951535*imag(t246)+0.5877852522924732*imag(t248))
+	t252 := complex(imag(t251), -real(t251))
+	t253 := t250 + t252
+	t254 := t250 - t252
+	t255 := complex(real(t192)-0.8090169943749475*real(t245)+0.30901699437494723*real(t247), imag(t192)-0.8090169943749475*imag(t245)+0.30901699437494723*imag(t247))
+	t256 := complex(0.5877852522924732*real(t246)-0.9510565162951536*real(t248), 0.5877852522924732*imag(t246)-0.9510565162951536*imag(t248))
+	t257 := complex(imag(t256), -real(t256))
+	t258 := t255 + t257
+	t259 := t255 - t257
+	t260 := w8 * t201
+	t261 := w16 * t209
+	t262 := w24 * t217
+	t263 := w32 * t225
+	t264 := t260 + t263
+	t265 := t260 - t263
+	t266 := t261 + t262
+	t267 := t261 - t262
+	t268 := t193 + t264 + t266
+	t269 := complex(real(t193)+0.30901699437494745*real(t264)-0.8090169943749475*real(t266), imag(t193)+0.30901699437494745*imag(t264)-0.8090169943749475*imag(t266))
+	t270 := complex(0.9510565162951535*real(t265)+0.5877852522924732*real(t267), 0.9510565162951535*imag(t265)+0.5877852522924732*imag(t267))
+	t271 := complex(imag(t270), -real(t270))
+	t272 := t269 + t271
+	t273 := t269 - t271
+	t274 := complex(real(t193)-0.8090169943749475*real(t264)+0.30901699437494723*real(t266), imag(t193)-0.8090169943749475*imag(t264)+0.30901699437494723*imag(t266))
+	t275 := complex(0.5877852522924732*real(t265)-0.9510565162951536*real(t267), 0.5877852522924732*imag(t265)-0.9510565162951536*imag(t267))
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t274 + t276
+	t278 := t274 - t276
+	t279 := x23 + x43
+	t280 := x23 - x43
+	t281 := x3 + t279
+	t282 := complex(real(x3)-0.5*real(t279), imag(x3)-0.5*imag(t279))
+	t283 := complex(0.8660254037844388*real(t280), 0.8660254037844388*imag(t280))
+	t284 := complex(imag(t283), -real(t283))
+	t285 := t282 + t284
+	t286 := t282 - t284
+	t287 := x27 + x47
+	t288 := x27 - x47
+	t289 := x7 + t287
+	t290 := complex(real(x7)-0.5*real(t287), imag(x7)-0.5*imag(t287))
+	t291 := complex(0.8660254037844388*real(t288), 0.8660254037844388*imag(t288))
+	t292 := complex(imag(t291), -real(t291))
+	t293 := t290 + t292
+	t294 := t290 - t292
+	t295 := x31 + x51
+	t296 := x31 - x51
+	t297 := x11 + t295
+	t298 := complex(real(x11)-0.5*real(t295), imag(x11)-0.5*imag(t295))
+	t299 := complex(0.8660254037844388*real(t296), 0.8660254037844388*imag(t296))
+	t300 := complex(imag(t299), -real(t299))
+	t301 := t298 + t300
+	t302 := t298 - t300
+	t303 := x35 + x55
+	t304 := x35 - x55
+	t305 := x15 + t303
+	t306 := complex(real(x15)-0.5*real(t303), imag(x15)-0.5*imag(t303))
+	t307 := complex(0.8660254037844388*real(t304), 0.8660254037844388*imag(t304))
+	t308 := complex(imag(t307), -real(t307))
+	t309 := t306 + t308
+	t310 := t306 - t308
+	t311 := x39 + x59
+	t312 := x39 - x59
+	t313 := x19 + t311
+	t314 := complex(real(x19)-0.5*real(t311), imag(x19)-0.5*imag(t311))
+	t315 := complex(0.8660254037844388*real(t312), 0.8660254037844388*imag(t312))
+	t316 := complex(imag(t315), -real(t315))
+	t317 := t314 + t316
+	t318 := t314 - t316
+	t319 := t289 + t313
+	t320 := t289 - t313
+	t321 := t297 + t305
+	t322 := t297 - t305
+	t323 := t281 + t319 + t321
+	t324 := complex(real(t281)+0.30901699437494745*real(t319)-0.8090169943749475*real(t321), imag(t281)+0.30901699437494745*imag(t319)-0.8090169943749475*imag(t321))
+	t325 := complex(0.9510565162951535*real(t320)+0.5877852522924732*real(t322), 0.9510565162951535*imag(t320)+0.5877852522924732*imag(t322))
+	t326 := complex(imag(t325), -real(t325))
+	t327 := t324 + t326
+	t328 := t324 - t326
+	t329 := complex(real(t281)-0.8090169943749475*real(t319)+0.30901699437494723*real(t321), imag(t281)-0.8090169943749475*imag(t319)+0.30901699437494723*imag(t321))
+	t330 := complex(0.5877852522924732*real(t320)-0.9510565162951536*real(t322), 0.5877852522924732*imag(t320)-0.9510565162951536*imag(t322))
+	t331 := complex(imag(t330), -real(t330))
+	t332 := t329 + t331
+	t333 := t329 - t331
+	t334 := w4 * t293
+	t335 := w8 * t301
+	t336 := w12 * t309
+	t337 := w16 * t317
+	t338 := t334 + t337
+	t339 := t334 - t337
+	t340 := t335 + t336
+	t341 := t335 - t336
+	t342 := t285 + t338 + t340
+	t343 := complex(real(t285)+0.30901699437494745*real(t338)-0.8090169943749475*real(t340), imag(t285)+0.30901699437494745*imag(t338)-0.8090169943749475*imag(t340))
+	t344 := complex(0.9510565162951535*real(t339)+0.5877852522924732*real(t341), 0.9510565162951535*imag(t339)+0.5877852522924732*imag(t341))
+	t345 := complex(imag(t344), -real(t344))
+	t346 := t343 + t345
+	t347 := t343 - t345
+	t348 := complex(real(t285)-0.8090169943749475*real(t338)+0.30901699437494723*real(t340), imag(t285)-0.8090169943749475*imag(t338)+0.30901699437494723*imag(t340))
+	t349 := complex(0.5877852522924732*real(t339)-0.9510565162951536*real(t341), 0.5877852522924732*imag(t339)-0.9510565162951536*imag(t341))
+	t350 := complex(imag(t349), -real(t349))
+	t351 := t348 + t350
+	t352 := t348 - t350
+	t353 := w8 * t294
+	t354 := w16 * t302
+	t355 := w24 * t310
+	t356 := w32 * t318
+	t357 := t353 + t356
+	t358 := t353 - t356
+	t359 := t354 + t355
+	t360 := t354 - t355
+	t361 := t286 + t357 + t359
+	t362 := complex(real(t286)+0.30901699437494745*real(t357)-0.8090169943749475*real(t359), imag(t286)+0.30901699437494745*imag(t357)-0.8090169943749475*imag(t359))
+	t363 := complex(0.9510565162951535*real(t358)+0.5877852522924732*real(t360), 0.9510565162951535*imag(t358)+0.5877852522924732*imag(t360))
+	t364 := complex(imag(t363), -real(t363))
+	t365 := t362 + t364
+	t366 := t362 - t364
+	t367 := complex(real(t286)-0.8090169943749475*real(t357)+0.30901699437494723*real(t359), imag(t286)-0.8090169943749475*imag(t357)+0.30901699437494723*imag(t359))
+	t368 := complex(0.5877852522924732*real(t358)-0.9510565162951536*real(t360), 0.5877852522924732*imag(t358)-0.9510565162951536*imag(t360))
+	t369 := complex(imag(t368), -real(t368))
+	t370 := t367 + t369
+	t371 := t367 - t369
+	t372 := t44 + t230
+	t373 := t44 - t230
+	t374 := t137 + t323
+	t375 := t137 - t323
+	t376 := complex(imag(t375), -real(t375))
+	t377 := t372 + t374
+	t378 := t373 + t376
+	t379 := t372 - t374
+	t380 := t373 - t376
+	t381 := w1 * t156
+	t382 := w2 * t249
+	t383 := w3 * t342
+	t384 := t63 + t382
+	t385 := t63 - t382
+	t386 := t381 + t383
+	t387 := t381 - t383
+	t388 := complex(imag(t387), -real(t387))
+	t389 := t384 + t386
+	t390 := t385 + t388
+	t391 := t384 - t386
+	t392 := t385 - t388
+	t393 := w2 * t175
+	t394 := w4 * t268
+	t395 := w6 * t361
+	t396 := t82 + t394
+	t397 := t82 - t394
+	t398 := t393 + t395
+	t399 := t393 - t395
+	t400 := complex(imag(t399), -real(t399))
+	t401 := t396 + t398
+	t402 := t397 + t400
+	t403 := t396 - t398
+	t404 := t397 - t400
+	t405 := w3 * t141
+	t406 := w6 * t234
+	t407 := w9 * t327
+	t408 := t48 + t406
+	t409 := t48 - t406
+	t410 := t405 + t407
+	t411 := t405 - t407
+	t412 := complex(imag(t411), -real(t411))
+	t413 := t408 + t410
+	t414 := t409 + t412
+	t415 := t408 - t410
+	t416 := t409 - t412
+	t417 := w4 * t160
+	t418 := w8 * t253
+	t419 := w12 * t346
+	t420 := t67 + t418
+	t421 := t67 - t418
+	t422 := t417 + t419
+	t423 := t417 - t419
+	t424 := complex(imag(t423), -real(t423))
+	t425 := t420 + t422
+	t426 := t421 + t424
+	t427 := t420 - t422
+	t428 := t421 - t424
+	t429 := w5 * t179
+	t430 := w10 * t272
+	t431 := complex(imag(t365), -real(t365))
+	t432 := t86 + t430
+	t433 := t86 - t430
+	t434 := t429 + t431
+	t435 := t429 - t431
+	t436 := complex(imag(t435), -real(t435))
+	t437 := t432 + t434
+	t438 := t433 + t436
+	t439 := t432 - t434
+	t440 := t433 - t436
+	t441 := w6 * t146
+	t442 := w12 * t239
+	t443 := w18 * t332
+	t444 := t53 + t442
+	t445 := t53 - t442
+	t446 := t441 + t443
+	t447 := t441 - t443
+	t448 := complex(imag(t447), -real(t447))
+	t449 := t444 + t446
+	t450 := t445 + t448
+	t451 := t444 - t446
+	t452 := t445 - t448
+	t453 := w7 * t165
+	t454 := w14 * t258
+	t455 := w21 * t351
+	t456 := t72 + t454
+	t457 := t72 - t454
+	t458 := t453 + t455
+	t459 := t453 - t455
+	t460 := complex(imag(t459), -real(t459))
+	t461 := t456 + t458
+	t462 := t457 + t460
+	t463 := t456 - t458
+	t464 := t457 - t460
+	t465 := w8 * t184
+	t466 := w16 * t277
+	t467 := w24 * t370
+	t468 := t91 + t466
+	t469 := t91 - t466
+	t470 := t465 + t467
+	t471 := t465 - t467
+	t472 := complex(imag(t471), -real(t471))
+	t473 := t468 + t470
+	t474 := t469 + t472
+	t475 := t468 - t470
+	t476 := t469 - t472
+	t477 := w9 * t147
+	t478 := w18 * t240
+	t479 := w27 * t333
+	t480 := t54 + t478
+	t481 := t54 - t478
+	t482 := t477 + t479
+	t483 := t477 - t479
+	t484 := complex(imag(t483), -real(t483))
+	t485 := t480 + t482
+	t486 := t481 + t484
+	t487 := t480 - t482
+	t488 := t481 - t484
+	t489 := w10 * t166
+	t490 := w20 * t259
+	t491 := -t352
+	t492 := t73 + t490
+	t493 := t73 - t490
+	t494 := t489 + t491
+	t495 := t489 - t491
+	t496 := complex(imag(t495), -real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := w11 * t185
+	t502 := w22 * t278
+	t503 := w33 * t371
+	t504 := t92 + t502
+	t505 := t92 - t502
+	t506 := t501 + t503
+	t507 := t501 - t503
+	t508 := complex(imag(t507), -real(t507))
+	t509 := t504 + t506
+	t510 := t505 + t508
+	t511 := t504 - t506
+	t512 := t505 - t508
+	t513 := w12 * t142
+	t514 := w24 * t235
+	t515 := w36 * t328
+	t516 := t49 + t514
+	t517 := t49 - t514
+	t518 := t513 + t515
+	t519 := t513 - t515
+	t520 := complex(imag(t519), -real(t519))
+	t521 := t516 + t518
+	t522 := t517 + t520
+	t523 := t516 - t518
+	t524 := t517 - t520
+	t525 := w13 * t161
+	t526 := w26 * t254
+	t527 := w39 * t347
+	t528 := t68 + t526
+	t529 := t68 - t526
+	t530 := t525 + t527
+	t531 := t525 - t527
+	t532 := complex(imag(t531), -real(t531))
+	t533 := t528 + t530
+	t534 := t529 + t532
+	t535 := t528 - t530
+	t536 := t529 - t532
+	t537 := w14 * t180
+	t538 := w28 * t273
+	t539 := w42 * t366
+	t540 := t87 + t538
+	t541 := t87 - t538
+	t542 := t537 + t539
+	t543 := t537 - t539
+	t544 := complex(imag(t543), -real(t543))
+	t545 := t540 + t542
+	t546 := t541 + t544
+	t547 := t540 - t542
+	t548 := t541 - t544
+
+	y := dst[:n]
+	y[0] = t377
+	y[1] = t389
+	y[2] = t401
+	y[3] = t413
+	y[4] = t425
+	y[5] = t437
+	y[6] = t449
+	y[7] = t461
+	y[8] = t473
+	y[9] = t485
+	y[10] = t497
+	y[11] = t509
+	y[12] = t521
+	y[13] = t533
+	y[14] = t545
+	y[15] = t378
+	y[16] = t390
+	y[17] = t402
+	y[18] = t414
+	y[19] = t426
+	y[20] = t438
+	y[21] = t450
+	y[22] = t462
+	y[23] = t474
+	y[24] = t486
+	y[25] = t498
+	y[26] = t510
+	y[27] = t522
+	y[28] = t534
+	y[29] = t546
+	y[30] = t379
+	y[31] = t391
+	y[32] = t403
+	y[33] = t415
+	y[34] = t427
+	y[35] = t439
+	y[36] = t451
+	y[37] = t463
+	y[38] = t475
+	y[39] = t487
+	y[40] = t499
+	y[41] = t511
+	y[42] = t523
+	y[43] = t535
+	y[44] = t547
+	y[45] = t380
+	y[46] = t392
+	y[47] = t404
+	y[48] = t416
+	y[49] = t428
+	y[50] = t440
+	y[51] = t452
+	y[52] = t464
+	y[53] = t476
+	y[54] = t488
+	y[55] = t500
+	y[56] = t512
+	y[57] = t524
+	y[58] = t536
+	y[59] = t548
+
+	return true
+}
+
+// inverseDIT60Radix4x5x3Complex128 computes a 60-point inverse FFT of complex128 data
+// with radix-4, radix-5, radix-3 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT60Radix4x5x3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 60
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w12 := complex(real(tw[12]), -imag(tw[12]))
+	w16 := complex(real(tw[16]), -imag(tw[16]))
+	w24 := complex(real(tw[24]), -imag(tw[24]))
+	w32 := complex(real(tw[32]), -imag(tw[32]))
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w6 := complex(real(tw[6]), -imag(tw[6]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w5 := complex(real(tw[5]), -imag(tw[5]))
+	w10 := complex(real(tw[10]), -imag(tw[10]))
+	w18 := complex(real(tw[18]), -imag(tw[18]))
+	w7 := complex(real(tw[7]), -imag(tw[7]))
+	w14 := complex(real(tw[14]), -imag(tw[14]))
+	w21 := complex(real(tw[21]), -imag(tw[21]))
+	w27 := complex(real(tw[27]), -imag(tw[27]))
+	w20 := complex(real(tw[20]), -imag(tw[20]))
+	w11 := complex(real(tw[11]), -imag(tw[11]))
+	w22 := complex(real(tw[22]), -imag(tw[22]))
+	w33 := complex(real(tw[33]), -imag(tw[33]))
+	w36 := complex(real(tw[36]), -imag(tw[36]))
+	w13 := complex(real(tw[13]), -imag(tw[13]))
+	w26 := complex(real(tw[26]), -imag(tw[26]))
+	w39 := complex(real(tw[39]), -imag(tw[39]))
+	w28 := complex(real(tw[28]), -imag(tw[28]))
+	w42 := complex(real(tw[42]), -imag(tw[42]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+	x48 := x[48]
+	x49 := x[49]
+	x50 := x[50]
+	x51 := x[51]
+	x52 := x[52]
+	x53 := x[53]
+	x54 := x[54]
+	x55 := x[55]
+	x56 := x[56]
+	x57 := x[57]
+	x58 := x[58]
+	x59 := x[59]
+
+	t0 := x20 + x40
+	t1 := x20 - x40
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844388*real(t1), 0.8660254037844388*imag(t1))
+	t5 := complex(-imag(t4), real(t4))
+	t6 := t3 + t5
+	t7 := t3 - t5
+	t8 := x24 + x44
+	t9 := x24 - x44
+	t10 := x4 + t8
+	t11 := complex(real(x4)-0.5*real(t8), imag(x4)-0.5*imag(t8))
+	t12 := complex(0.8660254037844388*real(t9), 0.8660254037844388*imag(t9))
+	t13 := complex(-imag(t12), real(t12))
+	t14 := t11 + t13
+	t15 := t11 - t13
+	t16 := x28 + x48
+	t17 := x28 - x48
+	t18 := x8 + t16
+	t19 := complex(real(x8)-0.5*real(t16), imag(x8)-0.5*imag(t16))
+	t20 := complex(0.8660254037844388*real(t17), 0.8660254037844388*imag(t17))
+	t21 := complex(-imag(t20), real(t20))
+	t22 := t19 + t21
+	t23 := t19 - t21
+	t24 := x32 + x52
+	t25 := x32 - x52
+	t26 := x12 + t24
+	t27 := complex(real(x12)-0.5*real(t24), imag(x12)-0.5*imag(t24))
+	t28 := complex(0.8660254037844388*real(t25), 0.8660254037844388*imag(t25))
+	t29 := complex(-imag(t28), real(t28))
+	t30 := t27 + t29
+	t31 := t27 - t29
+	t32 := x36 + x56
+	t33 := x36 - x56
+	t34 := x16 + t32
+	t35 := complex(real(x16)-0.5*real(t32), imag(x16)-0.5*imag(t32))
+	t36 := complex(0.8660254037844388*real(t33), 0.8660254037844388*imag(t33))
+	t37 := complex(-imag(t36), real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := t10 + t34
+	t41 := t10 - t34
+	t42 := t18 + t26
+	t43 := t18 - t26
+	t44 := t2 + t40 + t42
+	t45 := complex(real(t2)+0.30901699437494745*real(t40)-0.8090169943749475*real(t42), imag(t2)+0.30901699437494745*imag(t40)-0.8090169943749475*imag(t42))
+	t46 := complex(0.9510565162951535*real(t41)+0.5877852522924732*real(t43), 0.9510565162951535*imag(t41)+0.5877852522924732*imag(t43))
+	t47 := complex(-imag(t46), real(t46))
+	t48 := t45 + t47
+	t49 := t45 - t47
+	t50 := complex(real(t2)-0.8090169943749475*real(t40)+0.30901699437494723*real(t42), imag(t2)-0.8090169943749475*imag(t40)+0.30901699437494723*imag(t42))
+	t51 := complex(0.5877852522924732*real(t41)-0.9510565162951536*real(t43), 0.5877852522924732*imag(t41)-0.9510565162951536*imag(t43))
+	t52 := complex(-imag(t51), real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := w4 * t14
+	t56 := w8 * t22
+	t57 := w12 * t30
+	t58 := w16 * t38
+	t59 := t55 + t58
+	t60 := t55 - t58
+	t61 := t56 + t57
+	t62 := t56 - t57
+	t63 := t6 + t59 + t61
+	t64 := complex(real(t6)+0.30901699437494745*real(t59)-0.8090169943749475*real(t61), imag(t6)+0.30901699437494745*imag(t59)-0.8090169943749475*imag(t61))
+	t65 := complex(0.9510565162951535*real(t60)+0.5877852522924732*real(t62), 0.9510565162951535*imag(t60)+0.5877852522924732*imag(t62))
+	t66 := complex(-imag(t65), real(t65))
+	t67 := t64 + t66
+	t68 := t64 - t66
+	t69 := complex(real(t6)-0.8090169943749475*real(t59)+0.30901699437494723*real(t61), imag(t6)-0.8090169943749475*imag(t59)+0.30901699437494723*imag(t61))
+	t70 := complex(0.5877852522924732*real(t60)-0.9510565162951536*real(t62), 0.5877852522924732*imag(t60)-0.9510565162951536*imag(t62))
+	t71 := complex(-imag(t70), real(t70))
+	t72 := t69 + t71
+	t73 := t69 - t71
+	t74 := w8 * t15
+	t75 := w16 * t23
+	t76 := w24 * t31
+	t77 := w32 * t39
+	t78 := t74 + t77
+	t79 := t74 - t77
+	t80 := t75 + t76
+	t81 := t75 - t76
+	t82 := t7 + t78 + t80
+	t83 := complex(real(t7)+0.30901699437494745*real(t78)-0.8090169943749475*real(t80), imag(t7)+0.30901699437494745*imag(t78)-0.8090169943749475*imag(t80))
+	t84 := complex(0.9510565162951535*real(t79)+0.5877852522924732*real(t81), 0.9510565162951535*imag(t79)+0.5877852522924732*imag(t81))
+	t85 := complex(-imag(t84), real(t84))
+	t86 := t83 + t85
+	t87 := t83 - t85
+	t88 := complex(real(t7)-0.8090169943749475*real(t78)+0.30901699437494723*real(t80), imag(t7)-0.8090169943749475*imag(t78)+0.30901699437494723*imag(t80))
+	t89 := complex(0.5877852522924732*real(t79)-0.9510565162951536*real(t81), 0.5877852522924732*imag(t79)-0.9510565162951536*imag(t81))
+	t90 := complex(-imag(t89), real(t89))
+	t91 := t88 + t90
+	t92 := t88 - t90
+	t93 := x21 + x41
+	t94 := x21 - x41
+	t95 := x1 + t93
+	t96 := complex(real(x1)-0.5*real(t93), imag(x1)-0.5*imag(t93))
+	t97 := complex(0.8660254037844388*real(t94), 0.8660254037844388*imag(t94))
+	t98 := complex(-imag(t97), real(t97))
+	t99 := t96 + t98
+	t100 := t96 - t98
+	t101 := x25 + x45
+	t102 := x25 - x45
+	t103 := x5 + t101
+	t104 := complex(real(x5)-0.5*real(t101), imag(x5)-0.5*imag(t101))
+	t105 := complex(0.8660254037844388*real(t102), 0.8660254037844388*imag(t102))
+	t106 := complex(-imag(t105), real(t105))
+	t107 := t104 + t106
+	t108 := t104 - t106
+	t109 := x29 + x49
+	t110 := x29 - x49
+	t111 := x9 + t109
+	t112 := complex(real(x9)-0.5*real(t109), imag(x9)-0.5*imag(t109))
+	t113 := complex(0.8660254037844388*real(t110), 0.8660254037844388*imag(t110))
+	t114 := complex(-imag(t113), real(t113))
+	t115 := t112 + t114
+	t116 := t112 - t114
+	t117 := x33 + x53
+	t118 := x33 - x53
+	t119 := x13 + t117
+	t120 := complex(real(x13)-0.5*real(t117), imag(x13)-0.5*imag(t117))
+	t121 := complex(0.8660254037844388*real(t118), 0.8660254037844388*imag(t118))
+	t122 := complex(-imag(t121), real(t121))
+	t123 := t120 + t122
+	t124 := t120 - t122
+	t125 := x37 + x57
+	t126 := x37 - x57
+	t127 := x17 + t125
+	t128 := complex(real(x17)-0.5*real(t125), imag(x17)-0.5*imag(t125))
+	t129 := complex(0.8660254037844388*real(t126), 0.8660254037844388*imag(t126))
+	t130 := complex(-imag(t129), real(t129))
+	t131 := t128 + t130
+	t132 := t128 - t130
+	t133 := t103 + t127
+	t134 := t103 - t127
+	t135 := t111 + t119
+	t136 := t111 - t119
+	t137 := t95 + t133 + t135
+	t138 := complex(real(t95)+0.30901699437494745*real(t133)-0.8090169943749475*real(t135), imag(t95)+0.30901699437494745*imag(t133)-0.8090169943749475*imag(t135))
+	t139 := complex(0.9510565162951535*real(t134)+0.5877852522924732*real(t136), 0.9510565162951535*imag(t134)+0.5877852522924732*imag(t136))
+	t140 := complex(-imag(t139), real(t139))
+	t141 := t138 + t140
+	t142 := t138 - t140
+	t143 := complex(real(t95)-0.8090169943749475*real(t133)+0.30901699437494723*real(t135), imag(t95)-0.8090169943749475*imag(t133)+0.30901699437494723*imag(t135))
+	t144 := complex(0.5877852522924732*real(t134)-0.9510565162951536*real(t136), 0.5877852522924732*imag(t134)-0.9510565162951536*imag(t136))
+	t145 := complex(-imag(t144), real(t144))
+	t146 := t143 + t145
+	t147 := t143 - t145
+	t148 := w4 * t107
+	t149 := w8 * t115
+	t150 := w12 * t123
+	t151 := w16 * t131
+	t152 := t148 + t151
+	t153 := t148 - t151
+	t154 := t149 + t150
+	t155 := t149 - t150
+	t156 := t99 + t152 + t154
+	t157 := complex(real(t99)+0.30901699437494745*real(t152)-0.8090169943749475*real(t154), imag(t99)+0.30901699437494745*imag(t152)-0.8090169943749475*imag(t154))
+	t158 := complex(0.9510565162951535*real(t153)+0.5877852522924732*real(t155), 0.9510565162951535*imag(t153)+0.5877852522924732*imag(t155))
+	t159 := complex(-imag(t158), real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := complex(real(t99)-0.8090169943749475*real(t152)+0.30901699437494723*real(t154), imag(t99)-0.8090169943749475*imag(t152)+0.30901699437494723*imag(t154))
+	t163 := complex(0.5877852522924732*real(t153)-0.9510565162951536*real(t155), 0.5877852522924732*imag(t153)-0.9510565162951536*imag(t155))
+	t164 := complex(-imag(t163), real(t163))
+	t165 := t162 + t164
+	t166 := t162 - t164
+	t167 := w8 * t108
+	t168 := w16 * t116
+	t169 := w24 * t124
+	t170 := w32 * t132
+	t171 := t167 + t170
+	t172 := t167 - t170
+	t173 := t168 + t169
+	t174 := t168 - t169
+	t175 := t100 + t171 + t173
+	t176 := complex(real(t100)+0.30901699437494745*real(t171)-0.8090169943749475*real(t173), imag(t100)+0.30901699437494745*imag(t171)-0.8090169943749475*imag(t173))
+	t177 := complex(0.9510565162951535*real(t172)+0.5877852522924732*real(t174), 0.9510565162951535*imag(t172)+0.5877852522924732*imag(t174))
+	t178 := complex(-imag(t177), real(t177))
+	t179 := t176 + t178
+	t180 := t176 - t178
+	t181 := complex(real(t100)-0.8090169943749475*real(t171)+0.30901699437494723*real(t173), imag(t100)-0.8090169943749475*imag(t171)+0.30901699437494723*imag(t173))
+	t182 := complex(0.5877852522924732*real(t172)-0.9510565162951536*real(t174), 0.5877852522924732*imag(t172)-0.9510565162951536*imag(t174))
+	t183 := complex(-imag(t182), real(t182))
+	t184 := t181 + t183
+	t185 := t181 - t183
+	t186 := x22 + x42
+	t187 := x22 - x42
+	t188 := x2 + t186
+	t189 := complex(real(x2)-0.5*real(t186), imag(x2)-0.5*imag(t186))
+	t190 := complex(0.8660254037844388*real(t187), 0.8660254037844388*imag(t187))
+	t191 := complex(-imag(t190), real(t190))
+	t192 := t189 + t191
+	t193 := t189 - t191
+	t194 := x26 + x46
+	t195 := x26 - x46
+	t196 := x6 + t194
+	t197 := complex(real(x6)-0.5*real(t194), imag(x6)-0.5*imag(t194))
+	t198 := complex(0.8660254037844388*real(t195), 0.8660254037844388*imag(t195))
+	t199 := complex(-imag(t198), real(t198))
+	t200 := t197 + t199
+	t201 := t197 - t199
+	t202 := x30 + x50
+	t203 := x30 - x50
+	t204 := x10 + t202
+	t205 := complex(real(x10)-0.5*real(t202), imag(x10)-0.5*imag(t202))
+	t206 := complex(0.8660254037844388*real(t203), 0.8660254037844388*imag(t203))
+	t207 := complex(-imag(t206), real(t206))
+	t208 := t205 + t207
+	t209 := t205 - t207
+	t210 := x34 + x54
+	t211 := x34 - x54
+	t212 := x14 + t210
+	t213 := complex(real(x14)-0.5*real(t210), imag(x14)-0.5*imag(t210))
+	t214 := complex(0.8660254037844388*real(t211), 0.8660254037844388*imag(t211))
+	t215 := complex(-imag(t214), real(t214))
+	t216 := t213 + t215
+	t217 := t213 - t215
+	t218 := x38 + x58
+	t219 := x38 - x58
+	t220 := x18 + t218
+	t221 := complex(real(x18)-0.5*real(t218), imag(x18)-0.5*imag(t218))
+	t222 := complex(0.8660254037844388*real(t219), 0.8660254037844388*imag(t219))
+	t223 := complex(-imag(t222), real(t222))
+	t224 := t221 + t223
+	t225 := t221 - t223
+	t226 := t196 + t220
+	t227 := t196 - t220
+	t228 := t204 + t212
+	t229 := t204 - t212
+	t230 := t188 + t226 + t228
+	t231 := complex(real(t188)+0.30901699437494745*real(t226)-0.8090169943749475*real(t228), imag(t188)+0.30901699437494745*imag(t226)-0.8090169943749475*imag(t228))
+	t232 := complex(0.9510565162951535*real(t227)+0.5877852522924732*real(t229), 0.9510565162951535*imag(t227)+0.5877852522924732*imag(t229))
+	t233 := complex(-imag(t232), real(t232))
+	t234 := t231 + t233
+	t235 := t231 - t233
+	t236 := complex(real(t188)-0.8090169943749475*real(t226)+0.30901699437494723*real(t228), imag(t188)-0.8090169943749475*imag(t226)+0.30901699437494723*imag(t228))
+	t237 := complex(0.5877852522924732*real(t227)-0.9510565162951536*real(t229), 0.5877852522924732*imag(t227)-0.9510565162951536*imag(t229))
+	t238 := complex(-imag(t237), real(t237))
+	t239 := t236 + t238
+	t240 := t236 - t238
+	t241 := w4 * t200
+	t242 := w8 * t208
+	t243 := w12 * t216
+	t244 := w16 * t224
+	t245 := t241 + t244
+	t246 := t241 - t244
+	t247 := t242 + t243
+	t248 := t242 - t243
+	t249 := t192 + t245 + t247
+	t250 := complex(real(t192)+0.30901699437494745*real(t245)-0.8090169943749475*real(t247), imag(t192)+0.30901699437494745*imag(t245)-0.8090169943749475*imag(t247))
+	t251 := complex(0.9510565162951535*real(t246)+0.5877852522924732*real(t248), 0.9510565162951535*imag(t246)+0.5877852522924732*imag(t248))
+	t252 := complex(-imag(t251), real(t251))
+	t253 := t250 + t252
+	t254 := t250 - t252
+	t255 := complex(real(t192)-0.8090169943749475*real(t245)+0.30901699437494723*real(t247), imag(t192)-0.8090169943749475*imag(t245)+0.30901699437494723*imag(t247))
+	t256 := complex(0.5877852522924732*real(t246)-0.9510565162951536*real(t248), 0.5877852522924732*imag(t246)-0.9510565162951536*imag(t248))
+	t257 := complex(-imag(t256), real(t256))
+	t258 := t255 + t257
+	t259 := t255 - t257
+	t260 := w8 * t201
+	t261 := w16 * t209
+	t262 := w24 * t217
+	t263 := w32 * t225
+	t264 := t260 + t263
+	t265 := t260 - t263
+	t266 := t261 + t262
+	t267 := t261 - t262
+	t268 := t193 + t264 + t266
+	t269 := complex(real(t193)+0.30901699437494745*real(t264)-0.8090169943749475*real(t266), imag(t193)+0.30901699437494745*imag(t264)-0.8090169943749475*imag(t266))
+	t270 := complex(0.9510565162951535*real(t265)+0.5877852522924732*real(t267), 0.9510565162951535*imag(t265)+0.5877852522924732*imag(t267))
+	t271 := complex(-imag(t270), real(t270))
+	t272 := t269 + t271
+	t273 := t269 - t271
+	t274 := complex(real(t193)-0.8090169943749475*real(t264)+0.30901699437494723*real(t266), imag(t193)-0.8090169943749475*imag(t264)+0.30901699437494723*imag(t266))
+	t275 := complex(0.5877852522924732*real(t265)-0.9510565162951536*real(t267), 0.5877852522924732*imag(t265)-0.9510565162951536*imag(t267))
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t274 + t276
+	t278 := t274 - t276
+	t279 := x23 + x43
+	t280 := x23 - x43
+	t281 := x3 + t279
+	t282 := complex(real(x3)-0.5*real(t279), imag(x3)-0.5*imag(t279))
+	t283 := complex(0.8660254037844388*real(t280), 0.8660254037844388*imag(t280))
+	t284 := complex(-imag(t283), real(t283))
+	t285 := t282 + t284
+	t286 := t282 - t284
+	t287 := x27 + x47
+	t288 := x27 - x47
+	t289 := x7 + t287
+	t290 := complex(real(x7)-0.5*real(t287), imag(x7)-0.5*imag(t287))
+	t291 := complex(0.8660254037844388*real(t288), 0.8660254037844388*imag(t288))
+	t292 := complex(-imag(t291), real(t291))
+	t293 := t290 + t292
+	t294 := t290 - t292
+	t295 := x31 + x51
+	t296 := x31 - x51
+	t297 := x11 + t295
+	t298 := complex(real(x11)-0.5*real(t295), imag(x11)-0.5*imag(t295))
+	t299 := complex(0.8660254037844388*real(t296), 0.8660254037844388*imag(t296))
+	t300 := complex(-imag(t299), real(t299))
+	t301 := t298 + t300
+	t302 := t298 - t300
+	t303 := x35 + x55
+	t304 := x35 - x55
+	t305 := x15 + t303
+	t306 := complex(real(x15)-0.5*real(t303), imag(x15)-0.5*imag(t303))
+	t307 := complex(0.8660254037844388*real(t304), 0.8660254037844388*imag(t304))
+	t308 := complex(-imag(t307), real(t307))
+	t309 := t306 + t308
+	t310 := t306 - t308
+	t311 := x39 + x59
+	t312 := x39 - x59
+	t313 := x19 + t311
+	t314 := complex(real(x19)-0.5*real(t311), imag(x19)-0.5*imag(t311))
+	t315 := complex(0.8660254037844388*real(t312), 0.8660254037844388*imag(t312))
+	t316 := complex(-imag(t315), real(t315))
+	t317 := t314 + t316
+	t318 := t314 - t316
+	t319 := t289 + t313
+	t320 := t289 - t313
+	t321 := t297 + t305
+	t322 := t297 - t305
+	t323 := t281 + t319 + t321
+	t324 := complex(real(t281)+0.30901699437494745*real(t319)-0.8090169943749475*real(t321), imag(t281)+0.30901699437494745*imag(t319)-0.8090169943749475*imag(t321))
+	t325 := complex(0.9510565162951535*real(t320)+0.5877852522924732*real(t322), 0.9510565162951535*imag(t320)+0.5877852522924732*imag(t322))
+	t326 := complex(-imag(t325), real(t325))
+	t327 := t324 + t326
+	t328 := t324 - t326
+	t329 := complex(real(t281)-0.8090169943749475*real(t319)+0.30901699437494723*real(t321), imag(t281)-0.8090169943749475*imag(t319)+0.30901699437494723*imag(t321))
+	t330 := complex(0.5877852522924732*real(t320)-0.9510565162951536*real(t322), 0.5877852522924732*imag(t320)-0.9510565162951536*imag(t322))
+	t331 := complex(-imag(t330), real(t330))
+	t332 := t329 + t331
+	t333 := t329 - t331
+	t334 := w4 * t293
+	t335 := w8 * t301
+	t336 := w12 * t309
+	t337 := w16 * t317
+	t338 := t334 + t337
+	t339 := t334 - t337
+	t340 := t335 + t336
+	t341 := t335 - t336
+	t342 := t285 + t338 + t340
+	t343 := complex(real(t285)+0.30901699437494745*real(t338)-0.8090169943749475*real(t340), imag(t285)+0.30901699437494745*imag(t338)-0.8090169943749475*imag(t340))
+	t344 := complex(0.9510565162951535*real(t339)+0.5877852522924732*real(t341), 0.9510565162951535*imag(t339)+0.5877852522924732*imag(t341))
+	t345 := complex(-imag(t344), real(t344))
+	t346 := t343 + t345
+	t347 := t343 - t345
+	t348 := complex(real(t285)-0.8090169943749475*real(t338)+0.30901699437494723*real(t340), imag(t285)-0.8090169943749475*imag(t338)+0.30901699437494723*imag(t340))
+	t349 := complex(0.5877852522924732*real(t339)-0.9510565162951536*real(t341), 0.5877852522924732*imag(t339)-0.9510565162951536*imag(t341))
+	t350 := complex(-imag(t349), real(t349))
+	t351 := t348 + t350
+	t352 := t348 - t350
+	t353 := w8 * t294
+	t354 := w16 * t302
+	t355 := w24 * t310
+	t356 := w32 * t318
+	t357 := t353 + t356
+	t358 := t353 - t356
+	t359 := t354 + t355
+	t360 := t354 - t355
+	t361 := t286 + t357 + t359
+	t362 := complex(real(t286)+0.30901699437494745*real(t357)-0.8090169943749475*real(t359), imag(t286)+0.30901699437494745*imag(t357)-0.8090169943749475*imag(t359))
+	t363 := complex(0.9510565162951535*real(t358)+0.5877852522924732*real(t360), 0.9510565162951535*imag(t358)+0.5877852522924732*imag(t360))
+	t364 := complex(-imag(t363), real(t363))
+	t365 := t362 + t364
+	t366 := t362 - t364
+	t367 := complex(real(t286)-0.8090169943749475*real(t357)+0.30901699437494723*real(t359), imag(t286)-0.8090169943749475*imag(t357)+0.30901699437494723*imag(t359))
+	t368 := complex(0.5877852522924732*real(t358)-0.9510565162951536*real(t360), 0.5877852522924732*imag(t358)-0.9510565162951536*imag(t360))
+	t369 := complex(-imag(t368), real(t368))
+	t370 := t367 + t369
+	t371 := t367 - t369
+	t372 := t44 + t230
+	t373 := t44 - t230
+	t374 := t137 + t323
+	t375 := t137 - t323
+	t376 := complex(-imag(t375), real(t375))
+	t377 := t372 + t374
+	t378 := t373 + t376
+	t379 := t372 - t374
+	t380 := t373 - t376
+	t381 := w1 * t156
+	t382 := w2 * t249
+	t383 := w3 * t342
+	t384 := t63 + t382
+	t385 := t63 - t382
+	t386 := t381 + t383
+	t387 := t381 - t383
+	t388 := complex(-imag(t387), real(t387))
+	t389 := t384 + t386
+	t390 := t385 + t388
+	t391 := t384 - t386
+	t392 := t385 - t388
+	t393 := w2 * t175
+	t394 := w4 * t268
+	t395 := w6 * t361
+	t396 := t82 + t394
+	t397 := t82 - t394
+	t398 := t393 + t395
+	t399 := t393 - t395
+	t400 := complex(-imag(t399), real(t399))
+	t401 := t396 + t398
+	t402 := t397 + t400
+	t403 := t396 - t398
+	t404 := t397 - t400
+	t405 := w3 * t141
+	t406 := w6 * t234
+	t407 := w9 * t327
+	t408 := t48 + t406
+	t409 := t48 - t406
+	t410 := t405 + t407
+	t411 := t405 - t407
+	t412 := complex(-imag(t411), real(t411))
+	t413 := t408 + t410
+	t414 := t409 + t412
+	t415 := t408 - t410
+	t416 := t409 - t412
+	t417 := w4 * t160
+	t418 := w8 * t253
+	t419 := w12 * t346
+	t420 := t67 + t418
+	t421 := t67 - t418
+	t422 := t417 + t419
+	t423 := t417 - t419
+	t424 := complex(-imag(t423), real(t423))
+	t425 := t420 + t422
+	t426 := t421 + t424
+	t427 := t420 - t422
+	t428 := t421 - t424
+	t429 := w5 * t179
+	t430 := w10 * t272
+	t431 := complex(-imag(t365), real(t365))
+	t432 := t86 + t430
+	t433 := t86 - t430
+	t434 := t429 + t431
+	t435 := t429 - t431
+	t436 := complex(-imag(t435), real(t435))
+	t437 := t432 + t434
+	t438 := t433 + t436
+	t439 := t432 - t434
+	t440 := t433 - t436
+	t441 := w6 * t146
+	t442 := w12 * t239
+	t443 := w18 * t332
+	t444 := t53 + t442
+	t445 := t53 - t442
+	t446 := t441 + t443
+	t447 := t441 - t443
+	t448 := complex(-imag(t447), real(t447))
+	t449 := t444 + t446
+	t450 := t445 + t448
+	t451 := t444 - t446
+	t452 := t445 - t448
+	t453 := w7 * t165
+	t454 := w14 * t258
+	t455 := w21 * t351
+	t456 := t72 + t454
+	t457 := t72 - t454
+	t458 := t453 + t455
+	t459 := t453 - t455
+	t460 := complex(-imag(t459), real(t459))
+	t461 := t456 + t458
+	t462 := t457 + t460
+	t463 := t456 - t458
+	t464 := t457 - t460
+	t465 := w8 * t184
+	t466 := w16 * t277
+	t467 := w24 * t370
+	t468 := t91 + t466
+	t469 := t91 - t466
+	t470 := t465 + t467
+	t471 := t465 - t467
+	t472 := complex(-imag(t471), real(t471))
+	t473 := t468 + t470
+	t474 := t469 + t472
+	t475 := t468 - t470
+	t476 := t469 - t472
+	t477 := w9 * t147
+	t478 := w18 * t240
+	t479 := w27 * t333
+	t480 := t54 + t478
+	t481 := t54 - t478
+	t482 := t477 + t479
+	t483 := t477 - t479
+	t484 := complex(-imag(t483), real(t483))
+	t485 := t480 + t482
+	t486 := t481 + t484
+	t487 := t480 - t482
+	t488 := t481 - t484
+	t489 := w10 * t166
+	t490 := w20 * t259
+	t491 := -t352
+	t492 := t73 + t490
+	t493 := t73 - t490
+	t494 := t489 + t491
+	t495 := t489 - t491
+	t496 := complex(-imag(t495), real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := w11 * t185
+	t502 := w22 * t278
+	t503 := w33 * t371
+	t504 := t92 + t502
+	t505 := t92 - t502
+	t506 := t501 + t503
+	t507 := t501 - t503
+	t508 := complex(-imag(t507), real(t507))
+	t509 := t504 + t506
+	t510 := t505 + t508
+	t511 := t504 - t506
+	t512 := t505 - t508
+	t513 := w12 * t142
+	t514 := w24 * t235
+	t515 := w36 * t328
+	t516 := t49 + t514
+	t517 := t49 - t514
+	t518 := t513 + t515
+	t519 := t513 - t515
+	t520 := complex(-imag(t519), real(t519))
+	t521 := t516 + t518
+	t522 := t517 + t520
+	t523 := t516 - t518
+	t524 := t517 - t520
+	t525 := w13 * t161
+	t526 := w26 * t254
+	t527 := w39 * t347
+	t528 := t68 + t526
+	t529 := t68 - t526
+	t530 := t525 + t527
+	t531 := t525 - t527
+	t532 := complex(-imag(t531), real(t531))
+	t533 := t528 + t530
+	t534 := t529 + t532
+	t535 := t528 - t530
+	t536 := t529 - t532
+	t537 := w14 * t180
+	t538 := w28 * t273
+	t539 := w42 * t366
+	t540 := t87 + t538
+	t541 := t87 - t538
+	t542 := t537 + t539
+	t543 := t537 - t539
+	t544 := complex(-imag(t543), real(t543))
+	t545 := t540 + t542
+	t546 := t541 + t544
+	t547 := t540 - t542
+	t548 := t541 - t544
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t377)*scale, imag(t377)*scale)
+	y[1] = complex(real(t389)*scale, imag(t389)*scale)
+	y[2] = complex(real(t401)*scale, imag(t401)*scale)
+	y[3] = complex(real(t413)*scale, imag(t413)*scale)
+	y[4] = complex(real(t425)*scale, imag(t425)*scale)
+	y[5] = complex(real(t437)*scale, imag(t437)*scale)
+	y[6] = complex(real(t449)*scale, imag(t449)*scale)
+	y[7] = complex(real(t461)*scale, imag(t461)*scale)
+	y[8] = complex(real(t473)*scale, imag(t473)*scale)
+	y[9] = complex(real(t485)*scale, imag(t485)*scale)
+	y[10] = complex(real(t497)*scale, imag(t497)*scale)
+	y[11] = complex(real(t509)*scale, imag(t509)*scale)
+	y[12] = complex(real(t521)*scale, imag(t521)*scale)
+	y[13] = complex(real(t533)*scale, imag(t533)*scale)
+	y[14] = complex(real(t545)*scale, imag(t545)*scale)
+	y[15] = complex(real(t378)*scale, imag(t378)*scale)
+	y[16] = complex(real(t390)*scale, imag(t390)*scale)
+	y[17] = complex(real(t402)*scale, imag(t402)*scale)
+	y[18] = complex(real(t414)*scale, imag(t414)*scale)
+	y[19] = complex(real(t426)*scale, imag(t426)*scale)
+	y[20] = complex(real(t438)*scale, imag(t438)*scale)
+	y[21] = complex(real(t450)*scale, imag(t450)*scale)
+	y[22] = complex(real(t462)*scale, imag(t462)*scale)
+	y[23] = complex(real(t474)*scale, imag(t474)*scale)
+	y[24] = complex(real(t486)*scale, imag(t486)*scale)
+	y[25] = complex(real(t498)*scale, imag(t498)*scale)
+	y[26] = complex(real(t510)*scale, imag(t510)*scale)
+	y[27] = complex(real(t522)*scale, imag(t522)*scale)
+	y[28] = complex(real(t534)*scale, imag(t534)*scale)
+	y[29] = complex(real(t546)*scale, imag(t546)*scale)
+	y[30] = complex(real(t379)*scale, imag(t379)*scale)
+	y[31] = complex(real(t391)*scale, imag(t391)*scale)
+	y[32] = complex(real(t403)*scale, imag(t403)*scale)
+	y[33] = complex(real(t415)*scale, imag(t415)*scale)
+	y[34] = complex(real(t427)*scale, imag(t427)*scale)
+	y[35] = complex(real(t439)*scale, imag(t439)*scale)
+	y[36] = complex(real(t451)*scale, imag(t451)*scale)
+	y[37] = complex(real(t463)*scale, imag(t463)*scale)
+	y[38] = complex(real(t475)*scale, imag(t475)*scale)
+	y[39] = complex(real(t487)*scale, imag(t487)*scale)
+	y[40] = complex(real(t499)*scale, imag(t499)*scale)
+	y[41] = complex(real(t511)*scale, imag(t511)*scale)
+	y[42] = complex(real(t523)*scale, imag(t523)*scale)
+	y[43] = complex(real(t535)*scale, imag(t535)*scale)
+	y[44] = complex(real(t547)*scale, imag(t547)*scale)
+	y[45] = complex(real(t380)*scale, imag(t380)*scale)
+	y[46] = complex(real(t392)*scale, imag(t392)*scale)
+	y[47] = complex(real(t404)*scale, imag(t404)*scale)
+	y[48] = complex(real(t416)*scale, imag(t416)*scale)
+	y[49] = complex(real(t428)*scale, imag(t428)*scale)
+	y[50] = complex(real(t440)*scale, imag(t440)*scale)
+	y[51] = complex(real(t452)*scale, imag(t452)*scale)
+	y[52] = complex(real(t464)*scale, imag(t464)*scale)
+	y[53] = complex(real(t476)*scale, imag(t476)*scale)
+	y[54] = complex(real(t488)*scale, imag(t488)*scale)
+	y[55] = complex(real(t500)*scale, imag(t500)*scale)
+	y[56] = complex(real(t512)*scale, imag(t512)*scale)
+	y[57] = complex(real(t524)*scale, imag(t524)*scale)
+	y[58] = complex(real(t536)*scale, imag(t536)*scale)
+	y[59] = complex(real(t548)*scale, imag(t548)*scale)
+
+	return true
+}
@@ -0,0 +1,69 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+import (
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+// TestDIT60Radix4x5x3Complex64 checks the generated size-60 kernels against the reference
+// DFT and round-trips in place.
+func TestDIT60Radix4x5x3Complex64(t *testing.T) {
+	t.Parallel()
+
+	const n = 60
+
+	src := randomComplex64(n, 0x9e3779f5)
+	twiddle := ComputeTwiddleFactors[complex64](n)
+	scratch := make([]complex64, n)
+
+	fwd := make([]complex64, n)
+	if !forwardDIT60Radix4x5x3Complex64(fwd, src, twiddle, scratch) {
+		t.Fatal("forwardDIT60Radix4x5x3Complex64 failed")
+	}
+
+	assertComplex64Close(t, fwd, reference.NaiveDFT(src), 1e-4)
+
+	back := append([]complex64(nil), fwd...)
+	if !inverseDIT60Radix4x5x3Complex64(back, back, twiddle, scratch) {
+		t.Fatal("inverseDIT60Radix4x5x3Complex64 failed")
+	}
+
+	assertComplex64Close(t, back, src, 1e-5)
+
+	if forwardDIT60Radix4x5x3Complex64(fwd, src[:n-1], twiddle, scratch) {
+		t.Error("forwardDIT60Radix4x5x3Complex64 accepted a short src")
+	}
+}
+
+// TestDIT60Radix4x5x3Complex128 checks the generated size-60 kernels against the reference
+// DFT and round-trips in place.
+func TestDIT60Radix4x5x3Complex128(t *testing.T) {
+	t.Parallel()
+
+	const n = 60
+
+	src := randomComplex128(n, 0x7f4a7c51)
+	twiddle := ComputeTwiddleFactors[complex128](n)
+	scratch := make([]complex128, n)
+
+	fwd := make([]complex128, n)
+	if !forwardDIT60Radix4x5x3Complex128(fwd, src, twiddle, scratch) {
+		t.Fatal("forwardDIT60Radix4x5x3Complex128 failed")
+	}
+
+	assertComplex128Close(t, fwd, reference.NaiveDFT128(src), 1e-10)
+
+	back := append([]complex128(nil), fwd...)
+	if !inverseDIT60Radix4x5x3Complex128(back, back, twiddle, scratch) {
+		t.Fatal("inverseDIT60Radix4x5x3Complex128 failed")
+	}
+
+	assertComplex128Close(t, back, src, 1e-12)
+
+	if forwardDIT60Radix4x5x3Complex128(fwd, src[:n-1], twiddle, scratch) {
+		t.Error("forwardDIT60Radix4x5x3Complex128 accepted a short src")
+	}
+}
@@ -0,0 +1,3715 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT80Radix4x4x5Complex64 computes a 80-point forward FFT of complex64 data
+// with radix-4, radix-4, radix-5 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT80Radix4x4x5Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 80
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := tw[4]
+	w8 := tw[8]
+	w12 := tw[12]
+	w16 := tw[16]
+	w24 := tw[24]
+	w36 := tw[36]
+	w32 := tw[32]
+	w48 := tw[48]
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w6 := tw[6]
+	w9 := tw[9]
+	w5 := tw[5]
+	w10 := tw[10]
+	w15 := tw[15]
+	w18 := tw[18]
+	w7 := tw[7]
+	w14 := tw[14]
+	w21 := tw[21]
+	w27 := tw[27]
+	w30 := tw[30]
+	w11 := tw[11]
+	w22 := tw[22]
+	w33 := tw[33]
+	w13 := tw[13]
+	w26 := tw[26]
+	w39 := tw[39]
+	w28 := tw[28]
+	w42 := tw[42]
+	w45 := tw[45]
+	w17 := tw[17]
+	w34 := tw[34]
+	w51 := tw[51]
+	w54 := tw[54]
+	w19 := tw[19]
+	w38 := tw[38]
+	w57 := tw[57]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+	x48 := x[48]
+	x49 := x[49]
+	x50 := x[50]
+	x51 := x[51]
+	x52 := x[52]
+	x53 := x[53]
+	x54 := x[54]
+	x55 := x[55]
+	x56 := x[56]
+	x57 := x[57]
+	x58 := x[58]
+	x59 := x[59]
+	x60 := x[60]
+	x61 := x[61]
+	x62 := x[62]
+	x63 := x[63]
+	x64 := x[64]
+	x65 := x[65]
+	x66 := x[66]
+	x67 := x[67]
+	x68 := x[68]
+	x69 := x[69]
+	x70 := x[70]
+	x71 := x[71]
+	x72 := x[72]
+	x73 := x[73]
+	x74 := x[74]
+	x75 := x[75]
+	x76 := x[76]
+	x77 := x[77]
+	x78 := x[78]
+	x79 := x[79]
+
+	t0 := x16 + x64
+	t1 := x16 - x64
+	t2 := x32 + x48
+	t3 := x32 - x48
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.8090169943749475*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.8090169943749475*imag(t2))
+	t6 := complex(0.9510565162951535*real(t1)+0.5877852522924732*real(t3), 0.9510565162951535*imag(t1)+0.5877852522924732*imag(t3))
+	t7 := complex(imag(t6), -real(t6))
+	t8 := t5 + t7
+	t9 := t5 - t7
+	t10 := complex(real(x0)-0.8090169943749475*real(t0)+0.30901699437494723*real(t2), imag(x0)-0.8090169943749475*imag(t0)+0.30901699437494723*imag(t2))
+	t11 := complex(0.5877852522924732*real(t1)-0.9510565162951536*real(t3), 0.5877852522924732*imag(t1)-0.9510565162951536*imag(t3))
+	t12 := complex(imag(t11), -real(t11))
+	t13 := t10 + t12
+	t14 := t10 - t12
+	t15 := x20 + x68
+	t16 := x20 - x68
+	t17 := x36 + x52
+	t18 := x36 - x52
+	t19 := x4 + t15 + t17
+	t20 := complex(real(x4)+0.30901699437494745*real(t15)-0.8090169943749475*real(t17), imag(x4)+0.30901699437494745*imag(t15)-0.8090169943749475*imag(t17))
+	t21 := complex(0.9510565162951535*real(t16)+0.5877852522924732*real(t18), 0.9510565162951535*imag(t16)+0.5877852522924732*imag(t18))
+	t22 := complex(imag(t21), -real(t21))
+	t23 := t20 + t22
+	t24 := t20 - t22
+	t25 := complex(real(x4)-0.8090169943749475*real(t15)+0.30901699437494723*real(t17), imag(x4)-0.8090169943749475*imag(t15)+0.30901699437494723*imag(t17))
+	t26 := complex(0.5877852522924732*real(t16)-0.9510565162951536*real(t18), 0.5877852522924732*imag(t16)-0.9510565162951536*imag(t18))
+	t27 := complex(imag(t26), -real(t26))
+	t28 := t25 + t27
+	t29 := t25 - t27
+	t30 := x24 + x72
+	t31 := x24 - x72
+	t32 := x40 + x56
+	t33 := x40 - x56
+	t34 := x8 + t30 + t32
+	t35 := complex(real(x8)+0.30901699437494745*real(t30)-0.8090169943749475*real(t32), imag(x8)+0.30901699437494745*imag(t30)-0.8090169943749475*imag(t32))
+	t36 := complex(0.9510565162951535*real(t31)+0.5877852522924732*real(t33), 0.9510565162951535*imag(t31)+0.5877852522924732*imag(t33))
+	t37 := complex(imag(t36), -real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := complex(real(x8)-0.8090169943749475*real(t30)+0.30901699437494723*real(t32), imag(x8)-0.8090169943749475*imag(t30)+0.30901699437494723*imag(t32))
+	t41 := complex(0.5877852522924732*real(t31)-0.9510565162951536*real(t33), 0.5877852522924732*imag(t31)-0.9510565162951536*imag(t33))
+	t42 := complex(imag(t41), -real(t41))
+	t43 := t40 + t42
+	t44 := t40 - t42
+	t45 := x28 + x76
+	t46 := x28 - x76
+	t47 := x44 + x60
+	t48 := x44 - x60
+	t49 := x12 + t45 + t47
+	t50 := complex(real(x12)+0.30901699437494745*real(t45)-0.8090169943749475*real(t47), imag(x12)+0.30901699437494745*imag(t45)-0.8090169943749475*imag(t47))
+	t51 := complex(0.9510565162951535*real(t46)+0.5877852522924732*real(t48), 0.9510565162951535*imag(t46)+0.5877852522924732*imag(t48))
+	t52 := complex(imag(t51), -real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := complex(real(x12)-0.8090169943749475*real(t45)+0.30901699437494723*real(t47), imag(x12)-0.8090169943749475*imag(t45)+0.30901699437494723*imag(t47))
+	t56 := complex(0.5877852522924732*real(t46)-0.9510565162951536*real(t48), 0.5877852522924732*imag(t46)-0.9510565162951536*imag(t48))
+	t57 := complex(imag(t56), -real(t56))
+	t58 := t55 + t57
+	t59 := t55 - t57
+	t60 := t4 + t34
+	t61 := t4 - t34
+	t62 := t19 + t49
+	t63 := t19 - t49
+	t64 := complex(imag(t63), -real(t63))
+	t65 := t60 + t62
+	t66 := t61 + t64
+	t67 := t60 - t62
+	t68 := t61 - t64
+	t69 := w4 * t23
+	t70 := w8 * t38
+	t71 := w12 * t53
+	t72 := t8 + t70
+	t73 := t8 - t70
+	t74 := t69 + t71
+	t75 := t69 - t71
+	t76 := complex(imag(t75), -real(t75))
+	t77 := t72 + t74
+	t78 := t73 + t76
+	t79 := t72 - t74
+	t80 := t73 - t76
+	t81 := w8 * t28
+	t82 := w16 * t43
+	t83 := w24 * t58
+	t84 := t13 + t82
+	t85 := t13 - t82
+	t86 := t81 + t83
+	t87 := t81 - t83
+	t88 := complex(imag(t87), -real(t87))
+	t89 := t84 + t86
+	t90 := t85 + t88
+	t91 := t84 - t86
+	t92 := t85 - t88
+	t93 := w12 * t29
+	t94 := w24 * t44
+	t95 := w36 * t59
+	t96 := t14 + t94
+	t97 := t14 - t94
+	t98 := t93 + t95
+	t99 := t93 - t95
+	t100 := complex(imag(t99), -real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w16 * t24
+	t106 := w32 * t39
+	t107 := w48 * t54
+	t108 := t9 + t106
+	t109 := t9 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(imag(t111), -real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+	t117 := x17 + x65
+	t118 := x17 - x65
+	t119 := x33 + x49
+	t120 := x33 - x49
+	t121 := x1 + t117 + t119
+	t122 := complex(real(x1)+0.30901699437494745*real(t117)-0.8090169943749475*real(t119), imag(x1)+0.30901699437494745*imag(t117)-0.8090169943749475*imag(t119))
+	t123 := complex(0.9510565162951535*real(t118)+0.5877852522924732*real(t120), 0.9510565162951535*imag(t118)+0.5877852522924732*imag(t120))
+	t124 := complex(imag(t123), -real(t123))
+	t125 := t122 + t124
+	t126 := t122 - t124
+	t127 := complex(real(x1)-0.8090169943749475*real(t117)+0.30901699437494723*real(t119), imag(x1)-0.8090169943749475*imag(t117)+0.30901699437494723*imag(t119))
+	t128 := complex(0.5877852522924732*real(t118)-0.9510565162951536*real(t120), 0.5877852522924732*imag(t118)-0.9510565162951536*imag(t120))
+	t129 := complex(imag(t128), -real(t128))
+	t130 := t127 + t129
+	t131 := t127 - t129
+	t132 := x21 + x69
+	t133 := x21 - x69
+	t134 := x37 + x53
+	t135 := x37 - x53
+	t136 := x5 + t132 + t134
+	t137 := complex(real(x5)+0.30901699437494745*real(t132)-0.8090169943749475*real(t134), imag(x5)+0.30901699437494745*imag(t132)-0.8090169943749475*imag(t134))
+	t138 := complex(0.9510565162951535*real(t133)+0.5877852522924732*real(t135), 0.9510565162951535*imag(t133)+0.5877852522924732*imag(t135))
+	t139 := complex(imag(t138), -real(t138))
+	t140 := t137 + t139
+	t141 := t137 - t139
+	t142 := complex(real(x5)-0.8090169943749475*real(t132)+0.30901699437494723*real(t134), imag(x5)-0.8090169943749475*imag(t132)+0.30901699437494723*imag(t134))
+	t143 := complex(0.5877852522924732*real(t133)-0.9510565162951536*real(t135), 0.5877852522924732*imag(t133)-0.9510565162951536*imag(t135))
+	t144 := complex(imag(t143), -real(t143))
+	t145 := t142 + t144
+	t146 := t142 - t144
+	t147 := x25 + x73
+	t148 := x25 - x73
+	t149 := x41 + x57
+	t150 := x41 - x57
+	t151 := x9 + t147 + t149
+	t152 := complex(real(x9)+0.30901699437494745*real(t147)-0.8090169943749475*real(t149), imag(x9)+0.30901699437494745*imag(t147)-0.8090169943749475*imag(t149))
+	t153 := complex(0.9510565162951535*real(t148)+0.5877852522924732*real(t150), 0.9510565162951535*imag(t148)+0.5877852522924732*imag(t150))
+	t154 := complex(imag(t153), -real(t153))
+	t155 := t152 + t154
+	t156 := t152 - t154
+	t157 := complex(real(x9)-0.8090169943749475*real(t147)+0.30901699437494723*real(t149), imag(x9)-0.8090169943749475*imag(t147)+0.30901699437494723*imag(t149))
+	t158 := complex(0.5877852522924732*real(t148)-0.9510565162951536*real(t150), 0.5877852522924732*imag(t148)-0.9510565162951536*imag(t150))
+	t159 := complex(imag(t158), -real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := x29 + x77
+	t163 := x29 - x77
+	t164 := x45 + x61
+	t165 := x45 - x61
+	t166 := x13 + t162 + t164
+	t167 := complex(real(x13)+0.30901699437494745*real(t162)-0.8090169943749475*real(t164), imag(x13)+0.30901699437494745*imag(t162)-0.8090169943749475*imag(t164))
+	t168 := complex(0.9510565162951535*real(t163)+0.5877852522924732*real(t165), 0.9510565162951535*imag(t163)+0.5877852522924732*imag(t165))
+	t169 := complex(imag(t168), -real(t168))
+	t170 := t167 + t169
+	t171 := t167 - t169
+	t172 := complex(real(x13)-0.8090169943749475*real(t162)+0.30901699437494723*real(t164), imag(x13)-0.8090169943749475*imag(t162)+0.30901699437494723*imag(t164))
+	t173 := complex(0.5877852522924732*real(t163)-0.9510565162951536*real(t165), 0.5877852522924732*imag(t163)-0.9510565162951536*imag(t165))
+	t174 := complex(imag(t173), -real(t173))
+	t175 := t172 + t174
+	t176 := t172 - t174
+	t177 := t121 + t151
+	t178 := t121 - t151
+	t179 := t136 + t166
+	t180 := t136 - t166
+	t181 := complex(imag(t180), -real(t180))
+	t182 := t177 + t179
+	t183 := t178 + t181
+	t184 := t177 - t179
+	t185 := t178 - t181
+	t186 := w4 * t140
+	t187 := w8 * t155
+	t188 := w12 * t170
+	t189 := t125 + t187
+	t190 := t125 - t187
+	t191 := t186 + t188
+	t192 := t186 - t188
+	t193 := complex(imag(t192), -real(t192))
+	t194 := t189 + t191
+	t195 := t190 + t193
+	t196 := t189 - t191
+	t197 := t190 - t193
+	t198 := w8 * t145
+	t199 := w16 * t160
+	t200 := w24 * t175
+	t201 := t130 + t199
+	t202 := t130 - t199
+	t203 := t198 + t200
+	t204 := t198 - t200
+	t205 := complex(imag(t204), -real(t204))
+	t206 := t201 + t203
+	t207 := t202 + t205
+	t208 := t201 - t203
+	t209 := t202 - t205
+	t210 := w12 * t146
+	t211 := w24 * t161
+	t212 := w36 * t176
+	t213 := t131 + t211
+	t214 := t131 - t211
+	t215 := t210 + t212
+	t216 := t210 - t212
+	t217 := complex(imag(t216), -real(t216))
+	t218 := t213 + t215
+	t219 := t214 + t217
+	t220 := t213 - t215
+	t221 := t214 - t217
+	t222 := w16 * t141
+	t223 := w32 * t156
+	t224 := w48 * t171
+	t225 := t126 + t223
+	t226 := t126 - t223
+	t227 := t222 + t224
+	t228 := t222 - t224
+	t229 := complex(imag(t228), -real(t228))
+	t230 := t225 + t227
+	t231 := t226 + t229
+	t232 := t225 - t227
+	t233 := t226 - t229
+	t234 := x18 + x66
+	t235 := x18 - x66
+	t236 := x34 + x50
+	t237 := x34 - x50
+	t238 := x2 + t234 + t236
+	t239 := complex(real(x2)+0.30901699437494745*real(t234)-0.8090169943749475*real(t236), imag(x2)+0.30901699437494745*imag(t234)-0.8090169943749475*imag(t236))
+	t240 := complex(0.9510565162951535*real(t235)+0.5877852522924732*real(t237), 0.9510565162951535*imag(t235)+0.5877852522924732*imag(t237))
+	t241 := complex(imag(t240), -real(t240))
+	t242 := t239 + t241
+	t243 := t239 - t241
+	t244 := complex(real(x2)-0.8090169943749475*real(t234)+0.30901699437494723*real(t236), imag(x2)-0.8090169943749475*imag(t234)+0.30901699437494723*imag(t236))
+	t245 := complex(0.5877852522924732*real(t235)-0.9510565162951536*real(t237), 0.5877852522924732*imag(t235)-0.9510565162951536*imag(t237))
+	t246 := complex(imag(t245), -real(t245))
+	t247 := t244 + t246
+	t248 := t244 - t246
+	t249 := x22 + x70
+	t250 := x22 - x70
+	t251 := x38 + x54
+	t252 := x38 - x54
+	t253 := x6 + t249 + t251
+	t254 := complex(real(x6)+0.30901699437494745*real(t249)-0.8090169943749475*real(t251), imag(x6)+0.30901699437494745*imag(t249)-0.8090169943749475*imag(t251))
+	t255 := complex(0.9510565162951535*real(t250)+0.5877852522924732*real(t252), 0.9510565162951535*imag(t250)+0.5877852522924732*imag(t252))
+	t256 := complex(imag(t255), -real(t255))
+	t257 := t254 + t256
+	t258 := t254 - t256
+	t259 := complex(real(x6)-0.8090169943749475*real(t249)+0.30901699437494723*real(t251), imag(x6)-0.8090169943749475*imag(t249)+0.30901699437494723*imag(t251))
+	t260 := complex(0.5877852522924732*real(t250)-0.9510565162951536*real(t252), 0.5877852522924732*imag(t250)-0.9510565162951536*imag(t252))
+	t261 := complex(imag(t260), -real(t260))
+	t262 := t259 + t261
+	t263 := t259 - t261
+	t264 := x26 + x74
+	t265 := x26 - x74
+	t266 := x42 + x58
+	t267 := x42 - x58
+	t268 := x10 + t264 + t266
+	t269 := complex(real(x10)+0.30901699437494745*real(t264)-0.8090169943749475*real(t266), imag(x10)+0.30901699437494745*imag(t264)-0.8090169943749475*imag(t266))
+	t270 := complex(0.9510565162951535*real(t265)+0.5877852522924732*real(t267), 0.9510565162951535*imag(t265)+0.5877852522924732*imag(t267))
+	t271 := complex(imag(t270), -real(t270))
+	t272 := t269 + t271
+	t273 := t269 - t271
+	t274 := complex(real(x10)-0.8090169943749475*real(t264)+0.30901699437494723*real(t266), imag(x10)-0.8090169943749475*imag(t264)+0.30901699437494723*imag(t266))
+	t275 := complex(0.5877852522924732*real(t265)-0.9510565162951536*real(t267), 0.5877852522924732*imag(t265)-0.9510565162951536*imag(t267))
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t274 + t276
+	t278 := t274 - t276
+	t279 := x30 + x78
+	t280 := x30 - x78
+	t281 := x46 + x62
+	t282 := x46 - x62
+	t283 := x14 + t279 + t281
+	t284 := complex(real(x14)+0.30901699437494745*real(t279)-0.8090169943749475*real(t281), imag(x14)+0.30901699437494745*imag(t279)-0.8090169943749475*imag(t281))
+	t285 := complex(0.9510565162951535*real(t280)+0.5877852522924732*real(t282), 0.9510565162951535*imag(t280)+0.5877852522924732*imag(t282))
+	t286 := complex(imag(t285), -real(t285))
+	t287 := t284 + t286
+	t288 := t284 - t286
+	t289 := complex(real(x14)-0.8090169943749475*real(t279)+0.30901699437494723*real(t281), imag(x14)-0.8090169943749475*imag(t279)+0.30901699437494723*imag(t281))
+	t290 := complex(0.5877852522924732*real(t280)-0.9510565162951536*real(t282), 0.5877852522924732*imag(t280)-0.9510565162951536*imag(t282))
+	t291 := complex(imag(t290), -real(t290))
+	t292 := t289 + t291
+	t293 := t289 - t291
+	t294 := t238 + t268
+	t295 := t238 - t268
+	t296 := t253 + t283
+	t297 := t253 - t283
+	t298 := complex(imag(t297), -real(t297))
+	t299 := t294 + t296
+	t300 := t295 + t298
+	t301 := t294 - t296
+	t302 := t295 - t298
+	t303 := w4 * t257
+	t304 := w8 * t272
+	t305 := w12 * t287
+	t306 := t242 + t304
+	t307 := t242 - t304
+	t308 := t303 + t305
+	t309 := t303 - t305
+	t310 := complex(imag(t309), -real(t309))
+	t311 := t306 + t308
+	t312 := t307 + t310
+	t313 := t306 - t308
+	t314 := t307 - t310
+	t315 := w8 * t262
+	t316 := w16 * t277
+	t317 := w24 * t292
+	t318 := t247 + t316
+	t319 := t247 - t316
+	t320 := t315 + t317
+	t321 := t315 - t317
+	t322 := complex(imag(t321), -real(t321))
+	t323 := t318 + t320
+	t324 := t319 + t322
+	t325 := t318 - t320
+	t326 := t319 - t322
+	t327 := w12 * t263
+	t328 := w24 * t278
+	t329 := w36 * t293
+	t330 := t248 + t328
+	t331 := t248 - t328
+	t332 := t327 + t329
+	t333 := t327 - t329
+	t334 := complex(imag(t333), -real(t333))
+	t335 := t330 + t332
+	t336 := t331 + t334
+	t337 := t330 - t332
+	t338 := t331 - t334
+	t339 := w16 * t258
+	t340 := w32 * t273
+	t341 := w48 * t288
+	t342 := t243 + t340
+	t343 := t243 - t340
+	t344 := t339 + t341
+	t345 := t339 - t341
+	t346 := complex(imag(t345), -real(t345))
+	t347 := t342 + t344
+	t348 := t343 + t346
+	t349 := t342 - t344
+	t350 := t343 - t346
+	t351 := x19 + x67
+	t352 := x19 - x67
+	t353 := x35 + x51
+	t354 := x35 - x51
+	t355 := x3 + t351 + t353
+	t356 := complex(real(x3)+0.30901699437494745*real(t351)-0.8090169943749475*real(t353), imag(x3)+0.30901699437494745*imag(t351)-0.8090169943749475*imag(t353))
+	t357 := complex(0.9510565162951535*real(t352)+0.5877852522924732*real(t354), 0.9510565162951535*imag(t352)+0.5877852522924732*imag(t354))
+	t358 := complex(imag(t357), -real(t357))
+	t359 := t356 + t358
+	t360 := t356 - t358
+	t361 := complex(real(x3)-0.8090169943749475*real(t351)+0.30901699437494723*real(t353), imag(x3)-0.8090169943749475*imag(t351)+0.30901699437494723*imag(t353))
+	t362 := complex(0.5877852522924732*real(t352)-0.9510565162951536*real(t354), 0.5877852522924732*imag(t352)-0.9510565162951536*imag(t354))
+	t363 := complex(imag(t362), -real(t362))
+	t364 := t361 + t363
+	t365 := t361 - t363
+	t366 := x23 + x71
+	t367 := x23 - x71
+	t368 := x39 + x55
+	t369 := x39 - x55
+	t370 := x7 + t366 + t368
+	t371 := complex(real(x7)+0.30901699437494745*real(t366)-0.8090169943749475*real(t368), imag(x7)+0.30901699437494745*imag(t366)-0.8090169943749475*imag(t368))
+	t372 := complex(0.9510565162951535*real(t367)+0.5877852522924732*real(t369), 0.9510565162951535*imag(t367)+0.5877852522924732*imag(t369))
+	t373 := complex(imag(t372), -real(t372))
+	t374 := t371 + t373
+	t375 := t371 - t373
+	t376 := complex(real(x7)-0.8090169943749475*real(t366)+0.30901699437494723*real(t368), imag(x7)-0.8090169943749475*imag(t366)+0.30901699437494723*imag(t368))
+	t377 := complex(0.5877852522924732*real(t367)-0.9510565162951536*real(t369), 0.5877852522924732*imag(t367)-0.9510565162951536*imag(t369))
+	t378 := complex(imag(t377), -real(t377))
+	t379 := t376 + t378
+	t380 := t376 - t378
+	t381 := x27 + x75
+	t382 := x27 - x75
+	t383 := x43 + x59
+	t384 := x43 - x59
+	t385 := x11 + t381 + t383
+	t386 := complex(real(x11)+0.30901699437494745*real(t381)-0.8090169943749475*real(t383), imag(x11)+0.30901699437494745*imag(t381)-0.8090169943749475*imag(t383))
+	t387 := complex(0.9510565162951535*real(t382)+0.5877852522924732*real(t384), 0.9510565162951535*imag(t382)+0.5877852522924732*imag(t384))
+	t388 := complex(imag(t387), -real(t387))
+	t389 := t386 + t388
+	t390 := t386 - t388
+	t391 := complex(real(x11)-0.8090169943749475*real(t381)+0.30901699437494723*real(t383), imag(x11)-0.8090169943749475*imag(t381)+0.30901699437494723*imag(t383))
+	t392 := complex(0.5877852522924732*real(t382)-0.9510565162951536*real(t384), 0.5877852522924732*imag(t382)-0.9510565162951536*imag(t384))
+	t393 := complex(imag(t392), -real(t392))
+	t394 := t391 + t393
+	t395 := t391 - t393
+	t396 := x31 + x79
+	t397 := x31 - x79
+	t398 := x47 + x63
+	t399 := x47 - x63
+	t400 := x15 + t396 + t398
+	t401 := complex(real(x15)+0.30901699437494745*real(t396)-0.8090169943749475*real(t398), imag(x15)+0.30901699437494745*imag(t396)-0.8090169943749475*imag(t398))
+	t402 := complex(0.9510565162951535*real(t397)+0.5877852522924732*real(t399), 0.9510565162951535*imag(t397)+0.5877852522924732*imag(t399))
+	t403 := complex(imag(t402), -real(t402))
+	t404 := t401 + t403
+	t405 := t401 - t403
+	t406 := complex(real(x15)-0.8090169943749475*real(t396)+0.30901699437494723*real(t398), imag(x15)-0.8090169943749475*imag(t396)+0.30901699437494723*imag(t398))
+	t407 := complex(0.5877852522924732*real(t397)-0.9510565162951536*real(t399), 0.5877852522924732*imag(t397)-0.9510565162951536*imag(t399))
+	t408 := complex(imag(t407), -real(t407))
+	t409 := t406 + t408
+	t410 := t406 - t408
+	t411 := t355 + t385
+	t412 := t355 - t385
+	t413 := t370 + t400
+	t414 := t370 - t400
+	t415 := complex(imag(t414), -real(t414))
+	t416 := t411 + t413
+	t417 := t412 + t415
+	t418 := t411 - t413
+	t419 := t412 - t415
+	t420 := w4 * t374
+	t421 := w8 * t389
+	t422 := w12 * t404
+	t423 := t359 + t421
+	t424 := t359 - t421
+	t425 := t420 + t422
+	t426 := t420 - t422
+	t427 := complex(imag(t426), -real(t426))
+	t428 := t423 + t425
+	t429 := t424 + t427
+	t430 := t423 - t425
+	t431 := t424 - t427
+	t432 := w8 * t379
+	t433 := w16 * t394
+	t434 := w24 * t409
+	t435 := t364 + t433
+	t436 := t364 - t433
+	t437 := t432 + t434
+	t438 := t432 - t434
+	t439 := complex(imag(t438), -real(t438))
+	t440 := t435 + t437
+	t441 := t436 + t439
+	t442 := t435 - t437
+	t443 := t436 - t439
+	t444 := w12 * t380
+	t445 := w24 * t395
+	t446 := w36 * t410
+	t447 := t365 + t445
+	t448 := t365 - t445
+	t449 := t444 + t446
+	t450 := t444 - t446
+	t451 := complex(imag(t450), -real(t450))
+	t452 := t447 + t449
+	t453 := t448 + t451
+	t454 := t447 - t449
+	t455 := t448 - t451
+	t456 := w16 * t375
+	t457 := w32 * t390
+	t458 := w48 * t405
+	t459 := t360 + t457
+	t460 := t360 - t457
+	t461 := t456 + t458
+	t462 := t456 - t458
+	t463 := complex(imag(t462), -real(t462))
+	t464 := t459 + t461
+	t465 := t460 + t463
+	t466 := t459 - t461
+	t467 := t460 - t463
+	t468 := t65 + t299
+	t469 := t65 - t299
+	t470 := t182 + t416
+	t471 := t182 - t416
+	t472 := complex(imag(t471), -real(t471))
+	t473 := t468 + t470
+	t474 := t469 + t472
+	t475 := t468 - t470
+	t476 := t469 - t472
+	t477 := w1 * t194
+	t478 := w2 * t311
+	t479 := w3 * t428
+	t480 := t77 + t478
+	t481 := t77 - t478
+	t482 := t477 + t479
+	t483 := t477 - t479
+	t484 := complex(imag(t483), -real(t483))
+	t485 := t480 + t482
+	t486 := t481 + t484
+	t487 := t480 - t482
+	t488 := t481 - t484
+	t489 := w2 * t206
+	t490 := w4 * t323
+	t491 := w6 * t440
+	t492 := t89 + t490
+	t493 := t89 - t490
+	t494 := t489 + t491
+	t495 := t489 - t491
+	t496 := complex(imag(t495), -real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := w3 * t218
+	t502 := w6 * t335
+	t503 := w9 * t452
+	t504 := t101 + t502
+	t505 := t101 - t502
+	t506 := t501 + t503
+	t507 := t501 - t503
+	t508 := complex(imag(t507), -real(t507))
+	t509 := t504 + t506
+	t510 := t505 + t508
+	t511 := t504 - t506
+	t512 := t505 - t508
+	t513 := w4 * t230
+	t514 := w8 * t347
+	t515 := w12 * t464
+	t516 := t113 + t514
+	t517 := t113 - t514
+	t518 := t513 + t515
+	t519 := t513 - t515
+	t520 := complex(imag(t519), -real(t519))
+	t521 := t516 + t518
+	t522 := t517 + t520
+	t523 := t516 - t518
+	t524 := t517 - t520
+	t525 := w5 * t183
+	t526 := w10 * t300
+	t527 := w15 * t417
+	t528 := t66 + t526
+	t529 := t66 - t526
+	t530 := t525 + t527
+	t531 := t525 - t527
+	t532 := complex(imag(t531), -real(t531))
+	t533 := t528 + t530
+	t534 := t529 + t532
+	t535 := t528 - t530
+	t536 := t529 - t532
+	t537 := w6 * t195
+	t538 := w12 * t312
+	t539 := w18 * t429
+	t540 := t78 + t538
+	t541 := t78 - t538
+	t542 := t537 + t539
+	t543 := t537 - t539
+	t544 := complex(imag(t543), -real(t543))
+	t545 := t540 + t542
+	t546 := t541 + t544
+	t547 := t540 - t542
+	t548 := t541 - t544
+	t549 := w7 * t207
+	t550 := w14 * t324
+	t551 := w21 * t441
+	t552 := t90 + t550
+	t553 := t90 - t550
+	t554 := t549 + t551
+	t555 := t549 - t551
+	t556 := complex(imag(t555), -real(t555))
+	t557 := t552 + t554
+	t558 := t553 + t556
+	t559 := t552 - t554
+	t560 := t553 - t556
+	t561 := w8 * t219
+	t562 := w16 * t336
+	t563 := w24 * t453
+	t564 := t102 + t562
+	t565 := t102 - t562
+	t566 := t561 + t563
+	t567 := t561 - t563
+	t568 := complex(imag(t567), -real(t567))
+	t569 := t564 + t566
+	t570 := t565 + t568
+	t571 := t564 - t566
+	t572 := t565 - t568
+	t573 := w9 * t231
+	t574 := w18 * t348
+	t575 := w27 * t465
+	t576 := t114 + t574
+	t577 := t114 - t574
+	t578 := t573 + t575
+	t579 := t573 - t575
+	t580 := complex(imag(t579), -real(t579))
+	t581 := t576 + t578
+	t582 := t577 + t580
+	t583 := t576 - t578
+	t584 := t577 - t580
+	t585 := w10 * t184
+	t586 := complex(imag(t301), -real(t301))
+	t587 := w30 * t418
+	t588 := t67 + t586
+	t589 := t67 - t586
+	t590 := t585 + t587
+	t591 := t585 - t587
+	t592 := complex(imag(t591), -real(t591))
+	t593 := t588 + t590
+	t594 := t589 + t592
+	t595 := t588 - t590
+	t596 := t589 - t592
+	t597 := w11 * t196
+	t598 := w22 * t313
+	t599 := w33 * t430
+	t600 := t79 + t598
+	t601 := t79 - t598
+	t602 := t597 + t599
+	t603 := t597 - t599
+	t604 := complex(imag(t603), -real(t603))
+	t605 := t600 + t602
+	t606 := t601 + t604
+	t607 := t600 - t602
+	t608 := t601 - t604
+	t609 := w12 * t208
+	t610 := w24 * t325
+	t611 := w36 * t442
+	t612 := t91 + t610
+	t613 := t91 - t610
+	t614 := t609 + t611
+	t615 := t609 - t611
+	t616 := complex(imag(t615), -real(t615))
+	t617 := t612 + t614
+	t618 := t613 + t616
+	t619 := t612 - t614
+	t620 := t613 - t616
+	t621 := w13 * t220
+	t622 := w26 * t337
+	t623 := w39 * t454
+	t624 := t103 + t622
+	t625 := t103 - t622
+	t626 := t621 + t623
+	t627 := t621 - t623
+	t628 := complex(imag(t627), -real(t627))
+	t629 := t624 + t626
+	t630 := t625 + t628
+	t631 := t624 - t626
+	t632 := t625 - t628
+	t633 := w14 * t232
+	t634 := w28 * t349
+	t635 := w42 * t466
+	t636 := t115 + t634
+	t637 := t115 - t634
+	t638 := t633 + t635
+	t639 := t633 - t635
+	t640 := complex(imag(t639), -real(t639))
+	t641 := t636 + t638
+	t642 := t637 + t640
+	t643 := t636 - t638
+	t644 := t637 - t640
+	t645 := w15 * t185
+	t646 := w30 * t302
+	t647 := w45 * t419
+	t648 := t68 + t646
+	t649 := t68 - t646
+	t650 := t645 + t647
+	t651 := t645 - t647
+	t652 := complex(imag(t651), -real(t651))
+	t653 := t648 + t650
+	t654 := t649 + t652
+	t655 := t648 - t650
+	t656 := t649 - t652
+	t657 := w16 * t197
+	t658 := w32 * t314
+	t659 := w48 * t431
+	t660 := t80 + t658
+	t661 := t80 - t658
+	t662 := t657 + t659
+	t663 := t657 - t659
+	t664 := complex(imag(t663), -real(t663))
+	t665 := t660 + t662
+	t666 := t661 + t664
+	t667 := t660 - t662
+	t668 := t661 - t664
+	t669 := w17 * t209
+	t670 := w34 * t326
+	t671 := w51 * t443
+	t672 := t92 + t670
+	t673 := t92 - t670
+	t674 := t669 + t671
+	t675 := t669 - t671
+	t676 := complex(imag(t675), -real(t675))
+	t677 := t672 + t674
+	t678 := t673 + t676
+	t679 := t672 - t674
+	t680 := t673 - t676
+	t681 := w18 * t221
+	t682 := w36 * t338
+	t683 := w54 * t455
+	t684 := t104 + t682
+	t685 := t104 - t682
+	t686 := t681 + t683
+	t687 := t681 - t683
+	t688 := complex(imag(t687), -real(t687))
+	t689 := t684 + t686
+	t690 := t685 + t688
+	t691 := t684 - t686
+	t692 := t685 - t688
+	t693 := w19 * t233
+	t694 := w38 * t350
+	t695 := w57 * t467
+	t696 := t116 + t694
+	t697 := t116 - t694
+	t698 := t693 + t695
+	t699 := t693 - t695
+	t700 := complex(imag(t699), -real(t699))
+	t701 := t696 + t698
+	t702 := t697 + t700
+	t703 := t696 - t698
+	t704 := t697 - t700
+
+	y := dst[:n]
+	y[0] = t473
+	y[1] = t485
+	y[2] = t497
+	y[3] = t509
+	y[4] = t521
+	y[5] = t533
+	y[6] = t545
+	y[7] = t557
+	y[8] = t569
+	y[9] = t581
+	y[10] = t593
+	y[11] = t605
+	y[12] = t617
+	y[13] = t629
+	y[14] = t641
+	y[15] = t653
+	y[16] = t665
+	y[17] = t677
+	y[18] = t689
+	y[19] = t701
+	y[20] = t474
+	y[21] = t486
+	y[22] = t498
+	y[23] = t510
+	y[24] = t522
+	y[25] = t534
+	y[26] = t546
+	y[27] = t558
+	y[28] = t570
+	y[29] = t582
+	y[30] = t594
+	y[31] = t606
+	y[32] = t618
+	y[33] = t630
+	y[34] = t642
+	y[35] = t654
+	y[36] = t666
+	y[37] = t678
+	y[38] = t690
+	y[39] = t702
+	y[40] = t475
+	y[41] = t487
+	y[42] = t499
+	y[43] = t511
+	y[44] = t523
+	y[45] = t535
+	y[46] = t547
+	y[47] = t559
+	y[48] = t571
+	y[49] = t583
+	y[50] = t595
+	y[51] = t607
+	y[52] = t619
+	y[53] = t631
+	y[54] = t643
+	y[55] = t655
+	y[56] = t667
+	y[57] = t679
+	y[58] = t691
+	y[59] = t703
+	y[60] = t476
+	y[61] = t488
+	y[62] = t500
+	y[63] = t512
+	y[64] = t524
+	y[65] = t536
+	y[66] = t548
+	y[67] = t560
+	y[68] = t572
+	y[69] = t584
+	y[70] = t596
+	y[71] = t608
+	y[72] = t620
+	y[73] = t632
+	y[74] = t644
+	y[75] = t656
+	y[76] = t668
+	y[77] = t680
+	y[78] = t692
+	y[79] = t704
+
+	return true
+}
+
+// inverseDIT80Radix4x4x5Complex64 computes a 80-point inverse FFT of complex64 data
+// with radix-4, radix-4, radix-5 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT80Radix4x4x5Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 80
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w12 := complex(real(tw[12]), -imag(tw[12]))
+	w16 := complex(real(tw[16]), -imag(tw[16]))
+	w24 := complex(real(tw[24]), -imag(tw[24]))
+	w36 := complex(real(tw[36]), -imag(tw[36]))
+	w32 := complex(real(tw[32]), -imag(tw[32]))
+	w48 := complex(real(tw[48]), -imag(tw[48]))
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w6 := complex(real(tw[6]), -imag(tw[6]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w5 := complex(real(tw[5]), -imag(tw[5]))
+	w10 := complex(real(tw[10]), -imag(tw[10]))
+	w15 := complex(real(tw[15]), -imag(tw[15]))
+	w18 := complex(real(tw[18]), -imag(tw[18]))
+	w7 := complex(real(tw[7]), -imag(tw[7]))
+	w14 := complex(real(tw[14]), -imag(tw[14]))
+	w21 := complex(real(tw[21]), -imag(tw[21]))
+	w27 := complex(real(tw[27]), -imag(tw[27]))
+	w30 := complex(real(tw[30]), -imag(tw[30]))
+	w11 := complex(real(tw[11]), -imag(tw[11]))
+	w22 := complex(real(tw[22]), -imag(tw[22]))
+	w33 := complex(real(tw[33]), -imag(tw[33]))
+	w13 := complex(real(tw[13]), -imag(tw[13]))
+	w26 := complex(real(tw[26]), -imag(tw[26]))
+	w39 := complex(real(tw[39]), -imag(tw[39]))
+	w28 := complex(real(tw[28]), -imag(tw[28]))
+	w42 := complex(real(tw[42]), -imag(tw[42]))
+	w45 := complex(real(tw[45]), -imag(tw[45]))
+	w17 := complex(real(tw[17]), -imag(tw[17]))
+	w34 := complex(real(tw[34]), -imag(tw[34]))
+	w51 := complex(real(tw[51]), -imag(tw[51]))
+	w54 := complex(real(tw[54]), -imag(tw[54]))
+	w19 := complex(real(tw[19]), -imag(tw[19]))
+	w38 := complex(real(tw[38]), -imag(tw[38]))
+	w57 := complex(real(tw[57]), -imag(tw[57]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+	x48 := x[48]
+	x49 := x[49]
+	x50 := x[50]
+	x51 := x[51]
+	x52 := x[52]
+	x53 := x[53]
+	x54 := x[54]
+	x55 := x[55]
+	x56 := x[56]
+	x57 := x[57]
+	x58 := x[58]
+	x59 := x[59]
+	x60 := x[60]
+	x61 := x[61]
+	x62 := x[62]
+	x63 := x[63]
+	x64 := x[64]
+	x65 := x[65]
+	x66 := x[66]
+	x67 := x[67]
+	x68 := x[68]
+	x69 := x[69]
+	x70 := x[70]
+	x71 := x[71]
+	x72 := x[72]
+	x73 := x[73]
+	x74 := x[74]
+	x75 := x[75]
+	x76 := x[76]
+	x77 := x[77]
+	x78 := x[78]
+	x79 := x[79]
+
+	t0 := x16 + x64
+	t1 := x16 - x64
+	t2 := x32 + x48
+	t3 := x32 - x48
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.8090169943749475*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.8090169943749475*imag(t2))
+	t6 := complex(0.9510565162951535*real(t1)+0.5877852522924732*real(t3), 0.9510565162951535*imag(t1)+0.5877852522924732*imag(t3))
+	t7 := complex(-imag(t6), real(t6))
+	t8 := t5 + t7
+	t9 := t5 - t7
+	t10 := complex(real(x0)-0.8090169943749475*real(t0)+0.30901699437494723*real(t2), imag(x0)-0.8090169943749475*imag(t0)+0.30901699437494723*imag(t2))
+	t11 := complex(0.5877852522924732*real(t1)-0.9510565162951536*real(t3), 0.5877852522924732*imag(t1)-0.9510565162951536*imag(t3))
+	t12 := complex(-imag(t11), real(t11))
+	t13 := t10 + t12
+	t14 := t10 - t12
+	t15 := x20 + x68
+	t16 := x20 - x68
+	t17 := x36 + x52
+	t18 := x36 - x52
+	t19 := x4 + t15 + t17
+	t20 := complex(real(x4)+0.30901699437494745*real(t15)-0.8090169943749475*real(t17), imag(x4)+0.30901699437494745*imag(t15)-0.8090169943749475*imag(t17))
+	t21 := complex(0.9510565162951535*real(t16)+0.5877852522924732*real(t18), 0.9510565162951535*imag(t16)+0.5877852522924732*imag(t18))
+	t22 := complex(-imag(t21), real(t21))
+	t23 := t20 + t22
+	t24 := t20 - t22
+	t25 := complex(real(x4)-0.8090169943749475*real(t15)+0.30901699437494723*real(t17), imag(x4)-0.8090169943749475*imag(t15)+0.30901699437494723*imag(t17))
+	t26 := complex(0.5877852522924732*real(t16)-0.9510565162951536*real(t18), 0.5877852522924732*imag(t16)-0.9510565162951536*imag(t18))
+	t27 := complex(-imag(t26), real(t26))
+	t28 := t25 + t27
+	t29 := t25 - t27
+	t30 := x24 + x72
+	t31 := x24 - x72
+	t32 := x40 + x56
+	t33 := x40 - x56
+	t34 := x8 + t30 + t32
+	t35 := complex(real(x8)+0.30901699437494745*real(t30)-0.8090169943749475*real(t32), imag(x8)+0.30901699437494745*imag(t30)-0.8090169943749475*imag(t32))
+	t36 := complex(0.9510565162951535*real(t31)+0.5877852522924732*real(t33), 0.9510565162951535*imag(t31)+0.5877852522924732*imag(t33))
+	t37 := complex(-imag(t36), real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := complex(real(x8)-0.8090169943749475*real(t30)+0.30901699437494723*real(t32), imag(x8)-0.8090169943749475*imag(t30)+0.30901699437494723*imag(t32))
+	t41 := complex(0.5877852522924732*real(t31)-0.9510565162951536*real(t33), 0.5877852522924732*imag(t31)-0.9510565162951536*imag(t33))
+	t42 := complex(-imag(t41), real(t41))
+	t43 := t40 + t42
+	t44 := t40 - t42
+	t45 := x28 + x76
+	t46 := x28 - x76
+	t47 := x44 + x60
+	t48 := x44 - x60
+	t49 := x12 + t45 + t47
+	t50 := complex(real(x12)+0.30901699437494745*real(t45)-0.8090169943749475*real(t47), imag(x12)+0.30901699437494745*imag(t45)-0.8090169943749475*imag(t47))
+	t51 := complex(0.9510565162951535*real(t46)+0.5877852522924732*real(t48), 0.9510565162951535*imag(t46)+0.5877852522924732*imag(t48))
+	t52 := complex(-imag(t51), real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := complex(real(x12)-0.8090169943749475*real(t45)+0.30901699437494723*real(t47), imag(x12)-0.8090169943749475*imag(t45)+0.30901699437494723*imag(t47))
+	t56 := complex(0.5877852522924732*real(t46)-0.9510565162951536*real(t48), 0.5877852522924732*imag(t46)-0.9510565162951536*imag(t48))
+	t57 := complex(-imag(t56), real(t56))
+	t58 := t55 + t57
+	t59 := t55 - t57
+	t60 := t4 + t34
+	t61 := t4 - t34
+	t62 := t19 + t49
+	t63 := t19 - t49
+	t64 := complex(-imag(t63), real(t63))
+	t65 := t60 + t62
+	t66 := t61 + t64
+	t67 := t60 - t62
+	t68 := t61 - t64
+	t69 := w4 * t23
+	t70 := w8 * t38
+	t71 := w12 * t53
+	t72 := t8 + t70
+	t73 := t8 - t70
+	t74 := t69 + t71
+	t75 := t69 - t71
+	t76 := complex(-imag(t75), real(t75))
+	t77 := t72 + t74
+	t78 := t73 + t76
+	t79 := t72 - t74
+	t80 := t73 - t76
+	t81 := w8 * t28
+	t82 := w16 * t43
+	t83 := w24 * t58
+	t84 := t13 + t82
+	t85 := t13 - t82
+	t86 := t81 + t83
+	t87 := t81 - t83
+	t88 := complex(-imag(t87), real(t87))
+	t89 := t84 + t86
+	t90 := t85 + t88
+	t91 := t84 - t86
+	t92 := t85 - t88
+	t93 := w12 * t29
+	t94 := w24 * t44
+	t95 := w36 * t59
+	t96 := t14 + t94
+	t97 := t14 - t94
+	t98 := t93 + t95
+	t99 := t93 - t95
+	t100 := complex(-imag(t99), real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w16 * t24
+	t106 := w32 * t39
+	t107 := w48 * t54
+	t108 := t9 + t106
+	t109 := t9 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(-imag(t111), real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+	t117 := x17 + x65
+	t118 := x17 - x65
+	t119 := x33 + x49
+	t120 := x33 - x49
+	t121 := x1 + t117 + t119
+	t122 := complex(real(x1)+0.30901699437494745*real(t117)-0.8090169943749475*real(t119), imag(x1)+0.30901699437494745*imag(t117)-0.8090169943749475*imag(t119))
+	t123 := complex(0.9510565162951535*real(t118)+0.5877852522924732*real(t120), 0.9510565162951535*imag(t118)+0.5877852522924732*imag(t120))
+	t124 := complex(-imag(t123), real(t123))
+	t125 := t122 + t124
+	t126 := t122 - t124
+	t127 := complex(real(x1)-0.8090169943749475*real(t117)+0.30901699437494723*real(t119), imag(x1)-0.8090169943749475*imag(t117)+0.30901699437494723*imag(t119))
+	t128 := complex(0.5877852522924732*real(t118)-0.9510565162951536*real(t120), 0.5877852522924732*imag(t118)-0.9510565162951536*imag(t120))
+	t129 := complex(-imag(t128), real(t128))
+	t130 := t127 + t129
+	t131 := t127 - t129
+	t132 := x21 + x69
+	t133 := x21 - x69
+	t134 := x37 + x53
+	t135 := x37 - x53
+	t136 := x5 + t132 + t134
+	t137 := complex(real(x5)+0.30901699437494745*real(t132)-0.8090169943749475*real(t134), imag(x5)+0.30901699437494745*imag(t132)-0.8090169943749475*imag(t134))
+	t138 := complex(0.9510565162951535*real(t133)+0.5877852522924732*real(t135), 0.9510565162951535*imag(t133)+0.5877852522924732*imag(t135))
+	t139 := complex(-imag(t138), real(t138))
+	t140 := t137 + t139
+	t141 := t137 - t139
+	t142 := complex(real(x5)-0.8090169943749475*real(t132)+0.30901699437494723*real(t134), imag(x5)-0.8090169943749475*imag(t132)+0.30901699437494723*imag(t134))
+	t143 := complex(0.5877852522924732*real(t133)-0.9510565162951536*real(t135), 0.5877852522924732*imag(t133)-0.9510565162951536*imag(t135))
+	t144 := complex(-imag(t143), real(t143))
+	t145 := t142 + t144
+	t146 := t142 - t144
+	t147 := x25 + x73
+	t148 := x25 - x73
+	t149 := x41 + x57
+	t150 := x41 - x57
+	t151 := x9 + t147 + t149
+	t152 := complex(real(x9)+0.30901699437494745*real(t147)-0.8090169943749475*real(t149), imag(x9)+0.30901699437494745*imag(t147)-0.8090169943749475*imag(t149))
+	t153 := complex(0.9510565162951535*real(t148)+0.5877852522924732*real(t150), 0.9510565162951535*imag(t148)+0.5877852522924732*imag(t150))
+	t154 := complex(-imag(t153), real(t153))
+	t155 := t152 + t154
+	t156 := t152 - t154
+	t157 := complex(real(x9)-0.8090169943749475*real(t147)+0.30901699437494723*real(t149), imag(x9)-0.8090169943749475*imag(t147)+0.30901699437494723*imag(t149))
+	t158 := complex(0.5877852522924732*real(t148)-0.9510565162951536*real(t150), 0.5877852522924732*imag(t148)-0.9510565162951536*imag(t150))
+	t159 := complex(-imag(t158), real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := x29 + x77
+	t163 := x29 - x77
+	t164 := x45 + x61
+	t165 := x45 - x61
+	t166 := x13 + t162 + t164
+	t167 := complex(real(x13)+0.30901699437494745*real(t162)-0.8090169943749475*real(t164), imag(x13)+0.30901699437494745*imag(t162)-0.8090169943749475*imag(t164))
+	t168 := complex(0.9510565162951535*real(t163)+0.5877852522924732*real(t165), 0.9510565162951535*imag(t163)+0.5877852522924732*imag(t165))
+	t169 := complex(-imag(t168), real(t168))
+	t170 := t167 + t169
+	t171 := t167 - t169
+	t172 := complex(real(x13)-0.8090169943749475*real(t162)+0.30901699437494723*real(t164), imag(x13)-0.8090169943749475*imag(t162)+0.30901699437494723*imag(t164))
+	t173 := complex(0.5877852522924732*real(t163)-0.9510565162951536*real(t165), 0.5877852522924732*imag(t163)-0.9510565162951536*imag(t165))
+	t174 := complex(-imag(t173), real(t173))
+	t175 := t172 + t174
+	t176 := t172 - t174
+	t177 := t121 + t151
+	t178 := t121 - t151
+	t179 := t136 + t166
+	t180 := t136 - t166
+	t181 := complex(-imag(t180), real(t180))
+	t182 := t177 + t179
+	t183 := t178 + t181
+	t184 := t177 - t179
+	t185 := t178 - t181
+	t186 := w4 * t140
+	t187 := w8 * t155
+	t188 := w12 * t170
+	t189 := t125 + t187
+	t190 := t125 - t187
+	t191 := t186 + t188
+	t192 := t186 - t188
+	t193 := complex(-imag(t192), real(t192))
+	t194 := t189 + t191
+	t195 := t190 + t193
+	t196 := t189 - t191
+	t197 := t190 - t193
+	t198 := w8 * t145
+	t199 := w16 * t160
+	t200 := w24 * t175
+	t201 := t130 + t199
+	t202 := t130 - t199
+	t203 := t198 + t200
+	t204 := t198 - t200
+	t205 := complex(-imag(t204), real(t204))
+	t206 := t201 + t203
+	t207 := t202 + t205
+	t208 := t201 - t203
+	t209 := t202 - t205
+	t210 := w12 * t146
+	t211 := w24 * t161
+	t212 := w36 * t176
+	t213 := t131 + t211
+	t214 := t131 - t211
+	t215 := t210 + t212
+	t216 := t210 - t212
+	t217 := complex(-imag(t216), real(t216))
+	t218 := t213 + t215
+	t219 := t214 + t217
+	t220 := t213 - t215
+	t221 := t214 - t217
+	t222 := w16 * t141
+	t223 := w32 * t156
+	t224 := w48 * t171
+	t225 := t126 + t223
+	t226 := t126 - t223
+	t227 := t222 + t224
+	t228 := t222 - t224
+	t229 := complex(-imag(t228), real(t228))
+	t230 := t225 + t227
+	t231 := t226 + t229
+	t232 := t225 - t227
+	t233 := t226 - t229
+	t234 := x18 + x66
+	t235 := x18 - x66
+	t236 := x34 + x50
+	t237 := x34 - x50
+	t238 := x2 + t234 + t236
+	t239 := complex(real(x2)+0.30901699437494745*real(t234)-0.8090169943749475*real(t236), imag(x2)+0.30901699437494745*imag(t234)-0.8090169943749475*imag(t236))
+	t240 := complex(0.9510565162951535*real(t235)+0.5877852522924732*real(t237), 0.9510565162951535*imag(t235)+0.5877852522924732*imag(t237))
+	t241 := complex(-imag(t240), real(t240))
+	t242 := t239 + t241
+	t243 := t239 - t241
+	t244 := complex(real(x2)-0.8090169943749475*real(t234)+0.30901699437494723*real(t236), imag(x2)-0.8090169943749475*imag(t234)+0.30901699437494723*imag(t236))
+	t245 := complex(0.5877852522924732*real(t235)-0.9510565162951536*real(t237), 0.5877852522924732*imag(t235)-0.9510565162951536*imag(t237))
+	t246 := complex(-imag(t245), real(t245))
+	t247 := t244 + t246
+	t248 := t244 - t246
+	t249 := x22 + x70
+	t250 := x22 - x70
+	t251 := x38 + x54
+	t252 := x38 - x54
+	t253 := x6 + t249 + t251
+	t254 := complex(real(x6)+0.30901699437494745*real(t249)-0.8090169943749475*real(t251), imag(x6)+0.30901699437494745*imag(t249)-0.8090169943749475*imag(t251))
+	t255 := complex(0.9510565162951535*real(t250)+0.5877852522924732*real(t252), 0.9510565162951535*imag(t250)+0.5877852522924732*imag(t252))
+	t256 := complex(-imag(t255), real(t255))
+	t257 := t254 + t256
+	t258 := t254 - t256
+	t259 := complex(real(x6)-0.8090169943749475*real(t249)+0.30901699437494723*real(t251), imag(x6)-0.8090169943749475*imag(t249)+0.30901699437494723*imag(t251))
+	t260 := complex(0.5877852522924732*real(t250)-0.9510565162951536*real(t252), 0.5877852522924732*imag(t250)-0.9510565162951536*imag(t252))
+	t261 := complex(-imag(t260), real(t260))
+	t262 := t259 + t261
+	t263 := t259 - t261
+	t264 := x26 + x74
+	t265 := x26 - x74
+	t266 := x42 + x58
+	t267 := x42 - x58
+	t268 := x10 + t264 + t266
+	t269 := complex(real(x10)+0.30901699437494745*real(t264)-0.8090169943749475*real(t266), imag(x10)+0.30901699437494745*imag(t264)-0.8090169943749475*imag(t266))
+	t270 := complex(0.9510565162951535*real(t265)+0.5877852522924732*real(t267), 0.9510565162951535*imag(t265)+0.5877852522924732*imag(t267))
+	t271 := complex(-imag(t270), real(t270))
+	t272 := t269 + t271
+	t273 := t269 - t271
+	t274 := complex(real(x10)-0.8090169943749475*real(t264)+0.30901699437494723*real(t266), imag(x10)-0.8090169943749475*imag(t264)+0.30901699437494723*imag(t266))
+	t275 := complex(0.5877852522924732*real(t265)-0.9510565162951536*real(t267), 0.5877852522924732*imag(t265)-0.9510565162951536*imag(t267))
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t274 + t276
+	t278 := t274 - t276
+	t279 := x30 + x78
+	t280 := x30 - x78
+	t281 := x46 + x62
+	t282 := x46 - x62
+	t283 := x14 + t279 + t281
+	t284 := complex(real(x14)+0.30901699437494745*real(t279)-0.8090169943749475*real(t281), imag(x14)+0.30901699437494745*imag(t279)-0.8090169943749475*imag(t281))
+	t285 := complex(0.9510565162951535*real(t280)+0.5877852522924732*real(t282), 0.9510565162951535*imag(t280)+0.5877852522924732*imag(t282))
+	t286 := complex(-imag(t285), real(t285))
+	t287 := t284 + t286
+	t288 := t284 - t286
+	t289 := complex(real(x14)-0.8090169943749475*real(t279)+0.30901699437494723*real(t281), imag(x14)-0.8090169943749475*imag(t279)+0.30901699437494723*imag(t281))
+	t290 := complex(0.5877852522924732*real(t280)-0.9510565162951536*real(t282), 0.5877852522924732*imag(t280)-0.9510565162951536*imag(t282))
+	t291 := complex(-imag(t290), real(t290))
+	t292 := t289 + t291
+	t293 := t289 - t291
+	t294 := t238 + t268
+	t295 := t238 - t268
+	t296 := t253 + t283
+	t297 := t253 - t283
+	t298 := complex(-imag(t297), real(t297))
+	t299 := t294 + t296
+	t300 := t295 + t298
+	t301 := t294 - t296
+	t302 := t295 - t298
+	t303 := w4 * t257
+	t304 := w8 * t272
+	t305 := w12 * t287
+	t306 := t242 + t304
+	t307 := t242 - t304
+	t308 := t303 + t305
+	t309 := t303 - t305
+	t310 := complex(-imag(t309), real(t309))
+	t311 := t306 + t308
+	t312 := t307 + t310
+	t313 := t306 - t308
+	t314 := t307 - t310
+	t315 := w8 * t262
+	t316 := w16 * t277
+	t317 := w24 * t292
+	t318 := t247 + t316
+	t319 := t247 - t316
+	t320 := t315 + t317
+	t321 := t315 - t317
+	t322 := complex(-imag(t321), real(t321))
+	t323 := t318 + t320
+	t324 := t319 + t322
+	t325 := t318 - t320
+	t326 := t319 - t322
+	t327 := w12 * t263
+	t328 := w24 * t278
+	t329 := w36 * t293
+	t330 := t248 + t328
+	t331 := t248 - t328
+	t332 := t327 + t329
+	t333 := t327 - t329
+	t334 := complex(-imag(t333), real(t333))
+	t335 := t330 + t332
+	t336 := t331 + t334
+	t337 := t330 - t332
+	t338 := t331 - t334
+	t339 := w16 * t258
+	t340 := w32 * t273
+	t341 := w48 * t288
+	t342 := t243 + t340
+	t343 := t243 - t340
+	t344 := t339 + t341
+	t345 := t339 - t341
+	t346 := complex(-imag(t345), real(t345))
+	t347 := t342 + t344
+	t348 := t343 + t346
+	t349 := t342 - t344
+	t350 := t343 - t346
+	t351 := x19 + x67
+	t352 := x19 - x67
+	t353 := x35 + x51
+	t354 := x35 - x51
+	t355 := x3 + t351 + t353
+	t356 := complex(real(x3)+0.30901699437494745*real(t351)-0.8090169943749475*real(t353), imag(x3)+0.30901699437494745*imag(t351)-0.8090169943749475*imag(t353))
+	t357 := complex(0.9510565162951535*real(t352)+0.5877852522924732*real(t354), 0.9510565162951535*imag(t352)+0.5877852522924732*imag(t354))
+	t358 := complex(-imag(t357), real(t357))
+	t359 := t356 + t358
+	t360 := t356 - t358
+	t361 := complex(real(x3)-0.8090169943749475*real(t351)+0.30901699437494723*real(t353), imag(x3)-0.8090169943749475*imag(t351)+0.30901699437494723*imag(t353))
+	t362 := complex(0.5877852522924732*real(t352)-0.9510565162951536*real(t354), 0.5877852522924732*imag(t352)-0.9510565162951536*imag(t354))
+	t363 := complex(-imag(t362), real(t362))
+	t364 := t361 + t363
+	t365 := t361 - t363
+	t366 := x23 + x71
+	t367 := x23 - x71
+	t368 := x39 + x55
+	t369 := x39 - x55
+	t370 := x7 + t366 + t368
+	t371 := complex(real(x7)+0.30901699437494745*real(t366)-0.8090169943749475*real(t368), imag(x7)+0.30901699437494745*imag(t366)-0.8090169943749475*imag(t368))
+	t372 := complex(0.9510565162951535*real(t367)+0.5877852522924732*real(t369), 0.9510565162951535*imag(t367)+0.5877852522924732*imag(t369))
+	t373 := complex(-imag(t372), real(t372))
+	t374 := t371 + t373
+	t375 := t371 - t373
+	t376 := complex(real(x7)-0.8090169943749475*real(t366)+0.30901699437494723*real(t368), imag(x7)-0.8090169943749475*imag(t366)+0.30901699437494723*imag(t368))
+	t377 := complex(0.5877852522924732*real(t367)-0.9510565162951536*real(t369), 0.5877852522924732*imag(t367)-0.9510565162951536*imag(t369))
+	t378 := complex(-imag(t377), real(t377))
+	t379 := t376 + t378
+	t380 := t376 - t378
+	t381 := x27 + x75
+	t382 := x27 - x75
+	t383 := x43 + x59
+	t384 := x43 - x59
+	t385 := x11 + t381 + t383
+	t386 := complex(real(x11)+0.30901699437494745*real(t381)-0.8090169943749475*real(t383), imag(x11)+0.30901699437494745*imag(t381)-0.8090169943749475*imag(t383))
+	t387 := complex(0.9510565162951535*real(t382)+0.5877852522924732*real(t384), 0.9510565162951535*imag(t382)+0.5877852522924732*imag(t384))
+	t388 := complex(-imag(t387), real(t387))
+	t389 := t386 + t388
+	t390 := t386 - t388
+	t391 := complex(real(x11)-0.8090169943749475*real(t381)+0.30901699437494723*real(t383), imag(x11)-0.8090169943749475*imag(t381)+0.30901699437494723*imag(t383))
+	t392 := complex(0.5877852522924732*real(t382)-0.9510565162951536*real(t384), 0.5877852522924732*imag(t382)-0.9510565162951536*imag(t384))
+	t393 := complex(-imag(t392), real(t392))
+	t394 := t391 + t393
+	t395 := t391 - t393
+	t396 := x31 + x79
+	t397 := x31 - x79
+	t398 := x47 + x63
+	t399 := x47 - x63
+	t400 := x15 + t396 + t398
+	t401 := complex(real(x15)+0.30901699437494745*real(t396)-0.8090169943749475*real(t398), imag(x15)+0.30901699437494745*imag(t396)-0.8090169943749475*imag(t398))
+	t402 := complex(0.9510565162951535*real(t397)+0.5877852522924732*real(t399), 0.9510565162951535*imag(t397)+0.5877852522924732*imag(t399))
+	t403 := complex(-imag(t402), real(t402))
+	t404 := t401 + t403
+	t405 := t401 - t403
+	t406 := complex(real(x15)-0.8090169943749475*real(t396)+0.30901699437494723*real(t398), imag(x15)-0.8090169943749475*imag(t396)+0.30901699437494723*imag(t398))
+	t407 := complex(0.5877852522924732*real(t397)-0.9510565162951536*real(t399), 0.5877852522924732*imag(t397)-0.9510565162951536*imag(t399))
+	t408 := complex(-imag(t407), real(t407))
+	t409 := t406 + t408
+	t410 := t406 - t408
+	t411 := t355 + t385
+	t412 := t355 - t385
+	t413 := t370 + t400
+	t414 := t370 - t400
+	t415 := complex(-imag(t414), real(t414))
+	t416 := t411 + t413
+	t417 := t412 + t415
+	t418 := t411 - t413
+	t419 := t412 - t415
+	t420 := w4 * t374
+	t421 := w8 * t389
+	t422 := w12 * t404
+	t423 := t359 + t421
+	t424 := t359 - t421
+	t425 := t420 + t422
+	t426 := t420 - t422
+	t427 := complex(-imag(t426), real(t426))
+	t428 := t423 + t425
+	t429 := t424 + t427
+	t430 := t423 - t425
+	t431 := t424 - t427
+	t432 := w8 * t379
+	t433 := w16 * t394
+	t434 := w24 * t409
+	t435 := t364 + t433
+	t436 := t364 - t433
+	t437 := t432 + t434
+	t438 := t432 - t434
+	t439 := complex(-imag(t438), real(t438))
+	t440 := t435 + t437
+	t441 := t436 + t439
+	t442 := t435 - t437
+	t443 := t436 - t439
+	t444 := w12 * t380
+	t445 := w24 * t395
+	t446 := w36 * t410
+	t447 := t365 + t445
+	t448 := t365 - t445
+	t449 := t444 + t446
+	t450 := t444 - t446
+	t451 := complex(-imag(t450), real(t450))
+	t452 := t447 + t449
+	t453 := t448 + t451
+	t454 := t447 - t449
+	t455 := t448 - t451
+	t456 := w16 * t375
+	t457 := w32 * t390
+	t458 := w48 * t405
+	t459 := t360 + t457
+	t460 := t360 - t457
+	t461 := t456 + t458
+	t462 := t456 - t458
+	t463 := complex(-imag(t462), real(t462))
+	t464 := t459 + t461
+	t465 := t460 + t463
+	t466 := t459 - t461
+	t467 := t460 - t463
+	t468 := t65 + t299
+	t469 := t65 - t299
+	t470 := t182 + t416
+	t471 := t182 - t416
+	t472 := complex(-imag(t471), real(t471))
+	t473 := t468 + t470
+	t474 := t469 + t472
+	t475 := t468 - t470
+	t476 := t469 - t472
+	t477 := w1 * t194
+	t478 := w2 * t311
+	t479 := w3 * t428
+	t480 := t77 + t478
+	t481 := t77 - t478
+	t482 := t477 + t479
+	t483 := t477 - t479
+	t484 := complex(-imag(t483), real(t483))
+	t485 := t480 + t482
+	t486 := t481 + t484
+	t487 := t480 - t482
+	t488 := t481 - t484
+	t489 := w2 * t206
+	t490 := w4 * t323
+	t491 := w6 * t440
+	t492 := t89 + t490
+	t493 := t89 - t490
+	t494 := t489 + t491
+	t495 := t489 - t491
+	t496 := complex(-imag(t495), real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := w3 * t218
+	t502 := w6 * t335
+	t503 := w9 * t452
+	t504 := t101 + t502
+	t505 := t101 - t502
+	t506 := t501 + t503
+	t507 := t501 - t503
+	t508 := complex(-imag(t507), real(t507))
+	t509 := t504 + t506
+	t510 := t505 + t508
+	t511 := t504 - t506
+	t512 := t505 - t508
+	t513 := w4 * t230
+	t514 := w8 * t347
+	t515 := w12 * t464
+	t516 := t113 + t514
+	t517 := t113 - t514
+	t518 := t513 + t515
+	t519 := t513 - t515
+	t520 := complex(-imag(t519), real(t519))
+	t521 := t516 + t518
+	t522 := t517 + t520
+	t523 := t516 - t518
+	t524 := t517 - t520
+	t525 := w5 * t183
+	t526 := w10 * t300
+	t527 := w15 * t417
+	t528 := t66 + t526
+	t529 := t66 - t526
+	t530 := t525 + t527
+	t531 := t525 - t527
+	t532 := complex(-imag(t531), real(t531))
+	t533 := t528 + t530
+	t534 := t529 + t532
+	t535 := t528 - t530
+	t536 := t529 - t532
+	t537 := w6 * t195
+	t538 := w12 * t312
+	t539 := w18 * t429
+	t540 := t78 + t538
+	t541 := t78 - t538
+	t542 := t537 + t539
+	t543 := t537 - t539
+	t544 := complex(-imag(t543), real(t543))
+	t545 := t540 + t542
+	t546 := t541 + t544
+	t547 := t540 - t542
+	t548 := t541 - t544
+	t549 := w7 * t207
+	t550 := w14 * t324
+	t551 := w21 * t441
+	t552 := t90 + t550
+	t553 := t90 - t550
+	t554 := t549 + t551
+	t555 := t549 - t551
+	t556 := complex(-imag(t555), real(t555))
+	t557 := t552 + t554
+	t558 := t553 + t556
+	t559 := t552 - t554
+	t560 := t553 - t556
+	t561 := w8 * t219
+	t562 := w16 * t336
+	t563 := w24 * t453
+	t564 := t102 + t562
+	t565 := t102 - t562
+	t566 := t561 + t563
+	t567 := t561 - t563
+	t568 := complex(-imag(t567), real(t567))
+	t569 := t564 + t566
+	t570 := t565 + t568
+	t571 := t564 - t566
+	t572 := t565 - t568
+	t573 := w9 * t231
+	t574 := w18 * t348
+	t575 := w27 * t465
+	t576 := t114 + t574
+	t577 := t114 - t574
+	t578 := t573 + t575
+	t579 := t573 - t575
+	t580 := complex(-imag(t579), real(t579))
+	t581 := t576 + t578
+	t582 := t577 + t580
+	t583 := t576 - t578
+	t584 := t577 - t580
+	t585 := w10 * t184
+	t586 := complex(-imag(t301), real(t301))
+	t587 := w30 * t418
+	t588 := t67 + t586
+	t589 := t67 - t586
+	t590 := t585 + t587
+	t591 := t585 - t587
+	t592 := complex(-imag(t591), real(t591))
+	t593 := t588 + t590
+	t594 := t589 + t592
+	t595 := t588 - t590
+	t596 := t589 - t592
+	t597 := w11 * t196
+	t598 := w22 * t313
+	t599 := w33 * t430
+	t600 := t79 + t598
+	t601 := t79 - t598
+	t602 := t597 + t599
+	t603 := t597 - t599
+	t604 := complex(-imag(t603), real(t603))
+	t605 := t600 + t602
+	t606 := t601 + t604
+	t607 := t600 - t602
+	t608 := t601 - t604
+	t609 := w12 * t208
+	t610 := w24 * t325
+	t611 := w36 * t442
+	t612 := t91 + t610
+	t613 := t91 - t610
+	t614 := t609 + t611
+	t615 := t609 - t611
+	t616 := complex(-imag(t615), real(t615))
+	t617 := t612 + t614
+	t618 := t613 + t616
+	t619 := t612 - t614
+	t620 := t613 - t616
+	t621 := w13 * t220
+	t622 := w26 * t337
+	t623 := w39 * t454
+	t624 := t103 + t622
+	t625 := t103 - t622
+	t626 := t621 + t623
+	t627 := t621 - t623
+	t628 := complex(-imag(t627), real(t627))
+	t629 := t624 + t626
+	t630 := t625 + t628
+	t631 := t624 - t626
+	t632 := t625 - t628
+	t633 := w14 * t232
+	t634 := w28 * t349
+	t635 := w42 * t466
+	t636 := t115 + t634
+	t637 := t115 - t634
+	t638 := t633 + t635
+	t639 := t633 - t635
+	t640 := complex(-imag(t639), real(t639))
+	t641 := t636 + t638
+	t642 := t637 + t640
+	t643 := t636 - t638
+	t644 := t637 - t640
+	t645 := w15 * t185
+	t646 := w30 * t302
+	t647 := w45 * t419
+	t648 := t68 + t646
+	t649 := t68 - t646
+	t650 := t645 + t647
+	t651 := t645 - t647
+	t652 := complex(-imag(t651), real(t651))
+	t653 := t648 + t650
+	t654 := t649 + t652
+	t655 := t648 - t650
+	t656 := t649 - t652
+	t657 := w16 * t197
+	t658 := w32 * t314
+	t659 := w48 * t431
+	t660 := t80 + t658
+	t661 := t80 - t658
+	t662 := t657 + t659
+	t663 := t657 - t659
+	t664 := complex(-imag(t663), real(t663))
+	t665 := t660 + t662
+	t666 := t661 + t664
+	t667 := t660 - t662
+	t668 := t661 - t664
+	t669 := w17 * t209
+	t670 := w34 * t326
+	t671 := w51 * t443
+	t672 := t92 + t670
+	t673 := t92 - t670
+	t674 := t669 + t671
+	t675 := t669 - t671
+	t676 := complex(-imag(t675), real(t675))
+	t677 := t672 + t674
+	t678 := t673 + t676
+	t679 := t672 - t674
+	t680 := t673 - t676
+	t681 := w18 * t221
+	t682 := w36 * t338
+	t683 := w54 * t455
+	t684 := t104 + t682
+	t685 := t104 - t682
+	t686 := t681 + t683
+	t687 := t681 - t683
+	t688 := complex(-imag(t687), real(t687))
+	t689 := t684 + t686
+	t690 := t685 + t688
+	t691 := t684 - t686
+	t692 := t685 - t688
+	t693 := w19 * t233
+	t694 := w38 * t350
+	t695 := w57 * t467
+	t696 := t116 + t694
+	t697 := t116 - t694
+	t698 := t693 + t695
+	t699 := t693 - t695
+	t700 := complex(-imag(t699), real(t699))
+	t701 := t696 + t698
+	t702 := t697 + t700
+	t703 := t696 - t698
+	t704 := t697 - t700
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t473)*scale, imag(t473)*scale)
+	y[1] = complex(real(t485)*scale, imag(t485)*scale)
+	y[2] = complex(real(t497)*scale, imag(t497)*scale)
+	y[3] = complex(real(t509)*scale, imag(t509)*scale)
+	y[4] = complex(real(t521)*scale, imag(t521)*scale)
+	y[5] = complex(real(t533)*scale, imag(t533)*scale)
+	y[6] = complex(real(t545)*scale, imag(t545)*scale)
+	y[7] = complex(real(t557)*scale, imag(t557)*scale)
+	y[8] = complex(real(t569)*scale, imag(t569)*scale)
+	y[9] = complex(real(t581)*scale, imag(t581)*scale)
+	y[10] = complex(real(t593)*scale, imag(t593)*scale)
+	y[11] = complex(real(t605)*scale, imag(t605)*scale)
+	y[12] = complex(real(t617)*scale, imag(t617)*scale)
+	y[13] = complex(real(t629)*scale, imag(t629)*scale)
+	y[14] = complex(real(t641)*scale, imag(t641)*scale)
+	y[15] = complex(real(t653)*scale, imag(t653)*scale)
+	y[16] = complex(real(t665)*scale, imag(t665)*scale)
+	y[17] = complex(real(t677)*scale, imag(t677)*scale)
+	y[18] = complex(real(t689)*scale, imag(t689)*scale)
+	y[19] = complex(real(t701)*scale, imag(t701)*scale)
+	y[20] = complex(real(t474)*scale, imag(t474)*scale)
+	y[21] = complex(real(t486)*scale, imag(t486)*scale)
+	y[22] = complex(real(t498)*scale, imag(t498)*scale)
+	y[23] = complex(real(t510)*scale, imag(t510)*scale)
+	y[24] = complex(real(t522)*scale, imag(t522)*scale)
+	y[25] = complex(real(t534)*scale, imag(t534)*scale)
+	y[26] = complex(real(t546)*scale, imag(t546)*scale)
+	y[27] = complex(real(t558)*scale, imag(t558)*scale)
+	y[28] = complex(real(t570)*scale, imag(t570)*scale)
+	y[29] = complex(real(t582)*scale, imag(t582)*scale)
+	y[30] = complex(real(t594)*scale, imag(t594)*scale)
+	y[31] = complex(real(t606)*scale, imag(t606)*scale)
+	y[32] = complex(real(t618)*scale, imag(t618)*scale)
+	y[33] = complex(real(t630)*scale, imag(t630)*scale)
+	y[34] = complex(real(t642)*scale, imag(t642)*scale)
+	y[35] = complex(real(t654)*scale, imag(t654)*scale)
+	y[36] = complex(real(t666)*scale, imag(t666)*scale)
+	y[37] = complex(real(t678)*scale, imag(t678)*scale)
+	y[38] = complex(real(t690)*scale, imag(t690)*scale)
+	y[39] = complex(real(t702)*scale, imag(t702)*scale)
+	y[40] = complex(real(t475)*scale, imag(t475)*scale)
+	y[41] = complex(real(t487)*scale, imag(t487)*scale)
+	y[42] = complex(real(t499)*scale, imag(t499)*scale)
+	y[43] = complex(real(t511)*scale, imag(t511)*scale)
+	y[44] = complex(real(t523)*scale, imag(t523)*scale)
+	y[45] = complex(real(t535)*scale, imag(t535)*scale)
+	y[46] = complex(real(t547)*scale, imag(t547)*scale)
+	y[47] = complex(real(t559)*scale, imag(t559)*scale)
+	y[48] = complex(real(t571)*scale, imag(t571)*scale)
+	y[49] = complex(real(t583)*scale, imag(t583)*scale)
+	y[50] = complex(real(t595)*scale, imag(t595)*scale)
+	y[51] = complex(real(t607)*scale, imag(t607)*scale)
+	y[52] = complex(real(t619)*scale, imag(t619)*scale)
+	y[53] = complex(real(t631)*scale, imag(t631)*scale)
+	y[54] = complex(real(t643)*scale, imag(t643)*scale)
+	y[55] = complex(real(t655)*scale, imag(t655)*scale)
+	y[56] = complex(real(t667)*scale, imag(t667)*scale)
+	y[57] = complex(real(t679)*scale, imag(t679)*scale)
+	y[58] = complex(real(t691)*scale, imag(t691)*scale)
+	y[59] = complex(real(t703)*scale, imag(t703)*scale)
+	y[60] = complex(real(t476)*scale, imag(t476)*scale)
+	y[61] = complex(real(t488)*scale, imag(t488)*scale)
+	y[62] = complex(real(t500)*scale, imag(t500)*scale)
+	y[63] = complex(real(t512)*scale, imag(t512)*scale)
+	y[64] = complex(real(t524)*scale, imag(t524)*scale)
+	y[65] = complex(real(t536)*scale, imag(t536)*scale)
+	y[66] = complex(real(t548)*scale, imag(t548)*scale)
+	y[67] = complex(real(t560)*scale, imag(t560)*scale)
+	y[68] = complex(real(t572)*scale, imag(t572)*scale)
+	y[69] = complex(real(t584)*scale, imag(t584)*scale)
+	y[70] = complex(real(t596)*scale, imag(t596)*scale)
+	y[71] = complex(real(t608)*scale, imag(t608)*scale)
+	y[72] = complex(real(t620)*scale, imag(t620)*scale)
+	y[73] = complex(real(t632)*scale, imag(t632)*scale)
+	y[74] = complex(real(t644)*scale, imag(t644)*scale)
+	y[75] = complex(real(t656)*scale, imag(t656)*scale)
+	y[76] = complex(real(t668)*scale, imag(t668)*scale)
+	y[77] = complex(real(t680)*scale, imag(t680)*scale)
+	y[78] = complex(real(t692)*scale, imag(t692)*scale)
+	y[79] = complex(real(t704)*scale, imag(t704)*scale)
+
+	return true
+}
+
+// forwardDIT80Radix4x4x5Complex128 computes a 80-point forward FFT of complex128 data
+// with radix-4, radix-4, radix-5 stages, fully unrolled.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func forwardDIT80Radix4x4x5Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 80
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := tw[4]
+	w8 := tw[8]
+	w12 := tw[12]
+	w16 := tw[16]
+	w24 := tw[24]
+	w36 := tw[36]
+	w32 := tw[32]
+	w48 := tw[48]
+	w1 := tw[1]
+	w2 := tw[2]
+	w3 := tw[3]
+	w6 := tw[6]
+	w9 := tw[9]
+	w5 := tw[5]
+	w10 := tw[10]
+	w15 := tw[15]
+	w18 := tw[18]
+	w7 := tw[7]
+	w14 := tw[14]
+	w21 := tw[21]
+	w27 := tw[27]
+	w30 := tw[30]
+	w11 := tw[11]
+	w22 := tw[22]
+	w33 := tw[33]
+	w13 := tw[13]
+	w26 := tw[26]
+	w39 := tw[39]
+	w28 := tw[28]
+	w42 := tw[42]
+	w45 := tw[45]
+	w17 := tw[17]
+	w34 := tw[34]
+	w51 := tw[51]
+	w54 := tw[54]
+	w19 := tw[19]
+	w38 := tw[38]
+	w57 := tw[57]
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+	x48 := x[48]
+	x49 := x[49]
+	x50 := x[50]
+	x51 := x[51]
+	x52 := x[52]
+	x53 := x[53]
+	x54 := x[54]
+	x55 := x[55]
+	x56 := x[56]
+	x57 := x[57]
+	x58 := x[58]
+	x59 := x[59]
+	x60 := x[60]
+	x61 := x[61]
+	x62 := x[62]
+	x63 := x[63]
+	x64 := x[64]
+	x65 := x[65]
+	x66 := x[66]
+	x67 := x[67]
+	x68 := x[68]
+	x69 := x[69]
+	x70 := x[70]
+	x71 := x[71]
+	x72 := x[72]
+	x73 := x[73]
+	x74 := x[74]
+	x75 := x[75]
+	x76 := x[76]
+	x77 := x[77]
+	x78 := x[78]
+	x79 := x[79]
+
+	t0 := x16 + x64
+	t1 := x16 - x64
+	t2 := x32 + x48
+	t3 := x32 - x48
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.8090169943749475*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.8090169943749475*imag(t2))
+	t6 := complex(0.9510565162951535*real(t1)+0.5877852522924732*real(t3), 0.9510565162951535*imag(t1)+0.5877852522924732*imag(t3))
+	t7 := complex(imag(t6), -real(t6))
+	t8 := t5 + t7
+	t9 := t5 - t7
+	t10 := complex(real(x0)-0.8090169943749475*real(t0)+0.30901699437494723*real(t2), imag(x0)-0.8090169943749475*imag(t0)+0.30901699437494723*imag(t2))
+	t11 := complex(0.5877852522924732*real(t1)-0.9510565162951536*real(t3), 0.5877852522924732*imag(t1)-0.9510565162951536*imag(t3))
+	t12 := complex(imag(t11), -real(t11))
+	t13 := t10 + t12
+	t14 := t10 - t12
+	t15 := x20 + x68
+	t16 := x20 - x68
+	t17 := x36 + x52
+	t18 := x36 - x52
+	t19 := x4 + t15 + t17
+	t20 := complex(real(x4)+0.30901699437494745*real(t15)-0.8090169943749475*real(t17), imag(x4)+0.30901699437494745*imag(t15)-0.8090169943749475*imag(t17))
+	t21 := complex(0.9510565162951535*real(t16)+0.5877852522924732*real(t18), 0.9510565162951535*imag(t16)+0.5877852522924732*imag(t18))
+	t22 := complex(imag(t21), -real(t21))
+	t23 := t20 + t22
+	t24 := t20 - t22
+	t25 := complex(real(x4)-0.8090169943749475*real(t15)+0.30901699437494723*real(t17), imag(x4)-0.8090169943749475*imag(t15)+0.30901699437494723*imag(t17))
+	t26 := complex(0.5877852522924732*real(t16)-0.9510565162951536*real(t18), 0.5877852522924732*imag(t16)-0.9510565162951536*imag(t18))
+	t27 := complex(imag(t26), -real(t26))
+	t28 := t25 + t27
+	t29 := t25 - t27
+	t30 := x24 + x72
+	t31 := x24 - x72
+	t32 := x40 + x56
+	t33 := x40 - x56
+	t34 := x8 + t30 + t32
+	t35 := complex(real(x8)+0.30901699437494745*real(t30)-0.8090169943749475*real(t32), imag(x8)+0.30901699437494745*imag(t30)-0.8090169943749475*imag(t32))
+	t36 := complex(0.9510565162951535*real(t31)+0.5877852522924732*real(t33), 0.9510565162951535*imag(t31)+0.5877852522924732*imag(t33))
+	t37 := complex(imag(t36), -real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := complex(real(x8)-0.8090169943749475*real(t30)+0.30901699437494723*real(t32), imag(x8)-0.8090169943749475*imag(t30)+0.30901699437494723*imag(t32))
+	t41 := complex(0.5877852522924732*real(t31)-0.9510565162951536*real(t33), 0.5877852522924732*imag(t31)-0.9510565162951536*imag(t33))
+	t42 := complex(imag(t41), -real(t41))
+	t43 := t40 + t42
+	t44 := t40 - t42
+	t45 := x28 + x76
+	t46 := x28 - x76
+	t47 := x44 + x60
+	t48 := x44 - x60
+	t49 := x12 + t45 + t47
+	t50 := complex(real(x12)+0.30901699437494745*real(t45)-0.8090169943749475*real(t47), imag(x12)+0.30901699437494745*imag(t45)-0.8090169943749475*imag(t47))
+	t51 := complex(0.9510565162951535*real(t46)+0.5877852522924732*real(t48), 0.9510565162951535*imag(t46)+0.5877852522924732*imag(t48))
+	t52 := complex(imag(t51), -real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := complex(real(x12)-0.8090169943749475*real(t45)+0.30901699437494723*real(t47), imag(x12)-0.8090169943749475*imag(t45)+0.30901699437494723*imag(t47))
+	t56 := complex(0.5877852522924732*real(t46)-0.9510565162951536*real(t48), 0.5877852522924732*imag(t46)-0.9510565162951536*imag(t48))
+	t57 := complex(imag(t56), -real(t56))
+	t58 := t55 + t57
+	t59 := t55 - t57
+	t60 := t4 + t34
+	t61 := t4 - t34
+	t62 := t19 + t49
+	t63 := t19 - t49
+	t64 := complex(imag(t63), -real(t63))
+	t65 := t60 + t62
+	t66 := t61 + t64
+	t67 := t60 - t62
+	t68 := t61 - t64
+	t69 := w4 * t23
+	t70 := w8 * t38
+	t71 := w12 * t53
+	t72 := t8 + t70
+	t73 := t8 - t70
+	t74 := t69 + t71
+	t75 := t69 - t71
+	t76 := complex(imag(t75), -real(t75))
+	t77 := t72 + t74
+	t78 := t73 + t76
+	t79 := t72 - t74
+	t80 := t73 - t76
+	t81 := w8 * t28
+	t82 := w16 * t43
+	t83 := w24 * t58
+	t84 := t13 + t82
+	t85 := t13 - t82
+	t86 := t81 + t83
+	t87 := t81 - t83
+	t88 := complex(imag(t87), -real(t87))
+	t89 := t84 + t86
+	t90 := t85 + t88
+	t91 := t84 - t86
+	t92 := t85 - t88
+	t93 := w12 * t29
+	t94 := w24 * t44
+	t95 := w36 * t59
+	t96 := t14 + t94
+	t97 := t14 - t94
+	t98 := t93 + t95
+	t99 := t93 - t95
+	t100 := complex(imag(t99), -real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w16 * t24
+	t106 := w32 * t39
+	t107 := w48 * t54
+	t108 := t9 + t106
+	t109 := t9 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(imag(t111), -real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+	t117 := x17 + x65
+	t118 := x17 - x65
+	t119 := x33 + x49
+	t120 := x33 - x49
+	t121 := x1 + t117 + t119
+	t122 := complex(real(x1)+0.30901699437494745*real(t117)-0.8090169943749475*real(t119), imag(x1)+0.30901699437494745*imag(t117)-0.8090169943749475*imag(t119))
+	t123 := complex(0.9510565162951535*real(t118)+0.5877852522924732*real(t120), 0.9510565162951535*imag(t118)+0.5877852522924732*imag(t120))
+	t124 := complex(imag(t123), -real(t123))
+	t125 := t122 + t124
+	t126 := t122 - t124
+	t127 := complex(real(x1)-0.8090169943749475*real(t117)+0.30901699437494723*real(t119), imag(x1)-0.8090169943749475*imag(t117)+0.30901699437494723*imag(t119))
+	t128 := complex(0.5877852522924732*real(t118)-0.9510565162951536*real(t120), 0.5877852522924732*imag(t118)-0.9510565162951536*imag(t120))
+	t129 := complex(imag(t128), -real(t128))
+	t130 := t127 + t129
+	t131 := t127 - t129
+	t132 := x21 + x69
+	t133 := x21 - x69
+	t134 := x37 + x53
+	t135 := x37 - x53
+	t136 := x5 + t132 + t134
+	t137 := complex(real(x5)+0.30901699437494745*real(t132)-0.8090169943749475*real(t134), imag(x5)+0.30901699437494745*imag(t132)-0.8090169943749475*imag(t134))
+	t138 := complex(0.9510565162951535*real(t133)+0.5877852522924732*real(t135), 0.9510565162951535*imag(t133)+0.5877852522924732*imag(t135))
+	t139 := complex(imag(t138), -real(t138))
+	t140 := t137 + t139
+	t141 := t137 - t139
+	t142 := complex(real(x5)-0.8090169943749475*real(t132)+0.30901699437494723*real(t134), imag(x5)-0.8090169943749475*imag(t132)+0.30901699437494723*imag(t134))
+	t143 := complex(0.5877852522924732*real(t133)-0.9510565162951536*real(t135), 0.5877852522924732*imag(t133)-0.9510565162951536*imag(t135))
+	t144 := complex(imag(t143), -real(t143))
+	t145 := t142 + t144
+	t146 := t142 - t144
+	t147 := x25 + x73
+	t148 := x25 - x73
+	t149 := x41 + x57
+	t150 := x41 - x57
+	t151 := x9 + t147 + t149
+	t152 := complex(real(x9)+0.30901699437494745*real(t147)-0.8090169943749475*real(t149), imag(x9)+0.30901699437494745*imag(t147)-0.8090169943749475*imag(t149))
+	t153 := complex(0.9510565162951535*real(t148)+0.5877852522924732*real(t150), 0.9510565162951535*imag(t148)+0.5877852522924732*imag(t150))
+	t154 := complex(imag(t153), -real(t153))
+	t155 := t152 + t154
+	t156 := t152 - t154
+	t157 := complex(real(x9)-0.8090169943749475*real(t147)+0.30901699437494723*real(t149), imag(x9)-0.8090169943749475*imag(t147)+0.30901699437494723*imag(t149))
+	t158 := complex(0.5877852522924732*real(t148)-0.9510565162951536*real(t150), 0.5877852522924732*imag(t148)-0.9510565162951536*imag(t150))
+	t159 := complex(imag(t158), -real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := x29 + x77
+	t163 := x29 - x77
+	t164 := x45 + x61
+	t165 := x45 - x61
+	t166 := x13 + t162 + t164
+	t167 := complex(real(x13)+0.30901699437494745*real(t162)-0.8090169943749475*real(t164), imag(x13)+0.30901699437494745*imag(t162)-0.8090169943749475*imag(t164))
+	t168 := complex(0.9510565162951535*real(t163)+0.5877852522924732*real(t165), 0.9510565162951535*imag(t163)+0.5877852522924732*imag(t165))
+	t169 := complex(imag(t168), -real(t168))
+	t170 := t167 + t169
+	t171 := t167 - t169
+	t172 := complex(real(x13)-0.8090169943749475*real(t162)+0.30901699437494723*real(t164), imag(x13)-0.8090169943749475*imag(t162)+0.30901699437494723*imag(t164))
+	t173 := complex(0.5877852522924732*real(t163)-0.9510565162951536*real(t165), 0.5877852522924732*imag(t163)-0.9510565162951536*imag(t165))
+	t174 := complex(imag(t173), -real(t173))
+	t175 := t172 + t174
+	t176 := t172 - t174
+	t177 := t121 + t151
+	t178 := t121 - t151
+	t179 := t136 + t166
+	t180 := t136 - t166
+	t181 := complex(imag(t180), -real(t180))
+	t182 := t177 + t179
+	t183 := t178 + t181
+	t184 := t177 - t179
+	t185 := t178 - t181
+	t186 := w4 * t140
+	t187 := w8 * t155
+	t188 := w12 * t170
+	t189 := t125 + t187
+	t190 := t125 - t187
+	t191 := t186 + t188
+	t192 := t186 - t188
+	t193 := complex(imag(t192), -real(t192))
+	t194 := t189 + t191
+	t195 := t190 + t193
+	t196 := t189 - t191
+	t197 := t190 - t193
+	t198 := w8 * t145
+	t199 := w16 * t160
+	t200 := w24 * t175
+	t201 := t130 + t199
+	t202 := t130 - t199
+	t203 := t198 + t200
+	t204 := t198 - t200
+	t205 := complex(imag(t204), -real(t204))
+	t206 := t201 + t203
+	t207 := t202 + t205
+	t208 := t201 - t203
+	t209 := t202 - t205
+	t210 := w12 * t146
+	t211 := w24 * t161
+	t212 := w36 * t176
+	t213 := t131 + t211
+	t214 := t131 - t211
+	t215 := t210 + t212
+	t216 := t210 - t212
+	t217 := complex(imag(t216), -real(t216))
+	t218 := t213 + t215
+	t219 := t214 + t217
+	t220 := t213 - t215
+	t221 := t214 - t217
+	t222 := w16 * t141
+	t223 := w32 * t156
+	t224 := w48 * t171
+	t225 := t126 + t223
+	t226 := t126 - t223
+	t227 := t222 + t224
+	t228 := t222 - t224
+	t229 := complex(imag(t228), -real(t228))
+	t230 := t225 + t227
+	t231 := t226 + t229
+	t232 := t225 - t227
+	t233 := t226 - t229
+	t234 := x18 + x66
+	t235 := x18 - x66
+	t236 := x34 + x50
+	t237 := x34 - x50
+	t238 := x2 + t234 + t236
+	t239 := complex(real(x2)+0.30901699437494745*real(t234)-0.8090169943749475*real(t236), imag(x2)+0.30901699437494745*imag(t234)-0.8090169943749475*imag(t236))
+	t240 := complex(0.9510565162951535*real(t235)+0.5877852522924732*real(t237), 0.9510565162951535*imag(t235)+0.5877852522924732*imag(t237))
+	t241 := complex(imag(t240), -real(t240))
+	t242 := t239 + t241
+	t243 := t239 - t241
+	t244 := complex(real(x2)-0.8090169943749475*real(t234)+0.30901699437494723*real(t236), imag(x2)-0.8090169943749475*imag(t234)+0.30901699437494723*imag(t236))
+	t245 := complex(0.5877852522924732*real(t235)-0.9510565162951536*real(t237), 0.5877852522924732*imag(t235)-0.9510565162951536*imag(t237))
+	t246 := complex(imag(t245), -real(t245))
+	t247 := t244 + t246
+	t248 := t244 - t246
+	t249 := x22 + x70
+	t250 := x22 - x70
+	t251 := x38 + x54
+	t252 := x38 - x54
+	t253 := x6 + t249 + t251
+	t254 := complex(real(x6)+0.30901699437494745*real(t249)-0.8090169943749475*real(t251), imag(x6)+0.30901699437494745*imag(t249)-0.8090169943749475*imag(t251))
+	t255 := complex(0.9510565162951535*real(t250)+0.5877852522924732*real(t252), 0.9510565162951535*imag(t250)+0.5877852522924732*imag(t252))
+	t256 := complex(imag(t255), -real(t255))
+	t257 := t254 + t256
+	t258 := t254 - t256
+	t259 := complex(real(x6)-0.8090169943749475*real(t249)+0.30901699437494723*real(t251), imag(x6)-0.8090169943749475*imag(t249)+0.30901699437494723*imag(t251))
+	t260 := complex(0.5877852522924732*real(t250)-0.9510565162951536*real(t252), 0.5877852522924732*imag(t250)-0.9510565162951536*imag(t252))
+	t261 := complex(imag(t260), -real(t260))
+	t262 := t259 + t261
+	t263 := t259 - t261
+	t264 := x26 + x74
+	t265 := x26 - x74
+	t266 := x42 + x58
+	t267 := x42 - x58
+	t268 := x10 + t264 + t266
+	t269 := complex(real(x10)+0.30901699437494745*real(t264)-0.8090169943749475*real(t266), imag(x10)+0.30901699437494745*imag(t264)-0.8090169943749475*imag(t266))
+	t270 := complex(0.9510565162951535*real(t265)+0.5877852522924732*real(t267), 0.9510565162951535*imag(t265)+0.5877852522924732*imag(t267))
+	t271 := complex(imag(t270), -real(t270))
+	t272 := t269 + t271
+	t273 := t269 - t271
+	t274 := complex(real(x10)-0.8090169943749475*real(t264)+0.30901699437494723*real(t266), imag(x10)-0.8090169943749475*imag(t264)+0.30901699437494723*imag(t266))
+	t275 := complex(0.5877852522924732*real(t265)-0.9510565162951536*real(t267), 0.5877852522924732*imag(t265)-0.9510565162951536*imag(t267))
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t274 + t276
+	t278 := t274 - t276
+	t279 := x30 + x78
+	t280 := x30 - x78
+	t281 := x46 + x62
+	t282 := x46 - x62
+	t283 := x14 + t279 + t281
+	t284 := complex(real(x14)+0.30901699437494745*real(t279)-0.8090169943749475*real(t281), imag(x14)+0.30901699437494745*imag(t279)-0.8090169943749475*imag(t281))
+	t285 := complex(0.9510565162951535*real(t280)+0.5877852522924732*real(t282), 0.9510565162951535*imag(t280)+0.5877852522924732*imag(t282))
+	t286 := complex(imag(t285), -real(t285))
+	t287 := t284 + t286
+	t288 := t284 - t286
+	t289 := complex(real(x14)-0.8090169943749475*real(t279)+0.30901699437494723*real(t281), imag(x14)-0.8090169943749475*imag(t279)+0.30901699437494723*imag(t281))
+	t290 := complex(0.5877852522924732*real(t280)-0.9510565162951536*real(t282), 0.5877852522924732*imag(t280)-0.9510565162951536*imag(t282))
+	t291 := complex(imag(t290), -real(t290))
+	t292 := t289 + t291
+	t293 := t289 - t291
+	t294 := t238 + t268
+	t295 := t238 - t268
+	t296 := t253 + t283
+	t297 := t253 - t283
+	t298 := complex(imag(t297), -real(t297))
+	t299 := t294 + t296
+	t300 := t295 + t298
+	t301 := t294 - t296
+	t302 := t295 - t298
+	t303 := w4 * t257
+	t304 := w8 * t272
+	t305 := w12 * t287
+	t306 := t242 + t304
+	t307 := t242 - t304
+	t308 := t303 + t305
+	t309 := t303 - t305
+	t310 := complex(imag(t309), -real(t309))
+	t311 := t306 + t308
+	t312 := t307 + t310
+	t313 := t306 - t308
+	t314 := t307 - t310
+	t315 := w8 * t262
+	t316 := w16 * t277
+	t317 := w24 * t292
+	t318 := t247 + t316
+	t319 := t247 - t316
+	t320 := t315 + t317
+	t321 := t315 - t317
+	t322 := complex(imag(t321), -real(t321))
+	t323 := t318 + t320
+	t324 := t319 + t322
+	t325 := t318 - t320
+	t326 := t319 - t322
+	t327 := w12 * t263
+	t328 := w24 * t278
+	t329 := w36 * t293
+	t330 := t248 + t328
+	t331 := t248 - t328
+	t332 := t327 + t329
+	t333 := t327 - t329
+	t334 := complex(imag(t333), -real(t333))
+	t335 := t330 + t332
+	t336 := t331 + t334
+	t337 := t330 - t332
+	t338 := t331 - t334
+	t339 := w16 * t258
+	t340 := w32 * t273
+	t341 := w48 * t288
+	t342 := t243 + t340
+	t343 := t243 - t340
+	t344 := t339 + t341
+	t345 := t339 - t341
+	t346 := complex(imag(t345), -real(t345))
+	t347 := t342 + t344
+	t348 := t343 + t346
+	t349 := t342 - t344
+	t350 := t343 - t346
+	t351 := x19 + x67
+	t352 := x19 - x67
+	t353 := x35 + x51
+	t354 := x35 - x51
+	t355 := x3 + t351 + t353
+	t356 := complex(real(x3)+0.30901699437494745*real(t351)-0.8090169943749475*real(t353), imag(x3)+0.30901699437494745*imag(t351)-0.8090169943749475*imag(t353))
+	t357 := complex(0.9510565162951535*real(t352)+0.5877852522924732*real(t354), 0.9510565162951535*imag(t352)+0.5877852522924732*imag(t354))
+	t358 := complex(imag(t357), -real(t357))
+	t359 := t356 + t358
+	t360 := t356 - t358
+	t361 := complex(real(x3)-0.8090169943749475*real(t351)+0.30901699437494723*real(t353), imag(x3)-0.8090169943749475*imag(t351)+0.30901699437494723*imag(t353))
+	t362 := complex(0.5877852522924732*real(t352)-0.9510565162951536*real(t354), 0.5877852522924732*imag(t352)-0.9510565162951536*imag(t354))
+	t363 := complex(imag(t362), -real(t362))
+	t364 := t361 + t363
+	t365 := t361 - t363
+	t366 := x23 + x71
+	t367 := x23 - x71
+	t368 := x39 + x55
+	t369 := x39 - x55
+	t370 := x7 + t366 + t368
+	t371 := complex(real(x7)+0.30901699437494745*real(t366)-0.8090169943749475*real(t368), imag(x7)+0.30901699437494745*imag(t366)-0.8090169943749475*imag(t368))
+	t372 := complex(0.9510565162951535*real(t367)+0.5877852522924732*real(t369), 0.9510565162951535*imag(t367)+0.5877852522924732*imag(t369))
+	t373 := complex(imag(t372), -real(t372))
+	t374 := t371 + t373
+	t375 := t371 - t373
+	t376 := complex(real(x7)-0.8090169943749475*real(t366)+0.30901699437494723*real(t368), imag(x7)-0.8090169943749475*imag(t366)+0.30901699437494723*imag(t368))
+	t377 := complex(0.5877852522924732*real(t367)-0.9510565162951536*real(t369), 0.5877852522924732*imag(t367)-0.9510565162951536*imag(t369))
+	t378 := complex(imag(t377), -real(t377))
+	t379 := t376 + t378
+	t380 := t376 - t378
+	t381 := x27 + x75
+	t382 := x27 - x75
+	t383 := x43 + x59
+	t384 := x43 - x59
+	t385 := x11 + t381 + t383
+	t386 := complex(real(x11)+0.30901699437494745*real(t381)-0.8090169943749475*real(t383), imag(x11)+0.30901699437494745*imag(t381)-0.8090169943749475*imag(t383))
+	t387 := complex(0.9510565162951535*real(t382)+0.5877852522924732*real(t384), 0.9510565162951535*imag(t382)+0.5877852522924732*imag(t384))
+	t388 := complex(imag(t387), -real(t387))
+	t389 := t386 + t388
+	t390 := t386 - t388
+	t391 := complex(real(x11)-0.8090169943749475*real(t381)+0.30901699437494723*real(t383), imag(x11)-0.8090169943749475*imag(t381)+0.30901699437494723*imag(t383))
+	t392 := complex(0.5877852522924732*real(t382)-0.9510565162951536*real(t384), 0.5877852522924732*imag(t382)-0.9510565162951536*imag(t384))
+	t393 := complex(imag(t392), -real(t392))
+	t394 := t391 + t393
+	t395 := t391 - t393
+	t396 := x31 + x79
+	t397 := x31 - x79
+	t398 := x47 + x63
+	t399 := x47 - x63
+	t400 := x15 + t396 + t398
+	t401 := complex(real(x15)+0.30901699437494745*real(t396)-0.8090169943749475*real(t398), imag(x15)+0.30901699437494745*imag(t396)-0.8090169943749475*imag(t398))
+	t402 := complex(0.9510565162951535*real(t397)+0.5877852522924732*real(t399), 0.9510565162951535*imag(t397)+0.5877852522924732*imag(t399))
+	t403 := complex(imag(t402), -real(t402))
+	t404 := t401 + t403
+	t405 := t401 - t403
+	t406 := complex(real(x15)-0.8090169943749475*real(t396)+0.30901699437494723*real(t398), imag(x15)-0.8090169943749475*imag(t396)+0.30901699437494723*imag(t398))
+	t407 := complex(0.5877852522924732*real(t397)-0.9510565162951536*real(t399), 0.5877852522924732*imag(t397)-0.9510565162951536*imag(t399))
+	t408 := complex(imag(t407), -real(t407))
+	t409 := t406 + t408
+	t410 := t406 - t408
+	t411 := t355 + t385
+	t412 := t355 - t385
+	t413 := t370 + t400
+	t414 := t370 - t400
+	t415 := complex(imag(t414), -real(t414))
+	t416 := t411 + t413
+	t417 := t412 + t415
+	t418 := t411 - t413
+	t419 := t412 - t415
+	t420 := w4 * t374
+	t421 := w8 * t389
+	t422 := w12 * t404
+	t423 := t359 + t421
+	t424 := t359 - t421
+	t425 := t420 + t422
+	t426 := t420 - t422
+	t427 := complex(imag(t426), -real(t426))
+	t428 := t423 + t425
+	t429 := t424 + t427
+	t430 := t423 - t425
+	t431 := t424 - t427
+	t432 := w8 * t379
+	t433 := w16 * t394
+	t434 := w24 * t409
+	t435 := t364 + t433
+	t436 := t364 - t433
+	t437 := t432 + t434
+	t438 := t432 - t434
+	t439 := complex(imag(t438), -real(t438))
+	t440 := t435 + t437
+	t441 := t436 + t439
+	t442 := t435 - t437
+	t443 := t436 - t439
+	t444 := w12 * t380
+	t445 := w24 * t395
+	t446 := w36 * t410
+	t447 := t365 + t445
+	t448 := t365 - t445
+	t449 := t444 + t446
+	t450 := t444 - t446
+	t451 := complex(imag(t450), -real(t450))
+	t452 := t447 + t449
+	t453 := t448 + t451
+	t454 := t447 - t449
+	t455 := t448 - t451
+	t456 := w16 * t375
+	t457 := w32 * t390
+	t458 := w48 * t405
+	t459 := t360 + t457
+	t460 := t360 - t457
+	t461 := t456 + t458
+	t462 := t456 - t458
+	t463 := complex(imag(t462), -real(t462))
+	t464 := t459 + t461
+	t465 := t460 + t463
+	t466 := t459 - t461
+	t467 := t460 - t463
+	t468 := t65 + t299
+	t469 := t65 - t299
+	t470 := t182 + t416
+	t471 := t182 - t416
+	t472 := complex(imag(t471), -real(t471))
+	t473 := t468 + t470
+	t474 := t469 + t472
+	t475 := t468 - t470
+	t476 := t469 - t472
+	t477 := w1 * t194
+	t478 := w2 * t311
+	t479 := w3 * t428
+	t480 := t77 + t478
+	t481 := t77 - t478
+	t482 := t477 + t479
+	t483 := t477 - t479
+	t484 := complex(imag(t483), -real(t483))
+	t485 := t480 + t482
+	t486 := t481 + t484
+	t487 := t480 - t482
+	t488 := t481 - t484
+	t489 := w2 * t206
+	t490 := w4 * t323
+	t491 := w6 * t440
+	t492 := t89 + t490
+	t493 := t89 - t490
+	t494 := t489 + t491
+	t495 := t489 - t491
+	t496 := complex(imag(t495), -real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := w3 * t218
+	t502 := w6 * t335
+	t503 := w9 * t452
+	t504 := t101 + t502
+	t505 := t101 - t502
+	t506 := t501 + t503
+	t507 := t501 - t503
+	t508 := complex(imag(t507), -real(t507))
+	t509 := t504 + t506
+	t510 := t505 + t508
+	t511 := t504 - t506
+	t512 := t505 - t508
+	t513 := w4 * t230
+	t514 := w8 * t347
+	t515 := w12 * t464
+	t516 := t113 + t514
+	t517 := t113 - t514
+	t518 := t513 + t515
+	t519 := t513 - t515
+	t520 := complex(imag(t519), -real(t519))
+	t521 := t516 + t518
+	t522 := t517 + t520
+	t523 := t516 - t518
+	t524 := t517 - t520
+	t525 := w5 * t183
+	t526 := w10 * t300
+	t527 := w15 * t417
+	t528 := t66 + t526
+	t529 := t66 - t526
+	t530 := t525 + t527
+	t531 := t525 - t527
+	t532 := complex(imag(t531), -real(t531))
+	t533 := t528 + t530
+	t534 := t529 + t532
+	t535 := t528 - t530
+	t536 := t529 - t532
+	t537 := w6 * t195
+	t538 := w12 * t312
+	t539 := w18 * t429
+	t540 := t78 + t538
+	t541 := t78 - t538
+	t542 := t537 + t539
+	t543 := t537 - t539
+	t544 := complex(imag(t543), -real(t543))
+	t545 := t540 + t542
+	t546 := t541 + t544
+	t547 := t540 - t542
+	t548 := t541 - t544
+	t549 := w7 * t207
+	t550 := w14 * t324
+	t551 := w21 * t441
+	t552 := t90 + t550
+	t553 := t90 - t550
+	t554 := t549 + t551
+	t555 := t549 - t551
+	t556 := complex(imag(t555), -real(t555))
+	t557 := t552 + t554
+	t558 := t553 + t556
+	t559 := t552 - t554
+	t560 := t553 - t556
+	t561 := w8 * t219
+	t562 := w16 * t336
+	t563 := w24 * t453
+	t564 := t102 + t562
+	t565 := t102 - t562
+	t566 := t561 + t563
+	t567 := t561 - t563
+	t568 := complex(imag(t567), -real(t567))
+	t569 := t564 + t566
+	t570 := t565 + t568
+	t571 := t564 - t566
+	t572 := t565 - t568
+	t573 := w9 * t231
+	t574 := w18 * t348
+	t575 := w27 * t465
+	t576 := t114 + t574
+	t577 := t114 - t574
+	t578 := t573 + t575
+	t579 := t573 - t575
+	t580 := complex(imag(t579), -real(t579))
+	t581 := t576 + t578
+	t582 := t577 + t580
+	t583 := t576 - t578
+	t584 := t577 - t580
+	t585 := w10 * t184
+	t586 := complex(imag(t301), -real(t301))
+	t587 := w30 * t418
+	t588 := t67 + t586
+	t589 := t67 - t586
+	t590 := t585 + t587
+	t591 := t585 - t587
+	t592 := complex(imag(t591), -real(t591))
+	t593 := t588 + t590
+	t594 := t589 + t592
+	t595 := t588 - t590
+	t596 := t589 - t592
+	t597 := w11 * t196
+	t598 := w22 * t313
+	t599 := w33 * t430
+	t600 := t79 + t598
+	t601 := t79 - t598
+	t602 := t597 + t599
+	t603 := t597 - t599
+	t604 := complex(imag(t603), -real(t603))
+	t605 := t600 + t602
+	t606 := t601 + t604
+	t607 := t600 - t602
+	t608 := t601 - t604
+	t609 := w12 * t208
+	t610 := w24 * t325
+	t611 := w36 * t442
+	t612 := t91 + t610
+	t613 := t91 - t610
+	t614 := t609 + t611
+	t615 := t609 - t611
+	t616 := complex(imag(t615), -real(t615))
+	t617 := t612 + t614
+	t618 := t613 + t616
+	t619 := t612 - t614
+	t620 := t613 - t616
+	t621 := w13 * t220
+	t622 := w26 * t337
+	t623 := w39 * t454
+	t624 := t103 + t622
+	t625 := t103 - t622
+	t626 := t621 + t623
+	t627 := t621 - t623
+	t628 := complex(imag(t627), -real(t627))
+	t629 := t624 + t626
+	t630 := t625 + t628
+	t631 := t624 - t626
+	t632 := t625 - t628
+	t633 := w14 * t232
+	t634 := w28 * t349
+	t635 := w42 * t466
+	t636 := t115 + t634
+	t637 := t115 - t634
+	t638 := t633 + t635
+	t639 := t633 - t635
+	t640 := complex(imag(t639), -real(t639))
+	t641 := t636 + t638
+	t642 := t637 + t640
+	t643 := t636 - t638
+	t644 := t637 - t640
+	t645 := w15 * t185
+	t646 := w30 * t302
+	t647 := w45 * t419
+	t648 := t68 + t646
+	t649 := t68 - t646
+	t650 := t645 + t647
+	t651 := t645 - t647
+	t652 := complex(imag(t651), -real(t651))
+	t653 := t648 + t650
+	t654 := t649 + t652
+	t655 := t648 - t650
+	t656 := t649 - t652
+	t657 := w16 * t197
+	t658 := w32 * t314
+	t659 := w48 * t431
+	t660 := t80 + t658
+	t661 := t80 - t658
+	t662 := t657 + t659
+	t663 := t657 - t659
+	t664 := complex(imag(t663), -real(t663))
+	t665 := t660 + t662
+	t666 := t661 + t664
+	t667 := t660 - t662
+	t668 := t661 - t664
+	t669 := w17 * t209
+	t670 := w34 * t326
+	t671 := w51 * t443
+	t672 := t92 + t670
+	t673 := t92 - t670
+	t674 := t669 + t671
+	t675 := t669 - t671
+	t676 := complex(imag(t675), -real(t675))
+	t677 := t672 + t674
+	t678 := t673 + t676
+	t679 := t672 - t674
+	t680 := t673 - t676
+	t681 := w18 * t221
+	t682 := w36 * t338
+	t683 := w54 * t455
+	t684 := t104 + t682
+	t685 := t104 - t682
+	t686 := t681 + t683
+	t687 := t681 - t683
+	t688 := complex(imag(t687), -real(t687))
+	t689 := t684 + t686
+	t690 := t685 + t688
+	t691 := t684 - t686
+	t692 := t685 - t688
+	t693 := w19 * t233
+	t694 := w38 * t350
+	t695 := w57 * t467
+	t696 := t116 + t694
+	t697 := t116 - t694
+	t698 := t693 + t695
+	t699 := t693 - t695
+	t700 := complex(imag(t699), -real(t699))
+	t701 := t696 + t698
+	t702 := t697 + t700
+	t703 := t696 - t698
+	t704 := t697 - t700
+
+	y := dst[:n]
+	y[0] = t473
+	y[1] = t485
+	y[2] = t497
+	y[3] = t509
+	y[4] = t521
+	y[5] = t533
+	y[6] = t545
+	y[7] = t557
+	y[8] = t569
+	y[9] = t581
+	y[10] = t593
+	y[11] = t605
+	y[12] = t617
+	y[13] = t629
+	y[14] = t641
+	y[15] = t653
+	y[16] = t665
+	y[17] = t677
+	y[18] = t689
+	y[19] = t701
+	y[20] = t474
+	y[21] = t486
+	y[22] = t498
+	y[23] = t510
+	y[24] = t522
+	y[25] = t534
+	y[26] = t546
+	y[27] = t558
+	y[28] = t570
+	y[29] = t582
+	y[30] = t594
+	y[31] = t606
+	y[32] = t618
+	y[33] = t630
+	y[34] = t642
+	y[35] = t654
+	y[36] = t666
+	y[37] = t678
+	y[38] = t690
+	y[39] = t702
+	y[40] = t475
+	y[41] = t487
+	y[42] = t499
+	y[43] = t511
+	y[44] = t523
+	y[45] = t535
+	y[46] = t547
+	y[47] = t559
+	y[48] = t571
+	y[49] = t583
+	y[50] = t595
+	y[51] = t607
+	y[52] = t619
+	y[53] = t631
+	y[54] = t643
+	y[55] = t655
+	y[56] = t667
+	y[57] = t679
+	y[58] = t691
+	y[59] = t703
+	y[60] = t476
+	y[61] = t488
+	y[62] = t500
+	y[63] = t512
+	y[64] = t524
+	y[65] = t536
+	y[66] = t548
+	y[67] = t560
+	y[68] = t572
+	y[69] = t584
+	y[70] = t596
+	y[71] = t608
+	y[72] = t620
+	y[73] = t632
+	y[74] = t644
+	y[75] = t656
+	y[76] = t668
+	y[77] = t680
+	y[78] = t692
+	y[79] = t704
+
+	return true
+}
+
+// inverseDIT80Radix4x4x5Complex128 computes a 80-point inverse FFT of complex128 data
+// with radix-4, radix-4, radix-5 stages, fully unrolled. The twiddles are
+// conjugated and the result is scaled by 1/N.
+// Returns false if any slice is too small.
+//
+//nolint:funlen,maintidx
+func inverseDIT80Radix4x4x5Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 80
+
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n || len(src) < n {
+		return false
+	}
+
+	x := src[:n]
+	tw := twiddle[:n]
+
+	w4 := complex(real(tw[4]), -imag(tw[4]))
+	w8 := complex(real(tw[8]), -imag(tw[8]))
+	w12 := complex(real(tw[12]), -imag(tw[12]))
+	w16 := complex(real(tw[16]), -imag(tw[16]))
+	w24 := complex(real(tw[24]), -imag(tw[24]))
+	w36 := complex(real(tw[36]), -imag(tw[36]))
+	w32 := complex(real(tw[32]), -imag(tw[32]))
+	w48 := complex(real(tw[48]), -imag(tw[48]))
+	w1 := complex(real(tw[1]), -imag(tw[1]))
+	w2 := complex(real(tw[2]), -imag(tw[2]))
+	w3 := complex(real(tw[3]), -imag(tw[3]))
+	w6 := complex(real(tw[6]), -imag(tw[6]))
+	w9 := complex(real(tw[9]), -imag(tw[9]))
+	w5 := complex(real(tw[5]), -imag(tw[5]))
+	w10 := complex(real(tw[10]), -imag(tw[10]))
+	w15 := complex(real(tw[15]), -imag(tw[15]))
+	w18 := complex(real(tw[18]), -imag(tw[18]))
+	w7 := complex(real(tw[7]), -imag(tw[7]))
+	w14 := complex(real(tw[14]), -imag(tw[14]))
+	w21 := complex(real(tw[21]), -imag(tw[21]))
+	w27 := complex(real(tw[27]), -imag(tw[27]))
+	w30 := complex(real(tw[30]), -imag(tw[30]))
+	w11 := complex(real(tw[11]), -imag(tw[11]))
+	w22 := complex(real(tw[22]), -imag(tw[22]))
+	w33 := complex(real(tw[33]), -imag(tw[33]))
+	w13 := complex(real(tw[13]), -imag(tw[13]))
+	w26 := complex(real(tw[26]), -imag(tw[26]))
+	w39 := complex(real(tw[39]), -imag(tw[39]))
+	w28 := complex(real(tw[28]), -imag(tw[28]))
+	w42 := complex(real(tw[42]), -imag(tw[42]))
+	w45 := complex(real(tw[45]), -imag(tw[45]))
+	w17 := complex(real(tw[17]), -imag(tw[17]))
+	w34 := complex(real(tw[34]), -imag(tw[34]))
+	w51 := complex(real(tw[51]), -imag(tw[51]))
+	w54 := complex(real(tw[54]), -imag(tw[54]))
+	w19 := complex(real(tw[19]), -imag(tw[19]))
+	w38 := complex(real(tw[38]), -imag(tw[38]))
+	w57 := complex(real(tw[57]), -imag(tw[57]))
+
+	x0 := x[0]
+	x1 := x[1]
+	x2 := x[2]
+	x3 := x[3]
+	x4 := x[4]
+	x5 := x[5]
+	x6 := x[6]
+	x7 := x[7]
+	x8 := x[8]
+	x9 := x[9]
+	x10 := x[10]
+	x11 := x[11]
+	x12 := x[12]
+	x13 := x[13]
+	x14 := x[14]
+	x15 := x[15]
+	x16 := x[16]
+	x17 := x[17]
+	x18 := x[18]
+	x19 := x[19]
+	x20 := x[20]
+	x21 := x[21]
+	x22 := x[22]
+	x23 := x[23]
+	x24 := x[24]
+	x25 := x[25]
+	x26 := x[26]
+	x27 := x[27]
+	x28 := x[28]
+	x29 := x[29]
+	x30 := x[30]
+	x31 := x[31]
+	x32 := x[32]
+	x33 := x[33]
+	x34 := x[34]
+	x35 := x[35]
+	x36 := x[36]
+	x37 := x[37]
+	x38 := x[38]
+	x39 := x[39]
+	x40 := x[40]
+	x41 := x[41]
+	x42 := x[42]
+	x43 := x[43]
+	x44 := x[44]
+	x45 := x[45]
+	x46 := x[46]
+	x47 := x[47]
+	x48 := x[48]
+	x49 := x[49]
+	x50 := x[50]
+	x51 := x[51]
+	x52 := x[52]
+	x53 := x[53]
+	x54 := x[54]
+	x55 := x[55]
+	x56 := x[56]
+	x57 := x[57]
+	x58 := x[58]
+	x59 := x[59]
+	x60 := x[60]
+	x61 := x[61]
+	x62 := x[62]
+	x63 := x[63]
+	x64 := x[64]
+	x65 := x[65]
+	x66 := x[66]
+	x67 := x[67]
+	x68 := x[68]
+	x69 := x[69]
+	x70 := x[70]
+	x71 := x[71]
+	x72 := x[72]
+	x73 := x[73]
+	x74 := x[74]
+	x75 := x[75]
+	x76 := x[76]
+	x77 := x[77]
+	x78 := x[78]
+	x79 := x[79]
+
+	t0 := x16 + x64
+	t1 := x16 - x64
+	t2 := x32 + x48
+	t3 := x32 - x48
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.8090169943749475*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.8090169943749475*imag(t2))
+	t6 := complex(0.9510565162951535*real(t1)+0.5877852522924732*real(t3), 0.9510565162951535*imag(t1)+0.5877852522924732*imag(t3))
+	t7 := complex(-imag(t6), real(t6))
+	t8 := t5 + t7
+	t9 := t5 - t7
+	t10 := complex(real(x0)-0.8090169943749475*real(t0)+0.30901699437494723*real(t2), imag(x0)-0.8090169943749475*imag(t0)+0.30901699437494723*imag(t2))
+	t11 := complex(0.5877852522924732*real(t1)-0.9510565162951536*real(t3), 0.5877852522924732*imag(t1)-0.9510565162951536*imag(t3))
+	t12 := complex(-imag(t11), real(t11))
+	t13 := t10 + t12
+	t14 := t10 - t12
+	t15 := x20 + x68
+	t16 := x20 - x68
+	t17 := x36 + x52
+	t18 := x36 - x52
+	t19 := x4 + t15 + t17
+	t20 := complex(real(x4)+0.30901699437494745*real(t15)-0.8090169943749475*real(t17), imag(x4)+0.30901699437494745*imag(t15)-0.8090169943749475*imag(t17))
+	t21 := complex(0.9510565162951535*real(t16)+0.5877852522924732*real(t18), 0.9510565162951535*imag(t16)+0.5877852522924732*imag(t18))
+	t22 := complex(-imag(t21), real(t21))
+	t23 := t20 + t22
+	t24 := t20 - t22
+	t25 := complex(real(x4)-0.8090169943749475*real(t15)+0.30901699437494723*real(t17), imag(x4)-0.8090169943749475*imag(t15)+0.30901699437494723*imag(t17))
+	t26 := complex(0.5877852522924732*real(t16)-0.9510565162951536*real(t18), 0.5877852522924732*imag(t16)-0.9510565162951536*imag(t18))
+	t27 := complex(-imag(t26), real(t26))
+	t28 := t25 + t27
+	t29 := t25 - t27
+	t30 := x24 + x72
+	t31 := x24 - x72
+	t32 := x40 + x56
+	t33 := x40 - x56
+	t34 := x8 + t30 + t32
+	t35 := complex(real(x8)+0.30901699437494745*real(t30)-0.8090169943749475*real(t32), imag(x8)+0.30901699437494745*imag(t30)-0.8090169943749475*imag(t32))
+	t36 := complex(0.9510565162951535*real(t31)+0.5877852522924732*real(t33), 0.9510565162951535*imag(t31)+0.5877852522924732*imag(t33))
+	t37 := complex(-imag(t36), real(t36))
+	t38 := t35 + t37
+	t39 := t35 - t37
+	t40 := complex(real(x8)-0.8090169943749475*real(t30)+0.30901699437494723*real(t32), imag(x8)-0.8090169943749475*imag(t30)+0.30901699437494723*imag(t32))
+	t41 := complex(0.5877852522924732*real(t31)-0.9510565162951536*real(t33), 0.5877852522924732*imag(t31)-0.9510565162951536*imag(t33))
+	t42 := complex(-imag(t41), real(t41))
+	t43 := t40 + t42
+	t44 := t40 - t42
+	t45 := x28 + x76
+	t46 := x28 - x76
+	t47 := x44 + x60
+	t48 := x44 - x60
+	t49 := x12 + t45 + t47
+	t50 := complex(real(x12)+0.30901699437494745*real(t45)-0.8090169943749475*real(t47), imag(x12)+0.30901699437494745*imag(t45)-0.8090169943749475*imag(t47))
+	t51 := complex(0.9510565162951535*real(t46)+0.5877852522924732*real(t48), 0.9510565162951535*imag(t46)+0.5877852522924732*imag(t48))
+	t52 := complex(-imag(t51), real(t51))
+	t53 := t50 + t52
+	t54 := t50 - t52
+	t55 := complex(real(x12)-0.8090169943749475*real(t45)+0.30901699437494723*real(t47), imag(x12)-0.8090169943749475*imag(t45)+0.30901699437494723*imag(t47))
+	t56 := complex(0.5877852522924732*real(t46)-0.9510565162951536*real(t48), 0.5877852522924732*imag(t46)-0.9510565162951536*imag(t48))
+	t57 := complex(-imag(t56), real(t56))
+	t58 := t55 + t57
+	t59 := t55 - t57
+	t60 := t4 + t34
+	t61 := t4 - t34
+	t62 := t19 + t49
+	t63 := t19 - t49
+	t64 := complex(-imag(t63), real(t63))
+	t65 := t60 + t62
+	t66 := t61 + t64
+	t67 := t60 - t62
+	t68 := t61 - t64
+	t69 := w4 * t23
+	t70 := w8 * t38
+	t71 := w12 * t53
+	t72 := t8 + t70
+	t73 := t8 - t70
+	t74 := t69 + t71
+	t75 := t69 - t71
+	t76 := complex(-imag(t75), real(t75))
+	t77 := t72 + t74
+	t78 := t73 + t76
+	t79 := t72 - t74
+	t80 := t73 - t76
+	t81 := w8 * t28
+	t82 := w16 * t43
+	t83 := w24 * t58
+	t84 := t13 + t82
+	t85 := t13 - t82
+	t86 := t81 + t83
+	t87 := t81 - t83
+	t88 := complex(-imag(t87), real(t87))
+	t89 := t84 + t86
+	t90 := t85 + t88
+	t91 := t84 - t86
+	t92 := t85 - t88
+	t93 := w12 * t29
+	t94 := w24 * t44
+	t95 := w36 * t59
+	t96 := t14 + t94
+	t97 := t14 - t94
+	t98 := t93 + t95
+	t99 := t93 - t95
+	t100 := complex(-imag(t99), real(t99))
+	t101 := t96 + t98
+	t102 := t97 + t100
+	t103 := t96 - t98
+	t104 := t97 - t100
+	t105 := w16 * t24
+	t106 := w32 * t39
+	t107 := w48 * t54
+	t108 := t9 + t106
+	t109 := t9 - t106
+	t110 := t105 + t107
+	t111 := t105 - t107
+	t112 := complex(-imag(t111), real(t111))
+	t113 := t108 + t110
+	t114 := t109 + t112
+	t115 := t108 - t110
+	t116 := t109 - t112
+	t117 := x17 + x65
+	t118 := x17 - x65
+	t119 := x33 + x49
+	t120 := x33 - x49
+	t121 := x1 + t117 + t119
+	t122 := complex(real(x1)+0.30901699437494745*real(t117)-0.8090169943749475*real(t119), imag(x1)+0.30901699437494745*imag(t117)-0.8090169943749475*imag(t119))
+	t123 := complex(0.9510565162951535*real(t118)+0.5877852522924732*real(t120), 0.9510565162951535*imag(t118)+0.5877852522924732*imag(t120))
+	t124 := complex(-imag(t123), real(t123))
+	t125 := t122 + t124
+	t126 := t122 - t124
+	t127 := complex(real(x1)-0.8090169943749475*real(t117)+0.30901699437494723*real(t119), imag(x1)-0.8090169943749475*imag(t117)+0.30901699437494723*imag(t119))
+	t128 := complex(0.5877852522924732*real(t118)-0.9510565162951536*real(t120), 0.5877852522924732*imag(t118)-0.9510565162951536*imag(t120))
+	t129 := complex(-imag(t128), real(t128))
+	t130 := t127 + t129
+	t131 := t127 - t129
+	t132 := x21 + x69
+	t133 := x21 - x69
+	t134 := x37 + x53
+	t135 := x37 - x53
+	t136 := x5 + t132 + t134
+	t137 := complex(real(x5)+0.30901699437494745*real(t132)-0.8090169943749475*real(t134), imag(x5)+0.30901699437494745*imag(t132)-0.8090169943749475*imag(t134))
+	t138 := complex(0.9510565162951535*real(t133)+0.5877852522924732*real(t135), 0.9510565162951535*imag(t133)+0.5877852522924732*imag(t135))
+	t139 := complex(-imag(t138), real(t138))
+	t140 := t137 + t139
+	t141 := t137 - t139
+	t142 := complex(real(x5)-0.8090169943749475*real(t132)+0.30901699437494723*real(t134), imag(x5)-0.8090169943749475*imag(t132)+0.30901699437494723*imag(t134))
+	t143 := complex(0.5877852522924732*real(t133)-0.9510565162951536*real(t135), 0.5877852522924732*imag(t133)-0.9510565162951536*imag(t135))
+	t144 := complex(-imag(t143), real(t143))
+	t145 := t142 + t144
+	t146 := t142 - t144
+	t147 := x25 + x73
+	t148 := x25 - x73
+	t149 := x41 + x57
+	t150 := x41 - x57
+	t151 := x9 + t147 + t149
+	t152 := complex(real(x9)+0.30901699437494745*real(t147)-0.8090169943749475*real(t149), imag(x9)+0.30901699437494745*imag(t147)-0.8090169943749475*imag(t149))
+	t153 := complex(0.9510565162951535*real(t148)+0.5877852522924732*real(t150), 0.9510565162951535*imag(t148)+0.5877852522924732*imag(t150))
+	t154 := complex(-imag(t153), real(t153))
+	t155 := t152 + t154
+	t156 := t152 - t154
+	t157 := complex(real(x9)-0.8090169943749475*real(t147)+0.30901699437494723*real(t149), imag(x9)-0.8090169943749475*imag(t147)+0.30901699437494723*imag(t149))
+	t158 := complex(0.5877852522924732*real(t148)-0.9510565162951536*real(t150), 0.5877852522924732*imag(t148)-0.9510565162951536*imag(t150))
+	t159 := complex(-imag(t158), real(t158))
+	t160 := t157 + t159
+	t161 := t157 - t159
+	t162 := x29 + x77
+	t163 := x29 - x77
+	t164 := x45 + x61
+	t165 := x45 - x61
+	t166 := x13 + t162 + t164
+	t167 := complex(real(x13)+0.30901699437494745*real(t162)-0.8090169943749475*real(t164), imag(x13)+0.30901699437494745*imag(t162)-0.8090169943749475*imag(t164))
+	t168 := complex(0.9510565162951535*real(t163)+0.5877852522924732*real(t165), 0.9510565162951535*imag(t163)+0.5877852522924732*imag(t165))
+	t169 := complex(-imag(t168), real(t168))
+	t170 := t167 + t169
+	t171 := t167 - t169
+	t172 := complex(real(x13)-0.8090169943749475*real(t162)+0.30901699437494723*real(t164), imag(x13)-0.8090169943749475*imag(t162)+0.30901699437494723*imag(t164))
+	t173 := complex(0.5877852522924732*real(t163)-0.9510565162951536*real(t165), 0.5877852522924732*imag(t163)-0.9510565162951536*imag(t165))
+	t174 := complex(-imag(t173), real(t173))
+	t175 := t172 + t174
+	t176 := t172 - t174
+	t177 := t121 + t151
+	t178 := t121 - t151
+	t179 := t136 + t166
+	t180 := t136 - t166
+	t181 := complex(-imag(t180), real(t180))
+	t182 := t177 + t179
+	t183 := t178 + t181
+	t184 := t177 - t179
+	t185 := t178 - t181
+	t186 := w4 * t140
+	t187 := w8 * t155
+	t188 := w12 * t170
+	t189 := t125 + t187
+	t190 := t125 - t187
+	t191 := t186 + t188
+	t192 := t186 - t188
+	t193 := complex(-imag(t192), real(t192))
+	t194 := t189 + t191
+	t195 := t190 + t193
+	t196 := t189 - t191
+	t197 := t190 - t193
+	t198 := w8 * t145
+	t199 := w16 * t160
+	t200 := w24 * t175
+	t201 := t130 + t199
+	t202 := t130 - t199
+	t203 := t198 + t200
+	t204 := t198 - t200
+	t205 := complex(-imag(t204), real(t204))
+	t206 := t201 + t203
+	t207 := t202 + t205
+	t208 := t201 - t203
+	t209 := t202 - t205
+	t210 := w12 * t146
+	t211 := w24 * t161
+	t212 := w36 * t176
+	t213 := t131 + t211
+	t214 := t131 - t211
+	t215 := t210 + t212
+	t216 := t210 - t212
+	t217 := complex(-imag(t216), real(t216))
+	t218 := t213 + t215
+	t219 := t214 + t217
+	t220 := t213 - t215
+	t221 := t214 - t217
+	t222 := w16 * t141
+	t223 := w32 * t156
+	t224 := w48 * t171
+	t225 := t126 + t223
+	t226 := t126 - t223
+	t227 := t222 + t224
+	t228 := t222 - t224
+	t229 := complex(-imag(t228), real(t228))
+	t230 := t225 + t227
+	t231 := t226 + t229
+	t232 := t225 - t227
+	t233 := t226 - t229
+	t234 := x18 + x66
+	t235 := x18 - x66
+	t236 := x34 + x50
+	t237 := x34 - x50
+	t238 := x2 + t234 + t236
+	t239 := complex(real(x2)+0.30901699437494745*real(t234)-0.8090169943749475*real(t236), imag(x2)+0.30901699437494745*imag(t234)-0.8090169943749475*imag(t236))
+	t240 := complex(0.9510565162951535*real(t235)+0.5877852522924732*real(t237), 0.9510565162951535*imag(t235)+0.5877852522924732*imag(t237))
+	t241 := complex(-imag(t240), real(t240))
+	t242 := t239 + t241
+	t243 := t239 - t241
+	t244 := complex(real(x2)-0.8090169943749475*real(t234)+0.30901699437494723*real(t236), imag(x2)-0.8090169943749475*imag(t234)+0.30901699437494723*imag(t236))
+	t245 := complex(0.5877852522924732*real(t235)-0.9510565162951536*real(t237), 0.5877852522924732*imag(t235)-0.9510565162951536*imag(t237))
+	t246 := complex(-imag(t245), real(t245))
+	t247 := t244 + t246
+	t248 := t244 - t246
+	t249 := x22 + x70
+	t250 := x22 - x70
+	t251 := x38 + x54
+	t252 := x38 - x54
+	t253 := x6 + t249 + t251
+	t254 := complex(real(x6)+0.30901699437494745*real(t249)-0.8090169943749475*real(t251), imag(x6)+0.30901699437494745*imag(t249)-0.8090169943749475*imag(t251))
+	t255 := complex(0.9510565162951535*real(t250)+0.5877852522924732*real(t252), 0.9510565162951535*imag(t250)+0.5877852522924732*imag(t252))
+	t256 := complex(-imag(t255), real(t255))
+	t257 := t254 + t256
+	t258 := t254 - t256
+	t259 := complex(real(x6)-0.8090169943749475*real(t249)+0.30901699437494723*real(t251), imag(x6)-0.8090169943749475*imag(t249)+0.30901699437494723*imag(t251))
+	t260 := complex(0.5877852522924732*real(t250)-0.9510565162951536*real(t252), 0.5877852522924732*imag(t250)-0.9510565162951536*imag(t252))
+	t261 := complex(-imag(t260), real(t260))
+	t262 := t259 + t261
+	t263 := t259 - t261
+	t264 := x26 + x74
+	t265 := x26 - x74
+	t266 := x42 + x58
+	t267 := x42 - x58
+	t268 := x10 + t264 + t266
+	t269 := complex(real(x10)+0.30901699437494745*real(t264)-0.8090169943749475*real(t266), imag(x10)+0.30901699437494745*imag(t264)-0.8090169943749475*imag(t266))
+	t270 := complex(0.9510565162951535*real(t265)+0.5877852522924732*real(t267), 0.9510565162951535*imag(t265)+0.5877852522924732*imag(t267))
+	t271 := complex(-imag(t270), real(t270))
+	t272 := t269 + t271
+	t273 := t269 - t271
+	t274 := complex(real(x10)-0.8090169943749475*real(t264)+0.30901699437494723*real(t266), imag(x10)-0.8090169943749475*imag(t264)+0.30901699437494723*imag(t266))
+	t275 := complex(0.5877852522924732*real(t265)-0.9510565162951536*real(t267), 0.5877852522924732*imag(t265)-0.9510565162951536*imag(t267))
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t274 + t276
+	t278 := t274 - t276
+	t279 := x30 + x78
+	t280 := x30 - x78
+	t281 := x46 + x62
+	t282 := x46 - x62
+	t283 := x14 + t279 + t281
+	t284 := complex(real(x14)+0.30901699437494745*real(t279)-0.8090169943749475*real(t281), imag(x14)+0.30901699437494745*imag(t279)-0.8090169943749475*imag(t281))
+	t285 := complex(0.9510565162951535*real(t280)+0.5877852522924732*real(t282), 0.9510565162951535*imag(t280)+0.5877852522924732*imag(t282))
+	t286 := complex(-imag(t285), real(t285))
+	t287 := t284 + t286
+	t288 := t284 - t286
+	t289 := complex(real(x14)-0.8090169943749475*real(t279)+0.30901699437494723*real(t281), imag(x14)-0.8090169943749475*imag(t279)+0.30901699437494723*imag(t281))
+	t290 := complex(0.5877852522924732*real(t280)-0.9510565162951536*real(t282), 0.5877852522924732*imag(t280)-0.9510565162951536*imag(t282))
+	t291 := complex(-imag(t290), real(t290))
+	t292 := t289 + t291
+	t293 := t289 - t291
+	t294 := t238 + t268
+	t295 := t238 - t268
+	t296 := t253 + t283
+	t297 := t253 - t283
+	t298 := complex(-imag(t297), real(t297))
+	t299 := t294 + t296
+	t300 := t295 + t298
+	t301 := t294 - t296
+	t302 := t295 - t298
+	t303 := w4 * t257
+	t304 := w8 * t272
+	t305 := w12 * t287
+	t306 := t242 + t304
+	t307 := t242 - t304
+	t308 := t303 + t305
+	t309 := t303 - t305
+	t310 := complex(-imag(t309), real(t309))
+	t311 := t306 + t308
+	t312 := t307 + t310
+	t313 := t306 - t308
+	t314 := t307 - t310
+	t315 := w8 * t262
+	t316 := w16 * t277
+	t317 := w24 * t292
+	t318 := t247 + t316
+	t319 := t247 - t316
+	t320 := t315 + t317
+	t321 := t315 - t317
+	t322 := complex(-imag(t321), real(t321))
+	t323 := t318 + t320
+	t324 := t319 + t322
+	t325 := t318 - t320
+	t326 := t319 - t322
+	t327 := w12 * t263
+	t328 := w24 * t278
+	t329 := w36 * t293
+	t330 := t248 + t328
+	t331 := t248 - t328
+	t332 := t327 + t329
+	t333 := t327 - t329
+	t334 := complex(-imag(t333), real(t333))
+	t335 := t330 + t332
+	t336 := t331 + t334
+	t337 := t330 - t332
+	t338 := t331 - t334
+	t339 := w16 * t258
+	t340 := w32 * t273
+	t341 := w48 * t288
+	t342 := t243 + t340
+	t343 := t243 - t340
+	t344 := t339 + t341
+	t345 := t339 - t341
+	t346 := complex(-imag(t345), real(t345))
+	t347 := t342 + t344
+	t348 := t343 + t346
+	t349 := t342 - t344
+	t350 := t343 - t346
+	t351 := x19 + x67
+	t352 := x19 - x67
+	t353 := x35 + x51
+	t354 := x35 - x51
+	t355 := x3 + t351 + t353
+	t356 := complex(real(x3)+0.30901699437494745*real(t351)-0.8090169943749475*real(t353), imag(x3)+0.30901699437494745*imag(t351)-0.8090169943749475*imag(t353))
+	t357 := complex(0.9510565162951535*real(t352)+0.5877852522924732*real(t354), 0.9510565162951535*imag(t352)+0.5877852522924732*imag(t354))
+	t358 := complex(-imag(t357), real(t357))
+	t359 := t356 + t358
+	t360 := t356 - t358
+	t361 := complex(real(x3)-0.8090169943749475*real(t351)+0.30901699437494723*real(t353), imag(x3)-0.8090169943749475*imag(t351)+0.30901699437494723*imag(t353))
+	t362 := complex(0.5877852522924732*real(t352)-0.9510565162951536*real(t354), 0.5877852522924732*imag(t352)-0.9510565162951536*imag(t354))
+	t363 := complex(-imag(t362), real(t362))
+	t364 := t361 + t363
+	t365 := t361 - t363
+	t366 := x23 + x71
+	t367 := x23 - x71
+	t368 := x39 + x55
+	t369 := x39 - x55
+	t370 := x7 + t366 + t368
+	t371 := complex(real(x7)+0.30901699437494745*real(t366)-0.8090169943749475*real(t368), imag(x7)+0.30901699437494745*imag(t366)-0.8090169943749475*imag(t368))
+	t372 := complex(0.9510565162951535*real(t367)+0.5877852522924732*real(t369), 0.9510565162951535*imag(t367)+0.5877852522924732*imag(t369))
+	t373 := complex(-imag(t372), real(t372))
+	t374 := t371 + t373
+	t375 := t371 - t373
+	t376 := complex(real(x7)-0.8090169943749475*real(t366)+0.30901699437494723*real(t368), imag(x7)-0.8090169943749475*imag(t366)+0.30901699437494723*imag(t368))
+	t377 := complex(0.5877852522924732*real(t367)-0.9510565162951536*real(t369), 0.5877852522924732*imag(t367)-0.9510565162951536*imag(t369))
+	t378 := complex(-imag(t377), real(t377))
+	t379 := t376 + t378
+	t380 := t376 - t378
+	t381 := x27 + x75
+	t382 := x27 - x75
+	t383 := x43 + x59
+	t384 := x43 - x59
+	t385 := x11 + t381 + t383
+	t386 := complex(real(x11)+0.30901699437494745*real(t381)-0.8090169943749475*real(t383), imag(x11)+0.30901699437494745*imag(t381)-0.8090169943749475*imag(t383))
+	t387 := complex(0.9510565162951535*real(t382)+0.5877852522924732*real(t384), 0.9510565162951535*imag(t382)+0.5877852522924732*imag(t384))
+	t388 := complex(-imag(t387), real(t387))
+	t389 := t386 + t388
+	t390 := t386 - t388
+	t391 := complex(real(x11)-0.8090169943749475*real(t381)+0.30901699437494723*real(t383), imag(x11)-0.8090169943749475*imag(t381)+0.30901699437494723*imag(t383))
+	t392 := complex(0.5877852522924732*real(t382)-0.9510565162951536*real(t384), 0.5877852522924732*imag(t382)-0.9510565162951536*imag(t384))
+	t393 := complex(-imag(t392), real(t392))
+	t394 := t391 + t393
+	t395 := t391 - t393
+	t396 := x31 + x79
+	t397 := x31 - x79
+	t398 := x47 + x63
+	t399 := x47 - x63
+	t400 := x15 + t396 + t398
+	t401 := complex(real(x15)+0.30901699437494745*real(t396)-0.8090169943749475*real(t398), imag(x15)+0.30901699437494745*imag(t396)-0.8090169943749475*imag(t398))
+	t402 := complex(0.9510565162951535*real(t397)+0.5877852522924732*real(t399), 0.9510565162951535*imag(t397)+0.5877852522924732*imag(t399))
+	t403 := complex(-imag(t402), real(t402))
+	t404 := t401 + t403
+	t405 := t401 - t403
+	t406 := complex(real(x15)-0.8090169943749475*real(t396)+0.30901699437494723*real(t398), imag(x15)-0.8090169943749475*imag(t396)+0.30901699437494723*imag(t398))
+	t407 := complex(0.5877852522924732*real(t397)-0.9510565162951536*real(t399), 0.5877852522924732*imag(t397)-0.9510565162951536*imag(t399))
+	t408 := complex(-imag(t407), real(t407))
+	t409 := t406 + t408
+	t410 := t406 - t408
+	t411 := t355 + t385
+	t412 := t355 - t385
+	t413 := t370 + t400
+	t414 := t370 - t400
+	t415 := complex(-imag(t414), real(t414))
+	t416 := t411 + t413
+	t417 := t412 + t415
+	t418 := t411 - t413
+	t419 := t412 - t415
+	t420 := w4 * t374
+	t421 := w8 * t389
+	t422 := w12 * t404
+	t423 := t359 + t421
+	t424 := t359 - t421
+	t425 := t420 + t422
+	t426 := t420 - t422
+	t427 := complex(-imag(t426), real(t426))
+	t428 := t423 + t425
+	t429 := t424 + t427
+	t430 := t423 - t425
+	t431 := t424 - t427
+	t432 := w8 * t379
+	t433 := w16 * t394
+	t434 := w24 * t409
+	t435 := t364 + t433
+	t436 := t364 - t433
+	t437 := t432 + t434
+	t438 := t432 - t434
+	t439 := complex(-imag(t438), real(t438))
+	t440 := t435 + t437
+	t441 := t436 + t439
+	t442 := t435 - t437
+	t443 := t436 - t439
+	t444 := w12 * t380
+	t445 := w24 * t395
+	t446 := w36 * t410
+	t447 := t365 + t445
+	t448 := t365 - t445
+	t449 := t444 + t446
+	t450 := t444 - t446
+	t451 := complex(-imag(t450), real(t450))
+	t452 := t447 + t449
+	t453 := t448 + t451
+	t454 := t447 - t449
+	t455 := t448 - t451
+	t456 := w16 * t375
+	t457 := w32 * t390
+	t458 := w48 * t405
+	t459 := t360 + t457
+	t460 := t360 - t457
+	t461 := t456 + t458
+	t462 := t456 - t458
+	t463 := complex(-imag(t462), real(t462))
+	t464 := t459 + t461
+	t465 := t460 + t463
+	t466 := t459 - t461
+	t467 := t460 - t463
+	t468 := t65 + t299
+	t469 := t65 - t299
+	t470 := t182 + t416
+	t471 := t182 - t416
+	t472 := complex(-imag(t471), real(t471))
+	t473 := t468 + t470
+	t474 := t469 + t472
+	t475 := t468 - t470
+	t476 := t469 - t472
+	t477 := w1 * t194
+	t478 := w2 * t311
+	t479 := w3 * t428
+	t480 := t77 + t478
+	t481 := t77 - t478
+	t482 := t477 + t479
+	t483 := t477 - t479
+	t484 := complex(-imag(t483), real(t483))
+	t485 := t480 + t482
+	t486 := t481 + t484
+	t487 := t480 - t482
+	t488 := t481 - t484
+	t489 := w2 * t206
+	t490 := w4 * t323
+	t491 := w6 * t440
+	t492 := t89 + t490
+	t493 := t89 - t490
+	t494 := t489 + t491
+	t495 := t489 - t491
+	t496 := complex(-imag(t495), real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := w3 * t218
+	t502 := w6 * t335
+	t503 := w9 * t452
+	t504 := t101 + t502
+	t505 := t101 - t502
+	t506 := t501 + t503
+	t507 := t501 - t503
+	t508 := complex(-imag(t507), real(t507))
+	t509 := t504 + t506
+	t510 := t505 + t508
+	t511 := t504 - t506
+	t512 := t505 - t508
+	t513 := w4 * t230
+	t514 := w8 * t347
+	t515 := w12 * t464
+	t516 := t113 + t514
+	t517 := t113 - t514
+	t518 := t513 + t515
+	t519 := t513 - t515
+	t520 := complex(-imag(t519), real(t519))
+	t521 := t516 + t518
+	t522 := t517 + t520
+	t523 := t516 - t518
+	t524 := t517 - t520
+	t525 := w5 * t183
+	t526 := w10 * t300
+	t527 := w15 * t417
+	t528 := t66 + t526
+	t529 := t66 - t526
+	t530 := t525 + t527
+	t531 := t525 - t527
+	t532 := complex(-imag(t531), real(t531))
+	t533 := t528 + t530
+	t534 := t529 + t532
+	t535 := t528 - t530
+	t536 := t529 - t532
+	t537 := w6 * t195
+	t538 := w12 * t312
+	t539 := w18 * t429
+	t540 := t78 + t538
+	t541 := t78 - t538
+	t542 := t537 + t539
+	t543 := t537 - t539
+	t544 := complex(-imag(t543), real(t543))
+	t545 := t540 + t542
+	t546 := t541 + t544
+	t547 := t540 - t542
+	t548 := t541 - t544
+	t549 := w7 * t207
+	t550 := w14 * t324
+	t551 := w21 * t441
+	t552 := t90 + t550
+	t553 := t90 - t550
+	t554 := t549 + t551
+	t555 := t549 - t551
+	t556 := complex(-imag(t555), real(t555))
+	t557 := t552 + t554
+	t558 := t553 + t556
+	t559 := t552 - t554
+	t560 := t553 - t556
+	t561 := w8 * t219
+	t562 := w16 * t336
+	t563 := w24 * t453
+	t564 := t102 + t562
+	t565 := t102 - t562
+	t566 := t561 + t563
+	t567 := t561 - t563
+	t568 := complex(-imag(t567), real(t567))
+	t569 := t564 + t566
+	t570 := t565 + t568
+	t571 := t564 - t566
+	t572 := t565 - t568
+	t573 := w9 * t231
+	t574 := w18 * t348
+	t575 := w27 * t465
+	t576 := t114 + t574
+	t577 := t114 - t574
+	t578 := t573 + t575
+	t579 := t573 - t575
+	t580 := complex(-imag(t579), real(t579))
+	t581 := t576 + t578
+	t582 := t577 + t580
+	t583 := t576 - t578
+	t584 := t577 - t580
+	t585 := w10 * t184
+	t586 := complex(-imag(t301), real(t301))
+	t587 := w30 * t418
+	t588 := t67 + t586
+	t589 := t67 - t586
+	t590 := t585 + t587
+	t591 := t585 - t587
+	t592 := complex(-imag(t591), real(t591))
+	t593 := t588 + t590
+	t594 := t589 + t592
+	t595 := t588 - t590
+	t596 := t589 - t592
+	t597 := w11 * t196
+	t598 := w22 * t313
+	t599 := w33 * t430
+	t600 := t79 + t598
+	t601 := t79 - t598
+	t602 := t597 + t599
+	t603 := t597 - t599
+	t604 := complex(-imag(t603), real(t603))
+	t605 := t600 + t602
+	t606 := t601 + t604
+	t607 := t600 - t602
+	t608 := t601 - t604
+	t609 := w12 * t208
+	t610 := w24 * t325
+	t611 := w36 * t442
+	t612 := t91 + t610
+	t613 := t91 - t610
+	t614 := t609 + t611
+	t615 := t609 - t611
+	t616 := complex(-imag(t615), real(t615))
+	t617 := t612 + t614
+	t618 := t613 + t616
+	t619 := t612 - t614
+	t620 := t613 - t616
+	t621 := w13 * t220
+	t622 := w26 * t337
+	t623 := w39 * t454
+	t624 := t103 + t622
+	t625 := t103 - t622
+	t626 := t621 + t623
+	t627 := t621 - t623
+	t628 := complex(-imag(t627), real(t627))
+	t629 := t624 + t626
+	t630 := t625 + t628
+	t631 := t624 - t626
+	t632 := t625 - t628
+	t633 := w14 * t232
+	t634 := w28 * t349
+	t635 := w42 * t466
+	t636 := t115 + t634
+	t637 := t115 - t634
+	t638 := t633 + t635
+	t639 := t633 - t635
+	t640 := complex(-imag(t639), real(t639))
+	t641 := t636 + t638
+	t642 := t637 + t640
+	t643 := t636 - t638
+	t644 := t637 - t640
+	t645 := w15 * t185
+	t646 := w30 * t302
+	t647 := w45 * t419
+	t648 := t68 + t646
+	t649 := t68 - t646
+	t650 := t645 + t647
+	t651 := t645 - t647
+	t652 := complex(-imag(t651), real(t651))
+	t653 := t648 + t650
+	t654 := t649 + t652
+	t655 := t648 - t650
+	t656 := t649 - t652
+	t657 := w16 * t197
+	t658 := w32 * t314
+	t659 := w48 * t431
+	t660 := t80 + t658
+	t661 := t80 - t658
+	t662 := t657 + t659
+	t663 := t657 - t659
+	t664 := complex(-imag(t663), real(t663))
+	t665 := t660 + t662
+	t666 := t661 + t664
+	t667 := t660 - t662
+	t668 := t661 - t664
+	t669 := w17 * t209
+	t670 := w34 * t326
+	t671 := w51 * t443
+	t672 := t92 + t670
+	t673 := t92 - t670
+	t674 := t669 + t671
+	t675 := t669 - t671
+	t676 := complex(-imag(t675), real(t675))
+	t677 := t672 + t674
+	t678 := t673 + t676
+	t679 := t672 - t674
+	t680 := t673 - t676
+	t681 := w18 * t221
+	t682 := w36 * t338
+	t683 := w54 * t455
+	t684 := t104 + t682
+	t685 := t104 - t682
+	t686 := t681 + t683
+	t687 := t681 - t683
+	t688 := complex(-imag(t687), real(t687))
+	t689 := t684 + t686
+	t690 := t685 + t688
+	t691 := t684 - t686
+	t692 := t685 - t688
+	t693 := w19 * t233
+	t694 := w38 * t350
+	t695 := w57 * t467
+	t696 := t116 + t694
+	t697 := t116 - t694
+	t698 := t693 + t695
+	t699 := t693 - t695
+	t700 := complex(-imag(t699), real(t699))
+	t701 := t696 + t698
+	t702 := t697 + t700
+	t703 := t696 - t698
+	t704 := t697 - t700
+
+	y := dst[:n]
+
+	const scale = 1.0 / n
+
+	y[0] = complex(real(t473)*scale, imag(t473)*scale)
+	y[1] = complex(real(t485)*scale, imag(t485)*scale)
+	y[2] = complex(real(t497)*scale, imag(t497)*scale)
+	y[3] = complex(real(t509)*scale, imag(t509)*scale)
+	y[4] = complex(real(t521)*scale, imag(t521)*scale)
+	y[5] = complex(real(t533)*scale, imag(t533)*scale)
+	y[6] = complex(real(t545)*scale, imag(t545)*scale)
+	y[7] = complex(real(t557)*scale, imag(t557)*scale)
+	y[8] = complex(real(t569)*scale, imag(t569)*scale)
+	y[9] = complex(real(t581)*scale, imag(t581)*scale)
+	y[10] = complex(real(t593)*scale, imag(t593)*scale)
+	y[11] = complex(real(t605)*scale, imag(t605)*scale)
+	y[12] = complex(real(t617)*scale, imag(t617)*scale)
+	y[13] = complex(real(t629)*scale, imag(t629)*scale)
+	y[14] = complex(real(t641)*scale, imag(t641)*scale)
+	y[15] = complex(real(t653)*scale, imag(t653)*scale)
+	y[16] = complex(real(t665)*scale, imag(t665)*scale)
+	y[17] = complex(real(t677)*scale, imag(t677)*scale)
+	y[18] = complex(real(t689)*scale, imag(t689)*scale)
+	y[19] = complex(real(t701)*scale, imag(t701)*scale)
+	y[20] = complex(real(t474)*scale, imag(t474)*scale)
+	y[21] = complex(real(t486)*scale, imag(t486)*scale)
+	y[22] = complex(real(t498)*scale, imag(t498)*scale)
+	y[23] = complex(real(t510)*scale, imag(t510)*scale)
+	y[24] = complex(real(t522)*scale, imag(t522)*scale)
+	y[25] = complex(real(t534)*scale, imag(t534)*scale)
+	y[26] = complex(real(t546)*scale, imag(t546)*scale)
+	y[27] = complex(real(t558)*scale, imag(t558)*scale)
+	y[28] = complex(real(t570)*scale, imag(t570)*scale)
+	y[29] = complex(real(t582)*scale, imag(t582)*scale)
+	y[30] = complex(real(t594)*scale, imag(t594)*scale)
+	y[31] = complex(real(t606)*scale, imag(t606)*scale)
+	y[32] = complex(real(t618)*scale, imag(t618)*scale)
+	y[33] = complex(real(t630)*scale, imag(t630)*scale)
+	y[34] = complex(real(t642)*scale, imag(t642)*scale)
+	y[35] = complex(real(t654)*scale, imag(t654)*scale)
+	y[36] = complex(real(t666)*scale, imag(t666)*scale)
+	y[37] = complex(real(t678)*scale, imag(t678)*scale)
+	y[38] = complex(real(t690)*scale, imag(t690)*scale)
+	y[39] = complex(real(t702)*scale, imag(t702)*scale)
+	y[40] = complex(real(t475)*scale, imag(t475)*scale)
+	y[41] = complex(real(t487)*scale, imag(t487)*scale)
+	y[42] = complex(real(t499)*scale, imag(t499)*scale)
+	y[43] = complex(real(t511)*scale, imag(t511)*scale)
+	y[44] = complex(real(t523)*scale, imag(t523)*scale)
+	y[45] = complex(real(t535)*scale, imag(t535)*scale)
+	y[46] = complex(real(t547)*scale, imag(t547)*scale)
+	y[47] = complex(real(t559)*scale, imag(t559)*scale)
+	y[48] = complex(real(t571)*scale, imag(t571)*scale)
+	y[49] = complex(real(t583)*scale, imag(t583)*scale)
+	y[50] = complex(real(t595)*scale, imag(t595)*scale)
+	y[51] = complex(real(t607)*scale, imag(t607)*scale)
+	y[52] = complex(real(t619)*scale, imag(t619)*scale)
+	y[53] = complex(real(t631)*scale, imag(t631)*scale)
+	y[54] = complex(real(t643)*scale, imag(t643)*scale)
+	y[55] = complex(real(t655)*scale, imag(t655)*scale)
+	y[56] = complex(real(t667)*scale, imag(t667)*scale)
+	y[57] = complex(real(t679)*scale, imag(t679)*scale)
+	y[58] = complex(real(t691)*scale, imag(t691)*scale)
+	y[59] = complex(real(t703)*scale, imag(t703)*scale)
+	y[60] = complex(real(t476)*scale, imag(t476)*scale)
+	y[61] = complex(real(t488)*scale, imag(t488)*scale)
+	y[62] = complex(real(t500)*scale, imag(t500)*scale)
+	y[63] = complex(real(t512)*scale, imag(t512)*scale)
+	y[64] = complex(real(t524)*scale, imag(t524)*scale)
+	y[65] = complex(real(t536)*scale, imag(t536)*scale)
+	y[66] = complex(real(t548)*scale, imag(t548)*scale)
+	y[67] = complex(real(t560)*scale, imag(t560)*scale)
+	y[68] = complex(real(t572)*scale, imag(t572)*scale)
+	y[69] = complex(real(t584)*scale, imag(t584)*scale)
+	y[70] = complex(real(t596)*scale, imag(t596)*scale)
+	y[71] = complex(real(t608)*scale, imag(t608)*scale)
+	y[72] = complex(real(t620)*scale, imag(t620)*scale)
+	y[73] = complex(real(t632)*scale, imag(t632)*scale)
+	y[74] = complex(real(t644)*scale, imag(t644)*scale)
+	y[75] = complex(real(t656)*scale, imag(t656)*scale)
+	y[76] = complex(real(t668)*scale, imag(t668)*scale)
+	y[77] = complex(real(t680)*scale, imag(t680)*scale)
+	y[78] = complex(real(t692)*scale, imag(t692)*scale)
+	y[79] = complex(real(t704)*scale, imag(t704)*scale)
+
+	return true
+}
@@ -0,0 +1,159 @@
+package kernels
+
+import (
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// The Good-Thomas prime-factor algorithm splits a DFT of length n = n1*n2
+// with coprime n1 and n2 into a two-dimensional n1 x n2 DFT without
+// twiddle factors. Inputs are read with the Ruritanian map
+//
+//	j = (j1*n2 + j2*n1) mod n
+//
+// and outputs written with the CRT map
+//
+//	k = (k1*n2*(n2^-1 mod n1) + k2*n1*(n1^-1 mod n2)) mod n
+//
+// so that W_n^(j*k) = W_n1^(j1*k1) · W_n2^(j2*k2).
+
+// PFAIndices returns the index maps of the prime-factor algorithm for the
+// coprime sizes n1 and n2. input[j2*n1+j1] is the source index of j1, j2, so
+// the gathered rows are the size-n1 transforms. output[k1*n2+k2] is the
+// destination of k1, k2, laid out as the rows of the size-n2 transforms. It
+// returns nil slices if n1 and n2 are not coprime.
+func PFAIndices(n1, n2 int) (input, output []int) {
+	n := n1 * n2
+
+	e1 := m.ModInverse(n2, n1)
+	e2 := m.ModInverse(n1, n2)
+
+	if (e1 == 0 && n1 > 1) || (e2 == 0 && n2 > 1) {
+		return nil, nil
+	}
+
+	input = make([]int, n)
+	output = make([]int, n)
+
+	for j2 := range n2 {
+		for j1 := range n1 {
+			input[j2*n1+j1] = (j1*n2 + j2*n1) % n
+		}
+	}
+
+	c1 := n2 * e1 % n
+	c2 := n1 * e2 % n
+
+	for k1 := range n1 {
+		for k2 := range n2 {
+			output[k1*n2+k2] = (k1*c1 + k2*c2) % n
+		}
+	}
+
+	return input, output
+}
+
+// PFAModule returns an in-place forward DFT of the given size built from
+// the radix butterflies, or nil if there is none. The prime-factor
+// algorithm runs it on its lines instead of a size-n plan, whose mixed-radix
+// kernel spends more on setup than on arithmetic at these sizes.
+func PFAModule[T Complex](size int) func(line []T) {
+	var (
+		zero   T
+		module any
+	)
+
+	switch any(zero).(type) {
+	case complex64:
+		if fn, ok := pfaModules64[size]; ok {
+			module = fn
+		}
+	case complex128:
+		if fn, ok := pfaModules128[size]; ok {
+			module = fn
+		}
+	}
+
+	fn, _ := module.(func([]T))
+
+	return fn
+}
+
+//nolint:gochecknoglobals
+var pfaModules64 = map[int]func([]complex64){
+	2: func(x []complex64) {
+		x[0], x[1] = x[0]+x[1], x[0]-x[1]
+	},
+	3: func(x []complex64) {
+		x[0], x[1], x[2] = butterfly3ForwardComplex64(x[0], x[1], x[2])
+	},
+	4: func(x []complex64) {
+		x[0], x[1], x[2], x[3] = butterfly4ForwardComplex64(x[0], x[1], x[2], x[3])
+	},
+	5: func(x []complex64) {
+		x[0], x[1], x[2], x[3], x[4] = butterfly5ForwardComplex64(x[0], x[1], x[2], x[3], x[4])
+	},
+	7: func(x []complex64) {
+		butterfly7ForwardComplex64((*[7]complex64)(x))
+	},
+	11: func(x []complex64) {
+		butterfly11ForwardComplex64((*[11]complex64)(x))
+	},
+	13: func(x []complex64) {
+		butterfly13ForwardComplex64((*[13]complex64)(x))
+	},
+}
+
+//nolint:gochecknoglobals
+var pfaModules128 = map[int]func([]complex128){
+	2: func(x []complex128) {
+		x[0], x[1] = x[0]+x[1], x[0]-x[1]
+	},
+	3: func(x []complex128) {
+		x[0], x[1], x[2] = butterfly3ForwardComplex128(x[0], x[1], x[2])
+	},
+	4: func(x []complex128) {
+		x[0], x[1], x[2], x[3] = butterfly4ForwardComplex128(x[0], x[1], x[2], x[3])
+	},
+	5: func(x []complex128) {
+		x[0], x[1], x[2], x[3], x[4] = butterfly5ForwardComplex128(x[0], x[1], x[2], x[3], x[4])
+	},
+	7: func(x []complex128) {
+		butterfly7ForwardComplex128((*[7]complex128)(x))
+	},
+	11: func(x []complex128) {
+		butterfly11ForwardComplex128((*[11]complex128)(x))
+	},
+	13: func(x []complex128) {
+		butterfly13ForwardComplex128((*[13]complex128)(x))
+	},
+}
+
+// PFAGather stores work[i] = src[input[i]]. work must not alias src.
+func PFAGather[T Complex](work, src []T, input []int) {
+	for i, idx := range input {
+		work[i] = src[idx]
+	}
+}
+
+// PFAStoreColumn stores line as column col of the matrix dst with cols
+// columns.
+func PFAStoreColumn[T Complex](dst, line []T, col, cols int) {
+	for i, v := range line {
+		dst[i*cols+col] = v
+	}
+}
+
+// PFAScatterScaled stores dst[output[i]] = scale*work[i]. With reverse set
+// it stores to bin -output[i] mod len(dst) instead, which turns a forward
+// transform into the unscaled inverse. dst must not alias work.
+func PFAScatterScaled[T Complex](dst, work []T, output []int, scale T, reverse bool) {
+	n := len(dst)
+
+	for i, idx := range output {
+		if reverse && idx != 0 {
+			idx = n - idx
+		}
+
+		dst[idx] = work[i] * scale
+	}
+}
@@ -0,0 +1,126 @@
+package kernels
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestPFAIndices(t *testing.T) {
+	t.Parallel()
+
+	input, output := PFAIndices(3, 2)
+
+	// 2^-1 mod 3 = 2 and 3^-1 mod 2 = 1, so k = (4*k1 + 3*k2) mod 6.
+	wantInput := []int{0, 2, 4, 3, 5, 1}
+	wantOutput := []int{0, 3, 4, 1, 2, 5}
+
+	for i := range wantInput {
+		if input[i] != wantInput[i] || output[i] != wantOutput[i] {
+			t.Fatalf("PFAIndices(3, 2) = %v, %v, want %v, %v", input, output, wantInput, wantOutput)
+		}
+	}
+
+	if in, out := PFAIndices(4, 6); in != nil || out != nil {
+		t.Errorf("PFAIndices(4, 6) = %v, %v, want nil", in, out)
+	}
+}
+
+func TestPFATransform(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n1 = 4
+		n2 = 5
+		n  = n1 * n2
+	)
+
+	input, output := PFAIndices(n1, n2)
+
+	src := make([]complex128, n)
+	for i := range src {
+		src[i] = complex(float64(i)+0.5, float64(i%3)-1)
+	}
+
+	line := make([]complex128, n1)
+	work := make([]complex128, n)
+	dst := make([]complex128, n)
+
+	for j2 := range n2 {
+		PFAGather(line, src, input[j2*n1:(j2+1)*n1])
+		copy(line, naiveDFT(line))
+		PFAStoreColumn(work, line, j2, n2)
+	}
+
+	for k1 := range n1 {
+		row := work[k1*n2 : (k1+1)*n2]
+		copy(row, naiveDFT(row))
+		PFAScatterScaled(dst, row, output[k1*n2:(k1+1)*n2], 1, false)
+	}
+
+	want := naiveDFT(src)
+	for k := range n {
+		if cmplx.Abs(dst[k]-want[k]) > 1e-12 {
+			t.Errorf("bin %d = %v, want %v", k, dst[k], want[k])
+		}
+	}
+
+	// Reversed bins give the unscaled inverse of the conjugate direction
+	for k1 := range n1 {
+		PFAScatterScaled(dst, work[k1*n2:(k1+1)*n2], output[k1*n2:(k1+1)*n2], 0.5, true)
+	}
+
+	for k := range n {
+		if w := 0.5 * want[(n-k)%n]; cmplx.Abs(dst[k]-w) > 1e-12 {
+			t.Errorf("reversed bin %d = %v, want %v", k, dst[k], w)
+		}
+	}
+}
+
+func naiveDFT(src []complex128) []complex128 {
+	n := len(src)
+	dst := make([]complex128, n)
+
+	for k := range n {
+		for j, x := range src {
+			angle := -2 * math.Pi * float64(k*j%n) / float64(n)
+			dst[k] += x * complex(math.Cos(angle), math.Sin(angle))
+		}
+	}
+
+	return dst
+}
+
+func TestPFAModule(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{2, 3, 4, 5, 7, 11, 13} {
+		module := PFAModule[complex128](size)
+		if module == nil {
+			t.Fatalf("PFAModule(%d) = nil", size)
+		}
+
+		src := make([]complex128, size)
+		for i := range src {
+			src[i] = complex(float64(i)-0.25, float64(i*i%5)-2)
+		}
+
+		got := append([]complex128(nil), src...)
+		module(got)
+
+		want := naiveDFT(src)
+		for k := range size {
+			if cmplx.Abs(got[k]-want[k]) > 1e-12 {
+				t.Errorf("size %d: bin %d = %v, want %v", size, k, got[k], want[k])
+			}
+		}
+
+		if PFAModule[complex64](size) == nil {
+			t.Errorf("PFAModule[complex64](%d) = nil", size)
+		}
+	}
+
+	if PFAModule[complex128](9) != nil {
+		t.Error("PFAModule(9) != nil")
+	}
+}
@@ -0,0 +1,84 @@
+package kernels
+
+import (
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// Rader's algorithm turns a DFT of prime length p into a cyclic convolution
+// of length p-1. With a primitive root g, the nonzero indices are reordered
+// as x[g^k] and X[g^-q], which gives
+//
+//	X[0]      = Σ x[n]
+//	X[g^-q]   = x[0] + Σ_k x[g^k] · W_p^(g^(k-q))
+//
+// The sum is a cyclic convolution of a[k] = x[g^k] with b[k] = W_p^(g^-k),
+// computed with FFTs of length p-1.
+
+// RaderIndices returns the index maps of Rader's algorithm for the odd prime
+// p: gather[k] = g^k mod p and scatter[k] = g^-k mod p for k < p-1, where g is
+// the smallest primitive root. It returns nil slices if p is not an odd prime.
+func RaderIndices(p int) (gather, scatter []int) {
+	g := m.PrimitiveRoot(p)
+	if g == 0 {
+		return nil, nil
+	}
+
+	gather = make([]int, p-1)
+	scatter = make([]int, p-1)
+
+	// g^-1 = g^(p-2) generates the same residues in reverse.
+	gInv := m.PowMod(g, p-2, p)
+
+	x, y := 1, 1
+	for k := range p - 1 {
+		gather[k] = x
+		scatter[k] = y
+		x = m.MulMod(x, g, p)
+		y = m.MulMod(y, gInv, p)
+	}
+
+	return gather, scatter
+}
+
+// ComputeRaderSequence returns the convolution kernel b[k] = W_p^scatter[k]
+// of Rader's algorithm, or its conjugate for the inverse transform. twiddles
+// are for size p. The filter is the FFT of length p-1 of this sequence.
+func ComputeRaderSequence[T Complex](twiddles []T, scatter []int, inverse bool) []T {
+	b := make([]T, len(scatter))
+	for k, idx := range scatter {
+		b[k] = twiddles[idx]
+		if inverse {
+			b[k] = conj(b[k])
+		}
+	}
+
+	return b
+}
+
+// RaderGather stores the reordered inputs a[k] = src[gather[k]] in work and
+// returns src[0]. work must not alias src.
+func RaderGather[T Complex](work, src []T, gather []int) T {
+	for k, idx := range gather {
+		work[k] = src[idx]
+	}
+
+	return src[0]
+}
+
+// RaderScatter writes the DFT from the convolution result in work:
+// dst[0] = x0 + sum and dst[scatter[q]] = x0 + work[q]. sum is the sum of
+// the reordered inputs, i.e. bin 0 of their FFT.
+func RaderScatter[T Complex](dst, work []T, scatter []int, x0, sum T) {
+	dst[0] = x0 + sum
+	for q, idx := range scatter {
+		dst[idx] = x0 + work[q]
+	}
+}
+
+// RaderScatterScaled is RaderScatter with every output multiplied by scale.
+func RaderScatterScaled[T Complex](dst, work []T, scatter []int, x0, sum, scale T) {
+	dst[0] = (x0 + sum) * scale
+	for q, idx := range scatter {
+		dst[idx] = (x0 + work[q]) * scale
+	}
+}
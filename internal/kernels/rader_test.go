@@ -0,0 +1,83 @@
+package kernels
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestRaderIndices(t *testing.T) {
+	t.Parallel()
+
+	gather, scatter := RaderIndices(7)
+
+	// 3 is the smallest primitive root mod 7; 5 is its inverse.
+	wantGather := []int{1, 3, 2, 6, 4, 5}
+	wantScatter := []int{1, 5, 4, 6, 2, 3}
+
+	for k := range wantGather {
+		if gather[k] != wantGather[k] || scatter[k] != wantScatter[k] {
+			t.Fatalf("RaderIndices(7) = %v, %v, want %v, %v", gather, scatter, wantGather, wantScatter)
+		}
+	}
+
+	for _, p := range []int{1, 2, 9} {
+		if g, s := RaderIndices(p); g != nil || s != nil {
+			t.Errorf("RaderIndices(%d) = %v, %v, want nil", p, g, s)
+		}
+	}
+}
+
+func TestRaderTransform(t *testing.T) {
+	t.Parallel()
+
+	// p-1 = 16, so the convolution runs through the DIT kernels.
+	const p = 17
+
+	gather, scatter := RaderIndices(p)
+	twiddles := ComputeTwiddleFactors[complex128](p)
+	twiddlesL := ComputeTwiddleFactors[complex128](p - 1)
+	scratch := make([]complex128, p-1)
+
+	filter := ComputeRaderSequence(twiddles, scatter, false)
+	DITForward(filter, filter, twiddlesL, scratch)
+
+	src := make([]complex128, p)
+	for i := range src {
+		src[i] = complex(float64(i)+0.5, float64(i%3)-1)
+	}
+
+	work := make([]complex128, p-1)
+	x0 := RaderGather(work, src, gather)
+	DITForward(work, work, twiddlesL, scratch)
+	sum := work[0]
+
+	for i := range work {
+		work[i] *= filter[i]
+	}
+
+	DITInverse(work, work, twiddlesL, scratch)
+
+	dst := make([]complex128, p)
+	scaled := make([]complex128, p)
+
+	RaderScatter(dst, work, scatter, x0, sum)
+	RaderScatterScaled(scaled, work, scatter, x0, sum, 0.25)
+
+	for k := range p {
+		var want complex128
+
+		for n, x := range src {
+			angle := -2 * math.Pi * float64(k*n%p) / p
+			want += x * complex(math.Cos(angle), math.Sin(angle))
+		}
+
+		if cmplx.Abs(dst[k]-want) > 1e-12 {
+			t.Errorf("bin %d = %v, want %v", k, dst[k], want)
+		}
+
+		if cmplx.Abs(scaled[k]-want/4) > 1e-12 {
+			t.Errorf("scaled bin %d = %v, want %v", k, scaled[k], want/4)
+		}
+	}
+}
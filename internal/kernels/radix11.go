@@ -0,0 +1,253 @@
+package kernels
+
+// The radix-11 butterflies pair inputs symmetrically: with s_j = a[j] + a[11-j]
+// and d_j = a[j] - a[11-j] for j = 1..5, the outputs k and 11-k share
+//
+//	t_k = a[0] + Σ cos(2πjk/11) s_j,  u_k = Σ sin(2πjk/11) d_j
+//
+// as y_k = t_k - i·u_k and y_(11-k) = t_k + i·u_k (conjugated for the inverse).
+// That is 25 real multiply-adds per component instead of the 100 complex
+// products of a direct DFT.
+
+const (
+	radix11C1 = 0.8412535328311812  // cos(2π·1/11)
+	radix11C2 = 0.41541501300188644 // cos(2π·2/11)
+	radix11C3 = -0.142314838273285  // cos(2π·3/11)
+	radix11C4 = -0.654860733945285  // cos(2π·4/11)
+	radix11C5 = -0.9594929736144974 // cos(2π·5/11)
+	radix11S1 = 0.5406408174555976  // sin(2π·1/11)
+	radix11S2 = 0.9096319953545183  // sin(2π·2/11)
+	radix11S3 = 0.9898214418809328  // sin(2π·3/11)
+	radix11S4 = 0.7557495743542583  // sin(2π·4/11)
+	radix11S5 = 0.28173255684142967 // sin(2π·5/11)
+)
+
+// butterfly11ForwardComplex64 computes the 11-point forward DFT of a in place.
+func butterfly11ForwardComplex64(a *[11]complex64) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[10]), imag(a[1])+imag(a[10])
+	d1r, d1i := real(a[1])-real(a[10]), imag(a[1])-imag(a[10])
+	s2r, s2i := real(a[2])+real(a[9]), imag(a[2])+imag(a[9])
+	d2r, d2i := real(a[2])-real(a[9]), imag(a[2])-imag(a[9])
+	s3r, s3i := real(a[3])+real(a[8]), imag(a[3])+imag(a[8])
+	d3r, d3i := real(a[3])-real(a[8]), imag(a[3])-imag(a[8])
+	s4r, s4i := real(a[4])+real(a[7]), imag(a[4])+imag(a[7])
+	d4r, d4i := real(a[4])-real(a[7]), imag(a[4])-imag(a[7])
+	s5r, s5i := real(a[5])+real(a[6]), imag(a[5])+imag(a[6])
+	d5r, d5i := real(a[5])-real(a[6]), imag(a[5])-imag(a[6])
+
+	a[0] = complex(x0r+s1r+s2r+s3r+s4r+s5r, x0i+s1i+s2i+s3i+s4i+s5i)
+
+	tr := x0r + radix11C1*s1r + radix11C2*s2r + radix11C3*s3r + radix11C4*s4r + radix11C5*s5r
+	ti := x0i + radix11C1*s1i + radix11C2*s2i + radix11C3*s3i + radix11C4*s4i + radix11C5*s5i
+	ur := radix11S1*d1r + radix11S2*d2r + radix11S3*d3r + radix11S4*d4r + radix11S5*d5r
+	ui := radix11S1*d1i + radix11S2*d2i + radix11S3*d3i + radix11S4*d4i + radix11S5*d5i
+	a[1] = complex(tr+ui, ti-ur)
+	a[10] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix11C2*s1r + radix11C4*s2r + radix11C5*s3r + radix11C3*s4r + radix11C1*s5r
+	ti = x0i + radix11C2*s1i + radix11C4*s2i + radix11C5*s3i + radix11C3*s4i + radix11C1*s5i
+	ur = radix11S2*d1r + radix11S4*d2r - radix11S5*d3r - radix11S3*d4r - radix11S1*d5r
+	ui = radix11S2*d1i + radix11S4*d2i - radix11S5*d3i - radix11S3*d4i - radix11S1*d5i
+	a[2] = complex(tr+ui, ti-ur)
+	a[9] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix11C3*s1r + radix11C5*s2r + radix11C2*s3r + radix11C1*s4r + radix11C4*s5r
+	ti = x0i + radix11C3*s1i + radix11C5*s2i + radix11C2*s3i + radix11C1*s4i + radix11C4*s5i
+	ur = radix11S3*d1r - radix11S5*d2r - radix11S2*d3r + radix11S1*d4r + radix11S4*d5r
+	ui = radix11S3*d1i - radix11S5*d2i - radix11S2*d3i + radix11S1*d4i + radix11S4*d5i
+	a[3] = complex(tr+ui, ti-ur)
+	a[8] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix11C4*s1r + radix11C3*s2r + radix11C1*s3r + radix11C5*s4r + radix11C2*s5r
+	ti = x0i + radix11C4*s1i + radix11C3*s2i + radix11C1*s3i + radix11C5*s4i + radix11C2*s5i
+	ur = radix11S4*d1r - radix11S3*d2r + radix11S1*d3r + radix11S5*d4r - radix11S2*d5r
+	ui = radix11S4*d1i - radix11S3*d2i + radix11S1*d3i + radix11S5*d4i - radix11S2*d5i
+	a[4] = complex(tr+ui, ti-ur)
+	a[7] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix11C5*s1r + radix11C1*s2r + radix11C4*s3r + radix11C2*s4r + radix11C3*s5r
+	ti = x0i + radix11C5*s1i + radix11C1*s2i + radix11C4*s3i + radix11C2*s4i + radix11C3*s5i
+	ur = radix11S5*d1r - radix11S1*d2r + radix11S4*d3r - radix11S2*d4r + radix11S3*d5r
+	ui = radix11S5*d1i - radix11S1*d2i + radix11S4*d3i - radix11S2*d4i + radix11S3*d5i
+	a[5] = complex(tr+ui, ti-ur)
+	a[6] = complex(tr-ui, ti+ur)
+}
+
+// butterfly11InverseComplex64 computes the 11-point inverse DFT of a in place without the 1/11 scaling.
+func butterfly11InverseComplex64(a *[11]complex64) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[10]), imag(a[1])+imag(a[10])
+	d1r, d1i := real(a[1])-real(a[10]), imag(a[1])-imag(a[10])
+	s2r, s2i := real(a[2])+real(a[9]), imag(a[2])+imag(a[9])
+	d2r, d2i := real(a[2])-real(a[9]), imag(a[2])-imag(a[9])
+	s3r, s3i := real(a[3])+real(a[8]), imag(a[3])+imag(a[8])
+	d3r, d3i := real(a[3])-real(a[8]), imag(a[3])-imag(a[8])
+	s4r, s4i := real(a[4])+real(a[7]), imag(a[4])+imag(a[7])
+	d4r, d4i := real(a[4])-real(a[7]), imag(a[4])-imag(a[7])
+	s5r, s5i := real(a[5])+real(a[6]), imag(a[5])+imag(a[6])
+	d5r, d5i := real(a[5])-real(a[6]), imag(a[5])-imag(a[6])
+
+	a[0] = complex(x0r+s1r+s2r+s3r+s4r+s5r, x0i+s1i+s2i+s3i+s4i+s5i)
+
+	tr := x0r + radix11C1*s1r + radix11C2*s2r + radix11C3*s3r + radix11C4*s4r + radix11C5*s5r
+	ti := x0i + radix11C1*s1i + radix11C2*s2i + radix11C3*s3i + radix11C4*s4i + radix11C5*s5i
+	ur := radix11S1*d1r + radix11S2*d2r + radix11S3*d3r + radix11S4*d4r + radix11S5*d5r
+	ui := radix11S1*d1i + radix11S2*d2i + radix11S3*d3i + radix11S4*d4i + radix11S5*d5i
+	a[1] = complex(tr-ui, ti+ur)
+	a[10] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix11C2*s1r + radix11C4*s2r + radix11C5*s3r + radix11C3*s4r + radix11C1*s5r
+	ti = x0i + radix11C2*s1i + radix11C4*s2i + radix11C5*s3i + radix11C3*s4i + radix11C1*s5i
+	ur = radix11S2*d1r + radix11S4*d2r - radix11S5*d3r - radix11S3*d4r - radix11S1*d5r
+	ui = radix11S2*d1i + radix11S4*d2i - radix11S5*d3i - radix11S3*d4i - radix11S1*d5i
+	a[2] = complex(tr-ui, ti+ur)
+	a[9] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix11C3*s1r + radix11C5*s2r + radix11C2*s3r + radix11C1*s4r + radix11C4*s5r
+	ti = x0i + radix11C3*s1i + radix11C5*s2i + radix11C2*s3i + radix11C1*s4i + radix11C4*s5i
+	ur = radix11S3*d1r - radix11S5*d2r - radix11S2*d3r + radix11S1*d4r + radix11S4*d5r
+	ui = radix11S3*d1i - radix11S5*d2i - radix11S2*d3i + radix11S1*d4i + radix11S4*d5i
+	a[3] = complex(tr-ui, ti+ur)
+	a[8] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix11C4*s1r + radix11C3*s2r + radix11C1*s3r + radix11C5*s4r + radix11C2*s5r
+	ti = x0i + radix11C4*s1i + radix11C3*s2i + radix11C1*s3i + radix11C5*s4i + radix11C2*s5i
+	ur = radix11S4*d1r - radix11S3*d2r + radix11S1*d3r + radix11S5*d4r - radix11S2*d5r
+	ui = radix11S4*d1i - radix11S3*d2i + radix11S1*d3i + radix11S5*d4i - radix11S2*d5i
+	a[4] = complex(tr-ui, ti+ur)
+	a[7] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix11C5*s1r + radix11C1*s2r + radix11C4*s3r + radix11C2*s4r + radix11C3*s5r
+	ti = x0i + radix11C5*s1i + radix11C1*s2i + radix11C4*s3i + radix11C2*s4i + radix11C3*s5i
+	ur = radix11S5*d1r - radix11S1*d2r + radix11S4*d3r - radix11S2*d4r + radix11S3*d5r
+	ui = radix11S5*d1i - radix11S1*d2i + radix11S4*d3i - radix11S2*d4i + radix11S3*d5i
+	a[5] = complex(tr-ui, ti+ur)
+	a[6] = complex(tr+ui, ti-ur)
+}
+
+// butterfly11ForwardComplex128 computes the 11-point forward DFT of a in place.
+func butterfly11ForwardComplex128(a *[11]complex128) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[10]), imag(a[1])+imag(a[10])
+	d1r, d1i := real(a[1])-real(a[10]), imag(a[1])-imag(a[10])
+	s2r, s2i := real(a[2])+real(a[9]), imag(a[2])+imag(a[9])
+	d2r, d2i := real(a[2])-real(a[9]), imag(a[2])-imag(a[9])
+	s3r, s3i := real(a[3])+real(a[8]), imag(a[3])+imag(a[8])
+	d3r, d3i := real(a[3])-real(a[8]), imag(a[3])-imag(a[8])
+	s4r, s4i := real(a[4])+real(a[7]), imag(a[4])+imag(a[7])
+	d4r, d4i := real(a[4])-real(a[7]), imag(a[4])-imag(a[7])
+	s5r, s5i := real(a[5])+real(a[6]), imag(a[5])+imag(a[6])
+	d5r, d5i := real(a[5])-real(a[6]), imag(a[5])-imag(a[6])
+
+	a[0] = complex(x0r+s1r+s2r+s3r+s4r+s5r, x0i+s1i+s2i+s3i+s4i+s5i)
+
+	tr := x0r + radix11C1*s1r + radix11C2*s2r + radix11C3*s3r + radix11C4*s4r + radix11C5*s5r
+	ti := x0i + radix11C1*s1i + radix11C2*s2i + radix11C3*s3i + radix11C4*s4i + radix11C5*s5i
+	ur := radix11S1*d1r + radix11S2*d2r + radix11S3*d3r + radix11S4*d4r + radix11S5*d5r
+	ui := radix11S1*d1i + radix11S2*d2i + radix11S3*d3i + radix11S4*d4i + radix11S5*d5i
+	a[1] = complex(tr+ui, ti-ur)
+	a[10] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix11C2*s1r + radix11C4*s2r + radix11C5*s3r + radix11C3*s4r + radix11C1*s5r
+	ti = x0i + radix11C2*s1i + radix11C4*s2i + radix11C5*s3i + radix11C3*s4i + radix11C1*s5i
+	ur = radix11S2*d1r + radix11S4*d2r - radix11S5*d3r - radix11S3*d4r - radix11S1*d5r
+	ui = radix11S2*d1i + radix11S4*d2i - radix11S5*d3i - radix11S3*d4i - radix11S1*d5i
+	a[2] = complex(tr+ui, ti-ur)
+	a[9] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix11C3*s1r + radix11C5*s2r + radix11C2*s3r + radix11C1*s4r + radix11C4*s5r
+	ti = x0i + radix11C3*s1i + radix11C5*s2i + radix11C2*s3i + radix11C1*s4i + radix11C4*s5i
+	ur = radix11S3*d1r - radix11S5*d2r - radix11S2*d3r + radix11S1*d4r + radix11S4*d5r
+	ui = radix11S3*d1i - radix11S5*d2i - radix11S2*d3i + radix11S1*d4i + radix11S4*d5i
+	a[3] = complex(tr+ui, ti-ur)
+	a[8] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix11C4*s1r + radix11C3*s2r + radix11C1*s3r + radix11C5*s4r + radix11C2*s5r
+	ti = x0i + radix11C4*s1i + radix11C3*s2i + radix11C1*s3i + radix11C5*s4i + radix11C2*s5i
+	ur = radix11S4*d1r - radix11S3*d2r + radix11S1*d3r + radix11S5*d4r - radix11S2*d5r
+	ui = radix11S4*d1i - radix11S3*d2i + radix11S1*d3i + radix11S5*d4i - radix11S2*d5i
+	a[4] = complex(tr+ui, ti-ur)
+	a[7] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix11C5*s1r + radix11C1*s2r + radix11C4*s3r + radix11C2*s4r + radix11C3*s5r
+	ti = x0i + radix11C5*s1i + radix11C1*s2i + radix11C4*s3i + radix11C2*s4i + radix11C3*s5i
+	ur = radix11S5*d1r - radix11S1*d2r + radix11S4*d3r - radix11S2*d4r + radix11S3*d5r
+	ui = radix11S5*d1i - radix11S1*d2i + radix11S4*d3i - radix11S2*d4i + radix11S3*d5i
+	a[5] = complex(tr+ui, ti-ur)
+	a[6] = complex(tr-ui, ti+ur)
+}
+
+// butterfly11InverseComplex128 computes the 11-point inverse DFT of a in place without the 1/11 scaling.
+func butterfly11InverseComplex128(a *[11]complex128) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[10]), imag(a[1])+imag(a[10])
+	d1r, d1i := real(a[1])-real(a[10]), imag(a[1])-imag(a[10])
+	s2r, s2i := real(a[2])+real(a[9]), imag(a[2])+imag(a[9])
+	d2r, d2i := real(a[2])-real(a[9]), imag(a[2])-imag(a[9])
+	s3r, s3i := real(a[3])+real(a[8]), imag(a[3])+imag(a[8])
+	d3r, d3i := real(a[3])-real(a[8]), imag(a[3])-imag(a[8])
+	s4r, s4i := real(a[4])+real(a[7]), imag(a[4])+imag(a[7])
+	d4r, d4i := real(a[4])-real(a[7]), imag(a[4])-imag(a[7])
+	s5r, s5i := real(a[5])+real(a[6]), imag(a[5])+imag(a[6])
+	d5r, d5i := real(a[5])-real(a[6]), imag(a[5])-imag(a[6])
+
+	a[0] = complex(x0r+s1r+s2r+s3r+s4r+s5r, x0i+s1i+s2i+s3i+s4i+s5i)
+
+	tr := x0r + radix11C1*s1r + radix11C2*s2r + radix11C3*s3r + radix11C4*s4r + radix11C5*s5r
+	ti := x0i + radix11C1*s1i + radix11C2*s2i + radix11C3*s3i + radix11C4*s4i + radix11C5*s5i
+	ur := radix11S1*d1r + radix11S2*d2r + radix11S3*d3r + radix11S4*d4r + radix11S5*d5r
+	ui := radix11S1*d1i + radix11S2*d2i + radix11S3*d3i + radix11S4*d4i + radix11S5*d5i
+	a[1] = complex(tr-ui, ti+ur)
+	a[10] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix11C2*s1r + radix11C4*s2r + radix11C5*s3r + radix11C3*s4r + radix11C1*s5r
+	ti = x0i + radix11C2*s1i + radix11C4*s2i + radix11C5*s3i + radix11C3*s4i + radix11C1*s5i
+	ur = radix11S2*d1r + radix11S4*d2r - radix11S5*d3r - radix11S3*d4r - radix11S1*d5r
+	ui = radix11S2*d1i + radix11S4*d2i - radix11S5*d3i - radix11S3*d4i - radix11S1*d5i
+	a[2] = complex(tr-ui, ti+ur)
+	a[9] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix11C3*s1r + radix11C5*s2r + radix11C2*s3r + radix11C1*s4r + radix11C4*s5r
+	ti = x0i + radix11C3*s1i + radix11C5*s2i + radix11C2*s3i + radix11C1*s4i + radix11C4*s5i
+	ur = radix11S3*d1r - radix11S5*d2r - radix11S2*d3r + radix11S1*d4r + radix11S4*d5r
+	ui = radix11S3*d1i - radix11S5*d2i - radix11S2*d3i + radix11S1*d4i + radix11S4*d5i
+	a[3] = complex(tr-ui, ti+ur)
+	a[8] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix11C4*s1r + radix11C3*s2r + radix11C1*s3r + radix11C5*s4r + radix11C2*s5r
+	ti = x0i + radix11C4*s1i + radix11C3*s2i + radix11C1*s3i + radix11C5*s4i + radix11C2*s5i
+	ur = radix11S4*d1r - radix11S3*d2r + radix11S1*d3r + radix11S5*d4r - radix11S2*d5r
+	ui = radix11S4*d1i - radix11S3*d2i + radix11S1*d3i + radix11S5*d4i - radix11S2*d5i
+	a[4] = complex(tr-ui, ti+ur)
+	a[7] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix11C5*s1r + radix11C1*s2r + radix11C4*s3r + radix11C2*s4r + radix11C3*s5r
+	ti = x0i + radix11C5*s1i + radix11C1*s2i + radix11C4*s3i + radix11C2*s4i + radix11C3*s5i
+	ur = radix11S5*d1r - radix11S1*d2r + radix11S4*d3r - radix11S2*d4r + radix11S3*d5r
+	ui = radix11S5*d1i - radix11S1*d2i + radix11S4*d3i - radix11S2*d4i + radix11S3*d5i
+	a[5] = complex(tr-ui, ti+ur)
+	a[6] = complex(tr+ui, ti-ur)
+}
+
+func butterfly11Forward[T Complex](a *[11]T) {
+	switch a := any(a).(type) {
+	case *[11]complex64:
+		butterfly11ForwardComplex64(a)
+	case *[11]complex128:
+		butterfly11ForwardComplex128(a)
+	default:
+		panic("unsupported complex type")
+	}
+}
+
+func butterfly11Inverse[T Complex](a *[11]T) {
+	switch a := any(a).(type) {
+	case *[11]complex64:
+		butterfly11InverseComplex64(a)
+	case *[11]complex128:
+		butterfly11InverseComplex128(a)
+	default:
+		panic("unsupported complex type")
+	}
+}
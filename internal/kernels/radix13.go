@@ -0,0 +1,291 @@
+package kernels
+
+// The radix-13 butterflies pair inputs symmetrically: with s_j = a[j] + a[13-j]
+// and d_j = a[j] - a[13-j] for j = 1..6, the outputs k and 13-k share
+//
+//	t_k = a[0] + Σ cos(2πjk/13) s_j,  u_k = Σ sin(2πjk/13) d_j
+//
+// as y_k = t_k - i·u_k and y_(13-k) = t_k + i·u_k (conjugated for the inverse).
+// That is 36 real multiply-adds per component instead of the 144 complex
+// products of a direct DFT.
+
+const (
+	radix13C1 = 0.8854560256532099   // cos(2π·1/13)
+	radix13C2 = 0.5680647467311559   // cos(2π·2/13)
+	radix13C3 = 0.120536680255323    // cos(2π·3/13)
+	radix13C4 = -0.35460488704253545 // cos(2π·4/13)
+	radix13C5 = -0.7485107481711012  // cos(2π·5/13)
+	radix13C6 = -0.970941817426052   // cos(2π·6/13)
+	radix13S1 = 0.4647231720437685   // sin(2π·1/13)
+	radix13S2 = 0.8229838658936564   // sin(2π·2/13)
+	radix13S3 = 0.992708874098054    // sin(2π·3/13)
+	radix13S4 = 0.9350162426854148   // sin(2π·4/13)
+	radix13S5 = 0.6631226582407952   // sin(2π·5/13)
+	radix13S6 = 0.23931566428755768  // sin(2π·6/13)
+)
+
+// butterfly13ForwardComplex64 computes the 13-point forward DFT of a in place.
+func butterfly13ForwardComplex64(a *[13]complex64) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[12]), imag(a[1])+imag(a[12])
+	d1r, d1i := real(a[1])-real(a[12]), imag(a[1])-imag(a[12])
+	s2r, s2i := real(a[2])+real(a[11]), imag(a[2])+imag(a[11])
+	d2r, d2i := real(a[2])-real(a[11]), imag(a[2])-imag(a[11])
+	s3r, s3i := real(a[3])+real(a[10]), imag(a[3])+imag(a[10])
+	d3r, d3i := real(a[3])-real(a[10]), imag(a[3])-imag(a[10])
+	s4r, s4i := real(a[4])+real(a[9]), imag(a[4])+imag(a[9])
+	d4r, d4i := real(a[4])-real(a[9]), imag(a[4])-imag(a[9])
+	s5r, s5i := real(a[5])+real(a[8]), imag(a[5])+imag(a[8])
+	d5r, d5i := real(a[5])-real(a[8]), imag(a[5])-imag(a[8])
+	s6r, s6i := real(a[6])+real(a[7]), imag(a[6])+imag(a[7])
+	d6r, d6i := real(a[6])-real(a[7]), imag(a[6])-imag(a[7])
+
+	a[0] = complex(x0r+s1r+s2r+s3r+s4r+s5r+s6r, x0i+s1i+s2i+s3i+s4i+s5i+s6i)
+
+	tr := x0r + radix13C1*s1r + radix13C2*s2r + radix13C3*s3r + radix13C4*s4r + radix13C5*s5r + radix13C6*s6r
+	ti := x0i + radix13C1*s1i + radix13C2*s2i + radix13C3*s3i + radix13C4*s4i + radix13C5*s5i + radix13C6*s6i
+	ur := radix13S1*d1r + radix13S2*d2r + radix13S3*d3r + radix13S4*d4r + radix13S5*d5r + radix13S6*d6r
+	ui := radix13S1*d1i + radix13S2*d2i + radix13S3*d3i + radix13S4*d4i + radix13S5*d5i + radix13S6*d6i
+	a[1] = complex(tr+ui, ti-ur)
+	a[12] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C2*s1r + radix13C4*s2r + radix13C6*s3r + radix13C5*s4r + radix13C3*s5r + radix13C1*s6r
+	ti = x0i + radix13C2*s1i + radix13C4*s2i + radix13C6*s3i + radix13C5*s4i + radix13C3*s5i + radix13C1*s6i
+	ur = radix13S2*d1r + radix13S4*d2r + radix13S6*d3r - radix13S5*d4r - radix13S3*d5r - radix13S1*d6r
+	ui = radix13S2*d1i + radix13S4*d2i + radix13S6*d3i - radix13S5*d4i - radix13S3*d5i - radix13S1*d6i
+	a[2] = complex(tr+ui, ti-ur)
+	a[11] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C3*s1r + radix13C6*s2r + radix13C4*s3r + radix13C1*s4r + radix13C2*s5r + radix13C5*s6r
+	ti = x0i + radix13C3*s1i + radix13C6*s2i + radix13C4*s3i + radix13C1*s4i + radix13C2*s5i + radix13C5*s6i
+	ur = radix13S3*d1r + radix13S6*d2r - radix13S4*d3r - radix13S1*d4r + radix13S2*d5r + radix13S5*d6r
+	ui = radix13S3*d1i + radix13S6*d2i - radix13S4*d3i - radix13S1*d4i + radix13S2*d5i + radix13S5*d6i
+	a[3] = complex(tr+ui, ti-ur)
+	a[10] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C4*s1r + radix13C5*s2r + radix13C1*s3r + radix13C3*s4r + radix13C6*s5r + radix13C2*s6r
+	ti = x0i + radix13C4*s1i + radix13C5*s2i + radix13C1*s3i + radix13C3*s4i + radix13C6*s5i + radix13C2*s6i
+	ur = radix13S4*d1r - radix13S5*d2r - radix13S1*d3r + radix13S3*d4r - radix13S6*d5r - radix13S2*d6r
+	ui = radix13S4*d1i - radix13S5*d2i - radix13S1*d3i + radix13S3*d4i - radix13S6*d5i - radix13S2*d6i
+	a[4] = complex(tr+ui, ti-ur)
+	a[9] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C5*s1r + radix13C3*s2r + radix13C2*s3r + radix13C6*s4r + radix13C1*s5r + radix13C4*s6r
+	ti = x0i + radix13C5*s1i + radix13C3*s2i + radix13C2*s3i + radix13C6*s4i + radix13C1*s5i + radix13C4*s6i
+	ur = radix13S5*d1r - radix13S3*d2r + radix13S2*d3r - radix13S6*d4r - radix13S1*d5r + radix13S4*d6r
+	ui = radix13S5*d1i - radix13S3*d2i + radix13S2*d3i - radix13S6*d4i - radix13S1*d5i + radix13S4*d6i
+	a[5] = complex(tr+ui, ti-ur)
+	a[8] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C6*s1r + radix13C1*s2r + radix13C5*s3r + radix13C2*s4r + radix13C4*s5r + radix13C3*s6r
+	ti = x0i + radix13C6*s1i + radix13C1*s2i + radix13C5*s3i + radix13C2*s4i + radix13C4*s5i + radix13C3*s6i
+	ur = radix13S6*d1r - radix13S1*d2r + radix13S5*d3r - radix13S2*d4r + radix13S4*d5r - radix13S3*d6r
+	ui = radix13S6*d1i - radix13S1*d2i + radix13S5*d3i - radix13S2*d4i + radix13S4*d5i - radix13S3*d6i
+	a[6] = complex(tr+ui, ti-ur)
+	a[7] = complex(tr-ui, ti+ur)
+}
+
+// butterfly13InverseComplex64 computes the 13-point inverse DFT of a in place without the 1/13 scaling.
+func butterfly13InverseComplex64(a *[13]complex64) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[12]), imag(a[1])+imag(a[12])
+	d1r, d1i := real(a[1])-real(a[12]), imag(a[1])-imag(a[12])
+	s2r, s2i := real(a[2])+real(a[11]), imag(a[2])+imag(a[11])
+	d2r, d2i := real(a[2])-real(a[11]), imag(a[2])-imag(a[11])
+	s3r, s3i := real(a[3])+real(a[10]), imag(a[3])+imag(a[10])
+	d3r, d3i := real(a[3])-real(a[10]), imag(a[3])-imag(a[10])
+	s4r, s4i := real(a[4])+real(a[9]), imag(a[4])+imag(a[9])
+	d4r, d4i := real(a[4])-real(a[9]), imag(a[4])-imag(a[9])
+	s5r, s5i := real(a[5])+real(a[8]), imag(a[5])+imag(a[8])
+	d5r, d5i := real(a[5])-real(a[8]), imag(a[5])-imag(a[8])
+	s6r, s6i := real(a[6])+real(a[7]), imag(a[6])+imag(a[7])
+	d6r, d6i := real(a[6])-real(a[7]), imag(a[6])-imag(a[7])
+
+	a[0] = complex(x0r+s1r+s2r+s3r+s4r+s5r+s6r, x0i+s1i+s2i+s3i+s4i+s5i+s6i)
+
+	tr := x0r + radix13C1*s1r + radix13C2*s2r + radix13C3*s3r + radix13C4*s4r + radix13C5*s5r + radix13C6*s6r
+	ti := x0i + radix13C1*s1i + radix13C2*s2i + radix13C3*s3i + radix13C4*s4i + radix13C5*s5i + radix13C6*s6i
+	ur := radix13S1*d1r + radix13S2*d2r + radix13S3*d3r + radix13S4*d4r + radix13S5*d5r + radix13S6*d6r
+	ui := radix13S1*d1i + radix13S2*d2i + radix13S3*d3i + radix13S4*d4i + radix13S5*d5i + radix13S6*d6i
+	a[1] = complex(tr-ui, ti+ur)
+	a[12] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C2*s1r + radix13C4*s2r + radix13C6*s3r + radix13C5*s4r + radix13C3*s5r + radix13C1*s6r
+	ti = x0i + radix13C2*s1i + radix13C4*s2i + radix13C6*s3i + radix13C5*s4i + radix13C3*s5i + radix13C1*s6i
+	ur = radix13S2*d1r + radix13S4*d2r + radix13S6*d3r - radix13S5*d4r - radix13S3*d5r - radix13S1*d6r
+	ui = radix13S2*d1i + radix13S4*d2i + radix13S6*d3i - radix13S5*d4i - radix13S3*d5i - radix13S1*d6i
+	a[2] = complex(tr-ui, ti+ur)
+	a[11] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C3*s1r + radix13C6*s2r + radix13C4*s3r + radix13C1*s4r + radix13C2*s5r + radix13C5*s6r
+	ti = x0i + radix13C3*s1i + radix13C6*s2i + radix13C4*s3i + radix13C1*s4i + radix13C2*s5i + radix13C5*s6i
+	ur = radix13S3*d1r + radix13S6*d2r - radix13S4*d3r - radix13S1*d4r + radix13S2*d5r + radix13S5*d6r
+	ui = radix13S3*d1i + radix13S6*d2i - radix13S4*d3i - radix13S1*d4i + radix13S2*d5i + radix13S5*d6i
+	a[3] = complex(tr-ui, ti+ur)
+	a[10] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C4*s1r + radix13C5*s2r + radix13C1*s3r + radix13C3*s4r + radix13C6*s5r + radix13C2*s6r
+	ti = x0i + radix13C4*s1i + radix13C5*s2i + radix13C1*s3i + radix13C3*s4i + radix13C6*s5i + radix13C2*s6i
+	ur = radix13S4*d1r - radix13S5*d2r - radix13S1*d3r + radix13S3*d4r - radix13S6*d5r - radix13S2*d6r
+	ui = radix13S4*d1i - radix13S5*d2i - radix13S1*d3i + radix13S3*d4i - radix13S6*d5i - radix13S2*d6i
+	a[4] = complex(tr-ui, ti+ur)
+	a[9] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C5*s1r + radix13C3*s2r + radix13C2*s3r + radix13C6*s4r + radix13C1*s5r + radix13C4*s6r
+	ti = x0i + radix13C5*s1i + radix13C3*s2i + radix13C2*s3i + radix13C6*s4i + radix13C1*s5i + radix13C4*s6i
+	ur = radix13S5*d1r - radix13S3*d2r + radix13S2*d3r - radix13S6*d4r - radix13S1*d5r + radix13S4*d6r
+	ui = radix13S5*d1i - radix13S3*d2i + radix13S2*d3i - radix13S6*d4i - radix13S1*d5i + radix13S4*d6i
+	a[5] = complex(tr-ui, ti+ur)
+	a[8] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C6*s1r + radix13C1*s2r + radix13C5*s3r + radix13C2*s4r + radix13C4*s5r + radix13C3*s6r
+	ti = x0i + radix13C6*s1i + radix13C1*s2i + radix13C5*s3i + radix13C2*s4i + radix13C4*s5i + radix13C3*s6i
+	ur = radix13S6*d1r - radix13S1*d2r + radix13S5*d3r - radix13S2*d4r + radix13S4*d5r - radix13S3*d6r
+	ui = radix13S6*d1i - radix13S1*d2i + radix13S5*d3i - radix13S2*d4i + radix13S4*d5i - radix13S3*d6i
+	a[6] = complex(tr-ui, ti+ur)
+	a[7] = complex(tr+ui, ti-ur)
+}
+
+// butterfly13ForwardComplex128 computes the 13-point forward DFT of a in place.
+func butterfly13ForwardComplex128(a *[13]complex128) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[12]), imag(a[1])+imag(a[12])
+	d1r, d1i := real(a[1])-real(a[12]), imag(a[1])-imag(a[12])
+	s2r, s2i := real(a[2])+real(a[11]), imag(a[2])+imag(a[11])
+	d2r, d2i := real(a[2])-real(a[11]), imag(a[2])-imag(a[11])
+	s3r, s3i := real(a[3])+real(a[10]), imag(a[3])+imag(a[10])
+	d3r, d3i := real(a[3])-real(a[10]), imag(a[3])-imag(a[10])
+	s4r, s4i := real(a[4])+real(a[9]), imag(a[4])+imag(a[9])
+	d4r, d4i := real(a[4])-real(a[9]), imag(a[4])-imag(a[9])
+	s5r, s5i := real(a[5])+real(a[8]), imag(a[5])+imag(a[8])
+	d5r, d5i := real(a[5])-real(a[8]), imag(a[5])-imag(a[8])
+	s6r, s6i := real(a[6])+real(a[7]), imag(a[6])+imag(a[7])
+	d6r, d6i := real(a[6])-real(a[7]), imag(a[6])-imag(a[7])
+
+	a[0] = complex(x0r+s1r+s2r+s3r+s4r+s5r+s6r, x0i+s1i+s2i+s3i+s4i+s5i+s6i)
+
+	tr := x0r + radix13C1*s1r + radix13C2*s2r + radix13C3*s3r + radix13C4*s4r + radix13C5*s5r + radix13C6*s6r
+	ti := x0i + radix13C1*s1i + radix13C2*s2i + radix13C3*s3i + radix13C4*s4i + radix13C5*s5i + radix13C6*s6i
+	ur := radix13S1*d1r + radix13S2*d2r + radix13S3*d3r + radix13S4*d4r + radix13S5*d5r + radix13S6*d6r
+	ui := radix13S1*d1i + radix13S2*d2i + radix13S3*d3i + radix13S4*d4i + radix13S5*d5i + radix13S6*d6i
+	a[1] = complex(tr+ui, ti-ur)
+	a[12] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C2*s1r + radix13C4*s2r + radix13C6*s3r + radix13C5*s4r + radix13C3*s5r + radix13C1*s6r
+	ti = x0i + radix13C2*s1i + radix13C4*s2i + radix13C6*s3i + radix13C5*s4i + radix13C3*s5i + radix13C1*s6i
+	ur = radix13S2*d1r + radix13S4*d2r + radix13S6*d3r - radix13S5*d4r - radix13S3*d5r - radix13S1*d6r
+	ui = radix13S2*d1i + radix13S4*d2i + radix13S6*d3i - radix13S5*d4i - radix13S3*d5i - radix13S1*d6i
+	a[2] = complex(tr+ui, ti-ur)
+	a[11] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C3*s1r + radix13C6*s2r + radix13C4*s3r + radix13C1*s4r + radix13C2*s5r + radix13C5*s6r
+	ti = x0i + radix13C3*s1i + radix13C6*s2i + radix13C4*s3i + radix13C1*s4i + radix13C2*s5i + radix13C5*s6i
+	ur = radix13S3*d1r + radix13S6*d2r - radix13S4*d3r - radix13S1*d4r + radix13S2*d5r + radix13S5*d6r
+	ui = radix13S3*d1i + radix13S6*d2i - radix13S4*d3i - radix13S1*d4i + radix13S2*d5i + radix13S5*d6i
+	a[3] = complex(tr+ui, ti-ur)
+	a[10] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C4*s1r + radix13C5*s2r + radix13C1*s3r + radix13C3*s4r + radix13C6*s5r + radix13C2*s6r
+	ti = x0i + radix13C4*s1i + radix13C5*s2i + radix13C1*s3i + radix13C3*s4i + radix13C6*s5i + radix13C2*s6i
+	ur = radix13S4*d1r - radix13S5*d2r - radix13S1*d3r + radix13S3*d4r - radix13S6*d5r - radix13S2*d6r
+	ui = radix13S4*d1i - radix13S5*d2i - radix13S1*d3i + radix13S3*d4i - radix13S6*d5i - radix13S2*d6i
+	a[4] = complex(tr+ui, ti-ur)
+	a[9] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C5*s1r + radix13C3*s2r + radix13C2*s3r + radix13C6*s4r + radix13C1*s5r + radix13C4*s6r
+	ti = x0i + radix13C5*s1i + radix13C3*s2i + radix13C2*s3i + radix13C6*s4i + radix13C1*s5i + radix13C4*s6i
+	ur = radix13S5*d1r - radix13S3*d2r + radix13S2*d3r - radix13S6*d4r - radix13S1*d5r + radix13S4*d6r
+	ui = radix13S5*d1i - radix13S3*d2i + radix13S2*d3i - radix13S6*d4i - radix13S1*d5i + radix13S4*d6i
+	a[5] = complex(tr+ui, ti-ur)
+	a[8] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix13C6*s1r + radix13C1*s2r + radix13C5*s3r + radix13C2*s4r + radix13C4*s5r + radix13C3*s6r
+	ti = x0i + radix13C6*s1i + radix13C1*s2i + radix13C5*s3i + radix13C2*s4i + radix13C4*s5i + radix13C3*s6i
+	ur = radix13S6*d1r - radix13S1*d2r + radix13S5*d3r - radix13S2*d4r + radix13S4*d5r - radix13S3*d6r
+	ui = radix13S6*d1i - radix13S1*d2i + radix13S5*d3i - radix13S2*d4i + radix13S4*d5i - radix13S3*d6i
+	a[6] = complex(tr+ui, ti-ur)
+	a[7] = complex(tr-ui, ti+ur)
+}
+
+// butterfly13InverseComplex128 computes the 13-point inverse DFT of a in place without the 1/13 scaling.
+func butterfly13InverseComplex128(a *[13]complex128) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[12]), imag(a[1])+imag(a[12])
+	d1r, d1i := real(a[1])-real(a[12]), imag(a[1])-imag(a[12])
+	s2r, s2i := real(a[2])+real(a[11]), imag(a[2])+imag(a[11])
+	d2r, d2i := real(a[2])-real(a[11]), imag(a[2])-imag(a[11])
+	s3r, s3i := real(a[3])+real(a[10]), imag(a[3])+imag(a[10])
+	d3r, d3i := real(a[3])-real(a[10]), imag(a[3])-imag(a[10])
+	s4r, s4i := real(a[4])+real(a[9]), imag(a[4])+imag(a[9])
+	d4r, d4i := real(a[4])-real(a[9]), imag(a[4])-imag(a[9])
+	s5r, s5i := real(a[5])+real(a[8]), imag(a[5])+imag(a[8])
+	d5r, d5i := real(a[5])-real(a[8]), imag(a[5])-imag(a[8])
+	s6r, s6i := real(a[6])+real(a[7]), imag(a[6])+imag(a[7])
+	d6r, d6i := real(a[6])-real(a[7]), imag(a[6])-imag(a[7])
+
+	a[0] = complex(x0r+s1r+s2r+s3r+s4r+s5r+s6r, x0i+s1i+s2i+s3i+s4i+s5i+s6i)
+
+	tr := x0r + radix13C1*s1r + radix13C2*s2r + radix13C3*s3r + radix13C4*s4r + radix13C5*s5r + radix13C6*s6r
+	ti := x0i + radix13C1*s1i + radix13C2*s2i + radix13C3*s3i + radix13C4*s4i + radix13C5*s5i + radix13C6*s6i
+	ur := radix13S1*d1r + radix13S2*d2r + radix13S3*d3r + radix13S4*d4r + radix13S5*d5r + radix13S6*d6r
+	ui := radix13S1*d1i + radix13S2*d2i + radix13S3*d3i + radix13S4*d4i + radix13S5*d5i + radix13S6*d6i
+	a[1] = complex(tr-ui, ti+ur)
+	a[12] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C2*s1r + radix13C4*s2r + radix13C6*s3r + radix13C5*s4r + radix13C3*s5r + radix13C1*s6r
+	ti = x0i + radix13C2*s1i + radix13C4*s2i + radix13C6*s3i + radix13C5*s4i + radix13C3*s5i + radix13C1*s6i
+	ur = radix13S2*d1r + radix13S4*d2r + radix13S6*d3r - radix13S5*d4r - radix13S3*d5r - radix13S1*d6r
+	ui = radix13S2*d1i + radix13S4*d2i + radix13S6*d3i - radix13S5*d4i - radix13S3*d5i - radix13S1*d6i
+	a[2] = complex(tr-ui, ti+ur)
+	a[11] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C3*s1r + radix13C6*s2r + radix13C4*s3r + radix13C1*s4r + radix13C2*s5r + radix13C5*s6r
+	ti = x0i + radix13C3*s1i + radix13C6*s2i + radix13C4*s3i + radix13C1*s4i + radix13C2*s5i + radix13C5*s6i
+	ur = radix13S3*d1r + radix13S6*d2r - radix13S4*d3r - radix13S1*d4r + radix13S2*d5r + radix13S5*d6r
+	ui = radix13S3*d1i + radix13S6*d2i - radix13S4*d3i - radix13S1*d4i + radix13S2*d5i + radix13S5*d6i
+	a[3] = complex(tr-ui, ti+ur)
+	a[10] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C4*s1r + radix13C5*s2r + radix13C1*s3r + radix13C3*s4r + radix13C6*s5r + radix13C2*s6r
+	ti = x0i + radix13C4*s1i + radix13C5*s2i + radix13C1*s3i + radix13C3*s4i + radix13C6*s5i + radix13C2*s6i
+	ur = radix13S4*d1r - radix13S5*d2r - radix13S1*d3r + radix13S3*d4r - radix13S6*d5r - radix13S2*d6r
+	ui = radix13S4*d1i - radix13S5*d2i - radix13S1*d3i + radix13S3*d4i - radix13S6*d5i - radix13S2*d6i
+	a[4] = complex(tr-ui, ti+ur)
+	a[9] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C5*s1r + radix13C3*s2r + radix13C2*s3r + radix13C6*s4r + radix13C1*s5r + radix13C4*s6r
+	ti = x0i + radix13C5*s1i + radix13C3*s2i + radix13C2*s3i + radix13C6*s4i + radix13C1*s5i + radix13C4*s6i
+	ur = radix13S5*d1r - radix13S3*d2r + radix13S2*d3r - radix13S6*d4r - radix13S1*d5r + radix13S4*d6r
+	ui = radix13S5*d1i - radix13S3*d2i + radix13S2*d3i - radix13S6*d4i - radix13S1*d5i + radix13S4*d6i
+	a[5] = complex(tr-ui, ti+ur)
+	a[8] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix13C6*s1r + radix13C1*s2r + radix13C5*s3r + radix13C2*s4r + radix13C4*s5r + radix13C3*s6r
+	ti = x0i + radix13C6*s1i + radix13C1*s2i + radix13C5*s3i + radix13C2*s4i + radix13C4*s5i + radix13C3*s6i
+	ur = radix13S6*d1r - radix13S1*d2r + radix13S5*d3r - radix13S2*d4r + radix13S4*d5r - radix13S3*d6r
+	ui = radix13S6*d1i - radix13S1*d2i + radix13S5*d3i - radix13S2*d4i + radix13S4*d5i - radix13S3*d6i
+	a[6] = complex(tr-ui, ti+ur)
+	a[7] = complex(tr+ui, ti-ur)
+}
+
+func butterfly13Forward[T Complex](a *[13]T) {
+	switch a := any(a).(type) {
+	case *[13]complex64:
+		butterfly13ForwardComplex64(a)
+	case *[13]complex128:
+		butterfly13ForwardComplex128(a)
+	default:
+		panic("unsupported complex type")
+	}
+}
+
+func butterfly13Inverse[T Complex](a *[13]T) {
+	switch a := any(a).(type) {
+	case *[13]complex64:
+		butterfly13InverseComplex64(a)
+	case *[13]complex128:
+		butterfly13InverseComplex128(a)
+	default:
+		panic("unsupported complex type")
+	}
+}
@@ -0,0 +1,177 @@
+package kernels
+
+// The radix-7 butterflies pair inputs symmetrically: with s_j = a[j] + a[7-j]
+// and d_j = a[j] - a[7-j] for j = 1..3, the outputs k and 7-k share
+//
+//	t_k = a[0] + Σ cos(2πjk/7) s_j,  u_k = Σ sin(2πjk/7) d_j
+//
+// as y_k = t_k - i·u_k and y_(7-k) = t_k + i·u_k (conjugated for the inverse).
+// That is 9 real multiply-adds per component instead of the 36 complex
+// products of a direct DFT.
+
+const (
+	radix7C1 = 0.6234898018587336   // cos(2π·1/7)
+	radix7C2 = -0.22252093395631434 // cos(2π·2/7)
+	radix7C3 = -0.900968867902419   // cos(2π·3/7)
+	radix7S1 = 0.7818314824680298   // sin(2π·1/7)
+	radix7S2 = 0.9749279121818236   // sin(2π·2/7)
+	radix7S3 = 0.43388373911755823  // sin(2π·3/7)
+)
+
+// butterfly7ForwardComplex64 computes the 7-point forward DFT of a in place.
+func butterfly7ForwardComplex64(a *[7]complex64) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[6]), imag(a[1])+imag(a[6])
+	d1r, d1i := real(a[1])-real(a[6]), imag(a[1])-imag(a[6])
+	s2r, s2i := real(a[2])+real(a[5]), imag(a[2])+imag(a[5])
+	d2r, d2i := real(a[2])-real(a[5]), imag(a[2])-imag(a[5])
+	s3r, s3i := real(a[3])+real(a[4]), imag(a[3])+imag(a[4])
+	d3r, d3i := real(a[3])-real(a[4]), imag(a[3])-imag(a[4])
+
+	a[0] = complex(x0r+s1r+s2r+s3r, x0i+s1i+s2i+s3i)
+
+	tr := x0r + radix7C1*s1r + radix7C2*s2r + radix7C3*s3r
+	ti := x0i + radix7C1*s1i + radix7C2*s2i + radix7C3*s3i
+	ur := radix7S1*d1r + radix7S2*d2r + radix7S3*d3r
+	ui := radix7S1*d1i + radix7S2*d2i + radix7S3*d3i
+	a[1] = complex(tr+ui, ti-ur)
+	a[6] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix7C2*s1r + radix7C3*s2r + radix7C1*s3r
+	ti = x0i + radix7C2*s1i + radix7C3*s2i + radix7C1*s3i
+	ur = radix7S2*d1r - radix7S3*d2r - radix7S1*d3r
+	ui = radix7S2*d1i - radix7S3*d2i - radix7S1*d3i
+	a[2] = complex(tr+ui, ti-ur)
+	a[5] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix7C3*s1r + radix7C1*s2r + radix7C2*s3r
+	ti = x0i + radix7C3*s1i + radix7C1*s2i + radix7C2*s3i
+	ur = radix7S3*d1r - radix7S1*d2r + radix7S2*d3r
+	ui = radix7S3*d1i - radix7S1*d2i + radix7S2*d3i
+	a[3] = complex(tr+ui, ti-ur)
+	a[4] = complex(tr-ui, ti+ur)
+}
+
+// butterfly7InverseComplex64 computes the 7-point inverse DFT of a in place without the 1/7 scaling.
+func butterfly7InverseComplex64(a *[7]complex64) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[6]), imag(a[1])+imag(a[6])
+	d1r, d1i := real(a[1])-real(a[6]), imag(a[1])-imag(a[6])
+	s2r, s2i := real(a[2])+real(a[5]), imag(a[2])+imag(a[5])
+	d2r, d2i := real(a[2])-real(a[5]), imag(a[2])-imag(a[5])
+	s3r, s3i := real(a[3])+real(a[4]), imag(a[3])+imag(a[4])
+	d3r, d3i := real(a[3])-real(a[4]), imag(a[3])-imag(a[4])
+
+	a[0] = complex(x0r+s1r+s2r+s3r, x0i+s1i+s2i+s3i)
+
+	tr := x0r + radix7C1*s1r + radix7C2*s2r + radix7C3*s3r
+	ti := x0i + radix7C1*s1i + radix7C2*s2i + radix7C3*s3i
+	ur := radix7S1*d1r + radix7S2*d2r + radix7S3*d3r
+	ui := radix7S1*d1i + radix7S2*d2i + radix7S3*d3i
+	a[1] = complex(tr-ui, ti+ur)
+	a[6] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix7C2*s1r + radix7C3*s2r + radix7C1*s3r
+	ti = x0i + radix7C2*s1i + radix7C3*s2i + radix7C1*s3i
+	ur = radix7S2*d1r - radix7S3*d2r - radix7S1*d3r
+	ui = radix7S2*d1i - radix7S3*d2i - radix7S1*d3i
+	a[2] = complex(tr-ui, ti+ur)
+	a[5] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix7C3*s1r + radix7C1*s2r + radix7C2*s3r
+	ti = x0i + radix7C3*s1i + radix7C1*s2i + radix7C2*s3i
+	ur = radix7S3*d1r - radix7S1*d2r + radix7S2*d3r
+	ui = radix7S3*d1i - radix7S1*d2i + radix7S2*d3i
+	a[3] = complex(tr-ui, ti+ur)
+	a[4] = complex(tr+ui, ti-ur)
+}
+
+// butterfly7ForwardComplex128 computes the 7-point forward DFT of a in place.
+func butterfly7ForwardComplex128(a *[7]complex128) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[6]), imag(a[1])+imag(a[6])
+	d1r, d1i := real(a[1])-real(a[6]), imag(a[1])-imag(a[6])
+	s2r, s2i := real(a[2])+real(a[5]), imag(a[2])+imag(a[5])
+	d2r, d2i := real(a[2])-real(a[5]), imag(a[2])-imag(a[5])
+	s3r, s3i := real(a[3])+real(a[4]), imag(a[3])+imag(a[4])
+	d3r, d3i := real(a[3])-real(a[4]), imag(a[3])-imag(a[4])
+
+	a[0] = complex(x0r+s1r+s2r+s3r, x0i+s1i+s2i+s3i)
+
+	tr := x0r + radix7C1*s1r + radix7C2*s2r + radix7C3*s3r
+	ti := x0i + radix7C1*s1i + radix7C2*s2i + radix7C3*s3i
+	ur := radix7S1*d1r + radix7S2*d2r + radix7S3*d3r
+	ui := radix7S1*d1i + radix7S2*d2i + radix7S3*d3i
+	a[1] = complex(tr+ui, ti-ur)
+	a[6] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix7C2*s1r + radix7C3*s2r + radix7C1*s3r
+	ti = x0i + radix7C2*s1i + radix7C3*s2i + radix7C1*s3i
+	ur = radix7S2*d1r - radix7S3*d2r - radix7S1*d3r
+	ui = radix7S2*d1i - radix7S3*d2i - radix7S1*d3i
+	a[2] = complex(tr+ui, ti-ur)
+	a[5] = complex(tr-ui, ti+ur)
+
+	tr = x0r + radix7C3*s1r + radix7C1*s2r + radix7C2*s3r
+	ti = x0i + radix7C3*s1i + radix7C1*s2i + radix7C2*s3i
+	ur = radix7S3*d1r - radix7S1*d2r + radix7S2*d3r
+	ui = radix7S3*d1i - radix7S1*d2i + radix7S2*d3i
+	a[3] = complex(tr+ui, ti-ur)
+	a[4] = complex(tr-ui, ti+ur)
+}
+
+// butterfly7InverseComplex128 computes the 7-point inverse DFT of a in place without the 1/7 scaling.
+func butterfly7InverseComplex128(a *[7]complex128) {
+	x0r, x0i := real(a[0]), imag(a[0])
+	s1r, s1i := real(a[1])+real(a[6]), imag(a[1])+imag(a[6])
+	d1r, d1i := real(a[1])-real(a[6]), imag(a[1])-imag(a[6])
+	s2r, s2i := real(a[2])+real(a[5]), imag(a[2])+imag(a[5])
+	d2r, d2i := real(a[2])-real(a[5]), imag(a[2])-imag(a[5])
+	s3r, s3i := real(a[3])+real(a[4]), imag(a[3])+imag(a[4])
+	d3r, d3i := real(a[3])-real(a[4]), imag(a[3])-imag(a[4])
+
+	a[0] = complex(x0r+s1r+s2r+s3r, x0i+s1i+s2i+s3i)
+
+	tr := x0r + radix7C1*s1r + radix7C2*s2r + radix7C3*s3r
+	ti := x0i + radix7C1*s1i + radix7C2*s2i + radix7C3*s3i
+	ur := radix7S1*d1r + radix7S2*d2r + radix7S3*d3r
+	ui := radix7S1*d1i + radix7S2*d2i + radix7S3*d3i
+	a[1] = complex(tr-ui, ti+ur)
+	a[6] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix7C2*s1r + radix7C3*s2r + radix7C1*s3r
+	ti = x0i + radix7C2*s1i + radix7C3*s2i + radix7C1*s3i
+	ur = radix7S2*d1r - radix7S3*d2r - radix7S1*d3r
+	ui = radix7S2*d1i - radix7S3*d2i - radix7S1*d3i
+	a[2] = complex(tr-ui, ti+ur)
+	a[5] = complex(tr+ui, ti-ur)
+
+	tr = x0r + radix7C3*s1r + radix7C1*s2r + radix7C2*s3r
+	ti = x0i + radix7C3*s1i + radix7C1*s2i + radix7C2*s3i
+	ur = radix7S3*d1r - radix7S1*d2r + radix7S2*d3r
+	ui = radix7S3*d1i - radix7S1*d2i + radix7S2*d3i
+	a[3] = complex(tr-ui, ti+ur)
+	a[4] = complex(tr+ui, ti-ur)
+}
+
+func butterfly7Forward[T Complex](a *[7]T) {
+	switch a := any(a).(type) {
+	case *[7]complex64:
+		butterfly7ForwardComplex64(a)
+	case *[7]complex128:
+		butterfly7ForwardComplex128(a)
+	default:
+		panic("unsupported complex type")
+	}
+}
+
+func butterfly7Inverse[T Complex](a *[7]T) {
+	switch a := any(a).(type) {
+	case *[7]complex64:
+		butterfly7InverseComplex64(a)
+	case *[7]complex128:
+		butterfly7InverseComplex128(a)
+	default:
+		panic("unsupported complex type")
+	}
+}
@@ -0,0 +1,138 @@
+package kernels
+
+// primeRadixMax is the largest radix with a dedicated odd-prime butterfly.
+const primeRadixMax = 13
+
+// HasPrimeRadixButterfly reports whether radix has a dedicated odd-prime
+// butterfly for PrimeRadixStage.
+func HasPrimeRadixButterfly(radix int) bool {
+	return radix == 7 || radix == 11 || radix == 13
+}
+
+// PrimeRadixStage applies one mixed-radix stage of radix 7, 11 or 13: for
+// each k < span it transforms twiddle[j*k*step] * input[j*span+k] over j and
+// writes output q to dst[q*span+k]. The inverse uses conjugated twiddles and
+// no scaling. dst and input may alias. It returns false if radix has no
+// dedicated butterfly.
+func PrimeRadixStage[T Complex](dst, input, twiddle []T, radix, span, step int, inverse bool) bool {
+	switch dst := any(dst).(type) {
+	case []complex64:
+		return PrimeRadixStageComplex64(dst, any(input).([]complex64), any(twiddle).([]complex64), radix, span, step, inverse) //nolint:forcetypeassert
+	case []complex128:
+		return PrimeRadixStageComplex128(dst, any(input).([]complex128), any(twiddle).([]complex128), radix, span, step, inverse) //nolint:forcetypeassert
+	default:
+		return false
+	}
+}
+
+// PrimeRadixStageComplex64 is PrimeRadixStage for complex64. It runs the
+// pure Go butterflies; PrimeRadixStageAVX2Complex64 is the vector version.
+func PrimeRadixStageComplex64(dst, input, twiddle []complex64, radix, span, step int, inverse bool) bool {
+	if !HasPrimeRadixButterfly(radix) {
+		return false
+	}
+
+	primeRadixColumnsComplex64(dst, input, twiddle, radix, span, step, 0, inverse)
+
+	return true
+}
+
+// primeRadixColumnsComplex64 runs the columns k0..span-1 of a
+// PrimeRadixStageComplex64 stage.
+func primeRadixColumnsComplex64(dst, input, twiddle []complex64, radix, span, step, k0 int, inverse bool) {
+	var a [primeRadixMax]complex64
+
+	for k := k0; k < span; k++ {
+		for j := range radix {
+			w := twiddle[j*k*step]
+			if inverse {
+				w = conj(w)
+			}
+
+			a[j] = w * input[j*span+k]
+		}
+
+		butterflyPrimeComplex64(a[:radix], radix, inverse)
+
+		for q := range radix {
+			dst[q*span+k] = a[q]
+		}
+	}
+}
+
+// PrimeRadixStageComplex128 is PrimeRadixStage for complex128. It has no
+// vector version.
+func PrimeRadixStageComplex128(dst, input, twiddle []complex128, radix, span, step int, inverse bool) bool {
+	if !HasPrimeRadixButterfly(radix) {
+		return false
+	}
+
+	var a [primeRadixMax]complex128
+
+	for k := range span {
+		for j := range radix {
+			w := twiddle[j*k*step]
+			if inverse {
+				w = conj(w)
+			}
+
+			a[j] = w * input[j*span+k]
+		}
+
+		butterflyPrimeComplex128(a[:radix], radix, inverse)
+
+		for q := range radix {
+			dst[q*span+k] = a[q]
+		}
+	}
+
+	return true
+}
+
+// butterflyPrimeComplex64 runs the radix-len(a) butterfly on a in place.
+func butterflyPrimeComplex64(a []complex64, radix int, inverse bool) {
+	switch radix {
+	case 7:
+		if inverse {
+			butterfly7InverseComplex64((*[7]complex64)(a))
+		} else {
+			butterfly7ForwardComplex64((*[7]complex64)(a))
+		}
+	case 11:
+		if inverse {
+			butterfly11InverseComplex64((*[11]complex64)(a))
+		} else {
+			butterfly11ForwardComplex64((*[11]complex64)(a))
+		}
+	case 13:
+		if inverse {
+			butterfly13InverseComplex64((*[13]complex64)(a))
+		} else {
+			butterfly13ForwardComplex64((*[13]complex64)(a))
+		}
+	}
+}
+
+// butterflyPrimeComplex128 runs the radix-len(a) butterfly on a in place.
+func butterflyPrimeComplex128(a []complex128, radix int, inverse bool) {
+	switch radix {
+	case 7:
+		if inverse {
+			butterfly7InverseComplex128((*[7]complex128)(a))
+		} else {
+			butterfly7ForwardComplex128((*[7]complex128)(a))
+		}
+	case 11:
+		if inverse {
+			butterfly11InverseComplex128((*[11]complex128)(a))
+		} else {
+			butterfly11ForwardComplex128((*[11]complex128)(a))
+		}
+	case 13:
+		if inverse {
+			butterfly13InverseComplex128((*[13]complex128)(a))
+		} else {
+			butterfly13ForwardComplex128((*[13]complex128)(a))
+		}
+	}
+}
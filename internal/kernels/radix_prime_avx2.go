@@ -0,0 +1,71 @@
+//go:build amd64 && asm && !purego
+
+package kernels
+
+import (
+	amd64 "github.com/MeKo-Christian/algo-fft/internal/asm/amd64"
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+)
+
+// PrimeRadixAVX2Available reports whether PrimeRadixStageAVX2Complex64 can
+// run with the given CPU features. Callers check it once when they pick
+// their kernels.
+func PrimeRadixAVX2Available(features cpu.Features) bool {
+	return features.HasAVX2 && !features.ForceGeneric
+}
+
+// PrimeRadixStageAVX2Complex64 is PrimeRadixStageComplex64 in AVX2
+// assembly: for every four adjacent columns it loads the rows, multiplies
+// them by their twiddles, runs four butterflies and stores the rows back.
+// Columns left over at the end run the pure Go butterflies. It returns false
+// if radix has no dedicated butterfly or a slice is too short.
+func PrimeRadixStageAVX2Complex64(dst, input, twiddle []complex64, radix, span, step int, inverse bool) bool {
+	if !HasPrimeRadixButterfly(radix) {
+		return false
+	}
+
+	if len(dst) < radix*span || len(input) < radix*span ||
+		(span > 0 && len(twiddle) <= (radix-1)*(span-1)*step) {
+		return false
+	}
+
+	var a, y [4 * primeRadixMax]complex64
+
+	packed, out := a[:4*radix], y[:4*radix]
+	k := 0
+
+	for ; k+3 < span; k += 4 {
+		amd64.PrimeRadixLoadAVX2Complex64(packed, input, twiddle, radix, span, k, step, inverse)
+		butterflyPrimeAVX2Complex64(out, packed, radix, inverse)
+		amd64.PrimeRadixStoreAVX2Complex64(dst, out, radix, span, k)
+	}
+
+	primeRadixColumnsComplex64(dst, input, twiddle, radix, span, step, k, inverse)
+
+	return true
+}
+
+// butterflyPrimeAVX2Complex64 runs four radix-7, 11 or 13 butterflies on
+// the packed inputs a, where a[4*j+b] is input j of butterfly b.
+func butterflyPrimeAVX2Complex64(y, a []complex64, radix int, inverse bool) {
+	switch radix {
+	case 7:
+		if inverse {
+			amd64.Butterfly7InverseAVX2Complex64(y, a)
+		} else {
+			amd64.Butterfly7ForwardAVX2Complex64(y, a)
+		}
+	case 11:
+		if inverse {
+			amd64.Butterfly11InverseAVX2Complex64(y, a)
+		} else {
+			amd64.Butterfly11ForwardAVX2Complex64(y, a)
+		}
+	case 13:
+		if inverse {
+			amd64.Butterfly13InverseAVX2Complex64(y, a)
+		} else {
+			amd64.Butterfly13ForwardAVX2Complex64(y, a)
+		}
+	}
+}
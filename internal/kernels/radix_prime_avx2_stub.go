@@ -0,0 +1,16 @@
+//go:build !amd64 || !asm || purego
+
+package kernels
+
+import "github.com/MeKo-Christian/algo-fft/internal/cpu"
+
+// PrimeRadixAVX2Available reports false: this build has no AVX2 assembly.
+func PrimeRadixAVX2Available(cpu.Features) bool {
+	return false
+}
+
+// PrimeRadixStageAVX2Complex64 is unavailable in this build and always
+// returns false.
+func PrimeRadixStageAVX2Complex64(dst, input, twiddle []complex64, radix, span, step int, inverse bool) bool {
+	return false
+}
@@ -0,0 +1,149 @@
+//go:build amd64 && asm && !purego
+
+package kernels
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/asm/amd64"
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	mathpkg "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+func TestButterflyPrimeAVX2Complex64(t *testing.T) {
+	t.Parallel()
+
+	kernels := []struct {
+		radix            int
+		forward, inverse func(y, a []complex64)
+	}{
+		{7, amd64.Butterfly7ForwardAVX2Complex64, amd64.Butterfly7InverseAVX2Complex64},
+		{11, amd64.Butterfly11ForwardAVX2Complex64, amd64.Butterfly11InverseAVX2Complex64},
+		{13, amd64.Butterfly13ForwardAVX2Complex64, amd64.Butterfly13InverseAVX2Complex64},
+	}
+
+	for _, k := range kernels {
+		a := randomComplex64(4*k.radix, uint64(k.radix))
+
+		for _, inverse := range []bool{false, true} {
+			y := make([]complex64, 4*k.radix)
+			if inverse {
+				k.inverse(y, a)
+			} else {
+				k.forward(y, a)
+			}
+
+			// Butterfly b reads input j from a[4*j+b].
+			want := make([]complex64, 4*k.radix)
+			col := make([]complex64, k.radix)
+
+			for b := range 4 {
+				for j := range col {
+					col[j] = a[4*j+b]
+				}
+
+				butterflyPrimeComplex64(col, k.radix, inverse)
+
+				for j, v := range col {
+					want[4*j+b] = v
+				}
+			}
+
+			assertComplex64Close(t, y, want, 1e-5)
+		}
+	}
+}
+
+func TestButterflyPrimeAVX2ShortSlices(t *testing.T) {
+	t.Parallel()
+
+	// Short slices are rejected without touching y.
+	a := randomComplex64(4*7, 7)
+	y := make([]complex64, 4*7-1)
+
+	amd64.Butterfly7ForwardAVX2Complex64(y, a)
+
+	for i, v := range y {
+		if v != 0 {
+			t.Fatalf("y[%d] = %v, want 0", i, v)
+		}
+	}
+}
+
+func TestPrimeRadixStageAVX2Complex64(t *testing.T) {
+	t.Parallel()
+
+	if !PrimeRadixAVX2Available(cpu.DetectFeatures()) {
+		t.Skip("AVX2 not available")
+	}
+
+	// span 6 runs one batch of four columns and two scalar columns; step 2
+	// reads every other twiddle, as inner stages of a schedule do.
+	for _, radix := range []int{7, 11, 13} {
+		for _, span := range []int{4, 6, 9, 16} {
+			for _, step := range []int{1, 2} {
+				n := radix * span
+				twiddle := mathpkg.ComputeTwiddleFactors[complex64](n * step)
+
+				for _, inverse := range []bool{false, true} {
+					input := randomComplex64(n, uint64(n+step))
+
+					want := make([]complex64, n)
+					PrimeRadixStageComplex64(want, input, twiddle, radix, span, step, inverse)
+
+					got := make([]complex64, n)
+					if !PrimeRadixStageAVX2Complex64(got, input, twiddle, radix, span, step, inverse) {
+						t.Fatalf("radix %d span %d: PrimeRadixStageAVX2Complex64 returned false", radix, span)
+					}
+
+					assertComplex64Close(t, got, want, 1e-5)
+
+					// In place, as the mixed-radix recursion calls it at the leaves.
+					got = append(got[:0], input...)
+					PrimeRadixStageAVX2Complex64(got, got, twiddle, radix, span, step, inverse)
+
+					assertComplex64Close(t, got, want, 1e-5)
+				}
+			}
+		}
+	}
+
+	short := make([]complex64, 7*4-1)
+	if PrimeRadixStageAVX2Complex64(short, make([]complex64, 7*4), make([]complex64, 7*4), 7, 4, 1, false) {
+		t.Error("PrimeRadixStageAVX2Complex64 accepted a short dst")
+	}
+}
+
+func BenchmarkPrimeRadixStage(b *testing.B) {
+	avx2 := PrimeRadixAVX2Available(cpu.DetectFeatures())
+
+	for _, radix := range []int{7, 11, 13} {
+		for _, span := range []int{16, 256, 1024} {
+			n := radix * span
+			input := randomComplex64(n, uint64(n))
+			twiddle := mathpkg.ComputeTwiddleFactors[complex64](n)
+			dst := make([]complex64, n)
+
+			b.Run(fmt.Sprintf("Radix%d/Span%d/Scalar", radix, span), func(b *testing.B) {
+				b.SetBytes(int64(n * 8))
+
+				for b.Loop() {
+					PrimeRadixStageComplex64(dst, input, twiddle, radix, span, 1, false)
+				}
+			})
+
+			b.Run(fmt.Sprintf("Radix%d/Span%d/AVX2", radix, span), func(b *testing.B) {
+				if !avx2 {
+					b.Skip("AVX2 not available")
+				}
+
+				b.SetBytes(int64(n * 8))
+
+				for b.Loop() {
+					PrimeRadixStageAVX2Complex64(dst, input, twiddle, radix, span, 1, false)
+				}
+			})
+		}
+	}
+}
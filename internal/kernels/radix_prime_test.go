@@ -0,0 +1,122 @@
+package kernels
+
+import (
+	"testing"
+
+	mathpkg "github.com/MeKo-Christian/algo-fft/internal/math"
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+func TestPrimeRadixButterflies(t *testing.T) {
+	t.Parallel()
+
+	for _, radix := range []int{7, 11, 13} {
+		src := randomComplex128(radix, uint64(radix))
+		src64 := make([]complex64, radix)
+
+		for i, v := range src {
+			src64[i] = complex64(v)
+		}
+
+		a := append([]complex128(nil), src...)
+		butterflyPrimeComplex128(a, radix, false)
+		assertComplex128Close(t, a, reference.NaiveDFT128(src), 1e-12)
+
+		butterflyPrimeComplex128(a, radix, true)
+
+		for i := range a {
+			a[i] /= complex(float64(radix), 0)
+		}
+
+		assertComplex128Close(t, a, src, 1e-12)
+
+		a64 := append([]complex64(nil), src64...)
+		butterflyPrimeComplex64(a64, radix, false)
+		assertComplex64Close(t, a64, reference.NaiveDFT(src64), 1e-5)
+
+		a64 = append(a64[:0], src64...)
+		butterflyPrimeComplex64(a64, radix, true)
+		assertComplex64Close(t, a64, scaleComplex64(reference.NaiveIDFT(src64), float32(radix)), 1e-5)
+	}
+}
+
+func TestPrimeRadixStage(t *testing.T) {
+	t.Parallel()
+
+	const span = 6
+
+	for _, radix := range []int{7, 11, 13} {
+		n := radix * span
+		twiddle := mathpkg.ComputeTwiddleFactors[complex64](n)
+		twiddle128 := mathpkg.ComputeTwiddleFactors[complex128](n)
+
+		for _, inverse := range []bool{false, true} {
+			input := randomComplex64(n, uint64(n))
+			input128 := make([]complex128, n)
+
+			for i, v := range input {
+				input128[i] = complex128(v)
+			}
+
+			want := make([]complex128, n)
+
+			for k := range span {
+				col := make([]complex128, radix)
+
+				for j := range col {
+					w := twiddle128[j*k]
+					if inverse {
+						w = conj(w)
+					}
+
+					col[j] = w * input128[j*span+k]
+				}
+
+				out := reference.NaiveDFT128(col)
+				if inverse {
+					out = reference.NaiveIDFT128(col)
+				}
+
+				for q, v := range out {
+					if inverse {
+						v *= complex(float64(radix), 0)
+					}
+
+					want[q*span+k] = v
+				}
+			}
+
+			got := make([]complex64, n)
+			if !PrimeRadixStage(got, input, twiddle, radix, span, 1, inverse) {
+				t.Fatalf("PrimeRadixStage(radix %d) returned false", radix)
+			}
+
+			want64 := make([]complex64, n)
+			for i, v := range want {
+				want64[i] = complex64(v)
+			}
+
+			assertComplex64Close(t, got, want64, 1e-4)
+
+			// In place, as the mixed-radix recursion calls it at the leaves.
+			got128 := append([]complex128(nil), input128...)
+			if !PrimeRadixStage(got128, got128, twiddle128, radix, span, 1, inverse) {
+				t.Fatalf("PrimeRadixStage(radix %d) returned false", radix)
+			}
+
+			assertComplex128Close(t, got128, want, 1e-12)
+		}
+	}
+
+	if PrimeRadixStage(make([]complex64, 10), make([]complex64, 10), make([]complex64, 10), 5, 2, 1, false) {
+		t.Error("PrimeRadixStage accepted radix 5")
+	}
+}
+
+func scaleComplex64(x []complex64, s float32) []complex64 {
+	for i := range x {
+		x[i] *= complex(s, 0)
+	}
+
+	return x
+}
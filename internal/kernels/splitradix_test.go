@@ -0,0 +1,160 @@
+package kernels
+
+import (
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+func TestSplitRadixComplex64(t *testing.T) {
+	t.Parallel()
+
+	sizes := []int{1, 2, 4, 8, 16, 32, 64, 128, 256, 1024, 4096}
+
+	for _, n := range sizes {
+		t.Run(testName("forward", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex64(n, 0x5eed0000+uint64(n))
+			dst := make([]complex64, n)
+			scratch := make([]complex64, n)
+			twiddle := ComputeTwiddleFactors[complex64](n)
+
+			if !ForwardSplitRadixComplex64(dst, src, twiddle, scratch) {
+				t.Fatalf("ForwardSplitRadixComplex64 failed for n=%d", n)
+			}
+
+			want := reference.NaiveDFT(src)
+			assertComplex64Close(t, dst, want, 1e-4)
+		})
+
+		t.Run(testName("inverse", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex64(n, 0x5eed1000+uint64(n))
+			dst := make([]complex64, n)
+			scratch := make([]complex64, n)
+			twiddle := ComputeTwiddleFactors[complex64](n)
+
+			if !InverseSplitRadixComplex64(dst, src, twiddle, scratch) {
+				t.Fatalf("InverseSplitRadixComplex64 failed for n=%d", n)
+			}
+
+			want := reference.NaiveIDFT(src)
+			assertComplex64Close(t, dst, want, 1e-4)
+		})
+	}
+}
+
+func TestSplitRadixComplex128(t *testing.T) {
+	t.Parallel()
+
+	sizes := []int{1, 2, 4, 8, 16, 32, 64, 128, 256}
+
+	for _, n := range sizes {
+		t.Run(testName("roundtrip", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex128(n, 0x5eed2000+uint64(n))
+			fwd := make([]complex128, n)
+			dst := make([]complex128, n)
+			scratch := make([]complex128, n)
+			twiddle := ComputeTwiddleFactors[complex128](n)
+
+			if !ForwardSplitRadixComplex128(fwd, src, twiddle, scratch) {
+				t.Fatalf("ForwardSplitRadixComplex128 failed for n=%d", n)
+			}
+
+			assertComplex128Close(t, fwd, reference.NaiveDFT128(src), 1e-9)
+
+			if !InverseSplitRadixComplex128(dst, fwd, twiddle, scratch) {
+				t.Fatalf("InverseSplitRadixComplex128 failed for n=%d", n)
+			}
+
+			assertComplex128Close(t, dst, src, 1e-12)
+		})
+	}
+}
+
+func TestSplitRadixInPlace(t *testing.T) {
+	t.Parallel()
+
+	const n = 512
+
+	src := randomComplex128(n, 0x5eed3000)
+	data := append([]complex128(nil), src...)
+	scratch := make([]complex128, n)
+	twiddle := ComputeTwiddleFactors[complex128](n)
+
+	if !ForwardSplitRadixComplex128(data, data, twiddle, scratch) {
+		t.Fatal("ForwardSplitRadixComplex128 in place failed")
+	}
+
+	want := make([]complex128, n)
+	if !ditForward(want, src, twiddle, make([]complex128, n)) {
+		t.Fatal("ditForward failed")
+	}
+
+	assertComplex128Close(t, data, want, 1e-9)
+}
+
+func TestSplitRadixRejectsUnsupportedSizes(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{3, 6, 12, 100} {
+		src := make([]complex64, n)
+		dst := make([]complex64, n)
+		scratch := make([]complex64, n)
+		twiddle := ComputeTwiddleFactors[complex64](n)
+
+		if ForwardSplitRadixComplex64(dst, src, twiddle, scratch) {
+			t.Errorf("ForwardSplitRadixComplex64 accepted n=%d", n)
+		}
+	}
+
+	if ForwardSplitRadixComplex64(make([]complex64, 8), make([]complex64, 8),
+		ComputeTwiddleFactors[complex64](8), make([]complex64, 4)) {
+		t.Error("ForwardSplitRadixComplex64 accepted short scratch")
+	}
+}
+
+func BenchmarkSplitRadix(b *testing.B) {
+	for _, n := range []int{256, 1024, 4096, 16384, 65536} {
+		src := randomComplex64(n, uint64(n))
+		dst := make([]complex64, n)
+		scratch := make([]complex64, n)
+		twiddle := ComputeTwiddleFactors[complex64](n)
+
+		b.Run(benchName("splitradix", n), func(b *testing.B) {
+			b.SetBytes(int64(n * 8))
+
+			for range b.N {
+				ForwardSplitRadixComplex64(dst, src, twiddle, scratch)
+			}
+		})
+
+		b.Run(benchName("dit", n), func(b *testing.B) {
+			b.SetBytes(int64(n * 8))
+
+			for range b.N {
+				ditForward(dst, src, twiddle, scratch)
+			}
+		})
+
+		b.Run(benchName("dit_sized", n), func(b *testing.B) {
+			b.SetBytes(int64(n * 8))
+
+			for range b.N {
+				forwardDITComplex64(dst, src, twiddle, scratch)
+			}
+		})
+
+		b.Run(benchName("stockham", n), func(b *testing.B) {
+			b.SetBytes(int64(n * 8))
+
+			for range b.N {
+				stockhamForward(dst, src, twiddle, scratch)
+			}
+		})
+	}
+}
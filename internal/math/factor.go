@@ -1,5 +1,7 @@
 package math
 
+import "math/bits"
+
 // Factorize performs prime factorization of n, returning factors in ascending order.
 func Factorize(n int) []int {
 	if n <= 1 {
@@ -27,17 +29,110 @@ func Factorize(n int) []int {
 	return factors
 }
 
-// IsHighlyComposite reports whether n only contains 2, 3, or 5 factors.
+// IsHighlyComposite reports whether n only contains the factors 2, 3, 5, 7,
+// 11 and 13, the radices with dedicated mixed-radix butterflies.
 func IsHighlyComposite(n int) bool {
 	if n <= 0 {
 		return false
 	}
 
 	for _, factor := range Factorize(n) {
-		if factor != 2 && factor != 3 && factor != 5 {
+		if factor > 13 {
 			return false
 		}
 	}
 
 	return true
 }
+
+// IsPrime reports whether n is a prime number.
+func IsPrime(n int) bool {
+	return len(Factorize(n)) == 1
+}
+
+// PrimitiveRoot returns the smallest primitive root modulo the prime p: the
+// g whose powers g^0, ..., g^(p-2) run through every nonzero residue. It
+// returns 0 if p is not an odd prime.
+func PrimitiveRoot(p int) int {
+	if p < 3 || !IsPrime(p) {
+		return 0
+	}
+
+	factors := Factorize(p - 1)
+
+	for g := 2; g < p; g++ {
+		primitive := true
+
+		for i, q := range factors {
+			if i > 0 && q == factors[i-1] {
+				continue
+			}
+
+			if PowMod(g, (p-1)/q, p) == 1 {
+				primitive = false
+				break
+			}
+		}
+
+		if primitive {
+			return g
+		}
+	}
+
+	return 0
+}
+
+// PowMod returns base^exp mod m for exp >= 0 and m >= 1.
+func PowMod(base, exp, m int) int {
+	result := 1 % m
+	base %= m
+
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = MulMod(result, base, m)
+		}
+
+		base = MulMod(base, base, m)
+		exp >>= 1
+	}
+
+	return result
+}
+
+// MulMod returns a*b mod m for 0 <= a, b < m without overflow.
+func MulMod(a, b, m int) int {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+
+	return int(bits.Rem64(hi, lo, uint64(m)))
+}
+
+// ModInverse returns the inverse of a modulo m, the x in [0, m) with
+// a*x ≡ 1 (mod m), or 0 if a and m are not coprime. m must be positive.
+func ModInverse(a, m int) int {
+	if m == 1 {
+		return 0
+	}
+
+	// Extended Euclid on (a mod m, m), tracking the coefficient of a.
+	r0, r1 := a%m, m
+	if r0 < 0 {
+		r0 += m
+	}
+
+	s0, s1 := 1, 0
+	for r1 != 0 {
+		q := r0 / r1
+		r0, r1 = r1, r0-q*r1
+		s0, s1 = s1, s0-q*s1
+	}
+
+	if r0 != 1 {
+		return 0
+	}
+
+	if s0 < 0 {
+		s0 += m
+	}
+
+	return s0
+}
@@ -58,9 +58,13 @@ func TestIsHighlyComposite(t *testing.T) {
 		{n: 25, want: true},
 		{n: 30, want: true},
 		{n: 16, want: true},
-		{n: 14, want: false},
-		{n: 49, want: false},
-		{n: 11, want: false},
+		{n: 14, want: true},
+		{n: 49, want: true},
+		{n: 11, want: true},
+		{n: 1001, want: true},
+		{n: 17, want: false},
+		{n: 34, want: false},
+		{n: 2 * 13 * 19, want: false},
 	}
 
 	for _, tt := range tests {
@@ -70,3 +74,101 @@ func TestIsHighlyComposite(t *testing.T) {
 		}
 	}
 }
+
+func TestIsPrime(t *testing.T) {
+	t.Parallel()
+
+	primes := map[int]bool{2: true, 3: true, 5: true, 7: true, 11: true, 13: true, 17: true, 19: true, 23: true, 29: true}
+
+	for n := -2; n < 30; n++ {
+		if got := IsPrime(n); got != primes[n] {
+			t.Errorf("IsPrime(%d) = %v, want %v", n, got, primes[n])
+		}
+	}
+
+	if !IsPrime(65537) || IsPrime(65539*3) {
+		t.Error("IsPrime is wrong for large inputs")
+	}
+}
+
+func TestPrimitiveRoot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		p    int
+		want int
+	}{
+		{p: 2, want: 0},
+		{p: 9, want: 0},
+		{p: 3, want: 2},
+		{p: 7, want: 3},
+		{p: 17, want: 3},
+		{p: 23, want: 5},
+		{p: 41, want: 6},
+		{p: 1009, want: 11},
+	}
+
+	for _, tt := range tests {
+		g := PrimitiveRoot(tt.p)
+		if g != tt.want {
+			t.Errorf("PrimitiveRoot(%d) = %d, want %d", tt.p, g, tt.want)
+			continue
+		}
+
+		if g == 0 {
+			continue
+		}
+
+		// The powers of g must visit every nonzero residue once.
+		seen := make([]bool, tt.p)
+		x := 1
+
+		for range tt.p - 1 {
+			if seen[x] {
+				t.Fatalf("PrimitiveRoot(%d) = %d repeats %d", tt.p, g, x)
+			}
+
+			seen[x] = true
+			x = MulMod(x, g, tt.p)
+		}
+	}
+}
+
+func TestPowMod(t *testing.T) {
+	t.Parallel()
+
+	if got := PowMod(3, 200, 1000003); got != 333986 {
+		t.Errorf("PowMod(3, 200, 1000003) = %d", got)
+	}
+
+	if got := PowMod(5, 0, 1); got != 0 {
+		t.Errorf("PowMod(5, 0, 1) = %d, want 0", got)
+	}
+
+	const big = 1<<31 - 1
+	if got := MulMod(big-1, big-1, big); got != 1 {
+		t.Errorf("MulMod(-1, -1, %d) = %d, want 1", big, got)
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, m, want int
+	}{
+		{3, 5, 2},
+		{5, 3, 2},
+		{7, 64, 55},
+		{64, 105, 64},
+		{13, 77, 6},
+		{6, 9, 0},
+		{4, 1, 0},
+	}
+
+	for _, tt := range tests {
+		if got := ModInverse(tt.a, tt.m); got != tt.want {
+			t.Errorf("ModInverse(%d, %d) = %d, want %d", tt.a, tt.m, got, tt.want)
+		}
+	}
+}
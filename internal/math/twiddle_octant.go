@@ -0,0 +1,172 @@
+package math
+
+import "math"
+
+// TwiddleMode selects how twiddle factors are generated.
+type TwiddleMode uint8
+
+const (
+	// TwiddleDirect evaluates sin/cos of the full angle -2πk/n.
+	TwiddleDirect TwiddleMode = iota
+
+	// TwiddlePhasor uses block-corrected phasor recursion
+	// (ComputeTwiddleFactorsPhasor): fastest, least accurate.
+	TwiddlePhasor
+
+	// TwiddleOctant reduces every angle exactly to the first octant
+	// (ComputeTwiddleFactorsOctant): most accurate.
+	TwiddleOctant
+)
+
+// TwiddleFactors returns the twiddle factors W_n^k for k = 0..n-1 generated
+// with the given mode.
+func TwiddleFactors[T Complex](n int, mode TwiddleMode) []T {
+	switch mode {
+	case TwiddlePhasor:
+		return ComputeTwiddleFactorsPhasor[T](n)
+	case TwiddleOctant:
+		return ComputeTwiddleFactorsOctant[T](n)
+	default:
+		return ComputeTwiddleFactors[T](n)
+	}
+}
+
+// ComputeTwiddleFactorsOctant returns W_n^k = exp(-2πik/n) for k = 0..n-1,
+// each evaluated by RootOfUnity. The angles are reduced in integer
+// arithmetic, so the error does not grow with k and the symmetries
+// W_{n-k} = conj(W_k) and W_{k+n/4} = -i*W_k hold exactly.
+func ComputeTwiddleFactorsOctant[T Complex](n int) []T {
+	if n <= 0 {
+		return nil
+	}
+
+	twiddle := make([]T, n)
+	for k := range n {
+		twiddle[k] = ComplexFromFloat64[T](RootOfUnity(k, n))
+	}
+
+	return twiddle
+}
+
+// RootOfUnity returns the real and imaginary part of exp(-2πik/n).
+//
+// k is reduced modulo n and then to the first octant in exact integer
+// arithmetic: with 8k = o*n + r, the angle is o*π/4 plus r*π/(4n), which is
+// evaluated as a sine and cosine of at most π/4 and mapped back by the
+// octant symmetries. The result is within about one float64 ulp of the
+// exact root for every k, where the direct sin/cos of 2πk/n loses accuracy
+// in proportion to the angle. It uses only rounded IEEE operations, so it
+// returns the same bits on every platform.
+func RootOfUnity(k, n int) (float64, float64) {
+	k %= n
+	if k < 0 {
+		k += n
+	}
+
+	octant := 8 * k / n
+	rem := 8*k - octant*n
+
+	// Odd octants measure the angle back from the next multiple of π/4,
+	// so that the reduced angle phi lies in [0, π/4].
+	quadrant := octant / 2
+	sign := 1.0
+
+	if octant%2 == 1 {
+		rem = n - rem
+		quadrant++
+		sign = -1
+	}
+
+	phi := float64(math.Pi/4*float64(rem)) / float64(n)
+	sinPhi, cosPhi := sincosOctant(phi)
+
+	// Odd multiples of π/4 are reached from both sides; use one value.
+	if rem == n {
+		sinPhi, cosPhi = math.Sqrt2/2, math.Sqrt2/2
+	}
+
+	sinPhi *= sign
+
+	// theta = quadrant*π/2 + sign*phi
+	var cosTheta, sinTheta float64
+
+	switch quadrant % 4 {
+	case 0:
+		cosTheta, sinTheta = cosPhi, sinPhi
+	case 1:
+		cosTheta, sinTheta = -sinPhi, cosPhi
+	case 2:
+		cosTheta, sinTheta = -cosPhi, -sinPhi
+	default:
+		cosTheta, sinTheta = sinPhi, -cosPhi
+	}
+
+	return cosTheta, -sinTheta
+}
+
+// Taylor coefficients of sin and cos. On [0, π/4] the first omitted terms,
+// x^19/19! and x^18/18!, are below 1e-17.
+const (
+	sinC1 = -1.0 / 6
+	sinC2 = 1.0 / 120
+	sinC3 = -1.0 / 5040
+	sinC4 = 1.0 / 362880
+	sinC5 = -1.0 / 39916800
+	sinC6 = 1.0 / 6227020800
+	sinC7 = -1.0 / 1307674368000
+	sinC8 = 1.0 / 355687428096000
+
+	cosC1 = -1.0 / 2
+	cosC2 = 1.0 / 24
+	cosC3 = -1.0 / 720
+	cosC4 = 1.0 / 40320
+	cosC5 = -1.0 / 3628800
+	cosC6 = 1.0 / 479001600
+	cosC7 = -1.0 / 87178291200
+	cosC8 = 1.0 / 20922789888000
+)
+
+// sincosOctant returns sin(x) and cos(x) for x in [0, π/4]. Unlike
+// math.Sincos, whose polynomial the compiler may contract into fused
+// multiply-adds on some platforms, every product is rounded explicitly, so
+// the result does not depend on the platform.
+func sincosOctant(x float64) (float64, float64) {
+	x2 := float64(x * x)
+
+	s := float64(sinC8*x2) + sinC7
+	s = float64(s*x2) + sinC6
+	s = float64(s*x2) + sinC5
+	s = float64(s*x2) + sinC4
+	s = float64(s*x2) + sinC3
+	s = float64(s*x2) + sinC2
+	s = float64(s*x2) + sinC1
+	s = x + float64(float64(x*x2)*s)
+
+	c := float64(cosC8*x2) + cosC7
+	c = float64(c*x2) + cosC6
+	c = float64(c*x2) + cosC5
+	c = float64(c*x2) + cosC4
+	c = float64(c*x2) + cosC3
+	c = float64(c*x2) + cosC2
+	c = float64(c*x2) + cosC1
+	c = 1 + float64(x2*c)
+
+	return s, c
+}
+
+// ComputeChirpSequenceOctant returns the Bluestein chirp exp(-πik²/n) for
+// k = 0..n-1. k² is reduced modulo 2n before the angle is formed, so the
+// chirp stays accurate for large n, where the float64 angle πk²/n of the
+// direct computation loses digits.
+func ComputeChirpSequenceOctant[T Complex](n int) []T {
+	if n <= 0 {
+		return nil
+	}
+
+	chirp := make([]T, n)
+	for k := range n {
+		chirp[k] = ComplexFromFloat64[T](RootOfUnity(k*k%(2*n), 2*n))
+	}
+
+	return chirp
+}
@@ -0,0 +1,128 @@
+package math
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestComputeTwiddleFactorsOctant(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 3, 5, 8, 12, 17, 64, 1000, 4096} {
+		octant := ComputeTwiddleFactorsOctant[complex128](n)
+		direct := ComputeTwiddleFactors[complex128](n)
+
+		for k := range n {
+			if diff := cmplx.Abs(octant[k] - direct[k]); diff > 4e-15 {
+				t.Fatalf("n=%d k=%d: octant %v, direct %v (diff %g)", n, k, octant[k], direct[k], diff)
+			}
+
+			if k > 0 && octant[n-k] != complex(real(octant[k]), -imag(octant[k])) {
+				t.Fatalf("n=%d k=%d: W[n-k] = %v is not conj(W[k]) = %v", n, k, octant[n-k], octant[k])
+			}
+
+			if n%4 == 0 && k+n/4 < n && octant[k+n/4] != complex(imag(octant[k]), -real(octant[k])) {
+				t.Fatalf("n=%d k=%d: W[k+n/4] = %v is not -i*W[k]", n, k, octant[k+n/4])
+			}
+		}
+
+		if n%8 == 0 {
+			if octant[n/4] != complex(0, -1) || octant[n/2] != -1 {
+				t.Fatalf("n=%d: W[n/4] = %v, W[n/2] = %v, want -i and -1", n, octant[n/4], octant[n/2])
+			}
+
+			if re, im := real(octant[n/8]), imag(octant[n/8]); re != -im {
+				t.Fatalf("n=%d: W[n/8] = %v is not on the diagonal", n, octant[n/8])
+			}
+		}
+	}
+
+	if ComputeTwiddleFactorsOctant[complex64](0) != nil {
+		t.Fatal("ComputeTwiddleFactorsOctant(0) is not nil")
+	}
+}
+
+func TestRootOfUnityLargeAngles(t *testing.T) {
+	t.Parallel()
+
+	// exp(-2πik/n) for k = n-1 is exp(2πi/n); the octant reduction keeps it
+	// as accurate as W_1, while the direct angle 2π(n-1)/n loses digits.
+	const n = 1 << 20
+
+	re, im := RootOfUnity(n-1, n)
+	wantIm, wantRe := math.Sincos(2 * math.Pi / n)
+
+	if re != wantRe || im != wantIm {
+		t.Fatalf("RootOfUnity(n-1, n) = (%v, %v), want (%v, %v)", re, im, wantRe, wantIm)
+	}
+
+	re, im = RootOfUnity(-1, n)
+	if re != wantRe || im != wantIm {
+		t.Fatalf("RootOfUnity(-1, n) = (%v, %v), want (%v, %v)", re, im, wantRe, wantIm)
+	}
+}
+
+func TestComputeChirpSequenceOctant(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 7, 31, 1009} {
+		chirp := ComputeChirpSequenceOctant[complex128](n)
+
+		for k := range n {
+			angle := -math.Pi * float64(k*k) / float64(n)
+			want := complex(math.Cos(angle), math.Sin(angle))
+
+			if diff := cmplx.Abs(chirp[k] - want); diff > 1e-12 {
+				t.Fatalf("n=%d k=%d: chirp %v, want %v (diff %g)", n, k, chirp[k], want, diff)
+			}
+		}
+	}
+}
+
+func TestTwiddleFactorsModes(t *testing.T) {
+	t.Parallel()
+
+	const n = 256
+
+	tests := []struct {
+		mode TwiddleMode
+		want []complex64
+	}{
+		{TwiddleDirect, ComputeTwiddleFactors[complex64](n)},
+		{TwiddlePhasor, ComputeTwiddleFactorsPhasor[complex64](n)},
+		{TwiddleOctant, ComputeTwiddleFactorsOctant[complex64](n)},
+	}
+
+	for _, tt := range tests {
+		got := TwiddleFactors[complex64](n, tt.mode)
+		for k := range got {
+			if got[k] != tt.want[k] {
+				t.Fatalf("mode %d: twiddle[%d] = %v, want %v", tt.mode, k, got[k], tt.want[k])
+			}
+		}
+	}
+}
+
+func TestSincosOctant(t *testing.T) {
+	t.Parallel()
+
+	const steps = 10000
+
+	for i := 0; i <= steps; i++ {
+		x := math.Pi / 4 * float64(i) / steps
+		s, c := sincosOctant(x)
+		wantS, wantC := math.Sincos(x)
+
+		if math.Abs(s-wantS) > 2*ulp(wantS) || math.Abs(c-wantC) > 2*ulp(wantC) {
+			t.Fatalf("sincosOctant(%v) = (%v, %v), want (%v, %v)", x, s, c, wantS, wantC)
+		}
+	}
+}
+
+// ulp returns the spacing of float64 values at x.
+func ulp(x float64) float64 {
+	x = math.Abs(x)
+
+	return math.Nextafter(x, math.Inf(1)) - x
+}
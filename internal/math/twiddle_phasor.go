@@ -88,8 +88,9 @@ func ComputeTwiddleFactorsPhasor[T Complex](n int) []T {
 		}
 	}
 
-	// Apply conjugate symmetry: W_{n-k} = conj(W_k)
-	for k := 1; k < halfN; k++ {
+	// Apply conjugate symmetry: W_{n-k} = conj(W_k). For odd n this also
+	// covers n-halfN = halfN+1.
+	for k := 1; k < n-halfN; k++ {
 		twiddle[n-k] = Conj(twiddle[k])
 	}
 
@@ -158,7 +158,7 @@ func TestPhasorSymmetry(t *testing.T) {
 func TestPhasorVsDirect(t *testing.T) {
 	t.Parallel()
 	// Compare phasor results against direct computation
-	sizes := []int{32, 64, 128, 256, 512, 1024, 2048, 4096}
+	sizes := []int{32, 49, 63, 64, 128, 256, 512, 1001, 1024, 2048, 4096}
 
 	for _, n := range sizes {
 		t.Run(formatSizePhasor(n), func(t *testing.T) {
@@ -0,0 +1,127 @@
+package planner
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// Bluestein's algorithm computes a size n transform as a cyclic convolution
+// of any length M >= 2n-1. The next power of two can nearly double M, and
+// with it the work and memory, so the planner also considers the 5-smooth
+// lengths in between, which run on the mixed-radix kernel.
+
+// Extra cost of a radix-3 and a radix-5 stage of the mixed-radix kernel, in
+// radix-2 passes of the power-of-two kernels. The mixed-radix kernel is
+// slower per element than the power-of-two codelets, so a smooth length
+// must save more than its size ratio to be chosen. The values are the
+// medians of BenchmarkBluesteinStageCost in the root package over sizes
+// 768 to 81920 and both precisions, rounded to whole passes. The measuring
+// planners time the cheapest lengths instead of trusting them.
+const (
+	bluesteinRadix3Cost = 3
+	bluesteinRadix5Cost = 6
+)
+
+// bluesteinSizePrefix starts the algorithm name of a Bluestein plan with
+// a recorded convolution length.
+const bluesteinSizePrefix = "bluestein_"
+
+// BluesteinSizes returns the convolution lengths Bluestein's algorithm can
+// use for n, cheapest first by the cost model: the 5-smooth lengths from
+// 2n-1 up to the next power of two, which is always included.
+func BluesteinSizes(n int) []int {
+	if n < 1 {
+		return nil
+	}
+
+	lo := 2*n - 1
+	hi := m.NextPowerOfTwo(lo)
+
+	var sizes []int
+
+	for p5 := 1; p5 <= hi; p5 *= 5 {
+		for p3 := p5; p3 <= hi; p3 *= 3 {
+			for size := p3; size <= hi; size *= 2 {
+				if size >= lo {
+					sizes = append(sizes, size)
+				}
+			}
+		}
+	}
+
+	sort.Slice(sizes, func(i, j int) bool {
+		ci, cj := bluesteinCost(sizes[i]), bluesteinCost(sizes[j])
+		if ci != cj {
+			return ci < cj
+		}
+
+		return sizes[i] < sizes[j]
+	})
+
+	return sizes
+}
+
+// BluesteinSize returns the convolution length the estimate planner uses
+// for n, the first of BluesteinSizes. It depends only on n.
+func BluesteinSize(n int) int {
+	sizes := BluesteinSizes(n)
+	if len(sizes) == 0 {
+		return 0
+	}
+
+	return sizes[0]
+}
+
+// bluesteinCost estimates the time of a transform of the 5-smooth size in
+// radix-2 passes over size elements: log2(size) passes plus the extra cost
+// of its radix-3 and radix-5 stages.
+func bluesteinCost(size int) float64 {
+	passes := math.Log2(float64(size))
+
+	for r := size; r%3 == 0; r /= 3 {
+		passes += bluesteinRadix3Cost
+	}
+
+	for r := size; r%5 == 0; r /= 5 {
+		passes += bluesteinRadix5Cost
+	}
+
+	return float64(size) * passes
+}
+
+// BluesteinAlgorithmName returns the algorithm name of a Bluestein plan
+// convolving with length size, e.g. "bluestein_2304". The measuring planners
+// record it as wisdom.
+func BluesteinAlgorithmName(size int) string {
+	return bluesteinSizePrefix + strconv.Itoa(size)
+}
+
+// ParseBluesteinAlgorithm parses a name produced by BluesteinAlgorithmName
+// and checks that the length suits a transform of size n.
+func ParseBluesteinAlgorithm(name string, n int) (int, bool) {
+	rest, ok := strings.CutPrefix(name, bluesteinSizePrefix)
+	if !ok {
+		return 0, false
+	}
+
+	size, err := strconv.Atoi(rest)
+	if err != nil || size < 1 || size < 2*n-1 || !isFiveSmooth(size) {
+		return 0, false
+	}
+
+	return size, true
+}
+
+func isFiveSmooth(size int) bool {
+	for _, p := range [...]int{2, 3, 5} {
+		for size%p == 0 {
+			size /= p
+		}
+	}
+
+	return size == 1
+}
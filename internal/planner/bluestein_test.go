@@ -0,0 +1,132 @@
+package planner
+
+import (
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+func TestBluesteinSizes(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 17, 257, 289, 513, 1025, 4099, 10007} {
+		sizes := BluesteinSizes(n)
+		if len(sizes) == 0 {
+			t.Fatalf("BluesteinSizes(%d) is empty", n)
+		}
+
+		pow2 := m.NextPowerOfTwo(2*n - 1)
+		hasPow2 := false
+
+		for i, size := range sizes {
+			if size < 2*n-1 || size > pow2 || !isFiveSmooth(size) {
+				t.Errorf("BluesteinSizes(%d) contains %d", n, size)
+			}
+
+			if i > 0 && bluesteinCost(size) < bluesteinCost(sizes[i-1]) {
+				t.Errorf("BluesteinSizes(%d) not sorted by cost at %d", n, size)
+			}
+
+			hasPow2 = hasPow2 || size == pow2
+		}
+
+		if !hasPow2 {
+			t.Errorf("BluesteinSizes(%d) = %v lacks %d", n, sizes, pow2)
+		}
+
+		if BluesteinSize(n) != sizes[0] {
+			t.Errorf("BluesteinSize(%d) = %d, want %d", n, BluesteinSize(n), sizes[0])
+		}
+	}
+
+	tests := []struct{ n, want int }{
+		{17, 64},       // 33..64: nothing beats the power of two
+		{257, 576},     // 2^6·3^2 instead of 1024
+		{1025, 2304},   // 2^8·3^2 instead of 4096
+		{10007, 20480}, // 2^12·5 instead of 32768
+	}
+
+	for _, tt := range tests {
+		if got := BluesteinSize(tt.n); got != tt.want {
+			t.Errorf("BluesteinSize(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestParseBluesteinAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	if name := BluesteinAlgorithmName(2304); name != "bluestein_2304" {
+		t.Fatalf("BluesteinAlgorithmName(2304) = %q", name)
+	}
+
+	tests := []struct {
+		name string
+		n    int
+		want int
+		ok   bool
+	}{
+		{"bluestein_2304", 1025, 2304, true},
+		{"bluestein_4096", 1025, 4096, true},
+		{"bluestein_2048", 1025, 0, false}, // below 2n-1
+		{"bluestein_2051", 1025, 0, false}, // not 5-smooth
+		{"bluestein_0", 0, 0, false},
+		{"bluestein_x", 17, 0, false},
+		{"bluestein", 17, 0, false},
+		{"mixedradix_4x4", 16, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseBluesteinAlgorithm(tt.name, tt.n)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseBluesteinAlgorithm(%q, %d) = %d, %v, want %d, %v",
+				tt.name, tt.n, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestEstimatePlanBluesteinSize(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{Architecture: "amd64", HasSSE2: true}
+	mask := CPUFeatureMask(true, false, false, false)
+
+	const n = 17 * 17
+
+	estimate := EstimatePlan[complex64](n, features, nil, KernelAuto)
+	if estimate.Strategy != KernelBluestein || estimate.BluesteinM != BluesteinSize(n) {
+		t.Fatalf("EstimatePlan(%d) = %v with M = %d, want Bluestein with %d",
+			n, estimate.Strategy, estimate.BluesteinM, BluesteinSize(n))
+	}
+
+	wisdom := NewWisdom()
+	wisdom.Store(WisdomEntry{
+		Key:       WisdomKey{Size: n, Precision: 0, CPUFeatures: mask},
+		Algorithm: BluesteinAlgorithmName(1024),
+	})
+
+	for _, forced := range []KernelStrategy{KernelAuto, KernelBluestein} {
+		estimate := EstimatePlan[complex64](n, features, wisdom, forced)
+		if estimate.Strategy != KernelBluestein || estimate.BluesteinM != 1024 ||
+			estimate.Algorithm != "bluestein_1024" {
+			t.Errorf("EstimatePlan(%d, %v) with wisdom = %v/%q with M = %d, want bluestein_1024",
+				n, forced, estimate.Strategy, estimate.Algorithm, estimate.BluesteinM)
+		}
+	}
+
+	// A recorded length picks Bluestein's algorithm for a prime, but does
+	// not override a forced Rader plan
+	wisdom.Store(WisdomEntry{
+		Key:       WisdomKey{Size: 47, Precision: 0, CPUFeatures: mask},
+		Algorithm: BluesteinAlgorithmName(96),
+	})
+
+	if estimate := EstimatePlan[complex64](47, features, wisdom, KernelAuto); estimate.BluesteinM != 96 {
+		t.Errorf("EstimatePlan(47) with wisdom: M = %d, want 96", estimate.BluesteinM)
+	}
+
+	if estimate := EstimatePlan[complex64](47, features, wisdom, KernelRader); estimate.Strategy != KernelRader {
+		t.Errorf("EstimatePlan(47, Rader) with wisdom = %v, want KernelRader", estimate.Strategy)
+	}
+}
@@ -1,6 +1,7 @@
 package planner
 
 import (
+	"slices"
 	"sort"
 	"sync"
 
@@ -69,11 +70,38 @@ func (r *CodeletRegistry[T]) Register(entry CodeletEntry[T]) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	entries := r.codelets[entry.Size]
-	entries = append(entries, entry)
+	r.insertUnlocked(entry)
+}
+
+// Replace registers entry in place of any codelet with the same size and
+// signature. It reports whether one was replaced.
+func (r *CodeletRegistry[T]) Replace(entry CodeletEntry[T]) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	replaced := r.removeUnlocked(entry.Size, entry.Signature)
+	r.insertUnlocked(entry)
+
+	return replaced
+}
+
+// Unregister removes the codelets with the given size and signature.
+// It reports whether there were any.
+func (r *CodeletRegistry[T]) Unregister(size int, signature string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.removeUnlocked(size, signature)
+}
+
+// insertUnlocked adds entry and keeps its size sorted by preference.
+// Caller must hold r.mu for writing.
+func (r *CodeletRegistry[T]) insertUnlocked(entry CodeletEntry[T]) {
+	// Build a new slice: Lookup hands out pointers into the old one
+	entries := append(slices.Clone(r.codelets[entry.Size]), entry)
 
 	// Sort by SIMD level (higher = better) then priority
-	sort.Slice(entries, func(i, j int) bool {
+	sort.SliceStable(entries, func(i, j int) bool {
 		if entries[i].SIMDLevel != entries[j].SIMDLevel {
 			return entries[i].SIMDLevel > entries[j].SIMDLevel
 		}
@@ -84,6 +112,27 @@ func (r *CodeletRegistry[T]) Register(entry CodeletEntry[T]) {
 	r.codelets[entry.Size] = entries
 }
 
+// removeUnlocked deletes the entries of size with the given signature.
+// Caller must hold r.mu for writing.
+func (r *CodeletRegistry[T]) removeUnlocked(size int, signature string) bool {
+	entries := r.codelets[size]
+
+	kept := slices.DeleteFunc(slices.Clone(entries), func(e CodeletEntry[T]) bool {
+		return e.Signature == signature
+	})
+	if len(kept) == len(entries) {
+		return false
+	}
+
+	if len(kept) == 0 {
+		delete(r.codelets, size)
+	} else {
+		r.codelets[size] = kept
+	}
+
+	return true
+}
+
 // Lookup finds the best codelet for a given size and CPU features.
 // Returns nil if no codelet is available for the size.
 // The lookup prefers higher SIMD levels that the CPU supports.
@@ -194,9 +243,9 @@ func (r *CodeletRegistry[T]) lookupUnlocked(size int, features cpu.Features) *Co
 		return nil
 	}
 
-	// Find the best codelet that the CPU supports
+	// Find the best enabled codelet that the CPU supports
 	for i := range entries {
-		if cpuSupports(features, entries[i].SIMDLevel) {
+		if entries[i].Priority >= 0 && cpuSupports(features, entries[i].SIMDLevel) {
 			return &entries[i]
 		}
 	}
@@ -204,6 +253,12 @@ func (r *CodeletRegistry[T]) lookupUnlocked(size int, features cpu.Features) *Co
 	return nil
 }
 
+// SupportsSIMD reports whether codelets of the given SIMD level can run
+// with the CPU features.
+func SupportsSIMD(features cpu.Features, level SIMDLevel) bool {
+	return cpuSupports(features, level)
+}
+
 // cpuSupports checks if the CPU features support the given SIMD level.
 func cpuSupports(features cpu.Features, level SIMDLevel) bool {
 	// ForceGeneric is a testing/debugging knob to disable *all* SIMD. It must
@@ -394,6 +394,107 @@ func TestCodeletRegistrySorted(t *testing.T) {
 	}
 }
 
+// TestCodeletRegistryReplaceAndUnregister tests replacing and removing
+// codelets by signature.
+func TestCodeletRegistryReplaceAndUnregister(t *testing.T) {
+	t.Parallel()
+
+	registry := NewCodeletRegistry[complex64]()
+	features := cpu.Features{Architecture: "amd64", HasSSE2: true}
+
+	for _, sig := range []string{"low", "high"} {
+		priority := 0
+		if sig == "high" {
+			priority = 10
+		}
+
+		registry.Register(CodeletEntry[complex64]{
+			Size:      16,
+			Forward:   dummyCodelet[complex64],
+			Inverse:   dummyCodelet[complex64],
+			Algorithm: KernelDIT,
+			SIMDLevel: SIMDNone,
+			Signature: sig,
+			Priority:  priority,
+		})
+	}
+
+	// Replacing "low" with a higher priority makes it the best codelet
+	held := registry.Lookup(16, features)
+
+	replaced := registry.Replace(CodeletEntry[complex64]{
+		Size:      16,
+		Forward:   dummyCodelet[complex64],
+		Inverse:   dummyCodelet[complex64],
+		Algorithm: KernelStockham,
+		SIMDLevel: SIMDNone,
+		Signature: "low",
+		Priority:  20,
+	})
+	if !replaced {
+		t.Error("Replace did not find the \"low\" codelet")
+	}
+
+	if held.Signature != "high" {
+		t.Errorf("Replace changed a codelet returned by Lookup to %q", held.Signature)
+	}
+
+	if got := len(registry.GetAllForSize(16)); got != 2 {
+		t.Fatalf("expected 2 codelets after Replace, got %d", got)
+	}
+
+	found := registry.Lookup(16, features)
+	if found == nil || found.Signature != "low" || found.Algorithm != KernelStockham {
+		t.Fatalf("Lookup after Replace = %+v, want the new \"low\" codelet", found)
+	}
+
+	if !registry.Unregister(16, "low") {
+		t.Error("Unregister did not find the \"low\" codelet")
+	}
+
+	if registry.Unregister(16, "low") || registry.Unregister(32, "high") {
+		t.Error("Unregister removed a codelet that is not registered")
+	}
+
+	if found := registry.Lookup(16, features); found == nil || found.Signature != "high" {
+		t.Fatalf("Lookup after Unregister = %+v, want \"high\"", found)
+	}
+
+	registry.Unregister(16, "high")
+
+	if registry.Has(16) || len(registry.Sizes()) != 0 {
+		t.Errorf("registry still has size 16 after removing all its codelets: %v", registry.Sizes())
+	}
+}
+
+// TestCodeletRegistryDisabled tests that codelets with negative priority
+// are never selected.
+func TestCodeletRegistryDisabled(t *testing.T) {
+	t.Parallel()
+
+	registry := NewCodeletRegistry[complex64]()
+
+	registry.Register(CodeletEntry[complex64]{
+		Size:      16,
+		Forward:   dummyCodelet[complex64],
+		Inverse:   dummyCodelet[complex64],
+		Algorithm: KernelDIT,
+		SIMDLevel: SIMDNone,
+		Signature: "disabled",
+		Priority:  -1,
+	})
+
+	features := cpu.Features{Architecture: "amd64"}
+
+	if found := registry.Lookup(16, features); found != nil {
+		t.Errorf("Lookup returned disabled codelet %q", found.Signature)
+	}
+
+	if got := registry.GetAvailableSizes(features); len(got) != 0 {
+		t.Errorf("GetAvailableSizes included disabled codelets: %v", got)
+	}
+}
+
 // TestCodeletRegistryConcurrent tests concurrent registration and lookup.
 func TestCodeletRegistryConcurrent(t *testing.T) {
 	t.Parallel()
@@ -25,6 +25,14 @@ type PlanEstimate[T Complex] struct {
 
 	// Strategy is the kernel strategy (DIT, Stockham, etc.)
 	Strategy KernelStrategy
+
+	// Radices is an explicit mixed-radix schedule, outermost stage first
+	// (nil unless the plan should run the mixed-radix kernel with it)
+	Radices []int
+
+	// BluesteinM is the convolution length of a Bluestein plan (0 for other
+	// strategies)
+	BluesteinM int
 }
 
 // EstimatePlan determines the best kernel/codelet for the given size.
@@ -37,22 +45,35 @@ type PlanEstimate[T Complex] struct {
 //   - Direct codelet bindings (zero dispatch) if a codelet is registered for the size
 //   - Empty codelet fields and just Strategy if no codelet (caller uses fallback kernels)
 func EstimatePlan[T Complex](n int, features cpu.Features, wisdom WisdomStore, forcedStrategy KernelStrategy) PlanEstimate[T] {
+	// A forced PFA on a size without coprime factors is ignored
+	if forcedStrategy == KernelPFA && !CanUsePFA(n) {
+		forcedStrategy = KernelAuto
+	}
+
 	strategy := ResolveKernelStrategy(n)
 	if forcedStrategy != KernelAuto {
 		strategy = forcedStrategy
 	}
 
-	// For Bluestein, there are no codelets
-	if !IsPowerOf2(n) && !IsHighlyComposite(n) {
+	codelet := tryRegistry[T](n, features, forcedStrategy)
+
+	// The prime-factor algorithm runs its factors on child plans
+	if usePFA[T](n, features, wisdom, forcedStrategy, codelet != nil) {
 		return PlanEstimate[T]{
-			Strategy:  KernelBluestein,
-			Algorithm: "bluestein",
+			Strategy:  KernelPFA,
+			Algorithm: StrategyToAlgorithmName(KernelPFA),
 		}
 	}
 
+	// Other sizes need Rader or Bluestein, unless a registered codelet
+	// covers them
+	if forcedStrategy == KernelRader || codelet == nil && !IsPowerOf2(n) && !IsHighlyComposite(n) {
+		return estimateConvolution[T](n, features, wisdom, forcedStrategy)
+	}
+
 	// 1. Try codelet registry first (highest priority - zero dispatch)
-	if est := tryRegistry[T](n, features, forcedStrategy); est != nil {
-		return *est
+	if codelet != nil {
+		return *codelet
 	}
 
 	// 2. Try wisdom cache (if provided)
@@ -73,6 +94,70 @@ func EstimatePlan[T Complex](n int, features cpu.Features, wisdom WisdomStore, f
 	}
 }
 
+// usePFA reports whether n gets the prime-factor algorithm: when it is
+// forced, or else when wisdom recorded it, or else when PrefersPFA holds
+// and there is no codelet for n.
+func usePFA[T Complex](n int, features cpu.Features, wisdom WisdomStore, forcedStrategy KernelStrategy, hasCodelet bool) bool {
+	if !CanUsePFA(n) || forcedStrategy != KernelAuto && forcedStrategy != KernelPFA {
+		return false
+	}
+
+	if forcedStrategy == KernelPFA {
+		return true
+	}
+
+	if _, wisStrat, found := resolveWisdom[T](n, features, wisdom, KernelAuto); found {
+		return wisStrat == KernelPFA
+	}
+
+	return PrefersPFA(n) && !hasCodelet
+}
+
+// estimateConvolution chooses between Rader's and Bluestein's algorithm for
+// n: a forced strategy first, then wisdom, then PrefersRader. Sizes where
+// Rader's algorithm does not apply always get Bluestein's, with the
+// convolution length from wisdom if it recorded one.
+func estimateConvolution[T Complex](n int, features cpu.Features, wisdom WisdomStore, forcedStrategy KernelStrategy) PlanEstimate[T] {
+	strategy := forcedStrategy
+	wisEst, wisStrat, found := resolveWisdom[T](n, features, wisdom, KernelAuto)
+
+	if strategy != KernelRader && strategy != KernelBluestein {
+		strategy = KernelBluestein
+		if PrefersRader(n) {
+			strategy = KernelRader
+		}
+
+		if found && (wisStrat == KernelRader || wisStrat == KernelBluestein) {
+			strategy = wisStrat
+		}
+	}
+
+	estimate := ConvolutionEstimate[T](n, strategy)
+	if estimate.Strategy == KernelBluestein && wisEst != nil && wisEst.BluesteinM != 0 {
+		return *wisEst
+	}
+
+	return estimate
+}
+
+// ConvolutionEstimate returns the estimate of a Rader or Bluestein plan for
+// n. Rader's algorithm falls back to Bluestein's when n is not an odd prime;
+// Bluestein's convolves with the length BluesteinSize(n).
+func ConvolutionEstimate[T Complex](n int, strategy KernelStrategy) PlanEstimate[T] {
+	if strategy != KernelRader || !CanUseRader(n) {
+		return PlanEstimate[T]{
+			Strategy:   KernelBluestein,
+			Algorithm:  StrategyToAlgorithmName(KernelBluestein),
+			BluesteinM: BluesteinSize(n),
+		}
+	}
+
+	return PlanEstimate[T]{
+		Strategy:  strategy,
+		Algorithm: StrategyToAlgorithmName(strategy),
+	}
+}
+
 func tryRegistry[T Complex](n int, features cpu.Features, forcedStrategy KernelStrategy) *PlanEstimate[T] {
 	registry := GetRegistry[T]()
 	if registry == nil {
@@ -137,6 +222,32 @@ func resolveWisdom[T Complex](n int, features cpu.Features, wisdom WisdomStore,
 		}
 	}
 
+	// Wisdom recorded a mixed-radix schedule
+	if radices, ok := ParseRadixSchedule(algorithm); ok {
+		if scheduleProduct(radices) != n || (forcedStrategy != KernelAuto && forcedStrategy != KernelDIT) {
+			return nil, KernelAuto, false
+		}
+
+		return &PlanEstimate[T]{
+			Algorithm: algorithm,
+			Strategy:  KernelDIT,
+			Radices:   radices,
+		}, KernelAuto, true
+	}
+
+	// Wisdom recorded Bluestein's algorithm with its convolution length
+	if size, ok := ParseBluesteinAlgorithm(algorithm, n); ok {
+		if forcedStrategy != KernelAuto && forcedStrategy != KernelBluestein {
+			return nil, KernelAuto, false
+		}
+
+		return &PlanEstimate[T]{
+			Algorithm:  algorithm,
+			Strategy:   KernelBluestein,
+			BluesteinM: size,
+		}, KernelBluestein, true
+	}
+
 	// Wisdom algorithm doesn't match a codelet, apply as kernel strategy
 	var strategy KernelStrategy
 
@@ -151,6 +262,14 @@ func resolveWisdom[T Complex](n int, features cpu.Features, wisdom WisdomStore,
 		strategy = KernelEightStep
 	case "bluestein":
 		strategy = KernelBluestein
+	case "rader":
+		strategy = KernelRader
+	case "pfa":
+		strategy = KernelPFA
+	case "splitradix":
+		strategy = KernelSplitRadix
+	case "recursive":
+		strategy = KernelRecursive
 	default:
 		return nil, KernelAuto, false
 	}
@@ -99,7 +99,9 @@ func TestEstimatePlanNonPowerOf2(t *testing.T) {
 		{"Size 1000 (highly composite)", 1000, false}, // 2³ × 5³ - not bluestein
 		{"Size 1500 (highly composite)", 1500, false}, // 2² × 3 × 5³ - not bluestein
 		{"Size 3072 (highly composite)", 3072, false}, // 2¹⁰ × 3 - not bluestein
-		{"Size 1001 (not composite)", 1001, true},     // 7 × 11 × 13 - bluestein required
+		{"Size 1001 (13-smooth)", 1001, false},        // 7 × 11 × 13 - not bluestein
+		{"Size 4199 (coprime factors)", 4199, false},  // 13 × 17 × 19 - prime-factor algorithm
+		{"Size 1849 (prime power)", 1849, true},       // 43² - bluestein required
 	}
 
 	features := cpu.Features{
@@ -119,9 +121,10 @@ func TestEstimatePlanNonPowerOf2(t *testing.T) {
 						tt.size, estimate.Algorithm)
 				}
 			} else {
-				// Highly composite numbers use fallback strategies, not bluestein
+				// Highly composite numbers use fallback strategies and
+				// coprime factorizations PFA, not bluestein
 				if estimate.Algorithm == "bluestein" {
-					t.Errorf("EstimatePlan(%d) algorithm = \"bluestein\", but %d is 5-smooth",
+					t.Errorf("EstimatePlan(%d) algorithm = \"bluestein\", but %d splits into smaller kernels",
 						tt.size, tt.size)
 				}
 			}
@@ -216,3 +219,196 @@ func TestHasCodelet(t *testing.T) {
 		t.Error("HasCodelet should return false when no codelets registered")
 	}
 }
+
+// TestRadixScheduleName tests schedule names and their parsing.
+func TestRadixScheduleName(t *testing.T) {
+	t.Parallel()
+
+	name := RadixScheduleName([]int{8, 5, 3})
+	if name != "mixedradix_8x5x3" {
+		t.Fatalf("RadixScheduleName = %q, want mixedradix_8x5x3", name)
+	}
+
+	radices, ok := ParseRadixSchedule(name)
+	if !ok || len(radices) != 3 || radices[0] != 8 || radices[1] != 5 || radices[2] != 3 {
+		t.Fatalf("ParseRadixSchedule(%q) = %v, %v", name, radices, ok)
+	}
+
+	for _, bad := range []string{"stockham", "mixedradix_", "mixedradix_4xx2", "mixedradix_1x4", "mixedradix_4xa"} {
+		if _, ok := ParseRadixSchedule(bad); ok {
+			t.Errorf("ParseRadixSchedule(%q) succeeded", bad)
+		}
+	}
+}
+
+// TestEstimatePlanWithScheduleWisdom tests that wisdom can carry a radix schedule.
+func TestEstimatePlanWithScheduleWisdom(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{Architecture: "amd64", HasSSE2: true}
+	mask := CPUFeatureMask(true, false, false, false)
+
+	wisdom := NewWisdom()
+	wisdom.Store(WisdomEntry{
+		Key:       WisdomKey{Size: 360, Precision: 1, CPUFeatures: mask},
+		Algorithm: "mixedradix_8x5x3x3",
+	})
+	wisdom.Store(WisdomEntry{
+		Key:       WisdomKey{Size: 180, Precision: 1, CPUFeatures: mask},
+		Algorithm: "mixedradix_8x5x3x3", // wrong size, ignored
+	})
+
+	estimate := EstimatePlan[complex128](360, features, wisdom, KernelAuto)
+	if estimate.ForwardCodelet == nil && len(estimate.Radices) != 4 {
+		t.Errorf("EstimatePlan(360) radices = %v, want [8 5 3 3]", estimate.Radices)
+	}
+
+	estimate = EstimatePlan[complex128](180, features, wisdom, KernelAuto)
+	if estimate.Radices != nil {
+		t.Errorf("EstimatePlan(180) used mismatched schedule %v", estimate.Radices)
+	}
+
+	estimate = EstimatePlan[complex128](360, features, wisdom, KernelStockham)
+	if estimate.Radices != nil {
+		t.Errorf("forced strategy kept wisdom schedule %v", estimate.Radices)
+	}
+}
+
+// TestEstimatePlanRader tests the choice between Rader and Bluestein.
+func TestEstimatePlanRader(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{Architecture: "amd64", HasSSE2: true}
+
+	tests := []struct {
+		name   string
+		size   int
+		forced KernelStrategy
+		want   KernelStrategy
+	}{
+		{"Prime with power-of-two n-1", 17, KernelAuto, KernelRader},
+		{"Prime with smooth n-1", 61, KernelAuto, KernelRader},
+		{"Prime with non-smooth n-1", 47, KernelAuto, KernelBluestein},
+		{"Prime power non-smooth", 17 * 17, KernelAuto, KernelBluestein},
+		{"Forced Bluestein on prime", 17, KernelBluestein, KernelBluestein},
+		{"Forced Rader on non-smooth prime", 47, KernelRader, KernelRader},
+		{"Forced Rader on smooth prime", 5, KernelRader, KernelRader},
+		{"Forced Rader on composite", 34, KernelRader, KernelBluestein},
+		{"Forced DIT on prime", 17, KernelDIT, KernelRader},
+	}
+
+	for _, tt := range tests {
+		estimate := EstimatePlan[complex64](tt.size, features, nil, tt.forced)
+		if estimate.Strategy != tt.want || estimate.Algorithm != StrategyToAlgorithmName(tt.want) {
+			t.Errorf("%s: EstimatePlan(%d) = %v (%q), want %v", tt.name, tt.size,
+				estimate.Strategy, estimate.Algorithm, tt.want)
+		}
+	}
+
+	wisdom := NewWisdom()
+	wisdom.Store(WisdomEntry{
+		Key:       WisdomKey{Size: 17, Precision: 0, CPUFeatures: CPUFeatureMask(true, false, false, false)},
+		Algorithm: "bluestein",
+	})
+
+	if estimate := EstimatePlan[complex64](17, features, wisdom, KernelAuto); estimate.Strategy != KernelBluestein {
+		t.Errorf("EstimatePlan(17) with bluestein wisdom = %v, want KernelBluestein", estimate.Strategy)
+	}
+}
+
+// TestEstimatePlanPFA tests when the prime-factor algorithm is chosen.
+func TestEstimatePlanPFA(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{Architecture: "amd64", HasSSE2: true}
+
+	tests := []struct {
+		name   string
+		size   int
+		forced KernelStrategy
+		want   KernelStrategy
+	}{
+		{"Coprime Rader primes", 13 * 17 * 19, KernelAuto, KernelPFA},
+		{"Power of two and Rader prime", 64 * 17, KernelAuto, KernelPFA},
+		{"Prime power with butterfly", 49 * 17, KernelAuto, KernelPFA},
+		{"Factor needing Bluestein", 2 * 47, KernelAuto, KernelBluestein},
+		{"Prime power", 17 * 17, KernelAuto, KernelBluestein},
+		{"Smooth size with butterfly factors", 15, KernelAuto, KernelPFA},
+		{"Smooth size with nested PFA factor", 1001, KernelAuto, KernelPFA},
+		{"Smooth size with prime power factor", 45, KernelAuto, KernelDIT},
+		{"Forced PFA on smooth size", 45, KernelPFA, KernelPFA},
+		{"Forced PFA on Bluestein factor", 2 * 47, KernelPFA, KernelPFA},
+		{"Forced PFA on prime", 17, KernelPFA, KernelRader},
+		{"Forced Bluestein", 13 * 17 * 19, KernelBluestein, KernelBluestein},
+	}
+
+	for _, tt := range tests {
+		estimate := EstimatePlan[complex64](tt.size, features, nil, tt.forced)
+		if estimate.Strategy != tt.want || estimate.Algorithm != StrategyToAlgorithmName(tt.want) {
+			t.Errorf("%s: EstimatePlan(%d) = %v (%q), want %v", tt.name, tt.size,
+				estimate.Strategy, estimate.Algorithm, tt.want)
+		}
+	}
+
+	// Other smooth sizes keep their mixed-radix schedule unless wisdom says PFA.
+
+	wisdom := NewWisdom()
+	wisdom.Store(WisdomEntry{
+		Key:       WisdomKey{Size: 45, Precision: 0, CPUFeatures: CPUFeatureMask(true, false, false, false)},
+		Algorithm: "pfa",
+	})
+
+	if estimate := EstimatePlan[complex64](45, features, wisdom, KernelAuto); estimate.Strategy != KernelPFA {
+		t.Errorf("EstimatePlan(45) with pfa wisdom = %v, want KernelPFA", estimate.Strategy)
+	}
+}
+
+func TestPFAFactors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n, n1, n2 int
+	}{
+		{15, 5, 3},
+		{63, 9, 7},
+		{1001, 13, 77},
+		{3 * 5 * 7 * 64, 64, 105},
+		{12, 4, 3},
+		{64, 0, 0},
+		{49, 0, 0},
+		{17, 0, 0},
+		{1, 0, 0},
+	}
+
+	for _, tt := range tests {
+		if n1, n2 := PFAFactors(tt.n); n1 != tt.n1 || n2 != tt.n2 {
+			t.Errorf("PFAFactors(%d) = %d, %d, want %d, %d", tt.n, n1, n2, tt.n1, tt.n2)
+		}
+	}
+}
+
+func TestPrefersPFA(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n    int
+		want bool
+	}{
+		{15, true},              // 5 x 3 butterflies
+		{64 * 7, true},          // codelet and butterfly
+		{13 * 77, true},         // 77 is a PFA of butterflies
+		{63, false},             // 9 has no butterfly
+		{13 * 231, false},       // 231 nests two PFAs deep
+		{64 * 17, true},         // no mixed-radix schedule, Rader factor
+		{2 * 47, false},         // 47 needs Bluestein
+		{1024, false},           // power of two
+		{17 * 17, false},        // prime power
+		{3 * 5 * 7 * 64, false}, // 64 x 105, 105 = 7 x 15 nests twice
+	}
+
+	for _, tt := range tests {
+		if got := PrefersPFA(tt.n); got != tt.want {
+			t.Errorf("PrefersPFA(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
@@ -18,6 +18,7 @@ func TestSetGetKernelStrategy(t *testing.T) {
 		KernelStockham,
 		KernelSixStep,
 		KernelEightStep,
+		KernelSplitRadix,
 	}
 
 	for _, strategy := range tests {
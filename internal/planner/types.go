@@ -12,11 +12,14 @@ type KernelStrategy = fftypes.KernelStrategy
 
 // Strategy constants.
 const (
-	KernelAuto      = fftypes.KernelAuto
-	KernelDIT       = fftypes.KernelDIT
-	KernelStockham  = fftypes.KernelStockham
-	KernelSixStep   = fftypes.KernelSixStep
-	KernelEightStep = fftypes.KernelEightStep
-	KernelBluestein = fftypes.KernelBluestein
-	KernelRecursive = fftypes.KernelRecursive
+	KernelAuto       = fftypes.KernelAuto
+	KernelDIT        = fftypes.KernelDIT
+	KernelStockham   = fftypes.KernelStockham
+	KernelSixStep    = fftypes.KernelSixStep
+	KernelEightStep  = fftypes.KernelEightStep
+	KernelBluestein  = fftypes.KernelBluestein
+	KernelRecursive  = fftypes.KernelRecursive
+	KernelRader      = fftypes.KernelRader
+	KernelPFA        = fftypes.KernelPFA
+	KernelSplitRadix = fftypes.KernelSplitRadix
 )
@@ -1,6 +1,9 @@
 package planner
 
 import (
+	"strconv"
+	"strings"
+
 	m "github.com/MeKo-Christian/algo-fft/internal/math"
 )
 
@@ -22,6 +25,95 @@ var IsPowerOf2 = m.IsPowerOf2
 // Re-exported from internal/math.
 var IsHighlyComposite = m.IsHighlyComposite
 
+// CanUseRader reports whether Rader's algorithm applies to n: n must be an
+// odd prime.
+func CanUseRader(n int) bool {
+	return n > 2 && m.IsPrime(n)
+}
+
+// PrefersRader reports whether the estimate planner picks Rader's algorithm
+// over Bluestein's for n: n is prime and its convolution length n-1 has a
+// mixed-radix schedule, so it runs two FFTs of length n-1 instead of three
+// of a power of two >= 2n-1.
+func PrefersRader(n int) bool {
+	return CanUseRader(n) && (IsPowerOf2(n-1) || IsHighlyComposite(n-1))
+}
+
+// PFAFactors splits n into coprime factors n1*n2 for the prime-factor
+// algorithm: n1 is the largest prime power dividing n, so a power-of-two
+// part stays whole for its codelet. It returns 0, 0 if n is a prime power.
+func PFAFactors(n int) (n1, n2 int) {
+	factors := m.Factorize(n)
+	if len(factors) == 0 || factors[0] == factors[len(factors)-1] {
+		return 0, 0
+	}
+
+	power := 1
+	for i, q := range factors {
+		if i > 0 && q != factors[i-1] {
+			power = 1
+		}
+
+		power *= q
+		n1 = max(n1, power)
+	}
+
+	return n1, n / n1
+}
+
+// CanUsePFA reports whether the prime-factor algorithm applies to n: n must
+// have at least two distinct prime factors.
+func CanUsePFA(n int) bool {
+	n1, _ := PFAFactors(n)
+	return n1 != 0
+}
+
+// PrefersPFA reports whether the estimate planner picks the prime-factor
+// algorithm for n. Sizes without a mixed-radix schedule get it when they
+// split into coprime prime powers that avoid Bluestein's algorithm, i.e.
+// powers of primes up to 13 and primes that PrefersRader. Mixed-radix
+// sizes get it when both factors run cheaply as PFA lines, where it skips
+// the twiddle passes of the mixed-radix kernel: a line is a prime up to 13
+// or a power of two, and n2 may also be a PFA of two such lines. Deeper
+// nests and other prime powers keep the mixed-radix schedule; the
+// measuring planners compare both.
+func PrefersPFA(n int) bool {
+	if IsPowerOf2(n) || !CanUsePFA(n) {
+		return false
+	}
+
+	if IsHighlyComposite(n) {
+		n1, n2 := PFAFactors(n)
+		if !isPFALine(n1) {
+			return false
+		}
+
+		if isPFALine(n2) {
+			return true
+		}
+
+		m1, m2 := PFAFactors(n2)
+
+		return m1 != 0 && isPFALine(m1) && isPFALine(m2)
+	}
+
+	factors := m.Factorize(n)
+	for i, q := range factors {
+		if q > 13 && (i > 0 && factors[i-1] == q || !PrefersRader(q)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPFALine reports whether a PFA factor of size n runs on its lines
+// without a plan of its own: a radix butterfly covers the primes up to 13
+// and 4, a codelet the powers of two.
+func isPFALine(n int) bool {
+	return IsPowerOf2(n) || n <= 13 && m.IsPrime(n)
+}
+
 // complexFromFloat64 creates a complex number of type T from float64 components.
 func complexFromFloat64[T Complex](re, im float64) T {
 	return m.ComplexFromFloat64[T](re, im)
@@ -64,7 +156,67 @@ func StrategyToAlgorithmName(strategy KernelStrategy) string {
 		return "eightstep"
 	case KernelBluestein:
 		return "bluestein"
+	case KernelRader:
+		return "rader"
+	case KernelPFA:
+		return "pfa"
+	case KernelSplitRadix:
+		return "splitradix"
+	case KernelRecursive:
+		return "recursive"
 	default:
 		return "unknown"
 	}
 }
+
+// radixSchedulePrefix starts the algorithm name of a mixed-radix schedule.
+const radixSchedulePrefix = "mixedradix_"
+
+// RadixScheduleName returns the algorithm name of a mixed-radix schedule,
+// e.g. "mixedradix_4x4x4x2". It is used for Plan.Algorithm and wisdom entries.
+func RadixScheduleName(radices []int) string {
+	var b strings.Builder
+
+	b.WriteString(radixSchedulePrefix)
+
+	for i, r := range radices {
+		if i > 0 {
+			b.WriteByte('x')
+		}
+
+		b.WriteString(strconv.Itoa(r))
+	}
+
+	return b.String()
+}
+
+// ParseRadixSchedule parses a name produced by RadixScheduleName.
+func ParseRadixSchedule(name string) ([]int, bool) {
+	rest, ok := strings.CutPrefix(name, radixSchedulePrefix)
+	if !ok || rest == "" {
+		return nil, false
+	}
+
+	fields := strings.Split(rest, "x")
+	radices := make([]int, len(fields))
+
+	for i, field := range fields {
+		r, err := strconv.Atoi(field)
+		if err != nil || r < 2 {
+			return nil, false
+		}
+
+		radices[i] = r
+	}
+
+	return radices, true
+}
+
+func scheduleProduct(radices []int) int {
+	product := 1
+	for _, r := range radices {
+		product *= r
+	}
+
+	return product
+}
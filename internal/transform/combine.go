@@ -73,18 +73,18 @@ func combineRadix4[T Complex](
 // This is the radix-8 decimation-in-time combine step.
 func combineRadix8[T Complex](
 	dst []T, // Output buffer (size N)
-	subs [][]T, // Eight N/8 sub-results (subs[0] to subs[7])
-	twiddles [][]T, // Twiddle factors: twiddles[r][k] = W^(r*k) for r=0..7
+	subs []T, // Eight N/8 sub-results back to back
+	twiddles []T, // Twiddle factors: twiddles[r*N/8+k] = W^(r*k) for r=0..7
 ) {
-	eighth := len(subs[0])
+	eighth := len(subs) / 8
 
 	for k := range eighth {
 		// Apply twiddle factors
-		t := make([]T, 8)
+		var t [8]T
 
-		t[0] = subs[0][k] // W^0 = 1, no multiplication needed
+		t[0] = subs[k] // W^0 = 1, no multiplication needed
 		for r := 1; r < 8; r++ {
-			t[r] = twiddles[r][k] * subs[r][k]
+			t[r] = twiddles[r*eighth+k] * subs[r*eighth+k]
 		}
 
 		// Radix-8 butterfly (can be optimized further with radix-2 + radix-4 decomposition)
@@ -108,19 +108,18 @@ func combineRadix8[T Complex](
 // This is a fallback for unusual radix values.
 func combineGeneral[T Complex](
 	dst []T, // Output buffer (size N)
-	subs [][]T, // Radix sub-results (each of size N/radix)
-	twiddles [][]T, // Twiddle factors: twiddles[r][k] = W^(r*k)
-	radix int,
+	subs []T, // Radix sub-results (each of size N/radix) back to back
+	twiddles []T, // Twiddle factors: twiddles[r*N/radix+k] = W^(r*k)
+	t []T, // Temporaries, one per sub-result; len(t) is the radix
 ) {
-	subSize := len(subs[0])
+	radix := len(t)
+	subSize := len(subs) / radix
 
 	for k := range subSize {
 		// Apply twiddle factors
-		t := make([]T, radix)
-
-		t[0] = subs[0][k]
+		t[0] = subs[k]
 		for r := 1; r < radix; r++ {
-			t[r] = twiddles[r][k] * subs[r][k]
+			t[r] = twiddles[r*subSize+k] * subs[r*subSize+k]
 		}
 
 		// General DFT for this radix
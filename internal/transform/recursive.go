@@ -67,32 +67,15 @@ func recursiveForwardWithTwiddle[T Complex](
 	radix := strategy.SplitFactor
 	subSize := strategy.SubSize
 
-	// Allocate sub-result buffers from scratch space
-	// Layout: [sub0 | sub1 | ... | subN | remaining scratch]
-	subResults := make([][]T, radix)
-	for i := range radix {
-		subResults[i] = scratch[i*subSize : (i+1)*subSize]
-	}
-
-	remainingScratch := scratch[radix*subSize:]
-	subScratchSize := ScratchSizeRecursive(strategy.Recursive)
-	subScratch := remainingScratch[:subScratchSize]
-
-	// Allocate sub-input buffers (temporary, could optimize with in-place decimation)
-	subInputs := make([][]T, radix)
-	for i := range radix {
-		subInputs[i] = make([]T, subSize)
-	}
+	// Sub-results and sub-inputs are stored back to back in scratch:
+	// sub-FFT i occupies [i*subSize, (i+1)*subSize).
+	subResults, subInputs, temp, subScratch := splitScratchRecursive(scratch, strategy)
 
 	// Decimate input: extract strided sub-sequences
 	// For radix-2: even/odd indices
 	// For radix-4: indices mod 4
 	// General: indices mod radix
-	for i := range radix {
-		for j := range subSize {
-			subInputs[i][j] = src[i+j*radix]
-		}
-	}
+	decimate(subInputs, src, radix, subSize)
 
 	blockSize := radix * subSize
 	combineBlock := twiddle[twiddleOffset : twiddleOffset+blockSize]
@@ -101,8 +84,8 @@ func recursiveForwardWithTwiddle[T Complex](
 	// Recursively compute sub-FFTs
 	for i := range radix {
 		twiddleOffset = recursiveForwardWithTwiddle(
-			subResults[i],
-			subInputs[i],
+			subResults[i*subSize:(i+1)*subSize],
+			subInputs[i*subSize:(i+1)*subSize],
 			strategy.Recursive,
 			twiddle,
 			twiddleOffset,
@@ -116,23 +99,33 @@ func recursiveForwardWithTwiddle[T Complex](
 	switch radix {
 	case 2:
 		tw := combineBlock[subSize : 2*subSize]
-		combineRadix2(dst, subResults[0], subResults[1], tw)
+		combineRadix2(dst, subResults[:subSize], subResults[subSize:], tw)
 	case 4:
 		tw1 := combineBlock[subSize : 2*subSize]
 		tw2 := combineBlock[2*subSize : 3*subSize]
 		tw3 := combineBlock[3*subSize : 4*subSize]
-		combineRadix4(dst, subResults[0], subResults[1], subResults[2], subResults[3], tw1, tw2, tw3)
+		combineRadix4(dst, subResults[:subSize], subResults[subSize:2*subSize],
+			subResults[2*subSize:3*subSize], subResults[3*subSize:], tw1, tw2, tw3)
 	case 8:
-		twiddles := splitTwiddleBlock(combineBlock, radix, subSize)
-		combineRadix8(dst, subResults, twiddles)
+		combineRadix8(dst, subResults, combineBlock)
 	default:
-		twiddles := splitTwiddleBlock(combineBlock, radix, subSize)
-		combineGeneral(dst, subResults, twiddles, radix)
+		combineGeneral(dst, subResults, combineBlock, temp)
 	}
 
 	return twiddleOffset
 }
 
+// decimate stores the radix strided sub-sequences of src back to back in
+// dst: dst[i*subSize+j] = src[i+j*radix].
+func decimate[T Complex](dst, src []T, radix, subSize int) {
+	for i := range radix {
+		sub := dst[i*subSize : (i+1)*subSize]
+		for j := range sub {
+			sub[j] = src[i+j*radix]
+		}
+	}
+}
+
 // RecursiveInverse executes an inverse FFT using recursive decomposition.
 //
 // This is the main entry point for recursive inverse FFT transforms.
@@ -191,26 +184,10 @@ func recursiveInverseWithTwiddle[T Complex](
 	radix := strategy.SplitFactor
 	subSize := strategy.SubSize
 
-	subResults := make([][]T, radix)
-	for i := range radix {
-		subResults[i] = scratch[i*subSize : (i+1)*subSize]
-	}
-
-	remainingScratch := scratch[radix*subSize:]
-	subScratchSize := ScratchSizeRecursive(strategy.Recursive)
-	subScratch := remainingScratch[:subScratchSize]
-
-	subInputs := make([][]T, radix)
-	for i := range radix {
-		subInputs[i] = make([]T, subSize)
-	}
+	subResults, subInputs, temp, subScratch := splitScratchRecursive(scratch, strategy)
 
 	// Decimate input
-	for i := range radix {
-		for j := range subSize {
-			subInputs[i][j] = src[i+j*radix]
-		}
-	}
+	decimate(subInputs, src, radix, subSize)
 
 	blockSize := radix * subSize
 	combineBlock := twiddle[twiddleOffset : twiddleOffset+blockSize]
@@ -219,8 +196,8 @@ func recursiveInverseWithTwiddle[T Complex](
 	// Recursively compute sub-IFFTs
 	for i := range radix {
 		twiddleOffset = recursiveInverseWithTwiddle(
-			subResults[i],
-			subInputs[i],
+			subResults[i*subSize:(i+1)*subSize],
+			subInputs[i*subSize:(i+1)*subSize],
 			strategy.Recursive,
 			twiddle,
 			twiddleOffset,
@@ -234,18 +211,17 @@ func recursiveInverseWithTwiddle[T Complex](
 	switch radix {
 	case 2:
 		tw := combineBlock[subSize : 2*subSize]
-		combineRadix2Conj(dst, subResults[0], subResults[1], tw)
+		combineRadix2Conj(dst, subResults[:subSize], subResults[subSize:], tw)
 	case 4:
 		tw1 := combineBlock[subSize : 2*subSize]
 		tw2 := combineBlock[2*subSize : 3*subSize]
 		tw3 := combineBlock[3*subSize : 4*subSize]
-		combineRadix4Conj(dst, subResults[0], subResults[1], subResults[2], subResults[3], tw1, tw2, tw3)
+		combineRadix4Conj(dst, subResults[:subSize], subResults[subSize:2*subSize],
+			subResults[2*subSize:3*subSize], subResults[3*subSize:], tw1, tw2, tw3)
 	case 8:
-		twiddles := splitTwiddleBlock(combineBlock, radix, subSize)
-		combineRadix8Conj(dst, subResults, twiddles)
+		combineRadix8Conj(dst, subResults, combineBlock)
 	default:
-		twiddles := splitTwiddleBlock(combineBlock, radix, subSize)
-		combineGeneralConj(dst, subResults, twiddles, radix)
+		combineGeneralConj(dst, subResults, combineBlock, temp)
 	}
 
 	scaleComplexSlice(dst, 1.0/float64(radix))
@@ -378,16 +354,6 @@ func generateGeneralTwiddlesInverse[T Complex](n, radix int) [][]T {
 	return twiddles
 }
 
-func splitTwiddleBlock[T Complex](block []T, radix, subSize int) [][]T {
-	twiddles := make([][]T, radix)
-	for r := range radix {
-		start := r * subSize
-		twiddles[r] = block[start : start+subSize]
-	}
-
-	return twiddles
-}
-
 func combineRadix2Conj[T Complex](dst []T, sub0, sub1 []T, twiddle []T) {
 	half := len(sub0)
 	for k := range half {
@@ -423,15 +389,15 @@ func combineRadix4Conj[T Complex](
 	}
 }
 
-func combineRadix8Conj[T Complex](dst []T, subs [][]T, twiddles [][]T) {
-	eighth := len(subs[0])
+func combineRadix8Conj[T Complex](dst, subs, twiddles []T) {
+	eighth := len(subs) / 8
 
 	for k := range eighth {
-		t := make([]T, 8)
+		var t [8]T
 
-		t[0] = subs[0][k]
+		t[0] = subs[k]
 		for r := 1; r < 8; r++ {
-			t[r] = conj(twiddles[r][k]) * subs[r][k]
+			t[r] = conj(twiddles[r*eighth+k]) * subs[r*eighth+k]
 		}
 
 		for bin := range 8 {
@@ -448,15 +414,14 @@ func combineRadix8Conj[T Complex](dst []T, subs [][]T, twiddles [][]T) {
 	}
 }
 
-func combineGeneralConj[T Complex](dst []T, subs [][]T, twiddles [][]T, radix int) {
-	subSize := len(subs[0])
+func combineGeneralConj[T Complex](dst, subs, twiddles, t []T) {
+	radix := len(t)
+	subSize := len(subs) / radix
 
 	for k := range subSize {
-		t := make([]T, radix)
-
-		t[0] = subs[0][k]
+		t[0] = subs[k]
 		for r := 1; r < radix; r++ {
-			t[r] = conj(twiddles[r][k]) * subs[r][k]
+			t[r] = conj(twiddles[r*subSize+k]) * subs[r*subSize+k]
 		}
 
 		for bin := range radix {
@@ -474,17 +439,16 @@ func combineGeneralConj[T Complex](dst []T, subs [][]T, twiddles [][]T, radix in
 }
 
 func scaleComplexSlice[T Complex](dst []T, scale float64) {
-	var zero T
-	switch any(zero).(type) {
-	case complex64:
+	switch d := any(dst).(type) {
+	case []complex64:
 		s := complex(float32(scale), 0)
-		for i := range dst {
-			dst[i] = any(any(dst[i]).(complex64) * s).(T)
+		for i := range d {
+			d[i] *= s
 		}
-	case complex128:
+	case []complex128:
 		s := complex(scale, 0)
-		for i := range dst {
-			dst[i] = any(any(dst[i]).(complex128) * s).(T)
+		for i := range d {
+			d[i] *= s
 		}
 	default:
 		panic("unsupported complex type")
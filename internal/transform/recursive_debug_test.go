@@ -1,6 +1,7 @@
 package transform
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/MeKo-Christian/algo-fft/internal/cpu"
@@ -217,11 +218,9 @@ func TestRecursiveDebug_Size1024_Combine(t *testing.T) {
 		tw3 := combineBlock[3*subSize : 4*subSize]
 		combineRadix4(output, subResults[0], subResults[1], subResults[2], subResults[3], tw1, tw2, tw3)
 	case 8:
-		twiddles := splitTwiddleBlock(combineBlock, radix, subSize)
-		combineRadix8(output, subResults, twiddles)
+		combineRadix8(output, slices.Concat(subResults...), combineBlock)
 	default:
-		twiddles := splitTwiddleBlock(combineBlock, radix, subSize)
-		combineGeneral(output, subResults, twiddles, radix)
+		combineGeneral(output, slices.Concat(subResults...), combineBlock, make([]complex64, radix))
 	}
 
 	expected := reference.NaiveDFT(input)
@@ -1,8 +1,9 @@
 package transform
 
 // ScratchSizeRecursive returns the scratch size required for a recursive strategy.
-// The size accounts for holding all sub-results plus the maximum scratch needed
-// by any single sub-FFT (subcalls are executed sequentially).
+// The size accounts for holding all sub-results and decimated sub-inputs plus
+// the maximum scratch needed by any single sub-FFT (subcalls are executed
+// sequentially), so transforms need no other memory.
 func ScratchSizeRecursive(strategy *DecomposeStrategy) int {
 	if strategy == nil {
 		return 0
@@ -14,5 +15,21 @@ func ScratchSizeRecursive(strategy *DecomposeStrategy) int {
 
 	subScratch := ScratchSizeRecursive(strategy.Recursive)
 
-	return strategy.SplitFactor*strategy.SubSize + subScratch
+	// Sub-results, decimated inputs and the combine temporaries, then the
+	// sub-problems' scratch; see splitScratchRecursive.
+	return 2*strategy.SplitFactor*strategy.SubSize + strategy.SplitFactor + subScratch
+}
+
+// splitScratchRecursive carves the scratch of one recursion level into the
+// sub-results, the decimated sub-inputs, radix temporaries for the combine
+// step and the scratch of the sub-problems.
+func splitScratchRecursive[T Complex](scratch []T, strategy *DecomposeStrategy) (results, inputs, temp, sub []T) {
+	block := strategy.SplitFactor * strategy.SubSize
+
+	results = scratch[:block]
+	inputs = scratch[block : 2*block]
+	temp = scratch[2*block : 2*block+strategy.SplitFactor]
+	sub = scratch[2*block+strategy.SplitFactor:][:ScratchSizeRecursive(strategy.Recursive)]
+
+	return results, inputs, temp, sub
 }
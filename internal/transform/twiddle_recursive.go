@@ -1,17 +1,27 @@
 package transform
 
-import "math"
+import (
+	"math"
+
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
 
 // twiddle_recursive.go implements twiddle factor precomputation for recursive decomposition.
 
 // TwiddleFactorsRecursive generates all twiddle factors needed for a decomposition strategy.
 // This precomputes twiddles at plan creation time to ensure zero allocations during transforms.
 func TwiddleFactorsRecursive[T Complex](strategy *DecomposeStrategy) []T {
+	return TwiddleFactorsRecursiveMode[T](strategy, m.TwiddleDirect)
+}
+
+// TwiddleFactorsRecursiveMode is TwiddleFactorsRecursive with the twiddles
+// generated in the given mode.
+func TwiddleFactorsRecursiveMode[T Complex](strategy *DecomposeStrategy, mode m.TwiddleMode) []T {
 	n := strategy.Size
 
 	// Base case: codelet handles its own twiddles
 	if strategy.UseCodelet {
-		return ComputeTwiddleFactors[T](n)
+		return m.TwiddleFactors[T](n, mode)
 	}
 
 	// Calculate total twiddle size needed
@@ -19,7 +29,7 @@ func TwiddleFactorsRecursive[T Complex](strategy *DecomposeStrategy) []T {
 	twiddles := make([]T, totalSize)
 
 	// Fill twiddles recursively
-	fillTwiddles(twiddles, strategy, 0)
+	fillTwiddles(twiddles, strategy, 0, mode)
 
 	return twiddles
 }
@@ -40,12 +50,12 @@ func estimateTwiddleSize(strategy *DecomposeStrategy) int {
 }
 
 // fillTwiddles recursively fills the twiddle buffer.
-func fillTwiddles[T Complex](buffer []T, strategy *DecomposeStrategy, offset int) int {
+func fillTwiddles[T Complex](buffer []T, strategy *DecomposeStrategy, offset int, mode m.TwiddleMode) int {
 	n := strategy.Size
 
 	// Base case: generate DIT-compatible twiddles
 	if strategy.UseCodelet || strategy.Recursive == nil {
-		twiddles := ComputeTwiddleFactors[T](n)
+		twiddles := m.TwiddleFactors[T](n, mode)
 		copy(buffer[offset:], twiddles)
 
 		return offset + len(twiddles)
@@ -58,15 +68,20 @@ func fillTwiddles[T Complex](buffer []T, strategy *DecomposeStrategy, offset int
 	// W_N^(r*k) for r = 0..radix-1, k = 0..subSize-1
 	for r := range radix {
 		for k := range subSize {
-			angle := -2.0 * math.Pi * float64(r*k) / float64(n)
-			buffer[offset] = makeComplexFromAngle[T](angle)
+			if mode == m.TwiddleOctant {
+				buffer[offset] = m.ComplexFromFloat64[T](m.RootOfUnity(r*k, n))
+			} else {
+				angle := -2.0 * math.Pi * float64(r*k) / float64(n)
+				buffer[offset] = makeComplexFromAngle[T](angle)
+			}
+
 			offset++
 		}
 	}
 
 	// Recursively fill sub-twiddles
 	for range strategy.NumSubs {
-		offset = fillTwiddles(buffer, strategy.Recursive, offset)
+		offset = fillTwiddles(buffer, strategy.Recursive, offset, mode)
 	}
 
 	return offset
@@ -0,0 +1,86 @@
+package algofft
+
+import (
+	"math"
+
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// normalizationScales returns the factors a plan of n elements applies on
+// top of its kernels for the given normalization. Kernels compute an
+// unscaled forward transform and an inverse already scaled by 1/n, so the
+// inverse factor is relative to that. Plans do not multiply the 1/n
+// kernel output by it: they run the forward kernel and apply factor/n in
+// copyReversedScaled instead, so the result is rounded once.
+func normalizationScales(norm Normalization, n int) (forward, inverse float64) {
+	size := float64(n)
+
+	switch norm {
+	case NormOrtho:
+		return 1 / math.Sqrt(size), math.Sqrt(size)
+	case NormForward:
+		return 1 / size, size
+	case NormNone:
+		return 1, size
+	default:
+		return 1, 1
+	}
+}
+
+// copyScaled copies src into dst, multiplying by scale on the way.
+func copyScaled[T Complex](dst, src []T, scale float64) {
+	if scale == 1 {
+		copy(dst, src)
+		return
+	}
+
+	var zero T
+
+	switch any(zero).(type) {
+	case complex64:
+		dst64 := any(dst).([]complex64)
+		src64 := any(src).([]complex64)
+
+		factor := complex(float32(scale), 0)
+		for i := range src64 {
+			dst64[i] = src64[i] * factor
+		}
+	case complex128:
+		dst128 := any(dst).([]complex128)
+		src128 := any(src).([]complex128)
+
+		factor := complex(scale, 0)
+		for i := range src128 {
+			dst128[i] = src128[i] * factor
+		}
+	}
+}
+
+// copyReversedScaled turns the unscaled forward transform in src, a
+// row-major array with the given dims, into the unscaled inverse times
+// scale: dst[k] = scale*src[-k], every index negated modulo its dimension.
+// The reversal is exact, so scale is the only rounding. dst and src must
+// not overlap.
+func copyReversedScaled[T Complex](dst, src []T, dims []int, scale float64) {
+	copyReversed(dst, src, dims, m.ComplexFromFloat64[T](scale, 0))
+}
+
+func copyReversed[T Complex](dst, src []T, dims []int, factor T) {
+	n := dims[0]
+	size := len(src) / n
+
+	if len(dims) == 1 {
+		dst[0] = src[0] * factor
+		for i := 1; i < n; i++ {
+			dst[i] = src[n-i] * factor
+		}
+
+		return
+	}
+
+	copyReversed(dst[:size], src[:size], dims[1:], factor)
+
+	for i := 1; i < n; i++ {
+		copyReversed(dst[i*size:(i+1)*size], src[(n-i)*size:(n-i+1)*size], dims[1:], factor)
+	}
+}
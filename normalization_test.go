@@ -0,0 +1,553 @@
+package algofft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+)
+
+// normRun executes a plan configured with the given normalization and
+// returns its forward output and, where the plan has one, the inverse of
+// that output. Both are widened to complex128 for comparison.
+type normRun func(t *testing.T, norm Normalization) (spectrum, roundTrip []complex128)
+
+var allNormalizations = []Normalization{NormBackward, NormOrtho, NormForward, NormNone}
+
+func normalizationSignal(n int, seed uint64) []complex128 {
+	rng := rand.New(rand.NewPCG(seed, seed^0x5DEECE66D)) //nolint:gosec
+
+	data := make([]complex128, n)
+	for i := range data {
+		data[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+
+	return data
+}
+
+func widenSlice[T Complex](data []T) []complex128 {
+	out := make([]complex128, len(data))
+	for i, v := range data {
+		out[i] = complexToC128(v)
+	}
+
+	return out
+}
+
+func narrowSlice[T Complex](data []complex128) []T {
+	out := make([]T, len(data))
+	for i, v := range data {
+		out[i] = complexFromC128[T](v)
+	}
+
+	return out
+}
+
+func complexFromC128[T Complex](v complex128) T {
+	var zero T
+
+	switch any(zero).(type) {
+	case complex64:
+		return any(complex64(v)).(T)
+	default:
+		return any(v).(T)
+	}
+}
+
+func realParts(data []complex128) []float64 {
+	out := make([]float64, len(data))
+	for i, v := range data {
+		out[i] = real(v)
+	}
+
+	return out
+}
+
+func widenReal[F Float](data []F) []complex128 {
+	out := make([]complex128, len(data))
+	for i, v := range data {
+		out[i] = complex(float64(v), 0)
+	}
+
+	return out
+}
+
+func narrowReal[F Float](data []float64) []F {
+	out := make([]F, len(data))
+	for i, v := range data {
+		out[i] = F(v)
+	}
+
+	return out
+}
+
+func normComplexRun[T Complex](input []complex128, build func(PlanOptions) (normTransformer[T], error)) normRun {
+	return func(t *testing.T, norm Normalization) ([]complex128, []complex128) {
+		t.Helper()
+
+		plan, err := build(PlanOptions{Normalization: norm})
+		if err != nil {
+			t.Fatalf("build: %v", err)
+		}
+
+		src := narrowSlice[T](input)
+		spec := make([]T, len(src))
+		back := make([]T, len(src))
+
+		err = plan.Forward(spec, src)
+		if err != nil {
+			t.Fatalf("Forward: %v", err)
+		}
+
+		err = plan.Inverse(back, spec)
+		if err != nil {
+			t.Fatalf("Inverse: %v", err)
+		}
+
+		return widenSlice(spec), widenSlice(back)
+	}
+}
+
+// normTransformer is satisfied by every complex plan type exercised here.
+type normTransformer[T Complex] interface {
+	Forward(dst, src []T) error
+	Inverse(dst, src []T) error
+}
+
+func TestNormalization_AllPlans(t *testing.T) {
+	t.Parallel()
+
+	type normCase struct {
+		name string
+		n    int // logical transform size
+		in   []complex128
+		run  normRun
+	}
+
+	sig := func(n int) []complex128 { return normalizationSignal(n, uint64(n)) }
+	realSig := func(n int) []complex128 {
+		data := sig(n)
+		for i := range data {
+			data[i] = complex(real(data[i]), 0)
+		}
+
+		return data
+	}
+
+	var cases []normCase
+
+	for _, n := range []int{16, 60, 17} {
+		in := sig(n)
+		cases = append(cases,
+			normCase{"Plan64/" + strconv.Itoa(n), n, in, normComplexRun(in, func(o PlanOptions) (normTransformer[complex64], error) {
+				return NewPlanWithOptions[complex64](n, o)
+			})},
+			normCase{"Plan128/" + strconv.Itoa(n), n, in, normComplexRun(in, func(o PlanOptions) (normTransformer[complex128], error) {
+				return NewPlanWithOptions[complex128](n, o)
+			})},
+		)
+	}
+
+	in2D := sig(8 * 6)
+	cases = append(cases, normCase{"Plan2D", 8 * 6, in2D, normComplexRun(in2D, func(o PlanOptions) (normTransformer[complex128], error) {
+		return NewPlan2DWithOptions[complex128](8, 6, o)
+	})})
+
+	in3D := sig(4 * 4 * 5)
+	cases = append(cases, normCase{"Plan3D", 4 * 4 * 5, in3D, normComplexRun(in3D, func(o PlanOptions) (normTransformer[complex64], error) {
+		return NewPlan3DWithOptions[complex64](4, 4, 5, o)
+	})})
+
+	inND := sig(2 * 3 * 4 * 2)
+	cases = append(cases, normCase{"PlanND", 2 * 3 * 4 * 2, inND, normComplexRun(inND, func(o PlanOptions) (normTransformer[complex128], error) {
+		return NewPlanNDWithOptions[complex128]([]int{2, 3, 4, 2}, o)
+	})})
+
+	inReal := realSig(32)
+	cases = append(cases, normCase{"PlanRealT64", 32, inReal, func(t *testing.T, norm Normalization) ([]complex128, []complex128) {
+		t.Helper()
+
+		plan, err := NewPlanReal64WithOptions(32, PlanOptions{Normalization: norm})
+		if err != nil {
+			t.Fatalf("build: %v", err)
+		}
+
+		spec := make([]complex128, plan.SpectrumLen())
+		back := make([]float64, 32)
+
+		if err := plan.Forward(spec, realParts(inReal)); err != nil {
+			t.Fatalf("Forward: %v", err)
+		}
+
+		if err := plan.Inverse(back, spec); err != nil {
+			t.Fatalf("Inverse: %v", err)
+		}
+
+		return spec, widenReal(back)
+	}})
+
+	cases = append(cases, normCase{"PlanReal", 32, inReal, func(t *testing.T, norm Normalization) ([]complex128, []complex128) {
+		t.Helper()
+
+		plan, err := NewPlanRealWithOptions(32, PlanOptions{Normalization: norm})
+		if err != nil {
+			t.Fatalf("build: %v", err)
+		}
+
+		spec := make([]complex64, plan.SpectrumLen())
+		back := make([]float32, 32)
+
+		if err := plan.Forward(spec, narrowReal[float32](realParts(inReal))); err != nil {
+			t.Fatalf("Forward: %v", err)
+		}
+
+		if err := plan.Inverse(back, spec); err != nil {
+			t.Fatalf("Inverse: %v", err)
+		}
+
+		return widenSlice(spec), widenReal(back)
+	}})
+
+	inReal2D := realSig(6 * 8)
+	cases = append(cases, normCase{"PlanReal2D", 6 * 8, inReal2D, func(t *testing.T, norm Normalization) ([]complex128, []complex128) {
+		t.Helper()
+
+		plan, err := NewPlanReal2DWithOptions(6, 8, PlanOptions{Normalization: norm})
+		if err != nil {
+			t.Fatalf("build: %v", err)
+		}
+
+		spec := make([]complex64, plan.SpectrumLen())
+		back := make([]float32, plan.Len())
+
+		if err := plan.Forward(spec, narrowReal[float32](realParts(inReal2D))); err != nil {
+			t.Fatalf("Forward: %v", err)
+		}
+
+		if err := plan.Inverse(back, spec); err != nil {
+			t.Fatalf("Inverse: %v", err)
+		}
+
+		return widenSlice(spec), widenReal(back)
+	}})
+
+	inReal3D := realSig(3 * 4 * 6)
+	cases = append(cases, normCase{"PlanReal3D", 3 * 4 * 6, inReal3D, func(t *testing.T, norm Normalization) ([]complex128, []complex128) {
+		t.Helper()
+
+		plan, err := NewPlanReal3DWithOptions(3, 4, 6, PlanOptions{Normalization: norm})
+		if err != nil {
+			t.Fatalf("build: %v", err)
+		}
+
+		spec := make([]complex64, plan.SpectrumLen())
+		back := make([]float32, plan.Len())
+
+		if err := plan.Forward(spec, narrowReal[float32](realParts(inReal3D))); err != nil {
+			t.Fatalf("Forward: %v", err)
+		}
+
+		if err := plan.Inverse(back, spec); err != nil {
+			t.Fatalf("Inverse: %v", err)
+		}
+
+		return widenSlice(spec), widenReal(back)
+	}})
+
+	inStrided := sig(16)
+	cases = append(cases, normCase{"Strided", 16, inStrided, func(t *testing.T, norm Normalization) ([]complex128, []complex128) {
+		t.Helper()
+
+		const stride = 3
+
+		plan, err := NewPlanWithOptions[complex128](16, PlanOptions{Normalization: norm})
+		if err != nil {
+			t.Fatalf("build: %v", err)
+		}
+
+		src := make([]complex128, 16*stride)
+		for i, v := range inStrided {
+			src[i*stride] = v
+		}
+
+		spec := make([]complex128, len(src))
+		back := make([]complex128, len(src))
+
+		if err := plan.ForwardStrided(spec, src, stride); err != nil {
+			t.Fatalf("ForwardStrided: %v", err)
+		}
+
+		if err := plan.InverseStrided(back, spec, stride); err != nil {
+			t.Fatalf("InverseStrided: %v", err)
+		}
+
+		gather := func(data []complex128) []complex128 {
+			out := make([]complex128, 16)
+			for i := range out {
+				out[i] = data[i*stride]
+			}
+
+			return out
+		}
+
+		return gather(spec), gather(back)
+	}})
+
+	inPruned := sig(10)
+	cases = append(cases, normCase{"Pruned", 64, inPruned, func(t *testing.T, norm Normalization) ([]complex128, []complex128) {
+		t.Helper()
+
+		plan, err := NewPlanPrunedWithOptions[complex128](64, 10, BinRange{Start: 5, Count: 12}, PlanOptions{Normalization: norm})
+		if err != nil {
+			t.Fatalf("build: %v", err)
+		}
+
+		spec := make([]complex128, 12)
+		if err := plan.Forward(spec, inPruned); err != nil {
+			t.Fatalf("Forward: %v", err)
+		}
+
+		return spec, nil
+	}})
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reference, _ := tc.run(t, NormBackward)
+			size := float64(tc.n)
+
+			for _, norm := range allNormalizations {
+				forwardFactor, roundTripFactor := 1.0, 1.0
+
+				switch norm {
+				case NormOrtho:
+					forwardFactor = 1 / math.Sqrt(size)
+				case NormForward:
+					forwardFactor = 1 / size
+				case NormNone:
+					roundTripFactor = size
+				}
+
+				spec, back := tc.run(t, norm)
+
+				tol := 1e-4 * size
+				for k := range spec {
+					want := reference[k] * complex(forwardFactor, 0)
+					if cmplx.Abs(spec[k]-want) > tol*forwardFactor {
+						t.Fatalf("%s: spectrum[%d] = %v, want %v", norm, k, spec[k], want)
+					}
+				}
+
+				for i := range back {
+					want := tc.in[i] * complex(roundTripFactor, 0)
+					if cmplx.Abs(back[i]-want) > 1e-4*roundTripFactor {
+						t.Fatalf("%s: roundtrip[%d] = %v, want %v", norm, i, back[i], want)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestNormalization_InverseIsReversedForward checks that an inverse whose
+// normalization cancels the kernels' 1/n is the forward transform with
+// reversed bins, bit for bit, rather than the 1/n kernel scaled back up.
+func TestNormalization_InverseIsReversedForward(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		n        int
+		strategy KernelStrategy
+		stride   int
+	}{
+		{"pow2", 64, KernelAuto, 1},
+		{"mixed", 36, KernelAuto, 1},
+		{"pfa", 15, KernelPFA, 1},
+		{"strided DIT", 32, KernelAuto, 3},
+		{"strided gather", 12, KernelAuto, 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			forward, err := NewPlanWithOptions[complex128](tc.n, PlanOptions{Strategy: tc.strategy})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			inverse, err := NewPlanWithOptions[complex128](tc.n, PlanOptions{Strategy: tc.strategy, Normalization: NormNone})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := normalizationSignal(tc.n*tc.stride, uint64(tc.n))
+			want := make([]complex128, len(src))
+			got := make([]complex128, len(src))
+
+			if err := forward.ForwardStrided(want, src, tc.stride); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := inverse.InverseStrided(got, src, tc.stride); err != nil {
+				t.Fatal(err)
+			}
+
+			for k := range tc.n {
+				w := want[(tc.n-k)%tc.n*tc.stride]
+				if g := got[k*tc.stride]; g != w {
+					t.Fatalf("bin %d = %v, want %v", k, g, w)
+				}
+			}
+		})
+	}
+}
+
+// TestNormalization_MultiDimRoundsOnce checks that 2D, 3D and ND plans run
+// unscaled sub-transforms and apply the normalization once: a NormForward
+// forward is the unscaled forward times 1/N and a NormNone inverse is the
+// unscaled forward at negated indices, bit for bit.
+func TestNormalization_MultiDimRoundsOnce(t *testing.T) {
+	t.Parallel()
+
+	type transforms struct {
+		forward, inverse func(dst, src []complex128) error
+	}
+
+	newND := func(dims []int, norm Normalization) (transforms, error) {
+		switch len(dims) {
+		case 2:
+			plan, err := NewPlan2DWithOptions[complex128](dims[0], dims[1], PlanOptions{Normalization: norm})
+			if err != nil {
+				return transforms{}, err
+			}
+
+			return transforms{plan.Forward, plan.Inverse}, nil
+		case 3:
+			plan, err := NewPlan3DWithOptions[complex128](dims[0], dims[1], dims[2], PlanOptions{Normalization: norm})
+			if err != nil {
+				return transforms{}, err
+			}
+
+			return transforms{plan.Forward, plan.Inverse}, nil
+		default:
+			plan, err := NewPlanNDWithOptions[complex128](dims, PlanOptions{Normalization: norm})
+			if err != nil {
+				return transforms{}, err
+			}
+
+			return transforms{plan.Forward, plan.Inverse}, nil
+		}
+	}
+
+	for _, dims := range [][]int{{4, 4}, {3, 5}, {2, 3, 4}, {2, 3, 2, 5}} {
+		t.Run(fmt.Sprint(dims), func(t *testing.T) {
+			t.Parallel()
+
+			size := 1
+			for _, d := range dims {
+				size *= d
+			}
+
+			backward, err := newND(dims, NormBackward)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			scaled, err := newND(dims, NormForward)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			none, err := newND(dims, NormNone)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := normalizationSignal(size, uint64(size))
+			unscaled := make([]complex128, size)
+			got := make([]complex128, size)
+
+			if err := backward.forward(unscaled, src); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := scaled.forward(got, src); err != nil {
+				t.Fatal(err)
+			}
+
+			for k := range size {
+				if want := unscaled[k] * complex(1/float64(size), 0); got[k] != want {
+					t.Fatalf("NormForward bin %d = %v, want %v", k, got[k], want)
+				}
+			}
+
+			if err := none.inverse(got, src); err != nil {
+				t.Fatal(err)
+			}
+
+			for k := range size {
+				// Negate every index of the row-major coordinates of k.
+				neg, rem, stride := 0, k, size
+				for _, d := range dims {
+					stride /= d
+					neg += (d - rem/stride) % d * stride
+					rem %= stride
+				}
+
+				if got[k] != unscaled[neg] {
+					t.Fatalf("NormNone inverse element %d = %v, want %v", k, got[k], unscaled[neg])
+				}
+			}
+		})
+	}
+}
+
+func TestNormalization_ResamplerIgnoresPlanNormalization(t *testing.T) {
+	t.Parallel()
+
+	src := narrowSlice[complex128](normalizationSignal(24, 7))
+	want := make([]complex128, 36)
+	got := make([]complex128, 36)
+
+	base, err := NewResampler[complex128](24, 36, ResampleOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ortho, err := NewResampler[complex128](24, 36, ResampleOptions{Plan: PlanOptions{Normalization: NormOrtho}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := base.Resample(want, src); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ortho.Resample(got, src); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range want {
+		if cmplx.Abs(got[i]-want[i]) > 1e-12 {
+			t.Fatalf("sample %d: got %v want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestNormalization_String(t *testing.T) {
+	t.Parallel()
+
+	for norm, want := range map[Normalization]string{
+		NormBackward: "backward",
+		NormOrtho:    "ortho",
+		NormForward:  "forward",
+		NormNone:     "none",
+	} {
+		if got := norm.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", norm, got, want)
+		}
+	}
+}
@@ -40,16 +40,32 @@ type Plan[T Complex] struct {
 	packedTwiddle16   *fft.PackedTwiddles[T]
 
 	// Bluestein specific fields (used only if kernelStrategy == KernelBluestein)
-	bluesteinM              int   // Padded size M >= 2N-1
-	bluesteinChirp          []T   // Size N
-	bluesteinChirpInv       []T   // Size N
-	bluesteinFilter         []T   // Size M
-	bluesteinFilterInv      []T   // Size M
-	bluesteinTwiddle        []T   // Size M
-	bluesteinBitrev         []int // Size M
-	bluesteinScratch        []T   // Size M (extra scratch for Bluestein)
+	bluesteinM              int      // Padded size M >= 2N-1
+	bluesteinPlan           *Plan[T] // Size M plan running the convolution (nil for deterministic plans)
+	bluesteinChirp          []T      // Size N
+	bluesteinChirpInv       []T      // Size N
+	bluesteinFilter         []T      // Size M
+	bluesteinFilterInv      []T      // Size M
+	bluesteinTwiddle        []T      // Size M (deterministic plans only)
+	bluesteinRadices        []int    // Deterministic schedule of M (deterministic plans only)
+	bluesteinScratch        []T      // Convolution scratch for Bluestein
 	bluesteinScratchBacking []byte
 
+	// PFA specific fields (used only if kernelStrategy == KernelPFA)
+	pfaPlan1   *Plan[T]  // Size N1 plan, the largest prime power dividing N
+	pfaPlan2   *Plan[T]  // Size N2 = N/N1 plan, coprime to N1
+	pfaModule1 func([]T) // Radix butterfly for size N1, or nil to use pfaPlan1
+	pfaModule2 func([]T) // Radix butterfly for size N2, or nil to use pfaPlan2
+	pfaInput   []int     // Ruritanian map from the N2 x N1 rows to input indices
+	pfaOutput  []int     // CRT map from the N1 x N2 rows to output indices
+
+	// Rader specific fields (used only if kernelStrategy == KernelRader)
+	raderPlan      *Plan[T] // Size N-1 plan running the cyclic convolution
+	raderGather    []int    // Size N-1, input order g^k mod N
+	raderScatter   []int    // Size N-1, output order g^-k mod N
+	raderFilter    []T      // Size N-1
+	raderFilterInv []T      // Size N-1
+
 	// Zero-dispatch codelet bindings (nil = use fallback kernel)
 	forwardCodelet fft.CodeletFunc[T]
 	inverseCodelet fft.CodeletFunc[T]
@@ -62,6 +78,11 @@ type Plan[T Complex] struct {
 	kernelStrategy fft.KernelStrategy
 	meta           PlanMeta
 
+	// forwardScale and inverseScale implement PlanOptions.Normalization on
+	// top of the kernels' unscaled forward and 1/n-scaled inverse.
+	forwardScale float64
+	inverseScale float64
+
 	// Recursive decomposition strategy (nil if using existing kernel path)
 	decompStrategy *fft.DecomposeStrategy
 
@@ -71,7 +92,7 @@ type Plan[T Complex] struct {
 	stridedScratchBacking []byte
 
 	// pool is the buffer pool this Plan was allocated from (nil if not pooled).
-	pool *fft.BufferPool
+	pool *BufferPool
 
 	// scratchPool manages per-call scratch buffers for thread-safety.
 	// Used only when scratch field is nil.
@@ -91,13 +112,16 @@ type scratchSet[T any] struct {
 type KernelStrategy = fft.KernelStrategy
 
 const (
-	KernelAuto      = fft.KernelAuto
-	KernelDIT       = fft.KernelDIT
-	KernelStockham  = fft.KernelStockham
-	KernelSixStep   = fft.KernelSixStep
-	KernelEightStep = fft.KernelEightStep
-	KernelBluestein = fft.KernelBluestein
-	KernelRecursive = fft.KernelRecursive // Recursive decomposition with codelet leaves
+	KernelAuto       = fft.KernelAuto
+	KernelDIT        = fft.KernelDIT
+	KernelStockham   = fft.KernelStockham
+	KernelSixStep    = fft.KernelSixStep
+	KernelEightStep  = fft.KernelEightStep
+	KernelBluestein  = fft.KernelBluestein
+	KernelRecursive  = fft.KernelRecursive  // Recursive decomposition with codelet leaves
+	KernelRader      = fft.KernelRader      // Rader's algorithm for prime sizes
+	KernelPFA        = fft.KernelPFA        // Good-Thomas prime-factor algorithm for coprime factors
+	KernelSplitRadix = fft.KernelSplitRadix // Split-radix algorithm for power-of-two sizes
 )
 
 // SetKernelStrategy overrides the global kernel selection strategy.
@@ -177,6 +201,12 @@ func (p *Plan[T]) String() string {
 		strategyName = "EightStep"
 	case fft.KernelBluestein:
 		strategyName = "Bluestein"
+	case fft.KernelRader:
+		strategyName = "Rader"
+	case fft.KernelPFA:
+		strategyName = "PFA"
+	case fft.KernelSplitRadix:
+		strategyName = "SplitRadix"
 	}
 
 	pooled := ""
@@ -220,20 +250,88 @@ func planBitReversal[T Complex](n int, estimate fft.PlanEstimate[T]) []int {
 	return nil
 }
 
+// getScratch returns the plan's own scratch: the fixed buffers of a clone or
+// a set from scratchPool, which the caller must put back. WorkspaceExternal
+// plans have neither and get nil buffers.
 func (p *Plan[T]) getScratch() ([]T, []T, []T, *scratchSet[T]) {
-	if p.scratch != nil {
+	if p.scratchPool == nil {
 		return p.scratch, p.stridedScratch, p.bluesteinScratch, nil
 	}
 	s := p.scratchPool.Get().(*scratchSet[T])
 	return s.scratch, s.stridedScratch, s.bluesteinScratch, s
 }
 
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *Plan[T]) ScratchLen() int {
+	switch p.kernelStrategy {
+	case fft.KernelBluestein:
+		return p.bluesteinM + p.bluesteinConvolutionScratchLen()
+	case fft.KernelRader:
+		return p.n + p.raderPlan.ScratchLen()
+	case fft.KernelPFA:
+		return p.n + p.pfaScratchLen()
+	}
+
+	return p.kernelScratchLen() + p.outScratchLen()
+}
+
+// outScratchLen returns the length of the buffer the kernel writes to when
+// the plan's normalization is applied in the copy out to dst: n for
+// normalizations other than NormBackward, else 0.
+func (p *Plan[T]) outScratchLen() int {
+	if p.forwardScale == 1 && p.inverseScale == 1 {
+		return 0
+	}
+
+	return p.n
+}
+
+// kernelScratchLen returns the scratch length of the bound kernel.
+func (p *Plan[T]) kernelScratchLen() int {
+	switch p.kernelStrategy {
+	case fft.KernelBluestein:
+		return p.bluesteinM
+	case fft.KernelRecursive:
+		return fft.ScratchSizeRecursive(p.decompStrategy)
+	default:
+		return p.n
+	}
+}
+
+// lineScratchLen returns the scratch length transformLine needs for a
+// strided line: a gather buffer followed by the kernel scratch.
+func (p *Plan[T]) lineScratchLen() int {
+	return p.n + p.ScratchLen()
+}
+
+// splitScratch carves caller-supplied scratch into the kernel scratch and,
+// for Bluestein and Rader, the convolution scratch, for PFA, the line
+// buffer and factor plans' scratch, and otherwise the output buffer of
+// outScratchLen.
+func (p *Plan[T]) splitScratch(scratch []T) ([]T, []T) {
+	switch p.kernelStrategy {
+	case fft.KernelBluestein:
+		size := p.bluesteinM
+		return scratch[:size:size], scratch[size:p.ScratchLen():p.ScratchLen()]
+	case fft.KernelRader, fft.KernelPFA:
+		return scratch[:p.n:p.n], scratch[p.n:p.ScratchLen():p.ScratchLen()]
+	}
+
+	size := p.kernelScratchLen()
+	end := size + p.outScratchLen()
+
+	return scratch[:size:size], scratch[size:end:end]
+}
+
 // Forward computes the forward (time-to-frequency) FFT.
 //
 // The transform is computed as:
 //
 //	X[k] = Σ x[n] * exp(-2πink/N) for k = 0..N-1
 //
+// and then scaled according to PlanOptions.Normalization (unscaled by default).
+//
 // dst and src must have length equal to Plan.Len().
 // dst and src may point to the same slice for in-place operation.
 //
@@ -245,15 +343,87 @@ func (p *Plan[T]) Forward(dst, src []T) error {
 		return err
 	}
 
-	scratch, _, bsScratch, set := p.getScratch()
+	return p.forwardScaled(dst, src, p.forwardScale)
+}
+
+// forwardScaled runs the forward kernel and multiplies the result by scale.
+// Bluestein folds scale into its final chirp pass and Rader and PFA into
+// their output scatter; other kernels write to an output buffer and scale
+// while copying it out to dst.
+func (p *Plan[T]) forwardScaled(dst, src []T, scale float64) error {
+	scratch, out, bsScratch, set := p.getScratch()
 	if set != nil {
 		defer p.scratchPool.Put(set)
 	}
 
-	if p.kernelStrategy == fft.KernelBluestein {
-		return p.bluesteinForward(dst, src, scratch, bsScratch)
+	if scratch == nil {
+		return ErrScratchRequired
+	}
+
+	return p.forwardBuffers(dst, src, scratch, p.auxScratch(out, bsScratch), scale)
+}
+
+// forwardScaledWith is forwardScaled with caller-supplied scratch of at
+// least ScratchLen elements; nil scratch falls back to the plan's own.
+func (p *Plan[T]) forwardScaledWith(dst, src, scratch []T, scale float64) error {
+	if scratch == nil {
+		return p.forwardScaled(dst, src, scale)
+	}
+
+	kernelScratch, bsScratch := p.splitScratch(scratch)
+
+	return p.forwardBuffers(dst, src, kernelScratch, bsScratch, scale)
+}
+
+// forwardWith is Forward without validation, using scratch like
+// forwardScaledWith. Multi-dimensional and real plans run their 1D
+// sub-plans through it.
+func (p *Plan[T]) forwardWith(dst, src, scratch []T) error {
+	return p.forwardScaledWith(dst, src, scratch, p.forwardScale)
+}
+
+// auxScratch picks the second buffer forwardBuffers and inverseBuffers
+// take from the plan's own scratch: the convolution or factor scratch for
+// Bluestein, Rader and PFA, else the n-element output buffer.
+func (p *Plan[T]) auxScratch(out, bsScratch []T) []T {
+	switch p.kernelStrategy {
+	case fft.KernelBluestein, fft.KernelRader, fft.KernelPFA:
+		return bsScratch
+	}
+
+	return out
+}
+
+// forwardBuffers dispatches the forward kernel on the given scratch. For
+// kernels other than Bluestein, Rader and PFA, aux is the output buffer a
+// scale other than 1 is applied from; see splitScratch.
+func (p *Plan[T]) forwardBuffers(dst, src, scratch, aux []T, scale float64) error {
+	bsScratch := aux
+
+	switch p.kernelStrategy {
+	case fft.KernelBluestein:
+		return p.bluesteinTransform(dst, src, scratch, bsScratch, p.bluesteinChirp, p.bluesteinFilter, scale)
+	case fft.KernelRader:
+		return p.raderTransform(dst, src, scratch, bsScratch, p.raderFilter, scale)
+	case fft.KernelPFA:
+		return p.pfaTransform(dst, src, scratch, bsScratch, false, scale)
+	}
+
+	if scale == 1 {
+		return p.forwardUnscaled(dst, src, scratch)
+	}
+
+	out := aux[:p.n]
+
+	err := p.forwardUnscaled(out, src, scratch)
+	if err == nil {
+		copyScaled(dst, out, scale)
 	}
 
+	return err
+}
+
+func (p *Plan[T]) forwardUnscaled(dst, src, scratch []T) error {
 	if p.kernelStrategy == fft.KernelRecursive {
 		return p.recursiveForward(dst, src, scratch)
 	}
@@ -284,6 +454,8 @@ func (p *Plan[T]) Forward(dst, src []T) error {
 //
 //	x[n] = (1/N) * Σ X[k] * exp(2πink/N) for n = 0..N-1
 //
+// with the 1/N factor replaced according to PlanOptions.Normalization.
+//
 // dst and src must have length equal to Plan.Len().
 // dst and src may point to the same slice for in-place operation.
 //
@@ -295,15 +467,73 @@ func (p *Plan[T]) Inverse(dst, src []T) error {
 		return err
 	}
 
-	scratch, _, bsScratch, set := p.getScratch()
+	return p.inverseScaled(dst, src, p.inverseScale)
+}
+
+// inverseScaled runs the 1/n-scaled inverse kernel and multiplies the result
+// by scale, like forwardScaled. For scale != 1 it runs the forward kernel
+// and reverses the bins while copying out, see copyReversedScaled.
+func (p *Plan[T]) inverseScaled(dst, src []T, scale float64) error {
+	scratch, out, bsScratch, set := p.getScratch()
 	if set != nil {
 		defer p.scratchPool.Put(set)
 	}
 
-	if p.kernelStrategy == fft.KernelBluestein {
-		return p.bluesteinInverse(dst, src, scratch, bsScratch)
+	if scratch == nil {
+		return ErrScratchRequired
+	}
+
+	return p.inverseBuffers(dst, src, scratch, p.auxScratch(out, bsScratch), scale)
+}
+
+// inverseScaledWith is inverseScaled with optional caller scratch, like
+// forwardScaledWith.
+func (p *Plan[T]) inverseScaledWith(dst, src, scratch []T, scale float64) error {
+	if scratch == nil {
+		return p.inverseScaled(dst, src, scale)
+	}
+
+	kernelScratch, bsScratch := p.splitScratch(scratch)
+
+	return p.inverseBuffers(dst, src, kernelScratch, bsScratch, scale)
+}
+
+// inverseWith is Inverse without validation, like forwardWith.
+func (p *Plan[T]) inverseWith(dst, src, scratch []T) error {
+	return p.inverseScaledWith(dst, src, scratch, p.inverseScale)
+}
+
+// inverseBuffers dispatches the inverse kernel on the given scratch, like
+// forwardBuffers.
+func (p *Plan[T]) inverseBuffers(dst, src, scratch, aux []T, scale float64) error {
+	bsScratch := aux
+
+	switch p.kernelStrategy {
+	case fft.KernelBluestein:
+		return p.bluesteinTransform(dst, src, scratch, bsScratch, p.bluesteinChirpInv, p.bluesteinFilterInv, scale/float64(p.n))
+	case fft.KernelRader:
+		return p.raderTransform(dst, src, scratch, bsScratch, p.raderFilterInv, scale/float64(p.n))
+	case fft.KernelPFA:
+		return p.pfaTransform(dst, src, scratch, bsScratch, true, scale)
+	}
+
+	if scale == 1 {
+		return p.inverseUnscaled(dst, src, scratch)
+	}
+
+	// Other normalizations reverse the forward transform rather than undo
+	// the inverse kernel's 1/n.
+	out := aux[:p.n]
+
+	err := p.forwardUnscaled(out, src, scratch)
+	if err == nil {
+		copyReversedScaled(dst, out, []int{p.n}, scale/float64(p.n))
 	}
 
+	return err
+}
+
+func (p *Plan[T]) inverseUnscaled(dst, src, scratch []T) error {
 	if p.kernelStrategy == fft.KernelRecursive {
 		return p.recursiveInverse(dst, src, scratch)
 	}
@@ -328,6 +558,43 @@ func (p *Plan[T]) Inverse(dst, src []T) error {
 	return ErrNotImplemented
 }
 
+// ForwardWithScratch computes the forward FFT like Forward, using scratch
+// instead of the plan's own workspace. scratch must hold at least
+// ScratchLen elements and must not be used by concurrent calls. The call
+// never allocates; it is the only way to run a WorkspaceExternal plan.
+//
+// Returns ErrNilSlice if dst, src or scratch is nil.
+// Returns ErrLengthMismatch if a slice is shorter than required.
+func (p *Plan[T]) ForwardWithScratch(dst, src, scratch []T) error {
+	err := p.validateSlices(dst, src)
+	if err != nil {
+		return err
+	}
+
+	err = validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forwardWith(dst, src, scratch)
+}
+
+// InverseWithScratch computes the inverse FFT like Inverse, using scratch
+// like ForwardWithScratch.
+func (p *Plan[T]) InverseWithScratch(dst, src, scratch []T) error {
+	err := p.validateSlices(dst, src)
+	if err != nil {
+		return err
+	}
+
+	err = validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverseWith(dst, src, scratch)
+}
+
 // InPlace computes the forward FFT in-place, modifying the input slice directly.
 //
 // This is equivalent to Forward(data, data) but may be slightly more efficient.
@@ -456,8 +723,10 @@ func (p *Plan[T]) validateSlices(dst, src []T) error {
 // NewPlanT creates a new FFT plan for the given size using the generic type T.
 // The size n can be any positive integer.
 // Power-of-2 sizes are most efficient.
-// Highly composite sizes (factors 2, 3, 5) use mixed-radix algorithms.
-// Prime or other sizes use Bluestein's algorithm (Chirp-Z transform).
+// Highly composite sizes (factors 2, 3, 5, 7, 11, 13) use mixed-radix algorithms.
+// Other sizes use the prime-factor algorithm when they split into coprime
+// factors, Rader's algorithm for primes, or Bluestein's algorithm
+// (Chirp-Z transform).
 //
 // Example:
 //
@@ -581,30 +850,42 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 		return nil, ErrInvalidLength
 	}
 
-	// Choose planning strategy based on mode
-	var estimate fft.PlanEstimate[T]
+	// An explicit radix schedule skips planning; otherwise choose based on mode
+	estimate, forced, err := planRadices[T](n, opts)
+	if err != nil {
+		return nil, err
+	}
 
-	switch opts.Planner {
-	case PlannerMeasure, PlannerPatient, PlannerExhaustive:
-		// Run micro-benchmarks to find the best strategy
-		var recorder fft.WisdomRecorder
-		if opts.Wisdom != nil {
-			recorder = wisdomAdapter{opts.Wisdom}
+	switch {
+	case opts.Deterministic:
+		estimate = planDeterministic[T](n, estimate.Radices)
+	case !forced:
+		switch opts.Planner {
+		case PlannerMeasure, PlannerPatient, PlannerExhaustive:
+			// Run micro-benchmarks to find the best strategy
+			var recorder fft.WisdomRecorder
+			if opts.Wisdom != nil {
+				recorder = wisdomAdapter{opts.Wisdom}
+			}
+
+			estimate = fft.MeasureAndSelect[T](
+				n,
+				features,
+				fft.PlannerMode(opts.Planner),
+				recorder,
+				opts.Strategy,
+			)
+		case PlannerEstimate:
+			// PlannerEstimate: use heuristics only (fast path)
+			estimate = fft.EstimatePlan[T](n, features, opts.Wisdom, opts.Strategy)
+		default:
+			// Fallback for any unknown planner modes
+			estimate = fft.EstimatePlan[T](n, features, opts.Wisdom, opts.Strategy)
 		}
+	}
 
-		estimate = fft.MeasureAndSelect[T](
-			n,
-			features,
-			fft.PlannerMode(opts.Planner),
-			recorder,
-			opts.Strategy,
-		)
-	case PlannerEstimate:
-		// PlannerEstimate: use heuristics only (fast path)
-		estimate = fft.EstimatePlan[T](n, features, opts.Wisdom, opts.Strategy)
-	default:
-		// Fallback for any unknown planner modes
-		estimate = fft.EstimatePlan[T](n, features, opts.Wisdom, opts.Strategy)
+	if !opts.Deterministic {
+		bindRadixSchedule(n, features, &estimate)
 	}
 
 	useBluestein := estimate.Strategy == fft.KernelBluestein
@@ -620,13 +901,7 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 		twiddleBacking []byte
 
 		// Bluestein specific
-		bluesteinM         int
-		bluesteinChirp     []T
-		bluesteinChirpInv  []T
-		bluesteinFilter    []T
-		bluesteinFilterInv []T
-		bluesteinTwiddle   []T
-		bluesteinBitrev    []int
+		bluesteinM int
 
 		// Recursive decomposition specific
 		decompStrategy *fft.DecomposeStrategy
@@ -634,123 +909,162 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 
 	// Pre-calculate configuration
 	if useBluestein {
-		bluesteinM = m.NextPowerOfTwo(2*n - 1)
+		bluesteinM = estimate.BluesteinM
+		if bluesteinM == 0 {
+			bluesteinM = fft.BluesteinSize(n)
+		}
 	} else if useRecursive {
 		codeletSizes := []int{4, 8, 16, 32, 64, 128, 256, 512}
 		cacheSize := 32768 // L1 cache size estimate
 		decompStrategy = fft.PlanDecomposition(n, codeletSizes, cacheSize)
 	}
 
-	// Allocate initial scratch set for setup (Bluestein filter computation)
+	// Allocate the initial scratch set, which seeds the pool
 	setupScratch := allocateScratchSet[T](n, strategy, bluesteinM, decompStrategy)
 
-	if useBluestein {
-		// Compute Bluestein tables
-		bluesteinChirp = fft.ComputeChirpSequence[T](n)
-
-		bluesteinChirpInv = make([]T, n)
-		for i, v := range bluesteinChirp {
-			bluesteinChirpInv[i] = fft.ConjugateOf(v)
-		}
-
-		bluesteinTwiddle = fft.ComputeTwiddleFactors[T](bluesteinM)
-		bluesteinBitrev = fft.ComputeBitReversalIndices(bluesteinM)
-
-		// Compute filters using the pre-allocated scratch buffer
-		bluesteinFilter = fft.ComputeBluesteinFilter(n, bluesteinM, bluesteinChirp, bluesteinTwiddle, setupScratch.bluesteinScratch)
-		bluesteinFilterInv = fft.ComputeBluesteinFilter(n, bluesteinM, bluesteinChirpInv, bluesteinTwiddle, setupScratch.bluesteinScratch)
-	} else if useRecursive {
+	// Bluestein plans need no twiddles of size n; initBluestein sets up
+	// their tables.
+	if useRecursive {
 		// Generate twiddles for recursive decomposition
 		var twiddleSize int
 
 		switch any(zero).(type) {
 		case complex64:
-			tmpTwiddle := fft.TwiddleFactorsRecursive[complex64](decompStrategy)
+			tmpTwiddle := fft.TwiddleFactorsRecursiveMode[complex64](decompStrategy, opts.Accuracy.twiddleMode())
 			twiddleSize = len(tmpTwiddle)
 			twiddleAligned, twiddleRaw := mem.AllocAlignedComplex64(twiddleSize)
 			copy(twiddleAligned, tmpTwiddle)
 			twiddle = any(twiddleAligned).([]T)
 			twiddleBacking = twiddleRaw
 		case complex128:
-			tmpTwiddle := fft.TwiddleFactorsRecursive[complex128](decompStrategy)
+			tmpTwiddle := fft.TwiddleFactorsRecursiveMode[complex128](decompStrategy, opts.Accuracy.twiddleMode())
 			twiddleSize = len(tmpTwiddle)
 			twiddleAligned, twiddleRaw := mem.AllocAlignedComplex128(twiddleSize)
 			copy(twiddleAligned, tmpTwiddle)
 			twiddle = any(twiddleAligned).([]T)
 			twiddleBacking = twiddleRaw
 		default:
-			twiddle = fft.TwiddleFactorsRecursive[T](decompStrategy)
+			twiddle = fft.TwiddleFactorsRecursiveMode[T](decompStrategy, opts.Accuracy.twiddleMode())
 		}
-	} else {
+	} else if !useBluestein {
 		// Standard allocation
 		switch any(zero).(type) {
 		case complex64:
 			twiddleAligned, twiddleRaw := mem.AllocAlignedComplex64(n)
-			tmp := fft.ComputeTwiddleFactors[complex64](n)
+			tmp := m.TwiddleFactors[complex64](n, opts.Accuracy.twiddleMode())
 			copy(twiddleAligned, tmp)
 			twiddle = any(twiddleAligned).([]T)
 			twiddleBacking = twiddleRaw
 		case complex128:
 			twiddleAligned, twiddleRaw := mem.AllocAlignedComplex128(n)
-			tmp := fft.ComputeTwiddleFactors[complex128](n)
+			tmp := m.TwiddleFactors[complex128](n, opts.Accuracy.twiddleMode())
 			copy(twiddleAligned, tmp)
 			twiddle = any(twiddleAligned).([]T)
 			twiddleBacking = twiddleRaw
 		default:
-			twiddle = fft.ComputeTwiddleFactors[T](n)
+			twiddle = m.TwiddleFactors[T](n, opts.Accuracy.twiddleMode())
 		}
 	}
 
-	// Create pool and put the setup scratch into it
-	scratchPool := &sync.Pool{
-		New: func() any {
-			return allocateScratchSet[T](n, strategy, bluesteinM, decompStrategy)
-		},
+	// Create pool and put the setup scratch into it. WorkspaceExternal plans
+	// keep no scratch; the setup buffers are left to the GC.
+	var scratchPool *sync.Pool
+	if opts.Workspace != WorkspaceExternal {
+		scratchPool = &sync.Pool{
+			New: func() any {
+				return allocateScratchSet[T](n, strategy, bluesteinM, decompStrategy)
+			},
+		}
+		scratchPool.Put(setupScratch)
 	}
-	scratchPool.Put(setupScratch)
 
 	p := &Plan[T]{
-		n:                  n,
-		twiddle:            twiddle,
-		scratch:            nil, // Use pool
-		stridedScratch:     nil, // Use pool
-		bitrev:             planBitReversal(n, estimate),
-		forwardCodelet:     estimate.ForwardCodelet,
-		inverseCodelet:     estimate.InverseCodelet,
-		algorithm:          estimate.Algorithm,
-		forwardKernel:      kernels.Forward,
-		inverseKernel:      kernels.Inverse,
-		kernelStrategy:     strategy,
-		decompStrategy:     decompStrategy,
-		twiddleBacking:     twiddleBacking,
-		scratchPool:        scratchPool,
-		bluesteinM:         bluesteinM,
-		bluesteinChirp:     bluesteinChirp,
-		bluesteinChirpInv:  bluesteinChirpInv,
-		bluesteinFilter:    bluesteinFilter,
-		bluesteinFilterInv: bluesteinFilterInv,
-		bluesteinTwiddle:   bluesteinTwiddle,
-		bluesteinBitrev:    bluesteinBitrev,
-		bluesteinScratch:   nil, // Use pool
+		n:                n,
+		twiddle:          twiddle,
+		scratch:          nil, // Use pool
+		stridedScratch:   nil, // Use pool
+		bitrev:           planBitReversal(n, estimate),
+		forwardCodelet:   estimate.ForwardCodelet,
+		inverseCodelet:   estimate.InverseCodelet,
+		algorithm:        estimate.Algorithm,
+		forwardKernel:    kernels.Forward,
+		inverseKernel:    kernels.Inverse,
+		kernelStrategy:   strategy,
+		decompStrategy:   decompStrategy,
+		twiddleBacking:   twiddleBacking,
+		scratchPool:      scratchPool,
+		bluesteinM:       bluesteinM,
+		bluesteinScratch: nil, // Use pool
 		meta: PlanMeta{
-			Planner:  opts.Planner,
-			Strategy: strategy,
-			Batch:    opts.Batch,
-			Stride:   opts.Stride,
-			InPlace:  opts.InPlace,
+			Planner:       opts.Planner,
+			Strategy:      strategy,
+			Batch:         opts.Batch,
+			Stride:        opts.Stride,
+			InPlace:       opts.InPlace,
+			Normalization: opts.Normalization,
+			Workspace:     opts.Workspace,
+			Accuracy:      opts.Accuracy,
+			Deterministic: opts.Deterministic,
+			Radices:       estimate.Radices,
 		},
 	}
 
-	if !useBluestein {
+	p.forwardScale, p.inverseScale = normalizationScales(opts.Normalization, n)
+
+	switch {
+	case useBluestein:
+		err = p.initBluestein(features, opts)
+		if err != nil {
+			return nil, err
+		}
+	case strategy == fft.KernelRader:
+		err = p.initRader(features, opts)
+		if err != nil {
+			return nil, err
+		}
+	case strategy == fft.KernelPFA:
+		err = p.initPFA(features, opts)
+		if err != nil {
+			return nil, err
+		}
+	case !useBluestein:
 		p.packedTwiddle4 = fft.ComputePackedTwiddles[T](n, 4, p.twiddle)
 		p.packedTwiddle4Inv = fft.ConjugatePackedTwiddles(p.packedTwiddle4)
 		p.packedTwiddle8 = fft.ComputePackedTwiddles[T](n, 8, p.twiddle)
 		p.packedTwiddle16 = fft.ComputePackedTwiddles[T](n, 16, p.twiddle)
 	}
 
+	// Bluestein, Rader and PFA sets also carry the child plans' scratch
+	if scratchPool != nil {
+		switch strategy {
+		case fft.KernelBluestein:
+			p.scratchPool = nestedScratchPool[T](n, strategy, bluesteinM, p.bluesteinConvolutionScratchLen())
+		case fft.KernelRader, fft.KernelPFA:
+			p.scratchPool = nestedScratchPool[T](n, fft.KernelDIT, 0, p.ScratchLen()-n)
+		}
+	}
+
 	return p, nil
 }
 
+// nestedScratchPool returns the scratch pool of a Bluestein, Rader or PFA
+// plan. Each set holds extra elements of child-plan scratch in its
+// bluesteinScratch slot, so a transform borrows one set instead of one per
+// child call.
+func nestedScratchPool[T Complex](n int, strategy KernelStrategy, bluesteinM, extra int) *sync.Pool {
+	return &sync.Pool{
+		New: func() any {
+			set := allocateScratchSet[T](n, strategy, bluesteinM, nil)
+			if len(set.bluesteinScratch) != extra {
+				set.bluesteinScratch = make([]T, extra)
+				set.bluesteinScratchBacking = nil
+			}
+
+			return set
+		},
+	}
+}
+
 // NewPlan creates a new single-precision (complex64) FFT plan.
 // This is equivalent to NewPlan32(n).
 func NewPlan(n int) (*Plan[complex64], error) {
@@ -781,38 +1095,62 @@ func NewPlan64(n int) (*Plan[complex128], error) {
 //	plan, err := NewPlanPooled[complex64](1024)
 //	defer plan.Close()
 func NewPlanPooled[T Complex](n int) (*Plan[T], error) {
-	return NewPlanFromPool[T](n, fft.DefaultPool)
+	return NewPlanFromPool[T](n, DefaultBufferPool())
 }
 
 // NewPlanPooledWithOptions creates a new FFT plan using pooled buffers and planner options.
 func NewPlanPooledWithOptions[T Complex](n int, opts PlanOptions) (*Plan[T], error) {
-	return NewPlanFromPoolWithOptions[T](n, fft.DefaultPool, opts)
+	return NewPlanFromPoolWithOptions[T](n, DefaultBufferPool(), opts)
 }
 
 // NewPlanFromPool creates a new FFT plan using buffers from the specified pool.
 // This allows custom pool management for advanced use cases.
-func NewPlanFromPool[T Complex](n int, pool *fft.BufferPool) (*Plan[T], error) {
+//
+// Twiddle factors and bit-reversal indices are held until Close; per-call
+// scratch is borrowed from the pool, so pooled plans are safe for
+// concurrent use like any other Plan.
+func NewPlanFromPool[T Complex](n int, pool *BufferPool) (*Plan[T], error) {
 	return NewPlanFromPoolWithOptions[T](n, pool, PlanOptions{})
 }
 
 // NewPlanFromPoolWithOptions creates a new FFT plan using buffers from the specified pool and planner options.
-func NewPlanFromPoolWithOptions[T Complex](n int, pool *fft.BufferPool, opts PlanOptions) (*Plan[T], error) {
+func NewPlanFromPoolWithOptions[T Complex](n int, pool *BufferPool, opts PlanOptions) (*Plan[T], error) {
 	if n < 1 || (!m.IsPowerOf2(n) && !m.IsHighlyComposite(n)) {
 		return nil, ErrInvalidLength
 	}
 
+	if pool == nil {
+		pool = DefaultBufferPool()
+	}
+
 	opts = normalizePlanOptions(opts)
 	features := cpu.DetectFeatures()
-	estimate := fft.EstimatePlan[T](n, features, opts.Wisdom, opts.Strategy)
+
+	if opts.Deterministic {
+		// The deterministic kernel has its own tables; the pool is not used.
+		return newPlanWithFeatures[T](n, features, opts)
+	}
+
+	estimate, forced, err := planRadices[T](n, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if !forced {
+		estimate = fft.EstimatePlan[T](n, features, opts.Wisdom, opts.Strategy)
+	}
+
+	bindRadixSchedule(n, features, &estimate)
 
 	strategy := estimate.Strategy
-	if strategy == fft.KernelBluestein {
+	if strategy == fft.KernelBluestein || strategy == fft.KernelRader || strategy == fft.KernelPFA {
 		return nil, ErrNotImplemented
 	}
 
 	kernels := fft.SelectKernelsWithStrategy[T](features, strategy)
 
-	twiddle, scratch, stridedScratch, twiddleBacking, scratchBacking, stridedBacking := getBuffersFromPool[T](n, pool)
+	twiddle, twiddleBacking := getPooledBuffer[T](pool, n)
+	copy(twiddle, m.TwiddleFactors[T](n, opts.Accuracy.twiddleMode()))
 
 	var bitrev []int
 	if m.IsPowerOf2(n) {
@@ -822,31 +1160,36 @@ func NewPlanFromPoolWithOptions[T Complex](n int, pool *fft.BufferPool, opts Pla
 	}
 
 	p := &Plan[T]{
-		n:                     n,
-		twiddle:               twiddle,
-		scratch:               scratch,
-		stridedScratch:        stridedScratch,
-		bitrev:                bitrev,
-		forwardCodelet:        estimate.ForwardCodelet,
-		inverseCodelet:        estimate.InverseCodelet,
-		algorithm:             estimate.Algorithm,
-		forwardKernel:         kernels.Forward,
-		inverseKernel:         kernels.Inverse,
-		kernelStrategy:        strategy,
-		twiddleBacking:        twiddleBacking,
-		scratchBacking:        scratchBacking,
-		stridedScratchBacking: stridedBacking,
-		pool:                  pool,
-		scratchPool:           nil, // No internal pool for pooled plans
+		n:              n,
+		twiddle:        twiddle,
+		bitrev:         bitrev,
+		forwardCodelet: estimate.ForwardCodelet,
+		inverseCodelet: estimate.InverseCodelet,
+		algorithm:      estimate.Algorithm,
+		forwardKernel:  kernels.Forward,
+		inverseKernel:  kernels.Inverse,
+		kernelStrategy: strategy,
+		twiddleBacking: twiddleBacking,
+		pool:           pool,
 		meta: PlanMeta{
-			Planner:  opts.Planner,
-			Strategy: strategy,
-			Batch:    opts.Batch,
-			Stride:   opts.Stride,
-			InPlace:  opts.InPlace,
+			Planner:       opts.Planner,
+			Strategy:      strategy,
+			Batch:         opts.Batch,
+			Stride:        opts.Stride,
+			InPlace:       opts.InPlace,
+			Normalization: opts.Normalization,
+			Workspace:     opts.Workspace,
+			Accuracy:      opts.Accuracy,
+			Radices:       estimate.Radices,
 		},
 	}
 
+	if opts.Workspace != WorkspaceExternal {
+		p.scratchPool = newPooledScratchPool[T](pool, n)
+	}
+
+	p.forwardScale, p.inverseScale = normalizationScales(opts.Normalization, n)
+
 	p.packedTwiddle4 = fft.ComputePackedTwiddles[T](n, 4, p.twiddle)
 	p.packedTwiddle4Inv = fft.ConjugatePackedTwiddles(p.packedTwiddle4)
 	p.packedTwiddle8 = fft.ComputePackedTwiddles[T](n, 8, p.twiddle)
@@ -855,51 +1198,41 @@ func NewPlanFromPoolWithOptions[T Complex](n int, pool *fft.BufferPool, opts Pla
 	return p, nil
 }
 
-func getBuffersFromPool[T Complex](n int, pool *fft.BufferPool) (twiddle, scratch, stridedScratch []T, twiddleBacking, scratchBacking, stridedBacking []byte) {
-	var zero T
-	switch any(zero).(type) {
-	case complex64:
-		twiddleAligned, twiddleRaw := pool.GetComplex64(n)
-		tmp := fft.ComputeTwiddleFactors[complex64](n)
-		copy(twiddleAligned, tmp)
-		twiddle = any(twiddleAligned).([]T)
-		twiddleBacking = twiddleRaw
-
-		scratchAligned, scratchRaw := pool.GetComplex64(n)
-		scratch = any(scratchAligned).([]T)
-		scratchBacking = scratchRaw
+// newPooledScratchPool returns a scratch-set pool whose buffers are taken
+// from pool. Close hands them back.
+func newPooledScratchPool[T Complex](pool *BufferPool, n int) *sync.Pool {
+	return &sync.Pool{
+		New: func() any {
+			set := &scratchSet[T]{}
+			set.scratch, set.scratchBacking = getPooledBuffer[T](pool, n)
+			set.stridedScratch, set.stridedScratchBacking = getPooledBuffer[T](pool, n)
 
-		stridedAligned, stridedRaw := pool.GetComplex64(n)
-		stridedScratch = any(stridedAligned).([]T)
-		stridedBacking = stridedRaw
-	case complex128:
-		twiddleAligned, twiddleRaw := pool.GetComplex128(n)
-		tmp := fft.ComputeTwiddleFactors[complex128](n)
-		copy(twiddleAligned, tmp)
-		twiddle = any(twiddleAligned).([]T)
-		twiddleBacking = twiddleRaw
+			return set
+		},
+	}
+}
 
-		scratchAligned, scratchRaw := pool.GetComplex128(n)
-		scratch = any(scratchAligned).([]T)
-		scratchBacking = scratchRaw
+// newChildPlan creates a 1D plan used inside a multi-dimensional or real
+// plan, ignoring PlanOptions.Radices. With a pool, the plan is built from it when the size allows and
+// falls back to regular allocation otherwise (e.g. Bluestein sizes).
+func newChildPlan[T Complex](n int, features cpu.Features, pool *BufferPool, opts PlanOptions) (*Plan[T], error) {
+	// A radix schedule describes one 1D size; sub-plans pick their own.
+	opts.Radices = nil
 
-		stridedAligned, stridedRaw := pool.GetComplex128(n)
-		stridedScratch = any(stridedAligned).([]T)
-		stridedBacking = stridedRaw
-	default:
-		twiddle = fft.ComputeTwiddleFactors[T](n)
-		scratch = make([]T, n)
-		stridedScratch = make([]T, n)
+	if pool != nil {
+		if plan, err := NewPlanFromPoolWithOptions[T](n, pool, opts); err == nil {
+			return plan, nil
+		}
 	}
 
-	return twiddle, scratch, stridedScratch, twiddleBacking, scratchBacking, stridedBacking
+	return newPlanWithFeatures[T](n, features, opts)
 }
 
 // Reset clears the scratch buffer and resets internal state.
 // This can be useful to ensure deterministic behavior or to clear sensitive data.
 // The Plan remains usable after Reset.
 func (p *Plan[T]) Reset() {
-	// Clear scratch buffer if it exists (pooled plans)
+	// Clear scratch buffer if it exists (cloned plans)
 	if p.scratch != nil {
 		clear(p.scratch)
 	}
@@ -921,45 +1254,25 @@ func (p *Plan[T]) Close() {
 		return // Not a pooled plan
 	}
 
-	var zero T
-	switch any(zero).(type) {
-	case complex64:
-		if p.twiddleBacking != nil {
-			p.pool.PutComplex64(p.n, any(p.twiddle).([]complex64), p.twiddleBacking)
-		}
-
-		if p.scratchBacking != nil {
-			p.pool.PutComplex64(p.n, any(p.scratch).([]complex64), p.scratchBacking)
-		}
-
-		if p.stridedScratchBacking != nil {
-			p.pool.PutComplex64(p.n, any(p.stridedScratch).([]complex64), p.stridedScratchBacking)
-		}
-	case complex128:
-		if p.twiddleBacking != nil {
-			p.pool.PutComplex128(p.n, any(p.twiddle).([]complex128), p.twiddleBacking)
-		}
-
-		if p.scratchBacking != nil {
-			p.pool.PutComplex128(p.n, any(p.scratch).([]complex128), p.scratchBacking)
-		}
-
-		if p.stridedScratchBacking != nil {
-			p.pool.PutComplex128(p.n, any(p.stridedScratch).([]complex128), p.stridedScratchBacking)
-		}
-	}
+	putPooledBuffer(p.pool, p.twiddle, p.twiddleBacking)
 
 	if p.bitrev != nil {
 		p.pool.PutIntSlice(p.n, p.bitrev)
 	}
 
+	drainPool(p.scratchPool, func(item any) {
+		if set, ok := item.(*scratchSet[T]); ok {
+			putPooledBuffer(p.pool, set.scratch, set.scratchBacking)
+			putPooledBuffer(p.pool, set.stridedScratch, set.stridedScratchBacking)
+		}
+	})
+
 	// Clear references to prevent reuse after Close
 	p.pool = nil
 	p.twiddle = nil
-	p.scratch = nil
 	p.bitrev = nil
 	p.twiddleBacking = nil
-	p.scratchBacking = nil
+	p.scratchPool = nil
 }
 
 // Clone creates an independent copy of the Plan with its own scratch buffer.
@@ -1000,7 +1313,7 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		stridedScratchBacking = stridedRaw
 
 		if p.kernelStrategy == fft.KernelBluestein {
-			bsAligned, bsRaw := mem.AllocAlignedComplex64(p.bluesteinM)
+			bsAligned, bsRaw := mem.AllocAlignedComplex64(p.bluesteinConvolutionScratchLen())
 			bluesteinScratch = any(bsAligned).([]T)
 			bluesteinScratchBacking = bsRaw
 		}
@@ -1014,7 +1327,7 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		stridedScratchBacking = stridedRaw
 
 		if p.kernelStrategy == fft.KernelBluestein {
-			bsAligned, bsRaw := mem.AllocAlignedComplex128(p.bluesteinM)
+			bsAligned, bsRaw := mem.AllocAlignedComplex128(p.bluesteinConvolutionScratchLen())
 			bluesteinScratch = any(bsAligned).([]T)
 			bluesteinScratchBacking = bsRaw
 		}
@@ -1022,10 +1335,29 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		scratch = make([]T, scratchSize)
 		stridedScratch = make([]T, p.n)
 		if p.kernelStrategy == fft.KernelBluestein {
-			bluesteinScratch = make([]T, p.bluesteinM)
+			bluesteinScratch = make([]T, p.bluesteinConvolutionScratchLen())
 		}
 	}
 
+	// PFA always runs on the set's child scratch, so its clone needs one
+	if p.kernelStrategy == fft.KernelPFA {
+		bluesteinScratch = make([]T, p.pfaScratchLen())
+	}
+
+	var bluesteinPlan, raderPlan, pfaPlan1, pfaPlan2 *Plan[T]
+	if p.bluesteinPlan != nil {
+		bluesteinPlan = p.bluesteinPlan.Clone()
+	}
+
+	if p.raderPlan != nil {
+		raderPlan = p.raderPlan.Clone()
+	}
+
+	if p.pfaPlan1 != nil {
+		pfaPlan1 = p.pfaPlan1.Clone()
+		pfaPlan2 = p.pfaPlan2.Clone()
+	}
+
 	return &Plan[T]{
 		n:                     p.n,
 		twiddle:               p.twiddle,           // Shared (immutable)
@@ -1044,6 +1376,8 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		kernelStrategy:        p.kernelStrategy,
 		decompStrategy:        p.decompStrategy,
 		meta:                  p.meta,
+		forwardScale:          p.forwardScale,
+		inverseScale:          p.inverseScale,
 		twiddleBacking:        p.twiddleBacking, // Shared reference (keeps original alive)
 		scratchBacking:        scratchBacking,   // New allocation
 		stridedScratchBacking: stridedScratchBacking,
@@ -1052,13 +1386,29 @@ func (p *Plan[T]) Clone() *Plan[T] {
 
 		// Bluestein fields
 		bluesteinM:              p.bluesteinM,
+		bluesteinPlan:           bluesteinPlan, // Own scratch like the clone
 		bluesteinChirp:          p.bluesteinChirp,
 		bluesteinChirpInv:       p.bluesteinChirpInv,
 		bluesteinFilter:         p.bluesteinFilter,
 		bluesteinFilterInv:      p.bluesteinFilterInv,
 		bluesteinTwiddle:        p.bluesteinTwiddle,
-		bluesteinBitrev:         p.bluesteinBitrev,
+		bluesteinRadices:        p.bluesteinRadices,
 		bluesteinScratch:        bluesteinScratch,        // New allocation
 		bluesteinScratchBacking: bluesteinScratchBacking, // New allocation
+
+		// Rader fields
+		raderPlan:      raderPlan, // Own scratch like the clone
+		raderGather:    p.raderGather,
+		raderScatter:   p.raderScatter,
+		raderFilter:    p.raderFilter,
+		raderFilterInv: p.raderFilterInv,
+
+		// PFA fields
+		pfaPlan1:   pfaPlan1,
+		pfaPlan2:   pfaPlan2,
+		pfaModule1: p.pfaModule1,
+		pfaModule2: p.pfaModule2,
+		pfaInput:   p.pfaInput,
+		pfaOutput:  p.pfaOutput,
 	}
 }
@@ -2,14 +2,16 @@ package algofft
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/MeKo-Christian/algo-fft/internal/cpu"
 	"github.com/MeKo-Christian/algo-fft/internal/fft"
-	mem "github.com/MeKo-Christian/algo-fft/internal/memory"
 )
 
 // Plan2D is a pre-computed 2D FFT plan for a specific matrix size and precision.
-// Plans are reusable and safe for concurrent use during transforms (but not during creation).
+// Plans are reusable and safe for concurrent use during transforms (but not during creation):
+// scratch is drawn from a per-plan pool, so one plan can be shared across goroutines.
+// With ForwardWithScratch and InverseWithScratch the caller supplies the scratch instead.
 //
 // The 2D FFT uses the row-column decomposition algorithm:
 // - Forward: FFT rows, then FFT columns
@@ -22,15 +24,16 @@ type Plan2D[T Complex] struct {
 	rows, cols int      // Matrix dimensions
 	rowPlan    *Plan[T] // Plan for transforming rows (size=cols)
 	colPlan    *Plan[T] // Plan for transforming columns (size=rows)
-	scratch    []T      // Working buffer (size=rows*cols)
-	colScratch []T      // Column scratch buffer for strided transforms (size=rows)
 	options    PlanOptions
 
 	// Transpose support for square matrices
 	transposePairs []fft.TransposePair
 
-	// backing keeps aligned scratch buffer alive for GC
-	scratchBacking []byte
+	// scratchPool provides per-call workspaces (buf size=rows*cols, line size=rows).
+	scratchPool *sync.Pool
+
+	// pool is the buffer pool this plan was allocated from (nil if not pooled).
+	pool *BufferPool
 }
 
 // NewPlan2D creates a new 2D FFT plan for a rows×cols matrix.
@@ -38,15 +41,33 @@ type Plan2D[T Complex] struct {
 // Both rows and cols must be ≥ 1. The plan supports arbitrary sizes via Bluestein's algorithm,
 // though power-of-2 and highly-composite sizes (products of small primes) are most efficient.
 //
-// The plan pre-allocates all necessary buffers, enabling zero-allocation transforms.
-//
-// For concurrent use, create separate plans via Clone() for each goroutine.
+// Scratch buffers are pooled per plan, enabling zero-allocation transforms in steady state.
 func NewPlan2D[T Complex](rows, cols int) (*Plan2D[T], error) {
 	return NewPlan2DWithOptions[T](rows, cols, PlanOptions{})
 }
 
 // NewPlan2DWithOptions creates a new 2D FFT plan with explicit planner options.
 func NewPlan2DWithOptions[T Complex](rows, cols int, opts PlanOptions) (*Plan2D[T], error) {
+	return newPlan2D[T](rows, cols, nil, opts)
+}
+
+// NewPlan2DFromPool creates a new 2D FFT plan whose 1D plans and workspaces
+// are drawn from pool. Call Close when the plan is no longer needed to hand
+// the buffers back.
+func NewPlan2DFromPool[T Complex](rows, cols int, pool *BufferPool) (*Plan2D[T], error) {
+	return NewPlan2DFromPoolWithOptions[T](rows, cols, pool, PlanOptions{})
+}
+
+// NewPlan2DFromPoolWithOptions is NewPlan2DFromPool with explicit planner options.
+func NewPlan2DFromPoolWithOptions[T Complex](rows, cols int, pool *BufferPool, opts PlanOptions) (*Plan2D[T], error) {
+	if pool == nil {
+		pool = DefaultBufferPool()
+	}
+
+	return newPlan2D[T](rows, cols, pool, opts)
+}
+
+func newPlan2D[T Complex](rows, cols int, pool *BufferPool, opts PlanOptions) (*Plan2D[T], error) {
 	if rows <= 0 || cols <= 0 {
 		return nil, ErrInvalidLength
 	}
@@ -58,49 +79,29 @@ func NewPlan2DWithOptions[T Complex](rows, cols int, opts PlanOptions) (*Plan2D[
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	// Normalization is applied once by this plan, not per dimension.
+	childOpts.Normalization = NormBackward
 
 	// Create 1D plans for rows and columns
-	rowPlan, err := newPlanWithFeatures[T](cols, features, childOpts)
+	rowPlan, err := newChildPlan[T](cols, features, pool, childOpts)
 	if err != nil {
 		return nil, err
 	}
 
-	colPlan, err := newPlanWithFeatures[T](rows, features, childOpts)
+	colPlan, err := newChildPlan[T](rows, features, pool, childOpts)
 	if err != nil {
+		rowPlan.Close()
 		return nil, err
 	}
 
-	// Allocate scratch buffer (aligned for SIMD)
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
-	totalSize := rows * cols
-
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	}
-
-	// Allocate column scratch buffer for strided transforms
-	colScratch := make([]T, rows)
-
 	p := &Plan2D[T]{
-		rows:           rows,
-		cols:           cols,
-		rowPlan:        rowPlan,
-		colPlan:        colPlan,
-		scratch:        scratch,
-		colScratch:     colScratch,
-		scratchBacking: scratchBacking,
-		options:        opts,
+		rows:        rows,
+		cols:        cols,
+		rowPlan:     rowPlan,
+		colPlan:     colPlan,
+		options:     opts,
+		scratchPool: newScratchPool[T](opts.Workspace, pool, rows*cols, rows),
+		pool:        pool,
 	}
 
 	// Pre-compute transpose pairs for square matrices (optimization)
@@ -159,6 +160,39 @@ func (p *Plan2D[T]) String() string {
 //
 // Formula: X[k,l] = Σ(m=0..rows-1) Σ(n=0..cols-1) x[m,n] * exp(-2πi*(km/rows + ln/cols)).
 func (p *Plan2D[T]) Forward(dst, src []T) error {
+	return p.forward(dst, src, nil)
+}
+
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *Plan2D[T]) ScratchLen() int {
+	return p.rows*p.cols + p.rows + max(p.rowPlan.ScratchLen(), p.colPlan.ScratchLen())
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *Plan2D[T]) ForwardWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forward(dst, src, scratch)
+}
+
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *Plan2D[T]) InverseWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverse(dst, src, scratch)
+}
+
+func (p *Plan2D[T]) forward(dst, src, scratch []T) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -176,7 +210,7 @@ func (p *Plan2D[T]) Forward(dst, src []T) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.forwardSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()])
+		err = p.transformSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()], scratch, false)
 		if err != nil {
 			return err
 		}
@@ -194,6 +228,10 @@ func (p *Plan2D[T]) Forward(dst, src []T) error {
 //
 // Formula: x[m,n] = (1/(rows*cols)) * Σ(k=0..rows-1) Σ(l=0..cols-1) X[k,l] * exp(2πi*(km/rows + ln/cols)).
 func (p *Plan2D[T]) Inverse(dst, src []T) error {
+	return p.inverse(dst, src, nil)
+}
+
+func (p *Plan2D[T]) inverse(dst, src, scratch []T) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -211,7 +249,7 @@ func (p *Plan2D[T]) Inverse(dst, src []T) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.inverseSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()])
+		err = p.transformSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()], scratch, true)
 		if err != nil {
 			return err
 		}
@@ -232,49 +270,40 @@ func (p *Plan2D[T]) InverseInPlace(data []T) error {
 	return p.Inverse(data, data)
 }
 
-// Clone creates an independent copy of the Plan2D for concurrent use.
-//
-// The clone shares immutable data (transpose pairs) but has its own:
-// - Scratch buffer (for thread safety)
-// - 1D plan instances (cloned from originals)
+// Clone creates an independent copy of the Plan2D.
 //
-// This allows multiple goroutines to perform transforms concurrently.
+// Plan2D is already safe for concurrent use, so Clone is only needed to
+// give a goroutine its own scratch pool. The clone shares the immutable
+// 1D plans and transpose pairs. Clones are never pooled; a pooled plan must
+// outlive its clones.
 func (p *Plan2D[T]) Clone() *Plan2D[T] {
-	// Allocate new scratch buffer
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
-	totalSize := p.rows * p.cols
-
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	}
-
-	// Allocate column scratch buffer for strided transforms
-	colScratch := make([]T, p.rows)
-
 	return &Plan2D[T]{
 		rows:           p.rows,
 		cols:           p.cols,
-		rowPlan:        p.rowPlan.Clone(),
-		colPlan:        p.colPlan.Clone(),
-		scratch:        scratch,
-		colScratch:     colScratch,
-		scratchBacking: scratchBacking,
-		transposePairs: p.transposePairs, // Shared (immutable)
+		rowPlan:        p.rowPlan,
+		colPlan:        p.colPlan,
 		options:        p.options,
+		transposePairs: p.transposePairs, // Shared (immutable)
+		scratchPool:    newScratchPool[T](p.options.Workspace, nil, p.rows*p.cols, p.rows),
 	}
 }
 
+// Close returns the buffers of a plan created with NewPlan2DFromPool to its
+// pool. After Close, the plan must not be used. Close is a no-op for other
+// plans and safe to call multiple times.
+func (p *Plan2D[T]) Close() {
+	if p.pool == nil {
+		return
+	}
+
+	p.rowPlan.Close()
+	p.colPlan.Close()
+	releaseWorkspacePool[T](p.scratchPool, p.pool)
+
+	p.pool = nil
+	p.scratchPool = nil
+}
+
 // validate checks that dst and src have the correct length for this plan.
 func (p *Plan2D[T]) validate(dst, src []T) error {
 	expectedLen := p.rows * p.cols
@@ -296,18 +325,14 @@ func (p *Plan2D[T]) validate(dst, src []T) error {
 
 // transformColumnsViaTranspose transforms columns using transpose for square matrices.
 // This is more cache-friendly than strided access.
-func (p *Plan2D[T]) transformColumnsViaTranspose(data []T, forward bool) {
+func (p *Plan2D[T]) transformColumnsViaTranspose(data, kernel []T) {
 	// Transpose: columns become rows
 	fft.ApplyTransposePairs(data, p.transposePairs)
 
 	// Transform each column (now a row)
 	for row := range p.rows {
 		rowData := data[row*p.cols : (row+1)*p.cols]
-		if forward {
-			_ = p.colPlan.InPlace(rowData)
-		} else {
-			_ = p.colPlan.InverseInPlace(rowData)
-		}
+		_ = p.colPlan.forwardWith(rowData, rowData, kernel)
 	}
 
 	// Transpose back
@@ -315,9 +340,7 @@ func (p *Plan2D[T]) transformColumnsViaTranspose(data []T, forward bool) {
 }
 
 // transformColumnsStrided transforms columns using strided access for non-square matrices.
-func (p *Plan2D[T]) transformColumnsStrided(data []T, forward bool) {
-	colData := p.colScratch
-
+func (p *Plan2D[T]) transformColumnsStrided(data, colData, kernel []T) {
 	for col := range p.cols {
 		// Extract column
 		for row := range p.rows {
@@ -325,11 +348,7 @@ func (p *Plan2D[T]) transformColumnsStrided(data []T, forward bool) {
 		}
 
 		// Transform column
-		if forward {
-			_ = p.colPlan.InPlace(colData)
-		} else {
-			_ = p.colPlan.InverseInPlace(colData)
-		}
+		_ = p.colPlan.forwardWith(colData, colData, kernel)
 
 		// Write back
 		for row := range p.rows {
@@ -338,64 +357,51 @@ func (p *Plan2D[T]) transformColumnsStrided(data []T, forward bool) {
 	}
 }
 
-func (p *Plan2D[T]) forwardSingle(dst, src []T) error {
+// transformSingle runs the forward transform of one matrix through the
+// unscaled row and column plans and applies the normalization once, in the
+// copy to dst. The inverse reverses the indices in that copy, see
+// copyReversedScaled.
+func (p *Plan2D[T]) transformSingle(dst, src, scratch []T, inverse bool) error {
 	err := p.validate(dst, src)
 	if err != nil {
 		return err
 	}
 
-	work := p.scratch
-	copy(work, src)
-
-	// Transform rows
-	for row := range p.rows {
-		rowData := work[row*p.cols : (row+1)*p.cols]
-
-		err := p.rowPlan.InPlace(rowData)
-		if err != nil {
-			return err
-		}
-	}
-
-	// Transform columns
-	if p.rows == p.cols {
-		p.transformColumnsViaTranspose(work, true)
-	} else {
-		p.transformColumnsStrided(work, true)
-	}
-
-	copy(dst, work)
-
-	return nil
-}
-
-func (p *Plan2D[T]) inverseSingle(dst, src []T) error {
-	err := p.validate(dst, src)
+	ws, pooled, err := acquireWorkspace[T](p.scratchPool, scratch, p.rows*p.cols, p.rows)
 	if err != nil {
 		return err
 	}
 
-	work := p.scratch
+	if pooled != nil {
+		defer p.scratchPool.Put(pooled)
+	}
+
+	work := ws.buf
 	copy(work, src)
 
-	// Transform rows (inverse)
+	// Transform rows
 	for row := range p.rows {
 		rowData := work[row*p.cols : (row+1)*p.cols]
 
-		err := p.rowPlan.InverseInPlace(rowData)
+		err := p.rowPlan.forwardWith(rowData, rowData, ws.kernel)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Transform columns (inverse)
+	// Transform columns
 	if p.rows == p.cols {
-		p.transformColumnsViaTranspose(work, false)
+		p.transformColumnsViaTranspose(work, ws.kernel)
 	} else {
-		p.transformColumnsStrided(work, false)
+		p.transformColumnsStrided(work, ws.line, ws.kernel)
 	}
 
-	copy(dst, work)
+	forwardScale, inverseScale := normalizationScales(p.options.Normalization, p.Len())
+	if inverse {
+		copyReversedScaled(dst, work, []int{p.rows, p.cols}, inverseScale/float64(p.Len()))
+	} else {
+		copyScaled(dst, work, forwardScale)
+	}
 
 	return nil
 }
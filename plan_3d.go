@@ -2,13 +2,15 @@ package algofft
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/MeKo-Christian/algo-fft/internal/cpu"
-	mem "github.com/MeKo-Christian/algo-fft/internal/memory"
 )
 
 // Plan3D is a pre-computed 3D FFT plan for a specific volume size and precision.
-// Plans are reusable and safe for concurrent use during transforms (but not during creation).
+// Plans are reusable and safe for concurrent use during transforms (but not during creation):
+// scratch is drawn from a per-plan pool, so one plan can be shared across goroutines.
+// With ForwardWithScratch and InverseWithScratch the caller supplies the scratch instead.
 //
 // The 3D FFT uses the dimension-by-dimension decomposition algorithm:
 // - Forward: FFT along width (innermost), then height, then depth (outermost)
@@ -23,12 +25,14 @@ type Plan3D[T Complex] struct {
 	widthPlan            *Plan[T] // Plan for transforming along width (size=width)
 	heightPlan           *Plan[T] // Plan for transforming along height (size=height)
 	depthPlan            *Plan[T] // Plan for transforming along depth (size=depth)
-	scratch              []T      // Working buffer (size=depth*height*width)
-	dimScratch           []T      // Dimension scratch buffer for strided transforms (size=max(height,depth))
 	options              PlanOptions
 
-	// backing keeps aligned scratch buffer alive for GC
-	scratchBacking []byte
+	// scratchPool provides per-call workspaces
+	// (buf size=depth*height*width, line size=max(height,depth)).
+	scratchPool *sync.Pool
+
+	// pool is the buffer pool this plan was allocated from (nil if not pooled).
+	pool *BufferPool
 }
 
 // NewPlan3D creates a new 3D FFT plan for a depth×height×width volume.
@@ -36,17 +40,34 @@ type Plan3D[T Complex] struct {
 // All dimensions must be ≥ 1. The plan supports arbitrary sizes via Bluestein's algorithm,
 // though power-of-2 and highly-composite sizes (products of small primes) are most efficient.
 //
-// The plan pre-allocates all necessary buffers, enabling zero-allocation transforms.
-//
-// For concurrent use, create separate plans via Clone() for each goroutine.
+// Scratch buffers are pooled per plan, enabling zero-allocation transforms in steady state.
 func NewPlan3D[T Complex](depth, height, width int) (*Plan3D[T], error) {
 	return NewPlan3DWithOptions[T](depth, height, width, PlanOptions{})
 }
 
 // NewPlan3DWithOptions creates a new 3D FFT plan with explicit planner options.
-//
-//nolint:funlen
 func NewPlan3DWithOptions[T Complex](depth, height, width int, opts PlanOptions) (*Plan3D[T], error) {
+	return newPlan3D[T](depth, height, width, nil, opts)
+}
+
+// NewPlan3DFromPool creates a new 3D FFT plan whose 1D plans and workspaces
+// are drawn from pool. Call Close when the plan is no longer needed to hand
+// the buffers back.
+func NewPlan3DFromPool[T Complex](depth, height, width int, pool *BufferPool) (*Plan3D[T], error) {
+	return NewPlan3DFromPoolWithOptions[T](depth, height, width, pool, PlanOptions{})
+}
+
+// NewPlan3DFromPoolWithOptions is NewPlan3DFromPool with explicit planner options.
+func NewPlan3DFromPoolWithOptions[T Complex](depth, height, width int, pool *BufferPool, opts PlanOptions) (*Plan3D[T], error) {
+	if pool == nil {
+		pool = DefaultBufferPool()
+	}
+
+	return newPlan3D[T](depth, height, width, pool, opts)
+}
+
+//nolint:funlen
+func newPlan3D[T Complex](depth, height, width int, pool *BufferPool, opts PlanOptions) (*Plan3D[T], error) {
 	if depth <= 0 || height <= 0 || width <= 0 {
 		return nil, ErrInvalidLength
 	}
@@ -58,61 +79,39 @@ func NewPlan3DWithOptions[T Complex](depth, height, width int, opts PlanOptions)
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	// Normalization is applied once by this plan, not per dimension.
+	childOpts.Normalization = NormBackward
 
 	// Create 1D plans for each dimension
-	widthPlan, err := newPlanWithFeatures[T](width, features, childOpts)
+	widthPlan, err := newChildPlan[T](width, features, pool, childOpts)
 	if err != nil {
 		return nil, err
 	}
 
-	heightPlan, err := newPlanWithFeatures[T](height, features, childOpts)
+	heightPlan, err := newChildPlan[T](height, features, pool, childOpts)
 	if err != nil {
+		widthPlan.Close()
 		return nil, err
 	}
 
-	depthPlan, err := newPlanWithFeatures[T](depth, features, childOpts)
+	depthPlan, err := newChildPlan[T](depth, features, pool, childOpts)
 	if err != nil {
-		return nil, err
-	}
+		widthPlan.Close()
+		heightPlan.Close()
 
-	// Allocate scratch buffer (aligned for SIMD)
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
-	totalSize := depth * height * width
-
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	}
-
-	// Allocate dimension scratch buffer for strided transforms
-	dimScratchSize := height
-	if depth > height {
-		dimScratchSize = depth
+		return nil, err
 	}
 
-	dimScratch := make([]T, dimScratchSize)
-
 	return &Plan3D[T]{
-		depth:          depth,
-		height:         height,
-		width:          width,
-		widthPlan:      widthPlan,
-		heightPlan:     heightPlan,
-		depthPlan:      depthPlan,
-		scratch:        scratch,
-		dimScratch:     dimScratch,
-		scratchBacking: scratchBacking,
-		options:        opts,
+		depth:       depth,
+		height:      height,
+		width:       width,
+		widthPlan:   widthPlan,
+		heightPlan:  heightPlan,
+		depthPlan:   depthPlan,
+		options:     opts,
+		scratchPool: newScratchPool[T](opts.Workspace, pool, depth*height*width, max(height, depth)),
+		pool:        pool,
 	}, nil
 }
 
@@ -171,6 +170,41 @@ func (p *Plan3D[T]) String() string {
 //
 //	x[d,h,w] * exp(-2πi*(kd*d/depth + kh*h/height + kw*w/width))
 func (p *Plan3D[T]) Forward(dst, src []T) error {
+	return p.forward(dst, src, nil)
+}
+
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *Plan3D[T]) ScratchLen() int {
+	kernelLen := max(p.widthPlan.ScratchLen(), p.heightPlan.ScratchLen(), p.depthPlan.ScratchLen())
+
+	return p.Len() + max(p.height, p.depth) + kernelLen
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *Plan3D[T]) ForwardWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forward(dst, src, scratch)
+}
+
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *Plan3D[T]) InverseWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverse(dst, src, scratch)
+}
+
+func (p *Plan3D[T]) forward(dst, src, scratch []T) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -188,7 +222,7 @@ func (p *Plan3D[T]) Forward(dst, src []T) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.forwardSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()])
+		err = p.transformSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()], scratch, false)
 		if err != nil {
 			return err
 		}
@@ -208,6 +242,10 @@ func (p *Plan3D[T]) Forward(dst, src []T) error {
 //
 //	X[kd,kh,kw] * exp(2πi*(kd*d/depth + kh*h/height + kw*w/width))
 func (p *Plan3D[T]) Inverse(dst, src []T) error {
+	return p.inverse(dst, src, nil)
+}
+
+func (p *Plan3D[T]) inverse(dst, src, scratch []T) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -225,7 +263,7 @@ func (p *Plan3D[T]) Inverse(dst, src []T) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.inverseSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()])
+		err = p.transformSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()], scratch, true)
 		if err != nil {
 			return err
 		}
@@ -246,53 +284,39 @@ func (p *Plan3D[T]) InverseInPlace(data []T) error {
 	return p.Inverse(data, data)
 }
 
-// Clone creates an independent copy of the Plan3D for concurrent use.
+// Clone creates an independent copy of the Plan3D.
 //
-// The clone shares immutable data but has its own:
-// - Scratch buffer (for thread safety)
-// - 1D plan instances (cloned from originals)
-//
-// This allows multiple goroutines to perform transforms concurrently.
+// Plan3D is already safe for concurrent use, so Clone is only needed to
+// give a goroutine its own scratch pool. The clone shares the immutable
+// 1D plans. Clones are never pooled; a pooled plan must outlive its clones.
 func (p *Plan3D[T]) Clone() *Plan3D[T] {
-	// Allocate new scratch buffer
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
-	totalSize := p.depth * p.height * p.width
-
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
+	return &Plan3D[T]{
+		depth:       p.depth,
+		height:      p.height,
+		width:       p.width,
+		widthPlan:   p.widthPlan,
+		heightPlan:  p.heightPlan,
+		depthPlan:   p.depthPlan,
+		options:     p.options,
+		scratchPool: newScratchPool[T](p.options.Workspace, nil, p.depth*p.height*p.width, max(p.height, p.depth)),
 	}
+}
 
-	// Allocate dimension scratch buffer for strided transforms
-	dimScratchSize := p.height
-	if p.depth > p.height {
-		dimScratchSize = p.depth
+// Close returns the buffers of a plan created with NewPlan3DFromPool to its
+// pool. After Close, the plan must not be used. Close is a no-op for other
+// plans and safe to call multiple times.
+func (p *Plan3D[T]) Close() {
+	if p.pool == nil {
+		return
 	}
 
-	dimScratch := make([]T, dimScratchSize)
+	p.widthPlan.Close()
+	p.heightPlan.Close()
+	p.depthPlan.Close()
+	releaseWorkspacePool[T](p.scratchPool, p.pool)
 
-	return &Plan3D[T]{
-		depth:          p.depth,
-		height:         p.height,
-		width:          p.width,
-		widthPlan:      p.widthPlan.Clone(),
-		heightPlan:     p.heightPlan.Clone(),
-		depthPlan:      p.depthPlan.Clone(),
-		scratch:        scratch,
-		dimScratch:     dimScratch,
-		scratchBacking: scratchBacking,
-		options:        p.options,
-	}
+	p.pool = nil
+	p.scratchPool = nil
 }
 
 // validate checks that dst and src have the correct length for this plan.
@@ -316,25 +340,21 @@ func (p *Plan3D[T]) validate(dst, src []T) error {
 
 // transformWidth transforms along the width dimension (innermost).
 // Each row of width elements is transformed in-place.
-func (p *Plan3D[T]) transformWidth(data []T, forward bool) {
+func (p *Plan3D[T]) transformWidth(data, kernel []T) {
 	for d := range p.depth {
 		for h := range p.height {
 			offset := d*p.height*p.width + h*p.width
 
 			rowData := data[offset : offset+p.width]
-			if forward {
-				_ = p.widthPlan.InPlace(rowData)
-			} else {
-				_ = p.widthPlan.InverseInPlace(rowData)
-			}
+			_ = p.widthPlan.forwardWith(rowData, rowData, kernel)
 		}
 	}
 }
 
 // transformHeight transforms along the height dimension (middle).
 // For each depth slice, columns along height are extracted, transformed, and written back.
-func (p *Plan3D[T]) transformHeight(data []T, forward bool) {
-	colData := p.dimScratch[:p.height]
+func (p *Plan3D[T]) transformHeight(data, line, kernel []T) {
+	colData := line[:p.height]
 
 	for d := range p.depth {
 		for w := range p.width {
@@ -344,11 +364,7 @@ func (p *Plan3D[T]) transformHeight(data []T, forward bool) {
 			}
 
 			// Transform column
-			if forward {
-				_ = p.heightPlan.InPlace(colData)
-			} else {
-				_ = p.heightPlan.InverseInPlace(colData)
-			}
+			_ = p.heightPlan.forwardWith(colData, colData, kernel)
 
 			// Write back
 			for h := range p.height {
@@ -360,8 +376,8 @@ func (p *Plan3D[T]) transformHeight(data []T, forward bool) {
 
 // transformDepth transforms along the depth dimension (outermost).
 // For each (height, width) position, a slice along depth is extracted, transformed, and written back.
-func (p *Plan3D[T]) transformDepth(data []T, forward bool) {
-	depthData := p.dimScratch[:p.depth]
+func (p *Plan3D[T]) transformDepth(data, line, kernel []T) {
+	depthData := line[:p.depth]
 
 	for h := range p.height {
 		for w := range p.width {
@@ -371,11 +387,7 @@ func (p *Plan3D[T]) transformDepth(data []T, forward bool) {
 			}
 
 			// Transform depth slice
-			if forward {
-				_ = p.depthPlan.InPlace(depthData)
-			} else {
-				_ = p.depthPlan.InverseInPlace(depthData)
-			}
+			_ = p.depthPlan.forwardWith(depthData, depthData, kernel)
 
 			// Write back
 			for d := range p.depth {
@@ -385,38 +397,37 @@ func (p *Plan3D[T]) transformDepth(data []T, forward bool) {
 	}
 }
 
-func (p *Plan3D[T]) forwardSingle(dst, src []T) error {
+// transformSingle runs the forward transform of one volume through the
+// unscaled axis plans and applies the normalization once, in the copy to
+// dst, like Plan2D.transformSingle.
+func (p *Plan3D[T]) transformSingle(dst, src, scratch []T, inverse bool) error {
 	err := p.validate(dst, src)
 	if err != nil {
 		return err
 	}
 
-	work := p.scratch
-	copy(work, src)
-
-	p.transformWidth(work, true)
-	p.transformHeight(work, true)
-	p.transformDepth(work, true)
-
-	copy(dst, work)
-
-	return nil
-}
-
-func (p *Plan3D[T]) inverseSingle(dst, src []T) error {
-	err := p.validate(dst, src)
+	ws, pooled, err := acquireWorkspace[T](p.scratchPool, scratch, p.Len(), max(p.height, p.depth))
 	if err != nil {
 		return err
 	}
 
-	work := p.scratch
+	if pooled != nil {
+		defer p.scratchPool.Put(pooled)
+	}
+
+	work := ws.buf
 	copy(work, src)
 
-	p.transformWidth(work, false)
-	p.transformHeight(work, false)
-	p.transformDepth(work, false)
+	p.transformWidth(work, ws.kernel)
+	p.transformHeight(work, ws.line, ws.kernel)
+	p.transformDepth(work, ws.line, ws.kernel)
 
-	copy(dst, work)
+	forwardScale, inverseScale := normalizationScales(p.options.Normalization, p.Len())
+	if inverse {
+		copyReversedScaled(dst, work, []int{p.depth, p.height, p.width}, inverseScale/float64(p.Len()))
+	} else {
+		copyScaled(dst, work, forwardScale)
+	}
 
 	return nil
 }
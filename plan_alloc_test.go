@@ -96,6 +96,10 @@ func TestPlanRealTransformsNoAllocs(t *testing.T) {
 func assertNoAllocs(t *testing.T, label string, run func() error) {
 	t.Helper()
 
+	if raceEnabled {
+		t.Skip("allocation counts are not stable under the race detector")
+	}
+
 	allocs := testing.AllocsPerRun(100, func() {
 		err := run()
 		if err != nil {
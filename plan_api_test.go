@@ -112,6 +112,7 @@ func TestKernelStrategy(t *testing.T) {
 		{"Stockham", 256, KernelStockham},
 		{"SixStep", 4096, KernelSixStep},
 		{"EightStep", 16384, KernelEightStep},
+		{"SplitRadix", 2048, KernelSplitRadix},
 	}
 
 	for _, tt := range tests {
@@ -233,6 +234,7 @@ func TestSetGetKernelStrategy(t *testing.T) {
 		KernelStockham,
 		KernelSixStep,
 		KernelEightStep,
+		KernelSplitRadix,
 	}
 
 	for _, strategy := range strategies {
@@ -330,6 +332,7 @@ func TestString_AllStrategies(t *testing.T) {
 		{KernelStockham, "Stockham", 256},
 		{KernelSixStep, "SixStep", 4096},
 		{KernelEightStep, "EightStep", 8192},
+		{KernelSplitRadix, "SplitRadix", 512},
 		{KernelAuto, "auto", 128}, // Auto might resolve to DIT or Stockham
 	}
 
@@ -338,6 +338,10 @@ func TestPlanBatch_InPlace(t *testing.T) {
 func TestPlanBatch_ZeroAllocations(t *testing.T) {
 	// Note: t.Parallel() cannot be used here because testing.AllocsPerRun
 	// panics when called during a parallel test.
+	if raceEnabled {
+		t.Skip("allocation counts are not stable under the race detector")
+	}
+
 	n := 64
 	count := 10
 
@@ -1,57 +1,120 @@
 package algofft
 
 import (
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
 	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
 )
 
-func (p *Plan[T]) bluesteinForward(dst, src, scratch, bluesteinScratch []T) error {
-	for i := range p.n {
-		scratch[i] = src[i] * p.bluesteinChirp[i]
+// initBluestein sets up Bluestein's algorithm for p.n: the chirps, a plan
+// of size p.bluesteinM for the convolution and its filters. Like Rader's
+// convolution plan, it is chosen by the same planner as p, so a smooth M
+// runs on the mixed-radix kernel. Deterministic plans convolve with the
+// deterministic kernel instead.
+func (p *Plan[T]) initBluestein(features cpu.Features, opts PlanOptions) error {
+	size := p.bluesteinM
+
+	p.bluesteinChirp = chirpSequence[T](p.n, opts.Accuracy)
+
+	p.bluesteinChirpInv = make([]T, p.n)
+	for i, v := range p.bluesteinChirp {
+		p.bluesteinChirpInv[i] = fft.ConjugateOf(v)
 	}
 
-	var zero T
-	for i := p.n; i < p.bluesteinM; i++ {
-		scratch[i] = zero
+	if opts.Deterministic {
+		scratch := make([]T, size)
+
+		p.bluesteinTwiddle = m.TwiddleFactors[T](size, opts.Accuracy.twiddleMode())
+		p.bluesteinRadices = fft.DeterministicRadices(size)
+		p.bluesteinFilter = fft.DeterministicBluesteinFilter(p.n, size, p.bluesteinChirp, p.bluesteinTwiddle,
+			scratch, p.bluesteinRadices)
+		p.bluesteinFilterInv = fft.DeterministicBluesteinFilter(p.n, size, p.bluesteinChirpInv, p.bluesteinTwiddle,
+			scratch, p.bluesteinRadices)
+
+		return nil
 	}
 
-	fft.BluesteinConvolution(
-		scratch, scratch, p.bluesteinFilter,
-		p.bluesteinTwiddle, bluesteinScratch,
-	)
+	// The forced strategy applies to p; the convolution picks its own.
+	opts.Strategy = KernelAuto
 
-	for i := range p.n {
-		dst[i] = scratch[i] * p.bluesteinChirp[i]
+	plan, err := newChildPlan[T](size, features, nil, opts)
+	if err != nil {
+		return err
+	}
+
+	scratch := make([]T, plan.ScratchLen())
+
+	p.bluesteinFilter = fft.ComputeBluesteinSequence(p.n, size, p.bluesteinChirp)
+	p.bluesteinFilterInv = fft.ComputeBluesteinSequence(p.n, size, p.bluesteinChirpInv)
+
+	err = plan.forwardScaledWith(p.bluesteinFilter, p.bluesteinFilter, scratch, 1)
+	if err == nil {
+		err = plan.forwardScaledWith(p.bluesteinFilterInv, p.bluesteinFilterInv, scratch, 1)
+	}
+
+	if err != nil {
+		return err
 	}
 
+	p.bluesteinPlan = plan
+
 	return nil
 }
 
-func (p *Plan[T]) bluesteinInverse(dst, src, scratch, bluesteinScratch []T) error {
+// bluesteinConvolutionScratchLen returns the scratch length of Bluestein's
+// convolution: the size M plan's, or M for the deterministic kernel.
+func (p *Plan[T]) bluesteinConvolutionScratchLen() int {
+	if p.bluesteinPlan != nil {
+		return p.bluesteinPlan.ScratchLen()
+	}
+
+	return p.bluesteinM
+}
+
+// bluesteinTransform computes the DFT of size n with Bluestein's algorithm:
+// the input times chirp, zero-padded to M, is convolved with filter by the
+// size M plan, and the first n results are multiplied by chirp and scale.
+// chirp and filter select the direction. convScratch is the convolution
+// plan's scratch, or nil to use its own.
+func (p *Plan[T]) bluesteinTransform(dst, src, scratch, convScratch, chirp, filter []T, scale float64) error {
+	if p.meta.Deterministic {
+		p.bluesteinDeterministic(dst, src, scratch, convScratch, chirp, filter, scale)
+		return nil
+	}
+
+	work := scratch[:p.bluesteinM]
+
 	for i := range p.n {
-		scratch[i] = src[i] * p.bluesteinChirpInv[i]
+		work[i] = src[i] * chirp[i]
+	}
+
+	clear(work[p.n:])
+
+	err := p.bluesteinPlan.forwardScaledWith(work, work, convScratch, 1)
+	if err != nil {
+		return err
 	}
 
-	var zero T
-	for i := p.n; i < p.bluesteinM; i++ {
-		scratch[i] = zero
+	for i := range work {
+		work[i] *= filter[i]
 	}
 
-	fft.BluesteinConvolution(
-		scratch, scratch, p.bluesteinFilterInv,
-		p.bluesteinTwiddle, bluesteinScratch,
-	)
+	err = p.bluesteinPlan.inverseScaledWith(work, work, convScratch, 1)
+	if err != nil {
+		return err
+	}
 
-	var scale T
+	if scale == 1 {
+		for i := range p.n {
+			dst[i] = work[i] * chirp[i]
+		}
 
-	switch any(zero).(type) {
-	case complex64:
-		scale = any(complex(float32(1.0/float64(p.n)), 0)).(T)
-	case complex128:
-		scale = any(complex(1.0/float64(p.n), 0)).(T)
+		return nil
 	}
 
+	factor := m.ComplexFromFloat64[T](scale, 0)
 	for i := range p.n {
-		dst[i] = scratch[i] * p.bluesteinChirpInv[i] * scale
+		dst[i] = work[i] * chirp[i] * factor
 	}
 
 	return nil
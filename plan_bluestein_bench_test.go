@@ -1,7 +1,12 @@
 package algofft
 
 import (
+	"fmt"
+	"math"
 	"testing"
+	"time"
+
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
 )
 
 // Bluestein FFT benchmarks for non-power-of-2 sizes.
@@ -158,3 +163,69 @@ func benchmarkBluesteinForward128(b *testing.B, fftSize int) {
 		}
 	}
 }
+
+// BenchmarkBluesteinStageCost measures the stage costs Bluestein's length
+// choice assumes (bluesteinRadix3Cost and bluesteinRadix5Cost in
+// internal/planner): the time of a plan of size radix·2^k beyond log2(size)
+// radix-2 passes, in passes of the next power-of-two plan. The planner
+// constants are the rounded passes/stage metric at the larger sizes.
+func BenchmarkBluesteinStageCost(b *testing.B) {
+	b.Run("complex64", benchmarkBluesteinStageCost[complex64])
+	b.Run("complex128", benchmarkBluesteinStageCost[complex128])
+}
+
+func benchmarkBluesteinStageCost[T Complex](b *testing.B) {
+	for _, radix := range []int{3, 5} {
+		for _, pow2 := range []int{256, 1024, 4096, 16384} {
+			size := radix * pow2
+			pow2Size := m.NextPowerOfTwo(size)
+
+			b.Run(fmt.Sprintf("Radix%d/%d", radix, size), func(b *testing.B) {
+				smooth, smoothBuf := newStageCostPlan[T](b, size)
+				power, powerBuf := newStageCostPlan[T](b, pow2Size)
+
+				var smoothTime, powerTime time.Duration
+
+				for b.Loop() {
+					start := time.Now()
+
+					if err := smooth.Forward(smoothBuf[size:], smoothBuf[:size]); err != nil {
+						b.Fatalf("Forward(%d) returned error: %v", size, err)
+					}
+
+					smoothTime += time.Since(start)
+					start = time.Now()
+
+					if err := power.Forward(powerBuf[pow2Size:], powerBuf[:pow2Size]); err != nil {
+						b.Fatalf("Forward(%d) returned error: %v", pow2Size, err)
+					}
+
+					powerTime += time.Since(start)
+				}
+
+				perPass := powerTime.Seconds() / (float64(pow2Size) * math.Log2(float64(pow2Size)))
+				passes := smoothTime.Seconds() / (float64(size) * perPass)
+
+				b.ReportMetric(passes-math.Log2(float64(size)), "passes/stage")
+			})
+		}
+	}
+}
+
+// newStageCostPlan returns a plan of size n and a buffer holding its input
+// followed by room for the output.
+func newStageCostPlan[T Complex](b *testing.B, n int) (*Plan[T], []T) {
+	b.Helper()
+
+	plan, err := NewPlanT[T](n)
+	if err != nil {
+		b.Fatalf("NewPlan(%d) returned error: %v", n, err)
+	}
+
+	buf := make([]T, 2*n)
+	for i := range n {
+		buf[i] = m.ComplexFromFloat64[T](float64(i%16)/16, float64((i+1)%16)/16)
+	}
+
+	return plan, buf
+}
@@ -2,8 +2,12 @@ package algofft
 
 import (
 	"math/cmplx"
+	"strings"
 	"testing"
 
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
 	"github.com/MeKo-Christian/algo-fft/internal/reference"
 )
 
@@ -72,8 +76,9 @@ func TestNewPlan_Bluestein_EdgeCases(t *testing.T) {
 func TestNewPlan_Bluestein(t *testing.T) {
 	t.Parallel()
 
-	// Prime lengths trigger Bluestein
-	primes := []int{7, 11, 13, 17}
+	// Primes p whose p-1 has a large prime factor trigger Bluestein; the
+	// others run Rader's algorithm.
+	primes := []int{47, 59, 83, 107}
 	for _, n := range primes {
 		t.Run("complex64_"+itoa(n), func(t *testing.T) {
 			t.Parallel()
@@ -228,14 +233,14 @@ func TestBluestein_LargePrimes(t *testing.T) {
 func TestBluestein_MatchesReference(t *testing.T) {
 	t.Parallel()
 
-	// Test various prime sizes
+	// Test various prime sizes; the default planner would pick Rader for most.
 	primes := []int{7, 11, 13, 17, 19, 23, 31}
 
 	for _, n := range primes {
 		t.Run("complex64_"+itoa(n), func(t *testing.T) {
 			t.Parallel()
 
-			plan, err := NewPlanT[complex64](n)
+			plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Strategy: KernelBluestein})
 			if err != nil {
 				t.Fatalf("NewPlan(%d) failed: %v", n, err)
 			}
@@ -269,7 +274,7 @@ func TestBluestein_MatchesReference(t *testing.T) {
 		t.Run("complex128_"+itoa(n), func(t *testing.T) {
 			t.Parallel()
 
-			plan, err := NewPlanT[complex128](n)
+			plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: KernelBluestein})
 			if err != nil {
 				t.Fatalf("NewPlan(%d) failed: %v", n, err)
 			}
@@ -310,7 +315,7 @@ func TestBluestein_InverseMatchesReference(t *testing.T) {
 		t.Run("complex128_"+itoa(n), func(t *testing.T) {
 			t.Parallel()
 
-			plan, err := NewPlanT[complex128](n)
+			plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: KernelBluestein})
 			if err != nil {
 				t.Fatalf("NewPlan(%d) failed: %v", n, err)
 			}
@@ -348,7 +353,7 @@ func BenchmarkBluesteinVsNaive(b *testing.B) {
 
 	for _, n := range primes {
 		b.Run("Bluestein_"+itoa(n), func(b *testing.B) {
-			plan, err := NewPlanT[complex64](n)
+			plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Strategy: KernelBluestein})
 			if err != nil {
 				b.Fatalf("NewPlan(%d) failed: %v", n, err)
 			}
@@ -525,3 +530,151 @@ func BenchmarkBluesteinRoundTrip(b *testing.B) {
 		})
 	}
 }
+
+func TestBluestein_SmoothConvolutionSize(t *testing.T) {
+	t.Parallel()
+
+	// Sizes just above a power of two, where the next power of two for the
+	// convolution is nearly twice 2n-1.
+	tests := []struct{ n, m int }{
+		{257, 576},
+		{513, 1152},
+		{1025, 2304},
+	}
+
+	for _, tt := range tests {
+		t.Run(itoa(tt.n), func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlanWithOptions[complex128](tt.n, PlanOptions{
+				Strategy:  KernelBluestein,
+				Workspace: WorkspaceExternal,
+			})
+			if err != nil {
+				t.Fatalf("NewPlan(%d) failed: %v", tt.n, err)
+			}
+
+			if plan.bluesteinM != tt.m {
+				t.Fatalf("bluesteinM = %d, want %d", plan.bluesteinM, tt.m)
+			}
+
+			// The same transform with a power-of-two convolution
+			pow2 := mapWisdomFor[complex128](tt.n, "bluestein_"+itoa(m.NextPowerOfTwo(2*tt.n-1)))
+
+			ref, err := NewPlanWithOptions[complex128](tt.n, PlanOptions{Strategy: KernelBluestein, Wisdom: pow2})
+			if err != nil {
+				t.Fatalf("NewPlan(%d) with wisdom failed: %v", tt.n, err)
+			}
+
+			src := randomComplex128(tt.n, uint64(tt.n))
+			want := make([]complex128, tt.n)
+
+			if err := ref.Forward(want, src); err != nil {
+				t.Fatalf("reference Forward failed: %v", err)
+			}
+
+			scratch := make([]complex128, plan.ScratchLen())
+			dst := make([]complex128, tt.n)
+
+			if err := plan.ForwardWithScratch(dst, src, scratch); err != nil {
+				t.Fatalf("ForwardWithScratch failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "forward", dst, want, 1e-9)
+
+			back := make([]complex128, tt.n)
+			if err := plan.Clone().InverseWithScratch(back, dst, scratch); err != nil {
+				t.Fatalf("clone InverseWithScratch failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "roundtrip", back, src, 1e-12)
+		})
+	}
+}
+
+func TestBluestein_WisdomConvolutionSize(t *testing.T) {
+	t.Parallel()
+
+	const n = 289 // 17², which only Bluestein's algorithm handles
+
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Wisdom: mapWisdomFor[complex64](n, "bluestein_1024")})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	if plan.bluesteinM != 1024 || plan.Algorithm() != "bluestein_1024" {
+		t.Fatalf("plan = %q with M = %d, want bluestein_1024", plan.Algorithm(), plan.bluesteinM)
+	}
+
+	// Lengths below 2n-1 or without a mixed-radix schedule are ignored
+	for _, algorithm := range []string{"bluestein_576", "bluestein_1183"} {
+		plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Wisdom: mapWisdomFor[complex64](n, algorithm)})
+		if err != nil {
+			t.Fatalf("NewPlan(%d) failed: %v", n, err)
+		}
+
+		if plan.bluesteinM != 768 {
+			t.Errorf("wisdom %q: bluesteinM = %d, want 768", algorithm, plan.bluesteinM)
+		}
+	}
+}
+
+func TestBluestein_MeasureRecordsConvolutionSize(t *testing.T) {
+	t.Parallel()
+
+	const n = 289
+
+	wisdom := &mapWisdom{entries: make(map[WisdomKey]WisdomEntry)}
+
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Planner: PlannerMeasure, Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	if plan.KernelStrategy() != KernelBluestein || plan.Algorithm() != "bluestein_"+itoa(plan.bluesteinM) {
+		t.Fatalf("plan = %v/%q with M = %d, want its Bluestein size",
+			plan.KernelStrategy(), plan.Algorithm(), plan.bluesteinM)
+	}
+
+	recorded := false
+
+	for key, entry := range wisdom.entries {
+		if key.Size == n {
+			recorded = entry.Algorithm == plan.Algorithm()
+		}
+	}
+
+	if !recorded {
+		t.Fatalf("wisdom %v has no %q entry for size %d", wisdom.entries, plan.Algorithm(), n)
+	}
+
+	replay, err := NewPlanWithOptions[complex64](n, PlanOptions{Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) with wisdom failed: %v", n, err)
+	}
+
+	if replay.bluesteinM != plan.bluesteinM || !strings.HasPrefix(replay.Algorithm(), "bluestein_") {
+		t.Errorf("wisdom plan = %q with M = %d, want %q", replay.Algorithm(), replay.bluesteinM, plan.Algorithm())
+	}
+}
+
+// mapWisdomFor returns wisdom holding algorithm for size n on this CPU.
+func mapWisdomFor[T Complex](n int, algorithm string) *mapWisdom {
+	var (
+		zero      T
+		precision = PrecisionComplex64
+	)
+
+	if _, ok := any(zero).(complex128); ok {
+		precision = PrecisionComplex128
+	}
+
+	features := cpu.DetectFeatures()
+	key := WisdomKey{
+		Size:        n,
+		Precision:   uint8(precision),
+		CPUFeatures: fft.CPUFeatureMask(features.HasSSE2, features.HasAVX2, features.HasAVX512, features.HasNEON),
+	}
+
+	return &mapWisdom{entries: map[WisdomKey]WisdomEntry{key: {Key: key, Algorithm: algorithm}}}
+}
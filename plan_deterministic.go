@@ -0,0 +1,53 @@
+package algofft
+
+import (
+	"slices"
+
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+)
+
+// planDeterministic returns the estimate of a PlanOptions.Deterministic
+// plan: the deterministic kernel with the given radix schedule, or with its
+// own schedule for n when radices is nil, and Bluestein's algorithm for
+// sizes without one. The choice depends only on n and the options, never
+// on the CPU, the planner or wisdom.
+func planDeterministic[T Complex](n int, radices []int) fft.PlanEstimate[T] {
+	if radices == nil {
+		radices = fft.DeterministicRadices(n)
+		if radices == nil {
+			return fft.PlanEstimate[T]{
+				Strategy:   fft.KernelBluestein,
+				Algorithm:  "deterministic_bluestein",
+				BluesteinM: fft.BluesteinSize(n),
+			}
+		}
+	}
+
+	radices = slices.Clone(radices)
+
+	return fft.PlanEstimate[T]{
+		Strategy:  fft.KernelDIT,
+		Algorithm: "deterministic_" + fft.RadixScheduleName(radices),
+		Radices:   radices,
+		ForwardCodelet: func(dst, src, twiddle, scratch []T) {
+			fft.DeterministicForward(dst, src, twiddle, scratch, radices)
+		},
+		InverseCodelet: func(dst, src, twiddle, scratch []T) {
+			fft.DeterministicInverse(dst, src, twiddle, scratch, radices)
+		},
+	}
+}
+
+// bluesteinDeterministic is the Bluestein transform of a deterministic plan:
+// the steps of bluesteinTransform run by the deterministic kernel. chirp and filter select the direction.
+func (p *Plan[T]) bluesteinDeterministic(dst, src, scratch, bluesteinScratch, chirp, filter []T, scale float64) {
+	work := scratch[:p.bluesteinM]
+
+	fft.DeterministicMul(work[:p.n], src, chirp)
+	clear(work[p.n:])
+
+	fft.DeterministicConvolve(work, filter, p.bluesteinTwiddle, bluesteinScratch, p.bluesteinRadices)
+
+	fft.DeterministicMul(dst, work[:p.n], chirp)
+	scaleSpectrumGeneric(dst, scale)
+}
@@ -0,0 +1,427 @@
+package algofft
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+)
+
+// IODim describes one dimension of a guru layout: its length and the
+// distance, in elements, between consecutive indices in the input and in
+// the output (FFTW's fftw_iodim).
+type IODim struct {
+	N         int // Number of indices along this dimension
+	InStride  int // Input distance between index i and i+1
+	OutStride int // Output distance between index i and i+1
+}
+
+// PlanGuru is a pre-computed FFT plan over an arbitrary strided layout, in
+// the style of FFTW's guru interface.
+//
+// Dims lists the transform dimensions (rank 1 for a 1D FFT, rank 2 for a
+// 2D FFT, ...). Howmany lists the loop dimensions: the transform is repeated
+// for every index combination of the howmany dimensions, each offsetting the
+// input and output by its own strides. Input and output strides are
+// independent, so, for example, the columns of a row-major matrix can be
+// transformed into a contiguous output, or interleaved channels can be
+// transformed in place.
+//
+// Element (i0, ..., ir-1) of transform (h0, ..., hm-1) is read from
+//
+//	src[Σ i_k*Dims[k].InStride + Σ h_k*Howmany[k].InStride]
+//
+// and written to the same expression with OutStride into dst.
+//
+// All strides must be positive. For in-place use (dst and src the same
+// slice) every input stride must equal the matching output stride.
+//
+// Plans are safe for concurrent use; scratch is drawn from the 1D plans' pools,
+// or supplied by the caller through ForwardWithScratch and InverseWithScratch.
+//
+// The generic type parameter T must be either complex64 or complex128.
+type PlanGuru[T Complex] struct {
+	dims    []IODim
+	howmany []IODim
+	passes  []guruPass[T]
+	size    int // product of transform dimensions
+	inLen   int // minimum len(src)
+	outLen  int // minimum len(dst)
+	scratch int // ScratchLen
+	options PlanOptions
+}
+
+// guruPass transforms every line along one transform axis.
+type guruPass[T Complex] struct {
+	plan    *Plan[T]
+	line    IODim   // axis length and strides
+	loops   []IODim // all other dimensions of this pass
+	fromSrc bool    // first pass reads src; later passes work on dst
+}
+
+// NewPlanGuru creates a guru-layout FFT plan with default options.
+func NewPlanGuru[T Complex](dims, howmany []IODim) (*PlanGuru[T], error) {
+	return NewPlanGuruWithOptions[T](dims, howmany, PlanOptions{})
+}
+
+// NewPlanGuruWithOptions creates a guru-layout FFT plan with explicit planner options.
+// Batch and Stride are ignored (the layout is given by dims and howmany);
+// Normalization is applied once per transform of all dims.
+//
+// Returns ErrInvalidLength if dims is empty or any length is < 1.
+// Returns ErrInvalidStride if a stride is < 1 or the layout overflows int.
+func NewPlanGuruWithOptions[T Complex](dims, howmany []IODim, opts PlanOptions) (*PlanGuru[T], error) {
+	if len(dims) == 0 {
+		return nil, ErrInvalidLength
+	}
+
+	err := validateIODims(dims)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateIODims(howmany)
+	if err != nil {
+		return nil, err
+	}
+
+	all := append(append([]IODim(nil), dims...), howmany...)
+
+	inLen, err := ioExtent(all, func(d IODim) int { return d.InStride })
+	if err != nil {
+		return nil, err
+	}
+
+	outLen, err := ioExtent(all, func(d IODim) int { return d.OutStride })
+	if err != nil {
+		return nil, err
+	}
+
+	opts = normalizePlanOptions(opts)
+	opts.Batch = 0
+	opts.Stride = 0
+	features := cpu.DetectFeatures()
+
+	childOpts := opts
+	// Normalization is applied once by this plan, not per dimension.
+	childOpts.Normalization = NormBackward
+	childOpts.Radices = nil
+
+	plans := make(map[int]*Plan[T])
+	passes := make([]guruPass[T], 0, len(dims))
+	size, scratchLen := 1, 0
+
+	// Innermost dimension first; the first pass moves data from src to dst.
+	for axis := len(dims) - 1; axis >= 0; axis-- {
+		dim := dims[axis]
+		size *= dim.N
+
+		plan, ok := plans[dim.N]
+		if !ok {
+			plan, err = newPlanWithFeatures[T](dim.N, features, childOpts)
+			if err != nil {
+				return nil, err
+			}
+
+			plans[dim.N] = plan
+			scratchLen = max(scratchLen, plan.lineScratchLen())
+		}
+
+		fromSrc := len(passes) == 0
+		line := dim
+
+		loops := make([]IODim, 0, len(all)-1)
+		loops = append(loops, howmany...)
+
+		for other, d := range dims {
+			if other != axis {
+				loops = append(loops, d)
+			}
+		}
+
+		if !fromSrc {
+			// Later passes read and write dst.
+			line.InStride = line.OutStride
+			for i := range loops {
+				loops[i].InStride = loops[i].OutStride
+			}
+		}
+
+		passes = append(passes, guruPass[T]{plan: plan, line: line, loops: loops, fromSrc: fromSrc})
+	}
+
+	return &PlanGuru[T]{
+		dims:    append([]IODim(nil), dims...),
+		howmany: append([]IODim(nil), howmany...),
+		passes:  passes,
+		size:    size,
+		inLen:   inLen,
+		outLen:  outLen,
+		scratch: scratchLen,
+		options: opts,
+	}, nil
+}
+
+// NewPlanMany creates a guru plan for howmany row-major transforms of shape
+// n, like FFTW's fftw_plan_many_dft without embedding: element strides
+// inStride/outStride and distances inDist/outDist between consecutive
+// transforms.
+//
+// Example:
+//
+//	// FFT of each of the 256 columns of a 128x256 row-major matrix, in place.
+//	plan, _ := algofft.NewPlanMany[complex64]([]int{128}, 256, 256, 1, 256, 1, algofft.PlanOptions{})
+//	err := plan.Forward(matrix, matrix)
+func NewPlanMany[T Complex](n []int, howmany, inStride, inDist, outStride, outDist int, opts PlanOptions) (*PlanGuru[T], error) {
+	if len(n) == 0 || howmany < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	dims := make([]IODim, len(n))
+	inStep, outStep := inStride, outStride
+
+	for axis := len(n) - 1; axis >= 0; axis-- {
+		dims[axis] = IODim{N: n[axis], InStride: inStep, OutStride: outStep}
+		inStep *= n[axis]
+		outStep *= n[axis]
+	}
+
+	return NewPlanGuruWithOptions[T](dims, []IODim{{N: howmany, InStride: inDist, OutStride: outDist}}, opts)
+}
+
+// Dims returns a copy of the transform dimensions.
+func (p *PlanGuru[T]) Dims() []IODim {
+	return append([]IODim(nil), p.dims...)
+}
+
+// Howmany returns a copy of the loop dimensions.
+func (p *PlanGuru[T]) Howmany() []IODim {
+	return append([]IODim(nil), p.howmany...)
+}
+
+// Len returns the number of elements of one transform (product of Dims lengths).
+func (p *PlanGuru[T]) Len() int {
+	return p.size
+}
+
+// InputLen returns the minimum length of src.
+func (p *PlanGuru[T]) InputLen() int {
+	return p.inLen
+}
+
+// OutputLen returns the minimum length of dst.
+func (p *PlanGuru[T]) OutputLen() int {
+	return p.outLen
+}
+
+// String returns a human-readable description of the PlanGuru for debugging.
+func (p *PlanGuru[T]) String() string {
+	var zero T
+
+	typeName := "complex64"
+	if _, ok := any(zero).(complex128); ok {
+		typeName = "complex128"
+	}
+
+	return fmt.Sprintf("PlanGuru[%s](dims=%s, howmany=%s)", typeName, formatIODims(p.dims), formatIODims(p.howmany))
+}
+
+// Forward computes the forward FFT of every transform in the layout.
+//
+// The output is scaled according to PlanOptions.Normalization.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if src is shorter than InputLen() or dst is
+// shorter than OutputLen().
+// Returns ErrInvalidStride if dst and src are the same slice but the input
+// and output strides differ.
+func (p *PlanGuru[T]) Forward(dst, src []T) error {
+	scale, _ := normalizationScales(p.options.Normalization, p.size)
+
+	return p.execute(dst, src, false, scale, nil)
+}
+
+// Inverse computes the inverse FFT of every transform in the layout.
+//
+// The output is scaled according to PlanOptions.Normalization (1/N by
+// default, N = Len()).
+//
+// Returns the same errors as Forward.
+func (p *PlanGuru[T]) Inverse(dst, src []T) error {
+	_, scale := normalizationScales(p.options.Normalization, p.size)
+
+	return p.execute(dst, src, true, scale, nil)
+}
+
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanGuru[T]) ScratchLen() int {
+	return p.scratch
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the 1D plans' pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanGuru[T]) ForwardWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, p.scratch)
+	if err != nil {
+		return err
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.size)
+
+	return p.execute(dst, src, false, scale, scratch)
+}
+
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanGuru[T]) InverseWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, p.scratch)
+	if err != nil {
+		return err
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.size)
+
+	return p.execute(dst, src, true, scale, scratch)
+}
+
+func (p *PlanGuru[T]) execute(dst, src []T, inverse bool, scale float64, scratch []T) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) < p.inLen || len(dst) < p.outLen {
+		return ErrLengthMismatch
+	}
+
+	if sameSliceStrided(dst, src) && !p.inPlaceCompatible() {
+		return fmt.Errorf("in-place guru transform needs matching input and output strides: %w", ErrInvalidStride)
+	}
+
+	last := len(p.passes) - 1
+
+	for i, pass := range p.passes {
+		in := dst
+		if pass.fromSrc {
+			in = src
+		}
+
+		// Normalization is folded into the final pass.
+		passScale := 1.0
+		if i == last {
+			passScale = scale
+		}
+
+		err := forEachIOOffset(pass.loops, func(inOff, outOff int) error {
+			return pass.plan.transformLine(dst, outOff, pass.line.OutStride, in, inOff, pass.line.InStride, inverse, passScale, scratch)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inPlaceCompatible reports whether every dimension uses identical input
+// and output strides, which makes dst == src safe.
+func (p *PlanGuru[T]) inPlaceCompatible() bool {
+	for _, dims := range [][]IODim{p.dims, p.howmany} {
+		for _, d := range dims {
+			if d.N > 1 && d.InStride != d.OutStride {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// forEachIOOffset calls fn with the input and output offsets of every index
+// combination of loops, in row-major order. Up to 8 loops are counted on
+// the stack, so transforms with caller scratch stay allocation-free.
+func forEachIOOffset(loops []IODim, fn func(inOff, outOff int) error) error {
+	var (
+		stack [8]int
+		idx   []int
+	)
+
+	if len(loops) <= len(stack) {
+		idx = stack[:len(loops)]
+	} else {
+		idx = make([]int, len(loops))
+	}
+	inOff, outOff := 0, 0
+
+	for {
+		err := fn(inOff, outOff)
+		if err != nil {
+			return err
+		}
+
+		// Odometer increment, innermost loop last.
+		axis := len(loops) - 1
+		for ; axis >= 0; axis-- {
+			idx[axis]++
+			inOff += loops[axis].InStride
+			outOff += loops[axis].OutStride
+
+			if idx[axis] < loops[axis].N {
+				break
+			}
+
+			inOff -= idx[axis] * loops[axis].InStride
+			outOff -= idx[axis] * loops[axis].OutStride
+			idx[axis] = 0
+		}
+
+		if axis < 0 {
+			return nil
+		}
+	}
+}
+
+func validateIODims(dims []IODim) error {
+	for _, d := range dims {
+		if d.N < 1 {
+			return fmt.Errorf("guru dimension length %d: %w", d.N, ErrInvalidLength)
+		}
+
+		if d.N > 1 && (d.InStride < 1 || d.OutStride < 1) {
+			return fmt.Errorf("guru dimension strides %d/%d: %w", d.InStride, d.OutStride, ErrInvalidStride)
+		}
+	}
+
+	return nil
+}
+
+// ioExtent returns 1 + the largest offset reachable in dims using stride.
+func ioExtent(dims []IODim, stride func(IODim) int) (int, error) {
+	maxInt := int(^uint(0) >> 1)
+	extent := 1
+
+	for _, d := range dims {
+		if d.N == 1 {
+			continue
+		}
+
+		s := stride(d)
+		if d.N-1 > (maxInt-extent)/s {
+			return 0, ErrInvalidStride
+		}
+
+		extent += (d.N - 1) * s
+	}
+
+	return extent, nil
+}
+
+func formatIODims(dims []IODim) string {
+	parts := make([]string, len(dims))
+	for i, d := range dims {
+		parts[i] = fmt.Sprintf("%d:%d/%d", d.N, d.InStride, d.OutStride)
+	}
+
+	return "[" + strings.Join(parts, " ") + "]"
+}
@@ -0,0 +1,338 @@
+package algofft
+
+import (
+	"errors"
+	"fmt"
+	"math/cmplx"
+	"testing"
+)
+
+// guruReference transforms one contiguous line with a 1D plan.
+func guruReference(t *testing.T, line []complex128, inverse bool) []complex128 {
+	t.Helper()
+
+	plan, err := NewPlanT[complex128](len(line))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := make([]complex128, len(line))
+	if inverse {
+		err = plan.Inverse(out, line)
+	} else {
+		err = plan.Forward(out, line)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return out
+}
+
+func assertGuruNear(t *testing.T, got, want complex128, tol float64, format string, args ...any) {
+	t.Helper()
+
+	if cmplx.Abs(got-want) > tol {
+		t.Fatalf("%s: got %v, want %v", fmt.Sprintf(format, args...), got, want)
+	}
+}
+
+func TestPlanGuru_MatrixColumnsToRows(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols = 16, 12
+
+	// Columns of a row-major rows x cols matrix, written as contiguous rows
+	// of a cols x rows output (a transform plus transpose).
+	plan, err := NewPlanGuru[complex128](
+		[]IODim{{N: rows, InStride: cols, OutStride: 1}},
+		[]IODim{{N: cols, InStride: 1, OutStride: rows}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.InputLen() != rows*cols || plan.OutputLen() != rows*cols {
+		t.Fatalf("InputLen/OutputLen = %d/%d, want %d", plan.InputLen(), plan.OutputLen(), rows*cols)
+	}
+
+	matrix := normalizationSignal(rows*cols, 1)
+	out := make([]complex128, rows*cols)
+
+	if err := plan.Forward(out, matrix); err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	for c := range cols {
+		column := make([]complex128, rows)
+		for r := range rows {
+			column[r] = matrix[r*cols+c]
+		}
+
+		want := guruReference(t, column, false)
+		for k := range rows {
+			assertGuruNear(t, out[c*rows+k], want[k], 1e-10, "column %d bin %d", c, k)
+		}
+	}
+
+	// The transposed layout inverts with swapped strides.
+	back, err := NewPlanGuru[complex128](
+		[]IODim{{N: rows, InStride: 1, OutStride: cols}},
+		[]IODim{{N: cols, InStride: rows, OutStride: 1}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored := make([]complex128, rows*cols)
+	if err := back.Inverse(restored, out); err != nil {
+		t.Fatalf("Inverse: %v", err)
+	}
+
+	for i := range matrix {
+		assertGuruNear(t, restored[i], matrix[i], 1e-10, "index %d", i)
+	}
+}
+
+func TestPlanGuru_InterleavedChannelsInPlace(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{32, 30} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			t.Parallel()
+
+			const channels = 3
+
+			plan, err := NewPlanMany[complex64]([]int{n}, channels, channels, 1, channels, 1, PlanOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := normalizationSignal(n*channels, uint64(n))
+			data := narrowSlice[complex64](src)
+
+			if err := plan.Forward(data, data); err != nil {
+				t.Fatalf("Forward: %v", err)
+			}
+
+			for ch := range channels {
+				line := make([]complex128, n)
+				for i := range n {
+					line[i] = src[i*channels+ch]
+				}
+
+				want := guruReference(t, line, false)
+				for k := range n {
+					assertGuruNear(t, complex128(data[k*channels+ch]), want[k], 1e-4, "channel %d bin %d", ch, k)
+				}
+			}
+
+			if err := plan.Inverse(data, data); err != nil {
+				t.Fatalf("Inverse: %v", err)
+			}
+
+			for i := range src {
+				assertGuruNear(t, complex128(data[i]), src[i], 1e-4, "index %d", i)
+			}
+		})
+	}
+}
+
+func TestPlanGuru_ManyMatchesPlanND(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dims      []int
+		inStride  int
+		outStride int
+	}{
+		{dims: []int{8, 6}, inStride: 1, outStride: 1},
+		{dims: []int{8, 6}, inStride: 2, outStride: 1},
+		{dims: []int{4, 5, 8}, inStride: 1, outStride: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v/%d/%d", tt.dims, tt.inStride, tt.outStride), func(t *testing.T) {
+			t.Parallel()
+
+			const howmany = 3
+
+			size := shapeSize(tt.dims)
+			inDist := size * tt.inStride
+			outDist := size * tt.outStride
+
+			plan, err := NewPlanMany[complex128](tt.dims, howmany, tt.inStride, inDist, tt.outStride, outDist, PlanOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if plan.Len() != size {
+				t.Fatalf("Len() = %d, want %d", plan.Len(), size)
+			}
+
+			ref, err := NewPlanND[complex128](tt.dims)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := normalizationSignal(plan.InputLen(), 7)
+			dst := make([]complex128, plan.OutputLen())
+
+			if err := plan.Forward(dst, src); err != nil {
+				t.Fatalf("Forward: %v", err)
+			}
+
+			for b := range howmany {
+				block := make([]complex128, size)
+				for i := range size {
+					block[i] = src[b*inDist+i*tt.inStride]
+				}
+
+				want := make([]complex128, size)
+				if err := ref.Forward(want, block); err != nil {
+					t.Fatal(err)
+				}
+
+				for i := range size {
+					assertGuruNear(t, dst[b*outDist+i*tt.outStride], want[i], 1e-9, "transform %d index %d", b, i)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanGuru_MultipleHowmany(t *testing.T) {
+	t.Parallel()
+
+	const a, b, n = 2, 3, 8
+
+	// Input is [a][b][n], output is [b][a][n]: two loop dimensions with
+	// different input and output strides.
+	plan, err := NewPlanGuru[complex128](
+		[]IODim{{N: n, InStride: 1, OutStride: 1}},
+		[]IODim{{N: a, InStride: b * n, OutStride: n}, {N: b, InStride: n, OutStride: a * n}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := normalizationSignal(a*b*n, 11)
+	dst := make([]complex128, a*b*n)
+
+	if err := plan.Forward(dst, src); err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	for i := range a {
+		for j := range b {
+			want := guruReference(t, src[(i*b+j)*n:(i*b+j+1)*n], false)
+			for k := range n {
+				assertGuruNear(t, dst[(j*a+i)*n+k], want[k], 1e-10, "(%d,%d) bin %d", i, j, k)
+			}
+		}
+	}
+}
+
+func TestPlanGuru_Normalization(t *testing.T) {
+	t.Parallel()
+
+	dims := []int{4, 8}
+
+	plan, err := NewPlanMany[complex128](dims, 2, 1, 32, 1, 32, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref, err := NewPlanNDWithOptions[complex128](dims, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := normalizationSignal(64, 5)
+	dst := make([]complex128, 64)
+
+	if err := plan.Forward(dst, src); err != nil {
+		t.Fatal(err)
+	}
+
+	want := make([]complex128, 32)
+	if err := ref.Forward(want, src[32:]); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range want {
+		assertGuruNear(t, dst[32+i], want[i], 1e-12, "index %d", i)
+	}
+
+	if err := plan.Inverse(dst, dst); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range src {
+		assertGuruNear(t, dst[i], src[i], 1e-12, "roundtrip index %d", i)
+	}
+}
+
+func TestPlanGuru_Errors(t *testing.T) {
+	t.Parallel()
+
+	constructors := []struct {
+		name    string
+		dims    []IODim
+		howmany []IODim
+		want    error
+	}{
+		{name: "no dims", want: ErrInvalidLength},
+		{name: "zero length", dims: []IODim{{N: 0, InStride: 1, OutStride: 1}}, want: ErrInvalidLength},
+		{name: "zero stride", dims: []IODim{{N: 8, InStride: 0, OutStride: 1}}, want: ErrInvalidStride},
+		{
+			name:    "howmany stride",
+			dims:    []IODim{{N: 8, InStride: 1, OutStride: 1}},
+			howmany: []IODim{{N: 2, InStride: 8, OutStride: -8}},
+			want:    ErrInvalidStride,
+		},
+		{
+			name: "overflow",
+			dims: []IODim{{N: 8, InStride: int(^uint(0) >> 2), OutStride: 1}},
+			want: ErrInvalidStride,
+		},
+	}
+
+	for _, tt := range constructors {
+		if _, err := NewPlanGuru[complex64](tt.dims, tt.howmany); !errors.Is(err, tt.want) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	if _, err := NewPlanMany[complex64]([]int{8}, 0, 1, 8, 1, 8, PlanOptions{}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("howmany=0 error = %v, want ErrInvalidLength", err)
+	}
+
+	plan, err := NewPlanGuru[complex64](
+		[]IODim{{N: 8, InStride: 2, OutStride: 1}},
+		[]IODim{{N: 2, InStride: 1, OutStride: 8}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]complex64, 16)
+
+	if err := plan.Forward(nil, buf); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst error = %v, want ErrNilSlice", err)
+	}
+
+	if err := plan.Forward(buf, buf[:10]); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short src error = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := plan.Inverse(buf, buf); !errors.Is(err, ErrInvalidStride) {
+		t.Errorf("in-place mismatched strides error = %v, want ErrInvalidStride", err)
+	}
+
+	want := "PlanGuru[complex64](dims=[8:2/1], howmany=[2:1/8])"
+	if plan.String() != want {
+		t.Errorf("String() = %q, want %q", plan.String(), want)
+	}
+}
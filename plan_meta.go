@@ -1,5 +1,7 @@
 package algofft
 
+import "slices"
+
 // PlanMeta captures planner decisions for introspection.
 type PlanMeta struct {
 	Planner  PlannerMode
@@ -7,9 +9,28 @@ type PlanMeta struct {
 	Batch    int
 	Stride   int
 	InPlace  bool
+
+	// Normalization is the scaling convention applied by Forward and Inverse.
+	Normalization Normalization
+
+	// Workspace is the scratch ownership policy the plan was created with.
+	Workspace WorkspacePolicy
+
+	// Accuracy is the twiddle accuracy level the plan was created with.
+	Accuracy Accuracy
+
+	// Deterministic reports whether the plan runs the deterministic kernel.
+	Deterministic bool
+
+	// Radices is the mixed-radix schedule the plan runs, outermost stage
+	// first, or nil if the plan uses another kernel.
+	Radices []int
 }
 
 // Meta returns metadata about how the plan was constructed.
 func (p *Plan[T]) Meta() PlanMeta {
-	return p.meta
+	meta := p.meta
+	meta.Radices = slices.Clone(meta.Radices)
+
+	return meta
 }
@@ -2,13 +2,16 @@ package algofft
 
 import (
 	"fmt"
+	"slices"
+	"sync"
 
 	"github.com/MeKo-Christian/algo-fft/internal/cpu"
-	mem "github.com/MeKo-Christian/algo-fft/internal/memory"
 )
 
 // PlanND is a pre-computed N-dimensional FFT plan for arbitrary dimensions.
-// Plans are reusable and safe for concurrent use during transforms (but not during creation).
+// Plans are reusable and safe for concurrent use during transforms (but not during creation):
+// scratch is drawn from a per-plan pool, so one plan can be shared across goroutines.
+// With ForwardWithScratch and InverseWithScratch the caller supplies the scratch instead.
 //
 // The N-D FFT uses the dimension-by-dimension decomposition algorithm:
 // transforms are applied sequentially along each axis from innermost to outermost.
@@ -20,12 +23,15 @@ import (
 type PlanND[T Complex] struct {
 	dims    []int      // Dimension sizes [d0, d1, ..., dN-1]
 	plans   []*Plan[T] // 1D plans for each dimension
-	scratch []T        // Working buffer (size = product of all dims)
 	strides []int      // Pre-computed strides for each dimension
 	options PlanOptions
 
-	// backing keeps aligned scratch buffer alive for GC
-	scratchBacking []byte
+	// scratchPool provides per-call workspaces
+	// (buf size = product of all dims, line size = largest dim).
+	scratchPool *sync.Pool
+
+	// pool is the buffer pool this plan was allocated from (nil if not pooled).
+	pool *BufferPool
 }
 
 // NewPlanND creates a new N-dimensional FFT plan for the given dimension sizes.
@@ -37,17 +43,34 @@ type PlanND[T Complex] struct {
 // All dimensions must be ≥ 1. The plan supports arbitrary sizes via Bluestein's algorithm,
 // though power-of-2 and highly-composite sizes are most efficient.
 //
-// The plan pre-allocates all necessary buffers, enabling zero-allocation transforms.
-//
-// For concurrent use, create separate plans via Clone() for each goroutine.
+// Scratch buffers are pooled per plan.
 func NewPlanND[T Complex](dims []int) (*PlanND[T], error) {
 	return NewPlanNDWithOptions[T](dims, PlanOptions{})
 }
 
 // NewPlanNDWithOptions creates a new N-dimensional FFT plan with explicit planner options.
-//
-//nolint:funlen
 func NewPlanNDWithOptions[T Complex](dims []int, opts PlanOptions) (*PlanND[T], error) {
+	return newPlanND[T](dims, nil, opts)
+}
+
+// NewPlanNDFromPool creates a new N-dimensional FFT plan whose 1D plans and
+// workspaces are drawn from pool. Call Close when the plan is no longer
+// needed to hand the buffers back.
+func NewPlanNDFromPool[T Complex](dims []int, pool *BufferPool) (*PlanND[T], error) {
+	return NewPlanNDFromPoolWithOptions[T](dims, pool, PlanOptions{})
+}
+
+// NewPlanNDFromPoolWithOptions is NewPlanNDFromPool with explicit planner options.
+func NewPlanNDFromPoolWithOptions[T Complex](dims []int, pool *BufferPool, opts PlanOptions) (*PlanND[T], error) {
+	if pool == nil {
+		pool = DefaultBufferPool()
+	}
+
+	return newPlanND[T](dims, pool, opts)
+}
+
+//nolint:funlen
+func newPlanND[T Complex](dims []int, pool *BufferPool, opts PlanOptions) (*PlanND[T], error) {
 	if len(dims) == 0 {
 		return nil, ErrInvalidLength
 	}
@@ -74,35 +97,24 @@ func NewPlanNDWithOptions[T Complex](dims []int, opts PlanOptions) (*PlanND[T],
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	// Normalization is applied once by this plan, not per dimension.
+	childOpts.Normalization = NormBackward
 
 	// Create 1D plans for each dimension
 	plans := make([]*Plan[T], len(dims))
 	for i, size := range dimsCopy {
-		plan, err := newPlanWithFeatures[T](size, features, childOpts)
+		plan, err := newChildPlan[T](size, features, pool, childOpts)
 		if err != nil {
+			for _, created := range plans[:i] {
+				created.Close()
+			}
+
 			return nil, fmt.Errorf("failed to create plan for dimension %d (size %d): %w", i, size, err)
 		}
 
 		plans[i] = plan
 	}
 
-	// Allocate scratch buffer (aligned for SIMD)
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	}
-
 	// Pre-compute strides for efficient indexing
 	strides := make([]int, len(dims))
 
@@ -113,12 +125,12 @@ func NewPlanNDWithOptions[T Complex](dims []int, opts PlanOptions) (*PlanND[T],
 	}
 
 	return &PlanND[T]{
-		dims:           dimsCopy,
-		plans:          plans,
-		scratch:        scratch,
-		strides:        strides,
-		scratchBacking: scratchBacking,
-		options:        opts,
+		dims:        dimsCopy,
+		plans:       plans,
+		strides:     strides,
+		options:     opts,
+		scratchPool: newScratchPool[T](opts.Workspace, pool, totalSize, slices.Max(dimsCopy)),
+		pool:        pool,
 	}, nil
 }
 
@@ -186,6 +198,44 @@ func (p *PlanND[T]) String() string {
 //
 // Supports in-place operation (dst == src).
 func (p *PlanND[T]) Forward(dst, src []T) error {
+	return p.forward(dst, src, nil)
+}
+
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanND[T]) ScratchLen() int {
+	kernelLen := 0
+	for _, plan := range p.plans {
+		kernelLen = max(kernelLen, plan.ScratchLen())
+	}
+
+	return p.Len() + slices.Max(p.dims) + kernelLen
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanND[T]) ForwardWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forward(dst, src, scratch)
+}
+
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanND[T]) InverseWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverse(dst, src, scratch)
+}
+
+func (p *PlanND[T]) forward(dst, src, scratch []T) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -203,7 +253,7 @@ func (p *PlanND[T]) Forward(dst, src []T) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.forwardSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()])
+		err = p.transformSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()], scratch, false)
 		if err != nil {
 			return err
 		}
@@ -219,6 +269,10 @@ func (p *PlanND[T]) Forward(dst, src []T) error {
 //
 // Supports in-place operation (dst == src).
 func (p *PlanND[T]) Inverse(dst, src []T) error {
+	return p.inverse(dst, src, nil)
+}
+
+func (p *PlanND[T]) inverse(dst, src, scratch []T) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -236,7 +290,7 @@ func (p *PlanND[T]) Inverse(dst, src []T) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.inverseSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()])
+		err = p.transformSingle(dst[dstOff:dstOff+p.Len()], src[srcOff:srcOff+p.Len()], scratch, true)
 		if err != nil {
 			return err
 		}
@@ -257,54 +311,37 @@ func (p *PlanND[T]) InverseInPlace(data []T) error {
 	return p.Inverse(data, data)
 }
 
-// Clone creates an independent copy of the PlanND for concurrent use.
-//
-// The clone shares immutable data but has its own:
-// - Scratch buffer (for thread safety)
-// - 1D plan instances (cloned from originals)
+// Clone creates an independent copy of the PlanND.
 //
-// This allows multiple goroutines to perform transforms concurrently.
+// PlanND is already safe for concurrent use, so Clone is only needed to
+// give a goroutine its own scratch pool. The clone shares the immutable
+// 1D plans. Clones are never pooled; a pooled plan must outlive its clones.
 func (p *PlanND[T]) Clone() *PlanND[T] {
-	// Allocate new scratch buffer
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
-	totalSize := p.Len()
-
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
+	return &PlanND[T]{
+		dims:        slices.Clone(p.dims),
+		plans:       slices.Clone(p.plans),
+		strides:     slices.Clone(p.strides),
+		options:     p.options,
+		scratchPool: newScratchPool[T](p.options.Workspace, nil, p.Len(), slices.Max(p.dims)),
 	}
+}
 
-	// Clone all 1D plans
-	plans := make([]*Plan[T], len(p.plans))
-	for i, plan := range p.plans {
-		plans[i] = plan.Clone()
+// Close returns the buffers of a plan created with NewPlanNDFromPool to its
+// pool. After Close, the plan must not be used. Close is a no-op for other
+// plans and safe to call multiple times.
+func (p *PlanND[T]) Close() {
+	if p.pool == nil {
+		return
 	}
 
-	// Copy dimensions and strides
-	dims := make([]int, len(p.dims))
-	copy(dims, p.dims)
+	for _, plan := range p.plans {
+		plan.Close()
+	}
 
-	strides := make([]int, len(p.strides))
-	copy(strides, p.strides)
+	releaseWorkspacePool[T](p.scratchPool, p.pool)
 
-	return &PlanND[T]{
-		dims:           dims,
-		plans:          plans,
-		scratch:        scratch,
-		strides:        strides,
-		scratchBacking: scratchBacking,
-		options:        p.options,
-	}
+	p.pool = nil
+	p.scratchPool = nil
 }
 
 // validate checks that dst and src have the correct length for this plan.
@@ -328,12 +365,12 @@ func (p *PlanND[T]) validate(dst, src []T) error {
 
 // transformDimension applies 1D FFT along the specified dimension.
 // This extracts slices along the dimension, transforms them, and writes back.
-func (p *PlanND[T]) transformDimension(data []T, dim int, forward bool) error {
+func (p *PlanND[T]) transformDimension(data, line []T, dim int, kernel []T) error {
 	dimSize := p.dims[dim]
 	plan := p.plans[dim]
 
-	// Allocate buffer for one slice along this dimension
-	sliceData := make([]T, dimSize)
+	// Buffer for one slice along this dimension
+	sliceData := line[:dimSize]
 
 	// Total number of slices to process
 	totalSlices := p.Len() / dimSize
@@ -344,13 +381,7 @@ func (p *PlanND[T]) transformDimension(data []T, dim int, forward bool) error {
 		p.extractSlice(data, sliceData, sliceIdx, dim)
 
 		// Transform slice
-		var err error
-		if forward {
-			err = plan.InPlace(sliceData)
-		} else {
-			err = plan.InverseInPlace(sliceData)
-		}
-
+		err := plan.forwardWith(sliceData, sliceData, kernel)
 		if err != nil {
 			return err
 		}
@@ -393,80 +424,59 @@ func (p *PlanND[T]) writeSlice(data, src []T, sliceIdx, dim int) {
 	}
 }
 
-func (p *PlanND[T]) forwardSingle(dst, src []T) error {
+// transformSingle runs the forward transform of one array through the
+// unscaled axis plans and applies the normalization once, in the copy to
+// dst, like Plan2D.transformSingle.
+func (p *PlanND[T]) transformSingle(dst, src, scratch []T, inverse bool) error {
 	err := p.validate(dst, src)
 	if err != nil {
 		return err
 	}
 
-	work := p.scratch
-	copy(work, src)
-
-	for dim := len(p.dims) - 1; dim >= 0; dim-- {
-		err = p.transformDimension(work, dim, true)
-		if err != nil {
-			return err
-		}
-	}
-
-	copy(dst, work)
-
-	return nil
-}
-
-func (p *PlanND[T]) inverseSingle(dst, src []T) error {
-	err := p.validate(dst, src)
+	ws, pooled, err := acquireWorkspace[T](p.scratchPool, scratch, p.Len(), slices.Max(p.dims))
 	if err != nil {
 		return err
 	}
 
-	work := p.scratch
+	if pooled != nil {
+		defer p.scratchPool.Put(pooled)
+	}
+
+	work := ws.buf
 	copy(work, src)
 
 	for dim := len(p.dims) - 1; dim >= 0; dim-- {
-		err = p.transformDimension(work, dim, false)
+		err = p.transformDimension(work, ws.line, dim, ws.kernel)
 		if err != nil {
 			return err
 		}
 	}
 
-	copy(dst, work)
+	forwardScale, inverseScale := normalizationScales(p.options.Normalization, p.Len())
+	if inverse {
+		copyReversedScaled(dst, work, p.dims, inverseScale/float64(p.Len()))
+	} else {
+		copyScaled(dst, work, forwardScale)
+	}
 
 	return nil
 }
 
 // sliceIndexToOffset converts a linear slice index to the base offset in scratch buffer.
-// This computes the offset for the first element of the slice.
+// This computes the offset for the first element of the slice: sliceIdx is
+// decoded as row-major coordinates over all dimensions except dim, which
+// stays at 0.
 func (p *PlanND[T]) sliceIndexToOffset(sliceIdx, dim int) int {
-	// Build array of "reduced" dimensions (all dims except the transform dimension)
-	reducedDims := make([]int, 0, len(p.dims)-1)
-	for d := range len(p.dims) {
-		if d != dim {
-			reducedDims = append(reducedDims, p.dims[d])
-		}
-	}
-
-	// Convert linear sliceIdx to coordinates in reduced space
-	coords := make([]int, len(reducedDims))
-
-	remaining := sliceIdx
-	for i := len(reducedDims) - 1; i >= 0; i-- {
-		coords[i] = remaining % reducedDims[i]
-		remaining /= reducedDims[i]
-	}
-
-	// Map reduced coordinates back to full coordinates and compute offset
 	offset := 0
-	reducedIdx := 0
+	remaining := sliceIdx
 
-	for d := range len(p.dims) {
+	for d := len(p.dims) - 1; d >= 0; d-- {
 		if d == dim {
-			// This dimension is set to 0 (first element along transform axis)
 			continue
 		}
 
-		offset += coords[reducedIdx] * p.strides[d]
-		reducedIdx++
+		offset += (remaining % p.dims[d]) * p.strides[d]
+		remaining /= p.dims[d]
 	}
 
 	return offset
@@ -0,0 +1,711 @@
+package algofft
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+)
+
+// PlanNDAxes is a pre-computed FFT plan that transforms only selected axes
+// of a row-major N-dimensional array, like numpy's fftn(a, axes=...).
+//
+// The untransformed axes are run as a batch: every line along a transformed
+// axis is processed in place through the strided machinery of PlanGuru,
+// without copying the array into a scratch buffer.
+//
+// Plans are safe for concurrent use.
+//
+// The generic type parameter T must be either complex64 or complex128.
+type PlanNDAxes[T Complex] struct {
+	dims []int
+	axes []int // sorted, unique
+	guru *PlanGuru[T]
+}
+
+// NewPlanNDAxes creates a plan that transforms the listed axes of an array
+// with shape dims. Axes may be given in any order; each must be in
+// [0, len(dims)) and appear at most once.
+//
+// Example:
+//
+//	// FFT along axis 1 of a 4x64x8 tensor.
+//	plan, _ := algofft.NewPlanNDAxes[complex64]([]int{4, 64, 8}, []int{1})
+//	err := plan.Forward(dst, src)
+func NewPlanNDAxes[T Complex](dims, axes []int) (*PlanNDAxes[T], error) {
+	return NewPlanNDAxesWithOptions[T](dims, axes, PlanOptions{})
+}
+
+// NewPlanNDAxesWithOptions creates an axis-selected plan with explicit
+// planner options. Batch and Stride are ignored; Normalization is relative
+// to the product of the transformed axis lengths.
+func NewPlanNDAxesWithOptions[T Complex](dims, axes []int, opts PlanOptions) (*PlanNDAxes[T], error) {
+	sorted, err := validateAxes(dims, axes)
+	if err != nil {
+		return nil, err
+	}
+
+	guru, err := newAxesGuru[T](dims, sorted, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlanNDAxes[T]{
+		dims: slices.Clone(dims),
+		axes: sorted,
+		guru: guru,
+	}, nil
+}
+
+// Dims returns a copy of the array shape.
+func (p *PlanNDAxes[T]) Dims() []int {
+	return slices.Clone(p.dims)
+}
+
+// Axes returns a copy of the transformed axes in ascending order.
+func (p *PlanNDAxes[T]) Axes() []int {
+	return slices.Clone(p.axes)
+}
+
+// Len returns the total number of elements of the array.
+func (p *PlanNDAxes[T]) Len() int {
+	return shapeSize(p.dims)
+}
+
+// String returns a human-readable description of the PlanNDAxes for debugging.
+func (p *PlanNDAxes[T]) String() string {
+	var zero T
+
+	typeName := "complex64"
+	if _, ok := any(zero).(complex128); ok {
+		typeName = "complex128"
+	}
+
+	return fmt.Sprintf("PlanNDAxes[%s](%s, axes=%v)", typeName, formatShape(p.dims), p.axes)
+}
+
+// Forward computes the FFT along the selected axes: dst = FFT_axes(src).
+//
+// src and dst must both have exactly Len() elements. In-place operation
+// (dst == src) is supported.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if slice lengths don't match Len().
+func (p *PlanNDAxes[T]) Forward(dst, src []T) error {
+	err := p.validate(dst, src)
+	if err != nil {
+		return err
+	}
+
+	return p.guru.Forward(dst, src)
+}
+
+// Inverse computes the inverse FFT along the selected axes.
+//
+// The output is scaled according to PlanOptions.Normalization (1/N by
+// default, N = product of the transformed axis lengths).
+//
+// Returns the same errors as Forward.
+func (p *PlanNDAxes[T]) Inverse(dst, src []T) error {
+	err := p.validate(dst, src)
+	if err != nil {
+		return err
+	}
+
+	return p.guru.Inverse(dst, src)
+}
+
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanNDAxes[T]) ScratchLen() int {
+	return p.guru.ScratchLen()
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanNDAxes[T]) ForwardWithScratch(dst, src, scratch []T) error {
+	err := p.validate(dst, src)
+	if err != nil {
+		return err
+	}
+
+	return p.guru.ForwardWithScratch(dst, src, scratch)
+}
+
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanNDAxes[T]) InverseWithScratch(dst, src, scratch []T) error {
+	err := p.validate(dst, src)
+	if err != nil {
+		return err
+	}
+
+	return p.guru.InverseWithScratch(dst, src, scratch)
+}
+
+// ForwardInPlace computes the FFT along the selected axes in place.
+func (p *PlanNDAxes[T]) ForwardInPlace(data []T) error {
+	return p.Forward(data, data)
+}
+
+// InverseInPlace computes the inverse FFT along the selected axes in place.
+func (p *PlanNDAxes[T]) InverseInPlace(data []T) error {
+	return p.Inverse(data, data)
+}
+
+// Clone returns a copy of the plan. PlanNDAxes holds no per-call state, so
+// the clone shares the underlying tables.
+func (p *PlanNDAxes[T]) Clone() *PlanNDAxes[T] {
+	return &PlanNDAxes[T]{
+		dims: slices.Clone(p.dims),
+		axes: slices.Clone(p.axes),
+		guru: p.guru,
+	}
+}
+
+func (p *PlanNDAxes[T]) validate(dst, src []T) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != p.Len() || len(src) != p.Len() {
+		return ErrLengthMismatch
+	}
+
+	return nil
+}
+
+// PlanRealNDAxes is a pre-computed real FFT plan over selected axes of a
+// row-major N-dimensional array, like numpy's rfftn(a, axes=...).
+//
+// The real-to-complex transform runs along the last listed axis, as in
+// numpy, which shrinks to n/2+1 bins in the spectrum; the remaining listed axes are then
+// transformed as complex data in place on the spectrum. Untransformed axes
+// are batched.
+//
+// Plans are safe for concurrent use; line buffers are drawn from a per-plan
+// pool, or supplied by the caller through ForwardWithScratch and
+// InverseWithScratch.
+//
+// Type parameters:
+//   - F: float type (float32 or float64)
+//   - C: complex type (complex64 or complex128), must match F
+type PlanRealNDAxes[F Float, C Complex] struct {
+	dims     []int
+	specDims []int // dims with the real axis replaced by n/2+1
+	axes     []int // sorted, unique
+	realAxis int   // the last axis the caller listed
+	lines    []IODim
+	realPlan *PlanRealT[F, C]
+	guru     *PlanGuru[C] // complex axes on the spectrum; nil if only one axis
+	options  PlanOptions
+
+	scratchPool *sync.Pool // *realAxesScratch[C]; nil for WorkspaceExternal
+}
+
+// realAxesScratch is the per-call scratch of a PlanRealNDAxes.
+type realAxesScratch[C Complex] struct {
+	work    []C // inverse: spectrum after the complex axes
+	lineOut []C // gathered spectrum line
+	packed  []C // real line packed as x[2k] + i*x[2k+1]
+
+	// kernel is caller scratch for the complex plans; nil when pooled,
+	// where they use their own pools.
+	kernel []C
+}
+
+// NewPlanRealNDAxes creates a real FFT plan over the listed axes of a real
+// array with shape dims. The last listed axis is the real axis; its length
+// must be even.
+func NewPlanRealNDAxes[F Float, C Complex](dims, axes []int) (*PlanRealNDAxes[F, C], error) {
+	return NewPlanRealNDAxesWithOptions[F, C](dims, axes, PlanOptions{})
+}
+
+// NewPlanRealNDAxesWithOptions creates a real axis-selected plan with
+// explicit planner options. Batch and Stride are ignored; Normalization is
+// relative to the product of the transformed axis lengths.
+//
+// Returns ErrNotImplemented if F and C have different precisions.
+func NewPlanRealNDAxesWithOptions[F Float, C Complex](dims, axes []int, opts PlanOptions) (*PlanRealNDAxes[F, C], error) {
+	if !splitTypesMatch[F, C]() {
+		return nil, fmt.Errorf("mismatched float/complex precision: %w", ErrNotImplemented)
+	}
+
+	sorted, err := validateAxes(dims, axes)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = normalizePlanOptions(opts)
+	opts.Batch = 0
+	opts.Stride = 0
+
+	realAxis := axes[len(axes)-1]
+	n := dims[realAxis]
+
+	childOpts := opts
+	// Normalization is applied once by this plan, not per axis.
+	childOpts.Normalization = NormBackward
+
+	realPlan, err := newPlanRealTWithFeatures[F, C](n, cpu.DetectFeatures(), nil, childOpts)
+	if err != nil {
+		return nil, fmt.Errorf("real axis %d (size %d): %w", realAxis, n, err)
+	}
+
+	specDims := slices.Clone(dims)
+	specDims[realAxis] = n/2 + 1
+
+	// Lines along the real axis: every index combination of the other axes,
+	// with input strides from dims and output strides from specDims.
+	inStrides := rowMajorStrides(dims)
+	outStrides := rowMajorStrides(specDims)
+	lines := make([]IODim, 0, len(dims)-1)
+
+	for axis := range dims {
+		if axis != realAxis {
+			lines = append(lines, IODim{N: dims[axis], InStride: inStrides[axis], OutStride: outStrides[axis]})
+		}
+	}
+
+	var guru *PlanGuru[C]
+	if len(sorted) > 1 {
+		complexAxes := slices.DeleteFunc(slices.Clone(sorted), func(axis int) bool { return axis == realAxis })
+
+		guru, err = newAxesGuru[C](specDims, complexAxes, childOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p := &PlanRealNDAxes[F, C]{
+		dims:     slices.Clone(dims),
+		specDims: specDims,
+		axes:     sorted,
+		realAxis: realAxis,
+		lines:    lines,
+		realPlan: realPlan,
+		guru:     guru,
+		options:  opts,
+	}
+	p.initScratchPool()
+
+	return p, nil
+}
+
+func (p *PlanRealNDAxes[F, C]) initScratchPool() {
+	if p.options.Workspace == WorkspaceExternal {
+		return
+	}
+
+	workLen, bins, half := p.workLen(), p.realPlan.SpectrumLen(), p.realPlan.half
+
+	p.scratchPool = &sync.Pool{
+		New: func() any {
+			return &realAxesScratch[C]{
+				work:    make([]C, workLen),
+				lineOut: make([]C, bins),
+				packed:  make([]C, half),
+			}
+		},
+	}
+}
+
+// workLen returns the length of the inverse work buffer.
+func (p *PlanRealNDAxes[F, C]) workLen() int {
+	if p.guru == nil {
+		return 0
+	}
+
+	return p.SpectrumLen()
+}
+
+// acquireScratch returns the scratch of one call: carved from caller
+// scratch, or taken from the pool and also returned as pooled.
+func (p *PlanRealNDAxes[F, C]) acquireScratch(scratch []C) (realAxesScratch[C], *realAxesScratch[C], error) {
+	if scratch == nil {
+		if p.scratchPool == nil {
+			return realAxesScratch[C]{}, nil, ErrScratchRequired
+		}
+
+		pooled, _ := p.scratchPool.Get().(*realAxesScratch[C])
+
+		return *pooled, pooled, nil
+	}
+
+	workLen, bins, half := p.workLen(), p.realPlan.SpectrumLen(), p.realPlan.half
+	lineEnd := workLen + bins
+	packedEnd := lineEnd + half
+
+	return realAxesScratch[C]{
+		work:    scratch[:workLen:workLen],
+		lineOut: scratch[workLen:lineEnd:lineEnd],
+		packed:  scratch[lineEnd:packedEnd:packedEnd],
+		kernel:  scratch[packedEnd:],
+	}, nil, nil
+}
+
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanRealNDAxes[F, C]) ScratchLen() int {
+	kernelLen := p.realPlan.plan.ScratchLen()
+	if p.guru != nil {
+		kernelLen = max(kernelLen, p.guru.ScratchLen())
+	}
+
+	return p.workLen() + p.realPlan.SpectrumLen() + p.realPlan.half + kernelLen
+}
+
+// Dims returns a copy of the real array shape.
+func (p *PlanRealNDAxes[F, C]) Dims() []int {
+	return slices.Clone(p.dims)
+}
+
+// SpectrumDims returns a copy of the spectrum shape: Dims() with the
+// real axis reduced to n/2+1.
+func (p *PlanRealNDAxes[F, C]) SpectrumDims() []int {
+	return slices.Clone(p.specDims)
+}
+
+// Axes returns a copy of the transformed axes in ascending order.
+func (p *PlanRealNDAxes[F, C]) Axes() []int {
+	return slices.Clone(p.axes)
+}
+
+// Len returns the number of real input elements.
+func (p *PlanRealNDAxes[F, C]) Len() int {
+	return shapeSize(p.dims)
+}
+
+// SpectrumLen returns the number of complex spectrum elements.
+func (p *PlanRealNDAxes[F, C]) SpectrumLen() int {
+	return shapeSize(p.specDims)
+}
+
+// String returns a human-readable description of the PlanRealNDAxes for debugging.
+func (p *PlanRealNDAxes[F, C]) String() string {
+	return fmt.Sprintf("PlanRealNDAxes[%s](%s, axes=%v)", splitTypeName[F](), formatShape(p.dims), p.axes)
+}
+
+// Forward computes the real FFT along the selected axes.
+//
+// src has Len() elements in shape Dims(); dst receives SpectrumLen()
+// elements in shape SpectrumDims(). The output is scaled according to
+// PlanOptions.Normalization.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if slice lengths don't match the plan.
+func (p *PlanRealNDAxes[F, C]) Forward(dst []C, src []F) error {
+	return p.forward(dst, src, nil)
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanRealNDAxes[F, C]) ForwardWithScratch(dst []C, src []F, scratch []C) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forward(dst, src, scratch)
+}
+
+func (p *PlanRealNDAxes[F, C]) forward(dst []C, src []F, scratch []C) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != p.Len() || len(dst) != p.SpectrumLen() {
+		return ErrLengthMismatch
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.transformSize())
+
+	// Normalization is folded into the last step.
+	lineScale := scale
+	if p.guru != nil {
+		lineScale = 1
+	}
+
+	ls, pooled, err := p.acquireScratch(scratch)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.scratchPool.Put(pooled)
+	}
+
+	err = p.forwardLines(dst, src, &ls, lineScale)
+	if err != nil {
+		return err
+	}
+
+	if p.guru == nil {
+		return nil
+	}
+
+	return p.guru.execute(dst, dst, false, scale, ls.kernel)
+}
+
+// Inverse computes the inverse real FFT along the selected axes.
+//
+// src has SpectrumLen() elements in shape SpectrumDims() and is not
+// modified; dst receives Len() real elements. The output is scaled
+// according to PlanOptions.Normalization (1/N by default, N = product of
+// the transformed axis lengths).
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if slice lengths don't match the plan.
+// Returns ErrInvalidSpectrum if a DC or Nyquist bin of a line along the
+// real axis is not real under SymmetryStrict or SymmetryAuto.
+func (p *PlanRealNDAxes[F, C]) Inverse(dst []F, src []C) error {
+	return p.inverse(dst, src, nil)
+}
+
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanRealNDAxes[F, C]) InverseWithScratch(dst []F, src []C, scratch []C) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverse(dst, src, scratch)
+}
+
+func (p *PlanRealNDAxes[F, C]) inverse(dst []F, src []C, scratch []C) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != p.Len() || len(src) != p.SpectrumLen() {
+		return ErrLengthMismatch
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.transformSize())
+
+	ls, pooled, err := p.acquireScratch(scratch)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.scratchPool.Put(pooled)
+	}
+
+	if p.guru == nil {
+		return p.inverseLines(dst, src, &ls, scale)
+	}
+
+	err = p.guru.execute(ls.work, src, true, scale, ls.kernel)
+	if err != nil {
+		return err
+	}
+
+	return p.inverseLines(dst, ls.work, &ls, 1)
+}
+
+// Clone creates an independent copy of the plan with its own scratch pool.
+func (p *PlanRealNDAxes[F, C]) Clone() *PlanRealNDAxes[F, C] {
+	clone := &PlanRealNDAxes[F, C]{
+		dims:     slices.Clone(p.dims),
+		specDims: slices.Clone(p.specDims),
+		axes:     slices.Clone(p.axes),
+		realAxis: p.realAxis,
+		lines:    slices.Clone(p.lines),
+		realPlan: p.realPlan,
+		guru:     p.guru,
+		options:  p.options,
+	}
+	clone.initScratchPool()
+
+	return clone
+}
+
+// transformSize returns the product of the transformed axis lengths.
+func (p *PlanRealNDAxes[F, C]) transformSize() int {
+	size := 1
+	for _, axis := range p.axes {
+		size *= p.dims[axis]
+	}
+
+	return size
+}
+
+// realStride returns the input and output strides along the real axis.
+func (p *PlanRealNDAxes[F, C]) realStride() (int, int) {
+	return shapeSize(p.dims[p.realAxis+1:]), shapeSize(p.specDims[p.realAxis+1:])
+}
+
+// forwardLines runs the real FFT along every line of the real axis. Each
+// line is packed straight from src into ls.packed, so strided lines need no
+// separate gather buffer.
+func (p *PlanRealNDAxes[F, C]) forwardLines(dst []C, src []F, ls *realAxesScratch[C], scale float64) error {
+	bins := len(ls.lineOut)
+	inStride, outStride := p.realStride()
+
+	return forEachIOOffset(p.lines, func(inOff, outOff int) error {
+		packRealLine(ls.packed, src, inOff, inStride, scale)
+
+		line := ls.lineOut
+		if outStride == 1 {
+			line = dst[outOff : outOff+bins]
+		}
+
+		err := p.realPlan.forwardPacked(line, ls.packed, ls.kernel)
+		if err != nil {
+			return err
+		}
+
+		if outStride != 1 {
+			for k, v := range line {
+				dst[outOff+k*outStride] = v
+			}
+		}
+
+		return nil
+	})
+}
+
+func (p *PlanRealNDAxes[F, C]) inverseLines(dst []F, src []C, ls *realAxesScratch[C], scale float64) error {
+	bins := len(ls.lineOut)
+	// Input of the inverse is the spectrum; lines map spectrum -> real.
+	realStride, specStride := p.realStride()
+
+	return forEachIOOffset(p.lines, func(realOff, specOff int) error {
+		line := ls.lineOut
+		if specStride == 1 {
+			line = src[specOff : specOff+bins]
+		} else {
+			for k := range line {
+				line[k] = src[specOff+k*specStride]
+			}
+		}
+
+		err := p.realPlan.inversePacked(ls.packed, line, ls.kernel)
+		if err != nil {
+			return err
+		}
+
+		unpackRealLine(dst, realOff, realStride, ls.packed, scale)
+
+		return nil
+	})
+}
+
+// packRealLine packs the real samples src[off + i*stride] pairwise into
+// buf as buf[k] = x[2k] + i*x[2k+1], multiplied by scale.
+func packRealLine[F Float, C Complex](buf []C, src []F, off, stride int, scale float64) {
+	switch b := any(buf).(type) {
+	case []complex64:
+		s32, f := float32(scale), any(src).([]float32)
+		for k := range b {
+			i := off + 2*k*stride
+			b[k] = complex(s32*f[i], s32*f[i+stride])
+		}
+	case []complex128:
+		f := any(src).([]float64)
+		for k := range b {
+			i := off + 2*k*stride
+			b[k] = complex(scale*f[i], scale*f[i+stride])
+		}
+	}
+}
+
+// unpackRealLine is the inverse of packRealLine: it writes the pairs of buf,
+// multiplied by scale, to dst[off + i*stride].
+func unpackRealLine[F Float, C Complex](dst []F, off, stride int, buf []C, scale float64) {
+	switch b := any(buf).(type) {
+	case []complex64:
+		s32, f := float32(scale), any(dst).([]float32)
+		for k, v := range b {
+			i := off + 2*k*stride
+			f[i] = s32 * real(v)
+			f[i+stride] = s32 * imag(v)
+		}
+	case []complex128:
+		f := any(dst).([]float64)
+		for k, v := range b {
+			i := off + 2*k*stride
+			f[i] = scale * real(v)
+			f[i+stride] = scale * imag(v)
+		}
+	}
+}
+
+// newAxesGuru builds a guru plan that transforms the given sorted axes of
+// a contiguous row-major array in place and loops over all other axes.
+func newAxesGuru[T Complex](dims, axes []int, opts PlanOptions) (*PlanGuru[T], error) {
+	strides := rowMajorStrides(dims)
+	transform := make([]IODim, 0, len(axes))
+	howmany := make([]IODim, 0, len(dims)-len(axes))
+
+	for axis, n := range dims {
+		dim := IODim{N: n, InStride: strides[axis], OutStride: strides[axis]}
+		if slices.Contains(axes, axis) {
+			transform = append(transform, dim)
+		} else {
+			howmany = append(howmany, dim)
+		}
+	}
+
+	return NewPlanGuruWithOptions[T](transform, howmany, opts)
+}
+
+// validateAxes checks dims and axes and returns the axes sorted ascending.
+func validateAxes(dims, axes []int) ([]int, error) {
+	if len(dims) == 0 || len(axes) == 0 {
+		return nil, ErrInvalidLength
+	}
+
+	for i, d := range dims {
+		if d <= 0 {
+			return nil, fmt.Errorf("dimension %d has invalid size %d: %w", i, d, ErrInvalidLength)
+		}
+	}
+
+	sorted := slices.Clone(axes)
+	slices.Sort(sorted)
+
+	for i, axis := range sorted {
+		if axis < 0 || axis >= len(dims) {
+			return nil, fmt.Errorf("axis %d out of range for %d dimensions: %w", axis, len(dims), ErrInvalidLength)
+		}
+
+		if i > 0 && sorted[i-1] == axis {
+			return nil, fmt.Errorf("axis %d listed twice: %w", axis, ErrInvalidLength)
+		}
+	}
+
+	return sorted, nil
+}
+
+// rowMajorStrides returns the element strides of a contiguous row-major array.
+func rowMajorStrides(dims []int) []int {
+	strides := make([]int, len(dims))
+
+	stride := 1
+	for i := len(dims) - 1; i >= 0; i-- {
+		strides[i] = stride
+		stride *= dims[i]
+	}
+
+	return strides
+}
+
+func formatShape(dims []int) string {
+	s := ""
+
+	for i, d := range dims {
+		if i > 0 {
+			s += "x"
+		}
+
+		s += itoa(d)
+	}
+
+	return s
+}
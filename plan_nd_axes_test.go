@@ -0,0 +1,290 @@
+package algofft
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// fftAlongAxis is a reference that transforms every line along axis with a
+// 1D plan using explicit index arithmetic.
+func fftAlongAxis(t *testing.T, data []complex128, dims []int, axis int) []complex128 {
+	t.Helper()
+
+	strides := rowMajorStrides(dims)
+	out := append([]complex128(nil), data...)
+	line := make([]complex128, dims[axis])
+
+	for base := range data {
+		// Visit each line once, from its element with index 0 along axis.
+		if (base/strides[axis])%dims[axis] != 0 {
+			continue
+		}
+
+		for i := range line {
+			line[i] = data[base+i*strides[axis]]
+		}
+
+		spectrum := guruReference(t, line, false)
+		for i, v := range spectrum {
+			out[base+i*strides[axis]] = v
+		}
+	}
+
+	return out
+}
+
+func TestPlanNDAxes_MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dims []int
+		axes []int
+	}{
+		{dims: []int{4, 16, 6}, axes: []int{1}},
+		{dims: []int{4, 16, 6}, axes: []int{0}},
+		{dims: []int{4, 16, 6}, axes: []int{2}},
+		{dims: []int{3, 5, 8, 4}, axes: []int{3, 2}},
+		{dims: []int{2, 8, 1, 12}, axes: []int{0, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v/%v", tt.dims, tt.axes), func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlanNDAxes[complex128](tt.dims, tt.axes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := normalizationSignal(shapeSize(tt.dims), uint64(len(tt.axes)))
+
+			want := src
+			for _, axis := range tt.axes {
+				want = fftAlongAxis(t, want, tt.dims, axis)
+			}
+
+			dst := make([]complex128, len(src))
+			if err := plan.Forward(dst, src); err != nil {
+				t.Fatalf("Forward: %v", err)
+			}
+
+			for i := range want {
+				assertGuruNear(t, dst[i], want[i], 1e-9, "index %d", i)
+			}
+
+			if err := plan.InverseInPlace(dst); err != nil {
+				t.Fatalf("InverseInPlace: %v", err)
+			}
+
+			for i := range src {
+				assertGuruNear(t, dst[i], src[i], 1e-12, "roundtrip index %d", i)
+			}
+		})
+	}
+}
+
+func TestPlanNDAxes_AllAxesMatchesPlanND(t *testing.T) {
+	t.Parallel()
+
+	dims := []int{4, 6, 8}
+
+	plan, err := NewPlanNDAxesWithOptions[complex64](dims, []int{2, 0, 1}, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref, err := NewPlanNDWithOptions[complex64](dims, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := generateRandomNDComplex64(dims, 4)
+	want := make([]complex64, len(src))
+	got := make([]complex64, len(src))
+
+	if err := ref.Forward(want, src); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := plan.Forward(got, src); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range want {
+		assertGuruNear(t, complex128(got[i]), complex128(want[i]), 1e-4, "index %d", i)
+	}
+}
+
+func TestPlanRealNDAxes_MatchesComplex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dims []int
+		axes []int
+	}{
+		{dims: []int{16}, axes: []int{0}},
+		{dims: []int{3, 16, 5}, axes: []int{1}},
+		{dims: []int{4, 6, 8}, axes: []int{1, 2}},
+		{dims: []int{2, 8, 3, 10}, axes: []int{0, 1}},
+		{dims: []int{4, 6, 8}, axes: []int{0, 1, 2}},
+		// The last listed axis is the real axis, whatever its position
+		{dims: []int{4, 6, 8}, axes: []int{2, 0}},
+		{dims: []int{6, 5, 8}, axes: []int{2, 1, 0}},
+		{dims: []int{3, 10, 4}, axes: []int{2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v/%v", tt.dims, tt.axes), func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlanRealNDAxes[float64, complex128](tt.dims, tt.axes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			realAxis := tt.axes[len(tt.axes)-1]
+			if got, want := plan.SpectrumDims()[realAxis], tt.dims[realAxis]/2+1; got != want {
+				t.Fatalf("SpectrumDims()[%d] = %d, want %d", realAxis, got, want)
+			}
+
+			ref, err := NewPlanNDAxes[complex128](tt.dims, tt.axes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := realParts(normalizationSignal(plan.Len(), 17))
+			full := make([]complex128, plan.Len())
+
+			if err := ref.Forward(full, widenReal(src)); err != nil {
+				t.Fatal(err)
+			}
+
+			dst := make([]complex128, plan.SpectrumLen())
+			if err := plan.Forward(dst, src); err != nil {
+				t.Fatalf("Forward: %v", err)
+			}
+
+			// The spectrum is the full result truncated along the real axis.
+			specDims := plan.SpectrumDims()
+			fullStrides := rowMajorStrides(tt.dims)
+			coords := make([]int, len(specDims))
+
+			for i := range dst {
+				rem := i
+				for d := len(specDims) - 1; d >= 0; d-- {
+					coords[d] = rem % specDims[d]
+					rem /= specDims[d]
+				}
+
+				offset := 0
+				for d, c := range coords {
+					offset += c * fullStrides[d]
+				}
+
+				assertGuruNear(t, dst[i], full[offset], 1e-9, "spectrum index %d", i)
+			}
+
+			restored := make([]float64, plan.Len())
+			if err := plan.Inverse(restored, dst); err != nil {
+				t.Fatalf("Inverse: %v", err)
+			}
+
+			for i := range src {
+				assertGuruNear(t, complex(restored[i], 0), complex(src[i], 0), 1e-12, "roundtrip index %d", i)
+			}
+		})
+	}
+}
+
+func TestPlanRealNDAxes_NormalizationAndClone(t *testing.T) {
+	t.Parallel()
+
+	dims := []int{6, 8}
+
+	plan, err := NewPlanRealNDAxesWithOptions[float32, complex64](dims, []int{0, 1}, PlanOptions{Normalization: NormForward})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref, err := NewPlanNDAxesWithOptions[complex64](dims, []int{0, 1}, PlanOptions{Normalization: NormForward})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := narrowReal[float32](realParts(normalizationSignal(48, 23)))
+	full := make([]complex64, 48)
+
+	if err := ref.Forward(full, narrowSlice[complex64](widenReal(src))); err != nil {
+		t.Fatal(err)
+	}
+
+	clone := plan.Clone()
+	dst := make([]complex64, plan.SpectrumLen())
+
+	if err := clone.Forward(dst, src); err != nil {
+		t.Fatal(err)
+	}
+
+	for r := range 6 {
+		for c := range 5 {
+			assertGuruNear(t, complex128(dst[r*5+c]), complex128(full[r*8+c]), 1e-5, "bin (%d,%d)", r, c)
+		}
+	}
+
+	restored := make([]float32, 48)
+	if err := plan.Inverse(restored, dst); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range src {
+		assertGuruNear(t, complex(float64(restored[i]), 0), complex(float64(src[i]), 0), 1e-4, "roundtrip index %d", i)
+	}
+}
+
+func TestPlanNDAxes_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		dims []int
+		axes []int
+	}{
+		{name: "no axes", dims: []int{4, 4}},
+		{name: "out of range", dims: []int{4, 4}, axes: []int{2}},
+		{name: "negative", dims: []int{4, 4}, axes: []int{-1}},
+		{name: "duplicate", dims: []int{4, 4}, axes: []int{1, 1}},
+		{name: "zero dim", dims: []int{4, 0}, axes: []int{0}},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewPlanNDAxes[complex64](tt.dims, tt.axes); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("%s: complex error = %v, want ErrInvalidLength", tt.name, err)
+		}
+
+		if _, err := NewPlanRealNDAxes[float32, complex64](tt.dims, tt.axes); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("%s: real error = %v, want ErrInvalidLength", tt.name, err)
+		}
+	}
+
+	if _, err := NewPlanRealNDAxes[float32, complex64]([]int{4, 5}, []int{1}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("odd real axis error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := NewPlanRealNDAxes[float64, complex64]([]int{4}, []int{0}); !errors.Is(err, ErrNotImplemented) {
+		t.Errorf("mismatched types error = %v, want ErrNotImplemented", err)
+	}
+
+	plan, err := NewPlanNDAxes[complex64]([]int{4, 8}, []int{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := plan.Forward(make([]complex64, 32), make([]complex64, 31)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short src error = %v, want ErrLengthMismatch", err)
+	}
+
+	if got, want := plan.String(), "PlanNDAxes[complex64](4x8, axes=[1])"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
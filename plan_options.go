@@ -28,20 +28,80 @@ const (
 	PlannerPatient
 
 	// PlannerExhaustive runs thorough micro-benchmarks (warmup=10, iters=100)
-	// testing all available strategies including EightStep.
+	// testing all available strategies including EightStep and SplitRadix.
 	PlannerExhaustive
 )
 
-// WorkspacePolicy controls how executors manage scratch space.
-// Note: This feature is not yet implemented and will be added in a future release.
+// WorkspacePolicy controls who owns the scratch memory of a plan.
 type WorkspacePolicy uint8
 
 const (
-	WorkspaceAuto     WorkspacePolicy = iota // Not yet implemented
-	WorkspacePooled                          // Not yet implemented
-	WorkspaceExternal                        // Not yet implemented
+	// WorkspaceAuto lets the plan choose; currently the same as WorkspacePooled.
+	WorkspaceAuto WorkspacePolicy = iota
+
+	// WorkspacePooled gives every plan a sync.Pool of scratch buffers, so
+	// Forward and Inverse can be called concurrently without extra setup.
+	WorkspacePooled
+
+	// WorkspaceExternal makes the caller own all scratch memory. The plan
+	// keeps no scratch and no pool; transforms must go through
+	// ForwardWithScratch and InverseWithScratch with a buffer of at least
+	// ScratchLen elements, and they never allocate. Forward and Inverse
+	// return ErrScratchRequired. Split and pruned plans and resamplers
+	// follow the same rule with their own ScratchLen and *WithScratch
+	// methods.
+	WorkspaceExternal
 )
 
+// String returns the name of the workspace policy.
+func (w WorkspacePolicy) String() string {
+	switch w {
+	case WorkspaceAuto:
+		return "auto"
+	case WorkspacePooled:
+		return "pooled"
+	case WorkspaceExternal:
+		return "external"
+	default:
+		return "unknown"
+	}
+}
+
+// Normalization describes how forward and inverse transforms are scaled.
+// The names follow the numpy.fft "norm" argument.
+type Normalization uint8
+
+const (
+	// NormBackward leaves the forward transform unscaled and scales the
+	// inverse by 1/n (the default).
+	NormBackward Normalization = iota
+
+	// NormOrtho scales both directions by 1/sqrt(n), making the transform unitary.
+	NormOrtho
+
+	// NormForward scales the forward transform by 1/n and leaves the inverse unscaled.
+	NormForward
+
+	// NormNone leaves both directions unscaled, so a round trip multiplies by n.
+	NormNone
+)
+
+// String returns the numpy-style name of the normalization.
+func (n Normalization) String() string {
+	switch n {
+	case NormBackward:
+		return "backward"
+	case NormOrtho:
+		return "ortho"
+	case NormForward:
+		return "forward"
+	case NormNone:
+		return "none"
+	default:
+		return "unknown"
+	}
+}
+
 // PlanOptions controls planning decisions and execution layout.
 type PlanOptions struct {
 	// Planner controls how much work the planner does to choose kernels.
@@ -52,13 +112,21 @@ type PlanOptions struct {
 	// to let the planner choose based on size and benchmarks.
 	Strategy KernelStrategy
 
-	// Radices hints at which radices to prefer for mixed-radix FFT.
+	// Radices forces a mixed-radix factorization, outermost stage first,
+	// e.g. []int{4, 4, 4, 2} for 128 or []int{8, 5, 3} for 120. Every radix
+	// must be in [2, MaxRadix] and their product must equal the transform
+	// size; otherwise plan creation returns ErrInvalidRadices. The schedule
+	// replaces codelets and the planner search, and Plan.Algorithm and
+	// PlanMeta.Radices report it. It applies to 1-D complex plans;
+	// multi-dimensional, real, guru and pruned plans ignore it.
 	Radices []int
 
 	// Batch specifies the number of transforms to execute in a batch.
 	Batch int
 
 	// Stride specifies the stride between consecutive elements.
+	// It applies to input and output alike; use PlanGuru for independent
+	// input/output strides and distances.
 	Stride int
 
 	// InPlace enables in-place transforms when possible.
@@ -70,9 +138,39 @@ type PlanOptions struct {
 	// to skip benchmarking for previously-measured sizes.
 	Wisdom WisdomStore
 
-	// Workspace controls how executors manage scratch space.
-	// Note: This feature is not yet implemented.
+	// Workspace controls who owns scratch memory. Default is WorkspaceAuto;
+	// WorkspaceExternal requires the *WithScratch methods.
 	Workspace WorkspacePolicy
+
+	// Symmetry controls how complex-to-real inverses of real plans treat
+	// spectra that are not exactly Hermitian. Default is SymmetryAuto.
+	Symmetry SymmetryPolicy
+
+	// SymmetryTolerance is the relative tolerance of SymmetryStrict. Zero
+	// selects 1e-4 for float32 plans and 1e-10 for float64 plans.
+	SymmetryTolerance float64
+
+	// Accuracy selects how twiddle factors are generated. Default is
+	// AccuracyDefault; see EstimatedErrorBound for the resulting error.
+	// Deterministic plans always use AccuracyAccurate.
+	Accuracy Accuracy
+
+	// Deterministic makes results bit-identical for a given size, precision
+	// and options on every amd64, arm64 and generic build. Plans then skip
+	// codelets, SIMD kernels, the planner and wisdom, and run a pure Go
+	// kernel with a fixed operation order, twiddle tables computed with
+	// plain IEEE arithmetic (AccuracyAccurate), and no fused multiply-add
+	// contraction. Strategy is ignored; Radices still selects the schedule.
+	// This holds for complex, real, split-complex, multi-dimensional and
+	// guru plans; pruned and sparse plans are not covered. Deterministic
+	// plans are slower than the default ones.
+	Deterministic bool
+
+	// Normalization selects how Forward and Inverse are scaled, like numpy's
+	// norm= argument. Default is NormBackward (inverse scaled by 1/n), where n
+	// is the total number of elements of one transform. The factor is folded
+	// into a pass the plan already makes over the data.
+	Normalization Normalization
 }
 
 // WisdomStore persists planner decisions for reuse.
@@ -127,21 +225,9 @@ func normalizePlanOptions(opts PlanOptions) PlanOptions {
 		opts.Stride = 0 // 0 means use default stride
 	}
 
-	// Normalize radices: drop invalid entries (<= 1)
-	// If none remain, fall back to planner defaults by clearing the slice
-	if len(opts.Radices) > 0 {
-		validRadices := opts.Radices[:0]
-		for _, r := range opts.Radices {
-			if r > 1 {
-				validRadices = append(validRadices, r)
-			}
-		}
-
-		if len(validRadices) == 0 {
-			opts.Radices = nil
-		} else {
-			opts.Radices = validRadices
-		}
+	// Deterministic plans need twiddle tables that are the same everywhere
+	if opts.Deterministic {
+		opts.Accuracy = AccuracyAccurate
 	}
 
 	return opts
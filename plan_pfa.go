@@ -0,0 +1,108 @@
+package algofft
+
+import (
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// initPFA sets up the Good-Thomas prime-factor algorithm for p.n = n1*n2:
+// the index maps and one plan per coprime factor. The factor plans are
+// chosen by the same planner as p, so they run on codelets, the mixed-radix
+// kernel, Rader, Bluestein or a nested PFA. Factors with a radix butterfly
+// run it directly on each line instead of their plan.
+func (p *Plan[T]) initPFA(features cpu.Features, opts PlanOptions) error {
+	n1, n2 := fft.PFAFactors(p.n)
+
+	p.pfaInput, p.pfaOutput = fft.PFAIndices(n1, n2)
+	if p.pfaInput == nil {
+		return ErrInvalidLength
+	}
+
+	// The forced strategy applies to p; the factors pick their own.
+	opts.Strategy = KernelAuto
+
+	plan1, err := newChildPlan[T](n1, features, nil, opts)
+	if err != nil {
+		return err
+	}
+
+	plan2, err := newChildPlan[T](n2, features, nil, opts)
+	if err != nil {
+		return err
+	}
+
+	p.pfaPlan1, p.pfaPlan2 = plan1, plan2
+	p.pfaModule1, p.pfaModule2 = fft.PFAModule[T](n1), fft.PFAModule[T](n2)
+
+	return nil
+}
+
+// pfaScratchLen returns the scratch the prime-factor algorithm needs after
+// its n-element work matrix: one line of either factor followed by the
+// factor plans' scratch.
+func (p *Plan[T]) pfaScratchLen() int {
+	n1, n2 := p.pfaPlan1.n, p.pfaPlan2.n
+
+	return max(n1, n2) + max(p.pfaPlan1.ScratchLen(), p.pfaPlan2.ScratchLen())
+}
+
+// pfaTransform computes the DFT of size n1*n2 with the prime-factor
+// algorithm, one line at a time: each size-n1 line is gathered from src
+// through the Ruritanian map, transformed and stored as a column of the
+// n1 x n2 work matrix, then each row of the matrix is transformed in place
+// and written straight to its CRT-mapped bins of dst. No twiddle factors
+// are applied between the two passes. The inverse runs the forward factor
+// transforms and writes the reversed bins, so the last pass applies the
+// whole normalization in one rounding. childScratch holds the line buffer
+// and the factor plans' scratch, as laid out by pfaScratchLen.
+func (p *Plan[T]) pfaTransform(dst, src, scratch, childScratch []T, inverse bool, scale float64) error {
+	n1, n2 := p.pfaPlan1.n, p.pfaPlan2.n
+	work := scratch[:p.n]
+	line := childScratch[:n1]
+	lineScratch := childScratch[max(n1, n2):]
+
+	if inverse {
+		scale /= float64(p.n)
+	}
+
+	for j2 := range n2 {
+		fft.PFAGather(line, src, p.pfaInput[j2*n1:(j2+1)*n1])
+
+		err := pfaLine(p.pfaPlan1, p.pfaModule1, line, lineScratch)
+		if err != nil {
+			return err
+		}
+
+		fft.PFAStoreColumn(work, line, j2, n2)
+	}
+
+	factor := m.ComplexFromFloat64[T](scale, 0)
+
+	for k1 := range n1 {
+		row := work[k1*n2 : (k1+1)*n2]
+
+		err := pfaLine(p.pfaPlan2, p.pfaModule2, row, lineScratch)
+		if err != nil {
+			return err
+		}
+
+		fft.PFAScatterScaled(dst, row, p.pfaOutput[k1*n2:(k1+1)*n2], factor, inverse)
+	}
+
+	return nil
+}
+
+// pfaLine runs the unscaled forward transform of one factor on line in
+// place, with the radix butterfly module if there is one and the factor
+// plan on scratch otherwise.
+func pfaLine[T Complex](plan *Plan[T], module func([]T), line, scratch []T) error {
+	if module != nil {
+		module(line)
+		return nil
+	}
+
+	kernelScratch, bsScratch := plan.splitScratch(scratch)
+
+	return plan.forwardBuffers(line, line, kernelScratch, bsScratch, 1)
+}
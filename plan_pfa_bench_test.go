@@ -0,0 +1,51 @@
+package algofft
+
+import (
+	"fmt"
+	"testing"
+)
+
+// pfaBenchSizes mixes 13-smooth sizes, where PFA competes with the
+// mixed-radix kernel, and sizes whose factors need Rader.
+var pfaBenchSizes = []int{15, 63, 120, 240, 360, 1001, 1008, 6720, 3 * 17 * 19, 64 * 17}
+
+// BenchmarkPFA compares the prime-factor algorithm with the planner's
+// default choice for the same size.
+func BenchmarkPFA(b *testing.B) {
+	for _, n := range pfaBenchSizes {
+		for _, tc := range []struct {
+			name     string
+			strategy KernelStrategy
+		}{
+			{"pfa", KernelPFA},
+			{"auto", KernelAuto},
+		} {
+			b.Run(fmt.Sprintf("N=%d/%s", n, tc.name), func(b *testing.B) {
+				benchmarkStrategyForward(b, n, tc.strategy)
+			})
+		}
+	}
+}
+
+func benchmarkStrategyForward(b *testing.B, n int, strategy KernelStrategy) {
+	b.Helper()
+
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Strategy: strategy})
+	if err != nil {
+		b.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	src := toComplex64(randomComplex128(n, uint64(n)))
+	dst := make([]complex64, n)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(n * 8))
+	b.ResetTimer()
+
+	for range b.N {
+		err := plan.Forward(dst, src)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package algofft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strings"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+func TestNewPlan_PFA(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n        int
+		strategy KernelStrategy
+	}{
+		{6, KernelPFA},
+		{15, KernelPFA},
+		{51, KernelAuto},
+		{76, KernelAuto},
+		{94, KernelPFA},
+		{360, KernelPFA},
+		{833, KernelAuto},
+		{969, KernelAuto},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("N=%d", tt.n), func(t *testing.T) {
+			t.Parallel()
+
+			opts := PlanOptions{Strategy: tt.strategy}
+
+			plan64, err := NewPlanWithOptions[complex64](tt.n, opts)
+			if err != nil {
+				t.Fatalf("NewPlan[complex64](%d) failed: %v", tt.n, err)
+			}
+
+			plan128, err := NewPlanWithOptions[complex128](tt.n, opts)
+			if err != nil {
+				t.Fatalf("NewPlan[complex128](%d) failed: %v", tt.n, err)
+			}
+
+			if plan128.KernelStrategy() != KernelPFA {
+				t.Fatalf("KernelStrategy() = %v, want %v", plan128.KernelStrategy(), KernelPFA)
+			}
+
+			src := randomComplex128(tt.n, uint64(tt.n))
+
+			dst := make([]complex128, tt.n)
+			if err := plan128.Forward(dst, src); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "forward128", dst, reference.NaiveDFT128(src), 1e-9)
+
+			back := make([]complex128, tt.n)
+			if err := plan128.Inverse(back, dst); err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "inverse128", back, src, 1e-12)
+
+			src32 := toComplex64(src)
+
+			dst32 := make([]complex64, tt.n)
+			if err := plan64.Forward(dst32, src32); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "forward64", dst32, reference.NaiveDFT(src32), 1e-3)
+
+			inPlace := append([]complex64(nil), dst32...)
+			if err := plan64.InverseInPlace(inPlace); err != nil {
+				t.Fatalf("InverseInPlace failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "inverse64", inPlace, src32, 1e-4)
+		})
+	}
+}
+
+func TestNewPlan_PFASelection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		n        int
+		strategy KernelStrategy
+		want     KernelStrategy
+	}{
+		{"coprime Rader primes", 3 * 17 * 19, KernelAuto, KernelPFA},
+		{"power of two and Rader prime", 64 * 17, KernelAuto, KernelPFA},
+		{"factor needing Bluestein", 2 * 47, KernelAuto, KernelBluestein},
+		{"prime power", 17 * 17, KernelAuto, KernelBluestein},
+		{"13-smooth size with butterfly factors", 1001, KernelAuto, KernelPFA},
+		{"13-smooth size with prime power factor", 63, KernelAuto, KernelDIT},
+		{"forced Bluestein", 3 * 17 * 19, KernelBluestein, KernelBluestein},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlanWithOptions[complex64](tt.n, PlanOptions{Strategy: tt.strategy})
+			if err != nil {
+				t.Fatalf("NewPlan(%d) failed: %v", tt.n, err)
+			}
+
+			if plan.KernelStrategy() != tt.want {
+				t.Errorf("KernelStrategy() = %v, want %v", plan.KernelStrategy(), tt.want)
+			}
+
+			if tt.want == KernelPFA {
+				if plan.Algorithm() != "pfa" {
+					t.Errorf("Algorithm() = %q, want %q", plan.Algorithm(), "pfa")
+				}
+
+				if !strings.Contains(plan.String(), "PFA") {
+					t.Errorf("String() = %q, want it to mention PFA", plan.String())
+				}
+			}
+		})
+	}
+
+	// A forced PFA without coprime factors is ignored.
+	plan, err := NewPlanWithOptions[complex64](64, PlanOptions{Strategy: KernelPFA})
+	if err != nil {
+		t.Fatalf("NewPlan(64) failed: %v", err)
+	}
+
+	if plan.KernelStrategy() == KernelPFA {
+		t.Errorf("KernelStrategy() = %v, want a power-of-two kernel", plan.KernelStrategy())
+	}
+}
+
+func TestNewPlan_PFALarge(t *testing.T) {
+	t.Parallel()
+
+	const n = 3 * 5 * 17 * 64
+
+	plan, err := NewPlanT[complex128](n)
+	if err != nil {
+		t.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	bluestein, err := NewPlanWithOptions[complex128](n, PlanOptions{
+		Strategy: KernelBluestein,
+		Accuracy: AccuracyAccurate,
+	})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) Bluestein failed: %v", n, err)
+	}
+
+	src := randomComplex128(n, 16320)
+	got := make([]complex128, n)
+	want := make([]complex128, n)
+
+	if err := plan.Forward(got, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if err := bluestein.Forward(want, src); err != nil {
+		t.Fatalf("Bluestein Forward failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "forward", got, want, 1e-9)
+
+	if err := plan.InPlace(got); err != nil {
+		t.Fatalf("InPlace failed: %v", err)
+	}
+
+	if err := bluestein.InPlace(want); err != nil {
+		t.Fatalf("Bluestein InPlace failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "in-place", got, want, 1e-6)
+}
+
+func TestNewPlan_PFAMeasureRecordsWisdom(t *testing.T) {
+	t.Parallel()
+
+	const n = 76
+
+	wisdom := &mapWisdom{entries: make(map[WisdomKey]WisdomEntry)}
+
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Planner: PlannerMeasure, Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	strategy := plan.KernelStrategy()
+	if strategy != KernelPFA && strategy != KernelBluestein {
+		t.Fatalf("KernelStrategy() = %v, want PFA or Bluestein", strategy)
+	}
+
+	// The factor plans record their own entries.
+	recorded := false
+
+	for key, entry := range wisdom.entries {
+		if key.Size == n {
+			recorded = entry.Algorithm == plan.Algorithm()
+		}
+	}
+
+	if !recorded {
+		t.Fatalf("wisdom %v has no %q entry for size %d", wisdom.entries, plan.Algorithm(), n)
+	}
+
+	replay, err := NewPlanWithOptions[complex64](n, PlanOptions{Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) with wisdom failed: %v", n, err)
+	}
+
+	if replay.KernelStrategy() != strategy || replay.Algorithm() != plan.Algorithm() {
+		t.Errorf("wisdom plan = %v/%q, want %v/%q",
+			replay.KernelStrategy(), replay.Algorithm(), strategy, plan.Algorithm())
+	}
+}
+
+func TestNewPlan_PFAScratchAndClone(t *testing.T) {
+	t.Parallel()
+
+	const n = 969
+
+	plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Workspace: WorkspaceExternal})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	if plan.KernelStrategy() != KernelPFA {
+		t.Fatalf("KernelStrategy() = %v, want %v", plan.KernelStrategy(), KernelPFA)
+	}
+
+	src := randomComplex128(n, 969)
+	want := reference.NaiveDFT128(src)
+	scratch := make([]complex128, plan.ScratchLen())
+
+	dst := make([]complex128, n)
+	if err := plan.ForwardWithScratch(dst, src, scratch); err != nil {
+		t.Fatalf("ForwardWithScratch failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "scratch", dst, want, 1e-9)
+
+	back := make([]complex128, n)
+	if err := plan.InverseWithScratch(back, dst, scratch); err != nil {
+		t.Fatalf("InverseWithScratch failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "roundtrip", back, src, 1e-12)
+
+	clone := plan.Clone()
+	cloneDst := make([]complex128, n)
+
+	if err := clone.ForwardWithScratch(cloneDst, src, make([]complex128, clone.ScratchLen())); err != nil {
+		t.Fatalf("clone ForwardWithScratch failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "clone", cloneDst, want, 1e-9)
+}
+
+func TestNewPlan_PFANormalizationAndBound(t *testing.T) {
+	t.Parallel()
+
+	const n = 51
+
+	plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	src := randomComplex128(n, 51)
+	want := reference.NaiveDFT128(src)
+
+	scale := complex(1/math.Sqrt(n), 0)
+	for i := range want {
+		want[i] *= scale
+	}
+
+	dst := make([]complex128, n)
+	if err := plan.Forward(dst, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "ortho", dst, want, 1e-12)
+
+	var diff, norm float64
+
+	for i := range dst {
+		d := cmplx.Abs(dst[i] - want[i])
+		diff += d * d
+		norm += cmplx.Abs(want[i]) * cmplx.Abs(want[i])
+	}
+
+	relErr := math.Sqrt(diff / norm)
+	if bound := plan.EstimatedErrorBound(); bound <= 0 || relErr > bound {
+		t.Errorf("relative error %g exceeds EstimatedErrorBound %g", relErr, bound)
+	}
+}
@@ -142,7 +142,7 @@ func TestPlan_Close_Pooled(t *testing.T) {
 func TestPlanPooled_InvalidLength(t *testing.T) {
 	t.Parallel()
 
-	_, err := NewPlanPooled[complex64](98) // Includes unsupported prime factor
+	_, err := NewPlanPooled[complex64](2 * 17) // Includes unsupported prime factor
 	if !errors.Is(err, ErrInvalidLength) {
 		t.Errorf("expected ErrInvalidLength, got %v", err)
 	}
@@ -291,6 +291,293 @@ func TestPlan_Clone_Complex128(t *testing.T) {
 	}
 }
 
+func TestPlansFromPool_MatchUnpooled(t *testing.T) {
+	t.Parallel()
+
+	pool := NewBufferPool(0)
+
+	complexCases := []struct {
+		name   string
+		pooled func() (ComplexTransformer[complex64], func(), error)
+		plain  func() (ComplexTransformer[complex64], error)
+	}{
+		{
+			name: "Plan2D",
+			pooled: func() (ComplexTransformer[complex64], func(), error) {
+				p, err := NewPlan2DFromPool[complex64](8, 12, pool)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				return p, p.Close, nil
+			},
+			plain: func() (ComplexTransformer[complex64], error) { return NewPlan2D[complex64](8, 12) },
+		},
+		{
+			name: "Plan3D",
+			pooled: func() (ComplexTransformer[complex64], func(), error) {
+				p, err := NewPlan3DFromPool[complex64](4, 6, 8, pool)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				return p, p.Close, nil
+			},
+			plain: func() (ComplexTransformer[complex64], error) { return NewPlan3D[complex64](4, 6, 8) },
+		},
+		{
+			name: "PlanND",
+			pooled: func() (ComplexTransformer[complex64], func(), error) {
+				// 7 is a Bluestein size and falls back to regular allocation.
+				p, err := NewPlanNDFromPool[complex64]([]int{2, 7, 16}, pool)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				return p, p.Close, nil
+			},
+			plain: func() (ComplexTransformer[complex64], error) { return NewPlanND[complex64]([]int{2, 7, 16}) },
+		},
+	}
+
+	for _, tc := range complexCases {
+		pooled, closePlan, err := tc.pooled()
+		if err != nil {
+			t.Fatalf("%s: pooled constructor: %v", tc.name, err)
+		}
+
+		plain, err := tc.plain()
+		if err != nil {
+			t.Fatalf("%s: constructor: %v", tc.name, err)
+		}
+
+		src := narrowSlice[complex64](normalizationSignal(plain.Len(), 3))
+		want := make([]complex64, plain.Len())
+		got := make([]complex64, plain.Len())
+
+		if err := plain.Forward(want, src); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := pooled.Forward(got, src); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range want {
+			assertGuruNear(t, complex128(got[i]), complex128(want[i]), 1e-4, "%s index %d", tc.name, i)
+		}
+
+		closePlan()
+		closePlan() // Close is idempotent
+	}
+
+	realCases := []struct {
+		name   string
+		pooled func() (RealTransformer[float32, complex64], func(), error)
+		plain  func() (RealTransformer[float32, complex64], error)
+	}{
+		{
+			name: "PlanReal",
+			pooled: func() (RealTransformer[float32, complex64], func(), error) {
+				p, err := NewPlanRealFromPool(64, pool)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				return p, p.Close, nil
+			},
+			plain: func() (RealTransformer[float32, complex64], error) { return NewPlanReal(64) },
+		},
+		{
+			name: "PlanRealT",
+			pooled: func() (RealTransformer[float32, complex64], func(), error) {
+				p, err := NewPlanRealTFromPool[float32, complex64](48, pool)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				return p, p.Close, nil
+			},
+			plain: func() (RealTransformer[float32, complex64], error) { return NewPlanRealT[float32, complex64](48) },
+		},
+		{
+			name: "PlanReal2D",
+			pooled: func() (RealTransformer[float32, complex64], func(), error) {
+				p, err := NewPlanReal2DFromPool(6, 16, pool)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				return p, p.Close, nil
+			},
+			plain: func() (RealTransformer[float32, complex64], error) { return NewPlanReal2D(6, 16) },
+		},
+		{
+			name: "PlanReal3D",
+			pooled: func() (RealTransformer[float32, complex64], func(), error) {
+				p, err := NewPlanReal3DFromPool(2, 4, 8, pool)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				return p, p.Close, nil
+			},
+			plain: func() (RealTransformer[float32, complex64], error) { return NewPlanReal3D(2, 4, 8) },
+		},
+	}
+
+	for _, tc := range realCases {
+		pooled, closePlan, err := tc.pooled()
+		if err != nil {
+			t.Fatalf("%s: pooled constructor: %v", tc.name, err)
+		}
+
+		plain, err := tc.plain()
+		if err != nil {
+			t.Fatalf("%s: constructor: %v", tc.name, err)
+		}
+
+		src := narrowReal[float32](realParts(normalizationSignal(plain.Len(), 5)))
+		want := make([]complex64, plain.SpectrumLen())
+		got := make([]complex64, plain.SpectrumLen())
+
+		if err := plain.Forward(want, src); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := pooled.Forward(got, src); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range want {
+			assertGuruNear(t, complex128(got[i]), complex128(want[i]), 1e-4, "%s bin %d", tc.name, i)
+		}
+
+		restored := make([]float32, plain.Len())
+		if err := pooled.Inverse(restored, got); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range src {
+			assertGuruNear(t, complex(float64(restored[i]), 0), complex(float64(src[i]), 0), 1e-4, "%s index %d", tc.name, i)
+		}
+
+		closePlan()
+		closePlan()
+	}
+}
+
+func TestPlan2DFromPool_CloseRecyclesBuffers(t *testing.T) {
+	t.Parallel()
+
+	pool := NewBufferPool(0)
+	src := make([]complex128, 16*32)
+	dst := make([]complex128, 16*32)
+
+	plan, err := NewPlan2DFromPool[complex128](16, 32, pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := plan.Forward(dst, src); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := pool.IdleBytes(); got != 0 {
+		t.Errorf("idle bytes while plan is open = %d, want 0", got)
+	}
+
+	plan.Close()
+
+	if pool.IdleBytes() == 0 {
+		t.Fatal("Close returned no buffers to the pool")
+	}
+
+	before := pool.Stats()
+
+	plan, err = NewPlan2DFromPool[complex128](16, 32, pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plan.Close()
+
+	after := pool.Stats()
+
+	var misses, hits uint64
+	for i, class := range after.Classes {
+		misses += class.Misses - before.Classes[i].Misses
+		hits += class.Hits - before.Classes[i].Hits
+	}
+
+	if misses != 0 || hits == 0 {
+		t.Errorf("rebuilding the plan: %d hits, %d misses; want only hits", hits, misses)
+	}
+}
+
+func TestPlansFromPool_ConcurrentUse(t *testing.T) {
+	t.Parallel()
+
+	pool := NewBufferPool(0)
+
+	plan2D, err := NewPlan2DFromPool[complex64](12, 16, pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plan2D.Close()
+
+	plan1D, err := NewPlanFromPool[complex64](256, pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plan1D.Close()
+
+	realPlan, err := NewPlanReal2DFromPool(8, 16, pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer realPlan.Close()
+
+	testSharedComplexTransformer(t, plan2D, 8, 20)
+	testSharedComplexTransformer(t, plan1D, 8, 20)
+	testSharedRealTransformer(t, realPlan, 8, 20)
+}
+
+func TestBufferPool_Public(t *testing.T) {
+	t.Parallel()
+
+	pool := NewBufferPool(1 << 10)
+	if got := pool.MaxBytes(); got != 1<<10 {
+		t.Errorf("MaxBytes() = %d, want %d", got, 1<<10)
+	}
+
+	if DefaultBufferPool().MaxBytes() != DefaultBufferPoolMaxBytes {
+		t.Errorf("default pool cap = %d, want %d", DefaultBufferPool().MaxBytes(), DefaultBufferPoolMaxBytes)
+	}
+
+	// A 1024-point complex64 plan needs more than 1 KiB per buffer, so
+	// nothing is kept after Close.
+	plan, err := NewPlanFromPool[complex64](1024, pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan.Close()
+
+	stats := pool.Stats()
+	if stats.IdleBytes != 0 {
+		t.Errorf("idle bytes = %d, want 0 under a 1 KiB cap", stats.IdleBytes)
+	}
+
+	var evictions uint64
+	for _, class := range stats.Classes {
+		evictions += class.Evictions
+	}
+
+	if evictions == 0 {
+		t.Error("expected oversized buffers to be counted as evictions")
+	}
+}
+
 // contains checks if substr is in s (simple implementation to avoid strings import).
 func contains(s, substr string) bool {
 	for i := 0; i <= len(s)-len(substr); i++ {
@@ -0,0 +1,535 @@
+package algofft
+
+import (
+	"fmt"
+	"math/bits"
+	"sync"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// BinRange selects a contiguous range of output bins [Start, Start+Count).
+// The range wraps around modulo the transform length, so a range that starts
+// near the end of the spectrum continues at bin 0 (useful for bins around DC).
+type BinRange struct {
+	Start int
+	Count int
+}
+
+// prunedMode identifies the decomposition chosen for a pruned plan.
+type prunedMode uint8
+
+const (
+	prunedFull   prunedMode = iota // Full transform, kept bins are copied out
+	prunedInput                    // Sparse-input decomposition (skips zero inputs)
+	prunedOutput                   // Subset-of-outputs decomposition (skips unused outputs)
+	prunedDirect                   // Direct DFT evaluation of the kept bins
+)
+
+// String returns a human-readable name for the pruning mode.
+func (mode prunedMode) String() string {
+	switch mode {
+	case prunedFull:
+		return "full"
+	case prunedInput:
+		return "input"
+	case prunedOutput:
+		return "output"
+	case prunedDirect:
+		return "direct"
+	default:
+		return "unknown"
+	}
+}
+
+// prunedGroup collects the output bins produced by one sub-transform of the
+// sparse-input decomposition: all bins k with k mod p == k1.
+type prunedGroup struct {
+	k1     int   // Residue class handled by this group
+	dstIdx []int // Output positions in dst
+	k2     []int // Corresponding bin index within the size-q sub-transform
+}
+
+// PlanPruned is a pre-computed pruned FFT plan.
+//
+// A pruned plan computes a subset of the bins of a length-n forward FFT whose
+// input is zero beyond the first inputLen samples. Butterflies that only touch
+// known-zero inputs or produce discarded outputs are skipped, so the cost
+// scales with the amount of work that actually contributes to the kept bins.
+//
+// The kept bins match Plan.Forward on the zero-padded input.
+// Plans are safe for concurrent use; scratch is drawn from a per-plan pool
+// or supplied by the caller with ForwardWithScratch.
+//
+// The generic type parameter T must be either complex64 or complex128.
+type PlanPruned[T Complex] struct {
+	n        int
+	inputLen int
+	out      BinRange
+	mode     prunedMode
+
+	// Decomposition n = p*q (input/output modes); sub transforms have size q.
+	p, q int
+	sub  *Plan[T]
+
+	// twiddle holds W_n^k for k = 0..n-1 (unused in full mode).
+	twiddle []T
+
+	// groups lists the sub-transforms needed in input mode.
+	groups []prunedGroup
+
+	// scale is the forward normalization factor, applied to the kept bins
+	// as they are written to dst.
+	scale T
+
+	// scratchPool provides per-call buffers of prunedScratchLen elements.
+	// It is nil for WorkspaceExternal plans.
+	scratchPool *sync.Pool
+}
+
+// NewPlanPruned creates a pruned forward FFT plan of length n.
+//
+// Only the first inputLen input samples may be non-zero; the remaining
+// n-inputLen samples are implicitly zero and are not passed to Forward.
+// outputRange selects the bins that Forward computes.
+//
+// Example:
+//
+//	// 256 non-zero samples at length 65536, first 100 bins only.
+//	plan, err := NewPlanPruned[complex64](65536, 256, BinRange{Start: 0, Count: 100})
+func NewPlanPruned[T Complex](n, inputLen int, outputRange BinRange) (*PlanPruned[T], error) {
+	return NewPlanPrunedWithOptions[T](n, inputLen, outputRange, PlanOptions{})
+}
+
+// NewPlanPrunedWithOptions creates a pruned FFT plan with explicit planner options.
+// The options apply to the inner sub-transform plan; opts.Normalization
+// scales the kept bins as for a length-n Plan.
+func NewPlanPrunedWithOptions[T Complex](n, inputLen int, outputRange BinRange, opts PlanOptions) (*PlanPruned[T], error) {
+	if n < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	if inputLen < 1 || inputLen > n {
+		return nil, fmt.Errorf("pruned input length %d outside [1, %d]: %w", inputLen, n, ErrInvalidLength)
+	}
+
+	if outputRange.Count < 1 || outputRange.Count > n || outputRange.Start < 0 || outputRange.Start >= n {
+		return nil, fmt.Errorf("pruned output range %+v invalid for length %d: %w", outputRange, n, ErrInvalidLength)
+	}
+
+	opts = normalizePlanOptions(opts)
+	opts.Batch = 0
+	opts.Stride = 0
+	opts.Radices = nil
+	forwardScale, _ := normalizationScales(opts.Normalization, n)
+	opts.Normalization = NormBackward
+
+	mode, p, q := choosePrunedDecomposition(n, inputLen, outputRange.Count)
+
+	plan := &PlanPruned[T]{
+		n:        n,
+		inputLen: inputLen,
+		out:      outputRange,
+		mode:     mode,
+		p:        p,
+		q:        q,
+		scale:    m.ComplexFromFloat64[T](forwardScale, 0),
+	}
+
+	subLen := q
+	if mode == prunedFull {
+		subLen = n
+	}
+
+	if mode != prunedDirect {
+		sub, err := newPlanWithFeatures[T](subLen, cpu.DetectFeatures(), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.sub = sub
+	}
+
+	if mode != prunedFull {
+		plan.twiddle = fft.ComputeTwiddleFactors[T](n)
+	}
+
+	if mode == prunedInput {
+		plan.groups = buildPrunedGroups(n, p, outputRange)
+	}
+
+	if opts.Workspace != WorkspaceExternal {
+		scratchLen := prunedScratchLen(mode, n, q)
+		plan.scratchPool = &sync.Pool{
+			New: func() any {
+				buf := make([]T, scratchLen)
+				return &buf
+			},
+		}
+	}
+
+	return plan, nil
+}
+
+// NewPlanPruned32 creates a pruned FFT plan using complex64 precision.
+func NewPlanPruned32(n, inputLen int, outputRange BinRange) (*PlanPruned[complex64], error) {
+	return NewPlanPruned[complex64](n, inputLen, outputRange)
+}
+
+// NewPlanPruned64 creates a pruned FFT plan using complex128 precision.
+func NewPlanPruned64(n, inputLen int, outputRange BinRange) (*PlanPruned[complex128], error) {
+	return NewPlanPruned[complex128](n, inputLen, outputRange)
+}
+
+// Len returns the (unpruned) transform length n.
+func (p *PlanPruned[T]) Len() int {
+	return p.n
+}
+
+// InputLen returns the number of leading input samples that may be non-zero.
+func (p *PlanPruned[T]) InputLen() int {
+	return p.inputLen
+}
+
+// OutputRange returns the range of bins computed by Forward.
+func (p *PlanPruned[T]) OutputRange() BinRange {
+	return p.out
+}
+
+// String returns a human-readable description of the PlanPruned for debugging.
+func (p *PlanPruned[T]) String() string {
+	var zero T
+
+	typeName := "complex64"
+	if _, ok := any(zero).(complex128); ok {
+		typeName = "complex128"
+	}
+
+	return fmt.Sprintf("PlanPruned[%s](%d, in=%d, out=%d+%d, %s)",
+		typeName, p.n, p.inputLen, p.out.Start, p.out.Count, p.mode)
+}
+
+// Forward computes the kept bins of the forward FFT of the zero-padded input.
+//
+// src must have length InputLen() and holds the leading non-zero samples.
+// dst must have length OutputRange().Count; dst[i] receives bin
+// (Start+i) mod n.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if slice lengths don't match the plan.
+func (p *PlanPruned[T]) Forward(dst, src []T) error {
+	return p.forward(dst, src, nil)
+}
+
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch needs.
+func (p *PlanPruned[T]) ScratchLen() int {
+	size := prunedScratchLen(p.mode, p.n, p.q)
+	if p.sub != nil {
+		size += p.sub.ScratchLen()
+	}
+
+	return size
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanPruned[T]) ForwardWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forward(dst, src, scratch)
+}
+
+// forward dispatches the pruning mode. Non-nil scratch (ScratchLen
+// elements) holds the mode's buffer followed by the sub-plan's scratch and
+// replaces the pools.
+func (p *PlanPruned[T]) forward(dst, src, scratch []T) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != p.inputLen || len(dst) != p.out.Count {
+		return ErrLengthMismatch
+	}
+
+	var buf, subScratch []T
+
+	if scratch != nil {
+		size := prunedScratchLen(p.mode, p.n, p.q)
+		buf, subScratch = scratch[:size], scratch[size:]
+	} else {
+		if p.scratchPool == nil {
+			return ErrScratchRequired
+		}
+
+		bufPtr, _ := p.scratchPool.Get().(*[]T)
+		defer p.scratchPool.Put(bufPtr)
+
+		buf = *bufPtr
+	}
+
+	switch p.mode {
+	case prunedInput:
+		return p.forwardInput(dst, src, buf, subScratch)
+	case prunedOutput:
+		return p.forwardOutput(dst, src, buf, subScratch)
+	case prunedDirect:
+		p.forwardDirect(dst, src)
+		return nil
+	default:
+		return p.forwardFull(dst, src, buf, subScratch)
+	}
+}
+
+// forwardFull zero-pads src, runs the full transform and copies the kept bins.
+func (p *PlanPruned[T]) forwardFull(dst, src, buf, subScratch []T) error {
+	copy(buf, src)
+	clear(buf[p.inputLen:])
+
+	err := p.sub.forwardWith(buf, buf, subScratch)
+	if err != nil {
+		return err
+	}
+
+	for i := range dst {
+		dst[i] = buf[p.binAt(i)] * p.scale
+	}
+
+	return nil
+}
+
+// forwardInput evaluates the sparse-input decomposition.
+//
+// With n = p*q and inputLen <= q, bin k = p*k2 + k1 is
+//
+//	X[p*k2+k1] = Σ(j<q) (x[j] * W_n^(j*k1)) * W_q^(j*k2)
+//
+// so each residue class k1 costs one size-q FFT of a twiddled copy of the
+// non-zero prefix. Residue classes without kept bins are skipped entirely.
+func (p *PlanPruned[T]) forwardInput(dst, src, buf, subScratch []T) error {
+	var zero T
+
+	for g := range p.groups {
+		group := &p.groups[g]
+
+		idx := 0
+		for j := range p.inputLen {
+			buf[j] = src[j] * p.twiddle[idx]
+
+			idx += group.k1
+			if idx >= p.n {
+				idx -= p.n
+			}
+		}
+
+		for j := p.inputLen; j < p.q; j++ {
+			buf[j] = zero
+		}
+
+		err := p.sub.forwardWith(buf, buf, subScratch)
+		if err != nil {
+			return err
+		}
+
+		for i, di := range group.dstIdx {
+			dst[di] = buf[group.k2[i]] * p.scale
+		}
+	}
+
+	return nil
+}
+
+// forwardOutput evaluates the subset-of-outputs decomposition.
+//
+// With n = p*q and input index j = j1 + p*j2, bin k is
+//
+//	X[k] = Σ(j1<p) W_n^(j1*k) * Z_j1[k mod q],  Z_j1 = FFT_q(x[j1 + p*j2])
+//
+// so the p decimated sub-transforms are shared by all kept bins, and each
+// kept bin costs p complex multiply-adds instead of a full final pass.
+func (p *PlanPruned[T]) forwardOutput(dst, src, buf, subScratch []T) error {
+	var zero T
+
+	for j1 := range p.p {
+		row := buf[j1*p.q : (j1+1)*p.q]
+
+		for j2 := range p.q {
+			j := j1 + p.p*j2
+			if j < p.inputLen {
+				row[j2] = src[j]
+			} else {
+				row[j2] = zero
+			}
+		}
+
+		err := p.sub.forwardWith(row, row, subScratch)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range dst {
+		k := p.binAt(i)
+		kq := k % p.q
+
+		var acc T
+
+		idx := 0
+		for j1 := range p.p {
+			acc += p.twiddle[idx] * buf[j1*p.q+kq]
+
+			idx += k
+			if idx >= p.n {
+				idx -= p.n
+			}
+		}
+
+		dst[i] = acc * p.scale
+	}
+
+	return nil
+}
+
+// forwardDirect evaluates each kept bin as a direct DFT over the non-zero prefix.
+func (p *PlanPruned[T]) forwardDirect(dst, src []T) {
+	for i := range dst {
+		k := p.binAt(i)
+
+		var acc T
+
+		idx := 0
+		for j := range p.inputLen {
+			acc += src[j] * p.twiddle[idx]
+
+			idx += k
+			if idx >= p.n {
+				idx -= p.n
+			}
+		}
+
+		dst[i] = acc * p.scale
+	}
+}
+
+// binAt returns the spectrum bin stored at dst[i].
+func (p *PlanPruned[T]) binAt(i int) int {
+	k := p.out.Start + i
+	if k >= p.n {
+		k -= p.n
+	}
+
+	return k
+}
+
+// prunedScratchLen returns the scratch length needed by a pruning mode.
+func prunedScratchLen(mode prunedMode, n, q int) int {
+	switch mode {
+	case prunedInput:
+		return q
+	case prunedOutput, prunedFull:
+		return n
+	default:
+		return 0
+	}
+}
+
+// buildPrunedGroups groups the kept bins by residue k mod p.
+func buildPrunedGroups(n, p int, out BinRange) []prunedGroup {
+	byResidue := make(map[int]int)
+	groups := make([]prunedGroup, 0, min(p, out.Count))
+
+	for i := range out.Count {
+		k := (out.Start + i) % n
+		k1 := k % p
+
+		g, ok := byResidue[k1]
+		if !ok {
+			g = len(groups)
+			byResidue[k1] = g
+			groups = append(groups, prunedGroup{k1: k1})
+		}
+
+		groups[g].dstIdx = append(groups[g].dstIdx, i)
+		groups[g].k2 = append(groups[g].k2, k/p)
+	}
+
+	return groups
+}
+
+// choosePrunedDecomposition selects the cheapest pruning mode using a simple
+// operation-count model: a size-q FFT costs q*log2(q), and every complex
+// multiply-add in a twiddle or direct-sum step costs one unit. Sub-transform
+// sizes that would fall back to Bluestein are penalized.
+func choosePrunedDecomposition(n, inputLen, outCount int) (prunedMode, int, int) {
+	bestMode := prunedFull
+	bestP, bestQ := 1, n
+	bestCost := prunedFFTCost(n) + float64(outCount)
+
+	direct := float64(inputLen) * float64(outCount)
+	if direct < bestCost {
+		bestMode, bestP, bestQ, bestCost = prunedDirect, 1, n, direct
+	}
+
+	for _, q := range divisors(n) {
+		if q == n || q < 2 {
+			continue
+		}
+
+		p := n / q
+
+		if inputLen <= q {
+			groups := min(p, outCount)
+			cost := float64(groups) * (prunedFFTCost(q) + float64(inputLen))
+
+			if cost < bestCost {
+				bestMode, bestP, bestQ, bestCost = prunedInput, p, q, cost
+			}
+		}
+
+		cost := float64(p)*prunedFFTCost(q) + float64(outCount)*float64(p)
+		if cost < bestCost {
+			bestMode, bestP, bestQ, bestCost = prunedOutput, p, q, cost
+		}
+	}
+
+	return bestMode, bestP, bestQ
+}
+
+// prunedFFTCost estimates the cost of a size-n FFT.
+func prunedFFTCost(n int) float64 {
+	cost := float64(n) * float64(bits.Len(uint(n))-1)
+	if !m.IsPowerOf2(n) && !m.IsHighlyComposite(n) {
+		cost *= 4
+	}
+
+	return cost
+}
+
+// divisors returns the divisors of n in ascending order.
+func divisors(n int) []int {
+	small := make([]int, 0, 16)
+	large := make([]int, 0, 16)
+
+	for d := 1; d*d <= n; d++ {
+		if n%d != 0 {
+			continue
+		}
+
+		small = append(small, d)
+		if d*d != n {
+			large = append(large, n/d)
+		}
+	}
+
+	for i := len(large) - 1; i >= 0; i-- {
+		small = append(small, large[i])
+	}
+
+	return small
+}
@@ -0,0 +1,43 @@
+package algofft
+
+import "testing"
+
+// Pruned FFT benchmarks against the dense transform of the same length.
+func BenchmarkPruned_Input_65536_256(b *testing.B) {
+	b.Run("Pruned", func(b *testing.B) { benchmarkPrunedForward(b, 65536, 256, BinRange{0, 65536}) })
+	b.Run("Dense", func(b *testing.B) { benchmarkPlanForward(b, 65536) })
+}
+
+func BenchmarkPruned_Output_16384_100(b *testing.B) {
+	b.Run("Pruned", func(b *testing.B) { benchmarkPrunedForward(b, 16384, 16384, BinRange{0, 100}) })
+	b.Run("Dense", func(b *testing.B) { benchmarkPlanForward(b, 16384) })
+}
+
+func BenchmarkPruned_Both_65536_256_100(b *testing.B) {
+	b.Run("Pruned", func(b *testing.B) { benchmarkPrunedForward(b, 65536, 256, BinRange{0, 100}) })
+	b.Run("Dense", func(b *testing.B) { benchmarkPlanForward(b, 65536) })
+}
+
+func benchmarkPrunedForward(b *testing.B, n, inputLen int, out BinRange) {
+	b.Helper()
+
+	plan, err := NewPlanPruned32(n, inputLen, out)
+	if err != nil {
+		b.Fatalf("NewPlanPruned32 failed: %v", err)
+	}
+
+	src := make([]complex64, inputLen)
+	for i := range src {
+		src[i] = complex(float32(i%13), float32(i%7))
+	}
+
+	dst := make([]complex64, out.Count)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(n * 8))
+	b.ResetTimer()
+
+	for range b.N {
+		_ = plan.Forward(dst, src)
+	}
+}
@@ -0,0 +1,147 @@
+package algofft
+
+import (
+	"errors"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestPlanPruned_MatchesForward(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		n        int
+		inputLen int
+		out      BinRange
+	}{
+		{"input_only", 4096, 64, BinRange{0, 4096}},
+		{"output_only", 4096, 4096, BinRange{100, 50}},
+		{"both", 16384, 256, BinRange{0, 100}},
+		{"wrap_around_dc", 1024, 1024, BinRange{1000, 48}},
+		{"direct", 1024, 4, BinRange{10, 3}},
+		{"full", 256, 256, BinRange{0, 256}},
+		{"mixed_radix", 1500, 37, BinRange{7, 200}},
+		{"prime", 1009, 20, BinRange{0, 1009}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rng := rand.New(rand.NewPCG(uint64(tc.n), uint64(tc.inputLen))) //nolint:gosec
+
+			src := make([]complex128, tc.inputLen)
+			for i := range src {
+				src[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+			}
+
+			full, err := NewPlan64(tc.n)
+			if err != nil {
+				t.Fatalf("NewPlan64(%d) failed: %v", tc.n, err)
+			}
+
+			padded := make([]complex128, tc.n)
+			copy(padded, src)
+
+			want := make([]complex128, tc.n)
+			if err := full.Forward(want, padded); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			pruned, err := NewPlanPruned64(tc.n, tc.inputLen, tc.out)
+			if err != nil {
+				t.Fatalf("NewPlanPruned64 failed: %v", err)
+			}
+
+			got := make([]complex128, tc.out.Count)
+			if err := pruned.Forward(got, src); err != nil {
+				t.Fatalf("pruned Forward failed: %v", err)
+			}
+
+			for i := range got {
+				k := (tc.out.Start + i) % tc.n
+				if cmplx.Abs(got[i]-want[k]) > 1e-9 {
+					t.Fatalf("%s: bin %d got %v want %v", pruned, k, got[i], want[k])
+				}
+			}
+		})
+	}
+}
+
+func TestPlanPruned_Complex64(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n        = 65536
+		inputLen = 256
+	)
+
+	src := make([]complex64, inputLen)
+	for i := range src {
+		src[i] = complex(float32(i%7)-3, float32(i%5)-2)
+	}
+
+	full, err := NewPlan32(n)
+	if err != nil {
+		t.Fatalf("NewPlan32 failed: %v", err)
+	}
+
+	padded := make([]complex64, n)
+	copy(padded, src)
+
+	want := make([]complex64, n)
+	if err := full.Forward(want, padded); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	pruned, err := NewPlanPruned32(n, inputLen, BinRange{Start: 500, Count: 1000})
+	if err != nil {
+		t.Fatalf("NewPlanPruned32 failed: %v", err)
+	}
+
+	got := make([]complex64, 1000)
+	if err := pruned.Forward(got, src); err != nil {
+		t.Fatalf("pruned Forward failed: %v", err)
+	}
+
+	for i := range got {
+		if cmplx.Abs(complex128(got[i]-want[500+i])) > 1e-2 {
+			t.Fatalf("bin %d got %v want %v", 500+i, got[i], want[500+i])
+		}
+	}
+}
+
+func TestPlanPruned_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPlanPruned64(0, 1, BinRange{0, 1}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("n=0: expected ErrInvalidLength, got %v", err)
+	}
+
+	if _, err := NewPlanPruned64(64, 65, BinRange{0, 1}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("inputLen>n: expected ErrInvalidLength, got %v", err)
+	}
+
+	if _, err := NewPlanPruned64(64, 8, BinRange{64, 1}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("start>=n: expected ErrInvalidLength, got %v", err)
+	}
+
+	if _, err := NewPlanPruned64(64, 8, BinRange{0, 0}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("count=0: expected ErrInvalidLength, got %v", err)
+	}
+
+	plan, err := NewPlanPruned64(64, 8, BinRange{0, 4})
+	if err != nil {
+		t.Fatalf("NewPlanPruned64 failed: %v", err)
+	}
+
+	if err := plan.Forward(nil, make([]complex128, 8)); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: expected ErrNilSlice, got %v", err)
+	}
+
+	if err := plan.Forward(make([]complex128, 4), make([]complex128, 64)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("full-length src: expected ErrLengthMismatch, got %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package algofft
+
+import (
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// initRader sets up Rader's algorithm for the prime size p.n: the index
+// maps, a plan of size n-1 for the cyclic convolution and its filters. The
+// convolution plan is chosen by the same planner as p, so it runs on
+// codelets or the mixed-radix kernel.
+func (p *Plan[T]) initRader(features cpu.Features, opts PlanOptions) error {
+	p.raderGather, p.raderScatter = fft.RaderIndices(p.n)
+	if p.raderGather == nil {
+		return ErrInvalidLength
+	}
+
+	// The forced strategy applies to p; the convolution picks its own.
+	opts.Strategy = KernelAuto
+
+	plan, err := newChildPlan[T](p.n-1, features, nil, opts)
+	if err != nil {
+		return err
+	}
+
+	scratch := make([]T, plan.ScratchLen())
+
+	p.raderFilter = fft.ComputeRaderSequence(p.twiddle, p.raderScatter, false)
+	p.raderFilterInv = fft.ComputeRaderSequence(p.twiddle, p.raderScatter, true)
+
+	err = plan.forwardScaledWith(p.raderFilter, p.raderFilter, scratch, 1)
+	if err == nil {
+		err = plan.forwardScaledWith(p.raderFilterInv, p.raderFilterInv, scratch, 1)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	p.raderPlan = plan
+
+	return nil
+}
+
+// raderTransform computes the DFT of the prime size n with Rader's
+// algorithm: the inputs in the order g^k are convolved with filter by the
+// size n-1 plan, and the results are scattered to the bins g^-k. filter
+// selects the direction and scale is applied while scattering. convScratch
+// is the convolution plan's scratch, or nil to use its own.
+func (p *Plan[T]) raderTransform(dst, src, scratch, convScratch, filter []T, scale float64) error {
+	work := scratch[:p.n-1]
+	x0 := fft.RaderGather(work, src, p.raderGather)
+
+	err := p.raderPlan.forwardScaledWith(work, work, convScratch, 1)
+	if err != nil {
+		return err
+	}
+
+	sum := work[0]
+	for i := range work {
+		work[i] *= filter[i]
+	}
+
+	err = p.raderPlan.inverseScaledWith(work, work, convScratch, 1)
+	if err != nil {
+		return err
+	}
+
+	fft.RaderScatterScaled(dst, work, p.raderScatter, x0, sum, m.ComplexFromFloat64[T](scale, 0))
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package algofft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strings"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+func TestNewPlan_Rader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n        int
+		strategy KernelStrategy
+	}{
+		{3, KernelRader},
+		{5, KernelRader},
+		{7, KernelRader},
+		{13, KernelRader},
+		{17, KernelAuto},
+		{29, KernelAuto},
+		{47, KernelRader},
+		{97, KernelAuto},
+		{257, KernelAuto},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("N=%d", tt.n), func(t *testing.T) {
+			t.Parallel()
+
+			opts := PlanOptions{Strategy: tt.strategy}
+
+			plan64, err := NewPlanWithOptions[complex64](tt.n, opts)
+			if err != nil {
+				t.Fatalf("NewPlan[complex64](%d) failed: %v", tt.n, err)
+			}
+
+			plan128, err := NewPlanWithOptions[complex128](tt.n, opts)
+			if err != nil {
+				t.Fatalf("NewPlan[complex128](%d) failed: %v", tt.n, err)
+			}
+
+			if plan128.KernelStrategy() != KernelRader {
+				t.Fatalf("KernelStrategy() = %v, want %v", plan128.KernelStrategy(), KernelRader)
+			}
+
+			src := randomComplex128(tt.n, uint64(tt.n))
+
+			dst := make([]complex128, tt.n)
+			if err := plan128.Forward(dst, src); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "forward128", dst, reference.NaiveDFT128(src), 1e-10)
+
+			back := make([]complex128, tt.n)
+			if err := plan128.Inverse(back, dst); err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "inverse128", back, src, 1e-10)
+
+			src32 := toComplex64(src)
+
+			dst32 := make([]complex64, tt.n)
+			if err := plan64.Forward(dst32, src32); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "forward64", dst32, reference.NaiveDFT(src32), 1e-3)
+
+			inPlace := append([]complex64(nil), dst32...)
+			if err := plan64.InverseInPlace(inPlace); err != nil {
+				t.Fatalf("InverseInPlace failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "inverse64", inPlace, reference.NaiveIDFT(dst32), 1e-4)
+		})
+	}
+}
+
+func TestNewPlan_RaderSelection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		n        int
+		strategy KernelStrategy
+		want     KernelStrategy
+	}{
+		{"smooth p-1", 17, KernelAuto, KernelRader},
+		{"large factor of p-1", 47, KernelAuto, KernelBluestein},
+		{"forced Bluestein", 17, KernelBluestein, KernelBluestein},
+		{"forced Rader on composite", 34, KernelRader, KernelBluestein},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlanWithOptions[complex64](tt.n, PlanOptions{Strategy: tt.strategy})
+			if err != nil {
+				t.Fatalf("NewPlan(%d) failed: %v", tt.n, err)
+			}
+
+			if plan.KernelStrategy() != tt.want {
+				t.Errorf("KernelStrategy() = %v, want %v", plan.KernelStrategy(), tt.want)
+			}
+
+			if tt.want == KernelRader {
+				if plan.Algorithm() != "rader" {
+					t.Errorf("Algorithm() = %q, want %q", plan.Algorithm(), "rader")
+				}
+
+				if !strings.Contains(plan.String(), "Rader") {
+					t.Errorf("String() = %q, want it to mention Rader", plan.String())
+				}
+			}
+		})
+	}
+}
+
+func TestNewPlan_RaderMeasureRecordsWisdom(t *testing.T) {
+	t.Parallel()
+
+	const n = 257
+
+	wisdom := &mapWisdom{entries: make(map[WisdomKey]WisdomEntry)}
+
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Planner: PlannerMeasure, Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	strategy := plan.KernelStrategy()
+	if strategy != KernelRader && strategy != KernelBluestein {
+		t.Fatalf("KernelStrategy() = %v, want Rader or Bluestein", strategy)
+	}
+
+	// The convolution plan of size n-1 records its own entry.
+	recorded := false
+
+	for key, entry := range wisdom.entries {
+		if key.Size == n {
+			recorded = entry.Algorithm == plan.Algorithm()
+		}
+	}
+
+	if !recorded {
+		t.Fatalf("wisdom %v has no %q entry for size %d", wisdom.entries, plan.Algorithm(), n)
+	}
+
+	replay, err := NewPlanWithOptions[complex64](n, PlanOptions{Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) with wisdom failed: %v", n, err)
+	}
+
+	if replay.KernelStrategy() != strategy || replay.Algorithm() != plan.Algorithm() {
+		t.Errorf("wisdom plan = %v/%q, want %v/%q",
+			replay.KernelStrategy(), replay.Algorithm(), strategy, plan.Algorithm())
+	}
+}
+
+func TestNewPlan_RaderScratchAndClone(t *testing.T) {
+	t.Parallel()
+
+	const n = 61
+
+	plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Workspace: WorkspaceExternal})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	if plan.KernelStrategy() != KernelRader {
+		t.Fatalf("KernelStrategy() = %v, want %v", plan.KernelStrategy(), KernelRader)
+	}
+
+	src := randomComplex128(n, 61)
+	want := reference.NaiveDFT128(src)
+	scratch := make([]complex128, plan.ScratchLen())
+
+	dst := make([]complex128, n)
+	if err := plan.ForwardWithScratch(dst, src, scratch); err != nil {
+		t.Fatalf("ForwardWithScratch failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "scratch", dst, want, 1e-10)
+
+	back := make([]complex128, n)
+	if err := plan.InverseWithScratch(back, dst, scratch); err != nil {
+		t.Fatalf("InverseWithScratch failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "roundtrip", back, src, 1e-10)
+
+	clone := plan.Clone()
+	cloneDst := make([]complex128, n)
+
+	if err := clone.ForwardWithScratch(cloneDst, src, make([]complex128, clone.ScratchLen())); err != nil {
+		t.Fatalf("clone ForwardWithScratch failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "clone", cloneDst, want, 1e-10)
+}
+
+func TestNewPlan_RaderNormalizationAndBound(t *testing.T) {
+	t.Parallel()
+
+	const n = 97
+
+	plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatalf("NewPlan(%d) failed: %v", n, err)
+	}
+
+	src := randomComplex128(n, 97)
+	want := reference.NaiveDFT128(src)
+
+	scale := complex(1/math.Sqrt(n), 0)
+	for i := range want {
+		want[i] *= scale
+	}
+
+	dst := make([]complex128, n)
+	if err := plan.Forward(dst, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "ortho", dst, want, 1e-12)
+
+	var diff, norm float64
+
+	for i := range dst {
+		d := cmplx.Abs(dst[i] - want[i])
+		diff += d * d
+		norm += cmplx.Abs(want[i]) * cmplx.Abs(want[i])
+	}
+
+	relErr := math.Sqrt(diff / norm)
+	if bound := plan.EstimatedErrorBound(); bound <= 0 || relErr > bound {
+		t.Errorf("relative error %g exceeds EstimatedErrorBound %g", relErr, bound)
+	}
+}
@@ -0,0 +1,90 @@
+package algofft
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// MaxRadix is the largest radix accepted in PlanOptions.Radices.
+const MaxRadix = fft.MixedRadixMaxRadix
+
+// validateRadices checks that opts.Radices is a usable schedule for size n.
+func validateRadices(n int, opts PlanOptions) error {
+	if opts.Strategy != KernelAuto && opts.Strategy != KernelDIT {
+		return fmt.Errorf("radices cannot be combined with kernel strategy %d: %w", opts.Strategy, ErrInvalidRadices)
+	}
+
+	if len(opts.Radices) > fft.MixedRadixMaxStages {
+		return fmt.Errorf("%d radices exceed the limit of %d stages: %w",
+			len(opts.Radices), fft.MixedRadixMaxStages, ErrInvalidRadices)
+	}
+
+	product := 1
+
+	for _, r := range opts.Radices {
+		if r < 2 || r > MaxRadix {
+			return fmt.Errorf("radix %d outside [2, %d]: %w", r, MaxRadix, ErrInvalidRadices)
+		}
+
+		product *= r
+		if product > n {
+			break
+		}
+	}
+
+	if product != n {
+		return fmt.Errorf("radices %v do not multiply to %d: %w", opts.Radices, n, ErrInvalidRadices)
+	}
+
+	return nil
+}
+
+// planRadices starts an estimate from opts.Radices when set. ok is false
+// when the planner should choose on its own.
+func planRadices[T Complex](n int, opts PlanOptions) (fft.PlanEstimate[T], bool, error) {
+	if len(opts.Radices) == 0 {
+		return fft.PlanEstimate[T]{}, false, nil
+	}
+
+	err := validateRadices(n, opts)
+	if err != nil {
+		return fft.PlanEstimate[T]{}, false, err
+	}
+
+	return fft.PlanEstimate[T]{
+		Strategy: fft.KernelDIT,
+		Radices:  opts.Radices,
+	}, true, nil
+}
+
+// bindRadixSchedule binds the mixed-radix kernel with a fixed schedule as
+// the estimate's codelets. Plans that fall back to the mixed-radix kernel
+// get the schedule it would pick per call, so Algorithm and Meta report what
+// actually runs. features picks the prime-radix stage path once, here.
+func bindRadixSchedule[T Complex](n int, features cpu.Features, estimate *fft.PlanEstimate[T]) {
+	if estimate.Radices == nil {
+		if estimate.ForwardCodelet != nil || m.IsPowerOf2(n) || !m.IsHighlyComposite(n) {
+			return
+		}
+
+		switch estimate.Strategy {
+		case fft.KernelBluestein, fft.KernelRecursive, fft.KernelRader, fft.KernelPFA:
+			return
+		}
+
+		estimate.Radices = fft.MixedRadixSchedule[T](n)
+		if estimate.Radices == nil {
+			return
+		}
+	}
+
+	radices := slices.Clone(estimate.Radices)
+
+	estimate.Radices = radices
+	estimate.Algorithm = fft.RadixScheduleName(radices)
+	estimate.ForwardCodelet, estimate.InverseCodelet = fft.MixedRadixScheduleCodelets[T](radices, features)
+}
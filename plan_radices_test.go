@@ -0,0 +1,342 @@
+package algofft
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+func TestPlanRadicesSchedules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n       int
+		radices []int
+		name    string
+	}{
+		{128, []int{4, 4, 4, 2}, "mixedradix_4x4x4x2"},
+		{128, []int{2, 4, 4, 4}, "mixedradix_2x4x4x4"},
+		{120, []int{8, 5, 3}, "mixedradix_8x5x3"},
+		{60, []int{3, 4, 5}, "mixedradix_3x4x5"},
+		{49, []int{7, 7}, "mixedradix_7x7"},
+		{16, []int{16}, "mixedradix_16"},
+		{2, []int{2}, "mixedradix_2"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlanWithOptions[complex128](tc.n, PlanOptions{Radices: tc.radices})
+			if err != nil {
+				t.Fatalf("NewPlanWithOptions: %v", err)
+			}
+
+			if got := plan.Algorithm(); got != tc.name {
+				t.Errorf("Algorithm() = %q, want %q", got, tc.name)
+			}
+
+			if got := plan.Meta().Radices; !slices.Equal(got, tc.radices) {
+				t.Errorf("Meta().Radices = %v, want %v", got, tc.radices)
+			}
+
+			src := randomComplex128(tc.n, uint64(tc.n))
+			got := make([]complex128, tc.n)
+
+			err = plan.Forward(got, src)
+			if err != nil {
+				t.Fatalf("Forward: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "forward", got, reference.NaiveDFT128(src), 1e-9)
+
+			back := make([]complex128, tc.n)
+
+			err = plan.Inverse(back, got)
+			if err != nil {
+				t.Fatalf("Inverse: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "roundtrip", back, src, 1e-9)
+
+			copy(got, src)
+
+			err = plan.InPlace(got)
+			if err != nil {
+				t.Fatalf("InPlace: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "in-place", got, reference.NaiveDFT128(src), 1e-9)
+		})
+	}
+}
+
+func TestPlanRadicesComplex64(t *testing.T) {
+	t.Parallel()
+
+	radices := []int{5, 4, 3, 2}
+
+	plan, err := NewPlanWithOptions[complex64](120, PlanOptions{Radices: radices})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := toComplex64(randomComplex128(120, 3))
+	got := make([]complex64, 120)
+
+	err = plan.Forward(got, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertComplexSlicesClose(t, "forward", got, reference.NaiveDFT(src), 1e-3)
+
+	// The plan keeps its own copy of the schedule.
+	radices[0] = 7
+	meta := plan.Meta()
+	meta.Radices[1] = 9
+
+	if got := plan.Meta().Radices; !slices.Equal(got, []int{5, 4, 3, 2}) {
+		t.Fatalf("Meta().Radices = %v after caller mutation", got)
+	}
+}
+
+func TestPlanRadicesInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		n    int
+		opts PlanOptions
+	}{
+		{"product too small", 128, PlanOptions{Radices: []int{4, 4, 4}}},
+		{"product too large", 60, PlanOptions{Radices: []int{4, 4, 4}}},
+		{"radix one", 8, PlanOptions{Radices: []int{1, 8}}},
+		{"radix zero", 8, PlanOptions{Radices: []int{0, 8}}},
+		{"negative radix", 8, PlanOptions{Radices: []int{-2, -4}}},
+		{"radix too large", 17, PlanOptions{Radices: []int{17}}},
+		{"strategy conflict", 64, PlanOptions{Radices: []int{8, 8}, Strategy: KernelStockham}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewPlanWithOptions[complex64](tc.n, tc.opts)
+			if !errors.Is(err, ErrInvalidRadices) {
+				t.Fatalf("error = %v, want ErrInvalidRadices", err)
+			}
+
+			_, err = NewPlanFromPoolWithOptions[complex64](tc.n, NewBufferPool(0), tc.opts)
+			if tc.n == 17 {
+				return // not a pooled size
+			}
+
+			if !errors.Is(err, ErrInvalidRadices) {
+				t.Fatalf("pooled error = %v, want ErrInvalidRadices", err)
+			}
+		})
+	}
+}
+
+func TestPlanRadicesReportedForMixedRadixSizes(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{60, 96, 2560, 448, 704, 832, 1001} {
+		plan, err := NewPlan64(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		radices := plan.Meta().Radices
+		if radices == nil {
+			continue // a codelet covers this size
+		}
+
+		product := 1
+		for _, r := range radices {
+			product *= r
+		}
+
+		if product != n {
+			t.Fatalf("n=%d: Meta().Radices = %v, product %d", n, radices, product)
+		}
+
+		src := randomComplex128(n, 9)
+		got := make([]complex128, n)
+
+		err = plan.Forward(got, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertComplexSlicesClose(t, "forward", got, reference.NaiveDFT128(src), 1e-8)
+	}
+
+	plan, err := NewPlan64(17)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if radices := plan.Meta().Radices; radices != nil {
+		t.Fatalf("Bluestein plan reports radices %v", radices)
+	}
+}
+
+// mapWisdom is a minimal in-memory WisdomStore.
+type mapWisdom struct {
+	mu      sync.Mutex
+	entries map[WisdomKey]WisdomEntry
+}
+
+func (w *mapWisdom) LookupWisdom(size int, precision uint8, cpuFeatures uint64) (string, bool) {
+	entry, ok := w.Lookup(WisdomKey{Size: size, Precision: precision, CPUFeatures: cpuFeatures})
+	return entry.Algorithm, ok
+}
+
+func (w *mapWisdom) Lookup(key WisdomKey) (WisdomEntry, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	entry, ok := w.entries[key]
+
+	return entry, ok
+}
+
+func (w *mapWisdom) Store(entry WisdomEntry) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.entries[entry.Key] = entry
+}
+
+func (w *mapWisdom) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.entries)
+}
+
+func TestPlanRadicesMeasureUsesWisdom(t *testing.T) {
+	t.Parallel()
+
+	wisdom := &mapWisdom{entries: make(map[WisdomKey]WisdomEntry)}
+
+	measured, err := NewPlanWithOptions[complex64](360, PlanOptions{Planner: PlannerMeasure, Wisdom: wisdom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	radices := measured.Meta().Radices
+	if radices == nil {
+		t.Skip("a codelet covers this size")
+	}
+
+	if wisdom.Len() != 1 {
+		t.Fatalf("wisdom has %d entries, want 1", wisdom.Len())
+	}
+
+	reused, err := NewPlanWithOptions[complex64](360, PlanOptions{Wisdom: wisdom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := reused.Meta().Radices; !slices.Equal(got, radices) {
+		t.Fatalf("wisdom plan radices = %v, want %v", got, radices)
+	}
+
+	if reused.Algorithm() != measured.Algorithm() {
+		t.Fatalf("wisdom plan algorithm = %q, want %q", reused.Algorithm(), measured.Algorithm())
+	}
+}
+
+func TestPlanRadicesIgnoredByCompositePlans(t *testing.T) {
+	t.Parallel()
+
+	opts := PlanOptions{Radices: []int{4, 4}}
+
+	_, err := NewPlan2DWithOptions[complex64](6, 10, opts)
+	if err != nil {
+		t.Fatalf("Plan2D: %v", err)
+	}
+
+	_, err = NewPlanRealTWithOptions[float64, complex128](20, opts)
+	if err != nil {
+		t.Fatalf("PlanRealT: %v", err)
+	}
+
+	_, err = NewPlanNDAxesWithOptions[complex64]([]int{3, 5}, []int{1}, opts)
+	if err != nil {
+		t.Fatalf("PlanNDAxes: %v", err)
+	}
+}
+
+func TestPlanRadicesNoAllocs(t *testing.T) {
+	plan, err := NewPlanWithOptions[complex64](120, PlanOptions{Radices: []int{8, 5, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := make([]complex64, 120)
+	dst := make([]complex64, 120)
+
+	assertNoAllocs(t, "Forward", func() error {
+		return plan.Forward(dst, src)
+	})
+}
+
+func TestPlanGeneratedCodelets(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n       int
+		name    string
+		radices []int
+	}{
+		{12, "dit12_radix4x3_generic", []int{3, 4}},
+		{20, "dit20_radix4x5_generic", []int{5, 4}},
+		{24, "dit24_radix4x2x3_generic", []int{3, 8}},
+		{48, "dit48_radix4x4x3_generic", []int{3, 16}},
+		{60, "dit60_radix4x5x3_generic", []int{3, 4, 5}},
+		{80, "dit80_radix4x4x5_generic", []int{5, 16}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlanT[complex128](tc.n)
+			if err != nil {
+				t.Fatalf("NewPlanT: %v", err)
+			}
+
+			if got := plan.Algorithm(); got != tc.name {
+				t.Errorf("Algorithm() = %q, want %q", got, tc.name)
+			}
+
+			src := randomComplex128(tc.n, uint64(tc.n))
+			got := append([]complex128(nil), src...)
+
+			err = plan.InPlace(got)
+			if err != nil {
+				t.Fatalf("InPlace: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "in-place", got, reference.NaiveDFT128(src), 1e-9)
+
+			// An explicit schedule still replaces the codelet
+			scheduled, err := NewPlanWithOptions[complex128](tc.n, PlanOptions{Radices: tc.radices})
+			if err != nil {
+				t.Fatalf("NewPlanWithOptions: %v", err)
+			}
+
+			if got, want := scheduled.Algorithm(), fft.RadixScheduleName(tc.radices); got != want {
+				t.Errorf("Algorithm() with Radices = %q, want %q", got, want)
+			}
+		})
+	}
+}
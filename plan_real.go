@@ -2,6 +2,7 @@ package algofft
 
 import (
 	"math"
+	"sync"
 
 	"github.com/MeKo-Christian/algo-fft/internal/cpu"
 )
@@ -14,14 +15,21 @@ import (
 //	X[k] = conj(X[N-k]) for k = 1..N/2-1
 //
 // Index 0 is DC and index N/2 is Nyquist (purely real for even N).
+//
+// Plans are safe for concurrent use; packing buffers are drawn from a per-plan
+// pool, or supplied by the caller through ForwardWithScratch and
+// InverseWithScratch.
 type PlanReal struct {
 	n    int
 	half int
 
 	plan    *Plan[complex64]
 	weight  []complex64
-	buf     []complex64
+	bufPool *sync.Pool // per-call packing workspaces of n/2 elements
 	options PlanOptions
+
+	// pool is the buffer pool this plan was allocated from (nil if not pooled).
+	pool *BufferPool
 }
 
 // NewPlanReal creates a new real FFT plan for length n.
@@ -32,10 +40,26 @@ func NewPlanReal(n int) (*PlanReal, error) {
 
 // NewPlanRealWithOptions creates a new real FFT plan with explicit planner options.
 func NewPlanRealWithOptions(n int, opts PlanOptions) (*PlanReal, error) {
-	return newPlanRealWithFeatures(n, cpu.DetectFeatures(), normalizePlanOptions(opts))
+	return newPlanRealWithFeatures(n, cpu.DetectFeatures(), nil, normalizePlanOptions(opts))
+}
+
+// NewPlanRealFromPool creates a new real FFT plan whose complex plan and
+// packing buffers are drawn from pool. Call Close when the plan is no longer
+// needed to hand the buffers back.
+func NewPlanRealFromPool(n int, pool *BufferPool) (*PlanReal, error) {
+	return NewPlanRealFromPoolWithOptions(n, pool, PlanOptions{})
+}
+
+// NewPlanRealFromPoolWithOptions is NewPlanRealFromPool with explicit planner options.
+func NewPlanRealFromPoolWithOptions(n int, pool *BufferPool, opts PlanOptions) (*PlanReal, error) {
+	if pool == nil {
+		pool = DefaultBufferPool()
+	}
+
+	return newPlanRealWithFeatures(n, cpu.DetectFeatures(), pool, normalizePlanOptions(opts))
 }
 
-func newPlanRealWithFeatures(n int, features cpu.Features, opts PlanOptions) (*PlanReal, error) {
+func newPlanRealWithFeatures(n int, features cpu.Features, pool *BufferPool, opts PlanOptions) (*PlanReal, error) {
 	if n < 2 || n%2 != 0 {
 		return nil, ErrInvalidLength
 	}
@@ -43,10 +67,12 @@ func newPlanRealWithFeatures(n int, features cpu.Features, opts PlanOptions) (*P
 	childOpts := opts
 	childOpts.Batch = 0
 	childOpts.Stride = 0
-	// The real-FFT pack/unpack path uses the child complex plan in-place on p.buf.
+	// The real-FFT pack/unpack path uses the child complex plan in-place on the packing buffer.
 	childOpts.InPlace = true
+	// Normalization is relative to n and folded into the pack/unpack loops.
+	childOpts.Normalization = NormBackward
 
-	plan, err := newPlanWithFeatures[complex64](n/2, features, childOpts)
+	plan, err := newChildPlan[complex64](n/2, features, pool, childOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -55,8 +81,8 @@ func newPlanRealWithFeatures(n int, features cpu.Features, opts PlanOptions) (*P
 	// U[k] = 0.5 * (1 + i*W_N^k) where W_N^k = exp(-2πik/N).
 	weight := make([]complex64, n/2+1)
 	for k := range weight {
-		theta := 2 * math.Pi * float64(k) / float64(n)
-		weight[k] = complex64(complex(0.5*(1+math.Sin(theta)), 0.5*math.Cos(theta)))
+		re, im := realWeight(k, n, opts.Accuracy)
+		weight[k] = complex64(complex(re, im))
 	}
 
 	return &PlanReal{
@@ -64,8 +90,9 @@ func newPlanRealWithFeatures(n int, features cpu.Features, opts PlanOptions) (*P
 		half:    n / 2,
 		plan:    plan,
 		weight:  weight,
-		buf:     make([]complex64, n/2),
+		bufPool: newScratchPool[complex64](opts.Workspace, pool, n/2, 0),
 		options: opts,
+		pool:    pool,
 	}, nil
 }
 
@@ -79,15 +106,75 @@ func (p *PlanReal) SpectrumLen() int {
 	return p.half + 1
 }
 
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanReal) ScratchLen() int {
+	return p.half + p.plan.ScratchLen()
+}
+
+// Clone creates an independent copy of the PlanReal. Plans are already safe
+// for concurrent use, so the clone shares the complex plan and weights and
+// only gets its own buffer pool. Clones are never pooled; a pooled plan must
+// outlive its clones.
+func (p *PlanReal) Clone() *PlanReal {
+	return &PlanReal{
+		n:       p.n,
+		half:    p.half,
+		plan:    p.plan,
+		weight:  p.weight,
+		bufPool: newScratchPool[complex64](p.options.Workspace, nil, p.half, 0),
+		options: p.options,
+	}
+}
+
+// Close returns the buffers of a plan created with NewPlanRealFromPool to its
+// pool. After Close, the plan must not be used. Close is a no-op for other
+// plans and safe to call multiple times.
+func (p *PlanReal) Close() {
+	if p.pool == nil {
+		return
+	}
+
+	p.plan.Close()
+	releaseWorkspacePool[complex64](p.bufPool, p.pool)
+
+	p.pool = nil
+	p.bufPool = nil
+}
+
 // Forward computes the real-to-complex FFT.
 // dst must have length N/2+1 and src must have length N.
+// The output is scaled according to PlanOptions.Normalization.
 func (p *PlanReal) Forward(dst []complex64, src []float32) error {
+	return p.forwardWith(dst, src, nil)
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanReal) ForwardWithScratch(dst []complex64, src []float32, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forwardWith(dst, src, scratch)
+}
+
+// forwardWith is Forward with optional caller scratch (nil: pooled).
+func (p *PlanReal) forwardWith(dst []complex64, src []float32, scratch []complex64) error {
+	scale, _ := normalizationScales(p.options.Normalization, p.n)
+
+	return p.forward(dst, src, float32(scale), scratch)
+}
+
+func (p *PlanReal) forward(dst []complex64, src []float32, scale float32, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.forwardSingle(dst, src)
+		return p.forwardSingle(dst, src, scale, scratch)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.n, p.half+1, p.options)
@@ -103,7 +190,7 @@ func (p *PlanReal) Forward(dst []complex64, src []float32) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.forwardSingle(dst[dstOff:dstOff+p.half+1], src[srcOff:srcOff+p.n])
+		err = p.forwardSingle(dst[dstOff:dstOff+p.half+1], src[srcOff:srcOff+p.n], scale, scratch)
 		if err != nil {
 			return err
 		}
@@ -112,7 +199,9 @@ func (p *PlanReal) Forward(dst []complex64, src []float32) error {
 	return nil
 }
 
-func (p *PlanReal) forwardSingle(dst []complex64, src []float32) error {
+// forwardSingle transforms one line; non-nil scratch (ScratchLen elements)
+// replaces the pooled workspace.
+func (p *PlanReal) forwardSingle(dst []complex64, src []float32, scale float32, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -121,72 +210,101 @@ func (p *PlanReal) forwardSingle(dst []complex64, src []float32) error {
 		return ErrLengthMismatch
 	}
 
+	ws, pooled, err := acquireWorkspace[complex64](p.bufPool, scratch, p.half, 0)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.bufPool.Put(pooled)
+	}
+
+	buf := ws.buf
+
+	// The transform is linear, so normalization is applied while packing.
 	for i := range p.half {
-		p.buf[i] = complex(src[2*i], src[2*i+1])
+		buf[i] = complex(scale*src[2*i], scale*src[2*i+1])
 	}
 
-	err := p.plan.Forward(p.buf, p.buf)
+	err = p.forwardBins(dst[1:p.half], buf, ws.kernel)
 	if err != nil {
 		return err
 	}
 
-	y0 := p.buf[0]
+	y0 := buf[0]
 	y0r := real(y0)
 	y0i := imag(y0)
 	dst[0] = complex(y0r+y0i, 0)
 	dst[p.half] = complex(y0r-y0i, 0)
 
+	return nil
+}
+
+// forwardBins runs the N/2 complex FFT in place on the packed buf and
+// writes bins 1..N/2-1 of the half-spectrum to bins[0:N/2-1]. Y[0] is left
+// in buf[0] for the DC and Nyquist bins.
+func (p *PlanReal) forwardBins(bins, buf, scratch []complex64) error {
+	err := p.plan.forwardWith(buf, buf, scratch)
+	if err != nil {
+		return err
+	}
+
 	// Recombination step: extract X[k] from the N/2-point FFT of packed data.
 	// Given z[m] = x[2m] + i*x[2m+1], we computed Y = FFT(z).
 	// With A[k] = Y[k], B[k] = conj(Y[N/2-k]), and U[k] = 0.5 * (1 + i*W_N^k),
 	// the spectrum is recovered via: X[k] = A[k] - U[k] * (A[k] - B[k]).
 	for k := 1; k < p.half; k++ {
-		a := p.buf[k]
-		bSrc := p.buf[p.half-k]
+		a := buf[k]
+		bSrc := buf[p.half-k]
 		b := complex(real(bSrc), -imag(bSrc)) // conj(Y[N/2-k])
 
 		c := p.weight[k] * (a - b)
-		dst[k] = a - c
+		bins[k-1] = a - c
 	}
 
 	return nil
 }
 
-// ForwardNormalized computes the real-to-complex FFT and scales the result by 1/N.
+// ForwardNormalized computes the real-to-complex FFT and scales the result by 1/N,
+// regardless of PlanOptions.Normalization.
 func (p *PlanReal) ForwardNormalized(dst []complex64, src []float32) error {
-	err := p.Forward(dst, src)
-	if err != nil {
-		return err
-	}
+	return p.forward(dst, src, float32(1.0/float64(p.n)), nil)
+}
 
-	scale := float32(1.0 / float64(p.n))
-	scaleSpectrumComplex64(dst, scale)
+// ForwardUnitary computes the real-to-complex FFT and scales the result by 1/sqrt(N),
+// regardless of PlanOptions.Normalization.
+func (p *PlanReal) ForwardUnitary(dst []complex64, src []float32) error {
+	return p.forward(dst, src, float32(1.0/math.Sqrt(float64(p.n))), nil)
+}
 
-	return nil
+// Inverse computes the complex-to-real inverse FFT.
+// dst must have length N and src must have length N/2+1.
+// The output is scaled according to PlanOptions.Normalization (1/N by default).
+func (p *PlanReal) Inverse(dst []float32, src []complex64) error {
+	return p.inverseWith(dst, src, nil)
 }
 
-// ForwardUnitary computes the real-to-complex FFT and scales the result by 1/sqrt(N).
-func (p *PlanReal) ForwardUnitary(dst []complex64, src []float32) error {
-	err := p.Forward(dst, src)
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanReal) InverseWithScratch(dst []float32, src []complex64, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
 	if err != nil {
 		return err
 	}
 
-	scale := float32(1.0 / math.Sqrt(float64(p.n)))
-	scaleSpectrumComplex64(dst, scale)
-
-	return nil
+	return p.inverseWith(dst, src, scratch)
 }
 
-// Inverse computes the complex-to-real inverse FFT.
-// dst must have length N and src must have length N/2+1.
-func (p *PlanReal) Inverse(dst []float32, src []complex64) error {
+// inverseWith is Inverse with optional caller scratch (nil: pooled).
+func (p *PlanReal) inverseWith(dst []float32, src []complex64, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
+	_, scale := normalizationScales(p.options.Normalization, p.n)
+
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.inverseSingle(dst, src)
+		return p.inverseSingle(dst, src, float32(scale), scratch)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.n, p.half+1, p.options)
@@ -202,7 +320,7 @@ func (p *PlanReal) Inverse(dst []float32, src []complex64) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.inverseSingle(dst[dstOff:dstOff+p.n], src[srcOff:srcOff+p.half+1])
+		err = p.inverseSingle(dst[dstOff:dstOff+p.n], src[srcOff:srcOff+p.half+1], float32(scale), scratch)
 		if err != nil {
 			return err
 		}
@@ -211,7 +329,9 @@ func (p *PlanReal) Inverse(dst []float32, src []complex64) error {
 	return nil
 }
 
-func (p *PlanReal) inverseSingle(dst []float32, src []complex64) error {
+// inverseSingle transforms one line; non-nil scratch (ScratchLen elements)
+// replaces the pooled workspace.
+func (p *PlanReal) inverseSingle(dst []float32, src []complex64, scale float32, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -220,15 +340,43 @@ func (p *PlanReal) inverseSingle(dst []float32, src []complex64) error {
 		return ErrLengthMismatch
 	}
 
-	const spectrumEps = 1e-4
+	if symmetryPolicy(p.options, SymmetryStrict) == SymmetryStrict {
+		err := checkHermitian(src, []int{p.n}, true, symmetryTolerance[complex64](p.options))
+		if err != nil {
+			return err
+		}
+	}
+
+	ws, pooled, err := acquireWorkspace[complex64](p.bufPool, scratch, p.half, 0)
+	if err != nil {
+		return err
+	}
 
-	if math.Abs(float64(imag(src[0]))) > spectrumEps || math.Abs(float64(imag(src[p.half]))) > spectrumEps {
-		return ErrInvalidSpectrum
+	if pooled != nil {
+		defer p.bufPool.Put(pooled)
 	}
 
-	x0 := real(src[0])
-	xh := real(src[p.half])
-	p.buf[0] = complex(0.5*(x0+xh), 0.5*(x0-xh))
+	buf := ws.buf
+
+	err = p.inverseBins(buf, real(src[0]), real(src[p.half]), src[1:p.half], ws.kernel)
+	if err != nil {
+		return err
+	}
+
+	for i := range p.half {
+		v := buf[i]
+		dst[2*i] = scale * real(v)
+		dst[2*i+1] = scale * imag(v)
+	}
+
+	return nil
+}
+
+// inverseBins rebuilds the packed buffer z[k] = x[2k] + i*x[2k+1] from the
+// real DC and Nyquist bins x0 and xh and bins 1..N/2-1 in bins[0:N/2-1], and
+// runs the inverse N/2 complex FFT on it.
+func (p *PlanReal) inverseBins(buf []complex64, x0, xh float32, bins, scratch []complex64) error {
+	buf[0] = complex(0.5*(x0+xh), 0.5*(x0-xh))
 
 	for k := 1; k < p.half; k++ {
 		m := p.half - k
@@ -236,45 +384,24 @@ func (p *PlanReal) inverseSingle(dst []float32, src []complex64) error {
 			continue
 		}
 
-		xk := src[k]
-		xmk := src[m]
+		xk := bins[k-1]
+		xmk := bins[m-1]
 		xmkc := complex(real(xmk), -imag(xmk))
 
 		u := p.weight[k]
 		oneMinusU := complex64(1) - u
 		det := complex64(1) - 2*u
-		invDet := complex64(1) / det
+		// det is on the unit circle, so 1/det == conj(det)
+		invDet := complex(real(det), -imag(det))
 
 		a := (xk*oneMinusU - xmkc*u) * invDet
 		b := (oneMinusU*xmkc - u*xk) * invDet
 
-		p.buf[k] = a
+		buf[k] = a
 		if k != m {
-			p.buf[m] = complex(real(b), -imag(b))
+			buf[m] = complex(real(b), -imag(b))
 		}
 	}
 
-	err := p.plan.Inverse(p.buf, p.buf)
-	if err != nil {
-		return err
-	}
-
-	for i := range p.half {
-		v := p.buf[i]
-		dst[2*i] = real(v)
-		dst[2*i+1] = imag(v)
-	}
-
-	return nil
-}
-
-func scaleSpectrumComplex64(dst []complex64, scale float32) {
-	if scale == 1 {
-		return
-	}
-
-	factor := complex(scale, 0)
-	for i := range dst {
-		dst[i] *= factor
-	}
+	return p.plan.inverseWith(buf, buf, scratch)
 }
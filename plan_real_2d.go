@@ -2,9 +2,9 @@ package algofft
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/MeKo-Christian/algo-fft/internal/cpu"
-	mem "github.com/MeKo-Christian/algo-fft/internal/memory"
 )
 
 // PlanReal2D is a pre-computed 2D real FFT plan for float32 input matrices.
@@ -19,33 +19,58 @@ import (
 // - Input (real): row-major M×N float32 array
 // - Compact output: row-major M×(N/2+1) complex64 array
 // - Full output: row-major M×N complex64 array (with redundant conjugate pairs).
+//
+// Plans are safe for concurrent use; scratch is drawn from a per-plan pool,
+// or supplied by the caller through ForwardWithScratch and InverseWithScratch.
 type PlanReal2D struct {
-	rows, cols     int                // Input dimensions (M×N real values)
-	halfCols       int                // N/2+1 (compact spectrum width)
-	rowPlan        *PlanReal          // Real FFT for rows (size N → N/2+1)
-	colPlans       []*Plan[complex64] // Complex FFT for each column (size M)
-	scratchCompact []complex64        // Working buffer (M×(N/2+1))
-	scratchFull    []complex64        // Full spectrum buffer (M×N) for ForwardFull
-	options        PlanOptions
+	rows, cols int                // Input dimensions (M×N real values)
+	halfCols   int                // N/2+1 (compact spectrum width)
+	rowPlan    *PlanReal          // Real FFT for rows (size N → N/2+1)
+	colPlans   []*Plan[complex64] // Complex FFT for each column (size M)
+	options    PlanOptions
+
+	// scratchPool provides per-call workspaces (buf M×(N/2+1), line size M).
+	scratchPool *sync.Pool
+
+	// linePool provides the workspaces of ForwardInPlace and InverseInPlace,
+	// which transform the caller's buffer and need no buf.
+	linePool *sync.Pool
 
-	// backing keeps aligned buffers alive for GC
-	scratchCompactBacking []byte
-	scratchFullBacking    []byte
+	// pool is the buffer pool this plan was allocated from (nil if not pooled).
+	pool *BufferPool
 }
 
 // NewPlanReal2D creates a new 2D real FFT plan for an M×N real matrix.
 //
 // Both rows and cols must be ≥ 2, and cols must be even (required by the real FFT algorithm).
 //
-// The plan pre-allocates all necessary buffers, enabling zero-allocation transforms.
-//
-// For concurrent use, create separate plans via Clone() for each goroutine.
+// Scratch buffers are pooled per plan, enabling zero-allocation transforms in steady state.
 func NewPlanReal2D(rows, cols int) (*PlanReal2D, error) {
 	return NewPlanReal2DWithOptions(rows, cols, PlanOptions{})
 }
 
 // NewPlanReal2DWithOptions creates a new 2D real FFT plan with explicit planner options.
 func NewPlanReal2DWithOptions(rows, cols int, opts PlanOptions) (*PlanReal2D, error) {
+	return newPlanReal2D(rows, cols, nil, opts)
+}
+
+// NewPlanReal2DFromPool creates a new 2D real FFT plan whose 1D plans and
+// workspaces are drawn from pool. Call Close when the plan is no longer
+// needed to hand the buffers back.
+func NewPlanReal2DFromPool(rows, cols int, pool *BufferPool) (*PlanReal2D, error) {
+	return NewPlanReal2DFromPoolWithOptions(rows, cols, pool, PlanOptions{})
+}
+
+// NewPlanReal2DFromPoolWithOptions is NewPlanReal2DFromPool with explicit planner options.
+func NewPlanReal2DFromPoolWithOptions(rows, cols int, pool *BufferPool, opts PlanOptions) (*PlanReal2D, error) {
+	if pool == nil {
+		pool = DefaultBufferPool()
+	}
+
+	return newPlanReal2D(rows, cols, pool, opts)
+}
+
+func newPlanReal2D(rows, cols int, pool *BufferPool, opts PlanOptions) (*PlanReal2D, error) {
 	if rows <= 0 || cols <= 0 {
 		return nil, ErrInvalidLength
 	}
@@ -61,9 +86,13 @@ func NewPlanReal2DWithOptions(rows, cols int, opts PlanOptions) (*PlanReal2D, er
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	// Normalization is applied once by this plan, not per dimension.
+	childOpts.Normalization = NormBackward
+	// Symmetry is enforced once on the whole spectrum, not per row.
+	childOpts.Symmetry = SymmetryIgnore
 
 	// Create 1D real plan for rows
-	rowPlan, err := newPlanRealWithFeatures(cols, features, childOpts)
+	rowPlan, err := newPlanRealWithFeatures(cols, features, pool, childOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -73,32 +102,25 @@ func NewPlanReal2DWithOptions(rows, cols int, opts PlanOptions) (*PlanReal2D, er
 	// Create complex plans for columns (one for each column in compact spectrum)
 	colPlans := make([]*Plan[complex64], halfCols)
 	for i := range colPlans {
-		plan, err := newPlanWithFeatures[complex64](rows, features, childOpts)
+		plan, err := newChildPlan[complex64](rows, features, pool, childOpts)
 		if err != nil {
+			rowPlan.Close()
 			return nil, err
 		}
 
 		colPlans[i] = plan
 	}
 
-	// Allocate scratch buffers (aligned for SIMD)
-	compactSize := rows * halfCols
-	fullSize := rows * cols
-
-	scratchCompact, scratchCompactBacking := mem.AllocAlignedComplex64(compactSize)
-	scratchFull, scratchFullBacking := mem.AllocAlignedComplex64(fullSize)
-
 	return &PlanReal2D{
-		rows:                  rows,
-		cols:                  cols,
-		halfCols:              halfCols,
-		rowPlan:               rowPlan,
-		colPlans:              colPlans,
-		scratchCompact:        scratchCompact,
-		scratchFull:           scratchFull,
-		scratchCompactBacking: scratchCompactBacking,
-		scratchFullBacking:    scratchFullBacking,
-		options:               opts,
+		rows:        rows,
+		cols:        cols,
+		halfCols:    halfCols,
+		rowPlan:     rowPlan,
+		colPlans:    colPlans,
+		options:     opts,
+		scratchPool: newScratchPool[complex64](opts.Workspace, pool, rows*halfCols, rows),
+		linePool:    newScratchPool[complex64](opts.Workspace, nil, 0, rows),
+		pool:        pool,
 	}, nil
 }
 
@@ -122,6 +144,12 @@ func (p *PlanReal2D) SpectrumLen() int {
 	return p.rows * p.halfCols
 }
 
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanReal2D) ScratchLen() int {
+	return p.SpectrumLen() + p.rows + max(p.rowPlan.ScratchLen(), p.colPlans[0].ScratchLen())
+}
+
 // String returns a human-readable description of the PlanReal2D for debugging.
 func (p *PlanReal2D) String() string {
 	return fmt.Sprintf("PlanReal2D[float32→complex64](%dx%d → %dx%d)", p.rows, p.cols, p.rows, p.halfCols)
@@ -137,12 +165,28 @@ func (p *PlanReal2D) String() string {
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
 func (p *PlanReal2D) Forward(dst []complex64, src []float32) error {
+	return p.forward(dst, src, nil)
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanReal2D) ForwardWithScratch(dst []complex64, src []float32, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forward(dst, src, scratch)
+}
+
+func (p *PlanReal2D) forward(dst []complex64, src []float32, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.forwardSingle(dst, src)
+		return p.forwardSingle(dst, src, scratch)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.rows*p.cols, p.rows*p.halfCols, p.options)
@@ -158,7 +202,7 @@ func (p *PlanReal2D) Forward(dst []complex64, src []float32) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.forwardSingle(dst[dstOff:dstOff+p.rows*p.halfCols], src[srcOff:srcOff+p.rows*p.cols])
+		err = p.forwardSingle(dst[dstOff:dstOff+p.rows*p.halfCols], src[srcOff:srcOff+p.rows*p.cols], scratch)
 		if err != nil {
 			return err
 		}
@@ -167,7 +211,7 @@ func (p *PlanReal2D) Forward(dst []complex64, src []float32) error {
 	return nil
 }
 
-func (p *PlanReal2D) forwardSingle(dst []complex64, src []float32) error {
+func (p *PlanReal2D) forwardSingle(dst []complex64, src []float32, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -180,41 +224,63 @@ func (p *PlanReal2D) forwardSingle(dst []complex64, src []float32) error {
 		return ErrLengthMismatch
 	}
 
+	ws, pooled, err := acquireWorkspace[complex64](p.scratchPool, scratch, p.SpectrumLen(), p.rows)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.scratchPool.Put(pooled)
+	}
+
+	err = p.forwardWork(ws.buf, src, p.cols, ws)
+	if err != nil {
+		return err
+	}
+
+	// Copy result to dst, applying normalization
+	scale, _ := normalizationScales(p.options.Normalization, p.Len())
+	copyScaled(dst, ws.buf, scale)
+
+	return nil
+}
+
+// forwardWork computes the unnormalized compact spectrum of src, whose rows
+// start every rowStride reals, in work. work may alias src when rowStride
+// is the padded width 2*(N/2+1).
+func (p *PlanReal2D) forwardWork(work []complex64, src []float32, rowStride int, ws workspace[complex64]) error {
 	// Step 1: Real FFT on each row (float32 input → complex64 half-spectrum)
 	for row := range p.rows {
-		srcRow := src[row*p.cols : (row+1)*p.cols]
-		dstRow := p.scratchCompact[row*p.halfCols : (row+1)*p.halfCols]
+		srcRow := src[row*rowStride : row*rowStride+p.cols]
+		dstRow := work[row*p.halfCols : (row+1)*p.halfCols]
 
-		err := p.rowPlan.Forward(dstRow, srcRow)
+		err := p.rowPlan.forwardWith(dstRow, srcRow, ws.kernel)
 		if err != nil {
 			return err
 		}
 	}
 
 	// Step 2: Complex FFT on each column of the half-spectrum
-	colData := make([]complex64, p.rows)
+	colData := ws.line
 
 	for col := range p.halfCols {
 		// Extract column
 		for row := range p.rows {
-			colData[row] = p.scratchCompact[row*p.halfCols+col]
+			colData[row] = work[row*p.halfCols+col]
 		}
 
 		// Transform column
-		err := p.colPlans[col].InPlace(colData)
+		err := p.colPlans[col].forwardWith(colData, colData, ws.kernel)
 		if err != nil {
 			return err
 		}
 
 		// Write back
 		for row := range p.rows {
-			p.scratchCompact[row*p.halfCols+col] = colData[row]
+			work[row*p.halfCols+col] = colData[row]
 		}
 	}
 
-	// Copy result to dst
-	copy(dst, p.scratchCompact)
-
 	return nil
 }
 
@@ -228,43 +294,59 @@ func (p *PlanReal2D) forwardSingle(dst []complex64, src []float32) error {
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+// Returns ErrScratchRequired for WorkspaceExternal plans.
 func (p *PlanReal2D) ForwardFull(dst []complex64, src []float32) error {
+	return p.ForwardFormat(RealView[float32](dst), src, SpectrumFull)
+}
+
+// FormatLen returns the number of reals that ForwardFormat writes and
+// InverseFormat reads for the given format, or 0 for an unknown format.
+func (p *PlanReal2D) FormatLen(format SpectrumFormat) int {
+	return spectrumFormatLen(format, p.rows, p.cols)
+}
+
+// ForwardFormat computes the 2D real FFT and writes the spectrum to dst in
+// the given format (see SpectrumFormat).
+//
+// Input src: M×N row-major array of float32 (length M*N)
+// Output dst: FormatLen(format) float32 values
+//
+// The spectrum is written to dst in the target layout straight from the
+// column transforms' workspace. Batch and Stride do not apply.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrInvalidFormat for an unknown format.
+// Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+// Returns ErrScratchRequired for WorkspaceExternal plans.
+func (p *PlanReal2D) ForwardFormat(dst, src []float32, format SpectrumFormat) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
-	if len(src) != p.rows*p.cols {
-		return ErrLengthMismatch
+	err := validateSpectrumFormat(format)
+	if err != nil {
+		return err
 	}
 
-	if len(dst) != p.rows*p.cols {
+	if len(src) != p.rows*p.cols || len(dst) != p.FormatLen(format) {
 		return ErrLengthMismatch
 	}
 
-	// First compute compact spectrum
-	err := p.Forward(p.scratchCompact, src)
+	ws, pooled, err := acquireWorkspace[complex64](p.scratchPool, nil, p.SpectrumLen(), p.rows)
 	if err != nil {
 		return err
 	}
 
-	// Expand to full spectrum using conjugate symmetry
-	// For 2D real FFT: X[k, n-l] = conj(X[k, l]) for l = 1..n/2-1
-	for row := range p.rows {
-		// Copy half-spectrum to output
-		for col := range p.halfCols {
-			dst[row*p.cols+col] = p.scratchCompact[row*p.halfCols+col]
-		}
+	defer p.scratchPool.Put(pooled)
 
-		// Fill conjugate pairs for col > N/2
-		for col := p.halfCols; col < p.cols; col++ {
-			mirrorCol := p.cols - col
-			// Need to conjugate and mirror row as well for 2D
-			mirrorRow := (p.rows - row) % p.rows
-			val := dst[mirrorRow*p.cols+mirrorCol]
-			dst[row*p.cols+col] = complex(real(val), -imag(val))
-		}
+	err = p.forwardWork(ws.buf, src, p.cols, ws)
+	if err != nil {
+		return err
 	}
 
+	scale, _ := normalizationScales(p.options.Normalization, p.Len())
+	storeSpectrum(dst, ws.buf, []int{p.rows, p.cols}, format, scale)
+
 	return nil
 }
 
@@ -275,13 +357,30 @@ func (p *PlanReal2D) ForwardFull(dst []complex64, src []float32) error {
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+// Returns a *SymmetryError (ErrInvalidSpectrum) if the DC or Nyquist plane
+// is not Hermitian under SymmetryStrict.
 func (p *PlanReal2D) Inverse(dst []float32, src []complex64) error {
+	return p.inverse(dst, src, nil)
+}
+
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanReal2D) InverseWithScratch(dst []float32, src []complex64, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverse(dst, src, scratch)
+}
+
+func (p *PlanReal2D) inverse(dst []float32, src []complex64, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.inverseSingle(dst, src)
+		return p.inverseSingle(dst, src, scratch)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.rows*p.cols, p.rows*p.halfCols, p.options)
@@ -297,7 +396,7 @@ func (p *PlanReal2D) Inverse(dst []float32, src []complex64) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.inverseSingle(dst[dstOff:dstOff+p.rows*p.cols], src[srcOff:srcOff+p.rows*p.halfCols])
+		err = p.inverseSingle(dst[dstOff:dstOff+p.rows*p.cols], src[srcOff:srcOff+p.rows*p.halfCols], scratch)
 		if err != nil {
 			return err
 		}
@@ -306,7 +405,7 @@ func (p *PlanReal2D) Inverse(dst []float32, src []complex64) error {
 	return nil
 }
 
-func (p *PlanReal2D) inverseSingle(dst []float32, src []complex64) error {
+func (p *PlanReal2D) inverseSingle(dst []float32, src []complex64, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -319,36 +418,58 @@ func (p *PlanReal2D) inverseSingle(dst []float32, src []complex64) error {
 		return ErrLengthMismatch
 	}
 
-	// Copy src to scratch
-	copy(p.scratchCompact, src)
+	ws, pooled, err := acquireWorkspace[complex64](p.scratchPool, scratch, p.SpectrumLen(), p.rows)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.scratchPool.Put(pooled)
+	}
 
+	// Copy src to scratch, applying normalization
+	_, scale := normalizationScales(p.options.Normalization, p.Len())
+	copyScaled(ws.buf, src, scale)
+
+	err = applySymmetry(ws.buf, []int{p.rows, p.cols}, p.options)
+	if err != nil {
+		return err
+	}
+
+	return p.inverseWork(dst, p.cols, ws.buf, ws)
+}
+
+// inverseWork transforms the compact spectrum in work, destroying it, and
+// writes the real result to dst with rows every rowStride reals. work may
+// alias dst when rowStride is the padded width 2*(N/2+1).
+func (p *PlanReal2D) inverseWork(dst []float32, rowStride int, work []complex64, ws workspace[complex64]) error {
 	// Step 1: Complex IFFT on each column
-	colData := make([]complex64, p.rows)
+	colData := ws.line
 
 	for col := range p.halfCols {
 		// Extract column
 		for row := range p.rows {
-			colData[row] = p.scratchCompact[row*p.halfCols+col]
+			colData[row] = work[row*p.halfCols+col]
 		}
 
 		// Inverse transform column
-		err := p.colPlans[col].InverseInPlace(colData)
+		err := p.colPlans[col].inverseWith(colData, colData, ws.kernel)
 		if err != nil {
 			return err
 		}
 
 		// Write back
 		for row := range p.rows {
-			p.scratchCompact[row*p.halfCols+col] = colData[row]
+			work[row*p.halfCols+col] = colData[row]
 		}
 	}
 
 	// Step 2: Real IFFT on each row (complex64 half-spectrum → float32)
 	for row := range p.rows {
-		srcRow := p.scratchCompact[row*p.halfCols : (row+1)*p.halfCols]
-		dstRow := dst[row*p.cols : (row+1)*p.cols]
+		srcRow := work[row*p.halfCols : (row+1)*p.halfCols]
+		dstRow := dst[row*rowStride : row*rowStride+p.cols]
 
-		err := p.rowPlan.Inverse(dstRow, srcRow)
+		err := p.rowPlan.inverseWith(dstRow, srcRow, ws.kernel)
 		if err != nil {
 			return err
 		}
@@ -362,65 +483,176 @@ func (p *PlanReal2D) inverseSingle(dst []float32, src []complex64) error {
 // Input src: M×N row-major array of complex64
 // Output dst: M×N row-major array of float32
 //
-// The input should have conjugate symmetry (as produced by ForwardFull).
-// Only the non-redundant half is used; the rest is ignored.
+// The input should have conjugate symmetry (as produced by ForwardFull);
+// PlanOptions.Symmetry decides how deviations are handled.
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+// Returns ErrScratchRequired for WorkspaceExternal plans.
 func (p *PlanReal2D) InverseFull(dst []float32, src []complex64) error {
+	return p.InverseFormat(dst, RealView[float32](src), SpectrumFull)
+}
+
+// InverseFormat computes the 2D real IFFT from a spectrum in the given
+// format, as produced by ForwardFormat.
+//
+// Input src: FormatLen(format) float32 values
+// Output dst: M×N row-major array of float32
+//
+// Bins that a format omits are rebuilt from conjugate symmetry while the
+// spectrum is unpacked into the workspace. Batch and Stride do not apply.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrInvalidFormat for an unknown format.
+// Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+// Returns ErrScratchRequired for WorkspaceExternal plans.
+func (p *PlanReal2D) InverseFormat(dst, src []float32, format SpectrumFormat) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
-	if len(src) != p.rows*p.cols {
-		return ErrLengthMismatch
+	err := validateSpectrumFormat(format)
+	if err != nil {
+		return err
 	}
 
-	if len(dst) != p.rows*p.cols {
+	if len(dst) != p.rows*p.cols || len(src) != p.FormatLen(format) {
 		return ErrLengthMismatch
 	}
 
-	// Extract compact half-spectrum from full spectrum
-	for row := range p.rows {
-		for col := range p.halfCols {
-			p.scratchCompact[row*p.halfCols+col] = src[row*p.cols+col]
-		}
+	ws, pooled, err := acquireWorkspace[complex64](p.scratchPool, nil, p.SpectrumLen(), p.rows)
+	if err != nil {
+		return err
+	}
+
+	defer p.scratchPool.Put(pooled)
+
+	_, scale := normalizationScales(p.options.Normalization, p.Len())
+	err = loadSymmetric(ws.buf, src, []int{p.rows, p.cols}, format, scale, p.options)
+	if err != nil {
+		return err
 	}
 
-	// Use compact inverse
-	return p.Inverse(dst, p.scratchCompact)
+	return p.inverseWork(dst, p.cols, ws.buf, ws)
+}
+
+// PaddedLen returns the number of reals in the buffer of ForwardInPlace and
+// InverseInPlace: M×2*(N/2+1), each row padded by two reals.
+func (p *PlanReal2D) PaddedLen() int {
+	return p.rows * 2 * p.halfCols
 }
 
-// Clone creates an independent copy of the PlanReal2D for concurrent use.
+// ForwardInPlace computes the 2D real FFT in place, FFTW style.
 //
-// The clone shares immutable data but has its own:
-// - Scratch buffers (for thread safety)
-// - 1D plan instances (cloned from originals)
+// Input data: PaddedLen float32 values; row r holds its N samples in
+// data[r*2*(N/2+1):][:N], the two padding reals are ignored.
+// Output: ComplexView[complex64](data) holds the M×(N/2+1) compact spectrum.
 //
-// This allows multiple goroutines to perform transforms concurrently.
-func (p *PlanReal2D) Clone() *PlanReal2D {
-	// Allocate new scratch buffers
-	compactSize := p.rows * p.halfCols
-	fullSize := p.rows * p.cols
+// The spectrum overwrites the samples, so the matrix needs one buffer
+// instead of separate real and complex arrays. Batch and Stride do not apply.
+//
+// Returns ErrNilSlice if data is nil.
+// Returns ErrLengthMismatch if len(data) != PaddedLen().
+// Returns ErrScratchRequired for WorkspaceExternal plans.
+func (p *PlanReal2D) ForwardInPlace(data []float32) error {
+	if data == nil {
+		return ErrNilSlice
+	}
 
-	scratchCompact, scratchCompactBacking := mem.AllocAlignedComplex64(compactSize)
-	scratchFull, scratchFullBacking := mem.AllocAlignedComplex64(fullSize)
+	if len(data) != p.PaddedLen() {
+		return ErrLengthMismatch
+	}
 
-	// Clone column plans
-	colPlans := make([]*Plan[complex64], p.halfCols)
-	for i := range colPlans {
-		colPlans[i] = p.colPlans[i].Clone()
+	ws, pooled, err := acquireWorkspace[complex64](p.linePool, nil, 0, p.rows)
+	if err != nil {
+		return err
+	}
+
+	defer p.linePool.Put(pooled)
+
+	work := ComplexView[complex64](data)
+
+	err = p.forwardWork(work, data, 2*p.halfCols, ws)
+	if err != nil {
+		return err
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.Len())
+	scaleSpectrumGeneric(work, scale)
+
+	return nil
+}
+
+// InverseInPlace is the inverse of ForwardInPlace: data, viewed as complex,
+// holds the M×(N/2+1) compact spectrum, and on return row r of the real
+// result is data[r*2*(N/2+1):][:N].
+//
+// Returns ErrNilSlice if data is nil.
+// Returns ErrLengthMismatch if len(data) != PaddedLen().
+// Returns ErrScratchRequired for WorkspaceExternal plans.
+func (p *PlanReal2D) InverseInPlace(data []float32) error {
+	if data == nil {
+		return ErrNilSlice
+	}
+
+	if len(data) != p.PaddedLen() {
+		return ErrLengthMismatch
+	}
+
+	ws, pooled, err := acquireWorkspace[complex64](p.linePool, nil, 0, p.rows)
+	if err != nil {
+		return err
 	}
 
+	defer p.linePool.Put(pooled)
+
+	work := ComplexView[complex64](data)
+
+	err = applySymmetry(work, []int{p.rows, p.cols}, p.options)
+	if err != nil {
+		return err
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.Len())
+	scaleSpectrumGeneric(work, scale)
+
+	return p.inverseWork(data, 2*p.halfCols, work, ws)
+}
+
+// Clone creates an independent copy of the PlanReal2D.
+//
+// PlanReal2D is already safe for concurrent use, so Clone is only needed to
+// give a goroutine its own scratch pool. The clone shares the immutable
+// 1D plans. Clones are never pooled; a pooled plan must outlive its clones.
+func (p *PlanReal2D) Clone() *PlanReal2D {
 	return &PlanReal2D{
-		rows:                  p.rows,
-		cols:                  p.cols,
-		halfCols:              p.halfCols,
-		rowPlan:               p.rowPlan, // PlanReal doesn't have Clone yet, share for now
-		colPlans:              colPlans,
-		scratchCompact:        scratchCompact,
-		scratchFull:           scratchFull,
-		scratchCompactBacking: scratchCompactBacking,
-		scratchFullBacking:    scratchFullBacking,
+		rows:        p.rows,
+		cols:        p.cols,
+		halfCols:    p.halfCols,
+		rowPlan:     p.rowPlan,
+		colPlans:    p.colPlans,
+		options:     p.options,
+		scratchPool: newScratchPool[complex64](p.options.Workspace, nil, p.rows*p.halfCols, p.rows),
+		linePool:    newScratchPool[complex64](p.options.Workspace, nil, 0, p.rows),
 	}
 }
+
+// Close returns the buffers of a plan created with NewPlanReal2DFromPool to
+// its pool. After Close, the plan must not be used. Close is a no-op for
+// other plans and safe to call multiple times.
+func (p *PlanReal2D) Close() {
+	if p.pool == nil {
+		return
+	}
+
+	p.rowPlan.Close()
+
+	for _, plan := range p.colPlans {
+		plan.Close()
+	}
+
+	releaseWorkspacePool[complex64](p.scratchPool, p.pool)
+
+	p.pool = nil
+	p.scratchPool = nil
+}
@@ -2,8 +2,9 @@ package algofft
 
 import (
 	"fmt"
+	"sync"
 
-	mem "github.com/MeKo-Christian/algo-fft/internal/memory"
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
 )
 
 // PlanReal3D is a pre-computed 3D real FFT plan for float32 input volumes.
@@ -18,28 +19,60 @@ import (
 // - Input (real): row-major D×H×W float32 array
 // - Compact output: row-major D×H×(W/2+1) complex64 array
 // - Full output: row-major D×H×W complex64 array (with redundant conjugate pairs).
+//
+// Plans are safe for concurrent use; scratch is drawn from a per-plan pool,
+// or supplied by the caller through ForwardWithScratch and InverseWithScratch.
 type PlanReal3D struct {
 	depth, height, width int                // Input dimensions (D×H×W real values)
 	halfWidth            int                // W/2+1 (compact spectrum width)
 	widthPlan            *PlanReal          // Real FFT for width (size W → W/2+1)
 	heightPlans          []*Plan[complex64] // Complex FFT for height (one per width column)
 	depthPlans           []*Plan[complex64] // Complex FFT for depth (one per height×width position)
-	scratchCompact       []complex64        // Working buffer (D×H×(W/2+1))
-	scratchFull          []complex64        // Full spectrum buffer (D×H×W) for ForwardFull
+	options              PlanOptions
+
+	// scratchPool provides per-call workspaces
+	// (buf D×H×(W/2+1), line size max(D, H)).
+	scratchPool *sync.Pool
+
+	// linePool provides the workspaces of ForwardInPlace and InverseInPlace,
+	// which transform the caller's buffer and need no buf.
+	linePool *sync.Pool
 
-	// backing keeps aligned buffers alive for GC
-	scratchCompactBacking []byte
-	scratchFullBacking    []byte
+	// pool is the buffer pool this plan was allocated from (nil if not pooled).
+	pool *BufferPool
 }
 
 // NewPlanReal3D creates a new 3D real FFT plan for a D×H×W real volume.
 //
 // All dimensions must be ≥ 2, and width must be even (required by the real FFT algorithm).
 //
-// The plan pre-allocates all necessary buffers, enabling zero-allocation transforms.
-//
-// For concurrent use, create separate plans via Clone() for each goroutine.
+// Scratch buffers are pooled per plan, enabling zero-allocation transforms in steady state.
 func NewPlanReal3D(depth, height, width int) (*PlanReal3D, error) {
+	return NewPlanReal3DWithOptions(depth, height, width, PlanOptions{})
+}
+
+// NewPlanReal3DWithOptions creates a new 3D real FFT plan with explicit planner options.
+func NewPlanReal3DWithOptions(depth, height, width int, opts PlanOptions) (*PlanReal3D, error) {
+	return newPlanReal3D(depth, height, width, nil, opts)
+}
+
+// NewPlanReal3DFromPool creates a new 3D real FFT plan whose 1D plans and
+// workspaces are drawn from pool. Call Close when the plan is no longer
+// needed to hand the buffers back.
+func NewPlanReal3DFromPool(depth, height, width int, pool *BufferPool) (*PlanReal3D, error) {
+	return NewPlanReal3DFromPoolWithOptions(depth, height, width, pool, PlanOptions{})
+}
+
+// NewPlanReal3DFromPoolWithOptions is NewPlanReal3DFromPool with explicit planner options.
+func NewPlanReal3DFromPoolWithOptions(depth, height, width int, pool *BufferPool, opts PlanOptions) (*PlanReal3D, error) {
+	if pool == nil {
+		pool = DefaultBufferPool()
+	}
+
+	return newPlanReal3D(depth, height, width, pool, opts)
+}
+
+func newPlanReal3D(depth, height, width int, pool *BufferPool, opts PlanOptions) (*PlanReal3D, error) {
 	if depth <= 0 || height <= 0 || width <= 0 {
 		return nil, ErrInvalidLength
 	}
@@ -48,8 +81,20 @@ func NewPlanReal3D(depth, height, width int) (*PlanReal3D, error) {
 		return nil, ErrInvalidLength // Real FFT requires even W
 	}
 
+	opts = normalizePlanOptions(opts)
+	features := cpu.DetectFeatures()
+
+	childOpts := opts
+	childOpts.Batch = 0
+	childOpts.Stride = 0
+	childOpts.InPlace = false
+	// Normalization is applied once by this plan, not per dimension.
+	childOpts.Normalization = NormBackward
+	// Symmetry is enforced once on the whole spectrum, not per row.
+	childOpts.Symmetry = SymmetryIgnore
+
 	// Create 1D real plan for width
-	widthPlan, err := NewPlanReal(width)
+	widthPlan, err := newPlanRealWithFeatures(width, features, pool, childOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +104,9 @@ func NewPlanReal3D(depth, height, width int) (*PlanReal3D, error) {
 	// Create complex plans for height (one for each column in compact spectrum)
 	heightPlans := make([]*Plan[complex64], halfWidth)
 	for i := range heightPlans {
-		plan, err := NewPlanT[complex64](height)
+		plan, err := newChildPlan[complex64](height, features, pool, childOpts)
 		if err != nil {
+			widthPlan.Close()
 			return nil, err
 		}
 
@@ -70,33 +116,32 @@ func NewPlanReal3D(depth, height, width int) (*PlanReal3D, error) {
 	// Create complex plans for depth (one for each height×width position)
 	depthPlans := make([]*Plan[complex64], height*halfWidth)
 	for i := range depthPlans {
-		plan, err := NewPlanT[complex64](depth)
+		plan, err := newChildPlan[complex64](depth, features, pool, childOpts)
 		if err != nil {
+			widthPlan.Close()
+
+			for _, created := range heightPlans {
+				created.Close()
+			}
+
 			return nil, err
 		}
 
 		depthPlans[i] = plan
 	}
 
-	// Allocate scratch buffers (aligned for SIMD)
-	compactSize := depth * height * halfWidth
-	fullSize := depth * height * width
-
-	scratchCompact, scratchCompactBacking := mem.AllocAlignedComplex64(compactSize)
-	scratchFull, scratchFullBacking := mem.AllocAlignedComplex64(fullSize)
-
 	return &PlanReal3D{
-		depth:                 depth,
-		height:                height,
-		width:                 width,
-		halfWidth:             halfWidth,
-		widthPlan:             widthPlan,
-		heightPlans:           heightPlans,
-		depthPlans:            depthPlans,
-		scratchCompact:        scratchCompact,
-		scratchFull:           scratchFull,
-		scratchCompactBacking: scratchCompactBacking,
-		scratchFullBacking:    scratchFullBacking,
+		depth:       depth,
+		height:      height,
+		width:       width,
+		halfWidth:   halfWidth,
+		widthPlan:   widthPlan,
+		heightPlans: heightPlans,
+		depthPlans:  depthPlans,
+		options:     opts,
+		scratchPool: newScratchPool[complex64](opts.Workspace, pool, depth*height*halfWidth, max(depth, height)),
+		linePool:    newScratchPool[complex64](opts.Workspace, nil, 0, max(depth, height)),
+		pool:        pool,
 	}, nil
 }
 
@@ -125,6 +170,14 @@ func (p *PlanReal3D) SpectrumLen() int {
 	return p.depth * p.height * p.halfWidth
 }
 
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanReal3D) ScratchLen() int {
+	kernelLen := max(p.widthPlan.ScratchLen(), p.heightPlans[0].ScratchLen(), p.depthPlans[0].ScratchLen())
+
+	return p.SpectrumLen() + max(p.depth, p.height) + kernelLen
+}
+
 // String returns a human-readable description of the PlanReal3D for debugging.
 func (p *PlanReal3D) String() string {
 	return fmt.Sprintf("PlanReal3D[float32→complex64](%dx%dx%d → %dx%dx%d)",
@@ -140,9 +193,23 @@ func (p *PlanReal3D) String() string {
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
-//
-//nolint:gocognit
 func (p *PlanReal3D) Forward(dst []complex64, src []float32) error {
+	return p.forward(dst, src, nil)
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanReal3D) ForwardWithScratch(dst []complex64, src []float32, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forward(dst, src, scratch)
+}
+
+func (p *PlanReal3D) forward(dst []complex64, src []float32, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -158,16 +225,43 @@ func (p *PlanReal3D) Forward(dst []complex64, src []float32) error {
 		return ErrLengthMismatch
 	}
 
+	ws, pooled, err := acquireWorkspace[complex64](p.scratchPool, scratch, p.SpectrumLen(), max(p.depth, p.height))
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.scratchPool.Put(pooled)
+	}
+
+	err = p.forwardWork(ws.buf, src, p.width, ws)
+	if err != nil {
+		return err
+	}
+
+	// Copy result to dst, applying normalization
+	scale, _ := normalizationScales(p.options.Normalization, p.Len())
+	copyScaled(dst, ws.buf, scale)
+
+	return nil
+}
+
+// forwardWork computes the unnormalized compact spectrum of src, whose rows
+// start every rowStride reals, in work. work may alias src when rowStride
+// is the padded width 2*(W/2+1).
+//
+//nolint:gocognit
+func (p *PlanReal3D) forwardWork(work []complex64, src []float32, rowStride int, ws workspace[complex64]) error {
 	// Step 1: Real FFT along width (innermost dimension)
 	for d := range p.depth {
 		for h := range p.height {
-			srcOffset := d*p.height*p.width + h*p.width
+			srcOffset := (d*p.height + h) * rowStride
 			dstOffset := d*p.height*p.halfWidth + h*p.halfWidth
 
 			srcRow := src[srcOffset : srcOffset+p.width]
-			dstRow := p.scratchCompact[dstOffset : dstOffset+p.halfWidth]
+			dstRow := work[dstOffset : dstOffset+p.halfWidth]
 
-			err := p.widthPlan.Forward(dstRow, srcRow)
+			err := p.widthPlan.forwardWith(dstRow, srcRow, ws.kernel)
 			if err != nil {
 				return err
 			}
@@ -175,56 +269,53 @@ func (p *PlanReal3D) Forward(dst []complex64, src []float32) error {
 	}
 
 	// Step 2: Complex FFT along height (middle dimension)
-	heightData := make([]complex64, p.height)
+	heightData := ws.line[:p.height]
 
 	for d := range p.depth {
 		for w := range p.halfWidth {
 			// Extract column along height
 			for h := range p.height {
-				heightData[h] = p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w]
+				heightData[h] = work[d*p.height*p.halfWidth+h*p.halfWidth+w]
 			}
 
 			// Transform column
-			err := p.heightPlans[w].InPlace(heightData)
+			err := p.heightPlans[w].forwardWith(heightData, heightData, ws.kernel)
 			if err != nil {
 				return err
 			}
 
 			// Write back
 			for h := range p.height {
-				p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w] = heightData[h]
+				work[d*p.height*p.halfWidth+h*p.halfWidth+w] = heightData[h]
 			}
 		}
 	}
 
 	// Step 3: Complex FFT along depth (outermost dimension)
-	depthData := make([]complex64, p.depth)
+	depthData := ws.line[:p.depth]
 
 	for h := range p.height {
 		for w := range p.halfWidth {
 			// Extract slice along depth
 			for d := range p.depth {
-				depthData[d] = p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w]
+				depthData[d] = work[d*p.height*p.halfWidth+h*p.halfWidth+w]
 			}
 
 			// Transform depth slice
 			planIdx := h*p.halfWidth + w
 
-			err := p.depthPlans[planIdx].InPlace(depthData)
+			err := p.depthPlans[planIdx].forwardWith(depthData, depthData, ws.kernel)
 			if err != nil {
 				return err
 			}
 
 			// Write back
 			for d := range p.depth {
-				p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w] = depthData[d]
+				work[d*p.height*p.halfWidth+h*p.halfWidth+w] = depthData[d]
 			}
 		}
 	}
 
-	// Copy result to dst
-	copy(dst, p.scratchCompact)
-
 	return nil
 }
 
@@ -238,49 +329,59 @@ func (p *PlanReal3D) Forward(dst []complex64, src []float32) error {
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+// Returns ErrScratchRequired for WorkspaceExternal plans.
 func (p *PlanReal3D) ForwardFull(dst []complex64, src []float32) error {
+	return p.ForwardFormat(RealView[float32](dst), src, SpectrumFull)
+}
+
+// FormatLen returns the number of reals that ForwardFormat writes and
+// InverseFormat reads for the given format, or 0 for an unknown format.
+func (p *PlanReal3D) FormatLen(format SpectrumFormat) int {
+	return spectrumFormatLen(format, p.depth, p.height, p.width)
+}
+
+// ForwardFormat computes the 3D real FFT and writes the spectrum to dst in
+// the given format (see SpectrumFormat).
+//
+// Input src: D×H×W row-major array of float32 (length D*H*W)
+// Output dst: FormatLen(format) float32 values
+//
+// The spectrum is written to dst in the target layout straight from the
+// depth transforms' workspace.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrInvalidFormat for an unknown format.
+// Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+// Returns ErrScratchRequired for WorkspaceExternal plans.
+func (p *PlanReal3D) ForwardFormat(dst, src []float32, format SpectrumFormat) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
-	expectedSrcLen := p.depth * p.height * p.width
-	expectedDstLen := p.depth * p.height * p.width
-
-	if len(src) != expectedSrcLen {
-		return ErrLengthMismatch
+	err := validateSpectrumFormat(format)
+	if err != nil {
+		return err
 	}
 
-	if len(dst) != expectedDstLen {
+	if len(src) != p.Len() || len(dst) != p.FormatLen(format) {
 		return ErrLengthMismatch
 	}
 
-	// First compute compact spectrum
-	err := p.Forward(p.scratchCompact, src)
+	ws, pooled, err := acquireWorkspace[complex64](p.scratchPool, nil, p.SpectrumLen(), max(p.depth, p.height))
 	if err != nil {
 		return err
 	}
 
-	// Expand to full spectrum using conjugate symmetry
-	// For 3D real FFT: X[kd, kh, w-kw] = conj(X[kd, kh, kw]) for kw = 1..w/2-1
-	for d := range p.depth {
-		for h := range p.height {
-			// Copy half-spectrum to output
-			for w := range p.halfWidth {
-				dst[d*p.height*p.width+h*p.width+w] = p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w]
-			}
+	defer p.scratchPool.Put(pooled)
 
-			// Fill conjugate pairs for w > W/2
-			for w := p.halfWidth; w < p.width; w++ {
-				mirrorW := p.width - w
-				// For 3D, need to mirror all dimensions for conjugate symmetry
-				mirrorD := (p.depth - d) % p.depth
-				mirrorH := (p.height - h) % p.height
-				val := dst[mirrorD*p.height*p.width+mirrorH*p.width+mirrorW]
-				dst[d*p.height*p.width+h*p.width+w] = complex(real(val), -imag(val))
-			}
-		}
+	err = p.forwardWork(ws.buf, src, p.width, ws)
+	if err != nil {
+		return err
 	}
 
+	scale, _ := normalizationScales(p.options.Normalization, p.Len())
+	storeSpectrum(dst, ws.buf, []int{p.depth, p.height, p.width}, format, scale)
+
 	return nil
 }
 
@@ -291,9 +392,24 @@ func (p *PlanReal3D) ForwardFull(dst []complex64, src []float32) error {
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
-//
-//nolint:gocognit
+// Returns a *SymmetryError (ErrInvalidSpectrum) if the DC or Nyquist plane
+// is not Hermitian under SymmetryStrict.
 func (p *PlanReal3D) Inverse(dst []float32, src []complex64) error {
+	return p.inverse(dst, src, nil)
+}
+
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanReal3D) InverseWithScratch(dst []float32, src []complex64, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverse(dst, src, scratch)
+}
+
+func (p *PlanReal3D) inverse(dst []float32, src []complex64, scratch []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -309,53 +425,77 @@ func (p *PlanReal3D) Inverse(dst []float32, src []complex64) error {
 		return ErrLengthMismatch
 	}
 
-	// Copy src to scratch
-	copy(p.scratchCompact, src)
+	ws, pooled, err := acquireWorkspace[complex64](p.scratchPool, scratch, p.SpectrumLen(), max(p.depth, p.height))
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.scratchPool.Put(pooled)
+	}
+
+	// Copy src to scratch, applying normalization
+	_, scale := normalizationScales(p.options.Normalization, p.Len())
+	copyScaled(ws.buf, src, scale)
+
+	err = applySymmetry(ws.buf, []int{p.depth, p.height, p.width}, p.options)
+	if err != nil {
+		return err
+	}
+
+	return p.inverseWork(dst, p.width, ws.buf, ws)
+}
 
+// inverseWork transforms the compact spectrum in work, destroying it, and
+// writes the real result to dst with rows every rowStride reals. work may
+// alias dst when rowStride is the padded width 2*(W/2+1).
+//
+//nolint:gocognit
+func (p *PlanReal3D) inverseWork(dst []float32, rowStride int, work []complex64, ws workspace[complex64]) error {
 	// Step 1: Complex IFFT along depth (outermost dimension)
-	depthData := make([]complex64, p.depth)
+	depthData := ws.line[:p.depth]
 
 	for h := range p.height {
 		for w := range p.halfWidth {
 			// Extract slice along depth
 			for d := range p.depth {
-				depthData[d] = p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w]
+				depthData[d] = work[d*p.height*p.halfWidth+h*p.halfWidth+w]
 			}
 
 			// Inverse transform depth slice
 			planIdx := h*p.halfWidth + w
 
-			err := p.depthPlans[planIdx].InverseInPlace(depthData)
+			err := p.depthPlans[planIdx].inverseWith(depthData, depthData, ws.kernel)
 			if err != nil {
 				return err
 			}
 
 			// Write back
 			for d := range p.depth {
-				p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w] = depthData[d]
+				work[d*p.height*p.halfWidth+h*p.halfWidth+w] = depthData[d]
 			}
 		}
 	}
 
 	// Step 2: Complex IFFT along height (middle dimension)
-	heightData := make([]complex64, p.height)
+	heightData := ws.line[:p.height]
 
 	for d := range p.depth {
 		for w := range p.halfWidth {
 			// Extract column along height
 			for h := range p.height {
-				heightData[h] = p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w]
+				heightData[h] = work[d*p.height*p.halfWidth+h*p.halfWidth+w]
 			}
 
 			// Inverse transform column
-			err := p.heightPlans[w].InverseInPlace(heightData)
+			err := p.heightPlans[w].inverseWith(heightData, heightData, ws.kernel)
 			if err != nil {
 				return err
 			}
 
 			// Write back
 			for h := range p.height {
-				p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w] = heightData[h]
+				work[d*p.height*p.halfWidth+h*p.halfWidth+w] = heightData[h]
 			}
 		}
 	}
@@ -364,12 +504,12 @@ func (p *PlanReal3D) Inverse(dst []float32, src []complex64) error {
 	for d := range p.depth {
 		for h := range p.height {
 			srcOffset := d*p.height*p.halfWidth + h*p.halfWidth
-			dstOffset := d*p.height*p.width + h*p.width
+			dstOffset := (d*p.height + h) * rowStride
 
-			srcRow := p.scratchCompact[srcOffset : srcOffset+p.halfWidth]
+			srcRow := work[srcOffset : srcOffset+p.halfWidth]
 			dstRow := dst[dstOffset : dstOffset+p.width]
 
-			err := p.widthPlan.Inverse(dstRow, srcRow)
+			err := p.widthPlan.inverseWith(dstRow, srcRow, ws.kernel)
 			if err != nil {
 				return err
 			}
@@ -384,78 +524,182 @@ func (p *PlanReal3D) Inverse(dst []float32, src []complex64) error {
 // Input src: D×H×W row-major array of complex64
 // Output dst: D×H×W row-major array of float32
 //
-// The input should have conjugate symmetry (as produced by ForwardFull).
-// Only the non-redundant half is used; the rest is ignored.
+// The input should have conjugate symmetry (as produced by ForwardFull);
+// PlanOptions.Symmetry decides how deviations are handled.
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+// Returns ErrScratchRequired for WorkspaceExternal plans.
 func (p *PlanReal3D) InverseFull(dst []float32, src []complex64) error {
+	return p.InverseFormat(dst, RealView[float32](src), SpectrumFull)
+}
+
+// InverseFormat computes the 3D real IFFT from a spectrum in the given
+// format, as produced by ForwardFormat.
+//
+// Input src: FormatLen(format) float32 values
+// Output dst: D×H×W row-major array of float32
+//
+// Bins that a format omits are rebuilt from conjugate symmetry while the
+// spectrum is unpacked into the workspace.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrInvalidFormat for an unknown format.
+// Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+// Returns ErrScratchRequired for WorkspaceExternal plans.
+func (p *PlanReal3D) InverseFormat(dst, src []float32, format SpectrumFormat) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
-	expectedSrcLen := p.depth * p.height * p.width
-	expectedDstLen := p.depth * p.height * p.width
+	err := validateSpectrumFormat(format)
+	if err != nil {
+		return err
+	}
 
-	if len(src) != expectedSrcLen {
+	if len(dst) != p.Len() || len(src) != p.FormatLen(format) {
 		return ErrLengthMismatch
 	}
 
-	if len(dst) != expectedDstLen {
-		return ErrLengthMismatch
+	ws, pooled, err := acquireWorkspace[complex64](p.scratchPool, nil, p.SpectrumLen(), max(p.depth, p.height))
+	if err != nil {
+		return err
 	}
 
-	// Extract compact half-spectrum from full spectrum
-	for d := range p.depth {
-		for h := range p.height {
-			for w := range p.halfWidth {
-				p.scratchCompact[d*p.height*p.halfWidth+h*p.halfWidth+w] = src[d*p.height*p.width+h*p.width+w]
-			}
-		}
+	defer p.scratchPool.Put(pooled)
+
+	_, scale := normalizationScales(p.options.Normalization, p.Len())
+	err = loadSymmetric(ws.buf, src, []int{p.depth, p.height, p.width}, format, scale, p.options)
+	if err != nil {
+		return err
 	}
 
-	// Use compact inverse
-	return p.Inverse(dst, p.scratchCompact)
+	return p.inverseWork(dst, p.width, ws.buf, ws)
 }
 
-// Clone creates an independent copy of the PlanReal3D for concurrent use.
+// PaddedLen returns the number of reals in the buffer of ForwardInPlace and
+// InverseInPlace: D×H×2*(W/2+1), each row padded by two reals.
+func (p *PlanReal3D) PaddedLen() int {
+	return p.depth * p.height * 2 * p.halfWidth
+}
+
+// ForwardInPlace computes the 3D real FFT in place, FFTW style.
 //
-// The clone shares immutable data but has its own:
-// - Scratch buffers (for thread safety)
-// - 1D plan instances (cloned from originals)
+// Input data: PaddedLen float32 values; the row at (d, h) holds its W
+// samples in data[(d*H+h)*2*(W/2+1):][:W], the two padding reals are ignored.
+// Output: ComplexView[complex64](data) holds the D×H×(W/2+1) compact spectrum.
 //
-// This allows multiple goroutines to perform transforms concurrently.
-func (p *PlanReal3D) Clone() *PlanReal3D {
-	// Allocate new scratch buffers
-	compactSize := p.depth * p.height * p.halfWidth
-	fullSize := p.depth * p.height * p.width
+// The spectrum overwrites the samples, so a volume needs one buffer instead
+// of separate real and complex arrays.
+//
+// Returns ErrNilSlice if data is nil.
+// Returns ErrLengthMismatch if len(data) != PaddedLen().
+// Returns ErrScratchRequired for WorkspaceExternal plans.
+func (p *PlanReal3D) ForwardInPlace(data []float32) error {
+	if data == nil {
+		return ErrNilSlice
+	}
 
-	scratchCompact, scratchCompactBacking := mem.AllocAlignedComplex64(compactSize)
-	scratchFull, scratchFullBacking := mem.AllocAlignedComplex64(fullSize)
+	if len(data) != p.PaddedLen() {
+		return ErrLengthMismatch
+	}
 
-	// Clone height plans
-	heightPlans := make([]*Plan[complex64], p.halfWidth)
-	for i := range heightPlans {
-		heightPlans[i] = p.heightPlans[i].Clone()
+	ws, pooled, err := acquireWorkspace[complex64](p.linePool, nil, 0, max(p.depth, p.height))
+	if err != nil {
+		return err
 	}
 
-	// Clone depth plans
-	depthPlans := make([]*Plan[complex64], p.height*p.halfWidth)
-	for i := range depthPlans {
-		depthPlans[i] = p.depthPlans[i].Clone()
+	defer p.linePool.Put(pooled)
+
+	work := ComplexView[complex64](data)
+
+	err = p.forwardWork(work, data, 2*p.halfWidth, ws)
+	if err != nil {
+		return err
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.Len())
+	scaleSpectrumGeneric(work, scale)
+
+	return nil
+}
+
+// InverseInPlace is the inverse of ForwardInPlace: data, viewed as complex,
+// holds the D×H×(W/2+1) compact spectrum, and on return the row at (d, h)
+// of the real result is data[(d*H+h)*2*(W/2+1):][:W].
+//
+// Returns ErrNilSlice if data is nil.
+// Returns ErrLengthMismatch if len(data) != PaddedLen().
+// Returns ErrScratchRequired for WorkspaceExternal plans.
+func (p *PlanReal3D) InverseInPlace(data []float32) error {
+	if data == nil {
+		return ErrNilSlice
+	}
+
+	if len(data) != p.PaddedLen() {
+		return ErrLengthMismatch
+	}
+
+	ws, pooled, err := acquireWorkspace[complex64](p.linePool, nil, 0, max(p.depth, p.height))
+	if err != nil {
+		return err
 	}
 
+	defer p.linePool.Put(pooled)
+
+	work := ComplexView[complex64](data)
+
+	err = applySymmetry(work, []int{p.depth, p.height, p.width}, p.options)
+	if err != nil {
+		return err
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.Len())
+	scaleSpectrumGeneric(work, scale)
+
+	return p.inverseWork(data, 2*p.halfWidth, work, ws)
+}
+
+// Clone creates an independent copy of the PlanReal3D.
+//
+// PlanReal3D is already safe for concurrent use, so Clone is only needed to
+// give a goroutine its own scratch pool. The clone shares the immutable
+// 1D plans. Clones are never pooled; a pooled plan must outlive its clones.
+func (p *PlanReal3D) Clone() *PlanReal3D {
 	return &PlanReal3D{
-		depth:                 p.depth,
-		height:                p.height,
-		width:                 p.width,
-		halfWidth:             p.halfWidth,
-		widthPlan:             p.widthPlan, // PlanReal doesn't have Clone yet, share for now
-		heightPlans:           heightPlans,
-		depthPlans:            depthPlans,
-		scratchCompact:        scratchCompact,
-		scratchFull:           scratchFull,
-		scratchCompactBacking: scratchCompactBacking,
-		scratchFullBacking:    scratchFullBacking,
+		depth:       p.depth,
+		height:      p.height,
+		width:       p.width,
+		halfWidth:   p.halfWidth,
+		widthPlan:   p.widthPlan,
+		heightPlans: p.heightPlans,
+		depthPlans:  p.depthPlans,
+		options:     p.options,
+		scratchPool: newScratchPool[complex64](p.options.Workspace, nil, p.depth*p.height*p.halfWidth, max(p.depth, p.height)),
+		linePool:    newScratchPool[complex64](p.options.Workspace, nil, 0, max(p.depth, p.height)),
+	}
+}
+
+// Close returns the buffers of a plan created with NewPlanReal3DFromPool to
+// its pool. After Close, the plan must not be used. Close is a no-op for
+// other plans and safe to call multiple times.
+func (p *PlanReal3D) Close() {
+	if p.pool == nil {
+		return
 	}
+
+	p.widthPlan.Close()
+
+	for _, plan := range p.heightPlans {
+		plan.Close()
+	}
+
+	for _, plan := range p.depthPlans {
+		plan.Close()
+	}
+
+	releaseWorkspacePool[complex64](p.scratchPool, p.pool)
+
+	p.pool = nil
+	p.scratchPool = nil
 }
@@ -0,0 +1,413 @@
+package algofft
+
+// FormatLen returns the number of reals that ForwardFormat writes and
+// InverseFormat reads for the given format, or 0 for an unknown format.
+func (p *PlanRealT[F, C]) FormatLen(format SpectrumFormat) int {
+	return spectrumFormatLen(format, p.n)
+}
+
+// ForwardFull computes the real-to-complex FFT with all N bins, the upper
+// half filled in as conjugates of the lower half. dst must have length N.
+func (p *PlanRealT[F, C]) ForwardFull(dst []C, src []F) error {
+	return p.ForwardFormat(RealView[F](dst), src, SpectrumFull)
+}
+
+// InverseFull computes the complex-to-real inverse FFT from all N bins.
+// PlanOptions.Symmetry decides whether the upper bins are checked against,
+// averaged with, or ignored in favour of the conjugates of the lower bins.
+func (p *PlanRealT[F, C]) InverseFull(dst []F, src []C) error {
+	return p.InverseFormat(dst, RealView[F](src), SpectrumFull)
+}
+
+// ForwardFormat computes the real-to-complex FFT of one signal and writes
+// the spectrum to dst in the given format; dst must have FormatLen(format)
+// elements. The bins are written straight into the format, without an
+// intermediate compact copy. Batch and Stride do not apply.
+func (p *PlanRealT[F, C]) ForwardFormat(dst, src []F, format SpectrumFormat) error {
+	return p.forwardFormat(dst, src, format, nil)
+}
+
+// ForwardFormatWithScratch is ForwardFormat using caller-supplied scratch of
+// at least ScratchLen elements instead of the plan's pools. It never
+// allocates. scratch must not be used by concurrent calls.
+func (p *PlanRealT[F, C]) ForwardFormatWithScratch(dst, src []F, format SpectrumFormat, scratch []C) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forwardFormat(dst, src, format, scratch)
+}
+
+func (p *PlanRealT[F, C]) forwardFormat(dst, src []F, format SpectrumFormat, scratch []C) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	err := validateSpectrumFormat(format)
+	if err != nil {
+		return err
+	}
+
+	if len(src) != p.n || len(dst) != p.FormatLen(format) {
+		return ErrLengthMismatch
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.n)
+	spec := ComplexView[C](dst)
+
+	switch format {
+	case SpectrumCompact, SpectrumCCS:
+		return p.forwardSingle(spec, src, scale, scratch)
+	case SpectrumFull:
+		err = p.forwardSingle(spec[:p.half+1], src, scale, scratch)
+		if err != nil {
+			return err
+		}
+
+		fillConjugates(spec, []int{p.n})
+
+		return nil
+	}
+
+	ws, pooled, err := acquireWorkspace[C](p.bufPool, scratch, p.half, 0)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.bufPool.Put(pooled)
+	}
+
+	packRealLine(ws.buf, src, 0, 1, scale)
+
+	// Pack shifts the interior bins by one real, Perm keeps them in place.
+	nyquist := p.n - 1
+	bins := ComplexView[C](dst[1:nyquist])
+
+	if format == SpectrumPerm {
+		nyquist = 1
+		bins = spec[1:p.half]
+	}
+
+	err = p.forwardBins(bins, ws.buf, ws.kernel)
+	if err != nil {
+		return err
+	}
+
+	dst[0], dst[nyquist] = dcNyquist[F](ws.buf[0])
+
+	return nil
+}
+
+// InverseFormat computes the complex-to-real inverse FFT from a spectrum
+// in the given format; src must have FormatLen(format) elements. Like
+// ForwardFormat it reads the format in place and transforms one signal.
+func (p *PlanRealT[F, C]) InverseFormat(dst, src []F, format SpectrumFormat) error {
+	return p.inverseFormat(dst, src, format, nil)
+}
+
+// InverseFormatWithScratch is InverseFormat using caller-supplied scratch,
+// like ForwardFormatWithScratch.
+func (p *PlanRealT[F, C]) InverseFormatWithScratch(dst, src []F, format SpectrumFormat, scratch []C) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverseFormat(dst, src, format, scratch)
+}
+
+func (p *PlanRealT[F, C]) inverseFormat(dst, src []F, format SpectrumFormat, scratch []C) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	err := validateSpectrumFormat(format)
+	if err != nil {
+		return err
+	}
+
+	if len(dst) != p.n || len(src) != p.FormatLen(format) {
+		return ErrLengthMismatch
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.n)
+	spec := ComplexView[C](src)
+
+	switch format {
+	case SpectrumCompact, SpectrumCCS:
+		return p.inverseSingle(dst, spec, scale, scratch)
+	case SpectrumFull:
+		return p.inverseFull(dst, spec, scale, scratch)
+	}
+
+	ws, pooled, err := acquireWorkspace[C](p.bufPool, scratch, p.half, 0)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.bufPool.Put(pooled)
+	}
+
+	nyquist := p.n - 1
+	bins := ComplexView[C](src[1:nyquist])
+
+	if format == SpectrumPerm {
+		nyquist = 1
+		bins = spec[1:p.half]
+	}
+
+	err = p.inverseBins(ws.buf, float64(src[0]), float64(src[nyquist]), bins, ws.kernel)
+	if err != nil {
+		return err
+	}
+
+	unpackRealLine(dst, 0, 1, ws.buf, scale)
+
+	return nil
+}
+
+// inverseFull is InverseFormat for SpectrumFull input under the plan's
+// symmetry policy.
+func (p *PlanRealT[F, C]) inverseFull(dst []F, full []C, scale float64, scratch []C) error {
+	switch symmetryPolicy(p.options, SymmetryIgnore) {
+	case SymmetryStrict:
+		err := checkHermitian(full, []int{p.n}, false, symmetryTolerance[C](p.options))
+		if err != nil {
+			return err
+		}
+	case SymmetryProject:
+		ws, pooled, err := acquireWorkspace[C](p.bufPool, scratch, p.half, 0)
+		if err != nil {
+			return err
+		}
+
+		if pooled != nil {
+			defer p.bufPool.Put(pooled)
+		}
+
+		// dst holds the projected bins until inverseBins has consumed them.
+		bins := ComplexView[C](dst)[:p.half-1]
+		projectFullBins(bins, full)
+
+		x0, xh := real(complexToC128(full[0])), real(complexToC128(full[p.half]))
+
+		err = p.inverseBins(ws.buf, x0, xh, bins, ws.kernel)
+		if err != nil {
+			return err
+		}
+
+		unpackRealLine(dst, 0, 1, ws.buf, scale)
+
+		return nil
+	}
+
+	return p.inverseSingle(dst, full[:p.half+1], scale, scratch)
+}
+
+// FormatLen returns the number of reals that ForwardFormat writes and
+// InverseFormat reads for the given format, or 0 for an unknown format.
+func (p *PlanReal) FormatLen(format SpectrumFormat) int {
+	return spectrumFormatLen(format, p.n)
+}
+
+// ForwardFull computes the real-to-complex FFT with all N bins, the upper
+// half filled in as conjugates of the lower half. dst must have length N.
+func (p *PlanReal) ForwardFull(dst []complex64, src []float32) error {
+	return p.ForwardFormat(RealView[float32](dst), src, SpectrumFull)
+}
+
+// InverseFull computes the complex-to-real inverse FFT from all N bins.
+// PlanOptions.Symmetry decides whether the upper bins are checked against,
+// averaged with, or ignored in favour of the conjugates of the lower bins.
+func (p *PlanReal) InverseFull(dst []float32, src []complex64) error {
+	return p.InverseFormat(dst, RealView[float32](src), SpectrumFull)
+}
+
+// ForwardFormat computes the real-to-complex FFT of one signal and writes
+// the spectrum to dst in the given format; dst must have FormatLen(format)
+// elements. The bins are written straight into the format, without an
+// intermediate compact copy. Batch and Stride do not apply.
+func (p *PlanReal) ForwardFormat(dst, src []float32, format SpectrumFormat) error {
+	return p.forwardFormat(dst, src, format, nil)
+}
+
+// ForwardFormatWithScratch is ForwardFormat using caller-supplied scratch of
+// at least ScratchLen elements instead of the plan's pools. It never
+// allocates. scratch must not be used by concurrent calls.
+func (p *PlanReal) ForwardFormatWithScratch(dst, src []float32, format SpectrumFormat, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forwardFormat(dst, src, format, scratch)
+}
+
+func (p *PlanReal) forwardFormat(dst, src []float32, format SpectrumFormat, scratch []complex64) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	err := validateSpectrumFormat(format)
+	if err != nil {
+		return err
+	}
+
+	if len(src) != p.n || len(dst) != p.FormatLen(format) {
+		return ErrLengthMismatch
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.n)
+	spec := ComplexView[complex64](dst)
+
+	switch format {
+	case SpectrumCompact, SpectrumCCS:
+		return p.forwardSingle(spec, src, float32(scale), scratch)
+	case SpectrumFull:
+		err = p.forwardSingle(spec[:p.half+1], src, float32(scale), scratch)
+		if err != nil {
+			return err
+		}
+
+		fillConjugates(spec, []int{p.n})
+
+		return nil
+	}
+
+	ws, pooled, err := acquireWorkspace[complex64](p.bufPool, scratch, p.half, 0)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.bufPool.Put(pooled)
+	}
+
+	packRealLine(ws.buf, src, 0, 1, scale)
+
+	// Pack shifts the interior bins by one real, Perm keeps them in place.
+	nyquist := p.n - 1
+	bins := ComplexView[complex64](dst[1:nyquist])
+
+	if format == SpectrumPerm {
+		nyquist = 1
+		bins = spec[1:p.half]
+	}
+
+	err = p.forwardBins(bins, ws.buf, ws.kernel)
+	if err != nil {
+		return err
+	}
+
+	dst[0], dst[nyquist] = dcNyquist[float32](ws.buf[0])
+
+	return nil
+}
+
+// InverseFormat computes the complex-to-real inverse FFT from a spectrum
+// in the given format; src must have FormatLen(format) elements. Like
+// ForwardFormat it reads the format in place and transforms one signal.
+func (p *PlanReal) InverseFormat(dst, src []float32, format SpectrumFormat) error {
+	return p.inverseFormat(dst, src, format, nil)
+}
+
+// InverseFormatWithScratch is InverseFormat using caller-supplied scratch,
+// like ForwardFormatWithScratch.
+func (p *PlanReal) InverseFormatWithScratch(dst, src []float32, format SpectrumFormat, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverseFormat(dst, src, format, scratch)
+}
+
+func (p *PlanReal) inverseFormat(dst, src []float32, format SpectrumFormat, scratch []complex64) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	err := validateSpectrumFormat(format)
+	if err != nil {
+		return err
+	}
+
+	if len(dst) != p.n || len(src) != p.FormatLen(format) {
+		return ErrLengthMismatch
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.n)
+	spec := ComplexView[complex64](src)
+
+	switch format {
+	case SpectrumCompact, SpectrumCCS:
+		return p.inverseSingle(dst, spec, float32(scale), scratch)
+	case SpectrumFull:
+		return p.inverseFull(dst, spec, scale, scratch)
+	}
+
+	ws, pooled, err := acquireWorkspace[complex64](p.bufPool, scratch, p.half, 0)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.bufPool.Put(pooled)
+	}
+
+	nyquist := p.n - 1
+	bins := ComplexView[complex64](src[1:nyquist])
+
+	if format == SpectrumPerm {
+		nyquist = 1
+		bins = spec[1:p.half]
+	}
+
+	err = p.inverseBins(ws.buf, src[0], src[nyquist], bins, ws.kernel)
+	if err != nil {
+		return err
+	}
+
+	unpackRealLine(dst, 0, 1, ws.buf, scale)
+
+	return nil
+}
+
+// inverseFull is InverseFormat for SpectrumFull input under the plan's
+// symmetry policy.
+func (p *PlanReal) inverseFull(dst []float32, full []complex64, scale float64, scratch []complex64) error {
+	switch symmetryPolicy(p.options, SymmetryIgnore) {
+	case SymmetryStrict:
+		err := checkHermitian(full, []int{p.n}, false, symmetryTolerance[complex64](p.options))
+		if err != nil {
+			return err
+		}
+	case SymmetryProject:
+		ws, pooled, err := acquireWorkspace[complex64](p.bufPool, scratch, p.half, 0)
+		if err != nil {
+			return err
+		}
+
+		if pooled != nil {
+			defer p.bufPool.Put(pooled)
+		}
+
+		// dst holds the projected bins until inverseBins has consumed them.
+		bins := ComplexView[complex64](dst)[:p.half-1]
+		projectFullBins(bins, full)
+
+		err = p.inverseBins(ws.buf, real(full[0]), real(full[p.half]), bins, ws.kernel)
+		if err != nil {
+			return err
+		}
+
+		unpackRealLine(dst, 0, 1, ws.buf, scale)
+
+		return nil
+	}
+
+	return p.inverseSingle(dst, full[:p.half+1], float32(scale), scratch)
+}
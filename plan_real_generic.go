@@ -2,8 +2,10 @@ package algofft
 
 import (
 	"math"
+	"sync"
 
 	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
 )
 
 // PlanRealT is a generic pre-computed real FFT plan supporting both float32 and float64 input.
@@ -18,14 +20,21 @@ import (
 //	X[k] = conj(X[N-k]) for k = 1..N/2-1
 //
 // Index 0 is DC and index N/2 is Nyquist (purely real for even N).
+//
+// Plans are safe for concurrent use; packing buffers are drawn from a per-plan
+// pool, or supplied by the caller through ForwardWithScratch and
+// InverseWithScratch.
 type PlanRealT[F Float, C Complex] struct {
 	n    int
 	half int
 
 	plan    *Plan[C]
 	weight  []C
-	buf     []C
+	bufPool *sync.Pool // per-call packing workspaces of n/2 elements
 	options PlanOptions
+
+	// pool is the buffer pool this plan was allocated from (nil if not pooled).
+	pool *BufferPool
 }
 
 // NewPlanRealT creates a new generic real FFT plan for length n.
@@ -45,10 +54,26 @@ func NewPlanRealT[F Float, C Complex](n int) (*PlanRealT[F, C], error) {
 
 // NewPlanRealTWithOptions creates a new generic real FFT plan with explicit planner options.
 func NewPlanRealTWithOptions[F Float, C Complex](n int, opts PlanOptions) (*PlanRealT[F, C], error) {
-	return newPlanRealTWithFeatures[F, C](n, cpu.DetectFeatures(), normalizePlanOptions(opts))
+	return newPlanRealTWithFeatures[F, C](n, cpu.DetectFeatures(), nil, normalizePlanOptions(opts))
 }
 
-func newPlanRealTWithFeatures[F Float, C Complex](n int, features cpu.Features, opts PlanOptions) (*PlanRealT[F, C], error) {
+// NewPlanRealTFromPool creates a new generic real FFT plan whose complex plan
+// and packing buffers are drawn from pool. Call Close when the plan is no
+// longer needed to hand the buffers back.
+func NewPlanRealTFromPool[F Float, C Complex](n int, pool *BufferPool) (*PlanRealT[F, C], error) {
+	return NewPlanRealTFromPoolWithOptions[F, C](n, pool, PlanOptions{})
+}
+
+// NewPlanRealTFromPoolWithOptions is NewPlanRealTFromPool with explicit planner options.
+func NewPlanRealTFromPoolWithOptions[F Float, C Complex](n int, pool *BufferPool, opts PlanOptions) (*PlanRealT[F, C], error) {
+	if pool == nil {
+		pool = DefaultBufferPool()
+	}
+
+	return newPlanRealTWithFeatures[F, C](n, cpu.DetectFeatures(), pool, normalizePlanOptions(opts))
+}
+
+func newPlanRealTWithFeatures[F Float, C Complex](n int, features cpu.Features, pool *BufferPool, opts PlanOptions) (*PlanRealT[F, C], error) {
 	if n < 2 || n%2 != 0 {
 		return nil, ErrInvalidLength
 	}
@@ -56,10 +81,12 @@ func newPlanRealTWithFeatures[F Float, C Complex](n int, features cpu.Features,
 	childOpts := opts
 	childOpts.Batch = 0
 	childOpts.Stride = 0
-	// The real-FFT pack/unpack path uses the child complex plan in-place on p.buf.
+	// The real-FFT pack/unpack path uses the child complex plan in-place on the packing buffer.
 	childOpts.InPlace = true
+	// Normalization is relative to n and folded into the pack/unpack loops.
+	childOpts.Normalization = NormBackward
 
-	plan, err := newPlanWithFeatures[C](n/2, features, childOpts)
+	plan, err := newChildPlan[C](n/2, features, pool, childOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -68,11 +95,8 @@ func newPlanRealTWithFeatures[F Float, C Complex](n int, features cpu.Features,
 	// U[k] = 0.5 * (1 + i*W_N^k) where W_N^k = exp(-2πik/N).
 	weight := make([]C, n/2+1)
 	for k := range weight {
-		theta := 2 * math.Pi * float64(k) / float64(n)
-
 		// Compute at full precision then cast to target type
-		re := 0.5 * (1 + math.Sin(theta))
-		im := 0.5 * math.Cos(theta)
+		re, im := realWeight(k, n, opts.Accuracy)
 
 		// Type switch to handle both precisions
 		var zero C
@@ -89,8 +113,9 @@ func newPlanRealTWithFeatures[F Float, C Complex](n int, features cpu.Features,
 		half:    n / 2,
 		plan:    plan,
 		weight:  weight,
-		buf:     make([]C, n/2),
+		bufPool: newScratchPool[C](opts.Workspace, pool, n/2, 0),
 		options: opts,
+		pool:    pool,
 	}, nil
 }
 
@@ -104,15 +129,72 @@ func (p *PlanRealT[F, C]) SpectrumLen() int {
 	return p.half + 1
 }
 
+// ScratchLen returns the number of elements of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanRealT[F, C]) ScratchLen() int {
+	return p.half + p.plan.ScratchLen()
+}
+
+// Clone creates an independent copy of the PlanRealT. Plans are already safe
+// for concurrent use, so the clone shares the complex plan and weights and
+// only gets its own buffer pool. Clones are never pooled; a pooled plan must
+// outlive its clones.
+func (p *PlanRealT[F, C]) Clone() *PlanRealT[F, C] {
+	return &PlanRealT[F, C]{
+		n:       p.n,
+		half:    p.half,
+		plan:    p.plan,
+		weight:  p.weight,
+		bufPool: newScratchPool[C](p.options.Workspace, nil, p.half, 0),
+		options: p.options,
+	}
+}
+
+// Close returns the buffers of a plan created with NewPlanRealTFromPool to
+// its pool. After Close, the plan must not be used. Close is a no-op for
+// other plans and safe to call multiple times.
+func (p *PlanRealT[F, C]) Close() {
+	if p.pool == nil {
+		return
+	}
+
+	p.plan.Close()
+	releaseWorkspacePool[C](p.bufPool, p.pool)
+
+	p.pool = nil
+	p.bufPool = nil
+}
+
 // Forward computes the real-to-complex FFT.
 // dst must have length N/2+1 and src must have length N.
+// The output is scaled according to PlanOptions.Normalization.
 func (p *PlanRealT[F, C]) Forward(dst []C, src []F) error {
+	scale, _ := normalizationScales(p.options.Normalization, p.n)
+
+	return p.forward(dst, src, scale, nil)
+}
+
+// ForwardWithScratch is Forward using caller-supplied scratch of at least
+// ScratchLen elements instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanRealT[F, C]) ForwardWithScratch(dst []C, src []F, scratch []C) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.n)
+
+	return p.forward(dst, src, scale, scratch)
+}
+
+func (p *PlanRealT[F, C]) forward(dst []C, src []F, scale float64, scratch []C) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.forwardSingle(dst, src)
+		return p.forwardSingle(dst, src, scale, scratch)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.n, p.half+1, p.options)
@@ -128,7 +210,7 @@ func (p *PlanRealT[F, C]) Forward(dst []C, src []F) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.forwardSingle(dst[dstOff:dstOff+p.half+1], src[srcOff:srcOff+p.n])
+		err = p.forwardSingle(dst[dstOff:dstOff+p.half+1], src[srcOff:srcOff+p.n], scale, scratch)
 		if err != nil {
 			return err
 		}
@@ -137,7 +219,9 @@ func (p *PlanRealT[F, C]) Forward(dst []C, src []F) error {
 	return nil
 }
 
-func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
+// forwardSingle transforms one line; non-nil scratch (ScratchLen elements)
+// replaces the pooled workspace.
+func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F, scale float64, scratch []C) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -146,33 +230,55 @@ func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
 		return ErrLengthMismatch
 	}
 
-	// Pack real samples into complex buffer: z[k] = src[2k] + i*src[2k+1]
+	ws, pooled, err := acquireWorkspace[C](p.bufPool, scratch, p.half, 0)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.bufPool.Put(pooled)
+	}
+
+	buf := ws.buf
+
+	// Pack real samples into complex buffer: z[k] = src[2k] + i*src[2k+1].
+	// The transform is linear, so normalization is applied here.
 	var zero C
 	switch any(zero).(type) {
 	case complex64:
 		srcF32 := any(src).([]float32)
+		s32 := float32(scale)
 
-		bufC64 := any(p.buf).([]complex64)
+		bufC64 := any(buf).([]complex64)
 		for i := range p.half {
-			bufC64[i] = complex(srcF32[2*i], srcF32[2*i+1])
+			bufC64[i] = complex(s32*srcF32[2*i], s32*srcF32[2*i+1])
 		}
 	case complex128:
 		srcF64 := any(src).([]float64)
 
-		bufC128 := any(p.buf).([]complex128)
+		bufC128 := any(buf).([]complex128)
 		for i := range p.half {
-			bufC128[i] = complex(srcF64[2*i], srcF64[2*i+1])
+			bufC128[i] = complex(scale*srcF64[2*i], scale*srcF64[2*i+1])
 		}
 	}
 
-	// Perform N/2 complex FFT
-	err := p.plan.Forward(p.buf, p.buf)
+	return p.forwardPacked(dst, buf, ws.kernel)
+}
+
+// forwardPacked finishes a forward transform whose samples are packed into
+// buf as z[k] = x[2k] + i*x[2k+1]: it runs the N/2 complex FFT in place on
+// buf, with scratch for the complex plan (nil: its own), and writes the
+// half-spectrum to dst.
+func (p *PlanRealT[F, C]) forwardPacked(dst, buf, scratch []C) error {
+	err := p.forwardBins(dst[1:p.half], buf, scratch)
 	if err != nil {
 		return err
 	}
 
+	var zero C
+
 	// Extract DC and Nyquist bins
-	y0 := p.buf[0]
+	y0 := buf[0]
 
 	switch any(zero).(type) {
 	case complex64:
@@ -191,14 +297,29 @@ func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
 		dstC128[p.half] = complex(y0r-y0i, 0)
 	}
 
+	return nil
+}
+
+// forwardBins is forwardPacked without the DC and Nyquist bins: it writes
+// bins 1..N/2-1 to bins[0:N/2-1] and leaves Y[0] in buf[0], from which
+// dcNyquist derives the two real bins.
+func (p *PlanRealT[F, C]) forwardBins(bins, buf, scratch []C) error {
+	// Perform N/2 complex FFT
+	err := p.plan.forwardWith(buf, buf, scratch)
+	if err != nil {
+		return err
+	}
+
+	var zero C
+
 	// Recombination step: extract X[k] from the N/2-point FFT of packed data.
 	// Given z[m] = x[2m] + i*x[2m+1], we computed Y = FFT(z).
 	// With A[k] = Y[k], B[k] = conj(Y[N/2-k]), and U[k] = 0.5 * (1 + i*W_N^k),
 	// the spectrum is recovered via: X[k] = A[k] - U[k] * (A[k] - B[k]).
 	switch any(zero).(type) {
 	case complex64:
-		bufC64 := any(p.buf).([]complex64)
-		dstC64 := any(dst).([]complex64)
+		bufC64 := any(buf).([]complex64)
+		binsC64 := any(bins).([]complex64)
 
 		weightC64 := any(p.weight).([]complex64)
 		for k := 1; k < p.half; k++ {
@@ -207,11 +328,11 @@ func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
 			b := complex(real(bSrc), -imag(bSrc)) // conj(Y[N/2-k])
 
 			c := weightC64[k] * (a - b)
-			dstC64[k] = a - c
+			binsC64[k-1] = a - c
 		}
 	case complex128:
-		bufC128 := any(p.buf).([]complex128)
-		dstC128 := any(dst).([]complex128)
+		bufC128 := any(buf).([]complex128)
+		binsC128 := any(bins).([]complex128)
 
 		weightC128 := any(p.weight).([]complex128)
 		for k := 1; k < p.half; k++ {
@@ -219,49 +340,67 @@ func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
 			bSrc := bufC128[p.half-k]
 			b := complex(real(bSrc), -imag(bSrc)) // conj(Y[N/2-k])
 
-			c := weightC128[k] * (a - b)
-			dstC128[k] = a - c
+			// Rounded products keep the result free of FMA contraction.
+			c := fft.MulRoundedComplex128(weightC128[k], a-b)
+			binsC128[k-1] = a - c
 		}
 	}
 
 	return nil
 }
 
-// ForwardNormalized computes the real-to-complex FFT and scales the result by 1/N.
-func (p *PlanRealT[F, C]) ForwardNormalized(dst []C, src []F) error {
-	err := p.Forward(dst, src)
-	if err != nil {
-		return err
+// dcNyquist returns the real DC and Nyquist bins from Y[0] of the packed
+// N/2-point transform.
+func dcNyquist[F Float, C Complex](y0 C) (F, F) {
+	switch v := any(y0).(type) {
+	case complex64:
+		return F(real(v) + imag(v)), F(real(v) - imag(v))
+	case complex128:
+		return F(real(v) + imag(v)), F(real(v) - imag(v))
 	}
 
-	scale := 1.0 / float64(p.n)
-	scaleSpectrumGeneric(dst, scale)
+	return 0, 0
+}
 
-	return nil
+// ForwardNormalized computes the real-to-complex FFT and scales the result by 1/N,
+// regardless of PlanOptions.Normalization.
+func (p *PlanRealT[F, C]) ForwardNormalized(dst []C, src []F) error {
+	return p.forward(dst, src, 1.0/float64(p.n), nil)
 }
 
-// ForwardUnitary computes the real-to-complex FFT and scales the result by 1/sqrt(N).
+// ForwardUnitary computes the real-to-complex FFT and scales the result by 1/sqrt(N),
+// regardless of PlanOptions.Normalization.
 func (p *PlanRealT[F, C]) ForwardUnitary(dst []C, src []F) error {
-	err := p.Forward(dst, src)
+	return p.forward(dst, src, 1.0/math.Sqrt(float64(p.n)), nil)
+}
+
+// Inverse computes the complex-to-real inverse FFT.
+// dst must have length N and src must have length N/2+1.
+// The output is scaled according to PlanOptions.Normalization (1/N by default).
+func (p *PlanRealT[F, C]) Inverse(dst []F, src []C) error {
+	return p.inverse(dst, src, nil)
+}
+
+// InverseWithScratch is Inverse using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanRealT[F, C]) InverseWithScratch(dst []F, src []C, scratch []C) error {
+	err := validateScratch(scratch, p.ScratchLen())
 	if err != nil {
 		return err
 	}
 
-	scale := 1.0 / math.Sqrt(float64(p.n))
-	scaleSpectrumGeneric(dst, scale)
-
-	return nil
+	return p.inverse(dst, src, scratch)
 }
 
-// Inverse computes the complex-to-real inverse FFT.
-// dst must have length N and src must have length N/2+1.
-func (p *PlanRealT[F, C]) Inverse(dst []F, src []C) error {
+func (p *PlanRealT[F, C]) inverse(dst []F, src []C, scratch []C) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
+	_, scale := normalizationScales(p.options.Normalization, p.n)
+
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.inverseSingle(dst, src)
+		return p.inverseSingle(dst, src, scale, scratch)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.n, p.half+1, p.options)
@@ -277,7 +416,7 @@ func (p *PlanRealT[F, C]) Inverse(dst []F, src []C) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.inverseSingle(dst[dstOff:dstOff+p.n], src[srcOff:srcOff+p.half+1])
+		err = p.inverseSingle(dst[dstOff:dstOff+p.n], src[srcOff:srcOff+p.half+1], scale, scratch)
 		if err != nil {
 			return err
 		}
@@ -286,8 +425,9 @@ func (p *PlanRealT[F, C]) Inverse(dst []F, src []C) error {
 	return nil
 }
 
-//nolint:gocognit
-func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
+// inverseSingle transforms one line; non-nil scratch (ScratchLen elements)
+// replaces the pooled workspace.
+func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C, scale float64, scratch []C) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -296,36 +436,82 @@ func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 		return ErrLengthMismatch
 	}
 
-	// Validate DC and Nyquist are real (imaginary parts near zero)
-	var zero C
+	ws, pooled, err := acquireWorkspace[C](p.bufPool, scratch, p.half, 0)
+	if err != nil {
+		return err
+	}
+
+	if pooled != nil {
+		defer p.bufPool.Put(pooled)
+	}
 
-	spectrumEps := 1e-4
+	buf := ws.buf
 
+	err = p.inversePacked(buf, src, ws.kernel)
+	if err != nil {
+		return err
+	}
+
+	// Unpack complex buffer to real output, applying normalization
+	var zero C
 	switch any(zero).(type) {
 	case complex64:
-		srcC64 := any(src).([]complex64)
-		if math.Abs(float64(imag(srcC64[0]))) > spectrumEps || math.Abs(float64(imag(srcC64[p.half]))) > spectrumEps {
-			return ErrInvalidSpectrum
+		bufC64 := any(buf).([]complex64)
+		dstF32 := any(dst).([]float32)
+		s32 := float32(scale)
+
+		for i := range p.half {
+			v := bufC64[i]
+			dstF32[2*i] = s32 * real(v)
+			dstF32[2*i+1] = s32 * imag(v)
 		}
 	case complex128:
-		srcC128 := any(src).([]complex128)
+		bufC128 := any(buf).([]complex128)
+		dstF64 := any(dst).([]float64)
+
+		for i := range p.half {
+			v := bufC128[i]
+			dstF64[2*i] = scale * real(v)
+			dstF64[2*i+1] = scale * imag(v)
+		}
+	}
+
+	return nil
+}
 
-		spectrumEps = 1e-12 // Tighter tolerance for float64
-		if math.Abs(imag(srcC128[0])) > spectrumEps || math.Abs(imag(srcC128[p.half])) > spectrumEps {
-			return ErrInvalidSpectrum
+// inversePacked rebuilds the packed buffer z[k] = x[2k] + i*x[2k+1] from
+// the half-spectrum src into buf, using scratch for the complex plan (nil:
+// its own). The caller unpacks and scales.
+func (p *PlanRealT[F, C]) inversePacked(buf, src, scratch []C) error {
+	if symmetryPolicy(p.options, SymmetryStrict) == SymmetryStrict {
+		err := checkHermitian(src, []int{p.n}, true, symmetryTolerance[C](p.options))
+		if err != nil {
+			return err
 		}
 	}
 
+	// Dropping the imaginary parts of the DC and Nyquist bins projects them.
+	x0, xh := real(complexToC128(src[0])), real(complexToC128(src[p.half]))
+
+	return p.inverseBins(buf, x0, xh, src[1:p.half], scratch)
+}
+
+// inverseBins is inversePacked from the real DC and Nyquist bins x0 and xh
+// and bins 1..N/2-1 in bins[0:N/2-1].
+//
+//nolint:gocognit
+func (p *PlanRealT[F, C]) inverseBins(buf []C, x0, xh float64, bins, scratch []C) error {
+	var zero C
+
 	// Reconstruct packed buffer from half-spectrum
 	switch any(zero).(type) {
 	case complex64:
-		srcC64 := any(src).([]complex64)
-		bufC64 := any(p.buf).([]complex64)
+		binsC64 := any(bins).([]complex64)
+		bufC64 := any(buf).([]complex64)
 		weightC64 := any(p.weight).([]complex64)
 
-		x0 := real(srcC64[0])
-		xh := real(srcC64[p.half])
-		bufC64[0] = complex(0.5*(x0+xh), 0.5*(x0-xh))
+		x0f, xhf := float32(x0), float32(xh)
+		bufC64[0] = complex(0.5*(x0f+xhf), 0.5*(x0f-xhf))
 
 		for k := 1; k < p.half; k++ {
 			m := p.half - k
@@ -333,8 +519,8 @@ func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 				continue
 			}
 
-			xk := srcC64[k]
-			xmk := srcC64[m]
+			xk := binsC64[k-1]
+			xmk := binsC64[m-1]
 			xmkc := complex(real(xmk), -imag(xmk))
 
 			u := weightC64[k]
@@ -352,12 +538,10 @@ func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 			}
 		}
 	case complex128:
-		srcC128 := any(src).([]complex128)
-		bufC128 := any(p.buf).([]complex128)
+		binsC128 := any(bins).([]complex128)
+		bufC128 := any(buf).([]complex128)
 		weightC128 := any(p.weight).([]complex128)
 
-		x0 := real(srcC128[0])
-		xh := real(srcC128[p.half])
 		bufC128[0] = complex(0.5*(x0+xh), 0.5*(x0-xh))
 
 		for k := 1; k < p.half; k++ {
@@ -366,8 +550,8 @@ func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 				continue
 			}
 
-			xk := srcC128[k]
-			xmk := srcC128[m]
+			xk := binsC128[k-1]
+			xmk := binsC128[m-1]
 			xmkc := complex(real(xmk), -imag(xmk))
 
 			u := weightC128[k]
@@ -376,8 +560,10 @@ func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 			// det is on the unit circle, so 1/det == conj(det)
 			invDet := complex(real(det), -imag(det))
 
-			a := (xk*oneMinusU - xmkc*u) * invDet
-			b := (oneMinusU*xmkc - u*xk) * invDet
+			// Rounded products keep the result free of FMA contraction.
+			mul := fft.MulRoundedComplex128
+			a := mul(mul(xk, oneMinusU)-mul(xmkc, u), invDet)
+			b := mul(mul(oneMinusU, xmkc)-mul(u, xk), invDet)
 
 			bufC128[k] = a
 			if k != m {
@@ -387,34 +573,7 @@ func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 	}
 
 	// Inverse N/2 complex FFT
-	err := p.plan.Inverse(p.buf, p.buf)
-	if err != nil {
-		return err
-	}
-
-	// Unpack complex buffer to real output
-	switch any(zero).(type) {
-	case complex64:
-		bufC64 := any(p.buf).([]complex64)
-		dstF32 := any(dst).([]float32)
-
-		for i := range p.half {
-			v := bufC64[i]
-			dstF32[2*i] = real(v)
-			dstF32[2*i+1] = imag(v)
-		}
-	case complex128:
-		bufC128 := any(p.buf).([]complex128)
-		dstF64 := any(dst).([]float64)
-
-		for i := range p.half {
-			v := bufC128[i]
-			dstF64[2*i] = real(v)
-			dstF64[2*i+1] = imag(v)
-		}
-	}
-
-	return nil
+	return p.plan.inverseWith(buf, buf, scratch)
 }
 
 func scaleSpectrumGeneric[C Complex](dst []C, scale float64) {
@@ -342,6 +342,10 @@ func TestPlanReal64_Normalized(t *testing.T) {
 func TestPlanReal64_ZeroAlloc(t *testing.T) {
 	// Note: t.Parallel() cannot be used here because testing.AllocsPerRun
 	// panics when called during a parallel test.
+	if raceEnabled {
+		t.Skip("allocation counts are not stable under the race detector")
+	}
+
 	n := 256
 
 	plan, err := NewPlanReal64(n)
@@ -0,0 +1,147 @@
+package algofft
+
+// PaddedLen returns the number of reals in the buffer of ForwardInPlace and
+// InverseInPlace: 2*(N/2+1), the N samples followed by two padding reals.
+func (p *PlanRealT[F, C]) PaddedLen() int {
+	return 2 * (p.half + 1)
+}
+
+// ForwardInPlace computes the real-to-complex FFT in place, FFTW style.
+// data must have PaddedLen elements with the N samples in data[:N]; on
+// return ComplexView[C](data) holds the N/2+1 spectrum bins. Batch and
+// Stride do not apply.
+func (p *PlanRealT[F, C]) ForwardInPlace(data []F) error {
+	return p.forwardInPlace(data, nil)
+}
+
+// ForwardInPlaceWithScratch is ForwardInPlace using caller-supplied scratch
+// of at least ScratchLen elements instead of the plan's pools. It never
+// allocates. scratch must not be used by concurrent calls.
+func (p *PlanRealT[F, C]) ForwardInPlaceWithScratch(data []F, scratch []C) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forwardInPlace(data, scratch)
+}
+
+func (p *PlanRealT[F, C]) forwardInPlace(data []F, scratch []C) error {
+	if data == nil {
+		return ErrNilSlice
+	}
+
+	if len(data) != p.PaddedLen() {
+		return ErrLengthMismatch
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.n)
+
+	// The samples are packed into the workspace before any bin is written.
+	return p.forwardSingle(ComplexView[C](data), data[:p.n], scale, scratch)
+}
+
+// InverseInPlace is the inverse of ForwardInPlace: data, viewed as complex,
+// holds the N/2+1 spectrum bins, and on return data[:N] holds the signal.
+func (p *PlanRealT[F, C]) InverseInPlace(data []F) error {
+	return p.inverseInPlace(data, nil)
+}
+
+// InverseInPlaceWithScratch is InverseInPlace using caller-supplied scratch,
+// like ForwardInPlaceWithScratch.
+func (p *PlanRealT[F, C]) InverseInPlaceWithScratch(data []F, scratch []C) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverseInPlace(data, scratch)
+}
+
+func (p *PlanRealT[F, C]) inverseInPlace(data []F, scratch []C) error {
+	if data == nil {
+		return ErrNilSlice
+	}
+
+	if len(data) != p.PaddedLen() {
+		return ErrLengthMismatch
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.n)
+
+	// The bins are unpacked into the workspace before any sample is written.
+	return p.inverseSingle(data[:p.n], ComplexView[C](data), scale, scratch)
+}
+
+// PaddedLen returns the number of reals in the buffer of ForwardInPlace and
+// InverseInPlace: 2*(N/2+1), the N samples followed by two padding reals.
+func (p *PlanReal) PaddedLen() int {
+	return 2 * (p.half + 1)
+}
+
+// ForwardInPlace computes the real-to-complex FFT in place, FFTW style.
+// data must have PaddedLen elements with the N samples in data[:N]; on
+// return ComplexView[complex64](data) holds the N/2+1 spectrum bins. Batch
+// and Stride do not apply.
+func (p *PlanReal) ForwardInPlace(data []float32) error {
+	return p.forwardInPlace(data, nil)
+}
+
+// ForwardInPlaceWithScratch is ForwardInPlace using caller-supplied scratch
+// of at least ScratchLen elements instead of the plan's pools. It never
+// allocates. scratch must not be used by concurrent calls.
+func (p *PlanReal) ForwardInPlaceWithScratch(data []float32, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forwardInPlace(data, scratch)
+}
+
+func (p *PlanReal) forwardInPlace(data []float32, scratch []complex64) error {
+	if data == nil {
+		return ErrNilSlice
+	}
+
+	if len(data) != p.PaddedLen() {
+		return ErrLengthMismatch
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.n)
+
+	// The samples are packed into the workspace before any bin is written.
+	return p.forwardSingle(ComplexView[complex64](data), data[:p.n], float32(scale), scratch)
+}
+
+// InverseInPlace is the inverse of ForwardInPlace: data, viewed as complex,
+// holds the N/2+1 spectrum bins, and on return data[:N] holds the signal.
+func (p *PlanReal) InverseInPlace(data []float32) error {
+	return p.inverseInPlace(data, nil)
+}
+
+// InverseInPlaceWithScratch is InverseInPlace using caller-supplied scratch,
+// like ForwardInPlaceWithScratch.
+func (p *PlanReal) InverseInPlaceWithScratch(data []float32, scratch []complex64) error {
+	err := validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverseInPlace(data, scratch)
+}
+
+func (p *PlanReal) inverseInPlace(data []float32, scratch []complex64) error {
+	if data == nil {
+		return ErrNilSlice
+	}
+
+	if len(data) != p.PaddedLen() {
+		return ErrLengthMismatch
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.n)
+
+	// The bins are unpacked into the workspace before any sample is written.
+	return p.inverseSingle(data[:p.n], ComplexView[complex64](data), float32(scale), scratch)
+}
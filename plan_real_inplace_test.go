@@ -0,0 +1,281 @@
+package algofft
+
+import (
+	"errors"
+	"testing"
+)
+
+// padRows copies rows of width cols from src into a buffer whose rows are
+// padded to 2*(cols/2+1) reals. The padding is filled with junk that the
+// forward transform must ignore.
+func padRows[F Float](src []F, cols int) []F {
+	stride := 2 * (cols/2 + 1)
+	rows := len(src) / cols
+	out := make([]F, rows*stride)
+
+	for r := range rows {
+		copy(out[r*stride:], src[r*cols:(r+1)*cols])
+		out[r*stride+cols] = 1e6
+		out[r*stride+cols+1] = -1e6
+	}
+
+	return out
+}
+
+// unpadRows is the inverse of padRows.
+func unpadRows[F Float](padded []F, cols int) []F {
+	stride := 2 * (cols/2 + 1)
+	rows := len(padded) / stride
+	out := make([]F, rows*cols)
+
+	for r := range rows {
+		copy(out[r*cols:(r+1)*cols], padded[r*stride:])
+	}
+
+	return out
+}
+
+func TestPlanRealTInPlace(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{2, 8, 30, 512} {
+		plan, err := NewPlanReal64(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := randomFloat64(n, uint64(n))
+		want := make([]complex128, plan.SpectrumLen())
+
+		err = plan.Forward(want, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := padRows(src, n)
+		if len(data) != plan.PaddedLen() {
+			t.Fatalf("n=%d: PaddedLen = %d, want %d", n, plan.PaddedLen(), len(data))
+		}
+
+		err = plan.ForwardInPlace(data)
+		if err != nil {
+			t.Fatalf("n=%d: ForwardInPlace: %v", n, err)
+		}
+
+		assertComplexSlicesClose(t, "ForwardInPlace", ComplexView[complex128](data), want, 0)
+
+		err = plan.InverseInPlace(data)
+		if err != nil {
+			t.Fatalf("n=%d: InverseInPlace: %v", n, err)
+		}
+
+		assertFloatSlicesClose(t, "InverseInPlace", data[:n], src, 1e-12)
+	}
+}
+
+func TestPlanRealInPlace(t *testing.T) {
+	t.Parallel()
+
+	const n = 128
+
+	plan, err := NewPlanReal(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := toFloat32(randomFloat64(n, 7))
+	want := make([]complex64, plan.SpectrumLen())
+
+	err = plan.Forward(want, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := padRows(src, n)
+
+	err = plan.ForwardInPlace(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertComplexSlicesClose(t, "ForwardInPlace", ComplexView[complex64](data), want, 0)
+
+	err = plan.InverseInPlace(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertFloatSlicesClose(t, "InverseInPlace", data[:n], src, 1e-5)
+}
+
+func TestPlanReal2DInPlace(t *testing.T) {
+	t.Parallel()
+
+	for _, norm := range []Normalization{NormBackward, NormOrtho} {
+		for _, dims := range [][2]int{{4, 6}, {5, 8}, {16, 16}, {1, 2}} {
+			rows, cols := dims[0], dims[1]
+
+			plan, err := NewPlanReal2DWithOptions(rows, cols, PlanOptions{Normalization: norm})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := toFloat32(randomFloat64(rows*cols, uint64(rows+cols)))
+			want := make([]complex64, plan.SpectrumLen())
+
+			err = plan.Forward(want, src)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data := padRows(src, cols)
+			if len(data) != plan.PaddedLen() {
+				t.Fatalf("%dx%d: PaddedLen = %d, want %d", rows, cols, plan.PaddedLen(), len(data))
+			}
+
+			err = plan.ForwardInPlace(data)
+			if err != nil {
+				t.Fatalf("%dx%d: ForwardInPlace: %v", rows, cols, err)
+			}
+
+			assertComplexSlicesClose(t, "ForwardInPlace", ComplexView[complex64](data), want, 1e-6)
+
+			err = plan.InverseInPlace(data)
+			if err != nil {
+				t.Fatalf("%dx%d: InverseInPlace: %v", rows, cols, err)
+			}
+
+			assertFloatSlicesClose(t, "InverseInPlace", unpadRows(data, cols), src, 1e-5)
+		}
+	}
+}
+
+func TestPlanReal3DInPlace(t *testing.T) {
+	t.Parallel()
+
+	for _, dims := range [][3]int{{2, 3, 4}, {4, 4, 8}, {3, 5, 6}} {
+		depth, height, width := dims[0], dims[1], dims[2]
+		n := depth * height * width
+
+		plan, err := NewPlanReal3D(depth, height, width)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := toFloat32(randomFloat64(n, uint64(n)))
+		want := make([]complex64, plan.SpectrumLen())
+
+		err = plan.Forward(want, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := padRows(src, width)
+		if len(data) != plan.PaddedLen() {
+			t.Fatalf("%v: PaddedLen = %d, want %d", dims, plan.PaddedLen(), len(data))
+		}
+
+		err = plan.ForwardInPlace(data)
+		if err != nil {
+			t.Fatalf("%v: ForwardInPlace: %v", dims, err)
+		}
+
+		assertComplexSlicesClose(t, "ForwardInPlace", ComplexView[complex64](data), want, 1e-6)
+
+		err = plan.InverseInPlace(data)
+		if err != nil {
+			t.Fatalf("%v: InverseInPlace: %v", dims, err)
+		}
+
+		assertFloatSlicesClose(t, "InverseInPlace", unpadRows(data, width), src, 1e-5)
+	}
+}
+
+func TestRealInPlaceErrors(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanReal64(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = plan.ForwardInPlace(make([]float64, 8))
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("unpadded 1D buffer: error = %v, want ErrLengthMismatch", err)
+	}
+
+	err = plan.InverseInPlace(nil)
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil 1D buffer: error = %v, want ErrNilSlice", err)
+	}
+
+	plan3D, err := NewPlanReal3D(2, 2, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = plan3D.ForwardInPlace(make([]float32, 16))
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("unpadded 3D buffer: error = %v, want ErrLengthMismatch", err)
+	}
+
+	external, err := NewPlanReal2DWithOptions(4, 4, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = external.ForwardInPlace(make([]float32, external.PaddedLen()))
+	if !errors.Is(err, ErrScratchRequired) {
+		t.Fatalf("external plan: error = %v, want ErrScratchRequired", err)
+	}
+}
+
+func TestViewsShareMemory(t *testing.T) {
+	t.Parallel()
+
+	reals := []float64{1, 2, 3, 4, 5}
+
+	c := ComplexView[complex128](reals)
+	if len(c) != 2 || c[1] != complex(3, 4) {
+		t.Fatalf("ComplexView = %v", c)
+	}
+
+	c[0] = complex(-1, -2)
+
+	back := RealView[float64](c)
+	if len(back) != 4 || reals[0] != -1 || back[1] != -2 {
+		t.Fatalf("RealView = %v, reals = %v", back, reals)
+	}
+
+	if ComplexView[complex64]([]float32(nil)) != nil || len(ComplexView[complex64]([]float32{1})) != 0 {
+		t.Fatal("ComplexView of nil or single-element slice is not empty")
+	}
+}
+
+func TestRealInPlaceNoAllocs(t *testing.T) {
+	plan, err := NewPlanReal3D(4, 4, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := make([]float32, plan.PaddedLen())
+
+	assertNoAllocs(t, "3D ForwardInPlace", func() error {
+		return plan.ForwardInPlace(data)
+	})
+
+	assertNoAllocs(t, "3D InverseInPlace", func() error {
+		return plan.InverseInPlace(data)
+	})
+
+	plan1D, err := NewPlanReal32(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data1D := make([]float32, plan1D.PaddedLen())
+
+	assertNoAllocs(t, "1D ForwardInPlace", func() error {
+		return plan1D.ForwardInPlace(data1D)
+	})
+}
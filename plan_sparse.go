@@ -0,0 +1,532 @@
+package algofft
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"math/rand/v2"
+	"slices"
+	"sync"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// SparseFFTParams controls a sparse FFT plan.
+// Zero values select automatic defaults; the resolved values are reported by
+// PlanSparse.Params.
+type SparseFFTParams struct {
+	// K is the number of significant frequencies to recover (required, >= 1).
+	K int
+
+	// Buckets is the number of hash buckets B per loop (power of two, divides n).
+	// Default is about sqrt(n*K/log2(n)), and at least 4*K.
+	Buckets int
+
+	// LocationLoops is the number of randomized hashing loops that vote on
+	// candidate frequencies. Default grows with log2(n).
+	LocationLoops int
+
+	// EstimationLoops is the number of loops whose estimates are combined by
+	// median to produce each coefficient. Loops beyond LocationLoops are run
+	// for estimation only. Default equals LocationLoops.
+	EstimationLoops int
+
+	// VoteThreshold is the number of location votes a frequency needs to be
+	// considered. Default is a strict majority of LocationLoops.
+	VoteThreshold int
+
+	// FilterTolerance is the stop-band leakage of the flat-window filter.
+	// Smaller values widen the filter. Default is 1e-8.
+	FilterTolerance float64
+
+	// FilterWidth is the resolved time-domain support of the filter (output only).
+	FilterWidth int
+
+	// Seed makes the random permutations reproducible. Zero selects a fixed seed.
+	Seed uint64
+}
+
+// SparseCoefficient is one recovered frequency of a sparse FFT.
+type SparseCoefficient[T Complex] struct {
+	Bin   int // Frequency bin in [0, n)
+	Value T   // Estimated Fourier coefficient X[Bin]
+}
+
+// PlanSparse is a pre-computed sparse FFT plan.
+//
+// It recovers the K largest Fourier coefficients of a length-n signal whose
+// spectrum is (approximately) K-sparse, reading only O(B log(1/tolerance))
+// samples per loop instead of all n. Each loop randomly permutes the spectrum,
+// filters the signal with a flat window that spreads each frequency into one
+// of B buckets, and subsamples the filtered spectrum with a size-B FFT
+// (in the style of sFFT 1.0/2.0). Frequencies that land in heavy buckets in a
+// majority of loops are located; their coefficients are the median of the
+// per-loop estimates.
+//
+// The result is exact up to filter leakage when the spectrum is K-sparse and
+// degrades gracefully with noise: weak tones near the noise floor may be
+// missed or replaced by noise bins, and the success model below no longer
+// applies. Plans are safe for concurrent use.
+//
+// The generic type parameter T must be either complex64 or complex128.
+type PlanSparse[T Complex] struct {
+	n      int
+	params SparseFFTParams
+
+	bucketPlan *Plan[complex128]
+
+	// window is the flat-window filter g[i] for i = -half..half, stored at
+	// window[i+half]. filterResp[d] is its frequency response at offset |d|.
+	window     []float64
+	half       int
+	filterResp []float64
+
+	scratchPool *sync.Pool
+}
+
+type sparseLoop struct {
+	sigma, tau int
+	buckets    []complex128
+}
+
+type sparseScratch[T Complex] struct {
+	loops      []sparseLoop
+	candidates []int
+	order      []int
+	coeffs     []SparseCoefficient[T]
+	re, im     []float64
+
+	pcg rand.PCG
+	rng *rand.Rand
+}
+
+// NewPlanSparse creates a sparse FFT plan for length n.
+// n must be a power of two (so that random odd multipliers permute the bins).
+func NewPlanSparse[T Complex](n int, params SparseFFTParams) (*PlanSparse[T], error) {
+	if n < 4 || !m.IsPowerOf2(n) {
+		return nil, fmt.Errorf("sparse FFT length %d must be a power of two >= 4: %w", n, ErrInvalidLength)
+	}
+
+	resolved, err := resolveSparseParams(n, params)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketPlan, err := newPlanWithFeatures[complex128](resolved.Buckets, cpu.DetectFeatures(), PlanOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	window, half, resp := sparseFlatWindow(n, resolved.Buckets, resolved.FilterTolerance)
+	resolved.FilterWidth = len(window)
+
+	p := &PlanSparse[T]{
+		n:          n,
+		params:     resolved,
+		bucketPlan: bucketPlan,
+		window:     window,
+		half:       half,
+		filterResp: resp,
+	}
+
+	loops := max(resolved.LocationLoops, resolved.EstimationLoops)
+	p.scratchPool = &sync.Pool{
+		New: func() any {
+			s := &sparseScratch[T]{
+				loops: make([]sparseLoop, loops),
+				re:    make([]float64, 0, resolved.EstimationLoops),
+				im:    make([]float64, 0, resolved.EstimationLoops),
+			}
+			s.rng = rand.New(&s.pcg) //nolint:gosec
+
+			for i := range s.loops {
+				s.loops[i].buckets = make([]complex128, resolved.Buckets)
+			}
+
+			return s
+		},
+	}
+
+	return p, nil
+}
+
+// NewPlanSparse32 creates a sparse FFT plan using complex64 precision.
+func NewPlanSparse32(n int, params SparseFFTParams) (*PlanSparse[complex64], error) {
+	return NewPlanSparse[complex64](n, params)
+}
+
+// NewPlanSparse64 creates a sparse FFT plan using complex128 precision.
+func NewPlanSparse64(n int, params SparseFFTParams) (*PlanSparse[complex128], error) {
+	return NewPlanSparse[complex128](n, params)
+}
+
+// Len returns the signal length n.
+func (p *PlanSparse[T]) Len() int {
+	return p.n
+}
+
+// Params returns the resolved plan parameters.
+func (p *PlanSparse[T]) Params() SparseFFTParams {
+	return p.params
+}
+
+// SuccessProbability returns the modeled probability that all K significant
+// frequencies are located, assuming an exactly K-sparse spectrum.
+//
+// A frequency is isolated in one loop unless another significant frequency
+// hashes into its bucket or an adjacent one (probability about 3(K-1)/B).
+// It is located when isolated in at least VoteThreshold of the LocationLoops.
+func (p *PlanSparse[T]) SuccessProbability() float64 {
+	k := p.params.K
+	collide := math.Min(1, 3*float64(k-1)/float64(p.params.Buckets))
+	perLoop := 1 - collide
+
+	loops := p.params.LocationLoops
+
+	located := 0.0
+	for hits := p.params.VoteThreshold; hits <= loops; hits++ {
+		located += binomial(loops, hits) * math.Pow(perLoop, float64(hits)) * math.Pow(1-perLoop, float64(loops-hits))
+	}
+
+	return math.Pow(math.Min(1, located), float64(k))
+}
+
+// String returns a human-readable description of the PlanSparse for debugging.
+func (p *PlanSparse[T]) String() string {
+	var zero T
+
+	typeName := "complex64"
+	if _, ok := any(zero).(complex128); ok {
+		typeName = "complex128"
+	}
+
+	return fmt.Sprintf("PlanSparse[%s](%d, k=%d, B=%d, loops=%d/%d, w=%d)",
+		typeName, p.n, p.params.K, p.params.Buckets,
+		p.params.LocationLoops, p.params.EstimationLoops, p.params.FilterWidth)
+}
+
+// Forward recovers the largest Fourier coefficients of src.
+//
+// src must have length Len(). dst receives up to len(dst) coefficients
+// ordered by decreasing magnitude; pass a slice of length K to get the top K.
+// Returns the number of coefficients written, which is smaller than len(dst)
+// when fewer frequencies pass the vote threshold.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if len(src) != Len().
+func (p *PlanSparse[T]) Forward(dst []SparseCoefficient[T], src []T) (int, error) {
+	if dst == nil || src == nil {
+		return 0, ErrNilSlice
+	}
+
+	if len(src) != p.n {
+		return 0, ErrLengthMismatch
+	}
+
+	s, ok := p.scratchPool.Get().(*sparseScratch[T])
+	if !ok {
+		return 0, ErrNotImplemented
+	}
+	defer p.scratchPool.Put(s)
+
+	seed := p.params.Seed
+	if seed == 0 {
+		seed = 0x5EED5EED
+	}
+
+	s.pcg.Seed(seed, seed^0x9E3779B97F4A7C15)
+
+	for l := range s.loops {
+		loop := &s.loops[l]
+		loop.sigma = 2*s.rng.IntN(p.n/2) + 1
+		loop.tau = s.rng.IntN(p.n)
+
+		err := p.hash(loop, src)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	candidates := p.locate(s)
+
+	coeffs := s.coeffs[:0]
+	for _, f := range candidates {
+		coeffs = append(coeffs, SparseCoefficient[T]{Bin: f, Value: p.estimate(s, f)})
+	}
+
+	s.coeffs = coeffs
+
+	slices.SortFunc(coeffs, func(a, b SparseCoefficient[T]) int {
+		aa := sqAbs(complexToC128(a.Value))
+		ab := sqAbs(complexToC128(b.Value))
+
+		switch {
+		case aa > ab:
+			return -1
+		case aa < ab:
+			return 1
+		default:
+			return a.Bin - b.Bin
+		}
+	})
+
+	return copy(dst, coeffs), nil
+}
+
+// hash filters the permuted signal and folds it into B buckets, then
+// subsamples the spectrum with a size-B FFT:
+//
+//	Z[b] = (1/n) Σ_f X[f] e^(2πiτf/n) ĝ(bW - σf),  W = n/B
+func (p *PlanSparse[T]) hash(loop *sparseLoop, src []T) error {
+	buckets := p.params.Buckets
+	mask := p.n - 1
+	z := loop.buckets
+	clear(z)
+
+	for i := -p.half; i <= p.half; i++ {
+		idx := (loop.sigma*i + loop.tau) & mask
+		z[i&(buckets-1)] += complexToC128(src[idx]) * complex(p.window[i+p.half], 0)
+	}
+
+	return p.bucketPlan.Forward(z, z)
+}
+
+// locate returns the frequencies that land in a heavy bucket in at least
+// VoteThreshold location loops, in ascending order.
+func (p *PlanSparse[T]) locate(s *sparseScratch[T]) []int {
+	buckets := p.params.Buckets
+	width := p.n / buckets
+	heavy := min(2*p.params.K, buckets)
+	mask := p.n - 1
+
+	s.candidates = s.candidates[:0]
+
+	if cap(s.order) < buckets {
+		s.order = make([]int, buckets)
+	}
+
+	order := s.order[:buckets]
+
+	for l := range p.params.LocationLoops {
+		loop := &s.loops[l]
+		sigmaInv := modInversePow2(loop.sigma, p.n)
+
+		for i := range order {
+			order[i] = i
+		}
+
+		z := loop.buckets
+		slices.SortFunc(order, func(a, b int) int {
+			return cmp.Compare(sqAbs(z[b]), sqAbs(z[a]))
+		})
+
+		for _, b := range order[:heavy] {
+			start := b*width - width/2
+			for off := range width {
+				mPerm := (start + off) & mask
+				s.candidates = append(s.candidates, (sigmaInv*mPerm)&mask)
+			}
+		}
+	}
+
+	slices.Sort(s.candidates)
+
+	// The located frequencies are compacted into the front of candidates
+	located := s.candidates[:0]
+	threshold := p.params.VoteThreshold
+
+	for i := 0; i < len(s.candidates); {
+		j := i
+		for j < len(s.candidates) && s.candidates[j] == s.candidates[i] {
+			j++
+		}
+
+		if j-i >= threshold {
+			located = append(located, s.candidates[i])
+		}
+
+		i = j
+	}
+
+	return located
+}
+
+// estimate returns the median of the per-loop estimates of X[f].
+func (p *PlanSparse[T]) estimate(s *sparseScratch[T], f int) T {
+	buckets := p.params.Buckets
+	width := p.n / buckets
+	mask := p.n - 1
+	loops := p.params.EstimationLoops
+
+	re := s.re[:0]
+	im := s.im[:0]
+
+	for l := range loops {
+		loop := &s.loops[l]
+
+		mPerm := (loop.sigma * f) & mask
+		b := ((mPerm + width/2) / width) & (buckets - 1)
+
+		d := b*width - mPerm
+		if d > p.n/2 {
+			d -= p.n
+		} else if d < -p.n/2 {
+			d += p.n
+		}
+
+		if d < 0 {
+			d = -d
+		}
+
+		gain := p.filterResp[min(d, len(p.filterResp)-1)]
+		phase := -2 * math.Pi * float64((loop.tau*f)&mask) / float64(p.n)
+		est := loop.buckets[b] * complex(float64(p.n)/gain, 0) * cmplx.Rect(1, phase)
+
+		re = append(re, real(est))
+		im = append(im, imag(est))
+	}
+
+	return m.ComplexFromFloat64[T](median(re), median(im))
+}
+
+// resolveSparseParams validates params and fills in defaults.
+func resolveSparseParams(n int, params SparseFFTParams) (SparseFFTParams, error) {
+	if params.K < 1 || params.K > n/4 {
+		return params, fmt.Errorf("sparse FFT K=%d outside [1, %d]: %w", params.K, n/4, ErrInvalidLength)
+	}
+
+	logN := bits.Len(uint(n)) - 1
+
+	if params.Buckets == 0 {
+		target := math.Sqrt(float64(n) * float64(params.K) / float64(logN))
+		params.Buckets = m.NextPowerOfTwo(max(int(target), 4*params.K))
+	}
+
+	if !m.IsPowerOf2(params.Buckets) || params.Buckets < 2 || params.Buckets > n {
+		return params, fmt.Errorf("sparse FFT buckets %d must be a power of two in [2, %d]: %w",
+			params.Buckets, n, ErrInvalidLength)
+	}
+
+	if params.LocationLoops <= 0 {
+		params.LocationLoops = 2*((logN+3)/4) + 1
+	}
+
+	if params.EstimationLoops <= 0 {
+		params.EstimationLoops = params.LocationLoops
+	}
+
+	if params.VoteThreshold <= 0 {
+		params.VoteThreshold = params.LocationLoops/2 + 1
+	}
+
+	if params.VoteThreshold > params.LocationLoops {
+		return params, fmt.Errorf("sparse FFT vote threshold %d exceeds %d loops: %w",
+			params.VoteThreshold, params.LocationLoops, ErrInvalidLength)
+	}
+
+	if params.FilterTolerance <= 0 || params.FilterTolerance >= 1 {
+		params.FilterTolerance = 1e-8
+	}
+
+	return params, nil
+}
+
+// sparseFlatWindow builds the flat-window filter for B buckets: a boxcar of
+// one bucket width in frequency, smoothed by a Gaussian whose time-domain
+// envelope is truncated where it falls below tol. The response is normalized
+// to 1 at DC and tabulated for offsets 0..n/(2B)+1.
+func sparseFlatWindow(n, buckets int, tol float64) ([]float64, int, []float64) {
+	width := n / buckets
+
+	// Gaussian std in frequency is width/6, so the passband edge sits at 3σ
+	// from the stop band of the neighbouring bucket center.
+	sigmaT := 6 * float64(buckets) / (2 * math.Pi)
+
+	half := int(math.Ceil(sigmaT * math.Sqrt(2*math.Log(1/tol))))
+	half = min(half, n/2-1)
+
+	window := make([]float64, 2*half+1)
+	boxcar := float64(width + 1)
+
+	for i := -half; i <= half; i++ {
+		var h float64
+		if i == 0 {
+			h = boxcar / float64(n)
+		} else {
+			x := math.Pi * float64(i) / float64(n)
+			h = math.Sin(boxcar*x) / (float64(n) * math.Sin(x))
+		}
+
+		t := float64(i) / sigmaT
+		window[i+half] = h * math.Exp(-0.5*t*t)
+	}
+
+	resp := make([]float64, width/2+2)
+	for d := range resp {
+		sum := window[half]
+		for i := 1; i <= half; i++ {
+			sum += 2 * window[i+half] * math.Cos(2*math.Pi*float64(i)*float64(d)/float64(n))
+		}
+
+		resp[d] = sum
+	}
+
+	norm := resp[0]
+	for i := range window {
+		window[i] /= norm
+	}
+
+	for d := range resp {
+		resp[d] /= norm
+	}
+
+	return window, half, resp
+}
+
+// modInversePow2 returns the inverse of odd a modulo the power of two n.
+func modInversePow2(a, n int) int {
+	inv := a // correct to 3 bits for odd a
+	for range 6 {
+		inv *= 2 - a*inv
+	}
+
+	return inv & (n - 1)
+}
+
+func complexToC128[T Complex](v T) complex128 {
+	switch c := any(v).(type) {
+	case complex64:
+		return complex128(c)
+	case complex128:
+		return c
+	default:
+		return 0
+	}
+}
+
+func sqAbs(v complex128) float64 {
+	return real(v)*real(v) + imag(v)*imag(v)
+}
+
+func median(values []float64) float64 {
+	slices.Sort(values)
+
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[mid]
+	}
+
+	return 0.5 * (values[mid-1] + values[mid])
+}
+
+func binomial(n, k int) float64 {
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result *= float64(n-k+i) / float64(i)
+	}
+
+	return result
+}
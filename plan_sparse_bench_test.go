@@ -0,0 +1,38 @@
+package algofft
+
+import "testing"
+
+// Sparse FFT benchmarks against the dense transform of the same length.
+func BenchmarkSparse_1M_K16(b *testing.B) {
+	b.Run("Sparse", func(b *testing.B) { benchmarkSparseForward(b, 1<<20, 16) })
+	b.Run("Dense", func(b *testing.B) { benchmarkPlanForward(b, 1<<20) })
+}
+
+func BenchmarkSparse_16M_K50(b *testing.B) {
+	b.Run("Sparse", func(b *testing.B) { benchmarkSparseForward(b, 1<<24, 50) })
+	b.Run("Dense", func(b *testing.B) { benchmarkPlanForward(b, 1<<24) })
+}
+
+func benchmarkSparseForward(b *testing.B, n, k int) {
+	b.Helper()
+
+	plan, err := NewPlanSparse32(n, SparseFFTParams{K: k})
+	if err != nil {
+		b.Fatalf("NewPlanSparse32 failed: %v", err)
+	}
+
+	src := make([]complex64, n)
+	for i := range src {
+		src[i] = complex(float32(i%13), float32(i%7))
+	}
+
+	dst := make([]SparseCoefficient[complex64], k)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(n * 8))
+	b.ResetTimer()
+
+	for range b.N {
+		_, _ = plan.Forward(dst, src)
+	}
+}
@@ -0,0 +1,236 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+// sparseSignal synthesizes a length-n signal with k tones at distinct random
+// bins and returns it with the tone spectrum (bin -> coefficient).
+func sparseSignal(n, k int, seed uint64) ([]complex128, map[int]complex128) {
+	rng := rand.New(rand.NewPCG(seed, uint64(n))) //nolint:gosec
+
+	tones := make(map[int]complex128, k)
+	for len(tones) < k {
+		bin := rng.IntN(n)
+		if _, ok := tones[bin]; ok {
+			continue
+		}
+
+		tones[bin] = cmplx.Rect(float64(n)*(1+rng.Float64()), 2*math.Pi*rng.Float64())
+	}
+
+	signal := make([]complex128, n)
+	for bin, coeff := range tones {
+		for j := range signal {
+			phase := 2 * math.Pi * float64((bin*j)%n) / float64(n)
+			signal[j] += coeff / complex(float64(n), 0) * cmplx.Rect(1, phase)
+		}
+	}
+
+	return signal, tones
+}
+
+func TestPlanSparse_RecoversExactSparse(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		n, k int
+	}{
+		{1 << 12, 1},
+		{1 << 14, 4},
+		{1 << 16, 16},
+	}
+
+	for _, tc := range cases {
+		signal, tones := sparseSignal(tc.n, tc.k, 7)
+
+		plan, err := NewPlanSparse64(tc.n, SparseFFTParams{K: tc.k})
+		if err != nil {
+			t.Fatalf("NewPlanSparse64(%d) failed: %v", tc.n, err)
+		}
+
+		if p := plan.SuccessProbability(); p <= 0 || p > 1 {
+			t.Fatalf("%s: success probability %v outside (0, 1]", plan, p)
+		}
+
+		dst := make([]SparseCoefficient[complex128], tc.k)
+
+		found, err := plan.Forward(dst, signal)
+		if err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		if found != tc.k {
+			t.Fatalf("%s: found %d coefficients, want %d", plan, found, tc.k)
+		}
+
+		for _, c := range dst {
+			want, ok := tones[c.Bin]
+			if !ok {
+				t.Fatalf("%s: unexpected bin %d", plan, c.Bin)
+			}
+
+			if cmplx.Abs(c.Value-want) > 1e-6*cmplx.Abs(want) {
+				t.Errorf("%s: bin %d got %v want %v", plan, c.Bin, c.Value, want)
+			}
+		}
+	}
+}
+
+func TestPlanSparse_NoisyInput(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n = 1 << 15
+		k = 8
+	)
+
+	signal, tones := sparseSignal(n, k, 11)
+
+	// White noise with per-bin magnitude about 1/10 of the weakest tone.
+	rng := rand.New(rand.NewPCG(3, 5)) //nolint:gosec
+	sigma := 0.1 / math.Sqrt(float64(n))
+
+	for i := range signal {
+		signal[i] += complex(rng.NormFloat64()*sigma, rng.NormFloat64()*sigma)
+	}
+
+	plan, err := NewPlanSparse64(n, SparseFFTParams{K: k, Seed: 42})
+	if err != nil {
+		t.Fatalf("NewPlanSparse64 failed: %v", err)
+	}
+
+	dst := make([]SparseCoefficient[complex128], k)
+
+	found, err := plan.Forward(dst, signal)
+	if err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if found != k {
+		t.Fatalf("found %d coefficients, want %d", found, k)
+	}
+
+	for _, c := range dst {
+		want, ok := tones[c.Bin]
+		if !ok {
+			t.Fatalf("unexpected bin %d", c.Bin)
+		}
+
+		if cmplx.Abs(c.Value-want) > 0.05*cmplx.Abs(want) {
+			t.Errorf("bin %d got %v want %v", c.Bin, c.Value, want)
+		}
+	}
+}
+
+func TestPlanSparse_Complex64(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n = 1 << 14
+		k = 3
+	)
+
+	signal, tones := sparseSignal(n, k, 19)
+
+	src := make([]complex64, n)
+	for i, v := range signal {
+		src[i] = complex64(v)
+	}
+
+	plan, err := NewPlanSparse32(n, SparseFFTParams{K: k})
+	if err != nil {
+		t.Fatalf("NewPlanSparse32 failed: %v", err)
+	}
+
+	dst := make([]SparseCoefficient[complex64], k)
+
+	found, err := plan.Forward(dst, src)
+	if err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	for _, c := range dst[:found] {
+		want, ok := tones[c.Bin]
+		if !ok {
+			t.Fatalf("unexpected bin %d", c.Bin)
+		}
+
+		if cmplx.Abs(complex128(c.Value)-want) > 1e-3*cmplx.Abs(want) {
+			t.Errorf("bin %d got %v want %v", c.Bin, c.Value, want)
+		}
+	}
+
+	if found != k {
+		t.Fatalf("found %d coefficients, want %d", found, k)
+	}
+}
+
+func TestPlanSparse_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPlanSparse64(1000, SparseFFTParams{K: 1}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("non-power-of-two n: expected ErrInvalidLength, got %v", err)
+	}
+
+	if _, err := NewPlanSparse64(1024, SparseFFTParams{}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("K=0: expected ErrInvalidLength, got %v", err)
+	}
+
+	if _, err := NewPlanSparse64(1024, SparseFFTParams{K: 1, Buckets: 24}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("buckets=24: expected ErrInvalidLength, got %v", err)
+	}
+
+	plan, err := NewPlanSparse64(1024, SparseFFTParams{K: 2})
+	if err != nil {
+		t.Fatalf("NewPlanSparse64 failed: %v", err)
+	}
+
+	if _, err := plan.Forward(nil, make([]complex128, 1024)); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: expected ErrNilSlice, got %v", err)
+	}
+
+	if _, err := plan.Forward(make([]SparseCoefficient[complex128], 2), make([]complex128, 512)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short src: expected ErrLengthMismatch, got %v", err)
+	}
+}
+
+// TestPlanSparse_ZeroAllocations verifies that repeated Forward calls reuse
+// the pooled scratch.
+//
+//nolint:paralleltest
+func TestPlanSparse_ZeroAllocations(t *testing.T) {
+	// Note: t.Parallel() cannot be used here because testing.AllocsPerRun
+	// panics when called during a parallel test.
+	if raceEnabled {
+		t.Skip("allocation counts are not stable under the race detector")
+	}
+
+	const n, k = 1 << 14, 4
+
+	signal, _ := sparseSignal(n, k, 11)
+
+	plan, err := NewPlanSparse64(n, SparseFFTParams{K: k})
+	if err != nil {
+		t.Fatalf("NewPlanSparse64 failed: %v", err)
+	}
+
+	dst := make([]SparseCoefficient[complex128], k)
+
+	// Warm up
+	for range 5 {
+		_, _ = plan.Forward(dst, signal)
+	}
+
+	allocs := testing.AllocsPerRun(50, func() {
+		_, _ = plan.Forward(dst, signal)
+	})
+
+	if allocs > 0 {
+		t.Errorf("Forward allocated %f times per run, want 0", allocs)
+	}
+}
@@ -0,0 +1,666 @@
+package algofft
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// PlanSplit is a pre-computed 1D FFT plan for split-complex (planar) data:
+// real and imaginary parts live in separate []F slices instead of one
+// interleaved []C slice.
+//
+// Power-of-two lengths run a radix-2 decimation-in-frequency kernel that
+// works on the planar layout directly, followed by a bit-reversal pass; its
+// butterflies are plain vertical vector operations and use AVX2 assembly
+// where available. Other lengths interleave into a buffer and use a regular
+// Plan. With PlanOptions.Deterministic the native kernel runs its pure Go
+// butterflies on RootOfUnity twiddles and the fallback Plan is
+// deterministic, so results are the same on every platform.
+//
+// Type parameters:
+//   - F: float type (float32 or float64)
+//   - C: complex type (complex64 or complex128), must match F
+//
+// PlanSplit is safe for concurrent use: each call takes its scratch from a
+// per-plan pool, or from the caller with ForwardWithScratch.
+type PlanSplit[F Float, C Complex] struct {
+	n int
+
+	// Native split-complex path (power-of-two lengths).
+	twiddle *fft.SplitTwiddles[F]
+
+	// Interleaved fallback (other lengths).
+	plan *Plan[C]
+
+	// scratchPool provides per-call scratch of 2n floats: the real and
+	// imaginary scratch of the native kernel, or the interleaved buffer of
+	// the fallback. It is nil for WorkspaceExternal plans.
+	scratchPool *sync.Pool
+
+	// forwardScale and inverseScale implement PlanOptions.Normalization, as
+	// returned by normalizationScales.
+	forwardScale float64
+	inverseScale float64
+	options      PlanOptions
+}
+
+// NewPlanSplit creates a split-complex FFT plan of length n.
+//
+// Example:
+//
+//	plan, _ := algofft.NewPlanSplit[float32, complex64](1024)
+//	err := plan.ForwardSplit(dstRe, dstIm, srcRe, srcIm)
+func NewPlanSplit[F Float, C Complex](n int) (*PlanSplit[F, C], error) {
+	return NewPlanSplitWithOptions[F, C](n, PlanOptions{})
+}
+
+// NewPlanSplitWithOptions creates a split-complex FFT plan with explicit planner options.
+// Batch and Stride are ignored; Normalization, Workspace and Deterministic
+// are honoured.
+func NewPlanSplitWithOptions[F Float, C Complex](n int, opts PlanOptions) (*PlanSplit[F, C], error) {
+	return newPlanSplitWithFeatures[F, C](n, cpu.DetectFeatures(), opts)
+}
+
+// NewPlanSplit32 creates a single-precision split-complex FFT plan.
+func NewPlanSplit32(n int) (*PlanSplit[float32, complex64], error) {
+	return NewPlanSplit[float32, complex64](n)
+}
+
+// NewPlanSplit64 creates a double-precision split-complex FFT plan.
+func NewPlanSplit64(n int) (*PlanSplit[float64, complex128], error) {
+	return NewPlanSplit[float64, complex128](n)
+}
+
+func newPlanSplitWithFeatures[F Float, C Complex](n int, features cpu.Features, opts PlanOptions) (*PlanSplit[F, C], error) {
+	if n < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	if !splitTypesMatch[F, C]() {
+		return nil, fmt.Errorf("split plan needs float32/complex64 or float64/complex128: %w", ErrNotImplemented)
+	}
+
+	opts = normalizePlanOptions(opts)
+	opts.Batch = 0
+	opts.Stride = 0
+
+	forwardScale, inverseScale := normalizationScales(opts.Normalization, n)
+
+	plan := &PlanSplit[F, C]{
+		n:            n,
+		scratchPool:  newSplitScratchPool[F](opts.Workspace, 2*n),
+		forwardScale: forwardScale,
+		inverseScale: inverseScale,
+		options:      opts,
+	}
+
+	if m.IsPowerOf2(n) {
+		if opts.Deterministic {
+			plan.twiddle = fft.NewSplitTwiddlesDeterministic[F](n)
+		} else {
+			plan.twiddle = fft.NewSplitTwiddles[F](n)
+		}
+
+		return plan, nil
+	}
+
+	// The fallback plan is unnormalized; this plan applies the scaling.
+	childOpts := opts
+	childOpts.Normalization = NormBackward
+
+	inner, err := newPlanWithFeatures[C](n, features, childOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.plan = inner
+
+	return plan, nil
+}
+
+// newSplitScratchPool returns a pool of size-float scratch buffers unless
+// the policy is WorkspaceExternal, like newScratchPool.
+func newSplitScratchPool[F Float](policy WorkspacePolicy, size int) *sync.Pool {
+	if policy == WorkspaceExternal {
+		return nil
+	}
+
+	return &sync.Pool{
+		New: func() any {
+			buf := make([]F, size)
+			return &buf
+		},
+	}
+}
+
+// Len returns the transform length.
+func (p *PlanSplit[F, C]) Len() int {
+	return p.n
+}
+
+// String returns a human-readable description of the PlanSplit for debugging.
+func (p *PlanSplit[F, C]) String() string {
+	layout := "soa"
+	if p.plan != nil {
+		layout = "interleaved"
+	}
+
+	return fmt.Sprintf("PlanSplit[%s](%d, %s)", splitTypeName[F](), p.n, layout)
+}
+
+// ForwardSplit computes the forward FFT of srcRe + i*srcIm into dstRe + i*dstIm.
+//
+// All four slices must have length Len(). dst may alias src for in-place
+// operation (dstRe == srcRe and dstIm == srcIm).
+// The output is scaled according to PlanOptions.Normalization.
+//
+// Returns ErrNilSlice if any slice is nil.
+// Returns ErrLengthMismatch if any slice length differs from Len().
+func (p *PlanSplit[F, C]) ForwardSplit(dstRe, dstIm, srcRe, srcIm []F) error {
+	err := validateSplit(p.n, dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return err
+	}
+
+	return p.forwardScaled(dstRe, dstIm, srcRe, srcIm, p.forwardScale)
+}
+
+// InverseSplit computes the inverse FFT of srcRe + i*srcIm into dstRe + i*dstIm.
+//
+// All four slices must have length Len(). dst may alias src.
+// The output is scaled according to PlanOptions.Normalization (1/N by default).
+//
+// Returns ErrNilSlice if any slice is nil.
+// Returns ErrLengthMismatch if any slice length differs from Len().
+func (p *PlanSplit[F, C]) InverseSplit(dstRe, dstIm, srcRe, srcIm []F) error {
+	err := validateSplit(p.n, dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return err
+	}
+
+	return p.inverseScaled(dstRe, dstIm, srcRe, srcIm, p.inverseScale)
+}
+
+// ScratchLen returns the number of floats of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanSplit[F, C]) ScratchLen() int {
+	if p.plan == nil {
+		return 2 * p.n
+	}
+
+	return 2 * (p.n + p.plan.ScratchLen())
+}
+
+// ForwardWithScratch is ForwardSplit using caller-supplied scratch of at
+// least ScratchLen floats instead of the plan's pool. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanSplit[F, C]) ForwardWithScratch(dstRe, dstIm, srcRe, srcIm, scratch []F) error {
+	err := validateSplit(p.n, dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return err
+	}
+
+	err = validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.forwardScaledWith(dstRe, dstIm, srcRe, srcIm, scratch, p.forwardScale)
+}
+
+// InverseWithScratch is InverseSplit using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanSplit[F, C]) InverseWithScratch(dstRe, dstIm, srcRe, srcIm, scratch []F) error {
+	err := validateSplit(p.n, dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return err
+	}
+
+	err = validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return p.inverseScaledWith(dstRe, dstIm, srcRe, srcIm, scratch, p.inverseScale)
+}
+
+// forwardScaled runs the forward transform on pooled scratch, see
+// forwardScaledWith.
+func (p *PlanSplit[F, C]) forwardScaled(dstRe, dstIm, srcRe, srcIm []F, scale float64) error {
+	return p.forwardScaledWith(dstRe, dstIm, srcRe, srcIm, nil, scale)
+}
+
+// inverseScaled runs the inverse transform with scale relative to 1/n, as
+// for Plan. It swaps the real and imaginary arrays around the unscaled
+// forward transform, so scale/n is the only rounding.
+func (p *PlanSplit[F, C]) inverseScaled(dstRe, dstIm, srcRe, srcIm []F, scale float64) error {
+	return p.inverseScaledWith(dstRe, dstIm, srcRe, srcIm, nil, scale)
+}
+
+// inverseScaledWith is inverseScaled with optional caller scratch, like
+// forwardScaledWith.
+func (p *PlanSplit[F, C]) inverseScaledWith(dstRe, dstIm, srcRe, srcIm, scratch []F, scale float64) error {
+	return p.forwardScaledWith(dstIm, dstRe, srcIm, srcRe, scratch, scale/float64(p.n))
+}
+
+// forwardScaledWith runs the forward transform and multiplies it by scale
+// in its final pass: the bit-reversal of the native path, the deinterleave
+// of the fallback. Non-nil scratch (ScratchLen floats) replaces the pooled
+// scratch; the fallback plan then runs on its tail.
+func (p *PlanSplit[F, C]) forwardScaledWith(dstRe, dstIm, srcRe, srcIm, scratch []F, scale float64) error {
+	var kernel []C
+
+	if scratch == nil {
+		if p.scratchPool == nil {
+			return ErrScratchRequired
+		}
+
+		pooled, _ := p.scratchPool.Get().(*[]F)
+		defer p.scratchPool.Put(pooled)
+
+		scratch = *pooled
+	} else if p.plan != nil {
+		kernel = ComplexView[C](scratch[2*p.n : p.ScratchLen()])
+	}
+
+	if p.plan == nil {
+		if !fft.ForwardSplitScaled(dstRe, dstIm, srcRe, srcIm, scratch[:p.n], scratch[p.n:2*p.n], p.twiddle, F(scale)) {
+			return ErrNotImplemented
+		}
+
+		return nil
+	}
+
+	buf := ComplexView[C](scratch[:2*p.n])
+	interleave(buf, srcRe, srcIm)
+
+	err := p.plan.forwardWith(buf, buf, kernel)
+	if err != nil {
+		return err
+	}
+
+	deinterleave(dstRe, dstIm, buf, scale)
+
+	return nil
+}
+
+// Clone creates an independent copy of the PlanSplit.
+//
+// PlanSplit is already safe for concurrent use, so Clone is only needed to
+// give a goroutine its own scratch pool. The clone shares the twiddle
+// factors and the fallback Plan.
+func (p *PlanSplit[F, C]) Clone() *PlanSplit[F, C] {
+	clone := *p
+	clone.scratchPool = newSplitScratchPool[F](p.options.Workspace, 2*p.n)
+
+	return &clone
+}
+
+// PlanSplitND is a pre-computed N-dimensional FFT plan for split-complex data.
+//
+// Data is row-major with the last dimension varying fastest, as for PlanND.
+// Each axis is transformed with a PlanSplit; the innermost axis runs directly
+// on the caller's arrays, outer axes gather one line at a time.
+//
+// PlanSplitND is safe for concurrent use, like PlanSplit.
+type PlanSplitND[F Float, C Complex] struct {
+	dims    []int
+	size    int
+	maxDim  int
+	plans   []*PlanSplit[F, C] // unnormalized 1D plan per axis
+	options PlanOptions
+
+	// linePool provides per-call line buffers of 2*maxDim floats for
+	// gathering outer axes. It is nil for WorkspaceExternal plans.
+	linePool *sync.Pool
+}
+
+// NewPlanSplitND creates an N-dimensional split-complex FFT plan.
+//
+// Example:
+//
+//	plan, _ := algofft.NewPlanSplitND[float32, complex64]([]int{64, 128})
+//	err := plan.ForwardSplit(dstRe, dstIm, srcRe, srcIm) // 2D FFT
+func NewPlanSplitND[F Float, C Complex](dims []int) (*PlanSplitND[F, C], error) {
+	return NewPlanSplitNDWithOptions[F, C](dims, PlanOptions{})
+}
+
+// NewPlanSplitNDWithOptions creates an N-dimensional split-complex FFT plan
+// with explicit planner options. Batch and Stride are ignored;
+// Normalization is applied once over the whole array.
+func NewPlanSplitNDWithOptions[F Float, C Complex](dims []int, opts PlanOptions) (*PlanSplitND[F, C], error) {
+	if len(dims) == 0 {
+		return nil, ErrInvalidLength
+	}
+
+	for _, dim := range dims {
+		if dim < 1 {
+			return nil, ErrInvalidLength
+		}
+	}
+
+	opts = normalizePlanOptions(opts)
+	opts.Batch = 0
+	opts.Stride = 0
+	features := cpu.DetectFeatures()
+
+	childOpts := opts
+	// Normalization is applied once by this plan, not per dimension.
+	childOpts.Normalization = NormBackward
+	childOpts.Radices = nil
+
+	plans := make([]*PlanSplit[F, C], len(dims))
+	maxDim := 0
+
+	for axis, dim := range dims {
+		plan, err := newPlanSplitWithFeatures[F, C](dim, features, childOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		plans[axis] = plan
+		maxDim = max(maxDim, dim)
+	}
+
+	return &PlanSplitND[F, C]{
+		dims:     append([]int(nil), dims...),
+		size:     shapeSize(dims),
+		maxDim:   maxDim,
+		plans:    plans,
+		options:  opts,
+		linePool: newSplitScratchPool[F](opts.Workspace, 2*maxDim),
+	}, nil
+}
+
+// Dims returns a copy of the dimension sizes.
+func (p *PlanSplitND[F, C]) Dims() []int {
+	return append([]int(nil), p.dims...)
+}
+
+// Len returns the total number of elements (product of all dimensions).
+func (p *PlanSplitND[F, C]) Len() int {
+	return p.size
+}
+
+// String returns a human-readable description of the PlanSplitND for debugging.
+func (p *PlanSplitND[F, C]) String() string {
+	dims := make([]string, len(p.dims))
+	for i, dim := range p.dims {
+		dims[i] = fmt.Sprint(dim)
+	}
+
+	return fmt.Sprintf("PlanSplitND[%s](%s)", splitTypeName[F](), strings.Join(dims, "x"))
+}
+
+// ForwardSplit computes the N-D forward FFT on split-complex data.
+//
+// All four slices must have length Len(). dst may alias src.
+// The output is scaled according to PlanOptions.Normalization.
+//
+// Returns ErrNilSlice if any slice is nil.
+// Returns ErrLengthMismatch if any slice length differs from Len().
+func (p *PlanSplitND[F, C]) ForwardSplit(dstRe, dstIm, srcRe, srcIm []F) error {
+	err := validateSplit(p.size, dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return err
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.size)
+
+	return p.transform(dstRe, dstIm, srcRe, srcIm, nil, false, scale)
+}
+
+// InverseSplit computes the N-D inverse FFT on split-complex data.
+//
+// All four slices must have length Len(). dst may alias src.
+// The output is scaled according to PlanOptions.Normalization (1/N by default).
+//
+// Returns ErrNilSlice if any slice is nil.
+// Returns ErrLengthMismatch if any slice length differs from Len().
+func (p *PlanSplitND[F, C]) InverseSplit(dstRe, dstIm, srcRe, srcIm []F) error {
+	err := validateSplit(p.size, dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return err
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.size)
+
+	return p.transform(dstRe, dstIm, srcRe, srcIm, nil, true, scale)
+}
+
+// ScratchLen returns the number of floats of scratch that
+// ForwardWithScratch and InverseWithScratch need.
+func (p *PlanSplitND[F, C]) ScratchLen() int {
+	axisLen := 0
+	for _, plan := range p.plans {
+		axisLen = max(axisLen, plan.ScratchLen())
+	}
+
+	return 2*p.maxDim + axisLen
+}
+
+// ForwardWithScratch is ForwardSplit using caller-supplied scratch of at
+// least ScratchLen floats instead of the plan's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (p *PlanSplitND[F, C]) ForwardWithScratch(dstRe, dstIm, srcRe, srcIm, scratch []F) error {
+	err := validateSplit(p.size, dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return err
+	}
+
+	err = validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	scale, _ := normalizationScales(p.options.Normalization, p.size)
+
+	return p.transform(dstRe, dstIm, srcRe, srcIm, scratch, false, scale)
+}
+
+// InverseWithScratch is InverseSplit using caller-supplied scratch, like
+// ForwardWithScratch.
+func (p *PlanSplitND[F, C]) InverseWithScratch(dstRe, dstIm, srcRe, srcIm, scratch []F) error {
+	err := validateSplit(p.size, dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return err
+	}
+
+	err = validateScratch(scratch, p.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	_, scale := normalizationScales(p.options.Normalization, p.size)
+
+	return p.transform(dstRe, dstIm, srcRe, srcIm, scratch, true, scale)
+}
+
+// transform applies the unscaled 1D forward transforms along every axis,
+// innermost first; the last axis applies scale. An inverse swaps the real
+// and imaginary arrays around the forward transform and applies scale/N,
+// so the normalization is the only rounding. Non-nil scratch (ScratchLen
+// floats) holds the line buffers followed by the axis plans' scratch and
+// replaces the pools.
+func (p *PlanSplitND[F, C]) transform(dstRe, dstIm, srcRe, srcIm, scratch []F, inverse bool, scale float64) error {
+	if inverse {
+		dstRe, dstIm = dstIm, dstRe
+		srcRe, srcIm = srcIm, srcRe
+		scale /= float64(p.size)
+	}
+
+	copy(dstRe, srcRe)
+	copy(dstIm, srcIm)
+
+	last := slices.IndexFunc(p.dims, func(dim int) bool { return dim > 1 })
+	if last < 0 {
+		scaleSplit(dstRe, dstIm, scale)
+		return nil
+	}
+
+	var line, axisScratch []F
+
+	if scratch != nil {
+		line, axisScratch = scratch[:2*p.maxDim], scratch[2*p.maxDim:]
+	} else {
+		if p.linePool == nil {
+			return ErrScratchRequired
+		}
+
+		pooled, _ := p.linePool.Get().(*[]F)
+		defer p.linePool.Put(pooled)
+
+		line = *pooled
+	}
+
+	inner := 1
+
+	for axis := len(p.dims) - 1; axis >= 0; axis-- {
+		dim := p.dims[axis]
+		plan := p.plans[axis]
+
+		if dim == 1 {
+			continue
+		}
+
+		axisScale := 1.0
+		if axis == last {
+			axisScale = scale
+		}
+
+		block := dim * inner
+
+		for start := 0; start < p.size; start += block {
+			if inner == 1 {
+				re := dstRe[start : start+dim]
+				im := dstIm[start : start+dim]
+
+				err := plan.forwardScaledWith(re, im, re, im, axisScratch, axisScale)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			for offset := range inner {
+				lineRe := line[:dim]
+				lineIm := line[dim : 2*dim]
+
+				for j := range dim {
+					lineRe[j] = dstRe[start+offset+j*inner]
+					lineIm[j] = dstIm[start+offset+j*inner]
+				}
+
+				err := plan.forwardScaledWith(lineRe, lineIm, lineRe, lineIm, axisScratch, axisScale)
+				if err != nil {
+					return err
+				}
+
+				for j := range dim {
+					dstRe[start+offset+j*inner] = lineRe[j]
+					dstIm[start+offset+j*inner] = lineIm[j]
+				}
+			}
+		}
+
+		inner = block
+	}
+
+	return nil
+}
+
+// Clone creates an independent copy of the PlanSplitND.
+//
+// PlanSplitND is already safe for concurrent use, so Clone is only needed
+// to give a goroutine its own line pool. The clone shares the axis plans.
+func (p *PlanSplitND[F, C]) Clone() *PlanSplitND[F, C] {
+	clone := *p
+	clone.linePool = newSplitScratchPool[F](p.options.Workspace, 2*p.maxDim)
+
+	return &clone
+}
+
+// validateSplit checks that all four split-complex slices hold n elements.
+func validateSplit[F Float](n int, dstRe, dstIm, srcRe, srcIm []F) error {
+	if dstRe == nil || dstIm == nil || srcRe == nil || srcIm == nil {
+		return ErrNilSlice
+	}
+
+	if len(dstRe) != n || len(dstIm) != n || len(srcRe) != n || len(srcIm) != n {
+		return ErrLengthMismatch
+	}
+
+	return nil
+}
+
+// scaleSplit multiplies both planes by scale (no-op if scale == 1).
+func scaleSplit[F Float](re, im []F, scale float64) {
+	if scale == 1 {
+		return
+	}
+
+	factor := F(scale)
+	for i := range re {
+		re[i] *= factor
+		im[i] *= factor
+	}
+}
+
+// interleave packs split-complex data into dst.
+func interleave[F Float, C Complex](dst []C, re, im []F) {
+	switch out := any(dst).(type) {
+	case []complex64:
+		for i := range out {
+			out[i] = complex(float32(re[i]), float32(im[i]))
+		}
+	case []complex128:
+		for i := range out {
+			out[i] = complex(float64(re[i]), float64(im[i]))
+		}
+	}
+}
+
+// deinterleave unpacks src into split-complex planes, multiplying by scale.
+func deinterleave[F Float, C Complex](re, im []F, src []C, scale float64) {
+	factor := F(scale)
+
+	switch in := any(src).(type) {
+	case []complex64:
+		for i, v := range in {
+			re[i], im[i] = F(real(v))*factor, F(imag(v))*factor
+		}
+	case []complex128:
+		for i, v := range in {
+			re[i], im[i] = F(real(v))*factor, F(imag(v))*factor
+		}
+	}
+}
+
+// splitTypesMatch reports whether F is the component type of C.
+func splitTypesMatch[F Float, C Complex]() bool {
+	var (
+		f F
+		c C
+	)
+
+	_, f32 := any(f).(float32)
+	_, c64 := any(c).(complex64)
+
+	return f32 == c64
+}
+
+func splitTypeName[F Float]() string {
+	var zero F
+	if _, ok := any(zero).(float64); ok {
+		return "float64"
+	}
+
+	return "float32"
+}
@@ -0,0 +1,49 @@
+package algofft
+
+import (
+	"fmt"
+	"testing"
+)
+
+// BenchmarkPlanSplit compares the native split-complex path with
+// interleaving into a complex buffer around Plan.Forward.
+func BenchmarkPlanSplit(b *testing.B) {
+	for _, n := range []int{256, 4096, 65536} {
+		src := narrowSlice[complex64](normalizationSignal(n, 1))
+		srcRe, srcIm := splitFromComplex[float32](src)
+		dstRe := make([]float32, n)
+		dstIm := make([]float32, n)
+
+		b.Run(fmt.Sprintf("split/%d", n), func(b *testing.B) {
+			plan, err := NewPlanSplit32(n)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.SetBytes(int64(n * 8))
+			b.ReportAllocs()
+
+			for b.Loop() {
+				_ = plan.ForwardSplit(dstRe, dstIm, srcRe, srcIm)
+			}
+		})
+
+		b.Run(fmt.Sprintf("interleaved/%d", n), func(b *testing.B) {
+			plan, err := NewPlan32(n)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			buf := make([]complex64, n)
+
+			b.SetBytes(int64(n * 8))
+			b.ReportAllocs()
+
+			for b.Loop() {
+				interleave(buf, srcRe, srcIm)
+				_ = plan.Forward(buf, buf)
+				deinterleave(dstRe, dstIm, buf, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package algofft
+
+import (
+	"errors"
+	"fmt"
+	"math/cmplx"
+	"sync"
+	"testing"
+)
+
+func splitFromComplex[F Float, C Complex](data []C) ([]F, []F) {
+	re := make([]F, len(data))
+	im := make([]F, len(data))
+	deinterleave(re, im, data, 1)
+
+	return re, im
+}
+
+func assertSplitMatches[F Float, C Complex](t *testing.T, re, im []F, want []C, tol float64) {
+	t.Helper()
+
+	for i, w := range want {
+		got := complex(float64(re[i]), float64(im[i]))
+		if cmplx.Abs(got-complexToC128(w)) > tol {
+			t.Fatalf("index %d: got %v, want %v", i, got, w)
+		}
+	}
+}
+
+func TestPlanSplit_MatchesPlan(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 8, 16, 64, 256, 1024, 4096, 12, 17, 100} {
+		t.Run(fmt.Sprintf("complex64/%d", n), func(t *testing.T) {
+			t.Parallel()
+			checkPlanSplit[float32, complex64](t, n, 1e-4)
+		})
+
+		t.Run(fmt.Sprintf("complex128/%d", n), func(t *testing.T) {
+			t.Parallel()
+			checkPlanSplit[float64, complex128](t, n, 1e-10)
+		})
+	}
+}
+
+func checkPlanSplit[F Float, C Complex](t *testing.T, n int, tol float64) {
+	t.Helper()
+
+	split, err := NewPlanSplit[F, C](n)
+	if err != nil {
+		t.Fatalf("NewPlanSplit(%d): %v", n, err)
+	}
+
+	plan, err := NewPlanT[C](n)
+	if err != nil {
+		t.Fatalf("NewPlanT(%d): %v", n, err)
+	}
+
+	src := narrowSlice[C](normalizationSignal(n, uint64(n)))
+	want := make([]C, n)
+
+	err = plan.Forward(want, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srcRe, srcIm := splitFromComplex[F](src)
+	dstRe := make([]F, n)
+	dstIm := make([]F, n)
+
+	err = split.ForwardSplit(dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		t.Fatalf("ForwardSplit: %v", err)
+	}
+
+	assertSplitMatches(t, dstRe, dstIm, want, tol*float64(n))
+
+	// In-place inverse restores the input.
+	err = split.InverseSplit(dstRe, dstIm, dstRe, dstIm)
+	if err != nil {
+		t.Fatalf("InverseSplit: %v", err)
+	}
+
+	assertSplitMatches(t, dstRe, dstIm, src, tol*float64(n))
+}
+
+func TestPlanSplit_Normalization(t *testing.T) {
+	t.Parallel()
+
+	const n = 64
+
+	plan, err := NewPlanSplitWithOptions[float64, complex128](n, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref, err := NewPlanWithOptions[complex128](n, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := normalizationSignal(n, 3)
+	want := make([]complex128, n)
+
+	if err := ref.Forward(want, src); err != nil {
+		t.Fatal(err)
+	}
+
+	srcRe, srcIm := splitFromComplex[float64](src)
+	dstRe := make([]float64, n)
+	dstIm := make([]float64, n)
+
+	if err := plan.ForwardSplit(dstRe, dstIm, srcRe, srcIm); err != nil {
+		t.Fatal(err)
+	}
+
+	assertSplitMatches(t, dstRe, dstIm, want, 1e-12)
+
+	if err := plan.InverseSplit(dstRe, dstIm, dstRe, dstIm); err != nil {
+		t.Fatal(err)
+	}
+
+	assertSplitMatches(t, dstRe, dstIm, src, 1e-12)
+}
+
+func TestPlanSplitND_MatchesPlanND(t *testing.T) {
+	t.Parallel()
+
+	for _, dims := range [][]int{{16}, {8, 16}, {4, 6, 8}, {2, 3, 4, 5}, {1, 8, 1}} {
+		t.Run(fmt.Sprint(dims), func(t *testing.T) {
+			t.Parallel()
+
+			split, err := NewPlanSplitND[float32, complex64](dims)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ref, err := NewPlanND[complex64](dims)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			size := shapeSize(dims)
+			src := generateRandomNDComplex64(dims, uint64(size))
+			want := make([]complex64, size)
+
+			if err := ref.Forward(want, src); err != nil {
+				t.Fatal(err)
+			}
+
+			srcRe, srcIm := splitFromComplex[float32](src)
+			dstRe := make([]float32, size)
+			dstIm := make([]float32, size)
+
+			if err := split.ForwardSplit(dstRe, dstIm, srcRe, srcIm); err != nil {
+				t.Fatalf("ForwardSplit: %v", err)
+			}
+
+			assertSplitMatches(t, dstRe, dstIm, want, 1e-4*float64(size)*10)
+
+			if err := split.InverseSplit(dstRe, dstIm, dstRe, dstIm); err != nil {
+				t.Fatalf("InverseSplit: %v", err)
+			}
+
+			assertSplitMatches(t, dstRe, dstIm, src, 1e-3)
+		})
+	}
+}
+
+func TestPlanSplit_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPlanSplit32(0); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewPlanSplit32(0) error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := NewPlanSplit[float32, complex128](8); !errors.Is(err, ErrNotImplemented) {
+		t.Fatalf("mismatched types error = %v, want ErrNotImplemented", err)
+	}
+
+	if _, err := NewPlanSplitND[float64, complex128]([]int{4, 0}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewPlanSplitND zero dim error = %v, want ErrInvalidLength", err)
+	}
+
+	plan, err := NewPlanSplit64(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]float64, 8)
+	short := make([]float64, 4)
+
+	if err := plan.ForwardSplit(buf, nil, buf, buf); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil slice error = %v, want ErrNilSlice", err)
+	}
+
+	if err := plan.InverseSplit(buf, buf, short, buf); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short slice error = %v, want ErrLengthMismatch", err)
+	}
+}
+
+func TestPlanSplit_Clone(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{32, 30} {
+		plan, err := NewPlanSplit32(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		clone := plan.Clone()
+		if clone.String() != plan.String() {
+			t.Fatalf("clone String() = %q, want %q", clone.String(), plan.String())
+		}
+
+		src := narrowSlice[complex64](normalizationSignal(n, 9))
+		srcRe, srcIm := splitFromComplex[float32](src)
+
+		aRe, aIm := make([]float32, n), make([]float32, n)
+		bRe, bIm := make([]float32, n), make([]float32, n)
+
+		if err := plan.ForwardSplit(aRe, aIm, srcRe, srcIm); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := clone.ForwardSplit(bRe, bIm, srcRe, srcIm); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range aRe {
+			if aRe[i] != bRe[i] || aIm[i] != bIm[i] {
+				t.Fatalf("n=%d index %d: clone differs", n, i)
+			}
+		}
+	}
+}
+
+// TestPlanSplit_ConcurrentUse shares one plan of each kind across
+// goroutines and checks every result against a serial reference.
+func TestPlanSplit_ConcurrentUse(t *testing.T) {
+	t.Parallel()
+
+	type splitTransformer interface {
+		Len() int
+		ForwardSplit(dstRe, dstIm, srcRe, srcIm []float32) error
+	}
+
+	native, err := NewPlanSplit32(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fallback, err := NewPlanSplit32(60)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nd, err := NewPlanSplitND[float32, complex64]([]int{6, 8, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, plan := range map[string]splitTransformer{"native": native, "fallback": fallback, "ND": nd} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			const goroutines = 8
+
+			n := plan.Len()
+			srcRe := make([][]float32, goroutines)
+			srcIm := make([][]float32, goroutines)
+			wantRe := make([][]float32, goroutines)
+			wantIm := make([][]float32, goroutines)
+
+			for g := range goroutines {
+				srcRe[g], srcIm[g] = splitFromComplex[float32](narrowSlice[complex64](normalizationSignal(n, uint64(g+1))))
+				wantRe[g], wantIm[g] = make([]float32, n), make([]float32, n)
+
+				if err := plan.ForwardSplit(wantRe[g], wantIm[g], srcRe[g], srcIm[g]); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var wg sync.WaitGroup
+
+			for g := range goroutines {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					re, im := make([]float32, n), make([]float32, n)
+
+					for range 50 {
+						if err := plan.ForwardSplit(re, im, srcRe[g], srcIm[g]); err != nil {
+							t.Error(err)
+							return
+						}
+
+						for i := range re {
+							if re[i] != wantRe[g][i] || im[i] != wantIm[g][i] {
+								t.Errorf("goroutine %d index %d differs from the serial result", g, i)
+								return
+							}
+						}
+					}
+				}()
+			}
+
+			wg.Wait()
+		})
+	}
+}
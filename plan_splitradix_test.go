@@ -0,0 +1,84 @@
+package algofft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+func TestNewPlan_SplitRadix(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{2, 4, 8, 16, 64, 256, 1024, 4096} {
+		t.Run(fmt.Sprintf("N=%d", n), func(t *testing.T) {
+			t.Parallel()
+
+			opts := PlanOptions{Strategy: KernelSplitRadix}
+
+			plan64, err := NewPlanWithOptions[complex64](n, opts)
+			if err != nil {
+				t.Fatalf("NewPlan[complex64](%d) failed: %v", n, err)
+			}
+
+			plan128, err := NewPlanWithOptions[complex128](n, opts)
+			if err != nil {
+				t.Fatalf("NewPlan[complex128](%d) failed: %v", n, err)
+			}
+
+			if plan128.KernelStrategy() != KernelSplitRadix || plan128.Algorithm() != "splitradix" {
+				t.Fatalf("plan = %v/%q, want KernelSplitRadix/splitradix",
+					plan128.KernelStrategy(), plan128.Algorithm())
+			}
+
+			src := randomComplex128(n, uint64(n))
+
+			dst := make([]complex128, n)
+			if err := plan128.Forward(dst, src); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "forward128", dst, reference.NaiveDFT128(src), 1e-9)
+
+			back := make([]complex128, n)
+			if err := plan128.Inverse(back, dst); err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "inverse128", back, src, 1e-12)
+
+			src32 := toComplex64(src)
+
+			dst32 := make([]complex64, n)
+			if err := plan64.Forward(dst32, src32); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "forward64", dst32, reference.NaiveDFT(src32), 1e-3)
+
+			inPlace := append([]complex64(nil), dst32...)
+			if err := plan64.InverseInPlace(inPlace); err != nil {
+				t.Fatalf("InverseInPlace failed: %v", err)
+			}
+
+			assertComplexSlicesClose(t, "inverse64", inPlace, src32, 1e-4)
+		})
+	}
+}
+
+func TestSplitRadix_Wisdom(t *testing.T) {
+	t.Parallel()
+
+	const n = 1 << 15 // no codelet, so wisdom picks the kernel
+
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{
+		Wisdom: mapWisdomFor[complex64](n, "splitradix"),
+	})
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+
+	if plan.KernelStrategy() != KernelSplitRadix {
+		t.Fatalf("KernelStrategy() = %v, want KernelSplitRadix", plan.KernelStrategy())
+	}
+}
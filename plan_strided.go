@@ -7,12 +7,16 @@ import (
 
 // ForwardStrided computes the forward FFT on strided input/output data.
 //
-// The stride parameter specifies the distance between consecutive elements.
-// For example, stride=numCols transforms a matrix column in row-major storage.
+// The stride parameter specifies the distance between consecutive elements
+// of both src and dst. For example, stride=numCols transforms a matrix
+// column in row-major storage.
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrInvalidStride if stride < 1 or overflows index computation.
 // Returns ErrLengthMismatch if slices are too short for the given stride.
+//
+// Deprecated: Use PlanGuru (NewPlanGuru or NewPlanMany), which supports
+// independent input and output strides and batches of lines.
 func (p *Plan[T]) ForwardStrided(dst, src []T, stride int) error {
 	return p.transformStrided(dst, src, stride, false)
 }
@@ -22,12 +26,16 @@ func (p *Plan[T]) ForwardStrided(dst, src []T, stride int) error {
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrInvalidStride if stride < 1 or overflows index computation.
 // Returns ErrLengthMismatch if slices are too short for the given stride.
+//
+// Deprecated: Use PlanGuru (NewPlanGuru or NewPlanMany).
 func (p *Plan[T]) InverseStrided(dst, src []T, stride int) error {
 	return p.transformStrided(dst, src, stride, true)
 }
 
 // TransformStrided computes either forward or inverse FFT based on the inverse flag.
 // This is a convenience wrapper over ForwardStrided/InverseStrided.
+//
+// Deprecated: Use PlanGuru (NewPlanGuru or NewPlanMany).
 func (p *Plan[T]) TransformStrided(dst, src []T, stride int, inverse bool) error {
 	return p.transformStrided(dst, src, stride, inverse)
 }
@@ -38,61 +46,123 @@ func (p *Plan[T]) transformStrided(dst, src []T, stride int, inverse bool) error
 		return err
 	}
 
-	if stride == 1 {
+	scale := p.forwardScale
+	if inverse {
+		scale = p.inverseScale
+	}
+
+	return p.transformLine(dst, 0, stride, src, 0, stride, inverse, scale, nil)
+}
+
+// transformLine transforms the n elements src[srcOff + k*srcStride] into
+// dst[dstOff + k*dstStride], multiplying the result by scale (relative to
+// the kernels' own scaling, see normalizationScales). It is the execution
+// primitive shared by the strided methods and PlanGuru; callers validate
+// the layout. Non-nil scratch (lineScratchLen elements) replaces the plan's
+// own workspace.
+func (p *Plan[T]) transformLine(dst []T, dstOff, dstStride int, src []T, srcOff, srcStride int, inverse bool, scale float64, scratch []T) error {
+	n := p.n
+
+	// Caller scratch holds an output buffer only for the plan's own
+	// normalization, so other scales go through the line buffer below.
+	if srcStride == 1 && dstStride == 1 && (scale == 1 || scratch == nil) {
 		if inverse {
-			return p.Inverse(dst[:p.n], src[:p.n])
+			return p.inverseScaledWith(dst[dstOff:dstOff+n], src[srcOff:srcOff+n], scratch, scale)
 		}
 
-		return p.Forward(dst[:p.n], src[:p.n])
+		return p.forwardScaledWith(dst[dstOff:dstOff+n], src[srcOff:srcOff+n], scratch, scale)
 	}
 
+	dst = dst[dstOff:]
+	src = src[srcOff:]
+
 	// Use optimized strided DIT only if:
+	// - Input and output share one stride
 	// - Size is power of 2
 	// - Not using Bluestein's algorithm
 	// - dst != src (not in-place)
 	// - The bitrev is standard radix-2 (strided DIT requires radix-2 bit-reversal)
-	canUseStridedDIT := m.IsPowerOf2(p.n) &&
+	// - No normalization is applied, which the buffer path folds into its scatter
+	canUseStridedDIT := scale == 1 &&
+		srcStride == dstStride &&
+		m.IsPowerOf2(n) &&
 		p.kernelStrategy != fft.KernelBluestein &&
 		!sameSliceStrided(dst, src) &&
-		isRadix2BitRev(p.bitrev, p.n)
+		isRadix2BitRev(p.bitrev, n)
 
-	//nolint:nestif
 	if canUseStridedDIT {
+		done := false
 		if inverse {
-			if fft.InverseStridedDIT(dst, src, p.twiddle, p.bitrev, stride, p.n) {
-				return nil
-			}
+			done = fft.InverseStridedDIT(dst, src, p.twiddle, p.bitrev, dstStride, n)
 		} else {
-			if fft.ForwardStridedDIT(dst, src, p.twiddle, p.bitrev, stride, p.n) {
-				return nil
-			}
+			done = fft.ForwardStridedDIT(dst, src, p.twiddle, p.bitrev, dstStride, n)
+		}
+
+		if done {
+			return nil
 		}
 	}
 
-	_, stridedScratch, _, set := p.getScratch()
-	if set != nil {
-		defer p.scratchPool.Put(set)
+	// An inverse with a normalization other than 1/n runs forward and
+	// reverses the bins, see copyReversedScaled.
+	reverse := inverse && scale != 1
+	if reverse {
+		scale /= float64(n)
 	}
 
-	buffer := stridedScratch[:p.n]
-	for i := range p.n {
-		buffer[i] = src[i*stride]
+	var buffer, kernelScratch []T
+
+	if scratch != nil {
+		buffer, kernelScratch = scratch[:n:n], scratch[n:]
+	} else {
+		_, stridedScratch, _, set := p.getScratch()
+		if set != nil {
+			defer p.scratchPool.Put(set)
+		}
+
+		if stridedScratch == nil {
+			return ErrScratchRequired
+		}
+
+		buffer = stridedScratch[:n]
 	}
 
-	if inverse {
-		err := p.Inverse(buffer, buffer)
+	// Contiguous input runs the kernel straight from src into the buffer.
+	in := buffer
+	if srcStride == 1 {
+		in = src[:n]
+	} else {
+		for i := range n {
+			buffer[i] = src[i*srcStride]
+		}
+	}
+
+	// Normalization is applied while scattering back to dst.
+	if inverse && !reverse {
+		err := p.inverseScaledWith(buffer, in, kernelScratch, 1)
 		if err != nil {
 			return err
 		}
 	} else {
-		err := p.Forward(buffer, buffer)
+		err := p.forwardScaledWith(buffer, in, kernelScratch, 1)
 		if err != nil {
 			return err
 		}
 	}
 
-	for i := range p.n {
-		dst[i*stride] = buffer[i]
+	factor := m.ComplexFromFloat64[T](scale, 0)
+
+	if reverse {
+		dst[0] = buffer[0] * factor
+		for i := 1; i < n; i++ {
+			dst[i*dstStride] = buffer[n-i] * factor
+		}
+
+		return nil
+	}
+
+	for i := range n {
+		dst[i*dstStride] = buffer[i] * factor
 	}
 
 	return nil
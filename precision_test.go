@@ -5,6 +5,7 @@ import (
 	"math"
 	"math/cmplx"
 	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -531,3 +532,274 @@ func cmplx64abs(c complex64) float32 {
 	r, i := real(c), imag(c)
 	return float32(math.Sqrt(float64(r*r + i*i)))
 }
+
+// relativeRMS returns ‖got − want‖/‖want‖.
+func relativeRMS[T Complex](got []T, want []complex128) float64 {
+	var num, den float64
+
+	for i, w := range want {
+		d := complexToC128(got[i]) - w
+		num += real(d)*real(d) + imag(d)*imag(d)
+		den += real(w)*real(w) + imag(w)*imag(w)
+	}
+
+	return math.Sqrt(num / den)
+}
+
+// TestPrecisionErrorBound verifies that EstimatedErrorBound bounds the
+// measured error at every accuracy level, and that the bounds meet the
+// round-trip errors promised in docs/PRECISION.md.
+func TestPrecisionErrorBound(t *testing.T) {
+	t.Parallel()
+
+	sizes := []int{256, 1009, 15015, 65536}
+	if !testing.Short() && !raceEnabled {
+		sizes = append(sizes, 1<<20)
+	}
+
+	accuracies := []Accuracy{AccuracyFast, AccuracyDefault, AccuracyAccurate}
+
+	for _, n := range sizes {
+		t.Run(fmt.Sprintf("size_%d", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex128(n, uint64(n))
+
+			// complex128 with accurate twiddles is the reference for complex64.
+			ref, err := NewPlanWithOptions[complex128](n, PlanOptions{Accuracy: AccuracyAccurate})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := make([]complex128, n)
+			if err := ref.Forward(want, src); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, acc := range accuracies {
+				forward64, roundTrip64, bound64 := measurePlanError[complex64](t, n, acc, toComplex64(src), want)
+				_, roundTrip128, bound128 := measurePlanError[complex128](t, n, acc, src, want)
+
+				t.Logf("%s: complex64 forward %.3g round trip %.3g bound %.3g; complex128 round trip %.3g bound %.3g",
+					acc, forward64, roundTrip64, bound64, roundTrip128, bound128)
+
+				if forward64 > bound64 || roundTrip64 > 2*bound64 {
+					t.Errorf("%s complex64: errors %.3g/%.3g exceed bound %.3g", acc, forward64, roundTrip64, bound64)
+				}
+
+				if roundTrip128 > 2*bound128 {
+					t.Errorf("%s complex128: round trip %.3g exceeds twice the bound %.3g", acc, roundTrip128, bound128)
+				}
+
+				if n&(n-1) == 0 && 2*bound64 > 1e-5 {
+					t.Errorf("%s complex64: round-trip bound %.3g exceeds the documented 1e-5", acc, 2*bound64)
+				}
+
+				if n&(n-1) == 0 && acc != AccuracyFast && 2*bound128 > 1e-13 {
+					t.Errorf("%s complex128: round-trip bound %.3g exceeds the documented 1e-13", acc, 2*bound128)
+				}
+			}
+		})
+	}
+}
+
+// measurePlanError returns the forward error against want, the round-trip
+// error and the EstimatedErrorBound of a plan of size n.
+func measurePlanError[T Complex](t *testing.T, n int, acc Accuracy, src []T, want []complex128) (float64, float64, float64) {
+	t.Helper()
+
+	plan, err := NewPlanWithOptions[T](n, PlanOptions{Accuracy: acc})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	freq := make([]T, n)
+	back := make([]T, n)
+
+	if err := plan.Forward(freq, src); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := plan.Inverse(back, freq); err != nil {
+		t.Fatal(err)
+	}
+
+	orig := make([]complex128, n)
+	for i, v := range src {
+		orig[i] = complexToC128(v)
+	}
+
+	return relativeRMS(freq, want), relativeRMS(back, orig), plan.EstimatedErrorBound()
+}
+
+// TestPrecisionAccurateBluestein verifies that AccuracyAccurate removes the
+// chirp error that limits large complex128 Bluestein transforms.
+func TestPrecisionAccurateBluestein(t *testing.T) {
+	t.Parallel()
+
+	const n = 10007
+
+	src := randomComplex128(n, 11)
+	orig := slices.Clone(src)
+
+	var errs, bounds [2]float64
+
+	for i, acc := range []Accuracy{AccuracyDefault, AccuracyAccurate} {
+		plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Accuracy: acc})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if plan.Meta().Accuracy != acc {
+			t.Fatalf("Meta().Accuracy = %v, want %v", plan.Meta().Accuracy, acc)
+		}
+
+		freq := make([]complex128, n)
+		back := make([]complex128, n)
+
+		if err := plan.Forward(freq, src); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := plan.Inverse(back, freq); err != nil {
+			t.Fatal(err)
+		}
+
+		errs[i], bounds[i] = relativeRMS(back, orig), plan.EstimatedErrorBound()
+	}
+
+	t.Logf("round trip: default %.3g (bound %.3g), accurate %.3g (bound %.3g)", errs[0], bounds[0], errs[1], bounds[1])
+
+	if errs[1] > errs[0]/10 || errs[1] > 1e-14 {
+		t.Errorf("accurate round trip %.3g is not well below default %.3g", errs[1], errs[0])
+	}
+
+	if bounds[1] >= bounds[0] {
+		t.Errorf("accurate bound %.3g is not below default bound %.3g", bounds[1], bounds[0])
+	}
+}
+
+// TestPrecisionAccuracyStrategies runs every kernel strategy with accurate
+// twiddles and checks its round trip against the bound.
+func TestPrecisionAccuracyStrategies(t *testing.T) {
+	t.Parallel()
+
+	const n = 4096
+
+	src := randomComplex128(n, 5)
+
+	for _, strategy := range []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep, KernelRecursive, KernelBluestein} {
+		plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: strategy, Accuracy: AccuracyAccurate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		freq := make([]complex128, n)
+		back := make([]complex128, n)
+
+		if err := plan.Forward(freq, src); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := plan.Inverse(back, freq); err != nil {
+			t.Fatal(err)
+		}
+
+		if got, bound := relativeRMS(back, src), plan.EstimatedErrorBound(); got > 2*bound {
+			t.Errorf("%v: round trip %.3g exceeds twice the bound %.3g", strategy, got, bound)
+		}
+	}
+}
+
+// TestPrecisionErrorBoundMultiDim checks the bounds of real and
+// multi-dimensional plans against their round-trip errors.
+func TestPrecisionErrorBoundMultiDim(t *testing.T) {
+	t.Parallel()
+
+	opts := PlanOptions{Accuracy: AccuracyAccurate}
+
+	real64, err := NewPlanReal64WithOptions(4096, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := randomFloat64(4096, 1)
+	spec := make([]complex128, real64.SpectrumLen())
+	back := make([]float64, 4096)
+
+	if err := real64.Forward(spec, src); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := real64.Inverse(back, spec); err != nil {
+		t.Fatal(err)
+	}
+
+	assertRoundTripWithin(t, "PlanReal64", back, src, real64.EstimatedErrorBound())
+
+	plan2D, err := NewPlan2DWithOptions[complex64](64, 48, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src2D := toComplex64(randomComplex128(64*48, 2))
+	freq2D := make([]complex64, 64*48)
+	back2D := make([]complex64, 64*48)
+
+	if err := plan2D.Forward(freq2D, src2D); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := plan2D.Inverse(back2D, freq2D); err != nil {
+		t.Fatal(err)
+	}
+
+	if bound := plan2D.EstimatedErrorBound(); bound <= plan2D.rowPlan.EstimatedErrorBound() {
+		t.Errorf("Plan2D bound %.3g does not include the column plan", bound)
+	}
+
+	orig2D := make([]complex128, len(src2D))
+	for i, v := range src2D {
+		orig2D[i] = complex128(v)
+	}
+
+	if got, bound := relativeRMS(back2D, orig2D), plan2D.EstimatedErrorBound(); got > 2*bound {
+		t.Errorf("Plan2D round trip %.3g exceeds twice the bound %.3g", got, bound)
+	}
+}
+
+// assertRoundTripWithin checks a real round trip against twice bound.
+func assertRoundTripWithin(t *testing.T, label string, got, want []float64, bound float64) {
+	t.Helper()
+
+	var num, den float64
+
+	for i, w := range want {
+		num += (got[i] - w) * (got[i] - w)
+		den += w * w
+	}
+
+	if rms := math.Sqrt(num / den); rms > 2*bound {
+		t.Errorf("%s: round trip %.3g exceeds twice the bound %.3g", label, rms, bound)
+	}
+}
+
+func TestAccuracyString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		acc  Accuracy
+		want string
+	}{
+		{AccuracyDefault, "default"},
+		{AccuracyFast, "fast"},
+		{AccuracyAccurate, "accurate"},
+		{Accuracy(99), "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.acc.String(); got != tt.want {
+			t.Errorf("Accuracy(%d).String() = %q, want %q", tt.acc, got, tt.want)
+		}
+	}
+}
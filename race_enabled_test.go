@@ -0,0 +1,8 @@
+//go:build race
+
+package algofft
+
+// raceEnabled reports whether the race detector is active. sync.Pool drops
+// items at random under the race detector, so allocation counts of pooled
+// plans are not meaningful there.
+const raceEnabled = true
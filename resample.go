@@ -0,0 +1,514 @@
+package algofft
+
+import (
+	"fmt"
+	"sync"
+
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// ResampleWindow is a frequency-domain window for resampling.
+// It is called with the signed frequency index bin in [-n/2, n/2] of the
+// length-n input spectrum and returns the gain for that bin. For real
+// signals the window must be symmetric (w(-bin) == w(bin)).
+type ResampleWindow func(bin, n int) float64
+
+// ResampleOptions configures resampler plans.
+type ResampleOptions struct {
+	// Window is applied to the input spectrum before it is trimmed or
+	// zero-padded. Nil means no window.
+	Window ResampleWindow
+
+	// Plan configures the underlying forward and inverse FFT plans.
+	// Plan.Normalization and Plan.Radices are ignored: resampling always
+	// preserves amplitude and plans two different sizes. With
+	// WorkspaceExternal, Resample returns ErrScratchRequired and
+	// ResampleWithScratch must be used.
+	Plan PlanOptions
+}
+
+// resampleTerm adds gain*X[src] to Y[dst].
+type resampleTerm struct {
+	dst, src int
+	gain     float64
+}
+
+// resampleTerms maps a length-n spectrum to a length-mOut spectrum the way
+// scipy.signal.resample does: positive and negative frequencies are kept up
+// to the shorter length, the Nyquist bin of an even shorter length is split
+// (upsampling) or folded (downsampling), and the result is scaled by mOut/n.
+func resampleTerms(n, mOut int, window ResampleWindow) []resampleTerm {
+	short := min(n, mOut)
+	nyq := short/2 + 1
+	negatives := short - nyq
+	scale := float64(mOut) / float64(n)
+
+	gain := func(src int) float64 {
+		if window == nil {
+			return scale
+		}
+
+		bin := src
+		if 2*src > n {
+			bin = src - n
+		}
+
+		return scale * window(bin, n)
+	}
+
+	terms := make([]resampleTerm, 0, short+1)
+
+	for k := range nyq {
+		terms = append(terms, resampleTerm{dst: k, src: k, gain: gain(k)})
+	}
+
+	for k := 1; k <= negatives; k++ {
+		terms = append(terms, resampleTerm{dst: mOut - k, src: n - k, gain: gain(n - k)})
+	}
+
+	if short%2 != 0 || n == mOut {
+		return terms
+	}
+
+	half := short / 2
+
+	if mOut < n {
+		// Fold the -n/2 component into the output Nyquist bin.
+		return append(terms, resampleTerm{dst: half, src: n - half, gain: gain(n - half)})
+	}
+
+	// Split the input Nyquist bin between +n/2 and -n/2.
+	terms[half].gain *= 0.5
+
+	return append(terms, resampleTerm{dst: mOut - half, src: half, gain: terms[half].gain})
+}
+
+// Resampler is a reusable plan for Fourier resampling of complex signals.
+//
+// Resample trims or zero-pads the spectrum of a length-InputLen signal to
+// OutputLen bins (scipy.signal.resample semantics) and transforms back, so
+// the output is the band-limited interpolation of the input sampled at
+// OutputLen points over the same period. Any lengths are supported.
+//
+// A Resampler is safe for concurrent use: each call takes its spectrum
+// buffers from a per-resampler pool, or from the caller with
+// ResampleWithScratch.
+type Resampler[T Complex] struct {
+	inLen, outLen int
+
+	forward *Plan[T]
+	inverse *Plan[T]
+	terms   []resampleTerm
+
+	// scratchPool provides per-call buffers of inLen+outLen elements: the
+	// input spectrum followed by the output spectrum. It is nil for
+	// WorkspaceExternal resamplers.
+	scratchPool *sync.Pool
+}
+
+// NewResampler creates a complex resampler from inLen to outLen samples.
+func NewResampler[T Complex](inLen, outLen int, opts ResampleOptions) (*Resampler[T], error) {
+	if inLen < 1 || outLen < 1 {
+		return nil, fmt.Errorf("resample %d -> %d: %w", inLen, outLen, ErrInvalidLength)
+	}
+
+	opts.Plan.Normalization = NormBackward
+	opts.Plan.Radices = nil
+
+	forward, err := NewPlanWithOptions[T](inLen, opts.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	inverse, err := NewPlanWithOptions[T](outLen, opts.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resampler[T]{
+		inLen:       inLen,
+		outLen:      outLen,
+		forward:     forward,
+		inverse:     inverse,
+		terms:       resampleTerms(inLen, outLen, opts.Window),
+		scratchPool: newResampleScratchPool[T](opts.Plan.Workspace, inLen+outLen),
+	}, nil
+}
+
+// newResampleScratchPool returns a pool of size-element buffers unless the
+// policy is WorkspaceExternal, like newScratchPool.
+func newResampleScratchPool[T Complex](policy WorkspacePolicy, size int) *sync.Pool {
+	if policy == WorkspaceExternal {
+		return nil
+	}
+
+	return &sync.Pool{
+		New: func() any {
+			buf := make([]T, size)
+			return &buf
+		},
+	}
+}
+
+// InputLen returns the number of input samples.
+func (r *Resampler[T]) InputLen() int {
+	return r.inLen
+}
+
+// OutputLen returns the number of output samples.
+func (r *Resampler[T]) OutputLen() int {
+	return r.outLen
+}
+
+// String returns a human-readable description of the Resampler for debugging.
+func (r *Resampler[T]) String() string {
+	return fmt.Sprintf("Resampler(%d -> %d, %s, %s)", r.inLen, r.outLen, r.forward, r.inverse)
+}
+
+// Resample writes the resampled signal to dst.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if len(src) != InputLen() or len(dst) != OutputLen().
+func (r *Resampler[T]) Resample(dst, src []T) error {
+	return r.resample(dst, src, nil)
+}
+
+// ScratchLen returns the number of elements of scratch that
+// ResampleWithScratch needs.
+func (r *Resampler[T]) ScratchLen() int {
+	return r.inLen + r.outLen + max(r.forward.ScratchLen(), r.inverse.ScratchLen())
+}
+
+// ResampleWithScratch is Resample using caller-supplied scratch of at least
+// ScratchLen elements instead of the resampler's pools. It never allocates.
+// scratch must not be used by concurrent calls.
+func (r *Resampler[T]) ResampleWithScratch(dst, src, scratch []T) error {
+	err := validateScratch(scratch, r.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return r.resample(dst, src, scratch)
+}
+
+// resample runs the resampler. Non-nil scratch (ScratchLen elements) holds
+// the two spectra followed by the plans' scratch and replaces the pools.
+func (r *Resampler[T]) resample(dst, src, scratch []T) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != r.inLen || len(dst) != r.outLen {
+		return ErrLengthMismatch
+	}
+
+	var kernel []T
+
+	if scratch != nil {
+		kernel = scratch[r.inLen+r.outLen:]
+	} else {
+		if r.scratchPool == nil {
+			return ErrScratchRequired
+		}
+
+		pooled, _ := r.scratchPool.Get().(*[]T)
+		defer r.scratchPool.Put(pooled)
+
+		scratch = *pooled
+	}
+
+	spectrum := scratch[:r.inLen]
+	output := scratch[r.inLen : r.inLen+r.outLen]
+
+	err := r.forward.forwardWith(spectrum, src, kernel)
+	if err != nil {
+		return err
+	}
+
+	clear(output)
+
+	for _, t := range r.terms {
+		output[t.dst] += spectrum[t.src] * m.ComplexFromFloat64[T](t.gain, 0)
+	}
+
+	return r.inverse.inverseWith(dst, output, kernel)
+}
+
+// ResamplerReal is a reusable plan for Fourier resampling of real signals.
+//
+// It computes the same result as Resampler on real input but works on the
+// half spectrum. Even lengths use PlanRealT; odd lengths fall back to a
+// complex plan of the same length.
+//
+// A ResamplerReal is safe for concurrent use, like Resampler.
+type ResamplerReal[F Float, C Complex] struct {
+	inLen, outLen int
+
+	realForward *PlanRealT[F, C]
+	realInverse *PlanRealT[F, C]
+	forward     *Plan[C]
+	inverse     *Plan[C]
+	terms       []resampleTerm
+
+	// scratchPool provides per-call buffers of bufLen elements: the input
+	// and output half spectra, followed for odd lengths by a complex line of
+	// max(inLen, outLen). It is nil for WorkspaceExternal resamplers.
+	scratchPool *sync.Pool
+	bufLen      int
+}
+
+// NewResamplerReal creates a real resampler from inLen to outLen samples.
+func NewResamplerReal[F Float, C Complex](inLen, outLen int, opts ResampleOptions) (*ResamplerReal[F, C], error) {
+	if inLen < 1 || outLen < 1 {
+		return nil, fmt.Errorf("resample %d -> %d: %w", inLen, outLen, ErrInvalidLength)
+	}
+
+	opts.Plan.Normalization = NormBackward
+	opts.Plan.Radices = nil
+
+	r := &ResamplerReal[F, C]{
+		inLen:  inLen,
+		outLen: outLen,
+		bufLen: inLen/2 + 1 + outLen/2 + 1,
+	}
+
+	var err error
+
+	if inLen%2 == 0 {
+		r.realForward, err = NewPlanRealTWithOptions[F, C](inLen, opts.Plan)
+	} else {
+		r.forward, err = NewPlanWithOptions[C](inLen, opts.Plan)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if outLen%2 == 0 {
+		r.realInverse, err = NewPlanRealTWithOptions[F, C](outLen, opts.Plan)
+	} else {
+		r.inverse, err = NewPlanWithOptions[C](outLen, opts.Plan)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if inLen%2 != 0 || outLen%2 != 0 {
+		r.bufLen += max(inLen, outLen)
+	}
+
+	r.scratchPool = newResampleScratchPool[C](opts.Plan.Workspace, r.bufLen)
+
+	// Only the non-negative output bins are needed; the rest follow from
+	// Hermitian symmetry.
+	for _, t := range resampleTerms(inLen, outLen, opts.Window) {
+		if 2*t.dst <= outLen {
+			r.terms = append(r.terms, t)
+		}
+	}
+
+	return r, nil
+}
+
+// InputLen returns the number of input samples.
+func (r *ResamplerReal[F, C]) InputLen() int {
+	return r.inLen
+}
+
+// OutputLen returns the number of output samples.
+func (r *ResamplerReal[F, C]) OutputLen() int {
+	return r.outLen
+}
+
+// String returns a human-readable description of the ResamplerReal for debugging.
+func (r *ResamplerReal[F, C]) String() string {
+	var zero F
+
+	typeName := "float32"
+	if _, ok := any(zero).(float64); ok {
+		typeName = "float64"
+	}
+
+	return fmt.Sprintf("ResamplerReal[%s](%d -> %d)", typeName, r.inLen, r.outLen)
+}
+
+// Resample writes the resampled signal to dst.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if len(src) != InputLen() or len(dst) != OutputLen().
+func (r *ResamplerReal[F, C]) Resample(dst, src []F) error {
+	return r.resample(dst, src, nil)
+}
+
+// ScratchLen returns the number of elements of scratch that
+// ResampleWithScratch needs.
+func (r *ResamplerReal[F, C]) ScratchLen() int {
+	planLen := 0
+
+	if r.realForward != nil {
+		planLen = max(planLen, r.realForward.ScratchLen())
+	} else {
+		planLen = max(planLen, r.forward.ScratchLen())
+	}
+
+	if r.realInverse != nil {
+		planLen = max(planLen, r.realInverse.ScratchLen())
+	} else {
+		planLen = max(planLen, r.inverse.ScratchLen())
+	}
+
+	return r.bufLen + planLen
+}
+
+// ResampleWithScratch is Resample using caller-supplied scratch, like
+// Resampler.ResampleWithScratch.
+func (r *ResamplerReal[F, C]) ResampleWithScratch(dst, src []F, scratch []C) error {
+	err := validateScratch(scratch, r.ScratchLen())
+	if err != nil {
+		return err
+	}
+
+	return r.resample(dst, src, scratch)
+}
+
+// resampleBuffers are the per-call buffers of a ResamplerReal carved from
+// its scratch.
+type resampleBuffers[C Complex] struct {
+	inHalf, outHalf []C
+	line            []C // complex line for odd lengths
+	kernel          []C // plan scratch, nil for pooled plans
+}
+
+// resample runs the resampler. Non-nil scratch (ScratchLen elements)
+// replaces the pools.
+func (r *ResamplerReal[F, C]) resample(dst, src []F, scratch []C) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != r.inLen || len(dst) != r.outLen {
+		return ErrLengthMismatch
+	}
+
+	var kernel []C
+
+	if scratch != nil {
+		kernel = scratch[r.bufLen:]
+	} else {
+		if r.scratchPool == nil {
+			return ErrScratchRequired
+		}
+
+		pooled, _ := r.scratchPool.Get().(*[]C)
+		defer r.scratchPool.Put(pooled)
+
+		scratch = *pooled
+	}
+
+	inEnd := r.inLen/2 + 1
+	outEnd := inEnd + r.outLen/2 + 1
+	bufs := resampleBuffers[C]{
+		inHalf:  scratch[:inEnd],
+		outHalf: scratch[inEnd:outEnd],
+		line:    scratch[outEnd:r.bufLen],
+		kernel:  kernel,
+	}
+
+	err := r.forwardHalf(src, bufs)
+	if err != nil {
+		return err
+	}
+
+	clear(bufs.outHalf)
+
+	for _, t := range r.terms {
+		x := bufs.inHalf[min(t.src, r.inLen-t.src)]
+		if 2*t.src > r.inLen {
+			x = m.Conj(x)
+		}
+
+		bufs.outHalf[t.dst] += x * m.ComplexFromFloat64[C](t.gain, 0)
+	}
+
+	return r.inverseHalf(dst, bufs)
+}
+
+func (r *ResamplerReal[F, C]) forwardHalf(src []F, bufs resampleBuffers[C]) error {
+	if r.realForward != nil {
+		return r.realForward.forward(bufs.inHalf, src, 1, bufs.kernel)
+	}
+
+	buf := bufs.line[:r.inLen]
+	for i, v := range src {
+		buf[i] = m.ComplexFromFloat64[C](float64(v), 0)
+	}
+
+	err := r.forward.forwardWith(buf, buf, bufs.kernel)
+	if err != nil {
+		return err
+	}
+
+	copy(bufs.inHalf, buf)
+
+	return nil
+}
+
+func (r *ResamplerReal[F, C]) inverseHalf(dst []F, bufs resampleBuffers[C]) error {
+	half := r.outLen / 2
+	outHalf := bufs.outHalf
+
+	// DC (and Nyquist for even lengths) are real for real signals; drop the
+	// rounding residue so the real inverse accepts the spectrum.
+	outHalf[0] = m.ComplexFromFloat64[C](real(complexToC128(outHalf[0])), 0)
+
+	if r.realInverse != nil {
+		outHalf[half] = m.ComplexFromFloat64[C](real(complexToC128(outHalf[half])), 0)
+
+		return r.realInverse.inverse(dst, outHalf, bufs.kernel)
+	}
+
+	buf := bufs.line[:r.outLen]
+	copy(buf, outHalf)
+
+	for k := 1; k <= half; k++ {
+		buf[r.outLen-k] = m.Conj(outHalf[k])
+	}
+
+	err := r.inverse.inverseWith(buf, buf, bufs.kernel)
+	if err != nil {
+		return err
+	}
+
+	for i := range dst {
+		dst[i] = F(real(complexToC128(buf[i])))
+	}
+
+	return nil
+}
+
+// Resample resamples the real signal src to len(dst) samples using the FFT
+// (scipy.signal.resample semantics). Any lengths are supported.
+// For repeated calls with the same lengths, use NewResamplerReal.
+func Resample(dst, src []float32) error {
+	return resampleReal[float32, complex64](dst, src)
+}
+
+// Resample64 resamples the real signal src to len(dst) samples using the FFT.
+// It is the float64 counterpart of Resample.
+func Resample64(dst, src []float64) error {
+	return resampleReal[float64, complex128](dst, src)
+}
+
+func resampleReal[F Float, C Complex](dst, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	r, err := NewResamplerReal[F, C](len(src), len(dst), ResampleOptions{})
+	if err != nil {
+		return err
+	}
+
+	return r.Resample(dst, src)
+}
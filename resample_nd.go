@@ -0,0 +1,231 @@
+package algofft
+
+import (
+	"fmt"
+	"slices"
+)
+
+// resampleLine resamples one contiguous line along an axis, using scratch
+// elements of type S.
+type resampleLine[E, S any] interface {
+	ResampleWithScratch(dst, src []E, scratch []S) error
+	ScratchLen() int
+}
+
+// resampleAxes applies a 1D resampler along each axis of a row-major array.
+// Axes are processed in order, ping-ponging between two buffers. The line
+// resamplers run on the axes' own scratch, so any workspace policy works.
+type resampleAxes[E, S any] struct {
+	inDims, outDims []int
+	axes            []resampleLine[E, S] // nil where the axis is unchanged
+
+	bufA, bufB  []E
+	lineIn      []E
+	lineOut     []E
+	lineScratch []S
+}
+
+func newResampleAxes[E, S any](inDims, outDims []int, opts ResampleOptions,
+	newLine func(inLen, outLen int, opts ResampleOptions) (resampleLine[E, S], error),
+) (*resampleAxes[E, S], error) {
+	if len(inDims) == 0 || len(inDims) != len(outDims) {
+		return nil, fmt.Errorf("resample dims %v -> %v: %w", inDims, outDims, ErrInvalidLength)
+	}
+
+	r := &resampleAxes[E, S]{
+		inDims:  slices.Clone(inDims),
+		outDims: slices.Clone(outDims),
+		axes:    make([]resampleLine[E, S], len(inDims)),
+	}
+
+	cur := slices.Clone(inDims)
+	maxSize, maxLine, maxScratch := 0, 0, 0
+
+	for axis := range inDims {
+		if inDims[axis] < 1 || outDims[axis] < 1 {
+			return nil, fmt.Errorf("resample dims %v -> %v: %w", inDims, outDims, ErrInvalidLength)
+		}
+
+		maxSize = max(maxSize, shapeSize(cur))
+
+		if inDims[axis] != outDims[axis] || opts.Window != nil {
+			line, err := newLine(inDims[axis], outDims[axis], opts)
+			if err != nil {
+				return nil, err
+			}
+
+			r.axes[axis] = line
+			maxLine = max(maxLine, inDims[axis], outDims[axis])
+			maxScratch = max(maxScratch, line.ScratchLen())
+		}
+
+		cur[axis] = outDims[axis]
+	}
+
+	maxSize = max(maxSize, shapeSize(cur))
+
+	r.bufA = make([]E, maxSize)
+	r.bufB = make([]E, maxSize)
+	r.lineIn = make([]E, maxLine)
+	r.lineOut = make([]E, maxLine)
+	r.lineScratch = make([]S, maxScratch)
+
+	return r, nil
+}
+
+func (r *resampleAxes[E, S]) resample(dst, src []E) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != shapeSize(r.inDims) || len(dst) != shapeSize(r.outDims) {
+		return ErrLengthMismatch
+	}
+
+	cur := slices.Clone(r.inDims)
+	in := src
+	out := r.bufA
+
+	for axis, line := range r.axes {
+		if line == nil {
+			continue
+		}
+
+		inLen, outLen := r.inDims[axis], r.outDims[axis]
+		outer := shapeSize(cur[:axis])
+		inner := shapeSize(cur[axis+1:])
+		lineIn := r.lineIn[:inLen]
+		lineOut := r.lineOut[:outLen]
+
+		for o := range outer {
+			for i := range inner {
+				base := o*inLen*inner + i
+				for j := range inLen {
+					lineIn[j] = in[base+j*inner]
+				}
+
+				err := line.ResampleWithScratch(lineOut, lineIn, r.lineScratch)
+				if err != nil {
+					return err
+				}
+
+				base = o*outLen*inner + i
+				for j := range outLen {
+					out[base+j*inner] = lineOut[j]
+				}
+			}
+		}
+
+		cur[axis] = outLen
+		in = out[:shapeSize(cur)]
+
+		if &out[0] == &r.bufA[0] {
+			out = r.bufB
+		} else {
+			out = r.bufA
+		}
+	}
+
+	copy(dst, in)
+
+	return nil
+}
+
+func shapeSize(dims []int) int {
+	total := 1
+	for _, d := range dims {
+		total *= d
+	}
+
+	return total
+}
+
+// ResamplerND is a reusable plan for separable Fourier resampling of
+// row-major complex N-dimensional arrays (for example images), resampling
+// each axis in turn with scipy.signal.resample semantics.
+//
+// A ResamplerND holds scratch buffers and is not safe for concurrent use.
+type ResamplerND[T Complex] struct {
+	axes *resampleAxes[T, T]
+}
+
+// NewResamplerND creates a complex ND resampler from inDims to outDims.
+// Both shapes are row-major and must have the same rank.
+func NewResamplerND[T Complex](inDims, outDims []int, opts ResampleOptions) (*ResamplerND[T], error) {
+	axes, err := newResampleAxes(inDims, outDims, opts,
+		func(inLen, outLen int, opts ResampleOptions) (resampleLine[T, T], error) {
+			return NewResampler[T](inLen, outLen, opts)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResamplerND[T]{axes: axes}, nil
+}
+
+// InputDims returns a copy of the input shape.
+func (r *ResamplerND[T]) InputDims() []int {
+	return slices.Clone(r.axes.inDims)
+}
+
+// OutputDims returns a copy of the output shape.
+func (r *ResamplerND[T]) OutputDims() []int {
+	return slices.Clone(r.axes.outDims)
+}
+
+// String returns a human-readable description of the ResamplerND for debugging.
+func (r *ResamplerND[T]) String() string {
+	return fmt.Sprintf("ResamplerND(%v -> %v)", r.axes.inDims, r.axes.outDims)
+}
+
+// Resample writes the resampled array to dst.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if the slice lengths do not match the shapes.
+func (r *ResamplerND[T]) Resample(dst, src []T) error {
+	return r.axes.resample(dst, src)
+}
+
+// ResamplerRealND is the real-valued counterpart of ResamplerND.
+//
+// A ResamplerRealND holds scratch buffers and is not safe for concurrent use.
+type ResamplerRealND[F Float, C Complex] struct {
+	axes *resampleAxes[F, C]
+}
+
+// NewResamplerRealND creates a real ND resampler from inDims to outDims.
+// Both shapes are row-major and must have the same rank.
+func NewResamplerRealND[F Float, C Complex](inDims, outDims []int, opts ResampleOptions) (*ResamplerRealND[F, C], error) {
+	axes, err := newResampleAxes(inDims, outDims, opts,
+		func(inLen, outLen int, opts ResampleOptions) (resampleLine[F, C], error) {
+			return NewResamplerReal[F, C](inLen, outLen, opts)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResamplerRealND[F, C]{axes: axes}, nil
+}
+
+// InputDims returns a copy of the input shape.
+func (r *ResamplerRealND[F, C]) InputDims() []int {
+	return slices.Clone(r.axes.inDims)
+}
+
+// OutputDims returns a copy of the output shape.
+func (r *ResamplerRealND[F, C]) OutputDims() []int {
+	return slices.Clone(r.axes.outDims)
+}
+
+// String returns a human-readable description of the ResamplerRealND for debugging.
+func (r *ResamplerRealND[F, C]) String() string {
+	return fmt.Sprintf("ResamplerRealND(%v -> %v)", r.axes.inDims, r.axes.outDims)
+}
+
+// Resample writes the resampled array to dst.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if the slice lengths do not match the shapes.
+func (r *ResamplerRealND[F, C]) Resample(dst, src []F) error {
+	return r.axes.resample(dst, src)
+}
@@ -0,0 +1,349 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+// trigInterpolate evaluates the trigonometric interpolant of x at mOut
+// equispaced points, splitting the Nyquist term of even lengths as a cosine.
+func trigInterpolate(x []complex128, mOut int) []complex128 {
+	n := len(x)
+	spectrum := reference.NaiveDFT128(x)
+	out := make([]complex128, mOut)
+
+	for j := range out {
+		t := float64(j) * float64(n) / float64(mOut)
+
+		var sum complex128
+
+		for k := range n {
+			bin := k
+			if 2*k > n {
+				bin = k - n
+			}
+
+			if 2*k == n {
+				sum += spectrum[k] * complex(math.Cos(math.Pi*t), 0)
+				continue
+			}
+
+			sum += spectrum[k] * cmplx.Rect(1, 2*math.Pi*float64(bin)*t/float64(n))
+		}
+
+		out[j] = sum / complex(float64(n), 0)
+	}
+
+	return out
+}
+
+func randomComplex128(n int, seed uint64) []complex128 {
+	rng := rand.New(rand.NewPCG(seed, uint64(n))) //nolint:gosec
+
+	x := make([]complex128, n)
+	for i := range x {
+		x[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+
+	return x
+}
+
+func TestResampler_UpsampleMatchesInterpolant(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct{ n, m int }{{8, 16}, {8, 13}, {7, 20}, {16, 17}, {5, 5}, {1, 4}} {
+		x := randomComplex128(tc.n, 1)
+
+		r, err := NewResampler[complex128](tc.n, tc.m, ResampleOptions{})
+		if err != nil {
+			t.Fatalf("NewResampler(%d, %d) failed: %v", tc.n, tc.m, err)
+		}
+
+		got := make([]complex128, tc.m)
+		if err := r.Resample(got, x); err != nil {
+			t.Fatalf("Resample failed: %v", err)
+		}
+
+		want := trigInterpolate(x, tc.m)
+		for i := range got {
+			if cmplx.Abs(got[i]-want[i]) > 1e-12 {
+				t.Fatalf("%d->%d: sample %d got %v want %v", tc.n, tc.m, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestResampler_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct{ n, m int }{{8, 32}, {10, 15}, {9, 12}, {7, 11}, {12, 97}} {
+		x := randomComplex128(tc.n, 2)
+
+		up, err := NewResampler[complex128](tc.n, tc.m, ResampleOptions{})
+		if err != nil {
+			t.Fatalf("NewResampler failed: %v", err)
+		}
+
+		down, err := NewResampler[complex128](tc.m, tc.n, ResampleOptions{})
+		if err != nil {
+			t.Fatalf("NewResampler failed: %v", err)
+		}
+
+		mid := make([]complex128, tc.m)
+		got := make([]complex128, tc.n)
+
+		if err := up.Resample(mid, x); err != nil {
+			t.Fatalf("up Resample failed: %v", err)
+		}
+
+		if err := down.Resample(got, mid); err != nil {
+			t.Fatalf("down Resample failed: %v", err)
+		}
+
+		for i := range got {
+			if cmplx.Abs(got[i]-x[i]) > 1e-12 {
+				t.Fatalf("%d->%d->%d: sample %d got %v want %v", tc.n, tc.m, tc.n, i, got[i], x[i])
+			}
+		}
+	}
+}
+
+func TestResamplerReal_MatchesComplex(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct{ n, m int }{{16, 24}, {16, 9}, {15, 24}, {15, 8}, {10, 7}, {9, 3}, {12, 12}} {
+		x := randomComplex128(tc.n, 3)
+		src := make([]float64, tc.n)
+
+		for i := range x {
+			x[i] = complex(real(x[i]), 0)
+			src[i] = real(x[i])
+		}
+
+		cr, err := NewResampler[complex128](tc.n, tc.m, ResampleOptions{})
+		if err != nil {
+			t.Fatalf("NewResampler failed: %v", err)
+		}
+
+		want := make([]complex128, tc.m)
+		if err := cr.Resample(want, x); err != nil {
+			t.Fatalf("complex Resample failed: %v", err)
+		}
+
+		got := make([]float64, tc.m)
+		if err := Resample64(got, src); err != nil {
+			t.Fatalf("Resample64(%d -> %d) failed: %v", tc.n, tc.m, err)
+		}
+
+		for i := range got {
+			if math.Abs(got[i]-real(want[i])) > 1e-12 {
+				t.Fatalf("%d->%d: sample %d got %v want %v", tc.n, tc.m, i, got[i], real(want[i]))
+			}
+		}
+	}
+}
+
+// TestResamplerReal_ConcurrentUse shares one resampler across goroutines
+// and checks every result against a serial reference.
+func TestResamplerReal_ConcurrentUse(t *testing.T) {
+	t.Parallel()
+
+	const goroutines = 8
+
+	r, err := NewResamplerReal[float64, complex128](15, 22, ResampleOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := make([][]float64, goroutines)
+	want := make([][]float64, goroutines)
+
+	for g := range goroutines {
+		src[g] = randomFloat64(r.InputLen(), uint64(g+1))
+		want[g] = make([]float64, r.OutputLen())
+
+		if err := r.Resample(want[g], src[g]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	for g := range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			got := make([]float64, r.OutputLen())
+
+			for range 50 {
+				if err := r.Resample(got, src[g]); err != nil {
+					t.Error(err)
+					return
+				}
+
+				for i := range got {
+					if got[i] != want[g][i] {
+						t.Errorf("goroutine %d sample %d differs from the serial result", g, i)
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestResample_Float32Tone(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n = 64
+		m = 150
+	)
+
+	src := make([]float32, n)
+	for i := range src {
+		src[i] = float32(math.Sin(2 * math.Pi * 5 * float64(i) / n))
+	}
+
+	dst := make([]float32, m)
+	if err := Resample(dst, src); err != nil {
+		t.Fatalf("Resample failed: %v", err)
+	}
+
+	for i, v := range dst {
+		want := math.Sin(2 * math.Pi * 5 * float64(i) / m)
+		if math.Abs(float64(v)-want) > 1e-5 {
+			t.Fatalf("sample %d got %v want %v", i, v, want)
+		}
+	}
+}
+
+func TestResampler_Window(t *testing.T) {
+	t.Parallel()
+
+	x := randomComplex128(12, 4)
+
+	// Keep only DC: the output is the constant mean of the input.
+	dcOnly := func(bin, _ int) float64 {
+		if bin == 0 {
+			return 1
+		}
+
+		return 0
+	}
+
+	r, err := NewResampler[complex128](12, 20, ResampleOptions{Window: dcOnly})
+	if err != nil {
+		t.Fatalf("NewResampler failed: %v", err)
+	}
+
+	var mean complex128
+	for _, v := range x {
+		mean += v / 12
+	}
+
+	got := make([]complex128, 20)
+	if err := r.Resample(got, x); err != nil {
+		t.Fatalf("Resample failed: %v", err)
+	}
+
+	for i, v := range got {
+		if cmplx.Abs(v-mean) > 1e-12 {
+			t.Fatalf("sample %d got %v want %v", i, v, mean)
+		}
+	}
+}
+
+func TestResamplerRealND_Image(t *testing.T) {
+	t.Parallel()
+
+	const (
+		h, w   = 12, 16
+		h2, w2 = 21, 10
+	)
+
+	tone := func(y, x, height, width int) float64 {
+		return math.Cos(2 * math.Pi * (2*float64(y)/float64(height) + 3*float64(x)/float64(width)))
+	}
+
+	src := make([]float64, h*w)
+	for y := range h {
+		for x := range w {
+			src[y*w+x] = tone(y, x, h, w)
+		}
+	}
+
+	r, err := NewResamplerRealND[float64, complex128]([]int{h, w}, []int{h2, w2}, ResampleOptions{})
+	if err != nil {
+		t.Fatalf("NewResamplerRealND failed: %v", err)
+	}
+
+	dst := make([]float64, h2*w2)
+	if err := r.Resample(dst, src); err != nil {
+		t.Fatalf("Resample failed: %v", err)
+	}
+
+	for y := range h2 {
+		for x := range w2 {
+			if want := tone(y, x, h2, w2); math.Abs(dst[y*w2+x]-want) > 1e-12 {
+				t.Fatalf("%s: (%d,%d) got %v want %v", r, y, x, dst[y*w2+x], want)
+			}
+		}
+	}
+
+	cr, err := NewResamplerND[complex128]([]int{h, w}, []int{h2, w2}, ResampleOptions{})
+	if err != nil {
+		t.Fatalf("NewResamplerND failed: %v", err)
+	}
+
+	csrc := make([]complex128, len(src))
+	for i, v := range src {
+		csrc[i] = complex(v, 0)
+	}
+
+	cdst := make([]complex128, h2*w2)
+	if err := cr.Resample(cdst, csrc); err != nil {
+		t.Fatalf("complex Resample failed: %v", err)
+	}
+
+	for i := range cdst {
+		if cmplx.Abs(cdst[i]-complex(dst[i], 0)) > 1e-12 {
+			t.Fatalf("%s: index %d got %v want %v", cr, i, cdst[i], dst[i])
+		}
+	}
+}
+
+func TestResampler_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewResampler[complex64](0, 4, ResampleOptions{}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("inLen=0: expected ErrInvalidLength, got %v", err)
+	}
+
+	if _, err := NewResamplerRealND[float32, complex64]([]int{4, 4}, []int{4}, ResampleOptions{}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("rank mismatch: expected ErrInvalidLength, got %v", err)
+	}
+
+	if err := Resample(nil, make([]float32, 4)); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: expected ErrNilSlice, got %v", err)
+	}
+
+	r, err := NewResamplerReal[float32, complex64](8, 6, ResampleOptions{})
+	if err != nil {
+		t.Fatalf("NewResamplerReal failed: %v", err)
+	}
+
+	if err := r.Resample(make([]float32, 8), make([]float32, 8)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("wrong dst length: expected ErrLengthMismatch, got %v", err)
+	}
+}
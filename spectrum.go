@@ -0,0 +1,173 @@
+package algofft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// Spectrum wraps FFT output with the metadata needed to interpret it
+// physically: the sample rate, whether the bins are one-sided (real FFT) or
+// two-sided (complex FFT), and how the transform was normalized.
+//
+// Spectrum is a value type; it references Bins without copying.
+type Spectrum[T Complex] struct {
+	// Bins holds the FFT output in FFT order.
+	// Two-sided: N bins. One-sided: N/2+1 bins.
+	Bins []T
+
+	// N is the transform length (number of time-domain samples).
+	N int
+
+	// SampleRate is the time-domain sample rate in Hz.
+	SampleRate float64
+
+	// OneSided reports whether Bins holds only the non-negative frequencies
+	// of a real signal.
+	OneSided bool
+
+	// Normalization is the scaling that was applied by the forward transform.
+	Normalization Normalization
+}
+
+// NewSpectrum wraps the output of Plan.Forward (two-sided, N = len(bins)).
+func NewSpectrum[T Complex](bins []T, sampleRate float64) Spectrum[T] {
+	return Spectrum[T]{Bins: bins, N: len(bins), SampleRate: sampleRate}
+}
+
+// NewRealSpectrum wraps the output of PlanRealT.Forward (one-sided) for a
+// length-n real transform.
+//
+// Returns ErrLengthMismatch if len(bins) != n/2+1.
+func NewRealSpectrum[C Complex](bins []C, n int, sampleRate float64) (Spectrum[C], error) {
+	if n < 1 || len(bins) != n/2+1 {
+		return Spectrum[C]{}, fmt.Errorf("spectrum of length %d needs %d bins, got %d: %w",
+			n, n/2+1, len(bins), ErrLengthMismatch)
+	}
+
+	return Spectrum[C]{Bins: bins, N: n, SampleRate: sampleRate, OneSided: true}, nil
+}
+
+// Resolution returns the bin spacing SampleRate/N in Hz.
+func (s Spectrum[T]) Resolution() float64 {
+	return s.SampleRate / float64(s.N)
+}
+
+// Frequency returns the frequency of bin k in Hz.
+// Two-sided spectra map the upper half of the bins to negative frequencies.
+func (s Spectrum[T]) Frequency(k int) float64 {
+	if !s.OneSided && k >= (s.N+1)/2 {
+		k -= s.N
+	}
+
+	return float64(k) * s.Resolution()
+}
+
+// Frequencies returns the frequency of every bin in Hz, in bin order.
+func (s Spectrum[T]) Frequencies() []float64 {
+	if s.OneSided {
+		return RFFTFreq(s.N, 1/s.SampleRate)
+	}
+
+	return FFTFreq(s.N, 1/s.SampleRate)
+}
+
+// Bin returns the index of the bin nearest to freq (in Hz).
+// Negative frequencies wrap for two-sided spectra and clamp to 0 for
+// one-sided spectra; frequencies above the last bin clamp to it.
+func (s Spectrum[T]) Bin(freq float64) int {
+	k := int(math.Round(freq / s.Resolution()))
+
+	if s.OneSided {
+		return min(max(k, 0), len(s.Bins)-1)
+	}
+
+	k %= s.N
+	if k < 0 {
+		k += s.N
+	}
+
+	return k
+}
+
+// Magnitude returns the amplitude of each bin, undoing the transform
+// normalization so that a sinusoid of amplitude A at a bin frequency reads A
+// in a one-sided spectrum (A/2 at each of ±f in a two-sided spectrum).
+func (s Spectrum[T]) Magnitude() []float64 {
+	scale := s.amplitudeScale()
+	mags := make([]float64, len(s.Bins))
+
+	for k, v := range s.Bins {
+		mags[k] = cmplx.Abs(complexToC128(v)) * scale
+
+		// One-sided bins fold in their negative-frequency mirror, except DC
+		// and (for even N) Nyquist, which have none.
+		if s.OneSided && k != 0 && 2*k != s.N {
+			mags[k] *= 2
+		}
+	}
+
+	return mags
+}
+
+// Phase returns the phase of each bin in radians, in (-π, π].
+func (s Spectrum[T]) Phase() []float64 {
+	phases := make([]float64, len(s.Bins))
+	for k, v := range s.Bins {
+		phases[k] = cmplx.Phase(complexToC128(v))
+	}
+
+	return phases
+}
+
+// UnwrappedPhase returns Phase with 2π jumps removed (see Unwrap).
+func (s Spectrum[T]) UnwrappedPhase() []float64 {
+	phases := s.Phase()
+	Unwrap(phases)
+
+	return phases
+}
+
+// PowerDB returns 10*log10 of the squared Magnitude of each bin.
+// Empty bins yield -Inf.
+func (s Spectrum[T]) PowerDB() []float64 {
+	power := s.Magnitude()
+	for k, v := range power {
+		power[k] = 20 * math.Log10(v)
+	}
+
+	return power
+}
+
+// amplitudeScale converts |X[k]| to sinusoid amplitude for two-sided bins.
+func (s Spectrum[T]) amplitudeScale() float64 {
+	switch s.Normalization {
+	case NormOrtho:
+		return 1 / math.Sqrt(float64(s.N))
+	case NormForward:
+		return 1
+	default:
+		return 1 / float64(s.N)
+	}
+}
+
+// Unwrap removes 2π discontinuities from a sequence of phases in place:
+// whenever consecutive values differ by more than π, multiples of 2π are
+// added to the rest of the sequence (numpy.unwrap).
+func Unwrap(phases []float64) {
+	offset := 0.0
+
+	for i := 1; i < len(phases); i++ {
+		prev := phases[i-1]
+		cur := phases[i] + offset
+
+		delta := cur - prev
+		if delta > math.Pi || delta < -math.Pi {
+			jump := 2 * math.Pi * math.Round(delta/(2*math.Pi))
+			offset -= jump
+			cur -= jump
+		}
+
+		phases[i] = cur
+	}
+}
@@ -0,0 +1,329 @@
+package algofft
+
+import (
+	"fmt"
+	"unsafe"
+
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// SpectrumFormat selects the layout in which ForwardFormat writes, and
+// InverseFormat reads, the Hermitian spectrum of a real transform. All
+// formats are stored in a real-valued slice; complex bins are interleaved
+// (re, im) pairs, the memory layout of a complex slice.
+//
+// For a 1D transform of even length N with spectrum X:
+//
+//	SpectrumCompact  N+2 reals  Re X0, Im X0, Re X1, Im X1, ..., Re XN/2, Im XN/2
+//	SpectrumFull     2N reals   Re X0, Im X0, ..., Re XN-1, Im XN-1
+//	SpectrumCCS      N+2 reals  Re X0, 0, Re X1, Im X1, ..., Re XN/2, 0
+//	SpectrumPack     N reals    Re X0, Re X1, Im X1, ..., Re XN/2-1, Im XN/2-1, Re XN/2
+//	SpectrumPerm     N reals    Re X0, Re XN/2, Re X1, Im X1, ..., Re XN/2-1, Im XN/2-1
+//
+// SpectrumCompact is the output of Forward viewed as reals; SpectrumCCS is
+// the same layout under its IPP/MKL name. For 2D and 3D plans the compact,
+// CCS and full formats are the row-major compact and full spectra. Pack and
+// Perm store the interior bins along the last axis at every position of the
+// outer axes, and the DC and Nyquist planes, which are themselves Hermitian,
+// recursively in the same format along the outer axes; for 2D Pack this is
+// the RCPack2D layout of IPP. Outer axes of odd length store Pack and Perm
+// alike, without a Nyquist bin.
+type SpectrumFormat uint8
+
+const (
+	// SpectrumCompact is the non-redundant half-spectrum of Forward.
+	SpectrumCompact SpectrumFormat = iota
+
+	// SpectrumFull is the complete spectrum with the conjugates filled in.
+	SpectrumFull
+
+	// SpectrumCCS is the complex conjugate-symmetric format of IPP and MKL.
+	SpectrumCCS
+
+	// SpectrumPack is the real-interleaved format of IPP and MKL: N reals
+	// with the real DC and Nyquist bins at both ends.
+	SpectrumPack
+
+	// SpectrumPerm is the permuted format of IPP and MKL: N reals with the
+	// real DC and Nyquist bins at the front.
+	SpectrumPerm
+)
+
+// maxSpectrumRank is the largest rank of the real plans with spectrum formats.
+const maxSpectrumRank = 3
+
+// String returns the name of the format.
+func (f SpectrumFormat) String() string {
+	switch f {
+	case SpectrumCompact:
+		return "compact"
+	case SpectrumFull:
+		return "full"
+	case SpectrumCCS:
+		return "ccs"
+	case SpectrumPack:
+		return "pack"
+	case SpectrumPerm:
+		return "perm"
+	default:
+		return "unknown"
+	}
+}
+
+// validateSpectrumFormat rejects values outside the defined formats.
+func validateSpectrumFormat(format SpectrumFormat) error {
+	if format > SpectrumPerm {
+		return fmt.Errorf("spectrum format %d: %w", format, ErrInvalidFormat)
+	}
+
+	return nil
+}
+
+// spectrumFormatLen returns the number of reals that hold the spectrum of a
+// real array of shape dims in the given format, or 0 for unknown formats.
+func spectrumFormatLen(format SpectrumFormat, dims ...int) int {
+	total := shapeSize(dims)
+
+	switch format {
+	case SpectrumCompact, SpectrumCCS:
+		last := dims[len(dims)-1]
+
+		return total / last * (last + 2)
+	case SpectrumFull:
+		return 2 * total
+	case SpectrumPack, SpectrumPerm:
+		return total
+	default:
+		return 0
+	}
+}
+
+// ComplexView reinterprets the interleaved (re, im) pairs of s as complex
+// values without copying; the result shares memory with s. A trailing odd
+// element is not part of the view. C must match the precision of F
+// (float32→complex64, float64→complex128).
+//
+// Together with RealView it lets one buffer serve as the real input and the
+// complex output of an in-place real transform (see ForwardInPlace).
+func ComplexView[C Complex, F Float](s []F) []C {
+	switch f := any(s).(type) {
+	case []float32:
+		return any(unsafe.Slice((*complex64)(unsafe.Pointer(unsafe.SliceData(f))), len(f)/2)).([]C)
+	case []float64:
+		return any(unsafe.Slice((*complex128)(unsafe.Pointer(unsafe.SliceData(f))), len(f)/2)).([]C)
+	}
+
+	return nil
+}
+
+// RealView reinterprets s as its interleaved (re, im) pairs without
+// copying; the result shares memory with s. F must match the precision of C.
+func RealView[F Float, C Complex](s []C) []F {
+	switch c := any(s).(type) {
+	case []complex64:
+		return any(unsafe.Slice((*float32)(unsafe.Pointer(unsafe.SliceData(c))), 2*len(c))).([]F)
+	case []complex128:
+		return any(unsafe.Slice((*float64)(unsafe.Pointer(unsafe.SliceData(c))), 2*len(c))).([]F)
+	}
+
+	return nil
+}
+
+// axisSlots returns where bin k (0 <= 2k <= n) of a Hermitian axis of length
+// n is stored in Pack or Perm order. im is -1 for the real DC and Nyquist bins.
+func axisSlots(format SpectrumFormat, n, k int) (int, int) {
+	switch {
+	case k == 0:
+		return 0, -1
+	case 2*k == n && format == SpectrumPerm:
+		return 1, -1
+	case 2*k == n:
+		return n - 1, -1
+	case format == SpectrumPerm && n%2 == 0:
+		return 2 * k, 2*k + 1
+	default:
+		return 2*k - 1, 2 * k
+	}
+}
+
+// hermitianSlots returns the offsets of the real and imaginary part of bin k
+// in a Pack or Perm array of shape dims. A part that is not stored, because
+// it is zero or the conjugate of a stored bin, has offset -1. The last index
+// must not exceed dims[last]/2.
+func hermitianSlots(format SpectrumFormat, dims, k []int) (int, int) {
+	last := len(dims) - 1
+	n := dims[last]
+
+	if 2*k[last] > n {
+		return -1, -1 // conjugate of bin -k
+	}
+
+	reAxis, imAxis := axisSlots(format, n, k[last])
+	if imAxis >= 0 {
+		outer := flatIndex(dims[:last], k[:last])
+
+		return outer*n + reAxis, outer*n + imAxis
+	}
+
+	if last == 0 {
+		return reAxis, -1
+	}
+
+	// DC and Nyquist planes are Hermitian over the outer axes.
+	outerRe, outerIm := hermitianSlots(format, dims[:last], k[:last])
+
+	re, im := -1, -1
+	if outerRe >= 0 {
+		re = outerRe*n + reAxis
+	}
+
+	if outerIm >= 0 {
+		im = outerIm*n + reAxis
+	}
+
+	return re, im
+}
+
+// flatIndex returns the row-major offset of index k in an array of shape dims.
+func flatIndex(dims, k []int) int {
+	off := 0
+	for i, n := range dims {
+		off = off*n + k[i]
+	}
+
+	return off
+}
+
+// nextBin advances the row-major index k within shape.
+func nextBin(k, shape []int) {
+	for i := len(k) - 1; i >= 0; i-- {
+		k[i]++
+		if k[i] < shape[i] {
+			return
+		}
+
+		k[i] = 0
+	}
+}
+
+// mirrorBin sets m to the index of the conjugate partner of bin k.
+func mirrorBin(m, k, dims []int) {
+	for i, n := range dims {
+		m[i] = (n - k[i]) % n
+	}
+}
+
+// storeSpectrum writes spec, the compact spectrum of a real array of shape
+// dims, multiplied by scale, to dst in the given format.
+func storeSpectrum(dst []float32, spec []complex64, dims []int, format SpectrumFormat, scale float64) {
+	if format == SpectrumCompact || format == SpectrumCCS {
+		copyScaled(ComplexView[complex64](dst), spec, scale)
+		return
+	}
+
+	rank := len(dims)
+	s32 := float32(scale)
+
+	var bin, shape [maxSpectrumRank]int
+
+	copy(shape[:], dims)
+	shape[rank-1] = dims[rank-1]/2 + 1
+	k := bin[:rank]
+
+	if format == SpectrumFull {
+		full := ComplexView[complex64](dst)
+		for _, v := range spec {
+			full[flatIndex(dims, k)] = complex(s32*real(v), s32*imag(v))
+			nextBin(k, shape[:rank])
+		}
+
+		fillConjugates(full, dims)
+
+		return
+	}
+
+	for _, v := range spec {
+		re, im := hermitianSlots(format, dims, k)
+		if re >= 0 {
+			dst[re] = s32 * real(v)
+		}
+
+		if im >= 0 {
+			dst[im] = s32 * imag(v)
+		}
+
+		nextBin(k, shape[:rank])
+	}
+}
+
+// loadSpectrum is the inverse of storeSpectrum: it rebuilds the compact
+// spectrum of a real array of shape dims from src, multiplied by scale.
+func loadSpectrum(spec []complex64, src []float32, dims []int, format SpectrumFormat, scale float64) {
+	if format == SpectrumCompact || format == SpectrumCCS {
+		copyScaled(spec, ComplexView[complex64](src), scale)
+		return
+	}
+
+	rank := len(dims)
+	s32 := float32(scale)
+
+	var bin, mirror, shape [maxSpectrumRank]int
+
+	copy(shape[:], dims)
+	shape[rank-1] = dims[rank-1]/2 + 1
+	k, m := bin[:rank], mirror[:rank]
+
+	if format == SpectrumFull {
+		full := ComplexView[complex64](src)
+		for i := range spec {
+			v := full[flatIndex(dims, k)]
+			spec[i] = complex(s32*real(v), s32*imag(v))
+			nextBin(k, shape[:rank])
+		}
+
+		return
+	}
+
+	for i := range spec {
+		sign := float32(1)
+
+		re, im := hermitianSlots(format, dims, k)
+		if re < 0 && im < 0 {
+			mirrorBin(m, k, dims)
+			re, im = hermitianSlots(format, dims, m)
+			sign = -1
+		}
+
+		var vr, vi float32
+		if re >= 0 {
+			vr = src[re]
+		}
+
+		if im >= 0 {
+			vi = sign * src[im]
+		}
+
+		spec[i] = complex(s32*vr, s32*vi)
+		nextBin(k, shape[:rank])
+	}
+}
+
+// fillConjugates fills the bins of a full spectrum of shape dims whose last
+// index exceeds dims[last]/2 with the conjugates of their partners.
+func fillConjugates[C Complex](full []C, dims []int) {
+	rank := len(dims)
+	n := dims[rank-1]
+
+	var bin, mirror [maxSpectrumRank]int
+
+	k, mk := bin[:rank], mirror[:rank]
+
+	for i := range full {
+		if 2*k[rank-1] > n {
+			mirrorBin(mk, k, dims)
+			full[i] = m.Conj(full[flatIndex(dims, mk)])
+		}
+
+		nextBin(k, dims)
+	}
+}
@@ -0,0 +1,470 @@
+package algofft
+
+import (
+	"errors"
+	"testing"
+)
+
+var allSpectrumFormats = []SpectrumFormat{
+	SpectrumCompact, SpectrumFull, SpectrumCCS, SpectrumPack, SpectrumPerm,
+}
+
+// referenceFormat1D lays out the half-spectrum spec of an even-length real
+// signal in the given format, following the documented layouts literally.
+func referenceFormat1D(spec []complex128, n int, format SpectrumFormat) []float64 {
+	half := n / 2
+
+	switch format {
+	case SpectrumCompact, SpectrumCCS:
+		out := make([]float64, 0, n+2)
+		for _, v := range spec {
+			out = append(out, real(v), imag(v))
+		}
+
+		return out
+	case SpectrumFull:
+		out := make([]float64, 0, 2*n)
+		for k := range n {
+			v := spec[min(k, n-k)]
+			if k > half {
+				v = complex(real(v), -imag(v))
+			}
+
+			out = append(out, real(v), imag(v))
+		}
+
+		return out
+	case SpectrumPack:
+		out := []float64{real(spec[0])}
+		for k := 1; k < half; k++ {
+			out = append(out, real(spec[k]), imag(spec[k]))
+		}
+
+		return append(out, real(spec[half]))
+	case SpectrumPerm:
+		out := []float64{real(spec[0]), real(spec[half])}
+		for k := 1; k < half; k++ {
+			out = append(out, real(spec[k]), imag(spec[k]))
+		}
+
+		return out
+	}
+
+	return nil
+}
+
+func TestSpectrumFormatPlanRealT(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{2, 4, 6, 16, 30, 256} {
+		plan, err := NewPlanReal64(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := randomFloat64(n, uint64(n))
+		spec := make([]complex128, plan.SpectrumLen())
+
+		err = plan.Forward(spec, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, format := range allSpectrumFormats {
+			want := referenceFormat1D(spec, n, format)
+			if plan.FormatLen(format) != len(want) {
+				t.Fatalf("n=%d %v: FormatLen = %d, want %d", n, format, plan.FormatLen(format), len(want))
+			}
+
+			got := make([]float64, len(want))
+
+			err = plan.ForwardFormat(got, src, format)
+			if err != nil {
+				t.Fatalf("n=%d %v: ForwardFormat: %v", n, format, err)
+			}
+
+			assertFloatSlicesClose(t, format.String(), got, want, 1e-12)
+
+			back := make([]float64, n)
+
+			err = plan.InverseFormat(back, got, format)
+			if err != nil {
+				t.Fatalf("n=%d %v: InverseFormat: %v", n, format, err)
+			}
+
+			assertFloatSlicesClose(t, format.String()+" roundtrip", back, src, 1e-12)
+		}
+	}
+}
+
+func TestSpectrumFormatPlanReal(t *testing.T) {
+	t.Parallel()
+
+	const n = 64
+
+	plan, err := NewPlanReal(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := toFloat32(randomFloat64(n, 5))
+	spec := make([]complex64, plan.SpectrumLen())
+
+	err = plan.Forward(spec, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec128 := make([]complex128, len(spec))
+	for i, v := range spec {
+		spec128[i] = complex128(v)
+	}
+
+	for _, format := range allSpectrumFormats {
+		got := make([]float32, plan.FormatLen(format))
+
+		err = plan.ForwardFormat(got, src, format)
+		if err != nil {
+			t.Fatalf("%v: ForwardFormat: %v", format, err)
+		}
+
+		assertFloatSlicesClose(t, format.String(), got, toFloat32(referenceFormat1D(spec128, n, format)), 1e-6)
+
+		back := make([]float32, n)
+
+		err = plan.InverseFormat(back, got, format)
+		if err != nil {
+			t.Fatalf("%v: InverseFormat: %v", format, err)
+		}
+
+		assertFloatSlicesClose(t, format.String()+" roundtrip", back, src, 1e-5)
+	}
+}
+
+func TestSpectrumFormatFull1D(t *testing.T) {
+	t.Parallel()
+
+	const n = 12
+
+	plan, err := NewPlanReal64(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	complexPlan, err := NewPlan64(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := randomFloat64(n, 2)
+	srcC := make([]complex128, n)
+
+	for i, v := range src {
+		srcC[i] = complex(v, 0)
+	}
+
+	want := make([]complex128, n)
+
+	err = complexPlan.Forward(want, srcC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make([]complex128, n)
+
+	err = plan.ForwardFull(got, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertComplexSlicesClose(t, "ForwardFull", got, want, 1e-12)
+
+	back := make([]float64, n)
+
+	err = plan.InverseFull(back, got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertFloatSlicesClose(t, "InverseFull", back, src, 1e-12)
+}
+
+// referencePack2D lays out the compact spectrum of a rows×cols real matrix
+// in IPP's RCPack2D order.
+func referencePack2D(spec []complex64, rows, cols int) []float32 {
+	halfCols := cols/2 + 1
+	at := func(r, c int) complex64 { return spec[r*halfCols+c] }
+
+	// column packs a Hermitian column of the spectrum in 1D Pack order.
+	column := func(r, c int) float32 {
+		switch {
+		case r == 0:
+			return real(at(0, c))
+		case rows%2 == 0 && r == rows-1:
+			return real(at(rows/2, c))
+		case r%2 == 1:
+			return real(at((r+1)/2, c))
+		default:
+			return imag(at(r/2, c))
+		}
+	}
+
+	out := make([]float32, rows*cols)
+
+	for r := range rows {
+		out[r*cols] = column(r, 0)
+		out[r*cols+cols-1] = column(r, cols/2)
+
+		for c := 1; c < cols-1; c++ {
+			v := at(r, (c+1)/2)
+			if c%2 == 1 {
+				out[r*cols+c] = real(v)
+			} else {
+				out[r*cols+c] = imag(v)
+			}
+		}
+	}
+
+	return out
+}
+
+func TestSpectrumFormatPlanReal2D(t *testing.T) {
+	t.Parallel()
+
+	for _, dims := range [][2]int{{4, 6}, {5, 8}, {8, 8}, {2, 2}, {1, 4}} {
+		rows, cols := dims[0], dims[1]
+
+		plan, err := NewPlanReal2D(rows, cols)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := toFloat32(randomFloat64(rows*cols, uint64(rows*cols)))
+		spec := make([]complex64, plan.SpectrumLen())
+
+		err = plan.Forward(spec, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pack := make([]float32, plan.FormatLen(SpectrumPack))
+
+		err = plan.ForwardFormat(pack, src, SpectrumPack)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertFloatSlicesClose(t, "RCPack2D", pack, referencePack2D(spec, rows, cols), 1e-5)
+
+		ccs := make([]float32, plan.FormatLen(SpectrumCCS))
+
+		err = plan.ForwardFormat(ccs, src, SpectrumCCS)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertComplexSlicesClose(t, "ccs", ComplexView[complex64](ccs), spec, 0)
+
+		for _, format := range allSpectrumFormats {
+			got := make([]float32, plan.FormatLen(format))
+
+			err = plan.ForwardFormat(got, src, format)
+			if err != nil {
+				t.Fatalf("%dx%d %v: ForwardFormat: %v", rows, cols, format, err)
+			}
+
+			back := make([]float32, rows*cols)
+
+			err = plan.InverseFormat(back, got, format)
+			if err != nil {
+				t.Fatalf("%dx%d %v: InverseFormat: %v", rows, cols, format, err)
+			}
+
+			assertFloatSlicesClose(t, format.String()+" roundtrip", back, src, 1e-5)
+		}
+	}
+}
+
+func TestSpectrumFormatPlanReal3D(t *testing.T) {
+	t.Parallel()
+
+	for _, dims := range [][3]int{{2, 4, 6}, {3, 5, 4}, {4, 4, 8}} {
+		depth, height, width := dims[0], dims[1], dims[2]
+		n := depth * height * width
+
+		plan, err := NewPlanReal3D(depth, height, width)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := toFloat32(randomFloat64(n, uint64(n)))
+
+		full := make([]complex64, n)
+
+		err = plan.ForwardFull(full, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, format := range []SpectrumFormat{SpectrumPack, SpectrumPerm} {
+			got := make([]float32, plan.FormatLen(format))
+			if len(got) != n {
+				t.Fatalf("%v: FormatLen = %d, want %d", format, len(got), n)
+			}
+
+			err = plan.ForwardFormat(got, src, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Every stored value is a real or imaginary part of the full spectrum.
+			dimsSlice := []int{depth, height, width}
+			k := make([]int, 3)
+			shape := []int{depth, height, width/2 + 1}
+
+			for range plan.SpectrumLen() {
+				v := full[flatIndex(dimsSlice, k)]
+
+				re, im := hermitianSlots(format, dimsSlice, k)
+				if re >= 0 && got[re] != real(v) {
+					t.Fatalf("%v: bin %v real part at %d = %v, want %v", format, k, re, got[re], real(v))
+				}
+
+				if im >= 0 && got[im] != imag(v) {
+					t.Fatalf("%v: bin %v imag part at %d = %v, want %v", format, k, im, got[im], imag(v))
+				}
+
+				nextBin(k, shape)
+			}
+		}
+
+		for _, format := range allSpectrumFormats {
+			got := make([]float32, plan.FormatLen(format))
+
+			err = plan.ForwardFormat(got, src, format)
+			if err != nil {
+				t.Fatalf("%v %v: ForwardFormat: %v", dims, format, err)
+			}
+
+			back := make([]float32, n)
+
+			err = plan.InverseFormat(back, got, format)
+			if err != nil {
+				t.Fatalf("%v %v: InverseFormat: %v", dims, format, err)
+			}
+
+			assertFloatSlicesClose(t, format.String()+" roundtrip", back, src, 1e-5)
+		}
+	}
+}
+
+func TestHermitianSlotsCoverFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, dims := range [][]int{{8}, {4, 6}, {5, 8}, {3, 5, 4}, {4, 6, 2}} {
+		for _, format := range []SpectrumFormat{SpectrumPack, SpectrumPerm} {
+			total := shapeSize(dims)
+			seen := make([]int, total)
+			shape := append([]int(nil), dims...)
+			shape[len(shape)-1] = dims[len(dims)-1]/2 + 1
+			k := make([]int, len(dims))
+
+			for range shapeSize(shape) {
+				re, im := hermitianSlots(format, dims, k)
+				if re >= 0 {
+					seen[re]++
+				}
+
+				if im >= 0 {
+					seen[im]++
+				}
+
+				nextBin(k, shape)
+			}
+
+			for off, count := range seen {
+				if count != 1 {
+					t.Fatalf("%v %v: offset %d stored %d times", dims, format, off, count)
+				}
+			}
+		}
+	}
+}
+
+func TestSpectrumFormatErrors(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanReal32(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := make([]float32, 8)
+
+	err = plan.ForwardFormat(make([]float32, 8), src, SpectrumFormat(42))
+	if !errors.Is(err, ErrInvalidFormat) {
+		t.Fatalf("unknown format: error = %v, want ErrInvalidFormat", err)
+	}
+
+	err = plan.ForwardFormat(make([]float32, 10), src, SpectrumPack)
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short pack: error = %v, want ErrLengthMismatch", err)
+	}
+
+	err = plan.InverseFormat(src, nil, SpectrumPerm)
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil src: error = %v, want ErrNilSlice", err)
+	}
+
+	if got := plan.FormatLen(SpectrumFormat(42)); got != 0 {
+		t.Fatalf("FormatLen(unknown) = %d, want 0", got)
+	}
+
+	plan2D, err := NewPlanReal2DWithOptions(4, 4, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = plan2D.ForwardFormat(make([]float32, 16), make([]float32, 16), SpectrumPack)
+	if !errors.Is(err, ErrScratchRequired) {
+		t.Fatalf("external plan: error = %v, want ErrScratchRequired", err)
+	}
+}
+
+func TestSpectrumFormatNoAllocs(t *testing.T) {
+	plan, err := NewPlanReal32(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan2D, err := NewPlanReal2D(8, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := make([]float32, 256)
+	dst := make([]float32, 256)
+	full := make([]complex64, 256)
+
+	for _, format := range []SpectrumFormat{SpectrumPack, SpectrumPerm} {
+		assertNoAllocs(t, "1D ForwardFormat "+format.String(), func() error {
+			return plan.ForwardFormat(dst, src, format)
+		})
+
+		assertNoAllocs(t, "1D InverseFormat "+format.String(), func() error {
+			return plan.InverseFormat(src, dst, format)
+		})
+
+		assertNoAllocs(t, "2D ForwardFormat "+format.String(), func() error {
+			return plan2D.ForwardFormat(dst[:128], src[:128], format)
+		})
+
+		assertNoAllocs(t, "2D InverseFormat "+format.String(), func() error {
+			return plan2D.InverseFormat(src[:128], dst[:128], format)
+		})
+	}
+
+	assertNoAllocs(t, "1D ForwardFull", func() error {
+		return plan.ForwardFull(full, src)
+	})
+}
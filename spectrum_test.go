@@ -0,0 +1,129 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSpectrum_RealTone(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n    = 64
+		rate = 1000.0
+		amp  = 3.0
+	)
+
+	// 125 Hz sits exactly on bin 8 (resolution 15.625 Hz).
+	src := make([]float64, n)
+	for i := range src {
+		src[i] = 0.5 + amp*math.Cos(2*math.Pi*125*float64(i)/rate+0.3)
+	}
+
+	plan, err := NewPlanReal64(n)
+	if err != nil {
+		t.Fatalf("NewPlanReal64 failed: %v", err)
+	}
+
+	bins := make([]complex128, plan.SpectrumLen())
+	if err := plan.Forward(bins, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	spec, err := NewRealSpectrum(bins, n, rate)
+	if err != nil {
+		t.Fatalf("NewRealSpectrum failed: %v", err)
+	}
+
+	k := spec.Bin(125)
+	if k != 8 || spec.Frequency(k) != 125 {
+		t.Fatalf("Bin(125) = %d (%v Hz)", k, spec.Frequency(k))
+	}
+
+	mags := spec.Magnitude()
+	if math.Abs(mags[k]-amp) > 1e-12 || math.Abs(mags[0]-0.5) > 1e-12 {
+		t.Fatalf("magnitudes: tone %v (want %v), DC %v (want 0.5)", mags[k], amp, mags[0])
+	}
+
+	if phase := spec.Phase()[k]; math.Abs(phase-0.3) > 1e-12 {
+		t.Fatalf("phase %v, want 0.3", phase)
+	}
+
+	if db := spec.PowerDB()[k]; math.Abs(db-20*math.Log10(amp)) > 1e-9 {
+		t.Fatalf("power %v dB, want %v", db, 20*math.Log10(amp))
+	}
+
+	if freqs := spec.Frequencies(); len(freqs) != n/2+1 || freqs[n/2] != rate/2 {
+		t.Fatalf("Frequencies: len %d, last %v", len(freqs), freqs[len(freqs)-1])
+	}
+
+	if _, err := NewRealSpectrum(bins[:10], n, rate); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short bins: expected ErrLengthMismatch, got %v", err)
+	}
+}
+
+func TestSpectrum_TwoSided(t *testing.T) {
+	t.Parallel()
+
+	const n = 10
+
+	src := make([]complex128, n)
+	for i := range src {
+		src[i] = complex(2*math.Cos(2*math.Pi*3*float64(i)/n), 0)
+	}
+
+	plan, err := NewPlan64(n)
+	if err != nil {
+		t.Fatalf("NewPlan64 failed: %v", err)
+	}
+
+	bins := make([]complex128, n)
+	if err := plan.Forward(bins, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	spec := NewSpectrum(bins, 100)
+
+	if f := spec.Frequency(7); f != -30 {
+		t.Fatalf("Frequency(7) = %v, want -30", f)
+	}
+
+	if k := spec.Bin(-30); k != 7 {
+		t.Fatalf("Bin(-30) = %d, want 7", k)
+	}
+
+	mags := spec.Magnitude()
+	if math.Abs(mags[3]-1) > 1e-12 || math.Abs(mags[7]-1) > 1e-12 {
+		t.Fatalf("two-sided magnitudes %v, %v, want 1", mags[3], mags[7])
+	}
+
+	ortho := spec
+	ortho.Normalization = NormOrtho
+
+	for i := range bins {
+		bins[i] /= complex(math.Sqrt(n), 0)
+	}
+
+	if m := ortho.Magnitude()[3]; math.Abs(m-1) > 1e-12 {
+		t.Fatalf("ortho magnitude %v, want 1", m)
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	t.Parallel()
+
+	want := make([]float64, 20)
+	phases := make([]float64, 20)
+
+	for i := range want {
+		want[i] = 0.9 * float64(i)
+		phases[i] = math.Remainder(want[i], 2*math.Pi)
+	}
+
+	Unwrap(phases)
+
+	if !floatsNear(phases, want, 1e-12) {
+		t.Fatalf("Unwrap = %v, want %v", phases, want)
+	}
+}
@@ -0,0 +1,305 @@
+package algofft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"slices"
+
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// SymmetryPolicy controls how complex-to-real inverses treat spectra that
+// are not exactly Hermitian, X[k] = conj(X[-k]), e.g. after spectral
+// processing with rounding errors.
+//
+// The policy applies to every bin that an input stores together with its
+// conjugate partner: the DC and Nyquist bins of a 1D half-spectrum, the DC
+// and Nyquist planes of 2D and 3D half-spectra, and all bins of the full
+// spectra of InverseFull. Pack and Perm inputs store no redundant bins and
+// are Hermitian by construction.
+type SymmetryPolicy uint8
+
+const (
+	// SymmetryAuto keeps the behaviour from before symmetry policies: 1D
+	// half-spectrum inverses check their DC and Nyquist bins as under
+	// SymmetryStrict, while 2D and 3D inverses and the upper bins of full
+	// spectra are not checked, as under SymmetryIgnore.
+	SymmetryAuto SymmetryPolicy = iota
+
+	// SymmetryStrict validates the spectrum. A bin fails when half the
+	// distance between it and the conjugate of its partner exceeds
+	// PlanOptions.SymmetryTolerance times the largest magnitude among the
+	// checked bins; the inverse then returns a *SymmetryError naming the bin.
+	SymmetryStrict
+
+	// SymmetryProject replaces the spectrum by its nearest Hermitian
+	// spectrum, averaging each bin with the conjugate of its partner, so the
+	// result is the real part of the complex inverse. For half-spectra
+	// this drops the imaginary parts of self-conjugate bins, as numpy's
+	// irfft does. In-place inverses project the caller's buffer.
+	SymmetryProject
+
+	// SymmetryIgnore neither checks nor projects: the inverse reads only the
+	// non-redundant bins it needs and the real parts of self-conjugate bins.
+	SymmetryIgnore
+)
+
+// String returns the name of the symmetry policy.
+func (s SymmetryPolicy) String() string {
+	switch s {
+	case SymmetryAuto:
+		return "auto"
+	case SymmetryStrict:
+		return "strict"
+	case SymmetryProject:
+		return "project"
+	case SymmetryIgnore:
+		return "ignore"
+	default:
+		return "unknown"
+	}
+}
+
+// SymmetryError reports the first bin of a spectrum that violates Hermitian
+// symmetry under SymmetryStrict. It wraps ErrInvalidSpectrum.
+type SymmetryError struct {
+	// Bin is the index of the offending bin in the input spectrum, one
+	// entry per dimension (e.g. [k] in 1D, [k1, k2] in 2D).
+	Bin []int
+
+	// Deviation is half the distance between the bin and the conjugate of
+	// its partner, relative to the largest magnitude among the checked bins.
+	Deviation float64
+
+	// Tolerance is the tolerance that Deviation exceeds.
+	Tolerance float64
+}
+
+// Error implements the error interface.
+func (e *SymmetryError) Error() string {
+	return fmt.Sprintf("algo-fft: spectrum bin %v deviates from Hermitian symmetry by %.3g (tolerance %.3g)",
+		e.Bin, e.Deviation, e.Tolerance)
+}
+
+// Unwrap returns ErrInvalidSpectrum.
+func (e *SymmetryError) Unwrap() error {
+	return ErrInvalidSpectrum
+}
+
+// symmetryPolicy resolves SymmetryAuto to auto, the behaviour of the
+// inverse from before symmetry policies.
+func symmetryPolicy(opts PlanOptions, auto SymmetryPolicy) SymmetryPolicy {
+	if opts.Symmetry == SymmetryAuto {
+		return auto
+	}
+
+	return opts.Symmetry
+}
+
+// symmetryTolerance returns PlanOptions.SymmetryTolerance or the default for
+// the precision of C.
+func symmetryTolerance[C Complex](opts PlanOptions) float64 {
+	if opts.SymmetryTolerance > 0 {
+		return opts.SymmetryTolerance
+	}
+
+	var zero C
+	if _, ok := any(zero).(complex64); ok {
+		return 1e-4
+	}
+
+	return 1e-10
+}
+
+// checkHermitian validates spec against SymmetryStrict. spec is a full
+// spectrum of shape dims, or with compact set the half-spectrum whose last
+// axis holds dims[last]/2+1 bins; then only the DC and Nyquist planes have
+// stored partners. The tolerance is relative to the largest checked bin.
+func checkHermitian[C Complex](spec []C, dims []int, compact bool, tol float64) error {
+	rank := len(dims)
+	n := dims[rank-1]
+	line, step := n, 1
+
+	if compact {
+		// Bins 0 and n/2 of every line; n/2 is a Nyquist bin only for even n.
+		line, step = n/2+1, max(n/2, 1)
+	}
+
+	var bin, mirror, shape [maxSpectrumRank]int
+
+	copy(shape[:], dims)
+	shape[rank-1] = line
+	k, m := bin[:rank], mirror[:rank]
+
+	// visit calls fn with the flat index of every checked bin, k set to
+	// its position, until fn returns false.
+	visit := func(fn func(i int) bool) {
+		clear(k)
+
+		for base := 0; base < len(spec); base += line {
+			for col := 0; col < line; col += step {
+				if compact && col != 0 && 2*col != n {
+					continue
+				}
+
+				k[rank-1] = col
+				if !fn(base + col) {
+					return
+				}
+			}
+
+			nextBin(k[:rank-1], shape[:rank-1])
+		}
+	}
+
+	// Compare squared magnitudes to keep the passes cheap.
+	maxSq := 0.0
+
+	visit(func(i int) bool {
+		maxSq = max(maxSq, sqAbs(complexToC128(spec[i])))
+		return true
+	})
+
+	limit := tol * tol * maxSq
+
+	var err error
+
+	visit(func(i int) bool {
+		mirrorBin(m, k, dims)
+
+		partner := cmplx.Conj(complexToC128(spec[flatIndex(shape[:rank], m)]))
+
+		devSq := sqAbs(complexToC128(spec[i])-partner) / 4
+		if devSq <= limit {
+			return true
+		}
+
+		relative := math.Inf(1)
+		if maxSq > 0 {
+			relative = math.Sqrt(devSq / maxSq)
+		}
+
+		err = &SymmetryError{Bin: slices.Clone(k), Deviation: relative, Tolerance: tol}
+
+		return false
+	})
+
+	return err
+}
+
+// projectHermitian projects the DC and Nyquist planes of the half-spectrum
+// spec of a real array of shape dims onto Hermitian symmetry.
+func projectHermitian(spec []complex64, dims []int) {
+	rank := len(dims)
+	n := dims[rank-1]
+	half := n/2 + 1
+
+	var bin, mirror, shape [maxSpectrumRank]int
+
+	copy(shape[:], dims)
+	shape[rank-1] = half
+	k, m := bin[:rank], mirror[:rank]
+
+	for i := range spec {
+		if k[rank-1] == 0 || 2*k[rank-1] == n {
+			mirrorBin(m, k, dims)
+
+			j := flatIndex(shape[:rank], m)
+			if j >= i {
+				v, w := spec[i], spec[j]
+				avg := complex((real(v)+real(w))/2, (imag(v)-imag(w))/2)
+				spec[i] = avg
+				spec[j] = complex(real(avg), -imag(avg))
+			}
+		}
+
+		nextBin(k, shape[:rank])
+	}
+}
+
+// applySymmetry applies the symmetry policy of opts to the half-spectrum
+// spec of a real array of shape dims.
+func applySymmetry(spec []complex64, dims []int, opts PlanOptions) error {
+	switch symmetryPolicy(opts, SymmetryIgnore) {
+	case SymmetryStrict:
+		return checkHermitian(spec, dims, true, symmetryTolerance[complex64](opts))
+	case SymmetryProject:
+		projectHermitian(spec, dims)
+	}
+
+	return nil
+}
+
+// loadSymmetric is loadSpectrum under the symmetry policy of opts. Strict
+// validation happens before spec is touched.
+func loadSymmetric(spec []complex64, src []float32, dims []int, format SpectrumFormat, scale float64, opts PlanOptions) error {
+	policy := symmetryPolicy(opts, SymmetryIgnore)
+
+	switch format {
+	case SpectrumCompact, SpectrumCCS:
+		if policy == SymmetryStrict {
+			err := checkHermitian(ComplexView[complex64](src), dims, true, symmetryTolerance[complex64](opts))
+			if err != nil {
+				return err
+			}
+		}
+
+		loadSpectrum(spec, src, dims, format, scale)
+
+		if policy == SymmetryProject {
+			projectHermitian(spec, dims)
+		}
+
+		return nil
+	case SpectrumFull:
+		full := ComplexView[complex64](src)
+
+		switch policy {
+		case SymmetryStrict:
+			err := checkHermitian(full, dims, false, symmetryTolerance[complex64](opts))
+			if err != nil {
+				return err
+			}
+		case SymmetryProject:
+			loadProjectedFull(spec, full, dims, scale)
+			return nil
+		}
+	}
+
+	// Pack and Perm are Hermitian by construction.
+	loadSpectrum(spec, src, dims, format, scale)
+
+	return nil
+}
+
+// loadProjectedFull is loadSpectrum for SpectrumFull under SymmetryProject:
+// every bin of the compact spectrum is averaged with the conjugate of its
+// partner in full.
+func loadProjectedFull(spec, full []complex64, dims []int, scale float64) {
+	rank := len(dims)
+	half := float32(scale) / 2
+
+	var bin, mirror, shape [maxSpectrumRank]int
+
+	copy(shape[:], dims)
+	shape[rank-1] = dims[rank-1]/2 + 1
+	k, mk := bin[:rank], mirror[:rank]
+
+	for i := range spec {
+		mirrorBin(mk, k, dims)
+
+		v := full[flatIndex(dims, k)] + m.Conj(full[flatIndex(dims, mk)])
+		spec[i] = complex(half*real(v), half*imag(v))
+		nextBin(k, shape[:rank])
+	}
+}
+
+// projectFullBins sets bins[k-1], for k = 1..len(bins), to the projection
+// (X[k] + conj(X[N-k]))/2 of the full 1D spectrum full.
+func projectFullBins[C Complex](bins, full []C) {
+	n := len(full)
+	for k := 1; k <= len(bins); k++ {
+		bins[k-1] = (full[k] + m.Conj(full[n-k])) * 0.5
+	}
+}
@@ -0,0 +1,410 @@
+package algofft
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// realParts32 returns the real parts of s.
+func realParts32(s []complex64) []float32 {
+	out := make([]float32, len(s))
+	for i, v := range s {
+		out[i] = real(v)
+	}
+
+	return out
+}
+
+// assertSymmetryError checks that err is a *SymmetryError for bin.
+func assertSymmetryError(t *testing.T, label string, err error, bin ...int) {
+	t.Helper()
+
+	if !errors.Is(err, ErrInvalidSpectrum) {
+		t.Fatalf("%s: error = %v, want ErrInvalidSpectrum", label, err)
+	}
+
+	var symErr *SymmetryError
+	if !errors.As(err, &symErr) {
+		t.Fatalf("%s: error %v is not a *SymmetryError", label, err)
+	}
+
+	if !slices.Equal(symErr.Bin, bin) {
+		t.Fatalf("%s: Bin = %v, want %v", label, symErr.Bin, bin)
+	}
+
+	if symErr.Deviation <= symErr.Tolerance {
+		t.Fatalf("%s: Deviation %g does not exceed Tolerance %g", label, symErr.Deviation, symErr.Tolerance)
+	}
+}
+
+func TestSymmetryStrict1D(t *testing.T) {
+	t.Parallel()
+
+	const n = 16
+
+	plan, err := NewPlanReal64WithOptions(n, PlanOptions{Symmetry: SymmetryStrict})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := make([]complex128, plan.SpectrumLen())
+
+	err = plan.Forward(spec, randomFloat64(n, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := make([]float64, n)
+
+	nyquist := slices.Clone(spec)
+	nyquist[n/2] += 0.5i
+	assertSymmetryError(t, "Inverse", plan.Inverse(dst, nyquist), n/2)
+
+	full := make([]complex128, n)
+
+	err = plan.ForwardFull(full, randomFloat64(n, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	full[n-3] += 0.25
+	assertSymmetryError(t, "InverseFull", plan.InverseFull(dst, full), 3)
+
+	// Rounding-level deviations pass the default tolerance.
+	full[n-3] -= 0.25
+	full[0] += 1e-13i
+
+	err = plan.InverseFull(dst, full)
+	if err != nil {
+		t.Fatalf("InverseFull with rounding noise: %v", err)
+	}
+
+	loose, err := NewPlanReal64WithOptions(n, PlanOptions{SymmetryTolerance: 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = loose.Inverse(dst, nyquist)
+	if err != nil {
+		t.Fatalf("Inverse with loose tolerance: %v", err)
+	}
+
+	plan32, err := NewPlanReal(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec32 := toComplex64(spec)
+	spec32[0] += 1i
+	assertSymmetryError(t, "PlanReal.Inverse", plan32.Inverse(make([]float32, n), spec32), 0)
+}
+
+func TestSymmetryStrictScaleFromCheckedBins(t *testing.T) {
+	t.Parallel()
+
+	const n = 16
+
+	plan, err := NewPlanReal64WithOptions(n, PlanOptions{Symmetry: SymmetryStrict})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A large interior bin must not hide a non-real DC bin.
+	spec := make([]complex128, plan.SpectrumLen())
+	spec[0] = 1 + 1e-5i
+	spec[3] = 1e6
+	assertSymmetryError(t, "Inverse", plan.Inverse(make([]float64, n), spec), 0)
+}
+
+func TestSymmetryAuto(t *testing.T) {
+	t.Parallel()
+
+	const n = 16
+
+	plan, err := NewPlanReal64(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := randomFloat64(n, 3)
+	full := make([]complex128, n)
+
+	err = plan.ForwardFull(full, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := make([]float64, n)
+
+	// 1D half-spectra keep their DC and Nyquist check.
+	spec := slices.Clone(full[:plan.SpectrumLen()])
+	spec[n/2] += 0.5i
+	assertSymmetryError(t, "Inverse", plan.Inverse(dst, spec), n/2)
+
+	// The upper bins of a full spectrum are ignored.
+	full[n-3] += 0.25
+
+	err = plan.InverseFull(dst, full)
+	if err != nil {
+		t.Fatalf("InverseFull: %v", err)
+	}
+
+	assertFloatSlicesClose(t, "InverseFull", dst, src, 1e-12)
+
+	const rows, cols = 4, 6
+
+	plan2D, err := NewPlanReal2D(rows, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec2D := make([]complex64, plan2D.SpectrumLen())
+
+	err = plan2D.Forward(spec2D, toFloat32(randomFloat64(rows*cols, 5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2D inverses are not checked.
+	spec2D[1*(cols/2+1)] += 3i
+
+	err = plan2D.Inverse(make([]float32, rows*cols), spec2D)
+	if err != nil {
+		t.Fatalf("PlanReal2D.Inverse: %v", err)
+	}
+}
+
+func TestSymmetryProject1D(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{2, 8, 30} {
+		plan, err := NewPlanReal64WithOptions(n, PlanOptions{Symmetry: SymmetryProject})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		complexPlan, err := NewPlan64(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		full := randomComplex128(n, uint64(n))
+		want := make([]complex128, n)
+
+		err = complexPlan.Inverse(want, full)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dst := make([]float64, n)
+
+		err = plan.InverseFull(dst, full)
+		if err != nil {
+			t.Fatalf("n=%d: InverseFull: %v", n, err)
+		}
+
+		assertFloatSlicesClose(t, "InverseFull", dst, realParts(want), 1e-12)
+
+		plan32, err := NewPlanRealWithOptions(n, PlanOptions{Symmetry: SymmetryProject})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dst32 := make([]float32, n)
+
+		err = plan32.InverseFull(dst32, toComplex64(full))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertFloatSlicesClose(t, "PlanReal.InverseFull", dst32, toFloat32(dst), 1e-5)
+
+		// A half-spectrum projects to its real DC and Nyquist bins.
+		half := slices.Clone(full[:n/2+1])
+		fillConjugates(full, []int{n})
+		full[0], full[n/2] = complex(real(full[0]), 0), complex(real(full[n/2]), 0)
+
+		err = complexPlan.Inverse(want, full)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = plan.Inverse(dst, half)
+		if err != nil {
+			t.Fatalf("n=%d: Inverse: %v", n, err)
+		}
+
+		assertFloatSlicesClose(t, "Inverse", dst, realParts(want), 1e-12)
+	}
+}
+
+func TestSymmetryIgnore1D(t *testing.T) {
+	t.Parallel()
+
+	const n = 12
+
+	ignore, err := NewPlanReal64WithOptions(n, PlanOptions{Symmetry: SymmetryIgnore})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := randomFloat64(n, 9)
+	full := make([]complex128, n)
+
+	err = ignore.ForwardFull(full, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Upper bins and imaginary DC parts are not read.
+	full[0] += 2i
+	full[n-1] = 100
+
+	dst := make([]float64, n)
+
+	err = ignore.InverseFull(dst, full)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertFloatSlicesClose(t, "InverseFull", dst, src, 1e-12)
+}
+
+func TestSymmetry2D(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols = 4, 6
+
+	strict, err := NewPlanReal2DWithOptions(rows, cols, PlanOptions{Symmetry: SymmetryStrict})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := toFloat32(randomFloat64(rows*cols, 5))
+	spec := make([]complex64, strict.SpectrumLen())
+
+	err = strict.Forward(spec, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := make([]float32, rows*cols)
+
+	// Bin (1, 0) of the DC plane is stored with its partner (3, 0).
+	broken := slices.Clone(spec)
+	broken[1*(cols/2+1)] += 3i
+	assertSymmetryError(t, "Inverse", strict.Inverse(dst, broken), 1, 0)
+
+	err = strict.Inverse(dst, spec)
+	if err != nil {
+		t.Fatalf("Inverse of Forward output: %v", err)
+	}
+
+	full := toComplex64(randomComplex128(rows*cols, 6))
+	assertSymmetryError(t, "InverseFull", strict.InverseFull(dst, full), 0, 0)
+
+	project, err := NewPlanReal2DWithOptions(rows, cols, PlanOptions{Symmetry: SymmetryProject})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	complexPlan, err := NewPlan2D[complex64](rows, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := make([]complex64, rows*cols)
+
+	err = complexPlan.Inverse(want, full)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = project.InverseFull(dst, full)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertFloatSlicesClose(t, "InverseFull", dst, realParts32(want), 1e-5)
+
+	// A compact spectrum is completed from its stored half, then projected.
+	fullBroken := make([]complex64, rows*cols)
+	storeSpectrum(RealView[float32](fullBroken), broken, []int{rows, cols}, SpectrumFull, 1)
+
+	err = complexPlan.Inverse(want, fullBroken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := padRows(make([]float32, rows*cols), cols)
+	copy(ComplexView[complex64](data), broken)
+
+	err = project.InverseInPlace(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertFloatSlicesClose(t, "InverseInPlace", unpadRows(data, cols), realParts32(want), 1e-5)
+}
+
+func TestSymmetry3D(t *testing.T) {
+	t.Parallel()
+
+	const depth, height, width = 2, 3, 4
+
+	plan, err := NewPlanReal3DWithOptions(depth, height, width, PlanOptions{Symmetry: SymmetryStrict})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := padRows(toFloat32(randomFloat64(depth*height*width, 8)), width)
+
+	err = plan.ForwardInPlace(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Bin (0, 1, 2) of the Nyquist plane is stored with its partner (0, 2, 2).
+	halfWidth := width/2 + 1
+	ComplexView[complex64](data)[1*halfWidth+2] += 1
+	before := slices.Clone(data)
+
+	assertSymmetryError(t, "InverseInPlace", plan.InverseInPlace(data), 0, 1, 2)
+
+	if !slices.Equal(data, before) {
+		t.Fatal("InverseInPlace modified the buffer after a symmetry error")
+	}
+
+	ignore, err := NewPlanReal3DWithOptions(depth, height, width, PlanOptions{Symmetry: SymmetryIgnore})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ignore.InverseFormat(make([]float32, depth*height*width),
+		RealView[float32](ComplexView[complex64](data)), SpectrumCompact)
+	if err != nil {
+		t.Fatalf("InverseFormat with SymmetryIgnore: %v", err)
+	}
+}
+
+func TestSymmetryPolicyString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		policy SymmetryPolicy
+		want   string
+	}{
+		{SymmetryAuto, "auto"},
+		{SymmetryStrict, "strict"},
+		{SymmetryProject, "project"},
+		{SymmetryIgnore, "ignore"},
+		{SymmetryPolicy(99), "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.policy.String(); got != tt.want {
+			t.Errorf("SymmetryPolicy(%d).String() = %q, want %q", tt.policy, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package algofft
+
+// ComplexTransformer is the interface shared by the complex-to-complex
+// plan types: Plan, Plan2D, Plan3D, PlanND, PlanNDAxes, PlanGuru and
+// Executor. It lets pipeline code accept any complex transform without
+// knowing its shape.
+//
+// Len is the number of elements of one transform; batched and guru plans
+// may read and write more (see their documentation).
+//
+// Forward-only plans (PlanPruned, PlanSparse) and split-complex plans do
+// not implement it.
+type ComplexTransformer[T Complex] interface {
+	Len() int
+	Forward(dst, src []T) error
+	Inverse(dst, src []T) error
+
+	// CloneTransformer returns an independent transformer for use by
+	// another goroutine. It is the interface form of each plan's Clone.
+	CloneTransformer() ComplexTransformer[T]
+}
+
+// RealTransformer is the interface shared by the real-to-complex plan
+// types: PlanReal, PlanRealT, PlanReal2D, PlanReal3D, PlanRealNDAxes and
+// RealExecutor.
+//
+// Forward maps Len() real samples to SpectrumLen() complex bins and
+// Inverse maps them back.
+type RealTransformer[F Float, C Complex] interface {
+	Len() int
+	SpectrumLen() int
+	Forward(dst []C, src []F) error
+	Inverse(dst []F, src []C) error
+
+	// CloneTransformer returns an independent transformer for use by
+	// another goroutine. It is the interface form of each plan's Clone.
+	CloneTransformer() RealTransformer[F, C]
+}
+
+// CloneTransformer implements ComplexTransformer.
+func (p *Plan[T]) CloneTransformer() ComplexTransformer[T] {
+	return p.Clone()
+}
+
+// CloneTransformer implements ComplexTransformer.
+func (p *Plan2D[T]) CloneTransformer() ComplexTransformer[T] {
+	return p.Clone()
+}
+
+// CloneTransformer implements ComplexTransformer.
+func (p *Plan3D[T]) CloneTransformer() ComplexTransformer[T] {
+	return p.Clone()
+}
+
+// CloneTransformer implements ComplexTransformer.
+func (p *PlanND[T]) CloneTransformer() ComplexTransformer[T] {
+	return p.Clone()
+}
+
+// CloneTransformer implements ComplexTransformer.
+func (p *PlanNDAxes[T]) CloneTransformer() ComplexTransformer[T] {
+	return p.Clone()
+}
+
+// CloneTransformer implements ComplexTransformer. PlanGuru holds no
+// per-call state, so the plan itself is returned.
+func (p *PlanGuru[T]) CloneTransformer() ComplexTransformer[T] {
+	return p
+}
+
+// CloneTransformer implements RealTransformer.
+func (p *PlanReal) CloneTransformer() RealTransformer[float32, complex64] {
+	return p.Clone()
+}
+
+// CloneTransformer implements RealTransformer.
+func (p *PlanRealT[F, C]) CloneTransformer() RealTransformer[F, C] {
+	return p.Clone()
+}
+
+// CloneTransformer implements RealTransformer.
+func (p *PlanReal2D) CloneTransformer() RealTransformer[float32, complex64] {
+	return p.Clone()
+}
+
+// CloneTransformer implements RealTransformer.
+func (p *PlanReal3D) CloneTransformer() RealTransformer[float32, complex64] {
+	return p.Clone()
+}
+
+// CloneTransformer implements RealTransformer.
+func (p *PlanRealNDAxes[F, C]) CloneTransformer() RealTransformer[F, C] {
+	return p.Clone()
+}
@@ -0,0 +1,168 @@
+package algofft
+
+import (
+	"sync"
+	"testing"
+)
+
+var (
+	_ ComplexTransformer[complex64]  = (*Plan[complex64])(nil)
+	_ ComplexTransformer[complex64]  = (*Plan2D[complex64])(nil)
+	_ ComplexTransformer[complex64]  = (*Plan3D[complex64])(nil)
+	_ ComplexTransformer[complex128] = (*PlanND[complex128])(nil)
+	_ ComplexTransformer[complex128] = (*PlanNDAxes[complex128])(nil)
+	_ ComplexTransformer[complex128] = (*PlanGuru[complex128])(nil)
+	_ ComplexTransformer[complex128] = (*Executor[complex128])(nil)
+
+	_ RealTransformer[float32, complex64]  = (*PlanReal)(nil)
+	_ RealTransformer[float64, complex128] = (*PlanRealT[float64, complex128])(nil)
+	_ RealTransformer[float32, complex64]  = (*PlanReal2D)(nil)
+	_ RealTransformer[float32, complex64]  = (*PlanReal3D)(nil)
+	_ RealTransformer[float32, complex64]  = (*PlanRealNDAxes[float32, complex64])(nil)
+	_ RealTransformer[float32, complex64]  = (*RealExecutor[float32, complex64])(nil)
+)
+
+// checkComplexTransformer round-trips data through clones of tr running
+// on several goroutines, using only the interface.
+func checkComplexTransformer(t *testing.T, tr ComplexTransformer[complex128]) {
+	t.Helper()
+
+	const workers = 4
+
+	src := normalizationSignal(tr.Len(), uint64(tr.Len()))
+	want := make([]complex128, tr.Len())
+
+	if err := tr.Forward(want, src); err != nil {
+		t.Fatalf("Forward: %v", err)
+	}
+
+	var wg sync.WaitGroup
+
+	errs := make([]string, workers)
+
+	for w := range workers {
+		wg.Add(1)
+
+		go func(clone ComplexTransformer[complex128]) {
+			defer wg.Done()
+
+			spectrum := make([]complex128, clone.Len())
+			if err := clone.Forward(spectrum, src); err != nil {
+				errs[w] = err.Error()
+				return
+			}
+
+			for i := range want {
+				if spectrum[i] != want[i] {
+					errs[w] = "clone spectrum differs"
+					return
+				}
+			}
+
+			restored := make([]complex128, clone.Len())
+			if err := clone.Inverse(restored, spectrum); err != nil {
+				errs[w] = err.Error()
+				return
+			}
+
+			for i := range src {
+				if d := restored[i] - src[i]; real(d)*real(d)+imag(d)*imag(d) > 1e-20 {
+					errs[w] = "round trip differs"
+					return
+				}
+			}
+		}(tr.CloneTransformer())
+	}
+
+	wg.Wait()
+
+	for w, msg := range errs {
+		if msg != "" {
+			t.Fatalf("worker %d: %s", w, msg)
+		}
+	}
+}
+
+func TestComplexTransformer_AllPlans(t *testing.T) {
+	t.Parallel()
+
+	plan, _ := NewPlanT[complex128](48)
+	plan2D, _ := NewPlan2D[complex128](6, 8)
+	plan3D, _ := NewPlan3D[complex128](2, 4, 6)
+	planND, _ := NewPlanND[complex128]([]int{2, 3, 4, 2})
+	planAxes, _ := NewPlanNDAxes[complex128]([]int{4, 6}, []int{1})
+	planGuru, _ := NewPlanGuru[complex128]([]IODim{{N: 16, InStride: 1, OutStride: 1}}, nil)
+
+	transformers := map[string]ComplexTransformer[complex128]{
+		"Plan":       plan,
+		"Plan2D":     plan2D,
+		"Plan3D":     plan3D,
+		"PlanND":     planND,
+		"PlanNDAxes": planAxes,
+		"PlanGuru":   planGuru,
+		"Executor":   plan2D.NewExecutor(),
+	}
+
+	for name, tr := range transformers {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			checkComplexTransformer(t, tr)
+		})
+	}
+}
+
+func TestRealTransformer_AllPlans(t *testing.T) {
+	t.Parallel()
+
+	plan, _ := NewPlanReal(32)
+	plan2D, _ := NewPlanReal2D(4, 8)
+	plan3D, _ := NewPlanReal3D(2, 4, 8)
+	planAxes, _ := NewPlanRealNDAxes[float32, complex64]([]int{3, 8}, []int{1})
+	generic, _ := NewPlanRealT[float32, complex64](16)
+
+	transformers := map[string]RealTransformer[float32, complex64]{
+		"PlanReal":       plan,
+		"PlanRealT":      generic,
+		"PlanReal2D":     plan2D,
+		"PlanReal3D":     plan3D,
+		"PlanRealNDAxes": planAxes,
+		"RealExecutor":   plan3D.NewExecutor(),
+	}
+
+	for name, tr := range transformers {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			src := narrowReal[float32](realParts(normalizationSignal(tr.Len(), 5)))
+			clone := tr.CloneTransformer()
+
+			want := make([]complex64, tr.SpectrumLen())
+			got := make([]complex64, clone.SpectrumLen())
+
+			if err := tr.Forward(want, src); err != nil {
+				t.Fatalf("Forward: %v", err)
+			}
+
+			if err := clone.Forward(got, src); err != nil {
+				t.Fatalf("clone Forward: %v", err)
+			}
+
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("bin %d: clone %v, original %v", i, got[i], want[i])
+				}
+			}
+
+			restored := make([]float32, clone.Len())
+			if err := clone.Inverse(restored, got); err != nil {
+				t.Fatalf("Inverse: %v", err)
+			}
+
+			for i := range src {
+				if d := restored[i] - src[i]; d > 1e-4 || d < -1e-4 {
+					t.Fatalf("index %d: restored %v, want %v", i, restored[i], src[i])
+				}
+			}
+		})
+	}
+}
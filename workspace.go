@@ -0,0 +1,100 @@
+package algofft
+
+import (
+	"fmt"
+	"sync"
+)
+
+// workspace is the scratch memory of one transform call of a
+// multi-dimensional or real plan. Plans draw workspaces from a per-plan
+// sync.Pool, so a single plan can serve concurrent callers, or carve them
+// from caller-supplied scratch (see acquireWorkspace).
+type workspace[T Complex] struct {
+	buf  []T // SIMD-aligned working copy of the data
+	line []T // one gathered line along a strided axis
+
+	// kernel is scratch for the plan's 1D sub-plans. It is nil in pooled
+	// workspaces, where sub-plans use their own pools.
+	kernel []T
+
+	// backing keeps the aligned buf allocation alive for GC
+	backing []byte
+}
+
+// newWorkspacePool returns a pool of workspaces with bufLen aligned
+// elements and a lineLen-element line buffer. When pool is non-nil the
+// aligned buffers are taken from it; releaseWorkspacePool hands them back.
+func newWorkspacePool[T Complex](pool *BufferPool, bufLen, lineLen int) *sync.Pool {
+	return &sync.Pool{
+		New: func() any {
+			ws := &workspace[T]{line: make([]T, lineLen)}
+			ws.buf, ws.backing = getPooledBuffer[T](pool, bufLen)
+
+			return ws
+		},
+	}
+}
+
+// getWorkspace takes a workspace from pool; return it with pool.Put.
+func getWorkspace[T Complex](pool *sync.Pool) *workspace[T] {
+	ws, _ := pool.Get().(*workspace[T])
+
+	return ws
+}
+
+// releaseWorkspacePool returns the cached workspaces of sp to pool.
+func releaseWorkspacePool[T Complex](sp *sync.Pool, pool *BufferPool) {
+	drainPool(sp, func(item any) {
+		if ws, ok := item.(*workspace[T]); ok {
+			putPooledBuffer(pool, ws.buf, ws.backing)
+		}
+	})
+}
+
+// acquireWorkspace returns the workspace for one transform call. Non-nil
+// scratch is carved into buf, line and kernel (the remainder); otherwise a
+// workspace is taken from sp and also returned as pooled, which the caller
+// must put back. Plans without a pool (WorkspaceExternal) get
+// ErrScratchRequired when no scratch is supplied.
+func acquireWorkspace[T Complex](sp *sync.Pool, scratch []T, bufLen, lineLen int) (workspace[T], *workspace[T], error) {
+	if scratch != nil {
+		lineEnd := bufLen + lineLen
+
+		return workspace[T]{
+			buf:    scratch[:bufLen:bufLen],
+			line:   scratch[bufLen:lineEnd:lineEnd],
+			kernel: scratch[lineEnd:],
+		}, nil, nil
+	}
+
+	if sp == nil {
+		return workspace[T]{}, nil, ErrScratchRequired
+	}
+
+	ws := getWorkspace[T](sp)
+
+	return *ws, ws, nil
+}
+
+// validateScratch checks caller-supplied scratch against the required length.
+func validateScratch[T any](scratch []T, need int) error {
+	if scratch == nil {
+		return ErrNilSlice
+	}
+
+	if len(scratch) < need {
+		return fmt.Errorf("scratch has %d elements, need %d: %w", len(scratch), need, ErrLengthMismatch)
+	}
+
+	return nil
+}
+
+// newScratchPool returns a workspace pool unless the policy is
+// WorkspaceExternal, whose plans keep no scratch of their own.
+func newScratchPool[T Complex](policy WorkspacePolicy, pool *BufferPool, bufLen, lineLen int) *sync.Pool {
+	if policy == WorkspaceExternal {
+		return nil
+	}
+
+	return newWorkspacePool[T](pool, bufLen, lineLen)
+}
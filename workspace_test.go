@@ -0,0 +1,1003 @@
+package algofft
+
+import (
+	"errors"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+func externalOptions() PlanOptions {
+	return PlanOptions{Workspace: WorkspaceExternal}
+}
+
+func randomFloat64(n int, seed uint64) []float64 {
+	rng := rand.New(rand.NewPCG(seed, uint64(n))) //nolint:gosec
+
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = rng.Float64()*2 - 1
+	}
+
+	return out
+}
+
+func assertComplexSlicesClose[T Complex](t *testing.T, label string, got, want []T, tol float64) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s: length %d, want %d", label, len(got), len(want))
+	}
+
+	for i := range got {
+		diff := cmplx.Abs(complex128(got[i]) - complex128(want[i]))
+		if diff > tol {
+			t.Fatalf("%s[%d]: got %v want %v (diff=%v)", label, i, got[i], want[i], diff)
+		}
+	}
+}
+
+func assertFloatSlicesClose[F Float](t *testing.T, label string, got, want []F, tol float64) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s: length %d, want %d", label, len(got), len(want))
+	}
+
+	for i := range got {
+		diff := float64(got[i] - want[i])
+		if diff < 0 {
+			diff = -diff
+		}
+
+		if diff > tol {
+			t.Fatalf("%s[%d]: got %v want %v (diff=%v)", label, i, got[i], want[i], diff)
+		}
+	}
+}
+
+func toComplex64(src []complex128) []complex64 {
+	out := make([]complex64, len(src))
+	for i, v := range src {
+		out[i] = complex64(v)
+	}
+
+	return out
+}
+
+func toFloat32(src []float64) []float32 {
+	out := make([]float32, len(src))
+	for i, v := range src {
+		out[i] = float32(v)
+	}
+
+	return out
+}
+
+// scratchPlan is the subset of the complex plan API exercised by the
+// workspace tests.
+type scratchPlan[T Complex] interface {
+	Forward(dst, src []T) error
+	Inverse(dst, src []T) error
+	ForwardWithScratch(dst, src, scratch []T) error
+	InverseWithScratch(dst, src, scratch []T) error
+	ScratchLen() int
+	Len() int
+}
+
+func checkExternalMatchesAuto[T Complex](t *testing.T, auto, ext scratchPlan[T], src []T, tol float64) {
+	t.Helper()
+
+	n := auto.Len()
+	if guru, ok := auto.(interface{ InputLen() int }); ok {
+		n = guru.InputLen()
+	}
+
+	want := make([]T, n)
+	got := make([]T, n)
+
+	err := auto.Forward(want, src)
+	if err != nil {
+		t.Fatalf("auto Forward: %v", err)
+	}
+
+	err = ext.Forward(got, src)
+	if !errors.Is(err, ErrScratchRequired) {
+		t.Fatalf("external Forward error = %v, want ErrScratchRequired", err)
+	}
+
+	err = ext.Inverse(got, src)
+	if !errors.Is(err, ErrScratchRequired) {
+		t.Fatalf("external Inverse error = %v, want ErrScratchRequired", err)
+	}
+
+	scratch := make([]T, ext.ScratchLen())
+
+	err = ext.ForwardWithScratch(got, src, scratch)
+	if err != nil {
+		t.Fatalf("ForwardWithScratch: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "forward", got, want, tol)
+
+	back := make([]T, n)
+
+	err = ext.InverseWithScratch(back, got, scratch)
+	if err != nil {
+		t.Fatalf("InverseWithScratch: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "roundtrip", back, src, tol)
+
+	// Auto plans accept caller scratch too.
+	autoScratch := make([]T, auto.ScratchLen())
+
+	err = auto.ForwardWithScratch(got, src, autoScratch)
+	if err != nil {
+		t.Fatalf("auto ForwardWithScratch: %v", err)
+	}
+
+	assertComplexSlicesClose(t, "auto scratch", got, want, tol)
+}
+
+func TestWorkspaceExternalComplexPlans(t *testing.T) {
+	t.Parallel()
+
+	const tol = 1e-9
+
+	tests := []struct {
+		name  string
+		build func(opts PlanOptions) (scratchPlan[complex128], error)
+	}{
+		{"1D/pow2", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			return NewPlanWithOptions[complex128](64, opts)
+		}},
+		{"1D/mixed", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			return NewPlanWithOptions[complex128](60, opts)
+		}},
+		{"1D/bluestein", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			return NewPlanWithOptions[complex128](17, opts)
+		}},
+		{"1D/recursive", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			opts.Strategy = KernelRecursive
+			return NewPlanWithOptions[complex128](1024, opts)
+		}},
+		{"2D/square", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			return NewPlan2DWithOptions[complex128](8, 8, opts)
+		}},
+		{"2D/rect", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			return NewPlan2DWithOptions[complex128](6, 17, opts)
+		}},
+		{"3D", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			return NewPlan3DWithOptions[complex128](4, 5, 8, opts)
+		}},
+		{"ND", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			return NewPlanNDWithOptions[complex128]([]int{3, 4, 5, 2}, opts)
+		}},
+		{"NDAxes", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			return NewPlanNDAxesWithOptions[complex128]([]int{4, 6, 5}, []int{0, 2}, opts)
+		}},
+		{"Guru", func(opts PlanOptions) (scratchPlan[complex128], error) {
+			return NewPlanGuruWithOptions[complex128](
+				[]IODim{{N: 12, InStride: 3, OutStride: 3}},
+				[]IODim{{N: 3, InStride: 1, OutStride: 1}},
+				opts,
+			)
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			auto, err := tc.build(PlanOptions{})
+			if err != nil {
+				t.Fatalf("auto plan: %v", err)
+			}
+
+			ext, err := tc.build(externalOptions())
+			if err != nil {
+				t.Fatalf("external plan: %v", err)
+			}
+
+			n := auto.Len()
+			if guru, ok := auto.(interface{ InputLen() int }); ok {
+				n = guru.InputLen()
+			}
+
+			src := randomComplex128(n, 37)
+			checkExternalMatchesAuto(t, auto, ext, src, tol)
+		})
+	}
+}
+
+func TestWorkspaceExternalComplex64(t *testing.T) {
+	t.Parallel()
+
+	auto, err := NewPlanWithOptions[complex64](100, PlanOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ext, err := NewPlanWithOptions[complex64](100, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := ext.Meta().Workspace; got != WorkspaceExternal {
+		t.Fatalf("Meta().Workspace = %v, want %v", got, WorkspaceExternal)
+	}
+
+	checkExternalMatchesAuto[complex64](t, auto, ext, toComplex64(randomComplex128(100, 5)), 1e-4)
+}
+
+func TestWorkspaceExternalPlanReal(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{16, 34, 96} {
+		auto, err := NewPlanReal(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ext, err := NewPlanRealWithOptions(n, externalOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := toFloat32(randomFloat64(n, uint64(n)))
+		want := make([]complex64, auto.SpectrumLen())
+		got := make([]complex64, ext.SpectrumLen())
+
+		err = auto.Forward(want, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = ext.Forward(got, src)
+		if !errors.Is(err, ErrScratchRequired) {
+			t.Fatalf("n=%d: Forward error = %v, want ErrScratchRequired", n, err)
+		}
+
+		scratch := make([]complex64, ext.ScratchLen())
+
+		err = ext.ForwardWithScratch(got, src, scratch)
+		if err != nil {
+			t.Fatalf("n=%d: ForwardWithScratch: %v", n, err)
+		}
+
+		assertComplexSlicesClose(t, "forward", got, want, 1e-4)
+
+		back := make([]float32, n)
+
+		err = ext.InverseWithScratch(back, got, scratch)
+		if err != nil {
+			t.Fatalf("n=%d: InverseWithScratch: %v", n, err)
+		}
+
+		assertFloatSlicesClose(t, "roundtrip", back, src, 1e-4)
+	}
+}
+
+func TestWorkspaceExternalPlanRealT(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{32, 34, 50} {
+		auto, err := NewPlanReal64(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ext, err := NewPlanReal64WithOptions(n, externalOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := randomFloat64(n, uint64(n))
+		want := make([]complex128, auto.SpectrumLen())
+		got := make([]complex128, ext.SpectrumLen())
+
+		err = auto.Forward(want, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = ext.Forward(got, src)
+		if !errors.Is(err, ErrScratchRequired) {
+			t.Fatalf("n=%d: Forward error = %v, want ErrScratchRequired", n, err)
+		}
+
+		scratch := make([]complex128, ext.ScratchLen())
+
+		err = ext.ForwardWithScratch(got, src, scratch)
+		if err != nil {
+			t.Fatalf("n=%d: ForwardWithScratch: %v", n, err)
+		}
+
+		assertComplexSlicesClose(t, "forward", got, want, 1e-9)
+
+		back := make([]float64, n)
+
+		err = ext.InverseWithScratch(back, got, scratch)
+		if err != nil {
+			t.Fatalf("n=%d: InverseWithScratch: %v", n, err)
+		}
+
+		assertFloatSlicesClose(t, "roundtrip", back, src, 1e-9)
+	}
+}
+
+func TestWorkspaceExternalPlanRealFormats(t *testing.T) {
+	t.Parallel()
+
+	formats := []SpectrumFormat{SpectrumCompact, SpectrumFull, SpectrumPack, SpectrumPerm}
+
+	for _, n := range []int{32, 34} {
+		auto, err := NewPlanReal64WithOptions(n, PlanOptions{Symmetry: SymmetryProject})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ext, err := NewPlanReal64WithOptions(n, PlanOptions{Workspace: WorkspaceExternal, Symmetry: SymmetryProject})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := randomFloat64(n, uint64(n))
+		scratch := make([]complex128, ext.ScratchLen())
+
+		for _, format := range formats {
+			want := make([]float64, auto.FormatLen(format))
+			got := make([]float64, ext.FormatLen(format))
+
+			err = auto.ForwardFormat(want, src, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = ext.ForwardFormat(got, src, format)
+			if !errors.Is(err, ErrScratchRequired) {
+				t.Fatalf("n=%d %v: ForwardFormat error = %v, want ErrScratchRequired", n, format, err)
+			}
+
+			err = ext.ForwardFormatWithScratch(got, src, format, scratch)
+			if err != nil {
+				t.Fatalf("n=%d %v: ForwardFormatWithScratch: %v", n, format, err)
+			}
+
+			assertFloatSlicesClose(t, format.String(), got, want, 1e-9)
+
+			back := make([]float64, n)
+
+			err = ext.InverseFormatWithScratch(back, got, format, scratch)
+			if err != nil {
+				t.Fatalf("n=%d %v: InverseFormatWithScratch: %v", n, format, err)
+			}
+
+			assertFloatSlicesClose(t, format.String()+" roundtrip", back, src, 1e-9)
+		}
+
+		data := make([]float64, ext.PaddedLen())
+		copy(data, src)
+
+		err = ext.ForwardInPlace(data)
+		if !errors.Is(err, ErrScratchRequired) {
+			t.Fatalf("n=%d: ForwardInPlace error = %v, want ErrScratchRequired", n, err)
+		}
+
+		err = ext.ForwardInPlaceWithScratch(data, scratch)
+		if err != nil {
+			t.Fatalf("n=%d: ForwardInPlaceWithScratch: %v", n, err)
+		}
+
+		err = ext.InverseInPlaceWithScratch(data, scratch)
+		if err != nil {
+			t.Fatalf("n=%d: InverseInPlaceWithScratch: %v", n, err)
+		}
+
+		assertFloatSlicesClose(t, "in-place roundtrip", data[:n], src, 1e-9)
+	}
+}
+
+func TestWorkspaceExternalPlanSplit(t *testing.T) {
+	t.Parallel()
+
+	// 64 runs the native split kernel, 60 the interleaved fallback.
+	for _, n := range []int{64, 60} {
+		auto, err := NewPlanSplit[float64, complex128](n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ext, err := NewPlanSplitWithOptions[float64, complex128](n, externalOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		srcRe := randomFloat64(n, uint64(n))
+		srcIm := randomFloat64(n, uint64(n)+1)
+		wantRe, wantIm := make([]float64, n), make([]float64, n)
+		gotRe, gotIm := make([]float64, n), make([]float64, n)
+
+		err = auto.ForwardSplit(wantRe, wantIm, srcRe, srcIm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = ext.ForwardSplit(gotRe, gotIm, srcRe, srcIm)
+		if !errors.Is(err, ErrScratchRequired) {
+			t.Fatalf("n=%d: ForwardSplit error = %v, want ErrScratchRequired", n, err)
+		}
+
+		err = ext.InverseSplit(gotRe, gotIm, srcRe, srcIm)
+		if !errors.Is(err, ErrScratchRequired) {
+			t.Fatalf("n=%d: InverseSplit error = %v, want ErrScratchRequired", n, err)
+		}
+
+		scratch := make([]float64, ext.ScratchLen())
+
+		err = ext.ForwardWithScratch(gotRe, gotIm, srcRe, srcIm, scratch)
+		if err != nil {
+			t.Fatalf("n=%d: ForwardWithScratch: %v", n, err)
+		}
+
+		assertFloatSlicesClose(t, "forward re", gotRe, wantRe, 1e-12)
+		assertFloatSlicesClose(t, "forward im", gotIm, wantIm, 1e-12)
+
+		err = ext.InverseWithScratch(gotRe, gotIm, gotRe, gotIm, scratch)
+		if err != nil {
+			t.Fatalf("n=%d: InverseWithScratch: %v", n, err)
+		}
+
+		assertFloatSlicesClose(t, "roundtrip re", gotRe, srcRe, 1e-12)
+		assertFloatSlicesClose(t, "roundtrip im", gotIm, srcIm, 1e-12)
+	}
+}
+
+func TestWorkspaceExternalPlanSplitND(t *testing.T) {
+	t.Parallel()
+
+	dims := []int{4, 6, 8}
+
+	auto, err := NewPlanSplitND[float32, complex64](dims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ext, err := NewPlanSplitNDWithOptions[float32, complex64](dims, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := auto.Len()
+	srcRe := toFloat32(randomFloat64(n, 1))
+	srcIm := toFloat32(randomFloat64(n, 2))
+	wantRe, wantIm := make([]float32, n), make([]float32, n)
+	gotRe, gotIm := make([]float32, n), make([]float32, n)
+
+	err = auto.ForwardSplit(wantRe, wantIm, srcRe, srcIm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ext.ForwardSplit(gotRe, gotIm, srcRe, srcIm)
+	if !errors.Is(err, ErrScratchRequired) {
+		t.Fatalf("Forward error = %v, want ErrScratchRequired", err)
+	}
+
+	scratch := make([]float32, ext.ScratchLen())
+
+	err = ext.ForwardWithScratch(gotRe, gotIm, srcRe, srcIm, scratch)
+	if err != nil {
+		t.Fatalf("ForwardWithScratch: %v", err)
+	}
+
+	assertFloatSlicesClose(t, "forward re", gotRe, wantRe, 1e-4)
+	assertFloatSlicesClose(t, "forward im", gotIm, wantIm, 1e-4)
+
+	err = ext.InverseWithScratch(gotRe, gotIm, gotRe, gotIm, scratch)
+	if err != nil {
+		t.Fatalf("InverseWithScratch: %v", err)
+	}
+
+	assertFloatSlicesClose(t, "roundtrip re", gotRe, srcRe, 1e-4)
+	assertFloatSlicesClose(t, "roundtrip im", gotIm, srcIm, 1e-4)
+}
+
+func TestWorkspaceExternalPlanPruned(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		n, inputLen int
+		out         BinRange
+		mode        prunedMode
+	}{
+		{256, 256, BinRange{0, 256}, prunedFull},
+		{4096, 64, BinRange{0, 4096}, prunedInput},
+		{4096, 4096, BinRange{100, 50}, prunedOutput},
+		{1024, 4, BinRange{10, 3}, prunedDirect},
+	} {
+		auto, err := NewPlanPruned64(tc.n, tc.inputLen, tc.out)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ext, err := NewPlanPrunedWithOptions[complex128](tc.n, tc.inputLen, tc.out, externalOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ext.mode != tc.mode {
+			t.Fatalf("%v: plan mode is %v", tc.mode, ext.mode)
+		}
+
+		src := randomComplex128(tc.inputLen, uint64(tc.n))
+		want := make([]complex128, tc.out.Count)
+		got := make([]complex128, tc.out.Count)
+
+		err = auto.Forward(want, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = ext.Forward(got, src)
+		if !errors.Is(err, ErrScratchRequired) {
+			t.Fatalf("%v: Forward error = %v, want ErrScratchRequired", tc.mode, err)
+		}
+
+		scratch := make([]complex128, ext.ScratchLen())
+
+		err = ext.ForwardWithScratch(got, src, scratch)
+		if err != nil {
+			t.Fatalf("%v: ForwardWithScratch: %v", tc.mode, err)
+		}
+
+		assertComplexSlicesClose(t, tc.mode.String(), got, want, 1e-9)
+	}
+}
+
+func TestWorkspaceExternalResampler(t *testing.T) {
+	t.Parallel()
+
+	for _, lens := range [][2]int{{16, 24}, {15, 22}} {
+		inLen, outLen := lens[0], lens[1]
+
+		auto, err := NewResampler[complex128](inLen, outLen, ResampleOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ext, err := NewResampler[complex128](inLen, outLen, ResampleOptions{Plan: externalOptions()})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := randomComplex128(inLen, uint64(inLen))
+		want := make([]complex128, outLen)
+		got := make([]complex128, outLen)
+
+		err = auto.Resample(want, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = ext.Resample(got, src)
+		if !errors.Is(err, ErrScratchRequired) {
+			t.Fatalf("%d->%d: Resample error = %v, want ErrScratchRequired", inLen, outLen, err)
+		}
+
+		err = ext.ResampleWithScratch(got, src, make([]complex128, ext.ScratchLen()))
+		if err != nil {
+			t.Fatalf("%d->%d: ResampleWithScratch: %v", inLen, outLen, err)
+		}
+
+		assertComplexSlicesClose(t, "resample", got, want, 1e-12)
+
+		realAuto, err := NewResamplerReal[float64, complex128](inLen, outLen, ResampleOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		realExt, err := NewResamplerReal[float64, complex128](inLen, outLen, ResampleOptions{Plan: externalOptions()})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		realSrc := randomFloat64(inLen, uint64(outLen))
+		realWant := make([]float64, outLen)
+		realGot := make([]float64, outLen)
+
+		err = realAuto.Resample(realWant, realSrc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = realExt.Resample(realGot, realSrc)
+		if !errors.Is(err, ErrScratchRequired) {
+			t.Fatalf("%d->%d: real Resample error = %v, want ErrScratchRequired", inLen, outLen, err)
+		}
+
+		err = realExt.ResampleWithScratch(realGot, realSrc, make([]complex128, realExt.ScratchLen()))
+		if err != nil {
+			t.Fatalf("%d->%d: real ResampleWithScratch: %v", inLen, outLen, err)
+		}
+
+		assertFloatSlicesClose(t, "real resample", realGot, realWant, 1e-12)
+	}
+
+	// ND resamplers keep their own line scratch and work under any policy.
+	inDims, outDims := []int{6, 5}, []int{9, 4}
+
+	ndAuto, err := NewResamplerRealND[float64, complex128](inDims, outDims, ResampleOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ndExt, err := NewResamplerRealND[float64, complex128](inDims, outDims, ResampleOptions{Plan: externalOptions()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ndSrc := randomFloat64(30, 7)
+	ndWant := make([]float64, 36)
+	ndGot := make([]float64, 36)
+
+	err = ndAuto.Resample(ndWant, ndSrc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ndExt.Resample(ndGot, ndSrc)
+	if err != nil {
+		t.Fatalf("ND Resample: %v", err)
+	}
+
+	assertFloatSlicesClose(t, "ND resample", ndGot, ndWant, 1e-12)
+}
+
+func TestWorkspaceExternalPlanReal2D(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols = 6, 10
+
+	auto, err := NewPlanReal2D(rows, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ext, err := NewPlanReal2DWithOptions(rows, cols, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := toFloat32(randomFloat64(rows*cols, 11))
+	want := make([]complex64, auto.SpectrumLen())
+	got := make([]complex64, ext.SpectrumLen())
+
+	err = auto.Forward(want, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ext.Forward(got, src)
+	if !errors.Is(err, ErrScratchRequired) {
+		t.Fatalf("Forward error = %v, want ErrScratchRequired", err)
+	}
+
+	scratch := make([]complex64, ext.ScratchLen())
+
+	err = ext.ForwardWithScratch(got, src, scratch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertComplexSlicesClose(t, "forward", got, want, 1e-4)
+
+	back := make([]float32, rows*cols)
+
+	err = ext.InverseWithScratch(back, got, scratch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertFloatSlicesClose(t, "roundtrip", back, src, 1e-4)
+
+	full := make([]complex64, rows*cols)
+
+	err = ext.ForwardFull(full, src)
+	if !errors.Is(err, ErrScratchRequired) {
+		t.Fatalf("ForwardFull error = %v, want ErrScratchRequired", err)
+	}
+}
+
+func TestWorkspaceExternalPlanReal3D(t *testing.T) {
+	t.Parallel()
+
+	const depth, height, width = 3, 4, 8
+
+	auto, err := NewPlanReal3D(depth, height, width)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ext, err := NewPlanReal3DWithOptions(depth, height, width, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := toFloat32(randomFloat64(depth*height*width, 13))
+	want := make([]complex64, auto.SpectrumLen())
+	got := make([]complex64, ext.SpectrumLen())
+
+	err = auto.Forward(want, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ext.Forward(got, src)
+	if !errors.Is(err, ErrScratchRequired) {
+		t.Fatalf("Forward error = %v, want ErrScratchRequired", err)
+	}
+
+	scratch := make([]complex64, ext.ScratchLen())
+
+	err = ext.ForwardWithScratch(got, src, scratch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertComplexSlicesClose(t, "forward", got, want, 1e-4)
+
+	back := make([]float32, len(src))
+
+	err = ext.InverseWithScratch(back, got, scratch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertFloatSlicesClose(t, "roundtrip", back, src, 1e-4)
+}
+
+func TestWorkspaceExternalPlanRealNDAxes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		dims []int
+		axes []int
+	}{
+		{"last", []int{4, 6, 10}, []int{2}},
+		{"strided", []int{4, 10, 3}, []int{1}},
+		{"multi", []int{4, 6, 8}, []int{0, 2}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			auto, err := NewPlanRealNDAxes[float64, complex128](tc.dims, tc.axes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ext, err := NewPlanRealNDAxesWithOptions[float64, complex128](tc.dims, tc.axes, externalOptions())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := randomFloat64(auto.Len(), 19)
+			want := make([]complex128, auto.SpectrumLen())
+			got := make([]complex128, ext.SpectrumLen())
+
+			err = auto.Forward(want, src)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = ext.Forward(got, src)
+			if !errors.Is(err, ErrScratchRequired) {
+				t.Fatalf("Forward error = %v, want ErrScratchRequired", err)
+			}
+
+			scratch := make([]complex128, ext.ScratchLen())
+
+			err = ext.ForwardWithScratch(got, src, scratch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assertComplexSlicesClose(t, "forward", got, want, 1e-9)
+
+			back := make([]float64, len(src))
+
+			err = ext.InverseWithScratch(back, got, scratch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assertFloatSlicesClose(t, "roundtrip", back, src, 1e-9)
+		})
+	}
+}
+
+func TestWorkspaceScratchValidation(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanWithOptions[complex128](17, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := make([]complex128, 17)
+	dst := make([]complex128, 17)
+
+	err = plan.ForwardWithScratch(dst, src, nil)
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil scratch error = %v, want ErrNilSlice", err)
+	}
+
+	err = plan.ForwardWithScratch(dst, src, make([]complex128, plan.ScratchLen()-1))
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short scratch error = %v, want ErrLengthMismatch", err)
+	}
+
+	err = plan.InverseWithScratch(dst, src, make([]complex128, plan.ScratchLen()+8))
+	if err != nil {
+		t.Fatalf("oversized scratch: %v", err)
+	}
+}
+
+func TestWorkspaceExternalNoAllocs(t *testing.T) {
+	plan1D, err := NewPlanWithOptions[complex64](17, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan2D, err := NewPlan2DWithOptions[complex64](8, 12, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	planReal, err := NewPlanRealWithOptions(64, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	axes, err := NewPlanRealNDAxesWithOptions[float32, complex64]([]int{4, 10, 3}, []int{1}, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src1 := make([]complex64, plan1D.Len())
+	dst1 := make([]complex64, plan1D.Len())
+	scratch1 := make([]complex64, plan1D.ScratchLen())
+
+	assertNoAllocs(t, "Plan.ForwardWithScratch", func() error {
+		return plan1D.ForwardWithScratch(dst1, src1, scratch1)
+	})
+
+	src2 := make([]complex64, plan2D.Len())
+	dst2 := make([]complex64, plan2D.Len())
+	scratch2 := make([]complex64, plan2D.ScratchLen())
+
+	assertNoAllocs(t, "Plan2D.ForwardWithScratch", func() error {
+		return plan2D.ForwardWithScratch(dst2, src2, scratch2)
+	})
+
+	srcR := make([]float32, planReal.Len())
+	dstR := make([]complex64, planReal.SpectrumLen())
+	scratchR := make([]complex64, planReal.ScratchLen())
+
+	assertNoAllocs(t, "PlanReal.ForwardWithScratch", func() error {
+		return planReal.ForwardWithScratch(dstR, srcR, scratchR)
+	})
+
+	packed := make([]float32, planReal.FormatLen(SpectrumPack))
+
+	assertNoAllocs(t, "PlanReal.ForwardFormatWithScratch", func() error {
+		return planReal.ForwardFormatWithScratch(packed, srcR, SpectrumPack, scratchR)
+	})
+
+	padded := make([]float32, planReal.PaddedLen())
+
+	assertNoAllocs(t, "PlanReal.InverseInPlaceWithScratch", func() error {
+		return planReal.InverseInPlaceWithScratch(padded, scratchR)
+	})
+
+	srcA := make([]float32, axes.Len())
+	dstA := make([]complex64, axes.SpectrumLen())
+	scratchA := make([]complex64, axes.ScratchLen())
+
+	assertNoAllocs(t, "PlanRealNDAxes.ForwardWithScratch", func() error {
+		return axes.ForwardWithScratch(dstA, srcA, scratchA)
+	})
+
+	// Every 1D kernel family, in both directions
+	for _, tc := range []struct {
+		name     string
+		n        int
+		strategy KernelStrategy
+	}{
+		{"recursive", 1024, KernelRecursive},
+		{"bluestein", 97, KernelBluestein},
+		{"rader", 97, KernelRader},
+		{"pfa", 1001, KernelPFA},
+	} {
+		opts := externalOptions()
+		opts.Strategy = tc.strategy
+
+		plan, err := NewPlanWithOptions[complex64](tc.n, opts)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+
+		if plan.KernelStrategy() != tc.strategy || plan.Algorithm() == "unknown" {
+			t.Fatalf("%s: plan is %v/%q", tc.name, plan.KernelStrategy(), plan.Algorithm())
+		}
+
+		src := make([]complex64, tc.n)
+		dst := make([]complex64, tc.n)
+		scratch := make([]complex64, plan.ScratchLen())
+
+		assertNoAllocs(t, tc.name+" ForwardWithScratch", func() error {
+			return plan.ForwardWithScratch(dst, src, scratch)
+		})
+
+		assertNoAllocs(t, tc.name+" InverseWithScratch", func() error {
+			return plan.InverseWithScratch(dst, src, scratch)
+		})
+	}
+
+	// Split, pruned and resampler plans on caller scratch
+	for _, n := range []int{64, 60} {
+		split, err := NewPlanSplitWithOptions[float32, complex64](n, externalOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		re, im := make([]float32, n), make([]float32, n)
+		scratch := make([]float32, split.ScratchLen())
+
+		assertNoAllocs(t, "PlanSplit.InverseWithScratch", func() error {
+			return split.InverseWithScratch(re, im, re, im, scratch)
+		})
+	}
+
+	splitND, err := NewPlanSplitNDWithOptions[float32, complex64]([]int{4, 6, 8}, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ndRe, ndIm := make([]float32, splitND.Len()), make([]float32, splitND.Len())
+	scratchND := make([]float32, splitND.ScratchLen())
+
+	assertNoAllocs(t, "PlanSplitND.ForwardWithScratch", func() error {
+		return splitND.ForwardWithScratch(ndRe, ndIm, ndRe, ndIm, scratchND)
+	})
+
+	pruned, err := NewPlanPrunedWithOptions[complex64](4096, 64, BinRange{Start: 0, Count: 4096}, externalOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srcP := make([]complex64, pruned.InputLen())
+	dstP := make([]complex64, pruned.OutputRange().Count)
+	scratchP := make([]complex64, pruned.ScratchLen())
+
+	assertNoAllocs(t, "PlanPruned.ForwardWithScratch", func() error {
+		return pruned.ForwardWithScratch(dstP, srcP, scratchP)
+	})
+
+	resampler, err := NewResamplerReal[float32, complex64](15, 22, ResampleOptions{Plan: externalOptions()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srcRs := make([]float32, 15)
+	dstRs := make([]float32, 22)
+	scratchRs := make([]complex64, resampler.ScratchLen())
+
+	assertNoAllocs(t, "ResamplerReal.ResampleWithScratch", func() error {
+		return resampler.ResampleWithScratch(dstRs, srcRs, scratchRs)
+	})
+}